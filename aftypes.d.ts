@@ -636,6 +636,24 @@ declare module "jungletv:points" {
         pointsAdjustment: number;
     }
 
+    /** Arguments to the 'rewardredeemed' event */
+    export interface RewardRedeemedEventArgs extends EventArgs {
+        /** Guaranteed to be `rewardredeemed`. */
+        type: "rewardredeemed";
+
+        /** The newly created redemption, which will be pending fulfilment. */
+        redemption: RewardRedemption;
+    }
+
+    /** Arguments to the 'redemptionupdated' event */
+    export interface RedemptionUpdatedEventArgs extends EventArgs {
+        /** Guaranteed to be `redemptionupdated`. */
+        type: "redemptionupdated";
+
+        /** The updated redemption. */
+        redemption: RewardRedemption;
+    }
+
     /** A relation between event types and the arguments passed to the respective listeners */
     export interface PointsEventMap {
         /** This event is fired when a completely new points transaction is created. */
@@ -647,6 +665,12 @@ declare module "jungletv:points" {
          * The updated transaction retains its creation date but its update date and its value changes.
          */
         "transactionupdated": TransactionUpdatedEventArgs;
+
+        /** This event is fired when a user redeems a reward from the points rewards catalog, be it through JungleTV or through an application. */
+        "rewardredeemed": RewardRedeemedEventArgs;
+
+        /** This event is fired when the status or staff note of a reward redemption changes, be it by a staff member or by an application. */
+        "redemptionupdated": RedemptionUpdatedEventArgs;
     }
 
     /**
@@ -688,6 +712,105 @@ declare module "jungletv:points" {
      */
     export function getNiceSubscription(address: string): NiceSubscription;
 
+    /**
+     * Returns the rewards in the points rewards catalog, which is managed by JungleTV staff.
+     * @param includeDisabled Whether to include rewards that users can't currently redeem. Defaults to false.
+     * @returns An array of {@link PointsReward}, sorted by ascending cost.
+     */
+    export function getRewards(includeDisabled?: boolean): PointsReward[];
+
+    /**
+     * Returns the most recent reward redemptions, up to a maximum of 100.
+     * @param address When specified, only redemptions by the user with this reward address are returned.
+     * @param status When specified, only redemptions with this status are returned.
+     * @returns An array of {@link RewardRedemption}, most recent first.
+     */
+    export function getRedemptions(address?: string, status?: RewardRedemptionStatus): RewardRedemption[];
+
+    /**
+     * Redeems a reward on behalf of a user, deducting its cost from their points balance.
+     * Fails if the user does not have sufficient points, if the reward is disabled or out of stock, or if the user has reached the redemption limit for the reward.
+     * @param address Reward address of the user redeeming the reward.
+     * @param rewardID The ID of the reward to redeem.
+     * @param input The text provided by the user, mandatory for rewards that require input and ignored otherwise.
+     * Must not be longer than 500 characters.
+     * @returns The created {@link RewardRedemption}, with status `pending`.
+     */
+    export function redeemReward(address: string, rewardID: string, input?: string): RewardRedemption;
+
+    /**
+     * Changes the status and staff note of a reward redemption, for example to mark it as fulfilled once the application delivers the reward.
+     * Only pending redemptions can change status.
+     * Rejecting a redemption refunds its cost to the user and restores the reward stock.
+     * @param redemptionID The ID of the redemption to update.
+     * @param status The new status of the redemption.
+     * @param staffNote A note visible to the user and to staff, which replaces any existing note.
+     * Must not be longer than 500 characters.
+     * When omitted, any existing note is removed.
+     * @returns The updated {@link RewardRedemption}.
+     */
+    export function updateRedemption(redemptionID: string, status: RewardRedemptionStatus, staffNote?: string): RewardRedemption;
+
+    /** Represents a reward that users can redeem with points. */
+    export interface PointsReward {
+        /** The unique ID of the reward. */
+        id: string;
+
+        /** The user-visible title of the reward. */
+        title: string;
+
+        /** The user-visible description of the reward. */
+        description: string;
+
+        /** The amount of points required to redeem the reward. */
+        cost: number;
+
+        /** The remaining units of the reward. Absent when the stock is unlimited. */
+        stock?: number;
+
+        /** How many times each user can redeem the reward, not counting rejected redemptions. Absent when unlimited. */
+        perUserLimit?: number;
+
+        /** Whether users must provide some text when redeeming the reward, e.g. the message to highlight. */
+        requiresInput: boolean;
+
+        /** Whether users can currently redeem the reward. */
+        enabled: boolean;
+    }
+
+    /** The fulfilment status of a reward redemption. */
+    export type RewardRedemptionStatus = "pending" | "fulfilled" | "rejected";
+
+    /** Represents the redemption of a points reward by a user. */
+    export interface RewardRedemption {
+        /** The unique ID of the redemption. */
+        id: string;
+
+        /** The ID of the redeemed reward. */
+        rewardID: string;
+
+        /** The reward address of the user who redeemed the reward. */
+        address: string;
+
+        /** The amount of points the user paid for the reward. */
+        cost: number;
+
+        /** The text provided by the user, present only for rewards that require input. */
+        input?: string;
+
+        /** The fulfilment status of the redemption. Rejected redemptions have their cost refunded. */
+        status: RewardRedemptionStatus;
+
+        /** A note left by staff or by an application when updating the redemption. */
+        staffNote?: string;
+
+        /** When the reward was redeemed. */
+        createdAt: Date;
+
+        /** When the redemption was last updated. */
+        updatedAt: Date;
+    }
+
     /** Represents a JungleTV Nice subscription. */
     export interface NiceSubscription {
         /** The reward address of the subscriber. */
@@ -742,6 +865,11 @@ declare module "jungletv:points" {
         "skip_threshold_increase": {};
         "concealed_entry_enqueuing": ConcealedEntryEnqueuingExtraFields;
         "application_defined": ApplicationDefinedExtraFields;
+        "skip_vote": SkipVoteExtraFields;
+        "tip_sent": TipSentExtraFields;
+        "tip_received": TipReceivedExtraFields;
+        "reward_redemption": RewardRedemptionExtraFields;
+        "reward_redemption_refund": RewardRedemptionRefundExtraFields;
     }
 
     /** Extra object for the transaction type media_enqueued_reward */
@@ -797,6 +925,48 @@ declare module "jungletv:points" {
         /** The user-visible transaction description, as set by the application. */
         description: string;
     }
+
+    /** Extra object for the transaction type skip_vote */
+    export interface SkipVoteExtraFields {
+        /** The ID of the media that the user voted to skip. */
+        media: string;
+    }
+
+    /** Extra object for the transaction type tip_sent */
+    export interface TipSentExtraFields {
+        /** The unique ID of the tip. */
+        tip_id: string;
+
+        /** The reward address of the user who received the tip. */
+        recipient: string;
+    }
+
+    /** Extra object for the transaction type tip_received */
+    export interface TipReceivedExtraFields {
+        /** The unique ID of the tip. */
+        tip_id: string;
+
+        /** The reward address of the user who sent the tip. */
+        sender: string;
+    }
+
+    /** Extra object for the transaction type reward_redemption */
+    export interface RewardRedemptionExtraFields {
+        /** The unique ID of the redemption. */
+        redemption_id: string;
+
+        /** The ID of the redeemed reward. */
+        reward_id: string;
+    }
+
+    /** Extra object for the transaction type reward_redemption_refund */
+    export interface RewardRedemptionRefundExtraFields {
+        /** The unique ID of the rejected redemption. */
+        redemption_id: string;
+
+        /** The ID of the redeemed reward. */
+        reward_id: string;
+    }
 }
 
 /** Allows for altering different aspects of JungleTV's presentation and behavior. */
@@ -1060,7 +1230,7 @@ declare module "jungletv:queue" {
         /** Guaranteed to be `entryremoved`. */
         type: "entryremoved";
 
-        /** The queue position occupied by the entry prior to being removed. */
+        /** The queue position occupied by the entry prior to being removed, or -1 if the entry was scheduled and had yet to be released into the queue. */
         index: number;
 
         /** Whether the removal of the entry was requested by the user who enqueued it. */
@@ -1386,6 +1556,84 @@ declare module "jungletv:queue" {
 
         /** Read-only property containing the status of crowdfunded tipping. */
         tipping: CrowdfundedTippingStatus;
+
+        /**
+         * Schedules a rain: an amount that is split equally among the spectators eligible for rewards, either all at once or over the rewards for multiple queue entries.
+         * The amount is immediately debited from the application's wallet.
+         * Rains distributed all at once wait until there is a queue entry playing and at least one spectator is part of their audience.
+         * @param amount The amount to rain, in raw Banano units.
+         * @param options Optional object containing options for the rain.
+         * @returns The created {@link ScheduledRain}.
+         */
+        scheduleRain: (amount: Amount, options?: ScheduleRainOptions) => Promise<ScheduledRain>;
+
+        /**
+         * Obtains a rain previously scheduled by this application.
+         * @param id The ID of the scheduled rain.
+         * @returns The {@link ScheduledRain}, or null if no rain with the given ID was scheduled by this application.
+         */
+        getScheduledRain: (id: string) => Promise<ScheduledRain | null>;
+
+        /**
+         * Cancels a rain scheduled by this application that has not been fully distributed yet.
+         * The amount yet to be distributed is sent back to the application's wallet.
+         * @param id The ID of the scheduled rain.
+         * @returns The cancelled {@link ScheduledRain}.
+         */
+        cancelScheduledRain: (id: string) => Promise<ScheduledRain>;
+    }
+
+    /** Contains additional options for scheduling a rain */
+    export interface ScheduleRainOptions {
+        /** When the rain should start being distributed. Defaults to the current time. Must not be more than 30 days in the future. */
+        scheduledFor?: Date;
+
+        /**
+         * Who receives the rain.
+         * With `spectators`, the rain is split among all spectators eligible for rewards.
+         * With `chatters`, it is split among the spectators eligible for rewards who sent chat messages within the {@link chatWindow}.
+         * Defaults to `spectators`.
+         */
+        audience?: "spectators" | "chatters";
+
+        /** How far back, in milliseconds, to look for chat messages when the {@link audience} is `chatters`. Between one minute and 24 hours, defaults to ten minutes. */
+        chatWindow?: number;
+
+        /**
+         * The number of queue entries over whose rewards the rain is split, starting with the first entry to finish playing after {@link scheduledFor}.
+         * Between 0 and 100. When zero, the default, the whole amount is distributed at once.
+         */
+        mediaCount?: number;
+    }
+
+    /** Represents a rain scheduled by {@link Crowdfunding.scheduleRain}. */
+    export interface ScheduledRain {
+        /** The unique ID of the scheduled rain. */
+        id: string;
+
+        /** The total amount of the rain, in raw Banano units. */
+        amount: Amount;
+
+        /** The amount of the rain that has been distributed so far, in raw Banano units. */
+        distributedAmount: Amount;
+
+        /** Who receives the rain. */
+        audience: "spectators" | "chatters";
+
+        /** How far back, in milliseconds, chat messages are looked for when the {@link audience} is `chatters`. */
+        chatWindow: number;
+
+        /** The number of queue entries over whose rewards the rain is split, or zero if it is distributed at once. */
+        mediaCount: number;
+
+        /** The number of times a share of the rain has been distributed. */
+        mediaDistributed: number;
+
+        /** When the rain starts being distributed. */
+        scheduledFor: Date;
+
+        /** The status of the rain. */
+        status: "scheduled" | "complete" | "cancelled";
     }
 
     /** Status of the crowdfunded skipping feature. */
@@ -1792,6 +2040,12 @@ declare module "jungletv:queue" {
 
         /** This request is used to enqueue documents. */
         "document": DocumentMediaInfoRequest;
+
+        /** This request is used to enqueue media files (MP4, WebM) and HLS playlists hosted on arbitrary web servers. */
+        "direct_url": DirectMediaInfoRequest;
+
+        /** This request is used to enqueue media files uploaded to the JungleTV media library. */
+        "lib_media": LibraryMediaInfoRequest;
     }
 
     /** Represents the information required by the YouTube media provider to identify a video or broadcast as enqueuable media. */
@@ -1831,6 +2085,42 @@ declare module "jungletv:queue" {
         endOffset?: number;
     }
 
+    /** Represents the information required by the direct media provider to identify a media file or HLS playlist as enqueuable media. */
+    export interface DirectMediaInfoRequest {
+        /** The complete HTTP or HTTPS URL of the media file or HLS playlist. */
+        url: string;
+
+        /**
+         * The offset from the start of the media, in milliseconds, at which playback should start.
+         * Defaults to zero.
+         */
+        startOffset?: number;
+
+        /**
+         * The offset from the start of the media, in milliseconds, at which playback should end.
+         * Defaults to the length of the media.
+         */
+        endOffset?: number;
+    }
+
+    /** Represents the information required by the media library provider to identify an uploaded file as enqueuable media. */
+    export interface LibraryMediaInfoRequest {
+        /** The ID of the media library file. */
+        id: string;
+
+        /**
+         * The offset from the start of the media, in milliseconds, at which playback should start.
+         * Defaults to zero.
+         */
+        startOffset?: number;
+
+        /**
+         * The offset from the start of the media, in milliseconds, at which playback should end.
+         * Defaults to the length of the media.
+         */
+        endOffset?: number;
+    }
+
     /** Represents the information required by the documents media provider to identify a JungleTV document as enqueuable media. */
     export interface DocumentMediaInfoRequest {
         /** The ID of the document. */
@@ -1906,6 +2196,69 @@ declare module "jungletv:profile" {
      */
     export function getStatistics(address: string, since: Date): Promise<ProfileStatistics>;
 
+    /** Arguments to a profile event */
+    export interface EventArgs {
+        type: keyof ProfileEventMap;
+    }
+
+    /** Arguments to the 'tipsent' event */
+    export interface TipSentEventArgs extends EventArgs {
+        /** Guaranteed to be `tipsent`. */
+        type: "tipsent";
+
+        /** The tip that was sent. */
+        tip: Tip;
+    }
+
+    /** A relation between event types and the arguments passed to the respective listeners */
+    export interface ProfileEventMap {
+        /**
+         * This event is fired when a user tips another user directly, with points or Banano.
+         * For Banano tips, this event is fired once for each payment received from the sender.
+         */
+        "tipsent": TipSentEventArgs;
+    }
+
+    /**
+     * Registers a function to be called whenever the specified event occurs.
+     * Depending on the event, the function may be invoked with arguments containing information about the event.
+     * Refer to the documentation about each event type for details.
+     * @param eventType A case-sensitive string representing the event to listen for.
+     * @param listener A function that will be called when an event of the specified type occurs.
+     */
+    export function addEventListener<K extends keyof ProfileEventMap>(eventType: K, listener: (this: unknown, args: ProfileEventMap[K]) => void): void;
+
+    /**
+     * Ceases calling a function previously registered with {@link addEventListener} whenever the specified event occurs.
+     * @param eventType A case-sensitive string corresponding to the event type from which to unsubscribe.
+     * @param listener The function previously passed to {@link addEventListener}, that should no longer be called whenever an event of the given {@param eventType} occurs.
+     */
+    export function removeEventListener<K extends keyof ProfileEventMap>(eventType: K, listener: (this: unknown, args: ProfileEventMap[K]) => void): void;
+
+    /** Represents a direct tip from one user to another. */
+    export interface Tip {
+        /** The unique ID of the tip. */
+        id: string;
+
+        /** The user who sent the tip. */
+        sender: User;
+
+        /** The user who received the tip. */
+        recipient: User;
+
+        /** The currency of the tip. */
+        currency: "points" | "banano";
+
+        /** The amount tipped: a number of points for tips in points, or an {@link Amount} in raw Banano units for tips in Banano. */
+        amount: number | Amount;
+
+        /** The ID of the {@link MediaPerformance} whose requester was tipped for it, or `undefined` if the tip was not sent for a specific queue entry. */
+        queueEntryID?: string;
+
+        /** When the tip was sent. */
+        createdAt: Date;
+    }
+
     /** Contains extra fields about the user profile that are not present in the {@link User} type. */
     export interface UserProfile {
         /**
@@ -2156,6 +2509,49 @@ declare module "jungletv:wallet" {
      */
     export function receivePayment(timeout: number): Promise<PaymentReceiver>;
 
+    /**
+     * Creates a payment intent: a payment flow that, unlike those launched by {@link receivePayment}, is stored persistently and survives restarts of the application and of JungleTV.
+     * Like with {@link receivePayment}, a separate Banano account is allocated, into which users should send Banano.
+     * The intent concludes when the expected amount is received, when it expires, or when it is cancelled via {@link cancelPaymentIntent}.
+     * At that point, any amount received is sent to the application's {@link address} and the "paymentintentconcluded" event is fired.
+     * @param amount The amount, in raw Banano units, that is expected to be received.
+     * @param timeout The duration, in milliseconds, after which the intent expires if the expected amount has not been received.
+     * Must be no shorter than 20 seconds and no longer than seven days.
+     * @param metadata Optional data to store alongside the intent, which must be serializable as JSON and no larger than 4096 bytes once serialized.
+     * @returns The created {@link PaymentIntent}.
+     */
+    export function createPaymentIntent(amount: Amount, timeout: number, metadata?: any): Promise<PaymentIntent>;
+
+    /**
+     * Obtains a payment intent previously created by this application.
+     * @param id The ID of the payment intent.
+     * @returns The {@link PaymentIntent}, or null if no intent with the given ID was created by this application.
+     */
+    export function getPaymentIntent(id: string): Promise<PaymentIntent | null>;
+
+    /**
+     * Cancels a pending payment intent, sending any amount received so far to the application's {@link address}.
+     * @param id The ID of the payment intent.
+     * @returns The {@link PaymentIntent}, once concluded. If the intent had already concluded, it is returned unchanged.
+     */
+    export function cancelPaymentIntent(id: string): Promise<PaymentIntent>;
+
+    /**
+     * Registers a function to be called whenever the specified event occurs.
+     * Depending on the event, the function may be invoked with arguments containing information about the event.
+     * Refer to the documentation about each event type for details.
+     * @param eventType A case-sensitive string representing the event to listen for.
+     * @param listener A function that will be called when an event of the specified type occurs.
+     */
+    export function addEventListener<K extends keyof WalletEventMap>(eventType: K, listener: (this: unknown, args: WalletEventMap[K]) => void): void;
+
+    /**
+     * Ceases calling a function previously registered with {@link addEventListener} whenever the specified event occurs.
+     * @param eventType A case-sensitive string corresponding to the event type from which to unsubscribe.
+     * @param listener The function previously passed to {@link addEventListener}, that should no longer be called whenever an event of the given {@param eventType} occurs.
+     */
+    export function removeEventListener<K extends keyof WalletEventMap>(eventType: K, listener: (this: unknown, args: WalletEventMap[K]) => void): void;
+
     /**
      * Utility function that compares two raw Banano amounts.
      * @param first The first amount to compare.
@@ -2194,6 +2590,60 @@ declare module "jungletv:wallet" {
      */
     export function negateAmount(amount: Amount): Amount;
 
+    /** A relation between event types and the arguments passed to the respective listeners */
+    export interface WalletEventMap {
+        /**
+         * This event is fired when a payment intent created by {@link createPaymentIntent} stops being pending, after any amount it received has been sent to the application's {@link address}.
+         * Intents that conclude while the application has no listeners for this event, including while it is not running, are delivered once a listener is added.
+         * Listeners should therefore be added when the application starts.
+         */
+        "paymentintentconcluded": PaymentIntentConcludedEventArgs;
+    }
+
+    /** Arguments to the 'paymentintentconcluded' event */
+    export interface PaymentIntentConcludedEventArgs {
+        /** Guaranteed to be `paymentintentconcluded`. */
+        type: "paymentintentconcluded";
+
+        /** The payment intent that concluded. */
+        paymentIntent: PaymentIntent;
+    }
+
+    /** Represents a payment intent that was created by {@link createPaymentIntent}. */
+    export interface PaymentIntent {
+        /** The unique ID of the payment intent. */
+        id: string;
+
+        /**
+         * The address of the Banano account into which payments for this intent should be sent.
+         * After the intent concludes, this account should not be used to receive further funds.
+         */
+        address: string;
+
+        /** The amount, in raw Banano units, that the intent expects to receive. */
+        expectedAmount: Amount;
+
+        /** The amount, in raw Banano units, that the intent received so far. */
+        receivedAmount: Amount;
+
+        /**
+         * The status of the intent.
+         * Intents are `pending` until they receive the expected amount (`paid`), reach their expiry (`expired`) or are cancelled via {@link cancelPaymentIntent} (`cancelled`).
+         * Expired and cancelled intents may have received part of the expected amount.
+         * Intents remain `pending` until any amount they received has been sent to the application's {@link address}.
+         */
+        status: "pending" | "paid" | "expired" | "cancelled";
+
+        /** The metadata passed to {@link createPaymentIntent}. */
+        metadata: any;
+
+        /** When the intent was created. */
+        createdAt: Date;
+
+        /** When the intent expires, if it does not receive the expected amount before. */
+        expiresAt: Date;
+    }
+
     /** Represents a payment flow that was initiated by {@link receivePayment}. */
     export interface PaymentReceiver {
         /**
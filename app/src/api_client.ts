@@ -26,7 +26,7 @@ import {
     LeaderboardsRequest, LeaderboardsResponse, MarkAsActivelyModeratingRequest,
     MarkAsActivelyModeratingResponse, MediaConsumptionCheckpoint, MediaEnqueuingPermissionStatus, ModerationStatusOverview,
     MonitorMediaEnqueuingPermissionRequest,
-    MonitorModerationStatusRequest, MonitorQueueRequest, MonitorSkipAndTipRequest, MonitorTicketRequest, MoveQueueEntryRequest, MoveQueueEntryResponse, OngoingRaffleInfoRequest, OngoingRaffleInfoResponse, PlayedMediaHistoryRequest, PlayedMediaHistoryResponse, PointsInfoRequest, PointsInfoResponse, PointsTransactionsRequest, PointsTransactionsResponse, ProduceSegchaChallengeRequest, ProduceSegchaChallengeResponse, Queue,
+    MonitorModerationStatusRequest, MonitorQueueRequest, MonitorSkipAndTipRequest, MonitorTicketRequest, MoveQueueEntryRequest, MoveQueueEntryResponse, OngoingRaffleInfoRequest, OngoingRaffleInfoResponse, PlayedMediaHistoryRequest, PlayedMediaHistoryResponse, PointsInfoRequest, PointsInfoResponse, PointsTransactionsRequest, PointsTransactionsResponse, ProduceSegchaChallengeRequest, ProduceSegchaChallengeResponse, Queue, QueueEntryMovementCostRequest, QueueEntryMovementCostResponse,
     RPCConfigurationRequest,
     RPCConfigurationResponse,
     RaffleDrawingsRequest, RaffleDrawingsResponse, RedrawRaffleRequest, RedrawRaffleResponse, RemoveBanRequest,
@@ -446,6 +446,12 @@ class APIClient {
         return this.unaryRPC(JungleTV.MoveQueueEntry, request);
     }
 
+    async queueEntryMovementCost(id: string): Promise<QueueEntryMovementCostResponse> {
+        const request = new QueueEntryMovementCostRequest();
+        request.setId(id);
+        return this.unaryRPC(JungleTV.QueueEntryMovementCost, request);
+    }
+
     async rewardInfo(): Promise<RewardInfoResponse> {
         return this.unaryRPC(JungleTV.RewardInfo, new RewardInfoRequest());
     }
@@ -382,6 +382,110 @@ export namespace EnqueueSoundCloudTrackData {
   }
 }
 
+export class EnqueueYouTubePlaylistData extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnqueueYouTubePlaylistData.AsObject;
+  static toObject(includeInstance: boolean, msg: EnqueueYouTubePlaylistData): EnqueueYouTubePlaylistData.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: EnqueueYouTubePlaylistData, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnqueueYouTubePlaylistData;
+  static deserializeBinaryFromReader(message: EnqueueYouTubePlaylistData, reader: jspb.BinaryReader): EnqueueYouTubePlaylistData;
+}
+
+export namespace EnqueueYouTubePlaylistData {
+  export type AsObject = {
+    id: string,
+  }
+}
+
+export class EnqueueSoundCloudSetData extends jspb.Message {
+  getPermalink(): string;
+  setPermalink(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnqueueSoundCloudSetData.AsObject;
+  static toObject(includeInstance: boolean, msg: EnqueueSoundCloudSetData): EnqueueSoundCloudSetData.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: EnqueueSoundCloudSetData, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnqueueSoundCloudSetData;
+  static deserializeBinaryFromReader(message: EnqueueSoundCloudSetData, reader: jspb.BinaryReader): EnqueueSoundCloudSetData;
+}
+
+export namespace EnqueueSoundCloudSetData {
+  export type AsObject = {
+    permalink: string,
+  }
+}
+
+export class EnqueueDirectMediaData extends jspb.Message {
+  getUrl(): string;
+  setUrl(value: string): void;
+
+  hasStartOffset(): boolean;
+  clearStartOffset(): void;
+  getStartOffset(): google_protobuf_duration_pb.Duration | undefined;
+  setStartOffset(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasEndOffset(): boolean;
+  clearEndOffset(): void;
+  getEndOffset(): google_protobuf_duration_pb.Duration | undefined;
+  setEndOffset(value?: google_protobuf_duration_pb.Duration): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnqueueDirectMediaData.AsObject;
+  static toObject(includeInstance: boolean, msg: EnqueueDirectMediaData): EnqueueDirectMediaData.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: EnqueueDirectMediaData, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnqueueDirectMediaData;
+  static deserializeBinaryFromReader(message: EnqueueDirectMediaData, reader: jspb.BinaryReader): EnqueueDirectMediaData;
+}
+
+export namespace EnqueueDirectMediaData {
+  export type AsObject = {
+    url: string,
+    startOffset?: google_protobuf_duration_pb.Duration.AsObject,
+    endOffset?: google_protobuf_duration_pb.Duration.AsObject,
+  }
+}
+
+export class EnqueueLibraryMediaData extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  hasStartOffset(): boolean;
+  clearStartOffset(): void;
+  getStartOffset(): google_protobuf_duration_pb.Duration | undefined;
+  setStartOffset(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasEndOffset(): boolean;
+  clearEndOffset(): void;
+  getEndOffset(): google_protobuf_duration_pb.Duration | undefined;
+  setEndOffset(value?: google_protobuf_duration_pb.Duration): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnqueueLibraryMediaData.AsObject;
+  static toObject(includeInstance: boolean, msg: EnqueueLibraryMediaData): EnqueueLibraryMediaData.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: EnqueueLibraryMediaData, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnqueueLibraryMediaData;
+  static deserializeBinaryFromReader(message: EnqueueLibraryMediaData, reader: jspb.BinaryReader): EnqueueLibraryMediaData;
+}
+
+export namespace EnqueueLibraryMediaData {
+  export type AsObject = {
+    id: string,
+    startOffset?: google_protobuf_duration_pb.Duration.AsObject,
+    endOffset?: google_protobuf_duration_pb.Duration.AsObject,
+  }
+}
+
 export class EnqueueDocumentData extends jspb.Message {
   getDocumentId(): string;
   setDocumentId(value: string): void;
@@ -448,6 +552,29 @@ export class EnqueueMediaRequest extends jspb.Message {
   getDocumentData(): EnqueueDocumentData | undefined;
   setDocumentData(value?: EnqueueDocumentData): void;
 
+  hasDirectMediaData(): boolean;
+  clearDirectMediaData(): void;
+  getDirectMediaData(): EnqueueDirectMediaData | undefined;
+  setDirectMediaData(value?: EnqueueDirectMediaData): void;
+
+  hasYoutubePlaylistData(): boolean;
+  clearYoutubePlaylistData(): void;
+  getYoutubePlaylistData(): EnqueueYouTubePlaylistData | undefined;
+  setYoutubePlaylistData(value?: EnqueueYouTubePlaylistData): void;
+
+  hasSoundcloudSetData(): boolean;
+  clearSoundcloudSetData(): void;
+  getSoundcloudSetData(): EnqueueSoundCloudSetData | undefined;
+  setSoundcloudSetData(value?: EnqueueSoundCloudSetData): void;
+
+  hasLibraryMediaData(): boolean;
+  clearLibraryMediaData(): void;
+  getLibraryMediaData(): EnqueueLibraryMediaData | undefined;
+  setLibraryMediaData(value?: EnqueueLibraryMediaData): void;
+
+  getChannelId(): string;
+  setChannelId(value: string): void;
+
   getMediaInfoCase(): EnqueueMediaRequest.MediaInfoCase;
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): EnqueueMediaRequest.AsObject;
@@ -468,6 +595,11 @@ export namespace EnqueueMediaRequest {
     youtubeVideoData?: EnqueueYouTubeVideoData.AsObject,
     soundcloudTrackData?: EnqueueSoundCloudTrackData.AsObject,
     documentData?: EnqueueDocumentData.AsObject,
+    directMediaData?: EnqueueDirectMediaData.AsObject,
+    youtubePlaylistData?: EnqueueYouTubePlaylistData.AsObject,
+    soundcloudSetData?: EnqueueSoundCloudSetData.AsObject,
+    libraryMediaData?: EnqueueLibraryMediaData.AsObject,
+    channelId: string,
   }
 
   export enum MediaInfoCase {
@@ -475,6 +607,10 @@ export namespace EnqueueMediaRequest {
     YOUTUBE_VIDEO_DATA = 5,
     SOUNDCLOUD_TRACK_DATA = 6,
     DOCUMENT_DATA = 7,
+    DIRECT_MEDIA_DATA = 8,
+    YOUTUBE_PLAYLIST_DATA = 10,
+    SOUNDCLOUD_SET_DATA = 11,
+    LIBRARY_MEDIA_DATA = 12,
   }
 }
 
@@ -596,6 +732,29 @@ export class EnqueueMediaTicket extends jspb.Message {
   getDocumentData(): QueueDocumentData | undefined;
   setDocumentData(value?: QueueDocumentData): void;
 
+  hasDirectMediaData(): boolean;
+  clearDirectMediaData(): void;
+  getDirectMediaData(): QueueDirectMediaData | undefined;
+  setDirectMediaData(value?: QueueDirectMediaData): void;
+
+  hasLibraryMediaData(): boolean;
+  clearLibraryMediaData(): void;
+  getLibraryMediaData(): QueueLibraryMediaData | undefined;
+  setLibraryMediaData(value?: QueueLibraryMediaData): void;
+
+  clearItemsList(): void;
+  getItemsList(): Array<EnqueueMediaTicketItem>;
+  setItemsList(value: Array<EnqueueMediaTicketItem>): void;
+  addItems(value?: EnqueueMediaTicketItem, index?: number): EnqueueMediaTicketItem;
+
+  clearFailedItemsList(): void;
+  getFailedItemsList(): Array<EnqueueMediaFailedItem>;
+  setFailedItemsList(value: Array<EnqueueMediaFailedItem>): void;
+  addFailedItems(value?: EnqueueMediaFailedItem, index?: number): EnqueueMediaFailedItem;
+
+  getPredictedEnqueuePosition(): number;
+  setPredictedEnqueuePosition(value: number): void;
+
   getMediaInfoCase(): EnqueueMediaTicket.MediaInfoCase;
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): EnqueueMediaTicket.AsObject;
@@ -625,6 +784,11 @@ export namespace EnqueueMediaTicket {
     youtubeVideoData?: QueueYouTubeVideoData.AsObject,
     soundcloudTrackData?: QueueSoundCloudTrackData.AsObject,
     documentData?: QueueDocumentData.AsObject,
+    directMediaData?: QueueDirectMediaData.AsObject,
+    libraryMediaData?: QueueLibraryMediaData.AsObject,
+    itemsList: Array<EnqueueMediaTicketItem.AsObject>,
+    failedItemsList: Array<EnqueueMediaFailedItem.AsObject>,
+    predictedEnqueuePosition: number,
   }
 
   export enum MediaInfoCase {
@@ -632,6 +796,100 @@ export namespace EnqueueMediaTicket {
     YOUTUBE_VIDEO_DATA = 14,
     SOUNDCLOUD_TRACK_DATA = 15,
     DOCUMENT_DATA = 16,
+    DIRECT_MEDIA_DATA = 17,
+    LIBRARY_MEDIA_DATA = 21,
+  }
+}
+
+export class EnqueueMediaTicketItem extends jspb.Message {
+  hasLength(): boolean;
+  clearLength(): void;
+  getLength(): google_protobuf_duration_pb.Duration | undefined;
+  setLength(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasOffset(): boolean;
+  clearOffset(): void;
+  getOffset(): google_protobuf_duration_pb.Duration | undefined;
+  setOffset(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasYoutubeVideoData(): boolean;
+  clearYoutubeVideoData(): void;
+  getYoutubeVideoData(): QueueYouTubeVideoData | undefined;
+  setYoutubeVideoData(value?: QueueYouTubeVideoData): void;
+
+  hasSoundcloudTrackData(): boolean;
+  clearSoundcloudTrackData(): void;
+  getSoundcloudTrackData(): QueueSoundCloudTrackData | undefined;
+  setSoundcloudTrackData(value?: QueueSoundCloudTrackData): void;
+
+  hasDocumentData(): boolean;
+  clearDocumentData(): void;
+  getDocumentData(): QueueDocumentData | undefined;
+  setDocumentData(value?: QueueDocumentData): void;
+
+  hasDirectMediaData(): boolean;
+  clearDirectMediaData(): void;
+  getDirectMediaData(): QueueDirectMediaData | undefined;
+  setDirectMediaData(value?: QueueDirectMediaData): void;
+
+  hasLibraryMediaData(): boolean;
+  clearLibraryMediaData(): void;
+  getLibraryMediaData(): QueueLibraryMediaData | undefined;
+  setLibraryMediaData(value?: QueueLibraryMediaData): void;
+
+  getMediaInfoCase(): EnqueueMediaTicketItem.MediaInfoCase;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnqueueMediaTicketItem.AsObject;
+  static toObject(includeInstance: boolean, msg: EnqueueMediaTicketItem): EnqueueMediaTicketItem.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: EnqueueMediaTicketItem, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnqueueMediaTicketItem;
+  static deserializeBinaryFromReader(message: EnqueueMediaTicketItem, reader: jspb.BinaryReader): EnqueueMediaTicketItem;
+}
+
+export namespace EnqueueMediaTicketItem {
+  export type AsObject = {
+    length?: google_protobuf_duration_pb.Duration.AsObject,
+    offset?: google_protobuf_duration_pb.Duration.AsObject,
+    youtubeVideoData?: QueueYouTubeVideoData.AsObject,
+    soundcloudTrackData?: QueueSoundCloudTrackData.AsObject,
+    documentData?: QueueDocumentData.AsObject,
+    directMediaData?: QueueDirectMediaData.AsObject,
+    libraryMediaData?: QueueLibraryMediaData.AsObject,
+  }
+
+  export enum MediaInfoCase {
+    MEDIA_INFO_NOT_SET = 0,
+    YOUTUBE_VIDEO_DATA = 3,
+    SOUNDCLOUD_TRACK_DATA = 4,
+    DOCUMENT_DATA = 5,
+    DIRECT_MEDIA_DATA = 6,
+    LIBRARY_MEDIA_DATA = 7,
+  }
+}
+
+export class EnqueueMediaFailedItem extends jspb.Message {
+  getTitle(): string;
+  setTitle(value: string): void;
+
+  getFailureReason(): string;
+  setFailureReason(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnqueueMediaFailedItem.AsObject;
+  static toObject(includeInstance: boolean, msg: EnqueueMediaFailedItem): EnqueueMediaFailedItem.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: EnqueueMediaFailedItem, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnqueueMediaFailedItem;
+  static deserializeBinaryFromReader(message: EnqueueMediaFailedItem, reader: jspb.BinaryReader): EnqueueMediaFailedItem;
+}
+
+export namespace EnqueueMediaFailedItem {
+  export type AsObject = {
+    title: string,
+    failureReason: string,
   }
 }
 
@@ -771,7 +1029,50 @@ export namespace MoveQueueEntryResponse {
   }
 }
 
+export class QueueEntryMovementCostRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): QueueEntryMovementCostRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: QueueEntryMovementCostRequest): QueueEntryMovementCostRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: QueueEntryMovementCostRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): QueueEntryMovementCostRequest;
+  static deserializeBinaryFromReader(message: QueueEntryMovementCostRequest, reader: jspb.BinaryReader): QueueEntryMovementCostRequest;
+}
+
+export namespace QueueEntryMovementCostRequest {
+  export type AsObject = {
+    id: string,
+  }
+}
+
+export class QueueEntryMovementCostResponse extends jspb.Message {
+  getCost(): number;
+  setCost(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): QueueEntryMovementCostResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: QueueEntryMovementCostResponse): QueueEntryMovementCostResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: QueueEntryMovementCostResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): QueueEntryMovementCostResponse;
+  static deserializeBinaryFromReader(message: QueueEntryMovementCostResponse, reader: jspb.BinaryReader): QueueEntryMovementCostResponse;
+}
+
+export namespace QueueEntryMovementCostResponse {
+  export type AsObject = {
+    cost: number,
+  }
+}
+
 export class ConsumeMediaRequest extends jspb.Message {
+  getChannelId(): string;
+  setChannelId(value: string): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ConsumeMediaRequest.AsObject;
   static toObject(includeInstance: boolean, msg: ConsumeMediaRequest): ConsumeMediaRequest.AsObject;
@@ -784,6 +1085,7 @@ export class ConsumeMediaRequest extends jspb.Message {
 
 export namespace ConsumeMediaRequest {
   export type AsObject = {
+    channelId: string,
   }
 }
 
@@ -859,6 +1161,62 @@ export namespace NowPlayingDocumentData {
   }
 }
 
+export class NowPlayingDirectMediaData extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getUrl(): string;
+  setUrl(value: string): void;
+
+  getFormat(): DirectMediaFormatMap[keyof DirectMediaFormatMap];
+  setFormat(value: DirectMediaFormatMap[keyof DirectMediaFormatMap]): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): NowPlayingDirectMediaData.AsObject;
+  static toObject(includeInstance: boolean, msg: NowPlayingDirectMediaData): NowPlayingDirectMediaData.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: NowPlayingDirectMediaData, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): NowPlayingDirectMediaData;
+  static deserializeBinaryFromReader(message: NowPlayingDirectMediaData, reader: jspb.BinaryReader): NowPlayingDirectMediaData;
+}
+
+export namespace NowPlayingDirectMediaData {
+  export type AsObject = {
+    id: string,
+    url: string,
+    format: DirectMediaFormatMap[keyof DirectMediaFormatMap],
+  }
+}
+
+export class NowPlayingLibraryMediaData extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getUrl(): string;
+  setUrl(value: string): void;
+
+  getFormat(): DirectMediaFormatMap[keyof DirectMediaFormatMap];
+  setFormat(value: DirectMediaFormatMap[keyof DirectMediaFormatMap]): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): NowPlayingLibraryMediaData.AsObject;
+  static toObject(includeInstance: boolean, msg: NowPlayingLibraryMediaData): NowPlayingLibraryMediaData.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: NowPlayingLibraryMediaData, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): NowPlayingLibraryMediaData;
+  static deserializeBinaryFromReader(message: NowPlayingLibraryMediaData, reader: jspb.BinaryReader): NowPlayingLibraryMediaData;
+}
+
+export namespace NowPlayingLibraryMediaData {
+  export type AsObject = {
+    id: string,
+    url: string,
+    format: DirectMediaFormatMap[keyof DirectMediaFormatMap],
+  }
+}
+
 export class NowPlayingApplicationPageData extends jspb.Message {
   getApplicationId(): string;
   setApplicationId(value: string): void;
@@ -937,6 +1295,16 @@ export class MediaConsumptionCheckpoint extends jspb.Message {
   getApplicationPageData(): NowPlayingApplicationPageData | undefined;
   setApplicationPageData(value?: NowPlayingApplicationPageData): void;
 
+  hasDirectMediaData(): boolean;
+  clearDirectMediaData(): void;
+  getDirectMediaData(): NowPlayingDirectMediaData | undefined;
+  setDirectMediaData(value?: NowPlayingDirectMediaData): void;
+
+  hasLibraryMediaData(): boolean;
+  clearLibraryMediaData(): void;
+  getLibraryMediaData(): NowPlayingLibraryMediaData | undefined;
+  setLibraryMediaData(value?: NowPlayingLibraryMediaData): void;
+
   hasMediaTitle(): boolean;
   clearMediaTitle(): void;
   getMediaTitle(): string;
@@ -957,6 +1325,14 @@ export class MediaConsumptionCheckpoint extends jspb.Message {
   setClearedNotificationsList(value: Array<string>): void;
   addClearedNotifications(value: string, index?: number): string;
 
+  getPaused(): boolean;
+  setPaused(value: boolean): void;
+
+  hasPauseReason(): boolean;
+  clearPauseReason(): void;
+  getPauseReason(): string;
+  setPauseReason(value: string): void;
+
   getMediaInfoCase(): MediaConsumptionCheckpoint.MediaInfoCase;
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): MediaConsumptionCheckpoint.AsObject;
@@ -981,10 +1357,14 @@ export namespace MediaConsumptionCheckpoint {
     soundcloudTrackData?: NowPlayingSoundCloudTrackData.AsObject,
     documentData?: NowPlayingDocumentData.AsObject,
     applicationPageData?: NowPlayingApplicationPageData.AsObject,
+    directMediaData?: NowPlayingDirectMediaData.AsObject,
+    libraryMediaData?: NowPlayingLibraryMediaData.AsObject,
     mediaTitle: string,
     configurationChangesList: Array<common_pb.ConfigurationChange.AsObject>,
     notificationsList: Array<common_pb.Notification.AsObject>,
     clearedNotificationsList: Array<string>,
+    paused: boolean,
+    pauseReason: string,
   }
 
   export enum MediaInfoCase {
@@ -993,6 +1373,8 @@ export namespace MediaConsumptionCheckpoint {
     SOUNDCLOUD_TRACK_DATA = 9,
     DOCUMENT_DATA = 10,
     APPLICATION_PAGE_DATA = 11,
+    DIRECT_MEDIA_DATA = 12,
+    LIBRARY_MEDIA_DATA = 19,
   }
 }
 
@@ -1029,6 +1411,9 @@ export namespace ActivityChallenge {
 }
 
 export class MonitorQueueRequest extends jspb.Message {
+  getChannelId(): string;
+  setChannelId(value: string): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): MonitorQueueRequest.AsObject;
   static toObject(includeInstance: boolean, msg: MonitorQueueRequest): MonitorQueueRequest.AsObject;
@@ -1041,6 +1426,7 @@ export class MonitorQueueRequest extends jspb.Message {
 
 export namespace MonitorQueueRequest {
   export type AsObject = {
+    channelId: string,
   }
 }
 
@@ -1066,6 +1452,16 @@ export class Queue extends jspb.Message {
   getPlayingSince(): google_protobuf_timestamp_pb.Timestamp | undefined;
   setPlayingSince(value?: google_protobuf_timestamp_pb.Timestamp): void;
 
+  clearScheduledEntriesList(): void;
+  getScheduledEntriesList(): Array<QueueEntry>;
+  setScheduledEntriesList(value: Array<QueueEntry>): void;
+  addScheduledEntries(value?: QueueEntry, index?: number): QueueEntry;
+
+  clearTimetableSlotsList(): void;
+  getTimetableSlotsList(): Array<TimetableSlot>;
+  setTimetableSlotsList(value: Array<TimetableSlot>): void;
+  addTimetableSlots(value?: TimetableSlot, index?: number): TimetableSlot;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Queue.AsObject;
   static toObject(includeInstance: boolean, msg: Queue): Queue.AsObject;
@@ -1083,6 +1479,8 @@ export namespace Queue {
     ownEntryRemovalEnabled: boolean,
     insertCursor: string,
     playingSince?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    scheduledEntriesList: Array<QueueEntry.AsObject>,
+    timetableSlotsList: Array<TimetableSlot.AsObject>,
   }
 }
 
@@ -1224,6 +1622,70 @@ export namespace QueueApplicationPageData {
   }
 }
 
+export class QueueDirectMediaData extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getTitle(): string;
+  setTitle(value: string): void;
+
+  getUrl(): string;
+  setUrl(value: string): void;
+
+  getFormat(): DirectMediaFormatMap[keyof DirectMediaFormatMap];
+  setFormat(value: DirectMediaFormatMap[keyof DirectMediaFormatMap]): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): QueueDirectMediaData.AsObject;
+  static toObject(includeInstance: boolean, msg: QueueDirectMediaData): QueueDirectMediaData.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: QueueDirectMediaData, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): QueueDirectMediaData;
+  static deserializeBinaryFromReader(message: QueueDirectMediaData, reader: jspb.BinaryReader): QueueDirectMediaData;
+}
+
+export namespace QueueDirectMediaData {
+  export type AsObject = {
+    id: string,
+    title: string,
+    url: string,
+    format: DirectMediaFormatMap[keyof DirectMediaFormatMap],
+  }
+}
+
+export class QueueLibraryMediaData extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getTitle(): string;
+  setTitle(value: string): void;
+
+  getUrl(): string;
+  setUrl(value: string): void;
+
+  getFormat(): DirectMediaFormatMap[keyof DirectMediaFormatMap];
+  setFormat(value: DirectMediaFormatMap[keyof DirectMediaFormatMap]): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): QueueLibraryMediaData.AsObject;
+  static toObject(includeInstance: boolean, msg: QueueLibraryMediaData): QueueLibraryMediaData.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: QueueLibraryMediaData, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): QueueLibraryMediaData;
+  static deserializeBinaryFromReader(message: QueueLibraryMediaData, reader: jspb.BinaryReader): QueueLibraryMediaData;
+}
+
+export namespace QueueLibraryMediaData {
+  export type AsObject = {
+    id: string,
+    title: string,
+    url: string,
+    format: DirectMediaFormatMap[keyof DirectMediaFormatMap],
+  }
+}
+
 export class QueueConcealedData extends jspb.Message {
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): QueueConcealedData.AsObject;
@@ -1304,6 +1766,21 @@ export class QueueEntry extends jspb.Message {
   getConcealedData(): QueueConcealedData | undefined;
   setConcealedData(value?: QueueConcealedData): void;
 
+  hasDirectMediaData(): boolean;
+  clearDirectMediaData(): void;
+  getDirectMediaData(): QueueDirectMediaData | undefined;
+  setDirectMediaData(value?: QueueDirectMediaData): void;
+
+  hasLibraryMediaData(): boolean;
+  clearLibraryMediaData(): void;
+  getLibraryMediaData(): QueueLibraryMediaData | undefined;
+  setLibraryMediaData(value?: QueueLibraryMediaData): void;
+
+  hasSchedule(): boolean;
+  clearSchedule(): void;
+  getSchedule(): QueueEntrySchedule | undefined;
+  setSchedule(value?: QueueEntrySchedule): void;
+
   getMediaInfoCase(): QueueEntry.MediaInfoCase;
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): QueueEntry.AsObject;
@@ -1332,6 +1809,9 @@ export namespace QueueEntry {
     documentData?: QueueDocumentData.AsObject,
     applicationPageData?: QueueApplicationPageData.AsObject,
     concealedData?: QueueConcealedData.AsObject,
+    directMediaData?: QueueDirectMediaData.AsObject,
+    libraryMediaData?: QueueLibraryMediaData.AsObject,
+    schedule?: QueueEntrySchedule.AsObject,
   }
 
   export enum MediaInfoCase {
@@ -1341,22 +1821,166 @@ export namespace QueueEntry {
     DOCUMENT_DATA = 13,
     APPLICATION_PAGE_DATA = 14,
     CONCEALED_DATA = 15,
+    DIRECT_MEDIA_DATA = 16,
+    LIBRARY_MEDIA_DATA = 18,
   }
 }
 
-export class MonitorSkipAndTipRequest extends jspb.Message {
+export class QueueEntrySchedule extends jspb.Message {
+  hasPlannedStart(): boolean;
+  clearPlannedStart(): void;
+  getPlannedStart(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setPlannedStart(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getMode(): QueueEntrySchedulingModeMap[keyof QueueEntrySchedulingModeMap];
+  setMode(value: QueueEntrySchedulingModeMap[keyof QueueEntrySchedulingModeMap]): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): MonitorSkipAndTipRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: MonitorSkipAndTipRequest): MonitorSkipAndTipRequest.AsObject;
+  toObject(includeInstance?: boolean): QueueEntrySchedule.AsObject;
+  static toObject(includeInstance: boolean, msg: QueueEntrySchedule): QueueEntrySchedule.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: MonitorSkipAndTipRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): MonitorSkipAndTipRequest;
-  static deserializeBinaryFromReader(message: MonitorSkipAndTipRequest, reader: jspb.BinaryReader): MonitorSkipAndTipRequest;
+  static serializeBinaryToWriter(message: QueueEntrySchedule, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): QueueEntrySchedule;
+  static deserializeBinaryFromReader(message: QueueEntrySchedule, reader: jspb.BinaryReader): QueueEntrySchedule;
 }
 
-export namespace MonitorSkipAndTipRequest {
+export namespace QueueEntrySchedule {
   export type AsObject = {
+    plannedStart?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    mode: QueueEntrySchedulingModeMap[keyof QueueEntrySchedulingModeMap],
+  }
+}
+
+export class TimetableSlot extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getLabel(): string;
+  setLabel(value: string): void;
+
+  getWeekday(): number;
+  setWeekday(value: number): void;
+
+  hasStartTimeOfDay(): boolean;
+  clearStartTimeOfDay(): void;
+  getStartTimeOfDay(): google_protobuf_duration_pb.Duration | undefined;
+  setStartTimeOfDay(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasDuration(): boolean;
+  clearDuration(): void;
+  getDuration(): google_protobuf_duration_pb.Duration | undefined;
+  setDuration(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasNextStart(): boolean;
+  clearNextStart(): void;
+  getNextStart(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setNextStart(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getActive(): boolean;
+  setActive(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TimetableSlot.AsObject;
+  static toObject(includeInstance: boolean, msg: TimetableSlot): TimetableSlot.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TimetableSlot, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TimetableSlot;
+  static deserializeBinaryFromReader(message: TimetableSlot, reader: jspb.BinaryReader): TimetableSlot;
+}
+
+export namespace TimetableSlot {
+  export type AsObject = {
+    id: string,
+    label: string,
+    weekday: number,
+    startTimeOfDay?: google_protobuf_duration_pb.Duration.AsObject,
+    duration?: google_protobuf_duration_pb.Duration.AsObject,
+    nextStart?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    active: boolean,
+  }
+}
+
+export class MonitorSkipAndTipRequest extends jspb.Message {
+  getChannelId(): string;
+  setChannelId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MonitorSkipAndTipRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: MonitorSkipAndTipRequest): MonitorSkipAndTipRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: MonitorSkipAndTipRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MonitorSkipAndTipRequest;
+  static deserializeBinaryFromReader(message: MonitorSkipAndTipRequest, reader: jspb.BinaryReader): MonitorSkipAndTipRequest;
+}
+
+export namespace MonitorSkipAndTipRequest {
+  export type AsObject = {
+    channelId: string,
+  }
+}
+
+export class BroadcastChannelsRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BroadcastChannelsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: BroadcastChannelsRequest): BroadcastChannelsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: BroadcastChannelsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BroadcastChannelsRequest;
+  static deserializeBinaryFromReader(message: BroadcastChannelsRequest, reader: jspb.BinaryReader): BroadcastChannelsRequest;
+}
+
+export namespace BroadcastChannelsRequest {
+  export type AsObject = {
+  }
+}
+
+export class BroadcastChannelsResponse extends jspb.Message {
+  clearChannelsList(): void;
+  getChannelsList(): Array<BroadcastChannel>;
+  setChannelsList(value: Array<BroadcastChannel>): void;
+  addChannels(value?: BroadcastChannel, index?: number): BroadcastChannel;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BroadcastChannelsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: BroadcastChannelsResponse): BroadcastChannelsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: BroadcastChannelsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BroadcastChannelsResponse;
+  static deserializeBinaryFromReader(message: BroadcastChannelsResponse, reader: jspb.BinaryReader): BroadcastChannelsResponse;
+}
+
+export namespace BroadcastChannelsResponse {
+  export type AsObject = {
+    channelsList: Array<BroadcastChannel.AsObject>,
+  }
+}
+
+export class BroadcastChannel extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getName(): string;
+  setName(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BroadcastChannel.AsObject;
+  static toObject(includeInstance: boolean, msg: BroadcastChannel): BroadcastChannel.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: BroadcastChannel, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BroadcastChannel;
+  static deserializeBinaryFromReader(message: BroadcastChannel, reader: jspb.BinaryReader): BroadcastChannel;
+}
+
+export namespace BroadcastChannel {
+  export type AsObject = {
+    id: string,
+    name: string,
   }
 }
 
@@ -1382,6 +2006,20 @@ export class SkipAndTipStatus extends jspb.Message {
   getRainBalance(): string;
   setRainBalance(value: string): void;
 
+  getSkipVotes(): number;
+  setSkipVotes(value: number): void;
+
+  getSkipVoteThreshold(): number;
+  setSkipVoteThreshold(value: number): void;
+
+  getVotedToSkip(): boolean;
+  setVotedToSkip(value: boolean): void;
+
+  clearExtraCurrencyAddressesList(): void;
+  getExtraCurrencyAddressesList(): Array<ExtraCurrencySkipAndTipAddresses>;
+  setExtraCurrencyAddressesList(value: Array<ExtraCurrencySkipAndTipAddresses>): void;
+  addExtraCurrencyAddresses(value?: ExtraCurrencySkipAndTipAddresses, index?: number): ExtraCurrencySkipAndTipAddresses;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): SkipAndTipStatus.AsObject;
   static toObject(includeInstance: boolean, msg: SkipAndTipStatus): SkipAndTipStatus.AsObject;
@@ -1401,6 +2039,38 @@ export namespace SkipAndTipStatus {
     skipThresholdLowerable: boolean,
     rainAddress: string,
     rainBalance: string,
+    skipVotes: number,
+    skipVoteThreshold: number,
+    votedToSkip: boolean,
+    extraCurrencyAddressesList: Array<ExtraCurrencySkipAndTipAddresses.AsObject>,
+  }
+}
+
+export class ExtraCurrencySkipAndTipAddresses extends jspb.Message {
+  getCurrencyTicker(): string;
+  setCurrencyTicker(value: string): void;
+
+  getSkipAddress(): string;
+  setSkipAddress(value: string): void;
+
+  getRainAddress(): string;
+  setRainAddress(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExtraCurrencySkipAndTipAddresses.AsObject;
+  static toObject(includeInstance: boolean, msg: ExtraCurrencySkipAndTipAddresses): ExtraCurrencySkipAndTipAddresses.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ExtraCurrencySkipAndTipAddresses, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExtraCurrencySkipAndTipAddresses;
+  static deserializeBinaryFromReader(message: ExtraCurrencySkipAndTipAddresses, reader: jspb.BinaryReader): ExtraCurrencySkipAndTipAddresses;
+}
+
+export namespace ExtraCurrencySkipAndTipAddresses {
+  export type AsObject = {
+    currencyTicker: string,
+    skipAddress: string,
+    rainAddress: string,
   }
 }
 
@@ -1446,6 +2116,11 @@ export class RewardInfoResponse extends jspb.Message {
   getWithdrawalsInQueue(): number;
   setWithdrawalsInQueue(value: number): void;
 
+  hasNextExpectedPayout(): boolean;
+  clearNextExpectedPayout(): void;
+  getNextExpectedPayout(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setNextExpectedPayout(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): RewardInfoResponse.AsObject;
   static toObject(includeInstance: boolean, msg: RewardInfoResponse): RewardInfoResponse.AsObject;
@@ -1465,6 +2140,7 @@ export namespace RewardInfoResponse {
     badRemoteAddressReputation: boolean,
     withdrawalPositionInQueue: number,
     withdrawalsInQueue: number,
+    nextExpectedPayout?: google_protobuf_timestamp_pb.Timestamp.AsObject,
   }
 }
 
@@ -1511,6 +2187,11 @@ export class ForciblyEnqueueTicketRequest extends jspb.Message {
   getEnqueueType(): ForcedTicketEnqueueTypeMap[keyof ForcedTicketEnqueueTypeMap];
   setEnqueueType(value: ForcedTicketEnqueueTypeMap[keyof ForcedTicketEnqueueTypeMap]): void;
 
+  hasSchedule(): boolean;
+  clearSchedule(): void;
+  getSchedule(): QueueEntrySchedule | undefined;
+  setSchedule(value?: QueueEntrySchedule): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ForciblyEnqueueTicketRequest.AsObject;
   static toObject(includeInstance: boolean, msg: ForciblyEnqueueTicketRequest): ForciblyEnqueueTicketRequest.AsObject;
@@ -1525,6 +2206,7 @@ export namespace ForciblyEnqueueTicketRequest {
   export type AsObject = {
     id: string,
     enqueueType: ForcedTicketEnqueueTypeMap[keyof ForcedTicketEnqueueTypeMap],
+    schedule?: QueueEntrySchedule.AsObject,
   }
 }
 
@@ -3825,6 +4507,114 @@ export namespace WithdrawalHistoryResponse {
   }
 }
 
+export class WithdrawalPreferences extends jspb.Message {
+  hasThreshold(): boolean;
+  clearThreshold(): void;
+  getThreshold(): string;
+  setThreshold(value: string): void;
+
+  hasCadence(): boolean;
+  clearCadence(): void;
+  getCadence(): WithdrawalCadenceMap[keyof WithdrawalCadenceMap];
+  setCadence(value: WithdrawalCadenceMap[keyof WithdrawalCadenceMap]): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): WithdrawalPreferences.AsObject;
+  static toObject(includeInstance: boolean, msg: WithdrawalPreferences): WithdrawalPreferences.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: WithdrawalPreferences, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): WithdrawalPreferences;
+  static deserializeBinaryFromReader(message: WithdrawalPreferences, reader: jspb.BinaryReader): WithdrawalPreferences;
+}
+
+export namespace WithdrawalPreferences {
+  export type AsObject = {
+    threshold: string,
+    cadence: WithdrawalCadenceMap[keyof WithdrawalCadenceMap],
+  }
+}
+
+export class WithdrawalPreferencesRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): WithdrawalPreferencesRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: WithdrawalPreferencesRequest): WithdrawalPreferencesRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: WithdrawalPreferencesRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): WithdrawalPreferencesRequest;
+  static deserializeBinaryFromReader(message: WithdrawalPreferencesRequest, reader: jspb.BinaryReader): WithdrawalPreferencesRequest;
+}
+
+export namespace WithdrawalPreferencesRequest {
+  export type AsObject = {
+  }
+}
+
+export class WithdrawalPreferencesResponse extends jspb.Message {
+  hasPreferences(): boolean;
+  clearPreferences(): void;
+  getPreferences(): WithdrawalPreferences | undefined;
+  setPreferences(value?: WithdrawalPreferences): void;
+
+  getMinimumThreshold(): string;
+  setMinimumThreshold(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): WithdrawalPreferencesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: WithdrawalPreferencesResponse): WithdrawalPreferencesResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: WithdrawalPreferencesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): WithdrawalPreferencesResponse;
+  static deserializeBinaryFromReader(message: WithdrawalPreferencesResponse, reader: jspb.BinaryReader): WithdrawalPreferencesResponse;
+}
+
+export namespace WithdrawalPreferencesResponse {
+  export type AsObject = {
+    preferences?: WithdrawalPreferences.AsObject,
+    minimumThreshold: string,
+  }
+}
+
+export class SetWithdrawalPreferencesRequest extends jspb.Message {
+  hasPreferences(): boolean;
+  clearPreferences(): void;
+  getPreferences(): WithdrawalPreferences | undefined;
+  setPreferences(value?: WithdrawalPreferences): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetWithdrawalPreferencesRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetWithdrawalPreferencesRequest): SetWithdrawalPreferencesRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetWithdrawalPreferencesRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetWithdrawalPreferencesRequest;
+  static deserializeBinaryFromReader(message: SetWithdrawalPreferencesRequest, reader: jspb.BinaryReader): SetWithdrawalPreferencesRequest;
+}
+
+export namespace SetWithdrawalPreferencesRequest {
+  export type AsObject = {
+    preferences?: WithdrawalPreferences.AsObject,
+  }
+}
+
+export class SetWithdrawalPreferencesResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetWithdrawalPreferencesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetWithdrawalPreferencesResponse): SetWithdrawalPreferencesResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetWithdrawalPreferencesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetWithdrawalPreferencesResponse;
+  static deserializeBinaryFromReader(message: SetWithdrawalPreferencesResponse, reader: jspb.BinaryReader): SetWithdrawalPreferencesResponse;
+}
+
+export namespace SetWithdrawalPreferencesResponse {
+  export type AsObject = {
+  }
+}
+
 export class SetCrowdfundedSkippingEnabledRequest extends jspb.Message {
   getEnabled(): boolean;
   setEnabled(value: boolean): void;
@@ -4287,2448 +5077,5724 @@ export namespace RaffleDrawingsResponse {
   }
 }
 
-export class TriggerAnnouncementsNotificationRequest extends jspb.Message {
+export class Refund extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  hasRecipient(): boolean;
+  clearRecipient(): void;
+  getRecipient(): common_pb.User | undefined;
+  setRecipient(value?: common_pb.User): void;
+
+  getAmount(): string;
+  setAmount(value: string): void;
+
+  getReason(): RefundReasonMap[keyof RefundReasonMap];
+  setReason(value: RefundReasonMap[keyof RefundReasonMap]): void;
+
+  getStatus(): RefundStatusMap[keyof RefundStatusMap];
+  setStatus(value: RefundStatusMap[keyof RefundStatusMap]): void;
+
+  getComment(): string;
+  setComment(value: string): void;
+
+  hasTicketId(): boolean;
+  clearTicketId(): void;
+  getTicketId(): string;
+  setTicketId(value: string): void;
+
+  hasQueueEntryId(): boolean;
+  clearQueueEntryId(): void;
+  getQueueEntryId(): string;
+  setQueueEntryId(value: string): void;
+
+  hasPaymentBlockHash(): boolean;
+  clearPaymentBlockHash(): void;
+  getPaymentBlockHash(): string;
+  setPaymentBlockHash(value: string): void;
+
+  hasCreatedAt(): boolean;
+  clearCreatedAt(): void;
+  getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasUpdatedAt(): boolean;
+  clearUpdatedAt(): void;
+  getUpdatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setUpdatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasReviewedBy(): boolean;
+  clearReviewedBy(): void;
+  getReviewedBy(): common_pb.User | undefined;
+  setReviewedBy(value?: common_pb.User): void;
+
+  hasTxHash(): boolean;
+  clearTxHash(): void;
+  getTxHash(): string;
+  setTxHash(value: string): void;
+
+  hasFailureReason(): boolean;
+  clearFailureReason(): void;
+  getFailureReason(): string;
+  setFailureReason(value: string): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): TriggerAnnouncementsNotificationRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: TriggerAnnouncementsNotificationRequest): TriggerAnnouncementsNotificationRequest.AsObject;
+  toObject(includeInstance?: boolean): Refund.AsObject;
+  static toObject(includeInstance: boolean, msg: Refund): Refund.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: TriggerAnnouncementsNotificationRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): TriggerAnnouncementsNotificationRequest;
-  static deserializeBinaryFromReader(message: TriggerAnnouncementsNotificationRequest, reader: jspb.BinaryReader): TriggerAnnouncementsNotificationRequest;
+  static serializeBinaryToWriter(message: Refund, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Refund;
+  static deserializeBinaryFromReader(message: Refund, reader: jspb.BinaryReader): Refund;
 }
 
-export namespace TriggerAnnouncementsNotificationRequest {
+export namespace Refund {
   export type AsObject = {
+    id: string,
+    recipient?: common_pb.User.AsObject,
+    amount: string,
+    reason: RefundReasonMap[keyof RefundReasonMap],
+    status: RefundStatusMap[keyof RefundStatusMap],
+    comment: string,
+    ticketId: string,
+    queueEntryId: string,
+    paymentBlockHash: string,
+    createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    updatedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    reviewedBy?: common_pb.User.AsObject,
+    txHash: string,
+    failureReason: string,
   }
 }
 
-export class TriggerAnnouncementsNotificationResponse extends jspb.Message {
+export class RefundsRequest extends jspb.Message {
+  hasPaginationParams(): boolean;
+  clearPaginationParams(): void;
+  getPaginationParams(): common_pb.PaginationParameters | undefined;
+  setPaginationParams(value?: common_pb.PaginationParameters): void;
+
+  clearStatusesList(): void;
+  getStatusesList(): Array<RefundStatusMap[keyof RefundStatusMap]>;
+  setStatusesList(value: Array<RefundStatusMap[keyof RefundStatusMap]>): void;
+  addStatuses(value: RefundStatusMap[keyof RefundStatusMap], index?: number): RefundStatusMap[keyof RefundStatusMap];
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): TriggerAnnouncementsNotificationResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: TriggerAnnouncementsNotificationResponse): TriggerAnnouncementsNotificationResponse.AsObject;
+  toObject(includeInstance?: boolean): RefundsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RefundsRequest): RefundsRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: TriggerAnnouncementsNotificationResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): TriggerAnnouncementsNotificationResponse;
-  static deserializeBinaryFromReader(message: TriggerAnnouncementsNotificationResponse, reader: jspb.BinaryReader): TriggerAnnouncementsNotificationResponse;
+  static serializeBinaryToWriter(message: RefundsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RefundsRequest;
+  static deserializeBinaryFromReader(message: RefundsRequest, reader: jspb.BinaryReader): RefundsRequest;
 }
 
-export namespace TriggerAnnouncementsNotificationResponse {
+export namespace RefundsRequest {
   export type AsObject = {
+    paginationParams?: common_pb.PaginationParameters.AsObject,
+    statusesList: Array<RefundStatusMap[keyof RefundStatusMap]>,
   }
 }
 
-export class SpectatorInfoRequest extends jspb.Message {
-  getRewardsAddress(): string;
-  setRewardsAddress(value: string): void;
+export class RefundsResponse extends jspb.Message {
+  clearRefundsList(): void;
+  getRefundsList(): Array<Refund>;
+  setRefundsList(value: Array<Refund>): void;
+  addRefunds(value?: Refund, index?: number): Refund;
+
+  getOffset(): number;
+  setOffset(value: number): void;
+
+  getTotal(): number;
+  setTotal(value: number): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SpectatorInfoRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: SpectatorInfoRequest): SpectatorInfoRequest.AsObject;
+  toObject(includeInstance?: boolean): RefundsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RefundsResponse): RefundsResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SpectatorInfoRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SpectatorInfoRequest;
-  static deserializeBinaryFromReader(message: SpectatorInfoRequest, reader: jspb.BinaryReader): SpectatorInfoRequest;
+  static serializeBinaryToWriter(message: RefundsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RefundsResponse;
+  static deserializeBinaryFromReader(message: RefundsResponse, reader: jspb.BinaryReader): RefundsResponse;
 }
 
-export namespace SpectatorInfoRequest {
+export namespace RefundsResponse {
   export type AsObject = {
-    rewardsAddress: string,
+    refundsList: Array<Refund.AsObject>,
+    offset: number,
+    total: number,
   }
 }
 
-export class Spectator extends jspb.Message {
-  hasUser(): boolean;
-  clearUser(): void;
-  getUser(): common_pb.User | undefined;
-  setUser(value?: common_pb.User): void;
-
-  getNumConnections(): number;
-  setNumConnections(value: number): void;
+export class ProposeRefundRequest extends jspb.Message {
+  getAddress(): string;
+  setAddress(value: string): void;
 
-  getNumSpectatorsWithSameRemoteAddress(): number;
-  setNumSpectatorsWithSameRemoteAddress(value: number): void;
+  getAmount(): string;
+  setAmount(value: string): void;
 
-  hasWatchingSince(): boolean;
-  clearWatchingSince(): void;
-  getWatchingSince(): google_protobuf_timestamp_pb.Timestamp | undefined;
-  setWatchingSince(value?: google_protobuf_timestamp_pb.Timestamp): void;
+  getComment(): string;
+  setComment(value: string): void;
 
-  getRemoteAddressHasGoodReputation(): boolean;
-  setRemoteAddressHasGoodReputation(value: boolean): void;
+  hasTicketId(): boolean;
+  clearTicketId(): void;
+  getTicketId(): string;
+  setTicketId(value: string): void;
 
-  getRemoteAddressBannedFromRewards(): boolean;
-  setRemoteAddressBannedFromRewards(value: boolean): void;
-
-  getLegitimate(): boolean;
-  setLegitimate(value: boolean): void;
-
-  hasNotLegitimateSince(): boolean;
-  clearNotLegitimateSince(): void;
-  getNotLegitimateSince(): google_protobuf_timestamp_pb.Timestamp | undefined;
-  setNotLegitimateSince(value?: google_protobuf_timestamp_pb.Timestamp): void;
-
-  hasStoppedWatchingAt(): boolean;
-  clearStoppedWatchingAt(): void;
-  getStoppedWatchingAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
-  setStoppedWatchingAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
-
-  hasActivityChallenge(): boolean;
-  clearActivityChallenge(): void;
-  getActivityChallenge(): ActivityChallenge | undefined;
-  setActivityChallenge(value?: ActivityChallenge): void;
-
-  getClientIntegrityChecksSkipped(): boolean;
-  setClientIntegrityChecksSkipped(value: boolean): void;
-
-  getIpAddressReputationChecksSkipped(): boolean;
-  setIpAddressReputationChecksSkipped(value: boolean): void;
-
-  getHardChallengeFrequencyReduced(): boolean;
-  setHardChallengeFrequencyReduced(value: boolean): void;
-
-  hasAsNumber(): boolean;
-  clearAsNumber(): void;
-  getAsNumber(): number;
-  setAsNumber(value: number): void;
+  hasQueueEntryId(): boolean;
+  clearQueueEntryId(): void;
+  getQueueEntryId(): string;
+  setQueueEntryId(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): Spectator.AsObject;
-  static toObject(includeInstance: boolean, msg: Spectator): Spectator.AsObject;
+  toObject(includeInstance?: boolean): ProposeRefundRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ProposeRefundRequest): ProposeRefundRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: Spectator, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): Spectator;
-  static deserializeBinaryFromReader(message: Spectator, reader: jspb.BinaryReader): Spectator;
+  static serializeBinaryToWriter(message: ProposeRefundRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ProposeRefundRequest;
+  static deserializeBinaryFromReader(message: ProposeRefundRequest, reader: jspb.BinaryReader): ProposeRefundRequest;
 }
 
-export namespace Spectator {
+export namespace ProposeRefundRequest {
   export type AsObject = {
-    user?: common_pb.User.AsObject,
-    numConnections: number,
-    numSpectatorsWithSameRemoteAddress: number,
-    watchingSince?: google_protobuf_timestamp_pb.Timestamp.AsObject,
-    remoteAddressHasGoodReputation: boolean,
-    remoteAddressBannedFromRewards: boolean,
-    legitimate: boolean,
-    notLegitimateSince?: google_protobuf_timestamp_pb.Timestamp.AsObject,
-    stoppedWatchingAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
-    activityChallenge?: ActivityChallenge.AsObject,
-    clientIntegrityChecksSkipped: boolean,
-    ipAddressReputationChecksSkipped: boolean,
-    hardChallengeFrequencyReduced: boolean,
-    asNumber: number,
+    address: string,
+    amount: string,
+    comment: string,
+    ticketId: string,
+    queueEntryId: string,
   }
 }
 
-export class ResetSpectatorStatusRequest extends jspb.Message {
-  getRewardsAddress(): string;
-  setRewardsAddress(value: string): void;
+export class ProposeRefundResponse extends jspb.Message {
+  hasRefund(): boolean;
+  clearRefund(): void;
+  getRefund(): Refund | undefined;
+  setRefund(value?: Refund): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ResetSpectatorStatusRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: ResetSpectatorStatusRequest): ResetSpectatorStatusRequest.AsObject;
+  toObject(includeInstance?: boolean): ProposeRefundResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ProposeRefundResponse): ProposeRefundResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ResetSpectatorStatusRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ResetSpectatorStatusRequest;
-  static deserializeBinaryFromReader(message: ResetSpectatorStatusRequest, reader: jspb.BinaryReader): ResetSpectatorStatusRequest;
+  static serializeBinaryToWriter(message: ProposeRefundResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ProposeRefundResponse;
+  static deserializeBinaryFromReader(message: ProposeRefundResponse, reader: jspb.BinaryReader): ProposeRefundResponse;
 }
 
-export namespace ResetSpectatorStatusRequest {
+export namespace ProposeRefundResponse {
   export type AsObject = {
-    rewardsAddress: string,
+    refund?: Refund.AsObject,
   }
 }
 
-export class ResetSpectatorStatusResponse extends jspb.Message {
+export class ApproveRefundRequest extends jspb.Message {
+  getRefundId(): string;
+  setRefundId(value: string): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ResetSpectatorStatusResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: ResetSpectatorStatusResponse): ResetSpectatorStatusResponse.AsObject;
+  toObject(includeInstance?: boolean): ApproveRefundRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ApproveRefundRequest): ApproveRefundRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ResetSpectatorStatusResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ResetSpectatorStatusResponse;
-  static deserializeBinaryFromReader(message: ResetSpectatorStatusResponse, reader: jspb.BinaryReader): ResetSpectatorStatusResponse;
+  static serializeBinaryToWriter(message: ApproveRefundRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ApproveRefundRequest;
+  static deserializeBinaryFromReader(message: ApproveRefundRequest, reader: jspb.BinaryReader): ApproveRefundRequest;
 }
 
-export namespace ResetSpectatorStatusResponse {
+export namespace ApproveRefundRequest {
   export type AsObject = {
+    refundId: string,
   }
 }
 
-export class MonitorModerationStatusRequest extends jspb.Message {
+export class ApproveRefundResponse extends jspb.Message {
+  hasRefund(): boolean;
+  clearRefund(): void;
+  getRefund(): Refund | undefined;
+  setRefund(value?: Refund): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): MonitorModerationStatusRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: MonitorModerationStatusRequest): MonitorModerationStatusRequest.AsObject;
+  toObject(includeInstance?: boolean): ApproveRefundResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ApproveRefundResponse): ApproveRefundResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: MonitorModerationStatusRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): MonitorModerationStatusRequest;
-  static deserializeBinaryFromReader(message: MonitorModerationStatusRequest, reader: jspb.BinaryReader): MonitorModerationStatusRequest;
+  static serializeBinaryToWriter(message: ApproveRefundResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ApproveRefundResponse;
+  static deserializeBinaryFromReader(message: ApproveRefundResponse, reader: jspb.BinaryReader): ApproveRefundResponse;
 }
 
-export namespace MonitorModerationStatusRequest {
+export namespace ApproveRefundResponse {
   export type AsObject = {
+    refund?: Refund.AsObject,
   }
 }
 
-export class ModerationStatusOverview extends jspb.Message {
-  getAllowedMediaEnqueuing(): AllowedMediaEnqueuingTypeMap[keyof AllowedMediaEnqueuingTypeMap];
-  setAllowedMediaEnqueuing(value: AllowedMediaEnqueuingTypeMap[keyof AllowedMediaEnqueuingTypeMap]): void;
-
-  getEnqueuingPricesMultiplier(): number;
-  setEnqueuingPricesMultiplier(value: number): void;
-
-  getCrowdfundedSkippingEnabled(): boolean;
-  setCrowdfundedSkippingEnabled(value: boolean): void;
-
-  getCrowdfundedSkippingPricesMultiplier(): number;
-  setCrowdfundedSkippingPricesMultiplier(value: number): void;
-
-  getNewEntriesAlwaysUnskippable(): boolean;
-  setNewEntriesAlwaysUnskippable(value: boolean): void;
-
-  getOwnEntryRemovalEnabled(): boolean;
-  setOwnEntryRemovalEnabled(value: boolean): void;
-
-  getAllSkippingEnabled(): boolean;
-  setAllSkippingEnabled(value: boolean): void;
-
-  hasQueueInsertCursor(): boolean;
-  clearQueueInsertCursor(): void;
-  getQueueInsertCursor(): string;
-  setQueueInsertCursor(value: string): void;
-
-  getMinimumPricesMultiplier(): number;
-  setMinimumPricesMultiplier(value: number): void;
-
-  clearActivelyModeratingList(): void;
-  getActivelyModeratingList(): Array<common_pb.User>;
-  setActivelyModeratingList(value: Array<common_pb.User>): void;
-  addActivelyModerating(value?: common_pb.User, index?: number): common_pb.User;
-
-  getAllowEntryReordering(): boolean;
-  setAllowEntryReordering(value: boolean): void;
-
-  clearVipUsersList(): void;
-  getVipUsersList(): Array<common_pb.User>;
-  setVipUsersList(value: Array<common_pb.User>): void;
-  addVipUsers(value?: common_pb.User, index?: number): common_pb.User;
+export class RetryRefundRequest extends jspb.Message {
+  getRefundId(): string;
+  setRefundId(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ModerationStatusOverview.AsObject;
-  static toObject(includeInstance: boolean, msg: ModerationStatusOverview): ModerationStatusOverview.AsObject;
+  toObject(includeInstance?: boolean): RetryRefundRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RetryRefundRequest): RetryRefundRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ModerationStatusOverview, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ModerationStatusOverview;
-  static deserializeBinaryFromReader(message: ModerationStatusOverview, reader: jspb.BinaryReader): ModerationStatusOverview;
+  static serializeBinaryToWriter(message: RetryRefundRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RetryRefundRequest;
+  static deserializeBinaryFromReader(message: RetryRefundRequest, reader: jspb.BinaryReader): RetryRefundRequest;
 }
 
-export namespace ModerationStatusOverview {
+export namespace RetryRefundRequest {
   export type AsObject = {
-    allowedMediaEnqueuing: AllowedMediaEnqueuingTypeMap[keyof AllowedMediaEnqueuingTypeMap],
-    enqueuingPricesMultiplier: number,
-    crowdfundedSkippingEnabled: boolean,
-    crowdfundedSkippingPricesMultiplier: number,
-    newEntriesAlwaysUnskippable: boolean,
-    ownEntryRemovalEnabled: boolean,
-    allSkippingEnabled: boolean,
-    queueInsertCursor: string,
-    minimumPricesMultiplier: number,
-    activelyModeratingList: Array<common_pb.User.AsObject>,
-    allowEntryReordering: boolean,
-    vipUsersList: Array<common_pb.User.AsObject>,
+    refundId: string,
   }
 }
 
-export class SetQueueEntryReorderingAllowedRequest extends jspb.Message {
-  getAllowed(): boolean;
-  setAllowed(value: boolean): void;
+export class RetryRefundResponse extends jspb.Message {
+  hasRefund(): boolean;
+  clearRefund(): void;
+  getRefund(): Refund | undefined;
+  setRefund(value?: Refund): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetQueueEntryReorderingAllowedRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: SetQueueEntryReorderingAllowedRequest): SetQueueEntryReorderingAllowedRequest.AsObject;
+  toObject(includeInstance?: boolean): RetryRefundResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RetryRefundResponse): RetryRefundResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetQueueEntryReorderingAllowedRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetQueueEntryReorderingAllowedRequest;
-  static deserializeBinaryFromReader(message: SetQueueEntryReorderingAllowedRequest, reader: jspb.BinaryReader): SetQueueEntryReorderingAllowedRequest;
+  static serializeBinaryToWriter(message: RetryRefundResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RetryRefundResponse;
+  static deserializeBinaryFromReader(message: RetryRefundResponse, reader: jspb.BinaryReader): RetryRefundResponse;
 }
 
-export namespace SetQueueEntryReorderingAllowedRequest {
+export namespace RetryRefundResponse {
   export type AsObject = {
-    allowed: boolean,
+    refund?: Refund.AsObject,
   }
 }
 
-export class SetQueueEntryReorderingAllowedResponse extends jspb.Message {
+export class ReconcileJournalRequest extends jspb.Message {
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetQueueEntryReorderingAllowedResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: SetQueueEntryReorderingAllowedResponse): SetQueueEntryReorderingAllowedResponse.AsObject;
+  toObject(includeInstance?: boolean): ReconcileJournalRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ReconcileJournalRequest): ReconcileJournalRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetQueueEntryReorderingAllowedResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetQueueEntryReorderingAllowedResponse;
-  static deserializeBinaryFromReader(message: SetQueueEntryReorderingAllowedResponse, reader: jspb.BinaryReader): SetQueueEntryReorderingAllowedResponse;
+  static serializeBinaryToWriter(message: ReconcileJournalRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ReconcileJournalRequest;
+  static deserializeBinaryFromReader(message: ReconcileJournalRequest, reader: jspb.BinaryReader): ReconcileJournalRequest;
 }
 
-export namespace SetQueueEntryReorderingAllowedResponse {
+export namespace ReconcileJournalRequest {
   export type AsObject = {
   }
 }
 
-export class SetOwnQueueEntryRemovalAllowedRequest extends jspb.Message {
-  getAllowed(): boolean;
-  setAllowed(value: boolean): void;
+export class JournalDiscrepancy extends jspb.Message {
+  getAccount(): string;
+  setAccount(value: string): void;
+
+  getExpectedBalance(): string;
+  setExpectedBalance(value: string): void;
+
+  getActualBalance(): string;
+  setActualBalance(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetOwnQueueEntryRemovalAllowedRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: SetOwnQueueEntryRemovalAllowedRequest): SetOwnQueueEntryRemovalAllowedRequest.AsObject;
+  toObject(includeInstance?: boolean): JournalDiscrepancy.AsObject;
+  static toObject(includeInstance: boolean, msg: JournalDiscrepancy): JournalDiscrepancy.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetOwnQueueEntryRemovalAllowedRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetOwnQueueEntryRemovalAllowedRequest;
-  static deserializeBinaryFromReader(message: SetOwnQueueEntryRemovalAllowedRequest, reader: jspb.BinaryReader): SetOwnQueueEntryRemovalAllowedRequest;
+  static serializeBinaryToWriter(message: JournalDiscrepancy, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): JournalDiscrepancy;
+  static deserializeBinaryFromReader(message: JournalDiscrepancy, reader: jspb.BinaryReader): JournalDiscrepancy;
 }
 
-export namespace SetOwnQueueEntryRemovalAllowedRequest {
+export namespace JournalDiscrepancy {
   export type AsObject = {
-    allowed: boolean,
+    account: string,
+    expectedBalance: string,
+    actualBalance: string,
   }
 }
 
-export class SetOwnQueueEntryRemovalAllowedResponse extends jspb.Message {
+export class ReconcileJournalResponse extends jspb.Message {
+  hasCheckedAt(): boolean;
+  clearCheckedAt(): void;
+  getCheckedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCheckedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getCheckedAccounts(): number;
+  setCheckedAccounts(value: number): void;
+
+  clearDiscrepanciesList(): void;
+  getDiscrepanciesList(): Array<JournalDiscrepancy>;
+  setDiscrepanciesList(value: Array<JournalDiscrepancy>): void;
+  addDiscrepancies(value?: JournalDiscrepancy, index?: number): JournalDiscrepancy;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetOwnQueueEntryRemovalAllowedResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: SetOwnQueueEntryRemovalAllowedResponse): SetOwnQueueEntryRemovalAllowedResponse.AsObject;
+  toObject(includeInstance?: boolean): ReconcileJournalResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ReconcileJournalResponse): ReconcileJournalResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetOwnQueueEntryRemovalAllowedResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetOwnQueueEntryRemovalAllowedResponse;
-  static deserializeBinaryFromReader(message: SetOwnQueueEntryRemovalAllowedResponse, reader: jspb.BinaryReader): SetOwnQueueEntryRemovalAllowedResponse;
+  static serializeBinaryToWriter(message: ReconcileJournalResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ReconcileJournalResponse;
+  static deserializeBinaryFromReader(message: ReconcileJournalResponse, reader: jspb.BinaryReader): ReconcileJournalResponse;
 }
 
-export namespace SetOwnQueueEntryRemovalAllowedResponse {
+export namespace ReconcileJournalResponse {
   export type AsObject = {
+    checkedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    checkedAccounts: number,
+    discrepanciesList: Array<JournalDiscrepancy.AsObject>,
   }
 }
 
-export class SetNewQueueEntriesAlwaysUnskippableRequest extends jspb.Message {
-  getEnabled(): boolean;
-  setEnabled(value: boolean): void;
+export class ScheduledRain extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getChannelId(): string;
+  setChannelId(value: string): void;
+
+  getAmount(): string;
+  setAmount(value: string): void;
+
+  getDistributedAmount(): string;
+  setDistributedAmount(value: string): void;
+
+  getAudience(): ScheduledRainAudienceMap[keyof ScheduledRainAudienceMap];
+  setAudience(value: ScheduledRainAudienceMap[keyof ScheduledRainAudienceMap]): void;
+
+  hasChatWindow(): boolean;
+  clearChatWindow(): void;
+  getChatWindow(): google_protobuf_duration_pb.Duration | undefined;
+  setChatWindow(value?: google_protobuf_duration_pb.Duration): void;
+
+  getMediaCount(): number;
+  setMediaCount(value: number): void;
+
+  getMediaDistributed(): number;
+  setMediaDistributed(value: number): void;
+
+  hasScheduledFor(): boolean;
+  clearScheduledFor(): void;
+  getScheduledFor(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setScheduledFor(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getStatus(): ScheduledRainStatusMap[keyof ScheduledRainStatusMap];
+  setStatus(value: ScheduledRainStatusMap[keyof ScheduledRainStatusMap]): void;
+
+  hasCreatedBy(): boolean;
+  clearCreatedBy(): void;
+  getCreatedBy(): common_pb.User | undefined;
+  setCreatedBy(value?: common_pb.User): void;
+
+  hasApplicationId(): boolean;
+  clearApplicationId(): void;
+  getApplicationId(): string;
+  setApplicationId(value: string): void;
+
+  hasCreatedAt(): boolean;
+  clearCreatedAt(): void;
+  getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasUpdatedAt(): boolean;
+  clearUpdatedAt(): void;
+  getUpdatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setUpdatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetNewQueueEntriesAlwaysUnskippableRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: SetNewQueueEntriesAlwaysUnskippableRequest): SetNewQueueEntriesAlwaysUnskippableRequest.AsObject;
+  toObject(includeInstance?: boolean): ScheduledRain.AsObject;
+  static toObject(includeInstance: boolean, msg: ScheduledRain): ScheduledRain.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetNewQueueEntriesAlwaysUnskippableRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetNewQueueEntriesAlwaysUnskippableRequest;
-  static deserializeBinaryFromReader(message: SetNewQueueEntriesAlwaysUnskippableRequest, reader: jspb.BinaryReader): SetNewQueueEntriesAlwaysUnskippableRequest;
+  static serializeBinaryToWriter(message: ScheduledRain, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ScheduledRain;
+  static deserializeBinaryFromReader(message: ScheduledRain, reader: jspb.BinaryReader): ScheduledRain;
 }
 
-export namespace SetNewQueueEntriesAlwaysUnskippableRequest {
+export namespace ScheduledRain {
   export type AsObject = {
-    enabled: boolean,
+    id: string,
+    channelId: string,
+    amount: string,
+    distributedAmount: string,
+    audience: ScheduledRainAudienceMap[keyof ScheduledRainAudienceMap],
+    chatWindow?: google_protobuf_duration_pb.Duration.AsObject,
+    mediaCount: number,
+    mediaDistributed: number,
+    scheduledFor?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    status: ScheduledRainStatusMap[keyof ScheduledRainStatusMap],
+    createdBy?: common_pb.User.AsObject,
+    applicationId: string,
+    createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    updatedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
   }
 }
 
-export class SetNewQueueEntriesAlwaysUnskippableResponse extends jspb.Message {
+export class ScheduledRainsRequest extends jspb.Message {
+  hasPaginationParams(): boolean;
+  clearPaginationParams(): void;
+  getPaginationParams(): common_pb.PaginationParameters | undefined;
+  setPaginationParams(value?: common_pb.PaginationParameters): void;
+
+  clearStatusesList(): void;
+  getStatusesList(): Array<ScheduledRainStatusMap[keyof ScheduledRainStatusMap]>;
+  setStatusesList(value: Array<ScheduledRainStatusMap[keyof ScheduledRainStatusMap]>): void;
+  addStatuses(value: ScheduledRainStatusMap[keyof ScheduledRainStatusMap], index?: number): ScheduledRainStatusMap[keyof ScheduledRainStatusMap];
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetNewQueueEntriesAlwaysUnskippableResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: SetNewQueueEntriesAlwaysUnskippableResponse): SetNewQueueEntriesAlwaysUnskippableResponse.AsObject;
+  toObject(includeInstance?: boolean): ScheduledRainsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ScheduledRainsRequest): ScheduledRainsRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetNewQueueEntriesAlwaysUnskippableResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetNewQueueEntriesAlwaysUnskippableResponse;
-  static deserializeBinaryFromReader(message: SetNewQueueEntriesAlwaysUnskippableResponse, reader: jspb.BinaryReader): SetNewQueueEntriesAlwaysUnskippableResponse;
+  static serializeBinaryToWriter(message: ScheduledRainsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ScheduledRainsRequest;
+  static deserializeBinaryFromReader(message: ScheduledRainsRequest, reader: jspb.BinaryReader): ScheduledRainsRequest;
 }
 
-export namespace SetNewQueueEntriesAlwaysUnskippableResponse {
+export namespace ScheduledRainsRequest {
   export type AsObject = {
+    paginationParams?: common_pb.PaginationParameters.AsObject,
+    statusesList: Array<ScheduledRainStatusMap[keyof ScheduledRainStatusMap]>,
   }
 }
 
-export class SetSkippingEnabledRequest extends jspb.Message {
-  getEnabled(): boolean;
-  setEnabled(value: boolean): void;
+export class ScheduledRainsResponse extends jspb.Message {
+  clearRainsList(): void;
+  getRainsList(): Array<ScheduledRain>;
+  setRainsList(value: Array<ScheduledRain>): void;
+  addRains(value?: ScheduledRain, index?: number): ScheduledRain;
+
+  getOffset(): number;
+  setOffset(value: number): void;
+
+  getTotal(): number;
+  setTotal(value: number): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetSkippingEnabledRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: SetSkippingEnabledRequest): SetSkippingEnabledRequest.AsObject;
+  toObject(includeInstance?: boolean): ScheduledRainsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ScheduledRainsResponse): ScheduledRainsResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetSkippingEnabledRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetSkippingEnabledRequest;
-  static deserializeBinaryFromReader(message: SetSkippingEnabledRequest, reader: jspb.BinaryReader): SetSkippingEnabledRequest;
+  static serializeBinaryToWriter(message: ScheduledRainsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ScheduledRainsResponse;
+  static deserializeBinaryFromReader(message: ScheduledRainsResponse, reader: jspb.BinaryReader): ScheduledRainsResponse;
 }
 
-export namespace SetSkippingEnabledRequest {
+export namespace ScheduledRainsResponse {
   export type AsObject = {
-    enabled: boolean,
+    rainsList: Array<ScheduledRain.AsObject>,
+    offset: number,
+    total: number,
   }
 }
 
-export class SetSkippingEnabledResponse extends jspb.Message {
+export class ScheduleRainRequest extends jspb.Message {
+  getChannelId(): string;
+  setChannelId(value: string): void;
+
+  getAmount(): string;
+  setAmount(value: string): void;
+
+  getAudience(): ScheduledRainAudienceMap[keyof ScheduledRainAudienceMap];
+  setAudience(value: ScheduledRainAudienceMap[keyof ScheduledRainAudienceMap]): void;
+
+  hasChatWindow(): boolean;
+  clearChatWindow(): void;
+  getChatWindow(): google_protobuf_duration_pb.Duration | undefined;
+  setChatWindow(value?: google_protobuf_duration_pb.Duration): void;
+
+  getMediaCount(): number;
+  setMediaCount(value: number): void;
+
+  hasScheduledFor(): boolean;
+  clearScheduledFor(): void;
+  getScheduledFor(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setScheduledFor(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetSkippingEnabledResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: SetSkippingEnabledResponse): SetSkippingEnabledResponse.AsObject;
+  toObject(includeInstance?: boolean): ScheduleRainRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ScheduleRainRequest): ScheduleRainRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetSkippingEnabledResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetSkippingEnabledResponse;
-  static deserializeBinaryFromReader(message: SetSkippingEnabledResponse, reader: jspb.BinaryReader): SetSkippingEnabledResponse;
+  static serializeBinaryToWriter(message: ScheduleRainRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ScheduleRainRequest;
+  static deserializeBinaryFromReader(message: ScheduleRainRequest, reader: jspb.BinaryReader): ScheduleRainRequest;
 }
 
-export namespace SetSkippingEnabledResponse {
+export namespace ScheduleRainRequest {
   export type AsObject = {
+    channelId: string,
+    amount: string,
+    audience: ScheduledRainAudienceMap[keyof ScheduledRainAudienceMap],
+    chatWindow?: google_protobuf_duration_pb.Duration.AsObject,
+    mediaCount: number,
+    scheduledFor?: google_protobuf_timestamp_pb.Timestamp.AsObject,
   }
 }
 
-export class ConnectionsRequest extends jspb.Message {
+export class ScheduleRainResponse extends jspb.Message {
+  hasRain(): boolean;
+  clearRain(): void;
+  getRain(): ScheduledRain | undefined;
+  setRain(value?: ScheduledRain): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ConnectionsRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: ConnectionsRequest): ConnectionsRequest.AsObject;
+  toObject(includeInstance?: boolean): ScheduleRainResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ScheduleRainResponse): ScheduleRainResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ConnectionsRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ConnectionsRequest;
-  static deserializeBinaryFromReader(message: ConnectionsRequest, reader: jspb.BinaryReader): ConnectionsRequest;
+  static serializeBinaryToWriter(message: ScheduleRainResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ScheduleRainResponse;
+  static deserializeBinaryFromReader(message: ScheduleRainResponse, reader: jspb.BinaryReader): ScheduleRainResponse;
 }
 
-export namespace ConnectionsRequest {
+export namespace ScheduleRainResponse {
   export type AsObject = {
+    rain?: ScheduledRain.AsObject,
   }
 }
 
-export class Connection extends jspb.Message {
-  getId(): string;
-  setId(value: string): void;
-
-  getService(): ConnectionServiceMap[keyof ConnectionServiceMap];
-  setService(value: ConnectionServiceMap[keyof ConnectionServiceMap]): void;
-
-  getName(): string;
-  setName(value: string): void;
-
-  hasCreatedAt(): boolean;
-  clearCreatedAt(): void;
-  getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
-  setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+export class CancelScheduledRainRequest extends jspb.Message {
+  getRainId(): string;
+  setRainId(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): Connection.AsObject;
-  static toObject(includeInstance: boolean, msg: Connection): Connection.AsObject;
+  toObject(includeInstance?: boolean): CancelScheduledRainRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CancelScheduledRainRequest): CancelScheduledRainRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: Connection, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): Connection;
-  static deserializeBinaryFromReader(message: Connection, reader: jspb.BinaryReader): Connection;
+  static serializeBinaryToWriter(message: CancelScheduledRainRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CancelScheduledRainRequest;
+  static deserializeBinaryFromReader(message: CancelScheduledRainRequest, reader: jspb.BinaryReader): CancelScheduledRainRequest;
 }
 
-export namespace Connection {
+export namespace CancelScheduledRainRequest {
   export type AsObject = {
-    id: string,
-    service: ConnectionServiceMap[keyof ConnectionServiceMap],
-    name: string,
-    createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    rainId: string,
   }
 }
 
-export class ServiceInfo extends jspb.Message {
-  getService(): ConnectionServiceMap[keyof ConnectionServiceMap];
-  setService(value: ConnectionServiceMap[keyof ConnectionServiceMap]): void;
-
-  hasMaxConnections(): boolean;
-  clearMaxConnections(): void;
-  getMaxConnections(): number;
-  setMaxConnections(value: number): void;
+export class CancelScheduledRainResponse extends jspb.Message {
+  hasRain(): boolean;
+  clearRain(): void;
+  getRain(): ScheduledRain | undefined;
+  setRain(value?: ScheduledRain): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ServiceInfo.AsObject;
-  static toObject(includeInstance: boolean, msg: ServiceInfo): ServiceInfo.AsObject;
+  toObject(includeInstance?: boolean): CancelScheduledRainResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: CancelScheduledRainResponse): CancelScheduledRainResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ServiceInfo, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ServiceInfo;
-  static deserializeBinaryFromReader(message: ServiceInfo, reader: jspb.BinaryReader): ServiceInfo;
+  static serializeBinaryToWriter(message: CancelScheduledRainResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CancelScheduledRainResponse;
+  static deserializeBinaryFromReader(message: CancelScheduledRainResponse, reader: jspb.BinaryReader): CancelScheduledRainResponse;
 }
 
-export namespace ServiceInfo {
+export namespace CancelScheduledRainResponse {
   export type AsObject = {
-    service: ConnectionServiceMap[keyof ConnectionServiceMap],
-    maxConnections: number,
+    rain?: ScheduledRain.AsObject,
   }
 }
 
-export class ConnectionsResponse extends jspb.Message {
-  clearConnectionsList(): void;
-  getConnectionsList(): Array<Connection>;
-  setConnectionsList(value: Array<Connection>): void;
-  addConnections(value?: Connection, index?: number): Connection;
-
-  clearServiceInfosList(): void;
-  getServiceInfosList(): Array<ServiceInfo>;
-  setServiceInfosList(value: Array<ServiceInfo>): void;
-  addServiceInfos(value?: ServiceInfo, index?: number): ServiceInfo;
-
+export class TriggerAnnouncementsNotificationRequest extends jspb.Message {
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ConnectionsResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: ConnectionsResponse): ConnectionsResponse.AsObject;
+  toObject(includeInstance?: boolean): TriggerAnnouncementsNotificationRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: TriggerAnnouncementsNotificationRequest): TriggerAnnouncementsNotificationRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ConnectionsResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ConnectionsResponse;
-  static deserializeBinaryFromReader(message: ConnectionsResponse, reader: jspb.BinaryReader): ConnectionsResponse;
+  static serializeBinaryToWriter(message: TriggerAnnouncementsNotificationRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TriggerAnnouncementsNotificationRequest;
+  static deserializeBinaryFromReader(message: TriggerAnnouncementsNotificationRequest, reader: jspb.BinaryReader): TriggerAnnouncementsNotificationRequest;
 }
 
-export namespace ConnectionsResponse {
+export namespace TriggerAnnouncementsNotificationRequest {
   export type AsObject = {
-    connectionsList: Array<Connection.AsObject>,
-    serviceInfosList: Array<ServiceInfo.AsObject>,
   }
 }
 
-export class CreateConnectionRequest extends jspb.Message {
-  getService(): ConnectionServiceMap[keyof ConnectionServiceMap];
-  setService(value: ConnectionServiceMap[keyof ConnectionServiceMap]): void;
-
+export class TriggerAnnouncementsNotificationResponse extends jspb.Message {
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): CreateConnectionRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: CreateConnectionRequest): CreateConnectionRequest.AsObject;
+  toObject(includeInstance?: boolean): TriggerAnnouncementsNotificationResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: TriggerAnnouncementsNotificationResponse): TriggerAnnouncementsNotificationResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: CreateConnectionRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): CreateConnectionRequest;
-  static deserializeBinaryFromReader(message: CreateConnectionRequest, reader: jspb.BinaryReader): CreateConnectionRequest;
+  static serializeBinaryToWriter(message: TriggerAnnouncementsNotificationResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TriggerAnnouncementsNotificationResponse;
+  static deserializeBinaryFromReader(message: TriggerAnnouncementsNotificationResponse, reader: jspb.BinaryReader): TriggerAnnouncementsNotificationResponse;
 }
 
-export namespace CreateConnectionRequest {
+export namespace TriggerAnnouncementsNotificationResponse {
   export type AsObject = {
-    service: ConnectionServiceMap[keyof ConnectionServiceMap],
   }
 }
 
-export class CreateConnectionResponse extends jspb.Message {
-  getAuthUrl(): string;
-  setAuthUrl(value: string): void;
+export class SpectatorInfoRequest extends jspb.Message {
+  getRewardsAddress(): string;
+  setRewardsAddress(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): CreateConnectionResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: CreateConnectionResponse): CreateConnectionResponse.AsObject;
+  toObject(includeInstance?: boolean): SpectatorInfoRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SpectatorInfoRequest): SpectatorInfoRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: CreateConnectionResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): CreateConnectionResponse;
-  static deserializeBinaryFromReader(message: CreateConnectionResponse, reader: jspb.BinaryReader): CreateConnectionResponse;
+  static serializeBinaryToWriter(message: SpectatorInfoRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SpectatorInfoRequest;
+  static deserializeBinaryFromReader(message: SpectatorInfoRequest, reader: jspb.BinaryReader): SpectatorInfoRequest;
 }
 
-export namespace CreateConnectionResponse {
+export namespace SpectatorInfoRequest {
   export type AsObject = {
-    authUrl: string,
+    rewardsAddress: string,
   }
 }
 
-export class RemoveConnectionRequest extends jspb.Message {
-  getId(): string;
-  setId(value: string): void;
+export class Spectator extends jspb.Message {
+  hasUser(): boolean;
+  clearUser(): void;
+  getUser(): common_pb.User | undefined;
+  setUser(value?: common_pb.User): void;
+
+  getNumConnections(): number;
+  setNumConnections(value: number): void;
+
+  getNumSpectatorsWithSameRemoteAddress(): number;
+  setNumSpectatorsWithSameRemoteAddress(value: number): void;
+
+  hasWatchingSince(): boolean;
+  clearWatchingSince(): void;
+  getWatchingSince(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setWatchingSince(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getRemoteAddressHasGoodReputation(): boolean;
+  setRemoteAddressHasGoodReputation(value: boolean): void;
+
+  getRemoteAddressBannedFromRewards(): boolean;
+  setRemoteAddressBannedFromRewards(value: boolean): void;
+
+  getLegitimate(): boolean;
+  setLegitimate(value: boolean): void;
+
+  hasNotLegitimateSince(): boolean;
+  clearNotLegitimateSince(): void;
+  getNotLegitimateSince(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setNotLegitimateSince(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasStoppedWatchingAt(): boolean;
+  clearStoppedWatchingAt(): void;
+  getStoppedWatchingAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setStoppedWatchingAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasActivityChallenge(): boolean;
+  clearActivityChallenge(): void;
+  getActivityChallenge(): ActivityChallenge | undefined;
+  setActivityChallenge(value?: ActivityChallenge): void;
+
+  getClientIntegrityChecksSkipped(): boolean;
+  setClientIntegrityChecksSkipped(value: boolean): void;
+
+  getIpAddressReputationChecksSkipped(): boolean;
+  setIpAddressReputationChecksSkipped(value: boolean): void;
+
+  getHardChallengeFrequencyReduced(): boolean;
+  setHardChallengeFrequencyReduced(value: boolean): void;
+
+  hasAsNumber(): boolean;
+  clearAsNumber(): void;
+  getAsNumber(): number;
+  setAsNumber(value: number): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): RemoveConnectionRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: RemoveConnectionRequest): RemoveConnectionRequest.AsObject;
+  toObject(includeInstance?: boolean): Spectator.AsObject;
+  static toObject(includeInstance: boolean, msg: Spectator): Spectator.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: RemoveConnectionRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): RemoveConnectionRequest;
-  static deserializeBinaryFromReader(message: RemoveConnectionRequest, reader: jspb.BinaryReader): RemoveConnectionRequest;
+  static serializeBinaryToWriter(message: Spectator, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Spectator;
+  static deserializeBinaryFromReader(message: Spectator, reader: jspb.BinaryReader): Spectator;
 }
 
-export namespace RemoveConnectionRequest {
+export namespace Spectator {
   export type AsObject = {
-    id: string,
+    user?: common_pb.User.AsObject,
+    numConnections: number,
+    numSpectatorsWithSameRemoteAddress: number,
+    watchingSince?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    remoteAddressHasGoodReputation: boolean,
+    remoteAddressBannedFromRewards: boolean,
+    legitimate: boolean,
+    notLegitimateSince?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    stoppedWatchingAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    activityChallenge?: ActivityChallenge.AsObject,
+    clientIntegrityChecksSkipped: boolean,
+    ipAddressReputationChecksSkipped: boolean,
+    hardChallengeFrequencyReduced: boolean,
+    asNumber: number,
   }
 }
 
-export class RemoveConnectionResponse extends jspb.Message {
+export class ResetSpectatorStatusRequest extends jspb.Message {
+  getRewardsAddress(): string;
+  setRewardsAddress(value: string): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): RemoveConnectionResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: RemoveConnectionResponse): RemoveConnectionResponse.AsObject;
+  toObject(includeInstance?: boolean): ResetSpectatorStatusRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ResetSpectatorStatusRequest): ResetSpectatorStatusRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: RemoveConnectionResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): RemoveConnectionResponse;
-  static deserializeBinaryFromReader(message: RemoveConnectionResponse, reader: jspb.BinaryReader): RemoveConnectionResponse;
+  static serializeBinaryToWriter(message: ResetSpectatorStatusRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ResetSpectatorStatusRequest;
+  static deserializeBinaryFromReader(message: ResetSpectatorStatusRequest, reader: jspb.BinaryReader): ResetSpectatorStatusRequest;
 }
 
-export namespace RemoveConnectionResponse {
+export namespace ResetSpectatorStatusRequest {
   export type AsObject = {
+    rewardsAddress: string,
   }
 }
 
-export class SetQueueInsertCursorRequest extends jspb.Message {
-  getId(): string;
-  setId(value: string): void;
-
+export class ResetSpectatorStatusResponse extends jspb.Message {
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetQueueInsertCursorRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: SetQueueInsertCursorRequest): SetQueueInsertCursorRequest.AsObject;
+  toObject(includeInstance?: boolean): ResetSpectatorStatusResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ResetSpectatorStatusResponse): ResetSpectatorStatusResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetQueueInsertCursorRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetQueueInsertCursorRequest;
-  static deserializeBinaryFromReader(message: SetQueueInsertCursorRequest, reader: jspb.BinaryReader): SetQueueInsertCursorRequest;
+  static serializeBinaryToWriter(message: ResetSpectatorStatusResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ResetSpectatorStatusResponse;
+  static deserializeBinaryFromReader(message: ResetSpectatorStatusResponse, reader: jspb.BinaryReader): ResetSpectatorStatusResponse;
 }
 
-export namespace SetQueueInsertCursorRequest {
+export namespace ResetSpectatorStatusResponse {
   export type AsObject = {
-    id: string,
   }
 }
 
-export class SetQueueInsertCursorResponse extends jspb.Message {
+export class MonitorModerationStatusRequest extends jspb.Message {
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetQueueInsertCursorResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: SetQueueInsertCursorResponse): SetQueueInsertCursorResponse.AsObject;
+  toObject(includeInstance?: boolean): MonitorModerationStatusRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: MonitorModerationStatusRequest): MonitorModerationStatusRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetQueueInsertCursorResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetQueueInsertCursorResponse;
-  static deserializeBinaryFromReader(message: SetQueueInsertCursorResponse, reader: jspb.BinaryReader): SetQueueInsertCursorResponse;
+  static serializeBinaryToWriter(message: MonitorModerationStatusRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MonitorModerationStatusRequest;
+  static deserializeBinaryFromReader(message: MonitorModerationStatusRequest, reader: jspb.BinaryReader): MonitorModerationStatusRequest;
 }
 
-export namespace SetQueueInsertCursorResponse {
+export namespace MonitorModerationStatusRequest {
   export type AsObject = {
   }
 }
 
-export class ClearQueueInsertCursorRequest extends jspb.Message {
+export class ModerationStatusOverview extends jspb.Message {
+  getAllowedMediaEnqueuing(): AllowedMediaEnqueuingTypeMap[keyof AllowedMediaEnqueuingTypeMap];
+  setAllowedMediaEnqueuing(value: AllowedMediaEnqueuingTypeMap[keyof AllowedMediaEnqueuingTypeMap]): void;
+
+  getEnqueuingPricesMultiplier(): number;
+  setEnqueuingPricesMultiplier(value: number): void;
+
+  getCrowdfundedSkippingEnabled(): boolean;
+  setCrowdfundedSkippingEnabled(value: boolean): void;
+
+  getCrowdfundedSkippingPricesMultiplier(): number;
+  setCrowdfundedSkippingPricesMultiplier(value: number): void;
+
+  getNewEntriesAlwaysUnskippable(): boolean;
+  setNewEntriesAlwaysUnskippable(value: boolean): void;
+
+  getOwnEntryRemovalEnabled(): boolean;
+  setOwnEntryRemovalEnabled(value: boolean): void;
+
+  getAllSkippingEnabled(): boolean;
+  setAllSkippingEnabled(value: boolean): void;
+
+  hasQueueInsertCursor(): boolean;
+  clearQueueInsertCursor(): void;
+  getQueueInsertCursor(): string;
+  setQueueInsertCursor(value: string): void;
+
+  getMinimumPricesMultiplier(): number;
+  setMinimumPricesMultiplier(value: number): void;
+
+  clearActivelyModeratingList(): void;
+  getActivelyModeratingList(): Array<common_pb.User>;
+  setActivelyModeratingList(value: Array<common_pb.User>): void;
+  addActivelyModerating(value?: common_pb.User, index?: number): common_pb.User;
+
+  getAllowEntryReordering(): boolean;
+  setAllowEntryReordering(value: boolean): void;
+
+  clearVipUsersList(): void;
+  getVipUsersList(): Array<common_pb.User>;
+  setVipUsersList(value: Array<common_pb.User>): void;
+  addVipUsers(value?: common_pb.User, index?: number): common_pb.User;
+
+  getQueueOrderingPolicy(): QueueOrderingPolicyMap[keyof QueueOrderingPolicyMap];
+  setQueueOrderingPolicy(value: QueueOrderingPolicyMap[keyof QueueOrderingPolicyMap]): void;
+
+  getQueueMaxConsecutiveEntries(): number;
+  setQueueMaxConsecutiveEntries(value: number): void;
+
+  getRewardDistributionStrategy(): RewardDistributionStrategyMap[keyof RewardDistributionStrategyMap];
+  setRewardDistributionStrategy(value: RewardDistributionStrategyMap[keyof RewardDistributionStrategyMap]): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ClearQueueInsertCursorRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: ClearQueueInsertCursorRequest): ClearQueueInsertCursorRequest.AsObject;
+  toObject(includeInstance?: boolean): ModerationStatusOverview.AsObject;
+  static toObject(includeInstance: boolean, msg: ModerationStatusOverview): ModerationStatusOverview.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ClearQueueInsertCursorRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ClearQueueInsertCursorRequest;
-  static deserializeBinaryFromReader(message: ClearQueueInsertCursorRequest, reader: jspb.BinaryReader): ClearQueueInsertCursorRequest;
+  static serializeBinaryToWriter(message: ModerationStatusOverview, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ModerationStatusOverview;
+  static deserializeBinaryFromReader(message: ModerationStatusOverview, reader: jspb.BinaryReader): ModerationStatusOverview;
 }
 
-export namespace ClearQueueInsertCursorRequest {
+export namespace ModerationStatusOverview {
   export type AsObject = {
+    allowedMediaEnqueuing: AllowedMediaEnqueuingTypeMap[keyof AllowedMediaEnqueuingTypeMap],
+    enqueuingPricesMultiplier: number,
+    crowdfundedSkippingEnabled: boolean,
+    crowdfundedSkippingPricesMultiplier: number,
+    newEntriesAlwaysUnskippable: boolean,
+    ownEntryRemovalEnabled: boolean,
+    allSkippingEnabled: boolean,
+    queueInsertCursor: string,
+    minimumPricesMultiplier: number,
+    activelyModeratingList: Array<common_pb.User.AsObject>,
+    allowEntryReordering: boolean,
+    vipUsersList: Array<common_pb.User.AsObject>,
+    queueOrderingPolicy: QueueOrderingPolicyMap[keyof QueueOrderingPolicyMap],
+    queueMaxConsecutiveEntries: number,
+    rewardDistributionStrategy: RewardDistributionStrategyMap[keyof RewardDistributionStrategyMap],
   }
 }
 
-export class ClearQueueInsertCursorResponse extends jspb.Message {
+export class SetQueueEntryReorderingAllowedRequest extends jspb.Message {
+  getAllowed(): boolean;
+  setAllowed(value: boolean): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ClearQueueInsertCursorResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: ClearQueueInsertCursorResponse): ClearQueueInsertCursorResponse.AsObject;
+  toObject(includeInstance?: boolean): SetQueueEntryReorderingAllowedRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetQueueEntryReorderingAllowedRequest): SetQueueEntryReorderingAllowedRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ClearQueueInsertCursorResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ClearQueueInsertCursorResponse;
-  static deserializeBinaryFromReader(message: ClearQueueInsertCursorResponse, reader: jspb.BinaryReader): ClearQueueInsertCursorResponse;
+  static serializeBinaryToWriter(message: SetQueueEntryReorderingAllowedRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetQueueEntryReorderingAllowedRequest;
+  static deserializeBinaryFromReader(message: SetQueueEntryReorderingAllowedRequest, reader: jspb.BinaryReader): SetQueueEntryReorderingAllowedRequest;
 }
 
-export namespace ClearQueueInsertCursorResponse {
+export namespace SetQueueEntryReorderingAllowedRequest {
   export type AsObject = {
+    allowed: boolean,
   }
 }
 
-export class UserProfileRequest extends jspb.Message {
-  getAddressOrApplicationId(): string;
-  setAddressOrApplicationId(value: string): void;
-
+export class SetQueueEntryReorderingAllowedResponse extends jspb.Message {
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): UserProfileRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: UserProfileRequest): UserProfileRequest.AsObject;
+  toObject(includeInstance?: boolean): SetQueueEntryReorderingAllowedResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetQueueEntryReorderingAllowedResponse): SetQueueEntryReorderingAllowedResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: UserProfileRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): UserProfileRequest;
-  static deserializeBinaryFromReader(message: UserProfileRequest, reader: jspb.BinaryReader): UserProfileRequest;
+  static serializeBinaryToWriter(message: SetQueueEntryReorderingAllowedResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetQueueEntryReorderingAllowedResponse;
+  static deserializeBinaryFromReader(message: SetQueueEntryReorderingAllowedResponse, reader: jspb.BinaryReader): SetQueueEntryReorderingAllowedResponse;
 }
 
-export namespace UserProfileRequest {
+export namespace SetQueueEntryReorderingAllowedResponse {
   export type AsObject = {
-    addressOrApplicationId: string,
   }
 }
 
-export class UserProfileResponse extends jspb.Message {
-  hasUser(): boolean;
-  clearUser(): void;
-  getUser(): common_pb.User | undefined;
-  setUser(value?: common_pb.User): void;
+export class SetQueueOrderingPolicyRequest extends jspb.Message {
+  getPolicy(): QueueOrderingPolicyMap[keyof QueueOrderingPolicyMap];
+  setPolicy(value: QueueOrderingPolicyMap[keyof QueueOrderingPolicyMap]): void;
 
-  clearRecentlyPlayedRequestsList(): void;
-  getRecentlyPlayedRequestsList(): Array<PlayedMedia>;
-  setRecentlyPlayedRequestsList(value: Array<PlayedMedia>): void;
-  addRecentlyPlayedRequests(value?: PlayedMedia, index?: number): PlayedMedia;
+  getMaxConsecutiveEntries(): number;
+  setMaxConsecutiveEntries(value: number): void;
 
-  getBiography(): string;
-  setBiography(value: string): void;
+  getChannelId(): string;
+  setChannelId(value: string): void;
 
-  hasCurrentSubscription(): boolean;
-  clearCurrentSubscription(): void;
-  getCurrentSubscription(): SubscriptionDetails | undefined;
-  setCurrentSubscription(value?: SubscriptionDetails): void;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetQueueOrderingPolicyRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetQueueOrderingPolicyRequest): SetQueueOrderingPolicyRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetQueueOrderingPolicyRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetQueueOrderingPolicyRequest;
+  static deserializeBinaryFromReader(message: SetQueueOrderingPolicyRequest, reader: jspb.BinaryReader): SetQueueOrderingPolicyRequest;
+}
 
-  hasApplicationId(): boolean;
-  clearApplicationId(): void;
-  getApplicationId(): string;
-  setApplicationId(value: string): void;
+export namespace SetQueueOrderingPolicyRequest {
+  export type AsObject = {
+    policy: QueueOrderingPolicyMap[keyof QueueOrderingPolicyMap],
+    maxConsecutiveEntries: number,
+    channelId: string,
+  }
+}
 
-  clearApplicationTabsList(): void;
-  getApplicationTabsList(): Array<UserProfileApplicationTab>;
-  setApplicationTabsList(value: Array<UserProfileApplicationTab>): void;
-  addApplicationTabs(value?: UserProfileApplicationTab, index?: number): UserProfileApplicationTab;
+export class SetQueueOrderingPolicyResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetQueueOrderingPolicyResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetQueueOrderingPolicyResponse): SetQueueOrderingPolicyResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetQueueOrderingPolicyResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetQueueOrderingPolicyResponse;
+  static deserializeBinaryFromReader(message: SetQueueOrderingPolicyResponse, reader: jspb.BinaryReader): SetQueueOrderingPolicyResponse;
+}
 
-  hasYoutubeVideoData(): boolean;
-  clearYoutubeVideoData(): void;
-  getYoutubeVideoData(): QueueYouTubeVideoData | undefined;
-  setYoutubeVideoData(value?: QueueYouTubeVideoData): void;
+export namespace SetQueueOrderingPolicyResponse {
+  export type AsObject = {
+  }
+}
 
-  hasSoundcloudTrackData(): boolean;
-  clearSoundcloudTrackData(): void;
-  getSoundcloudTrackData(): QueueSoundCloudTrackData | undefined;
-  setSoundcloudTrackData(value?: QueueSoundCloudTrackData): void;
+export class SetRewardDistributionStrategyRequest extends jspb.Message {
+  getStrategy(): RewardDistributionStrategyMap[keyof RewardDistributionStrategyMap];
+  setStrategy(value: RewardDistributionStrategyMap[keyof RewardDistributionStrategyMap]): void;
 
-  hasDocumentData(): boolean;
-  clearDocumentData(): void;
-  getDocumentData(): QueueDocumentData | undefined;
-  setDocumentData(value?: QueueDocumentData): void;
+  getChannelId(): string;
+  setChannelId(value: string): void;
 
-  getFeaturedMediaCase(): UserProfileResponse.FeaturedMediaCase;
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): UserProfileResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: UserProfileResponse): UserProfileResponse.AsObject;
+  toObject(includeInstance?: boolean): SetRewardDistributionStrategyRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetRewardDistributionStrategyRequest): SetRewardDistributionStrategyRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: UserProfileResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): UserProfileResponse;
-  static deserializeBinaryFromReader(message: UserProfileResponse, reader: jspb.BinaryReader): UserProfileResponse;
+  static serializeBinaryToWriter(message: SetRewardDistributionStrategyRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetRewardDistributionStrategyRequest;
+  static deserializeBinaryFromReader(message: SetRewardDistributionStrategyRequest, reader: jspb.BinaryReader): SetRewardDistributionStrategyRequest;
 }
 
-export namespace UserProfileResponse {
+export namespace SetRewardDistributionStrategyRequest {
   export type AsObject = {
-    user?: common_pb.User.AsObject,
-    recentlyPlayedRequestsList: Array<PlayedMedia.AsObject>,
-    biography: string,
-    currentSubscription?: SubscriptionDetails.AsObject,
-    applicationId: string,
-    applicationTabsList: Array<UserProfileApplicationTab.AsObject>,
-    youtubeVideoData?: QueueYouTubeVideoData.AsObject,
-    soundcloudTrackData?: QueueSoundCloudTrackData.AsObject,
-    documentData?: QueueDocumentData.AsObject,
-  }
-
-  export enum FeaturedMediaCase {
-    FEATURED_MEDIA_NOT_SET = 0,
-    YOUTUBE_VIDEO_DATA = 7,
-    SOUNDCLOUD_TRACK_DATA = 8,
-    DOCUMENT_DATA = 9,
+    strategy: RewardDistributionStrategyMap[keyof RewardDistributionStrategyMap],
+    channelId: string,
   }
 }
 
-export class UserProfileApplicationTab extends jspb.Message {
-  getTabId(): string;
-  setTabId(value: string): void;
-
-  getApplicationId(): string;
-  setApplicationId(value: string): void;
+export class SetRewardDistributionStrategyResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetRewardDistributionStrategyResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetRewardDistributionStrategyResponse): SetRewardDistributionStrategyResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetRewardDistributionStrategyResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetRewardDistributionStrategyResponse;
+  static deserializeBinaryFromReader(message: SetRewardDistributionStrategyResponse, reader: jspb.BinaryReader): SetRewardDistributionStrategyResponse;
+}
 
-  getPageId(): string;
-  setPageId(value: string): void;
+export namespace SetRewardDistributionStrategyResponse {
+  export type AsObject = {
+  }
+}
 
-  getTabTitle(): string;
-  setTabTitle(value: string): void;
+export class PauseBroadcastRequest extends jspb.Message {
+  getReason(): string;
+  setReason(value: string): void;
 
-  getBeforeTabId(): string;
-  setBeforeTabId(value: string): void;
+  getChannelId(): string;
+  setChannelId(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): UserProfileApplicationTab.AsObject;
-  static toObject(includeInstance: boolean, msg: UserProfileApplicationTab): UserProfileApplicationTab.AsObject;
+  toObject(includeInstance?: boolean): PauseBroadcastRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: PauseBroadcastRequest): PauseBroadcastRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: UserProfileApplicationTab, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): UserProfileApplicationTab;
-  static deserializeBinaryFromReader(message: UserProfileApplicationTab, reader: jspb.BinaryReader): UserProfileApplicationTab;
+  static serializeBinaryToWriter(message: PauseBroadcastRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PauseBroadcastRequest;
+  static deserializeBinaryFromReader(message: PauseBroadcastRequest, reader: jspb.BinaryReader): PauseBroadcastRequest;
 }
 
-export namespace UserProfileApplicationTab {
+export namespace PauseBroadcastRequest {
   export type AsObject = {
-    tabId: string,
-    applicationId: string,
-    pageId: string,
-    tabTitle: string,
-    beforeTabId: string,
+    reason: string,
+    channelId: string,
   }
 }
 
-export class UserStatsRequest extends jspb.Message {
-  getAddress(): string;
-  setAddress(value: string): void;
-
+export class PauseBroadcastResponse extends jspb.Message {
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): UserStatsRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: UserStatsRequest): UserStatsRequest.AsObject;
+  toObject(includeInstance?: boolean): PauseBroadcastResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: PauseBroadcastResponse): PauseBroadcastResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: UserStatsRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): UserStatsRequest;
-  static deserializeBinaryFromReader(message: UserStatsRequest, reader: jspb.BinaryReader): UserStatsRequest;
+  static serializeBinaryToWriter(message: PauseBroadcastResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PauseBroadcastResponse;
+  static deserializeBinaryFromReader(message: PauseBroadcastResponse, reader: jspb.BinaryReader): PauseBroadcastResponse;
 }
 
-export namespace UserStatsRequest {
+export namespace PauseBroadcastResponse {
   export type AsObject = {
-    address: string,
   }
 }
 
-export class UserStatsForPeriod extends jspb.Message {
-  getTotalSpent(): string;
-  setTotalSpent(value: string): void;
-
-  getTotalWithdrawn(): string;
-  setTotalWithdrawn(value: string): void;
-
-  getRequestedMediaCount(): number;
-  setRequestedMediaCount(value: number): void;
-
-  hasRequestedMediaPlayTime(): boolean;
-  clearRequestedMediaPlayTime(): void;
-  getRequestedMediaPlayTime(): google_protobuf_duration_pb.Duration | undefined;
-  setRequestedMediaPlayTime(value?: google_protobuf_duration_pb.Duration): void;
+export class ResumeBroadcastRequest extends jspb.Message {
+  getChannelId(): string;
+  setChannelId(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): UserStatsForPeriod.AsObject;
-  static toObject(includeInstance: boolean, msg: UserStatsForPeriod): UserStatsForPeriod.AsObject;
+  toObject(includeInstance?: boolean): ResumeBroadcastRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ResumeBroadcastRequest): ResumeBroadcastRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: UserStatsForPeriod, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): UserStatsForPeriod;
-  static deserializeBinaryFromReader(message: UserStatsForPeriod, reader: jspb.BinaryReader): UserStatsForPeriod;
+  static serializeBinaryToWriter(message: ResumeBroadcastRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ResumeBroadcastRequest;
+  static deserializeBinaryFromReader(message: ResumeBroadcastRequest, reader: jspb.BinaryReader): ResumeBroadcastRequest;
 }
 
-export namespace UserStatsForPeriod {
+export namespace ResumeBroadcastRequest {
   export type AsObject = {
-    totalSpent: string,
-    totalWithdrawn: string,
-    requestedMediaCount: number,
-    requestedMediaPlayTime?: google_protobuf_duration_pb.Duration.AsObject,
+    channelId: string,
   }
 }
 
-export class UserStatsResponse extends jspb.Message {
-  hasStatsAllTime(): boolean;
-  clearStatsAllTime(): void;
-  getStatsAllTime(): UserStatsForPeriod | undefined;
-  setStatsAllTime(value?: UserStatsForPeriod): void;
+export class ResumeBroadcastResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ResumeBroadcastResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ResumeBroadcastResponse): ResumeBroadcastResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ResumeBroadcastResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ResumeBroadcastResponse;
+  static deserializeBinaryFromReader(message: ResumeBroadcastResponse, reader: jspb.BinaryReader): ResumeBroadcastResponse;
+}
 
-  hasStats30Days(): boolean;
-  clearStats30Days(): void;
-  getStats30Days(): UserStatsForPeriod | undefined;
-  setStats30Days(value?: UserStatsForPeriod): void;
+export namespace ResumeBroadcastResponse {
+  export type AsObject = {
+  }
+}
 
-  hasStats7Days(): boolean;
-  clearStats7Days(): void;
-  getStats7Days(): UserStatsForPeriod | undefined;
-  setStats7Days(value?: UserStatsForPeriod): void;
+export class SetOwnQueueEntryRemovalAllowedRequest extends jspb.Message {
+  getAllowed(): boolean;
+  setAllowed(value: boolean): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): UserStatsResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: UserStatsResponse): UserStatsResponse.AsObject;
+  toObject(includeInstance?: boolean): SetOwnQueueEntryRemovalAllowedRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetOwnQueueEntryRemovalAllowedRequest): SetOwnQueueEntryRemovalAllowedRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: UserStatsResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): UserStatsResponse;
-  static deserializeBinaryFromReader(message: UserStatsResponse, reader: jspb.BinaryReader): UserStatsResponse;
+  static serializeBinaryToWriter(message: SetOwnQueueEntryRemovalAllowedRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetOwnQueueEntryRemovalAllowedRequest;
+  static deserializeBinaryFromReader(message: SetOwnQueueEntryRemovalAllowedRequest, reader: jspb.BinaryReader): SetOwnQueueEntryRemovalAllowedRequest;
 }
 
-export namespace UserStatsResponse {
+export namespace SetOwnQueueEntryRemovalAllowedRequest {
   export type AsObject = {
-    statsAllTime?: UserStatsForPeriod.AsObject,
-    stats30Days?: UserStatsForPeriod.AsObject,
-    stats7Days?: UserStatsForPeriod.AsObject,
+    allowed: boolean,
   }
 }
 
-export class PlayedMedia extends jspb.Message {
+export class SetOwnQueueEntryRemovalAllowedResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetOwnQueueEntryRemovalAllowedResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetOwnQueueEntryRemovalAllowedResponse): SetOwnQueueEntryRemovalAllowedResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetOwnQueueEntryRemovalAllowedResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetOwnQueueEntryRemovalAllowedResponse;
+  static deserializeBinaryFromReader(message: SetOwnQueueEntryRemovalAllowedResponse, reader: jspb.BinaryReader): SetOwnQueueEntryRemovalAllowedResponse;
+}
+
+export namespace SetOwnQueueEntryRemovalAllowedResponse {
+  export type AsObject = {
+  }
+}
+
+export class SetNewQueueEntriesAlwaysUnskippableRequest extends jspb.Message {
+  getEnabled(): boolean;
+  setEnabled(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetNewQueueEntriesAlwaysUnskippableRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetNewQueueEntriesAlwaysUnskippableRequest): SetNewQueueEntriesAlwaysUnskippableRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetNewQueueEntriesAlwaysUnskippableRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetNewQueueEntriesAlwaysUnskippableRequest;
+  static deserializeBinaryFromReader(message: SetNewQueueEntriesAlwaysUnskippableRequest, reader: jspb.BinaryReader): SetNewQueueEntriesAlwaysUnskippableRequest;
+}
+
+export namespace SetNewQueueEntriesAlwaysUnskippableRequest {
+  export type AsObject = {
+    enabled: boolean,
+  }
+}
+
+export class SetNewQueueEntriesAlwaysUnskippableResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetNewQueueEntriesAlwaysUnskippableResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetNewQueueEntriesAlwaysUnskippableResponse): SetNewQueueEntriesAlwaysUnskippableResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetNewQueueEntriesAlwaysUnskippableResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetNewQueueEntriesAlwaysUnskippableResponse;
+  static deserializeBinaryFromReader(message: SetNewQueueEntriesAlwaysUnskippableResponse, reader: jspb.BinaryReader): SetNewQueueEntriesAlwaysUnskippableResponse;
+}
+
+export namespace SetNewQueueEntriesAlwaysUnskippableResponse {
+  export type AsObject = {
+  }
+}
+
+export class SetSkippingEnabledRequest extends jspb.Message {
+  getEnabled(): boolean;
+  setEnabled(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetSkippingEnabledRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetSkippingEnabledRequest): SetSkippingEnabledRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetSkippingEnabledRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetSkippingEnabledRequest;
+  static deserializeBinaryFromReader(message: SetSkippingEnabledRequest, reader: jspb.BinaryReader): SetSkippingEnabledRequest;
+}
+
+export namespace SetSkippingEnabledRequest {
+  export type AsObject = {
+    enabled: boolean,
+  }
+}
+
+export class SetSkippingEnabledResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetSkippingEnabledResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetSkippingEnabledResponse): SetSkippingEnabledResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetSkippingEnabledResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetSkippingEnabledResponse;
+  static deserializeBinaryFromReader(message: SetSkippingEnabledResponse, reader: jspb.BinaryReader): SetSkippingEnabledResponse;
+}
+
+export namespace SetSkippingEnabledResponse {
+  export type AsObject = {
+  }
+}
+
+export class ConnectionsRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ConnectionsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ConnectionsRequest): ConnectionsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ConnectionsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ConnectionsRequest;
+  static deserializeBinaryFromReader(message: ConnectionsRequest, reader: jspb.BinaryReader): ConnectionsRequest;
+}
+
+export namespace ConnectionsRequest {
+  export type AsObject = {
+  }
+}
+
+export class Connection extends jspb.Message {
   getId(): string;
   setId(value: string): void;
 
-  hasRequestedBy(): boolean;
-  clearRequestedBy(): void;
-  getRequestedBy(): common_pb.User | undefined;
-  setRequestedBy(value?: common_pb.User): void;
+  getService(): ConnectionServiceMap[keyof ConnectionServiceMap];
+  setService(value: ConnectionServiceMap[keyof ConnectionServiceMap]): void;
 
-  getRequestCost(): string;
-  setRequestCost(value: string): void;
+  getName(): string;
+  setName(value: string): void;
 
-  hasEnqueuedAt(): boolean;
-  clearEnqueuedAt(): void;
-  getEnqueuedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
-  setEnqueuedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+  hasCreatedAt(): boolean;
+  clearCreatedAt(): void;
+  getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
 
-  hasStartedAt(): boolean;
-  clearStartedAt(): void;
-  getStartedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
-  setStartedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Connection.AsObject;
+  static toObject(includeInstance: boolean, msg: Connection): Connection.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Connection, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Connection;
+  static deserializeBinaryFromReader(message: Connection, reader: jspb.BinaryReader): Connection;
+}
+
+export namespace Connection {
+  export type AsObject = {
+    id: string,
+    service: ConnectionServiceMap[keyof ConnectionServiceMap],
+    name: string,
+    createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class ServiceInfo extends jspb.Message {
+  getService(): ConnectionServiceMap[keyof ConnectionServiceMap];
+  setService(value: ConnectionServiceMap[keyof ConnectionServiceMap]): void;
+
+  hasMaxConnections(): boolean;
+  clearMaxConnections(): void;
+  getMaxConnections(): number;
+  setMaxConnections(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ServiceInfo.AsObject;
+  static toObject(includeInstance: boolean, msg: ServiceInfo): ServiceInfo.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ServiceInfo, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ServiceInfo;
+  static deserializeBinaryFromReader(message: ServiceInfo, reader: jspb.BinaryReader): ServiceInfo;
+}
+
+export namespace ServiceInfo {
+  export type AsObject = {
+    service: ConnectionServiceMap[keyof ConnectionServiceMap],
+    maxConnections: number,
+  }
+}
+
+export class ConnectionsResponse extends jspb.Message {
+  clearConnectionsList(): void;
+  getConnectionsList(): Array<Connection>;
+  setConnectionsList(value: Array<Connection>): void;
+  addConnections(value?: Connection, index?: number): Connection;
+
+  clearServiceInfosList(): void;
+  getServiceInfosList(): Array<ServiceInfo>;
+  setServiceInfosList(value: Array<ServiceInfo>): void;
+  addServiceInfos(value?: ServiceInfo, index?: number): ServiceInfo;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ConnectionsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ConnectionsResponse): ConnectionsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ConnectionsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ConnectionsResponse;
+  static deserializeBinaryFromReader(message: ConnectionsResponse, reader: jspb.BinaryReader): ConnectionsResponse;
+}
+
+export namespace ConnectionsResponse {
+  export type AsObject = {
+    connectionsList: Array<Connection.AsObject>,
+    serviceInfosList: Array<ServiceInfo.AsObject>,
+  }
+}
+
+export class CreateConnectionRequest extends jspb.Message {
+  getService(): ConnectionServiceMap[keyof ConnectionServiceMap];
+  setService(value: ConnectionServiceMap[keyof ConnectionServiceMap]): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CreateConnectionRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CreateConnectionRequest): CreateConnectionRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CreateConnectionRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CreateConnectionRequest;
+  static deserializeBinaryFromReader(message: CreateConnectionRequest, reader: jspb.BinaryReader): CreateConnectionRequest;
+}
+
+export namespace CreateConnectionRequest {
+  export type AsObject = {
+    service: ConnectionServiceMap[keyof ConnectionServiceMap],
+  }
+}
+
+export class CreateConnectionResponse extends jspb.Message {
+  getAuthUrl(): string;
+  setAuthUrl(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CreateConnectionResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: CreateConnectionResponse): CreateConnectionResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CreateConnectionResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CreateConnectionResponse;
+  static deserializeBinaryFromReader(message: CreateConnectionResponse, reader: jspb.BinaryReader): CreateConnectionResponse;
+}
+
+export namespace CreateConnectionResponse {
+  export type AsObject = {
+    authUrl: string,
+  }
+}
+
+export class RemoveConnectionRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RemoveConnectionRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveConnectionRequest): RemoveConnectionRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: RemoveConnectionRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveConnectionRequest;
+  static deserializeBinaryFromReader(message: RemoveConnectionRequest, reader: jspb.BinaryReader): RemoveConnectionRequest;
+}
+
+export namespace RemoveConnectionRequest {
+  export type AsObject = {
+    id: string,
+  }
+}
+
+export class RemoveConnectionResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RemoveConnectionResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveConnectionResponse): RemoveConnectionResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: RemoveConnectionResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveConnectionResponse;
+  static deserializeBinaryFromReader(message: RemoveConnectionResponse, reader: jspb.BinaryReader): RemoveConnectionResponse;
+}
+
+export namespace RemoveConnectionResponse {
+  export type AsObject = {
+  }
+}
+
+export class SetQueueInsertCursorRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetQueueInsertCursorRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetQueueInsertCursorRequest): SetQueueInsertCursorRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetQueueInsertCursorRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetQueueInsertCursorRequest;
+  static deserializeBinaryFromReader(message: SetQueueInsertCursorRequest, reader: jspb.BinaryReader): SetQueueInsertCursorRequest;
+}
+
+export namespace SetQueueInsertCursorRequest {
+  export type AsObject = {
+    id: string,
+  }
+}
+
+export class SetQueueInsertCursorResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetQueueInsertCursorResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetQueueInsertCursorResponse): SetQueueInsertCursorResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetQueueInsertCursorResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetQueueInsertCursorResponse;
+  static deserializeBinaryFromReader(message: SetQueueInsertCursorResponse, reader: jspb.BinaryReader): SetQueueInsertCursorResponse;
+}
+
+export namespace SetQueueInsertCursorResponse {
+  export type AsObject = {
+  }
+}
+
+export class ClearQueueInsertCursorRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClearQueueInsertCursorRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ClearQueueInsertCursorRequest): ClearQueueInsertCursorRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClearQueueInsertCursorRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClearQueueInsertCursorRequest;
+  static deserializeBinaryFromReader(message: ClearQueueInsertCursorRequest, reader: jspb.BinaryReader): ClearQueueInsertCursorRequest;
+}
+
+export namespace ClearQueueInsertCursorRequest {
+  export type AsObject = {
+  }
+}
+
+export class ClearQueueInsertCursorResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClearQueueInsertCursorResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ClearQueueInsertCursorResponse): ClearQueueInsertCursorResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClearQueueInsertCursorResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClearQueueInsertCursorResponse;
+  static deserializeBinaryFromReader(message: ClearQueueInsertCursorResponse, reader: jspb.BinaryReader): ClearQueueInsertCursorResponse;
+}
+
+export namespace ClearQueueInsertCursorResponse {
+  export type AsObject = {
+  }
+}
+
+export class UserProfileRequest extends jspb.Message {
+  getAddressOrApplicationId(): string;
+  setAddressOrApplicationId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UserProfileRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: UserProfileRequest): UserProfileRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: UserProfileRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UserProfileRequest;
+  static deserializeBinaryFromReader(message: UserProfileRequest, reader: jspb.BinaryReader): UserProfileRequest;
+}
+
+export namespace UserProfileRequest {
+  export type AsObject = {
+    addressOrApplicationId: string,
+  }
+}
+
+export class UserProfileResponse extends jspb.Message {
+  hasUser(): boolean;
+  clearUser(): void;
+  getUser(): common_pb.User | undefined;
+  setUser(value?: common_pb.User): void;
+
+  clearRecentlyPlayedRequestsList(): void;
+  getRecentlyPlayedRequestsList(): Array<PlayedMedia>;
+  setRecentlyPlayedRequestsList(value: Array<PlayedMedia>): void;
+  addRecentlyPlayedRequests(value?: PlayedMedia, index?: number): PlayedMedia;
+
+  getBiography(): string;
+  setBiography(value: string): void;
+
+  hasCurrentSubscription(): boolean;
+  clearCurrentSubscription(): void;
+  getCurrentSubscription(): SubscriptionDetails | undefined;
+  setCurrentSubscription(value?: SubscriptionDetails): void;
+
+  hasApplicationId(): boolean;
+  clearApplicationId(): void;
+  getApplicationId(): string;
+  setApplicationId(value: string): void;
+
+  clearApplicationTabsList(): void;
+  getApplicationTabsList(): Array<UserProfileApplicationTab>;
+  setApplicationTabsList(value: Array<UserProfileApplicationTab>): void;
+  addApplicationTabs(value?: UserProfileApplicationTab, index?: number): UserProfileApplicationTab;
+
+  hasYoutubeVideoData(): boolean;
+  clearYoutubeVideoData(): void;
+  getYoutubeVideoData(): QueueYouTubeVideoData | undefined;
+  setYoutubeVideoData(value?: QueueYouTubeVideoData): void;
+
+  hasSoundcloudTrackData(): boolean;
+  clearSoundcloudTrackData(): void;
+  getSoundcloudTrackData(): QueueSoundCloudTrackData | undefined;
+  setSoundcloudTrackData(value?: QueueSoundCloudTrackData): void;
+
+  hasDocumentData(): boolean;
+  clearDocumentData(): void;
+  getDocumentData(): QueueDocumentData | undefined;
+  setDocumentData(value?: QueueDocumentData): void;
+
+  hasDirectMediaData(): boolean;
+  clearDirectMediaData(): void;
+  getDirectMediaData(): QueueDirectMediaData | undefined;
+  setDirectMediaData(value?: QueueDirectMediaData): void;
+
+  hasLibraryMediaData(): boolean;
+  clearLibraryMediaData(): void;
+  getLibraryMediaData(): QueueLibraryMediaData | undefined;
+  setLibraryMediaData(value?: QueueLibraryMediaData): void;
+
+  clearRecentTipsList(): void;
+  getRecentTipsList(): Array<Tip>;
+  setRecentTipsList(value: Array<Tip>): void;
+  addRecentTips(value?: Tip, index?: number): Tip;
+
+  getFeaturedMediaCase(): UserProfileResponse.FeaturedMediaCase;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UserProfileResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: UserProfileResponse): UserProfileResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: UserProfileResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UserProfileResponse;
+  static deserializeBinaryFromReader(message: UserProfileResponse, reader: jspb.BinaryReader): UserProfileResponse;
+}
+
+export namespace UserProfileResponse {
+  export type AsObject = {
+    user?: common_pb.User.AsObject,
+    recentlyPlayedRequestsList: Array<PlayedMedia.AsObject>,
+    biography: string,
+    currentSubscription?: SubscriptionDetails.AsObject,
+    applicationId: string,
+    applicationTabsList: Array<UserProfileApplicationTab.AsObject>,
+    youtubeVideoData?: QueueYouTubeVideoData.AsObject,
+    soundcloudTrackData?: QueueSoundCloudTrackData.AsObject,
+    documentData?: QueueDocumentData.AsObject,
+    directMediaData?: QueueDirectMediaData.AsObject,
+    libraryMediaData?: QueueLibraryMediaData.AsObject,
+    recentTipsList: Array<Tip.AsObject>,
+  }
+
+  export enum FeaturedMediaCase {
+    FEATURED_MEDIA_NOT_SET = 0,
+    YOUTUBE_VIDEO_DATA = 7,
+    SOUNDCLOUD_TRACK_DATA = 8,
+    DOCUMENT_DATA = 9,
+    DIRECT_MEDIA_DATA = 10,
+    LIBRARY_MEDIA_DATA = 11,
+  }
+}
+
+export class UserProfileApplicationTab extends jspb.Message {
+  getTabId(): string;
+  setTabId(value: string): void;
+
+  getApplicationId(): string;
+  setApplicationId(value: string): void;
+
+  getPageId(): string;
+  setPageId(value: string): void;
+
+  getTabTitle(): string;
+  setTabTitle(value: string): void;
+
+  getBeforeTabId(): string;
+  setBeforeTabId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UserProfileApplicationTab.AsObject;
+  static toObject(includeInstance: boolean, msg: UserProfileApplicationTab): UserProfileApplicationTab.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: UserProfileApplicationTab, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UserProfileApplicationTab;
+  static deserializeBinaryFromReader(message: UserProfileApplicationTab, reader: jspb.BinaryReader): UserProfileApplicationTab;
+}
+
+export namespace UserProfileApplicationTab {
+  export type AsObject = {
+    tabId: string,
+    applicationId: string,
+    pageId: string,
+    tabTitle: string,
+    beforeTabId: string,
+  }
+}
+
+export class UserStatsRequest extends jspb.Message {
+  getAddress(): string;
+  setAddress(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UserStatsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: UserStatsRequest): UserStatsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: UserStatsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UserStatsRequest;
+  static deserializeBinaryFromReader(message: UserStatsRequest, reader: jspb.BinaryReader): UserStatsRequest;
+}
+
+export namespace UserStatsRequest {
+  export type AsObject = {
+    address: string,
+  }
+}
+
+export class UserStatsForPeriod extends jspb.Message {
+  getTotalSpent(): string;
+  setTotalSpent(value: string): void;
+
+  getTotalWithdrawn(): string;
+  setTotalWithdrawn(value: string): void;
+
+  getRequestedMediaCount(): number;
+  setRequestedMediaCount(value: number): void;
+
+  hasRequestedMediaPlayTime(): boolean;
+  clearRequestedMediaPlayTime(): void;
+  getRequestedMediaPlayTime(): google_protobuf_duration_pb.Duration | undefined;
+  setRequestedMediaPlayTime(value?: google_protobuf_duration_pb.Duration): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UserStatsForPeriod.AsObject;
+  static toObject(includeInstance: boolean, msg: UserStatsForPeriod): UserStatsForPeriod.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: UserStatsForPeriod, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UserStatsForPeriod;
+  static deserializeBinaryFromReader(message: UserStatsForPeriod, reader: jspb.BinaryReader): UserStatsForPeriod;
+}
+
+export namespace UserStatsForPeriod {
+  export type AsObject = {
+    totalSpent: string,
+    totalWithdrawn: string,
+    requestedMediaCount: number,
+    requestedMediaPlayTime?: google_protobuf_duration_pb.Duration.AsObject,
+  }
+}
+
+export class UserStatsResponse extends jspb.Message {
+  hasStatsAllTime(): boolean;
+  clearStatsAllTime(): void;
+  getStatsAllTime(): UserStatsForPeriod | undefined;
+  setStatsAllTime(value?: UserStatsForPeriod): void;
+
+  hasStats30Days(): boolean;
+  clearStats30Days(): void;
+  getStats30Days(): UserStatsForPeriod | undefined;
+  setStats30Days(value?: UserStatsForPeriod): void;
+
+  hasStats7Days(): boolean;
+  clearStats7Days(): void;
+  getStats7Days(): UserStatsForPeriod | undefined;
+  setStats7Days(value?: UserStatsForPeriod): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UserStatsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: UserStatsResponse): UserStatsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: UserStatsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UserStatsResponse;
+  static deserializeBinaryFromReader(message: UserStatsResponse, reader: jspb.BinaryReader): UserStatsResponse;
+}
+
+export namespace UserStatsResponse {
+  export type AsObject = {
+    statsAllTime?: UserStatsForPeriod.AsObject,
+    stats30Days?: UserStatsForPeriod.AsObject,
+    stats7Days?: UserStatsForPeriod.AsObject,
+  }
+}
+
+export class PlayedMedia extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  hasRequestedBy(): boolean;
+  clearRequestedBy(): void;
+  getRequestedBy(): common_pb.User | undefined;
+  setRequestedBy(value?: common_pb.User): void;
+
+  getRequestCost(): string;
+  setRequestCost(value: string): void;
+
+  hasEnqueuedAt(): boolean;
+  clearEnqueuedAt(): void;
+  getEnqueuedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setEnqueuedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasStartedAt(): boolean;
+  clearStartedAt(): void;
+  getStartedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setStartedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasEndedAt(): boolean;
+  clearEndedAt(): void;
+  getEndedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setEndedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasLength(): boolean;
+  clearLength(): void;
+  getLength(): google_protobuf_duration_pb.Duration | undefined;
+  setLength(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasOffset(): boolean;
+  clearOffset(): void;
+  getOffset(): google_protobuf_duration_pb.Duration | undefined;
+  setOffset(value?: google_protobuf_duration_pb.Duration): void;
+
+  getUnskippable(): boolean;
+  setUnskippable(value: boolean): void;
+
+  hasYoutubeVideoData(): boolean;
+  clearYoutubeVideoData(): void;
+  getYoutubeVideoData(): QueueYouTubeVideoData | undefined;
+  setYoutubeVideoData(value?: QueueYouTubeVideoData): void;
+
+  hasSoundcloudTrackData(): boolean;
+  clearSoundcloudTrackData(): void;
+  getSoundcloudTrackData(): QueueSoundCloudTrackData | undefined;
+  setSoundcloudTrackData(value?: QueueSoundCloudTrackData): void;
+
+  hasDocumentData(): boolean;
+  clearDocumentData(): void;
+  getDocumentData(): QueueDocumentData | undefined;
+  setDocumentData(value?: QueueDocumentData): void;
+
+  hasApplicationPageData(): boolean;
+  clearApplicationPageData(): void;
+  getApplicationPageData(): QueueApplicationPageData | undefined;
+  setApplicationPageData(value?: QueueApplicationPageData): void;
+
+  hasDirectMediaData(): boolean;
+  clearDirectMediaData(): void;
+  getDirectMediaData(): QueueDirectMediaData | undefined;
+  setDirectMediaData(value?: QueueDirectMediaData): void;
+
+  hasLibraryMediaData(): boolean;
+  clearLibraryMediaData(): void;
+  getLibraryMediaData(): QueueLibraryMediaData | undefined;
+  setLibraryMediaData(value?: QueueLibraryMediaData): void;
+
+  getMediaInfoCase(): PlayedMedia.MediaInfoCase;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PlayedMedia.AsObject;
+  static toObject(includeInstance: boolean, msg: PlayedMedia): PlayedMedia.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PlayedMedia, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PlayedMedia;
+  static deserializeBinaryFromReader(message: PlayedMedia, reader: jspb.BinaryReader): PlayedMedia;
+}
+
+export namespace PlayedMedia {
+  export type AsObject = {
+    id: string,
+    requestedBy?: common_pb.User.AsObject,
+    requestCost: string,
+    enqueuedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    startedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    endedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    length?: google_protobuf_duration_pb.Duration.AsObject,
+    offset?: google_protobuf_duration_pb.Duration.AsObject,
+    unskippable: boolean,
+    youtubeVideoData?: QueueYouTubeVideoData.AsObject,
+    soundcloudTrackData?: QueueSoundCloudTrackData.AsObject,
+    documentData?: QueueDocumentData.AsObject,
+    applicationPageData?: QueueApplicationPageData.AsObject,
+    directMediaData?: QueueDirectMediaData.AsObject,
+    libraryMediaData?: QueueLibraryMediaData.AsObject,
+  }
+
+  export enum MediaInfoCase {
+    MEDIA_INFO_NOT_SET = 0,
+    YOUTUBE_VIDEO_DATA = 10,
+    SOUNDCLOUD_TRACK_DATA = 11,
+    DOCUMENT_DATA = 12,
+    APPLICATION_PAGE_DATA = 13,
+    DIRECT_MEDIA_DATA = 14,
+    LIBRARY_MEDIA_DATA = 15,
+  }
+}
+
+export class SetProfileBiographyRequest extends jspb.Message {
+  getBiography(): string;
+  setBiography(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetProfileBiographyRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetProfileBiographyRequest): SetProfileBiographyRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetProfileBiographyRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetProfileBiographyRequest;
+  static deserializeBinaryFromReader(message: SetProfileBiographyRequest, reader: jspb.BinaryReader): SetProfileBiographyRequest;
+}
+
+export namespace SetProfileBiographyRequest {
+  export type AsObject = {
+    biography: string,
+  }
+}
+
+export class SetProfileBiographyResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetProfileBiographyResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetProfileBiographyResponse): SetProfileBiographyResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetProfileBiographyResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetProfileBiographyResponse;
+  static deserializeBinaryFromReader(message: SetProfileBiographyResponse, reader: jspb.BinaryReader): SetProfileBiographyResponse;
+}
+
+export namespace SetProfileBiographyResponse {
+  export type AsObject = {
+  }
+}
+
+export class SetProfileFeaturedMediaRequest extends jspb.Message {
+  hasMediaId(): boolean;
+  clearMediaId(): void;
+  getMediaId(): string;
+  setMediaId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetProfileFeaturedMediaRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetProfileFeaturedMediaRequest): SetProfileFeaturedMediaRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetProfileFeaturedMediaRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetProfileFeaturedMediaRequest;
+  static deserializeBinaryFromReader(message: SetProfileFeaturedMediaRequest, reader: jspb.BinaryReader): SetProfileFeaturedMediaRequest;
+}
+
+export namespace SetProfileFeaturedMediaRequest {
+  export type AsObject = {
+    mediaId: string,
+  }
+}
+
+export class SetProfileFeaturedMediaResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetProfileFeaturedMediaResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetProfileFeaturedMediaResponse): SetProfileFeaturedMediaResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetProfileFeaturedMediaResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetProfileFeaturedMediaResponse;
+  static deserializeBinaryFromReader(message: SetProfileFeaturedMediaResponse, reader: jspb.BinaryReader): SetProfileFeaturedMediaResponse;
+}
+
+export namespace SetProfileFeaturedMediaResponse {
+  export type AsObject = {
+  }
+}
+
+export class ClearUserProfileRequest extends jspb.Message {
+  getAddress(): string;
+  setAddress(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClearUserProfileRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ClearUserProfileRequest): ClearUserProfileRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClearUserProfileRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClearUserProfileRequest;
+  static deserializeBinaryFromReader(message: ClearUserProfileRequest, reader: jspb.BinaryReader): ClearUserProfileRequest;
+}
+
+export namespace ClearUserProfileRequest {
+  export type AsObject = {
+    address: string,
+  }
+}
+
+export class ClearUserProfileResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClearUserProfileResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ClearUserProfileResponse): ClearUserProfileResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClearUserProfileResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClearUserProfileResponse;
+  static deserializeBinaryFromReader(message: ClearUserProfileResponse, reader: jspb.BinaryReader): ClearUserProfileResponse;
+}
+
+export namespace ClearUserProfileResponse {
+  export type AsObject = {
+  }
+}
+
+export class PlayedMediaHistoryRequest extends jspb.Message {
+  hasPaginationParams(): boolean;
+  clearPaginationParams(): void;
+  getPaginationParams(): common_pb.PaginationParameters | undefined;
+  setPaginationParams(value?: common_pb.PaginationParameters): void;
+
+  getSearchQuery(): string;
+  setSearchQuery(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PlayedMediaHistoryRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: PlayedMediaHistoryRequest): PlayedMediaHistoryRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PlayedMediaHistoryRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PlayedMediaHistoryRequest;
+  static deserializeBinaryFromReader(message: PlayedMediaHistoryRequest, reader: jspb.BinaryReader): PlayedMediaHistoryRequest;
+}
+
+export namespace PlayedMediaHistoryRequest {
+  export type AsObject = {
+    paginationParams?: common_pb.PaginationParameters.AsObject,
+    searchQuery: string,
+  }
+}
+
+export class PlayedMediaHistoryResponse extends jspb.Message {
+  clearPlayedMediaList(): void;
+  getPlayedMediaList(): Array<PlayedMedia>;
+  setPlayedMediaList(value: Array<PlayedMedia>): void;
+  addPlayedMedia(value?: PlayedMedia, index?: number): PlayedMedia;
+
+  getOffset(): number;
+  setOffset(value: number): void;
+
+  getTotal(): number;
+  setTotal(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PlayedMediaHistoryResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: PlayedMediaHistoryResponse): PlayedMediaHistoryResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PlayedMediaHistoryResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PlayedMediaHistoryResponse;
+  static deserializeBinaryFromReader(message: PlayedMediaHistoryResponse, reader: jspb.BinaryReader): PlayedMediaHistoryResponse;
+}
+
+export namespace PlayedMediaHistoryResponse {
+  export type AsObject = {
+    playedMediaList: Array<PlayedMedia.AsObject>,
+    offset: number,
+    total: number,
+  }
+}
+
+export class BlockUserRequest extends jspb.Message {
+  getAddress(): string;
+  setAddress(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BlockUserRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: BlockUserRequest): BlockUserRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: BlockUserRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BlockUserRequest;
+  static deserializeBinaryFromReader(message: BlockUserRequest, reader: jspb.BinaryReader): BlockUserRequest;
+}
+
+export namespace BlockUserRequest {
+  export type AsObject = {
+    address: string,
+  }
+}
+
+export class BlockUserResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BlockUserResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: BlockUserResponse): BlockUserResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: BlockUserResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BlockUserResponse;
+  static deserializeBinaryFromReader(message: BlockUserResponse, reader: jspb.BinaryReader): BlockUserResponse;
+}
+
+export namespace BlockUserResponse {
+  export type AsObject = {
+  }
+}
+
+export class UnblockUserRequest extends jspb.Message {
+  hasBlockId(): boolean;
+  clearBlockId(): void;
+  getBlockId(): string;
+  setBlockId(value: string): void;
+
+  hasAddress(): boolean;
+  clearAddress(): void;
+  getAddress(): string;
+  setAddress(value: string): void;
+
+  getBlockIdentificationCase(): UnblockUserRequest.BlockIdentificationCase;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UnblockUserRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: UnblockUserRequest): UnblockUserRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: UnblockUserRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UnblockUserRequest;
+  static deserializeBinaryFromReader(message: UnblockUserRequest, reader: jspb.BinaryReader): UnblockUserRequest;
+}
+
+export namespace UnblockUserRequest {
+  export type AsObject = {
+    blockId: string,
+    address: string,
+  }
+
+  export enum BlockIdentificationCase {
+    BLOCK_IDENTIFICATION_NOT_SET = 0,
+    BLOCK_ID = 1,
+    ADDRESS = 2,
+  }
+}
+
+export class UnblockUserResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UnblockUserResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: UnblockUserResponse): UnblockUserResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: UnblockUserResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UnblockUserResponse;
+  static deserializeBinaryFromReader(message: UnblockUserResponse, reader: jspb.BinaryReader): UnblockUserResponse;
+}
+
+export namespace UnblockUserResponse {
+  export type AsObject = {
+  }
+}
+
+export class BlockedUsersRequest extends jspb.Message {
+  hasPaginationParams(): boolean;
+  clearPaginationParams(): void;
+  getPaginationParams(): common_pb.PaginationParameters | undefined;
+  setPaginationParams(value?: common_pb.PaginationParameters): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BlockedUsersRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: BlockedUsersRequest): BlockedUsersRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: BlockedUsersRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BlockedUsersRequest;
+  static deserializeBinaryFromReader(message: BlockedUsersRequest, reader: jspb.BinaryReader): BlockedUsersRequest;
+}
+
+export namespace BlockedUsersRequest {
+  export type AsObject = {
+    paginationParams?: common_pb.PaginationParameters.AsObject,
+  }
+}
+
+export class BlockedUser extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  hasBlockedUser(): boolean;
+  clearBlockedUser(): void;
+  getBlockedUser(): common_pb.User | undefined;
+  setBlockedUser(value?: common_pb.User): void;
+
+  hasBlockedBy(): boolean;
+  clearBlockedBy(): void;
+  getBlockedBy(): common_pb.User | undefined;
+  setBlockedBy(value?: common_pb.User): void;
+
+  hasCreatedAt(): boolean;
+  clearCreatedAt(): void;
+  getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BlockedUser.AsObject;
+  static toObject(includeInstance: boolean, msg: BlockedUser): BlockedUser.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: BlockedUser, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BlockedUser;
+  static deserializeBinaryFromReader(message: BlockedUser, reader: jspb.BinaryReader): BlockedUser;
+}
+
+export namespace BlockedUser {
+  export type AsObject = {
+    id: string,
+    blockedUser?: common_pb.User.AsObject,
+    blockedBy?: common_pb.User.AsObject,
+    createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class BlockedUsersResponse extends jspb.Message {
+  clearBlockedUsersList(): void;
+  getBlockedUsersList(): Array<BlockedUser>;
+  setBlockedUsersList(value: Array<BlockedUser>): void;
+  addBlockedUsers(value?: BlockedUser, index?: number): BlockedUser;
+
+  getOffset(): number;
+  setOffset(value: number): void;
+
+  getTotal(): number;
+  setTotal(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BlockedUsersResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: BlockedUsersResponse): BlockedUsersResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: BlockedUsersResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BlockedUsersResponse;
+  static deserializeBinaryFromReader(message: BlockedUsersResponse, reader: jspb.BinaryReader): BlockedUsersResponse;
+}
+
+export namespace BlockedUsersResponse {
+  export type AsObject = {
+    blockedUsersList: Array<BlockedUser.AsObject>,
+    offset: number,
+    total: number,
+  }
+}
+
+export class MarkAsActivelyModeratingRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MarkAsActivelyModeratingRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: MarkAsActivelyModeratingRequest): MarkAsActivelyModeratingRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: MarkAsActivelyModeratingRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MarkAsActivelyModeratingRequest;
+  static deserializeBinaryFromReader(message: MarkAsActivelyModeratingRequest, reader: jspb.BinaryReader): MarkAsActivelyModeratingRequest;
+}
+
+export namespace MarkAsActivelyModeratingRequest {
+  export type AsObject = {
+  }
+}
+
+export class MarkAsActivelyModeratingResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MarkAsActivelyModeratingResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: MarkAsActivelyModeratingResponse): MarkAsActivelyModeratingResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: MarkAsActivelyModeratingResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MarkAsActivelyModeratingResponse;
+  static deserializeBinaryFromReader(message: MarkAsActivelyModeratingResponse, reader: jspb.BinaryReader): MarkAsActivelyModeratingResponse;
+}
+
+export namespace MarkAsActivelyModeratingResponse {
+  export type AsObject = {
+  }
+}
+
+export class StopActivelyModeratingRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StopActivelyModeratingRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: StopActivelyModeratingRequest): StopActivelyModeratingRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: StopActivelyModeratingRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StopActivelyModeratingRequest;
+  static deserializeBinaryFromReader(message: StopActivelyModeratingRequest, reader: jspb.BinaryReader): StopActivelyModeratingRequest;
+}
+
+export namespace StopActivelyModeratingRequest {
+  export type AsObject = {
+  }
+}
+
+export class StopActivelyModeratingResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StopActivelyModeratingResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: StopActivelyModeratingResponse): StopActivelyModeratingResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: StopActivelyModeratingResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StopActivelyModeratingResponse;
+  static deserializeBinaryFromReader(message: StopActivelyModeratingResponse, reader: jspb.BinaryReader): StopActivelyModeratingResponse;
+}
+
+export namespace StopActivelyModeratingResponse {
+  export type AsObject = {
+  }
+}
+
+export class PointsInfoRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PointsInfoRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: PointsInfoRequest): PointsInfoRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PointsInfoRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PointsInfoRequest;
+  static deserializeBinaryFromReader(message: PointsInfoRequest, reader: jspb.BinaryReader): PointsInfoRequest;
+}
+
+export namespace PointsInfoRequest {
+  export type AsObject = {
+  }
+}
+
+export class PointsInfoResponse extends jspb.Message {
+  getBalance(): number;
+  setBalance(value: number): void;
+
+  hasCurrentSubscription(): boolean;
+  clearCurrentSubscription(): void;
+  getCurrentSubscription(): SubscriptionDetails | undefined;
+  setCurrentSubscription(value?: SubscriptionDetails): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PointsInfoResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: PointsInfoResponse): PointsInfoResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PointsInfoResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PointsInfoResponse;
+  static deserializeBinaryFromReader(message: PointsInfoResponse, reader: jspb.BinaryReader): PointsInfoResponse;
+}
+
+export namespace PointsInfoResponse {
+  export type AsObject = {
+    balance: number,
+    currentSubscription?: SubscriptionDetails.AsObject,
+  }
+}
+
+export class SubscriptionDetails extends jspb.Message {
+  hasSubscribedAt(): boolean;
+  clearSubscribedAt(): void;
+  getSubscribedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setSubscribedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasSubscribedUntil(): boolean;
+  clearSubscribedUntil(): void;
+  getSubscribedUntil(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setSubscribedUntil(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SubscriptionDetails.AsObject;
+  static toObject(includeInstance: boolean, msg: SubscriptionDetails): SubscriptionDetails.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SubscriptionDetails, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubscriptionDetails;
+  static deserializeBinaryFromReader(message: SubscriptionDetails, reader: jspb.BinaryReader): SubscriptionDetails;
+}
+
+export namespace SubscriptionDetails {
+  export type AsObject = {
+    subscribedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    subscribedUntil?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class PointsTransactionsRequest extends jspb.Message {
+  hasPaginationParams(): boolean;
+  clearPaginationParams(): void;
+  getPaginationParams(): common_pb.PaginationParameters | undefined;
+  setPaginationParams(value?: common_pb.PaginationParameters): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PointsTransactionsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: PointsTransactionsRequest): PointsTransactionsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PointsTransactionsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PointsTransactionsRequest;
+  static deserializeBinaryFromReader(message: PointsTransactionsRequest, reader: jspb.BinaryReader): PointsTransactionsRequest;
+}
+
+export namespace PointsTransactionsRequest {
+  export type AsObject = {
+    paginationParams?: common_pb.PaginationParameters.AsObject,
+  }
+}
+
+export class PointsTransactionsResponse extends jspb.Message {
+  clearTransactionsList(): void;
+  getTransactionsList(): Array<PointsTransaction>;
+  setTransactionsList(value: Array<PointsTransaction>): void;
+  addTransactions(value?: PointsTransaction, index?: number): PointsTransaction;
+
+  getOffset(): number;
+  setOffset(value: number): void;
+
+  getTotal(): number;
+  setTotal(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PointsTransactionsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: PointsTransactionsResponse): PointsTransactionsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PointsTransactionsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PointsTransactionsResponse;
+  static deserializeBinaryFromReader(message: PointsTransactionsResponse, reader: jspb.BinaryReader): PointsTransactionsResponse;
+}
+
+export namespace PointsTransactionsResponse {
+  export type AsObject = {
+    transactionsList: Array<PointsTransaction.AsObject>,
+    offset: number,
+    total: number,
+  }
+}
+
+export class PointsTransaction extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getRewardsAddress(): string;
+  setRewardsAddress(value: string): void;
+
+  hasCreatedAt(): boolean;
+  clearCreatedAt(): void;
+  getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasUpdatedAt(): boolean;
+  clearUpdatedAt(): void;
+  getUpdatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setUpdatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getValue(): number;
+  setValue(value: number): void;
+
+  getType(): PointsTransactionTypeMap[keyof PointsTransactionTypeMap];
+  setType(value: PointsTransactionTypeMap[keyof PointsTransactionTypeMap]): void;
+
+  getExtraMap(): jspb.Map<string, string>;
+  clearExtraMap(): void;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PointsTransaction.AsObject;
+  static toObject(includeInstance: boolean, msg: PointsTransaction): PointsTransaction.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PointsTransaction, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PointsTransaction;
+  static deserializeBinaryFromReader(message: PointsTransaction, reader: jspb.BinaryReader): PointsTransaction;
+}
+
+export namespace PointsTransaction {
+  export type AsObject = {
+    id: string,
+    rewardsAddress: string,
+    createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    updatedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    value: number,
+    type: PointsTransactionTypeMap[keyof PointsTransactionTypeMap],
+    extraMap: Array<[string, string]>,
+  }
+}
+
+export class ChatGifSearchRequest extends jspb.Message {
+  getQuery(): string;
+  setQuery(value: string): void;
+
+  getCursor(): string;
+  setCursor(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ChatGifSearchRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ChatGifSearchRequest): ChatGifSearchRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ChatGifSearchRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ChatGifSearchRequest;
+  static deserializeBinaryFromReader(message: ChatGifSearchRequest, reader: jspb.BinaryReader): ChatGifSearchRequest;
+}
+
+export namespace ChatGifSearchRequest {
+  export type AsObject = {
+    query: string,
+    cursor: string,
+  }
+}
+
+export class ChatGifSearchResponse extends jspb.Message {
+  clearResultsList(): void;
+  getResultsList(): Array<ChatGifSearchResult>;
+  setResultsList(value: Array<ChatGifSearchResult>): void;
+  addResults(value?: ChatGifSearchResult, index?: number): ChatGifSearchResult;
+
+  getNextCursor(): string;
+  setNextCursor(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ChatGifSearchResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ChatGifSearchResponse): ChatGifSearchResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ChatGifSearchResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ChatGifSearchResponse;
+  static deserializeBinaryFromReader(message: ChatGifSearchResponse, reader: jspb.BinaryReader): ChatGifSearchResponse;
+}
+
+export namespace ChatGifSearchResponse {
+  export type AsObject = {
+    resultsList: Array<ChatGifSearchResult.AsObject>,
+    nextCursor: string,
+  }
+}
+
+export class ChatGifSearchResult extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getTitle(): string;
+  setTitle(value: string): void;
+
+  getPreviewUrl(): string;
+  setPreviewUrl(value: string): void;
+
+  getPreviewFallbackUrl(): string;
+  setPreviewFallbackUrl(value: string): void;
+
+  getWidth(): number;
+  setWidth(value: number): void;
+
+  getHeight(): number;
+  setHeight(value: number): void;
+
+  getPointsCost(): number;
+  setPointsCost(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ChatGifSearchResult.AsObject;
+  static toObject(includeInstance: boolean, msg: ChatGifSearchResult): ChatGifSearchResult.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ChatGifSearchResult, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ChatGifSearchResult;
+  static deserializeBinaryFromReader(message: ChatGifSearchResult, reader: jspb.BinaryReader): ChatGifSearchResult;
+}
+
+export namespace ChatGifSearchResult {
+  export type AsObject = {
+    id: string,
+    title: string,
+    previewUrl: string,
+    previewFallbackUrl: string,
+    width: number,
+    height: number,
+    pointsCost: number,
+  }
+}
+
+export class AdjustPointsBalanceRequest extends jspb.Message {
+  getRewardsAddress(): string;
+  setRewardsAddress(value: string): void;
+
+  getValue(): number;
+  setValue(value: number): void;
+
+  getReason(): string;
+  setReason(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AdjustPointsBalanceRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AdjustPointsBalanceRequest): AdjustPointsBalanceRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: AdjustPointsBalanceRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AdjustPointsBalanceRequest;
+  static deserializeBinaryFromReader(message: AdjustPointsBalanceRequest, reader: jspb.BinaryReader): AdjustPointsBalanceRequest;
+}
+
+export namespace AdjustPointsBalanceRequest {
+  export type AsObject = {
+    rewardsAddress: string,
+    value: number,
+    reason: string,
+  }
+}
+
+export class AdjustPointsBalanceResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AdjustPointsBalanceResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: AdjustPointsBalanceResponse): AdjustPointsBalanceResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: AdjustPointsBalanceResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AdjustPointsBalanceResponse;
+  static deserializeBinaryFromReader(message: AdjustPointsBalanceResponse, reader: jspb.BinaryReader): AdjustPointsBalanceResponse;
+}
+
+export namespace AdjustPointsBalanceResponse {
+  export type AsObject = {
+  }
+}
+
+export class ConvertBananoToPointsRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ConvertBananoToPointsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ConvertBananoToPointsRequest): ConvertBananoToPointsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ConvertBananoToPointsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ConvertBananoToPointsRequest;
+  static deserializeBinaryFromReader(message: ConvertBananoToPointsRequest, reader: jspb.BinaryReader): ConvertBananoToPointsRequest;
+}
+
+export namespace ConvertBananoToPointsRequest {
+  export type AsObject = {
+  }
+}
+
+export class ConvertBananoToPointsStatus extends jspb.Message {
+  getPaymentAddress(): string;
+  setPaymentAddress(value: string): void;
+
+  getBananoConverted(): string;
+  setBananoConverted(value: string): void;
+
+  getPointsConverted(): number;
+  setPointsConverted(value: number): void;
+
+  hasExpiration(): boolean;
+  clearExpiration(): void;
+  getExpiration(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setExpiration(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getExpired(): boolean;
+  setExpired(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ConvertBananoToPointsStatus.AsObject;
+  static toObject(includeInstance: boolean, msg: ConvertBananoToPointsStatus): ConvertBananoToPointsStatus.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ConvertBananoToPointsStatus, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ConvertBananoToPointsStatus;
+  static deserializeBinaryFromReader(message: ConvertBananoToPointsStatus, reader: jspb.BinaryReader): ConvertBananoToPointsStatus;
+}
+
+export namespace ConvertBananoToPointsStatus {
+  export type AsObject = {
+    paymentAddress: string,
+    bananoConverted: string,
+    pointsConverted: number,
+    expiration?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    expired: boolean,
+  }
+}
+
+export class Tip extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  hasSender(): boolean;
+  clearSender(): void;
+  getSender(): common_pb.User | undefined;
+  setSender(value?: common_pb.User): void;
+
+  hasRecipient(): boolean;
+  clearRecipient(): void;
+  getRecipient(): common_pb.User | undefined;
+  setRecipient(value?: common_pb.User): void;
+
+  getCurrency(): TipCurrencyMap[keyof TipCurrencyMap];
+  setCurrency(value: TipCurrencyMap[keyof TipCurrencyMap]): void;
+
+  getAmount(): string;
+  setAmount(value: string): void;
+
+  hasQueueEntryId(): boolean;
+  clearQueueEntryId(): void;
+  getQueueEntryId(): string;
+  setQueueEntryId(value: string): void;
+
+  hasCreatedAt(): boolean;
+  clearCreatedAt(): void;
+  getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Tip.AsObject;
+  static toObject(includeInstance: boolean, msg: Tip): Tip.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Tip, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Tip;
+  static deserializeBinaryFromReader(message: Tip, reader: jspb.BinaryReader): Tip;
+}
+
+export namespace Tip {
+  export type AsObject = {
+    id: string,
+    sender?: common_pb.User.AsObject,
+    recipient?: common_pb.User.AsObject,
+    currency: TipCurrencyMap[keyof TipCurrencyMap],
+    amount: string,
+    queueEntryId: string,
+    createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class TipWithPointsRequest extends jspb.Message {
+  hasAddress(): boolean;
+  clearAddress(): void;
+  getAddress(): string;
+  setAddress(value: string): void;
+
+  hasCurrentEntryRequesterOfChannelId(): boolean;
+  clearCurrentEntryRequesterOfChannelId(): void;
+  getCurrentEntryRequesterOfChannelId(): string;
+  setCurrentEntryRequesterOfChannelId(value: string): void;
+
+  getPoints(): number;
+  setPoints(value: number): void;
+
+  getAnnounce(): boolean;
+  setAnnounce(value: boolean): void;
+
+  getRecipientCase(): TipWithPointsRequest.RecipientCase;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TipWithPointsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: TipWithPointsRequest): TipWithPointsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TipWithPointsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TipWithPointsRequest;
+  static deserializeBinaryFromReader(message: TipWithPointsRequest, reader: jspb.BinaryReader): TipWithPointsRequest;
+}
+
+export namespace TipWithPointsRequest {
+  export type AsObject = {
+    address: string,
+    currentEntryRequesterOfChannelId: string,
+    points: number,
+    announce: boolean,
+  }
+
+  export enum RecipientCase {
+    RECIPIENT_NOT_SET = 0,
+    ADDRESS = 1,
+    CURRENT_ENTRY_REQUESTER_OF_CHANNEL_ID = 2,
+  }
+}
+
+export class TipWithPointsResponse extends jspb.Message {
+  hasTip(): boolean;
+  clearTip(): void;
+  getTip(): Tip | undefined;
+  setTip(value?: Tip): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TipWithPointsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: TipWithPointsResponse): TipWithPointsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TipWithPointsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TipWithPointsResponse;
+  static deserializeBinaryFromReader(message: TipWithPointsResponse, reader: jspb.BinaryReader): TipWithPointsResponse;
+}
+
+export namespace TipWithPointsResponse {
+  export type AsObject = {
+    tip?: Tip.AsObject,
+  }
+}
+
+export class TipWithBananoRequest extends jspb.Message {
+  hasAddress(): boolean;
+  clearAddress(): void;
+  getAddress(): string;
+  setAddress(value: string): void;
+
+  hasCurrentEntryRequesterOfChannelId(): boolean;
+  clearCurrentEntryRequesterOfChannelId(): void;
+  getCurrentEntryRequesterOfChannelId(): string;
+  setCurrentEntryRequesterOfChannelId(value: string): void;
+
+  getAnnounce(): boolean;
+  setAnnounce(value: boolean): void;
+
+  getRecipientCase(): TipWithBananoRequest.RecipientCase;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TipWithBananoRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: TipWithBananoRequest): TipWithBananoRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TipWithBananoRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TipWithBananoRequest;
+  static deserializeBinaryFromReader(message: TipWithBananoRequest, reader: jspb.BinaryReader): TipWithBananoRequest;
+}
+
+export namespace TipWithBananoRequest {
+  export type AsObject = {
+    address: string,
+    currentEntryRequesterOfChannelId: string,
+    announce: boolean,
+  }
+
+  export enum RecipientCase {
+    RECIPIENT_NOT_SET = 0,
+    ADDRESS = 1,
+    CURRENT_ENTRY_REQUESTER_OF_CHANNEL_ID = 2,
+  }
+}
+
+export class TipWithBananoStatus extends jspb.Message {
+  getPaymentAddress(): string;
+  setPaymentAddress(value: string): void;
+
+  hasRecipient(): boolean;
+  clearRecipient(): void;
+  getRecipient(): common_pb.User | undefined;
+  setRecipient(value?: common_pb.User): void;
+
+  getBananoTipped(): string;
+  setBananoTipped(value: string): void;
+
+  hasExpiration(): boolean;
+  clearExpiration(): void;
+  getExpiration(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setExpiration(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getExpired(): boolean;
+  setExpired(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TipWithBananoStatus.AsObject;
+  static toObject(includeInstance: boolean, msg: TipWithBananoStatus): TipWithBananoStatus.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TipWithBananoStatus, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TipWithBananoStatus;
+  static deserializeBinaryFromReader(message: TipWithBananoStatus, reader: jspb.BinaryReader): TipWithBananoStatus;
+}
+
+export namespace TipWithBananoStatus {
+  export type AsObject = {
+    paymentAddress: string,
+    recipient?: common_pb.User.AsObject,
+    bananoTipped: string,
+    expiration?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    expired: boolean,
+  }
+}
+
+export class StartOrExtendSubscriptionRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StartOrExtendSubscriptionRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: StartOrExtendSubscriptionRequest): StartOrExtendSubscriptionRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: StartOrExtendSubscriptionRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StartOrExtendSubscriptionRequest;
+  static deserializeBinaryFromReader(message: StartOrExtendSubscriptionRequest, reader: jspb.BinaryReader): StartOrExtendSubscriptionRequest;
+}
+
+export namespace StartOrExtendSubscriptionRequest {
+  export type AsObject = {
+  }
+}
+
+export class StartOrExtendSubscriptionResponse extends jspb.Message {
+  hasSubscription(): boolean;
+  clearSubscription(): void;
+  getSubscription(): SubscriptionDetails | undefined;
+  setSubscription(value?: SubscriptionDetails): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StartOrExtendSubscriptionResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: StartOrExtendSubscriptionResponse): StartOrExtendSubscriptionResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: StartOrExtendSubscriptionResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StartOrExtendSubscriptionResponse;
+  static deserializeBinaryFromReader(message: StartOrExtendSubscriptionResponse, reader: jspb.BinaryReader): StartOrExtendSubscriptionResponse;
+}
+
+export namespace StartOrExtendSubscriptionResponse {
+  export type AsObject = {
+    subscription?: SubscriptionDetails.AsObject,
+  }
+}
+
+export class SoundCloudTrackDetailsRequest extends jspb.Message {
+  getTrackUrl(): string;
+  setTrackUrl(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SoundCloudTrackDetailsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SoundCloudTrackDetailsRequest): SoundCloudTrackDetailsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SoundCloudTrackDetailsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SoundCloudTrackDetailsRequest;
+  static deserializeBinaryFromReader(message: SoundCloudTrackDetailsRequest, reader: jspb.BinaryReader): SoundCloudTrackDetailsRequest;
+}
+
+export namespace SoundCloudTrackDetailsRequest {
+  export type AsObject = {
+    trackUrl: string,
+  }
+}
+
+export class SoundCloudTrackDetailsResponse extends jspb.Message {
+  hasLength(): boolean;
+  clearLength(): void;
+  getLength(): google_protobuf_duration_pb.Duration | undefined;
+  setLength(value?: google_protobuf_duration_pb.Duration): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SoundCloudTrackDetailsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SoundCloudTrackDetailsResponse): SoundCloudTrackDetailsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SoundCloudTrackDetailsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SoundCloudTrackDetailsResponse;
+  static deserializeBinaryFromReader(message: SoundCloudTrackDetailsResponse, reader: jspb.BinaryReader): SoundCloudTrackDetailsResponse;
+}
+
+export namespace SoundCloudTrackDetailsResponse {
+  export type AsObject = {
+    length?: google_protobuf_duration_pb.Duration.AsObject,
+  }
+}
+
+export class AddVipUserRequest extends jspb.Message {
+  getRewardsAddress(): string;
+  setRewardsAddress(value: string): void;
+
+  getAppearance(): VipUserAppearanceMap[keyof VipUserAppearanceMap];
+  setAppearance(value: VipUserAppearanceMap[keyof VipUserAppearanceMap]): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AddVipUserRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AddVipUserRequest): AddVipUserRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: AddVipUserRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AddVipUserRequest;
+  static deserializeBinaryFromReader(message: AddVipUserRequest, reader: jspb.BinaryReader): AddVipUserRequest;
+}
+
+export namespace AddVipUserRequest {
+  export type AsObject = {
+    rewardsAddress: string,
+    appearance: VipUserAppearanceMap[keyof VipUserAppearanceMap],
+  }
+}
+
+export class AddVipUserResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AddVipUserResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: AddVipUserResponse): AddVipUserResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: AddVipUserResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AddVipUserResponse;
+  static deserializeBinaryFromReader(message: AddVipUserResponse, reader: jspb.BinaryReader): AddVipUserResponse;
+}
+
+export namespace AddVipUserResponse {
+  export type AsObject = {
+  }
+}
+
+export class RemoveVipUserRequest extends jspb.Message {
+  getRewardsAddress(): string;
+  setRewardsAddress(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RemoveVipUserRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveVipUserRequest): RemoveVipUserRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: RemoveVipUserRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveVipUserRequest;
+  static deserializeBinaryFromReader(message: RemoveVipUserRequest, reader: jspb.BinaryReader): RemoveVipUserRequest;
+}
+
+export namespace RemoveVipUserRequest {
+  export type AsObject = {
+    rewardsAddress: string,
+  }
+}
+
+export class RemoveVipUserResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RemoveVipUserResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveVipUserResponse): RemoveVipUserResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: RemoveVipUserResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveVipUserResponse;
+  static deserializeBinaryFromReader(message: RemoveVipUserResponse, reader: jspb.BinaryReader): RemoveVipUserResponse;
+}
+
+export namespace RemoveVipUserResponse {
+  export type AsObject = {
+  }
+}
+
+export class TriggerClientReloadRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TriggerClientReloadRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: TriggerClientReloadRequest): TriggerClientReloadRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TriggerClientReloadRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TriggerClientReloadRequest;
+  static deserializeBinaryFromReader(message: TriggerClientReloadRequest, reader: jspb.BinaryReader): TriggerClientReloadRequest;
+}
+
+export namespace TriggerClientReloadRequest {
+  export type AsObject = {
+  }
+}
+
+export class TriggerClientReloadResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TriggerClientReloadResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: TriggerClientReloadResponse): TriggerClientReloadResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TriggerClientReloadResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TriggerClientReloadResponse;
+  static deserializeBinaryFromReader(message: TriggerClientReloadResponse, reader: jspb.BinaryReader): TriggerClientReloadResponse;
+}
+
+export namespace TriggerClientReloadResponse {
+  export type AsObject = {
+  }
+}
+
+export class IncreaseOrReduceSkipThresholdRequest extends jspb.Message {
+  getIncrease(): boolean;
+  setIncrease(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): IncreaseOrReduceSkipThresholdRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: IncreaseOrReduceSkipThresholdRequest): IncreaseOrReduceSkipThresholdRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: IncreaseOrReduceSkipThresholdRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): IncreaseOrReduceSkipThresholdRequest;
+  static deserializeBinaryFromReader(message: IncreaseOrReduceSkipThresholdRequest, reader: jspb.BinaryReader): IncreaseOrReduceSkipThresholdRequest;
+}
+
+export namespace IncreaseOrReduceSkipThresholdRequest {
+  export type AsObject = {
+    increase: boolean,
+  }
+}
+
+export class IncreaseOrReduceSkipThresholdResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): IncreaseOrReduceSkipThresholdResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: IncreaseOrReduceSkipThresholdResponse): IncreaseOrReduceSkipThresholdResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: IncreaseOrReduceSkipThresholdResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): IncreaseOrReduceSkipThresholdResponse;
+  static deserializeBinaryFromReader(message: IncreaseOrReduceSkipThresholdResponse, reader: jspb.BinaryReader): IncreaseOrReduceSkipThresholdResponse;
+}
+
+export namespace IncreaseOrReduceSkipThresholdResponse {
+  export type AsObject = {
+  }
+}
+
+export class VoteToSkipRequest extends jspb.Message {
+  getChannelId(): string;
+  setChannelId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): VoteToSkipRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: VoteToSkipRequest): VoteToSkipRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: VoteToSkipRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): VoteToSkipRequest;
+  static deserializeBinaryFromReader(message: VoteToSkipRequest, reader: jspb.BinaryReader): VoteToSkipRequest;
+}
+
+export namespace VoteToSkipRequest {
+  export type AsObject = {
+    channelId: string,
+  }
+}
+
+export class VoteToSkipResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): VoteToSkipResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: VoteToSkipResponse): VoteToSkipResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: VoteToSkipResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): VoteToSkipResponse;
+  static deserializeBinaryFromReader(message: VoteToSkipResponse, reader: jspb.BinaryReader): VoteToSkipResponse;
+}
+
+export namespace VoteToSkipResponse {
+  export type AsObject = {
+  }
+}
+
+export class SetMulticurrencyPaymentsEnabledRequest extends jspb.Message {
+  getEnabled(): boolean;
+  setEnabled(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetMulticurrencyPaymentsEnabledRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetMulticurrencyPaymentsEnabledRequest): SetMulticurrencyPaymentsEnabledRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetMulticurrencyPaymentsEnabledRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetMulticurrencyPaymentsEnabledRequest;
+  static deserializeBinaryFromReader(message: SetMulticurrencyPaymentsEnabledRequest, reader: jspb.BinaryReader): SetMulticurrencyPaymentsEnabledRequest;
+}
+
+export namespace SetMulticurrencyPaymentsEnabledRequest {
+  export type AsObject = {
+    enabled: boolean,
+  }
+}
+
+export class SetMulticurrencyPaymentsEnabledResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetMulticurrencyPaymentsEnabledResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetMulticurrencyPaymentsEnabledResponse): SetMulticurrencyPaymentsEnabledResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetMulticurrencyPaymentsEnabledResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetMulticurrencyPaymentsEnabledResponse;
+  static deserializeBinaryFromReader(message: SetMulticurrencyPaymentsEnabledResponse, reader: jspb.BinaryReader): SetMulticurrencyPaymentsEnabledResponse;
+}
+
+export namespace SetMulticurrencyPaymentsEnabledResponse {
+  export type AsObject = {
+  }
+}
+
+export class CheckMediaEnqueuingPasswordRequest extends jspb.Message {
+  getPassword(): string;
+  setPassword(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CheckMediaEnqueuingPasswordRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CheckMediaEnqueuingPasswordRequest): CheckMediaEnqueuingPasswordRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CheckMediaEnqueuingPasswordRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CheckMediaEnqueuingPasswordRequest;
+  static deserializeBinaryFromReader(message: CheckMediaEnqueuingPasswordRequest, reader: jspb.BinaryReader): CheckMediaEnqueuingPasswordRequest;
+}
+
+export namespace CheckMediaEnqueuingPasswordRequest {
+  export type AsObject = {
+    password: string,
+  }
+}
+
+export class CheckMediaEnqueuingPasswordResponse extends jspb.Message {
+  getPasswordEdition(): string;
+  setPasswordEdition(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CheckMediaEnqueuingPasswordResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: CheckMediaEnqueuingPasswordResponse): CheckMediaEnqueuingPasswordResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CheckMediaEnqueuingPasswordResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CheckMediaEnqueuingPasswordResponse;
+  static deserializeBinaryFromReader(message: CheckMediaEnqueuingPasswordResponse, reader: jspb.BinaryReader): CheckMediaEnqueuingPasswordResponse;
+}
+
+export namespace CheckMediaEnqueuingPasswordResponse {
+  export type AsObject = {
+    passwordEdition: string,
+  }
+}
+
+export class MonitorMediaEnqueuingPermissionRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MonitorMediaEnqueuingPermissionRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: MonitorMediaEnqueuingPermissionRequest): MonitorMediaEnqueuingPermissionRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: MonitorMediaEnqueuingPermissionRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MonitorMediaEnqueuingPermissionRequest;
+  static deserializeBinaryFromReader(message: MonitorMediaEnqueuingPermissionRequest, reader: jspb.BinaryReader): MonitorMediaEnqueuingPermissionRequest;
+}
+
+export namespace MonitorMediaEnqueuingPermissionRequest {
+  export type AsObject = {
+  }
+}
+
+export class MediaEnqueuingPermissionStatus extends jspb.Message {
+  getAllowedMediaEnqueuing(): AllowedMediaEnqueuingTypeMap[keyof AllowedMediaEnqueuingTypeMap];
+  setAllowedMediaEnqueuing(value: AllowedMediaEnqueuingTypeMap[keyof AllowedMediaEnqueuingTypeMap]): void;
+
+  getPasswordEdition(): string;
+  setPasswordEdition(value: string): void;
+
+  getPasswordIsNumeric(): boolean;
+  setPasswordIsNumeric(value: boolean): void;
+
+  getHasElevatedPrivileges(): boolean;
+  setHasElevatedPrivileges(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MediaEnqueuingPermissionStatus.AsObject;
+  static toObject(includeInstance: boolean, msg: MediaEnqueuingPermissionStatus): MediaEnqueuingPermissionStatus.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: MediaEnqueuingPermissionStatus, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MediaEnqueuingPermissionStatus;
+  static deserializeBinaryFromReader(message: MediaEnqueuingPermissionStatus, reader: jspb.BinaryReader): MediaEnqueuingPermissionStatus;
+}
+
+export namespace MediaEnqueuingPermissionStatus {
+  export type AsObject = {
+    allowedMediaEnqueuing: AllowedMediaEnqueuingTypeMap[keyof AllowedMediaEnqueuingTypeMap],
+    passwordEdition: string,
+    passwordIsNumeric: boolean,
+    hasElevatedPrivileges: boolean,
+  }
+}
+
+export class InvalidateAuthTokensRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): InvalidateAuthTokensRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: InvalidateAuthTokensRequest): InvalidateAuthTokensRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: InvalidateAuthTokensRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): InvalidateAuthTokensRequest;
+  static deserializeBinaryFromReader(message: InvalidateAuthTokensRequest, reader: jspb.BinaryReader): InvalidateAuthTokensRequest;
+}
+
+export namespace InvalidateAuthTokensRequest {
+  export type AsObject = {
+  }
+}
+
+export class InvalidateAuthTokensResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): InvalidateAuthTokensResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: InvalidateAuthTokensResponse): InvalidateAuthTokensResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: InvalidateAuthTokensResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): InvalidateAuthTokensResponse;
+  static deserializeBinaryFromReader(message: InvalidateAuthTokensResponse, reader: jspb.BinaryReader): InvalidateAuthTokensResponse;
+}
+
+export namespace InvalidateAuthTokensResponse {
+  export type AsObject = {
+  }
+}
+
+export class InvalidateUserAuthTokensRequest extends jspb.Message {
+  getAddress(): string;
+  setAddress(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): InvalidateUserAuthTokensRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: InvalidateUserAuthTokensRequest): InvalidateUserAuthTokensRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: InvalidateUserAuthTokensRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): InvalidateUserAuthTokensRequest;
+  static deserializeBinaryFromReader(message: InvalidateUserAuthTokensRequest, reader: jspb.BinaryReader): InvalidateUserAuthTokensRequest;
+}
+
+export namespace InvalidateUserAuthTokensRequest {
+  export type AsObject = {
+    address: string,
+  }
+}
+
+export class InvalidateUserAuthTokensResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): InvalidateUserAuthTokensResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: InvalidateUserAuthTokensResponse): InvalidateUserAuthTokensResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: InvalidateUserAuthTokensResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): InvalidateUserAuthTokensResponse;
+  static deserializeBinaryFromReader(message: InvalidateUserAuthTokensResponse, reader: jspb.BinaryReader): InvalidateUserAuthTokensResponse;
+}
+
+export namespace InvalidateUserAuthTokensResponse {
+  export type AsObject = {
+  }
+}
+
+export class SetRPCProxyEnabledRequest extends jspb.Message {
+  getEnabled(): boolean;
+  setEnabled(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetRPCProxyEnabledRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetRPCProxyEnabledRequest): SetRPCProxyEnabledRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetRPCProxyEnabledRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetRPCProxyEnabledRequest;
+  static deserializeBinaryFromReader(message: SetRPCProxyEnabledRequest, reader: jspb.BinaryReader): SetRPCProxyEnabledRequest;
+}
+
+export namespace SetRPCProxyEnabledRequest {
+  export type AsObject = {
+    enabled: boolean,
+  }
+}
+
+export class SetRPCProxyEnabledResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetRPCProxyEnabledResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetRPCProxyEnabledResponse): SetRPCProxyEnabledResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetRPCProxyEnabledResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetRPCProxyEnabledResponse;
+  static deserializeBinaryFromReader(message: SetRPCProxyEnabledResponse, reader: jspb.BinaryReader): SetRPCProxyEnabledResponse;
+}
+
+export namespace SetRPCProxyEnabledResponse {
+  export type AsObject = {
+  }
+}
+
+export class AuthorizeApplicationRequest extends jspb.Message {
+  getApplicationName(): string;
+  setApplicationName(value: string): void;
+
+  getDesiredPermissionLevel(): PermissionLevelMap[keyof PermissionLevelMap];
+  setDesiredPermissionLevel(value: PermissionLevelMap[keyof PermissionLevelMap]): void;
+
+  getReason(): string;
+  setReason(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AuthorizeApplicationRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AuthorizeApplicationRequest): AuthorizeApplicationRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: AuthorizeApplicationRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AuthorizeApplicationRequest;
+  static deserializeBinaryFromReader(message: AuthorizeApplicationRequest, reader: jspb.BinaryReader): AuthorizeApplicationRequest;
+}
+
+export namespace AuthorizeApplicationRequest {
+  export type AsObject = {
+    applicationName: string,
+    desiredPermissionLevel: PermissionLevelMap[keyof PermissionLevelMap],
+    reason: string,
+  }
+}
+
+export class AuthorizeApplicationEvent extends jspb.Message {
+  hasHeartbeat(): boolean;
+  clearHeartbeat(): void;
+  getHeartbeat(): AuthorizeApplicationHeartbeatEvent | undefined;
+  setHeartbeat(value?: AuthorizeApplicationHeartbeatEvent): void;
+
+  hasAuthorizationUrl(): boolean;
+  clearAuthorizationUrl(): void;
+  getAuthorizationUrl(): AuthorizeApplicationAuthorizationURLEvent | undefined;
+  setAuthorizationUrl(value?: AuthorizeApplicationAuthorizationURLEvent): void;
+
+  hasApproved(): boolean;
+  clearApproved(): void;
+  getApproved(): AuthorizeApplicationApprovedEvent | undefined;
+  setApproved(value?: AuthorizeApplicationApprovedEvent): void;
+
+  getEventCase(): AuthorizeApplicationEvent.EventCase;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AuthorizeApplicationEvent.AsObject;
+  static toObject(includeInstance: boolean, msg: AuthorizeApplicationEvent): AuthorizeApplicationEvent.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: AuthorizeApplicationEvent, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AuthorizeApplicationEvent;
+  static deserializeBinaryFromReader(message: AuthorizeApplicationEvent, reader: jspb.BinaryReader): AuthorizeApplicationEvent;
+}
+
+export namespace AuthorizeApplicationEvent {
+  export type AsObject = {
+    heartbeat?: AuthorizeApplicationHeartbeatEvent.AsObject,
+    authorizationUrl?: AuthorizeApplicationAuthorizationURLEvent.AsObject,
+    approved?: AuthorizeApplicationApprovedEvent.AsObject,
+  }
+
+  export enum EventCase {
+    EVENT_NOT_SET = 0,
+    HEARTBEAT = 1,
+    AUTHORIZATION_URL = 2,
+    APPROVED = 3,
+  }
+}
+
+export class AuthorizeApplicationHeartbeatEvent extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AuthorizeApplicationHeartbeatEvent.AsObject;
+  static toObject(includeInstance: boolean, msg: AuthorizeApplicationHeartbeatEvent): AuthorizeApplicationHeartbeatEvent.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: AuthorizeApplicationHeartbeatEvent, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AuthorizeApplicationHeartbeatEvent;
+  static deserializeBinaryFromReader(message: AuthorizeApplicationHeartbeatEvent, reader: jspb.BinaryReader): AuthorizeApplicationHeartbeatEvent;
+}
+
+export namespace AuthorizeApplicationHeartbeatEvent {
+  export type AsObject = {
+  }
+}
+
+export class AuthorizeApplicationAuthorizationURLEvent extends jspb.Message {
+  getAuthorizationUrl(): string;
+  setAuthorizationUrl(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AuthorizeApplicationAuthorizationURLEvent.AsObject;
+  static toObject(includeInstance: boolean, msg: AuthorizeApplicationAuthorizationURLEvent): AuthorizeApplicationAuthorizationURLEvent.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: AuthorizeApplicationAuthorizationURLEvent, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AuthorizeApplicationAuthorizationURLEvent;
+  static deserializeBinaryFromReader(message: AuthorizeApplicationAuthorizationURLEvent, reader: jspb.BinaryReader): AuthorizeApplicationAuthorizationURLEvent;
+}
+
+export namespace AuthorizeApplicationAuthorizationURLEvent {
+  export type AsObject = {
+    authorizationUrl: string,
+  }
+}
+
+export class AuthorizeApplicationApprovedEvent extends jspb.Message {
+  getAuthToken(): string;
+  setAuthToken(value: string): void;
+
+  hasTokenExpiration(): boolean;
+  clearTokenExpiration(): void;
+  getTokenExpiration(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTokenExpiration(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AuthorizeApplicationApprovedEvent.AsObject;
+  static toObject(includeInstance: boolean, msg: AuthorizeApplicationApprovedEvent): AuthorizeApplicationApprovedEvent.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: AuthorizeApplicationApprovedEvent, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AuthorizeApplicationApprovedEvent;
+  static deserializeBinaryFromReader(message: AuthorizeApplicationApprovedEvent, reader: jspb.BinaryReader): AuthorizeApplicationApprovedEvent;
+}
+
+export namespace AuthorizeApplicationApprovedEvent {
+  export type AsObject = {
+    authToken: string,
+    tokenExpiration?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class AuthorizationProcessDataRequest extends jspb.Message {
+  getProcessId(): string;
+  setProcessId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AuthorizationProcessDataRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AuthorizationProcessDataRequest): AuthorizationProcessDataRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: AuthorizationProcessDataRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AuthorizationProcessDataRequest;
+  static deserializeBinaryFromReader(message: AuthorizationProcessDataRequest, reader: jspb.BinaryReader): AuthorizationProcessDataRequest;
+}
+
+export namespace AuthorizationProcessDataRequest {
+  export type AsObject = {
+    processId: string,
+  }
+}
+
+export class AuthorizationProcessDataResponse extends jspb.Message {
+  getApplicationName(): string;
+  setApplicationName(value: string): void;
+
+  getDesiredPermissionLevel(): PermissionLevelMap[keyof PermissionLevelMap];
+  setDesiredPermissionLevel(value: PermissionLevelMap[keyof PermissionLevelMap]): void;
+
+  getReason(): string;
+  setReason(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AuthorizationProcessDataResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: AuthorizationProcessDataResponse): AuthorizationProcessDataResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: AuthorizationProcessDataResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AuthorizationProcessDataResponse;
+  static deserializeBinaryFromReader(message: AuthorizationProcessDataResponse, reader: jspb.BinaryReader): AuthorizationProcessDataResponse;
+}
+
+export namespace AuthorizationProcessDataResponse {
+  export type AsObject = {
+    applicationName: string,
+    desiredPermissionLevel: PermissionLevelMap[keyof PermissionLevelMap],
+    reason: string,
+  }
+}
+
+export class ConsentOrDissentToAuthorizationRequest extends jspb.Message {
+  getProcessId(): string;
+  setProcessId(value: string): void;
+
+  getConsent(): boolean;
+  setConsent(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ConsentOrDissentToAuthorizationRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ConsentOrDissentToAuthorizationRequest): ConsentOrDissentToAuthorizationRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ConsentOrDissentToAuthorizationRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ConsentOrDissentToAuthorizationRequest;
+  static deserializeBinaryFromReader(message: ConsentOrDissentToAuthorizationRequest, reader: jspb.BinaryReader): ConsentOrDissentToAuthorizationRequest;
+}
+
+export namespace ConsentOrDissentToAuthorizationRequest {
+  export type AsObject = {
+    processId: string,
+    consent: boolean,
+  }
+}
+
+export class ConsentOrDissentToAuthorizationResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ConsentOrDissentToAuthorizationResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ConsentOrDissentToAuthorizationResponse): ConsentOrDissentToAuthorizationResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ConsentOrDissentToAuthorizationResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ConsentOrDissentToAuthorizationResponse;
+  static deserializeBinaryFromReader(message: ConsentOrDissentToAuthorizationResponse, reader: jspb.BinaryReader): ConsentOrDissentToAuthorizationResponse;
+}
+
+export namespace ConsentOrDissentToAuthorizationResponse {
+  export type AsObject = {
+  }
+}
+
+export class SpectatorsRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SpectatorsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SpectatorsRequest): SpectatorsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SpectatorsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SpectatorsRequest;
+  static deserializeBinaryFromReader(message: SpectatorsRequest, reader: jspb.BinaryReader): SpectatorsRequest;
+}
+
+export namespace SpectatorsRequest {
+  export type AsObject = {
+  }
+}
+
+export class SpectatorsResponse extends jspb.Message {
+  clearSpectatorsList(): void;
+  getSpectatorsList(): Array<Spectator>;
+  setSpectatorsList(value: Array<Spectator>): void;
+  addSpectators(value?: Spectator, index?: number): Spectator;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SpectatorsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SpectatorsResponse): SpectatorsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SpectatorsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SpectatorsResponse;
+  static deserializeBinaryFromReader(message: SpectatorsResponse, reader: jspb.BinaryReader): SpectatorsResponse;
+}
+
+export namespace SpectatorsResponse {
+  export type AsObject = {
+    spectatorsList: Array<Spectator.AsObject>,
+  }
+}
+
+export class TimetableSlotsRequest extends jspb.Message {
+  getChannelId(): string;
+  setChannelId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TimetableSlotsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: TimetableSlotsRequest): TimetableSlotsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TimetableSlotsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TimetableSlotsRequest;
+  static deserializeBinaryFromReader(message: TimetableSlotsRequest, reader: jspb.BinaryReader): TimetableSlotsRequest;
+}
+
+export namespace TimetableSlotsRequest {
+  export type AsObject = {
+    channelId: string,
+  }
+}
 
-  hasEndedAt(): boolean;
-  clearEndedAt(): void;
-  getEndedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
-  setEndedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+export class TimetableSlotsResponse extends jspb.Message {
+  clearSlotsList(): void;
+  getSlotsList(): Array<TimetableSlot>;
+  setSlotsList(value: Array<TimetableSlot>): void;
+  addSlots(value?: TimetableSlot, index?: number): TimetableSlot;
 
-  hasLength(): boolean;
-  clearLength(): void;
-  getLength(): google_protobuf_duration_pb.Duration | undefined;
-  setLength(value?: google_protobuf_duration_pb.Duration): void;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TimetableSlotsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: TimetableSlotsResponse): TimetableSlotsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TimetableSlotsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TimetableSlotsResponse;
+  static deserializeBinaryFromReader(message: TimetableSlotsResponse, reader: jspb.BinaryReader): TimetableSlotsResponse;
+}
 
-  hasOffset(): boolean;
-  clearOffset(): void;
-  getOffset(): google_protobuf_duration_pb.Duration | undefined;
-  setOffset(value?: google_protobuf_duration_pb.Duration): void;
+export namespace TimetableSlotsResponse {
+  export type AsObject = {
+    slotsList: Array<TimetableSlot.AsObject>,
+  }
+}
 
-  getUnskippable(): boolean;
-  setUnskippable(value: boolean): void;
+export class SetTimetableSlotRequest extends jspb.Message {
+  hasId(): boolean;
+  clearId(): void;
+  getId(): string;
+  setId(value: string): void;
 
-  hasYoutubeVideoData(): boolean;
-  clearYoutubeVideoData(): void;
-  getYoutubeVideoData(): QueueYouTubeVideoData | undefined;
-  setYoutubeVideoData(value?: QueueYouTubeVideoData): void;
+  getLabel(): string;
+  setLabel(value: string): void;
 
-  hasSoundcloudTrackData(): boolean;
-  clearSoundcloudTrackData(): void;
-  getSoundcloudTrackData(): QueueSoundCloudTrackData | undefined;
-  setSoundcloudTrackData(value?: QueueSoundCloudTrackData): void;
+  getWeekday(): number;
+  setWeekday(value: number): void;
 
-  hasDocumentData(): boolean;
-  clearDocumentData(): void;
-  getDocumentData(): QueueDocumentData | undefined;
-  setDocumentData(value?: QueueDocumentData): void;
+  hasStartTimeOfDay(): boolean;
+  clearStartTimeOfDay(): void;
+  getStartTimeOfDay(): google_protobuf_duration_pb.Duration | undefined;
+  setStartTimeOfDay(value?: google_protobuf_duration_pb.Duration): void;
 
-  hasApplicationPageData(): boolean;
-  clearApplicationPageData(): void;
-  getApplicationPageData(): QueueApplicationPageData | undefined;
-  setApplicationPageData(value?: QueueApplicationPageData): void;
+  hasDuration(): boolean;
+  clearDuration(): void;
+  getDuration(): google_protobuf_duration_pb.Duration | undefined;
+  setDuration(value?: google_protobuf_duration_pb.Duration): void;
+
+  getChannelId(): string;
+  setChannelId(value: string): void;
 
-  getMediaInfoCase(): PlayedMedia.MediaInfoCase;
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): PlayedMedia.AsObject;
-  static toObject(includeInstance: boolean, msg: PlayedMedia): PlayedMedia.AsObject;
+  toObject(includeInstance?: boolean): SetTimetableSlotRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetTimetableSlotRequest): SetTimetableSlotRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: PlayedMedia, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): PlayedMedia;
-  static deserializeBinaryFromReader(message: PlayedMedia, reader: jspb.BinaryReader): PlayedMedia;
+  static serializeBinaryToWriter(message: SetTimetableSlotRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetTimetableSlotRequest;
+  static deserializeBinaryFromReader(message: SetTimetableSlotRequest, reader: jspb.BinaryReader): SetTimetableSlotRequest;
 }
 
-export namespace PlayedMedia {
+export namespace SetTimetableSlotRequest {
   export type AsObject = {
     id: string,
-    requestedBy?: common_pb.User.AsObject,
-    requestCost: string,
-    enqueuedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
-    startedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
-    endedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
-    length?: google_protobuf_duration_pb.Duration.AsObject,
-    offset?: google_protobuf_duration_pb.Duration.AsObject,
-    unskippable: boolean,
-    youtubeVideoData?: QueueYouTubeVideoData.AsObject,
-    soundcloudTrackData?: QueueSoundCloudTrackData.AsObject,
-    documentData?: QueueDocumentData.AsObject,
-    applicationPageData?: QueueApplicationPageData.AsObject,
+    label: string,
+    weekday: number,
+    startTimeOfDay?: google_protobuf_duration_pb.Duration.AsObject,
+    duration?: google_protobuf_duration_pb.Duration.AsObject,
+    channelId: string,
   }
+}
 
-  export enum MediaInfoCase {
-    MEDIA_INFO_NOT_SET = 0,
-    YOUTUBE_VIDEO_DATA = 10,
-    SOUNDCLOUD_TRACK_DATA = 11,
-    DOCUMENT_DATA = 12,
-    APPLICATION_PAGE_DATA = 13,
+export class SetTimetableSlotResponse extends jspb.Message {
+  hasSlot(): boolean;
+  clearSlot(): void;
+  getSlot(): TimetableSlot | undefined;
+  setSlot(value?: TimetableSlot): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetTimetableSlotResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetTimetableSlotResponse): SetTimetableSlotResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetTimetableSlotResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetTimetableSlotResponse;
+  static deserializeBinaryFromReader(message: SetTimetableSlotResponse, reader: jspb.BinaryReader): SetTimetableSlotResponse;
+}
+
+export namespace SetTimetableSlotResponse {
+  export type AsObject = {
+    slot?: TimetableSlot.AsObject,
   }
 }
 
-export class SetProfileBiographyRequest extends jspb.Message {
-  getBiography(): string;
-  setBiography(value: string): void;
+export class RemoveTimetableSlotRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getChannelId(): string;
+  setChannelId(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetProfileBiographyRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: SetProfileBiographyRequest): SetProfileBiographyRequest.AsObject;
+  toObject(includeInstance?: boolean): RemoveTimetableSlotRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveTimetableSlotRequest): RemoveTimetableSlotRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetProfileBiographyRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetProfileBiographyRequest;
-  static deserializeBinaryFromReader(message: SetProfileBiographyRequest, reader: jspb.BinaryReader): SetProfileBiographyRequest;
+  static serializeBinaryToWriter(message: RemoveTimetableSlotRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveTimetableSlotRequest;
+  static deserializeBinaryFromReader(message: RemoveTimetableSlotRequest, reader: jspb.BinaryReader): RemoveTimetableSlotRequest;
 }
 
-export namespace SetProfileBiographyRequest {
+export namespace RemoveTimetableSlotRequest {
   export type AsObject = {
-    biography: string,
+    id: string,
+    channelId: string,
   }
 }
 
-export class SetProfileBiographyResponse extends jspb.Message {
+export class RemoveTimetableSlotResponse extends jspb.Message {
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetProfileBiographyResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: SetProfileBiographyResponse): SetProfileBiographyResponse.AsObject;
+  toObject(includeInstance?: boolean): RemoveTimetableSlotResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveTimetableSlotResponse): RemoveTimetableSlotResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetProfileBiographyResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetProfileBiographyResponse;
-  static deserializeBinaryFromReader(message: SetProfileBiographyResponse, reader: jspb.BinaryReader): SetProfileBiographyResponse;
+  static serializeBinaryToWriter(message: RemoveTimetableSlotResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveTimetableSlotResponse;
+  static deserializeBinaryFromReader(message: RemoveTimetableSlotResponse, reader: jspb.BinaryReader): RemoveTimetableSlotResponse;
 }
 
-export namespace SetProfileBiographyResponse {
+export namespace RemoveTimetableSlotResponse {
   export type AsObject = {
   }
 }
 
-export class SetProfileFeaturedMediaRequest extends jspb.Message {
-  hasMediaId(): boolean;
-  clearMediaId(): void;
-  getMediaId(): string;
-  setMediaId(value: string): void;
+export class CreateBroadcastChannelRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getName(): string;
+  setName(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetProfileFeaturedMediaRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: SetProfileFeaturedMediaRequest): SetProfileFeaturedMediaRequest.AsObject;
+  toObject(includeInstance?: boolean): CreateBroadcastChannelRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CreateBroadcastChannelRequest): CreateBroadcastChannelRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetProfileFeaturedMediaRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetProfileFeaturedMediaRequest;
-  static deserializeBinaryFromReader(message: SetProfileFeaturedMediaRequest, reader: jspb.BinaryReader): SetProfileFeaturedMediaRequest;
+  static serializeBinaryToWriter(message: CreateBroadcastChannelRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CreateBroadcastChannelRequest;
+  static deserializeBinaryFromReader(message: CreateBroadcastChannelRequest, reader: jspb.BinaryReader): CreateBroadcastChannelRequest;
 }
 
-export namespace SetProfileFeaturedMediaRequest {
+export namespace CreateBroadcastChannelRequest {
   export type AsObject = {
-    mediaId: string,
+    id: string,
+    name: string,
   }
 }
 
-export class SetProfileFeaturedMediaResponse extends jspb.Message {
+export class CreateBroadcastChannelResponse extends jspb.Message {
+  hasChannel(): boolean;
+  clearChannel(): void;
+  getChannel(): BroadcastChannel | undefined;
+  setChannel(value?: BroadcastChannel): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetProfileFeaturedMediaResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: SetProfileFeaturedMediaResponse): SetProfileFeaturedMediaResponse.AsObject;
+  toObject(includeInstance?: boolean): CreateBroadcastChannelResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: CreateBroadcastChannelResponse): CreateBroadcastChannelResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetProfileFeaturedMediaResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetProfileFeaturedMediaResponse;
-  static deserializeBinaryFromReader(message: SetProfileFeaturedMediaResponse, reader: jspb.BinaryReader): SetProfileFeaturedMediaResponse;
+  static serializeBinaryToWriter(message: CreateBroadcastChannelResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CreateBroadcastChannelResponse;
+  static deserializeBinaryFromReader(message: CreateBroadcastChannelResponse, reader: jspb.BinaryReader): CreateBroadcastChannelResponse;
 }
 
-export namespace SetProfileFeaturedMediaResponse {
+export namespace CreateBroadcastChannelResponse {
   export type AsObject = {
+    channel?: BroadcastChannel.AsObject,
   }
 }
 
-export class ClearUserProfileRequest extends jspb.Message {
-  getAddress(): string;
-  setAddress(value: string): void;
+export class AutoplayPool extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getName(): string;
+  setName(value: string): void;
+
+  getSource(): AutoplayPoolSourceMap[keyof AutoplayPoolSourceMap];
+  setSource(value: AutoplayPoolSourceMap[keyof AutoplayPoolSourceMap]): void;
+
+  getEnabled(): boolean;
+  setEnabled(value: boolean): void;
+
+  getWeight(): number;
+  setWeight(value: number): void;
+
+  hasCooldown(): boolean;
+  clearCooldown(): void;
+  getCooldown(): google_protobuf_duration_pb.Duration | undefined;
+  setCooldown(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasActiveFrom(): boolean;
+  clearActiveFrom(): void;
+  getActiveFrom(): google_protobuf_duration_pb.Duration | undefined;
+  setActiveFrom(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasActiveUntil(): boolean;
+  clearActiveUntil(): void;
+  getActiveUntil(): google_protobuf_duration_pb.Duration | undefined;
+  setActiveUntil(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasHistoryLookback(): boolean;
+  clearHistoryLookback(): void;
+  getHistoryLookback(): google_protobuf_duration_pb.Duration | undefined;
+  setHistoryLookback(value?: google_protobuf_duration_pb.Duration): void;
+
+  getHistoryMinPlays(): number;
+  setHistoryMinPlays(value: number): void;
+
+  getActive(): boolean;
+  setActive(value: boolean): void;
+
+  getChannelId(): string;
+  setChannelId(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ClearUserProfileRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: ClearUserProfileRequest): ClearUserProfileRequest.AsObject;
+  toObject(includeInstance?: boolean): AutoplayPool.AsObject;
+  static toObject(includeInstance: boolean, msg: AutoplayPool): AutoplayPool.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ClearUserProfileRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ClearUserProfileRequest;
-  static deserializeBinaryFromReader(message: ClearUserProfileRequest, reader: jspb.BinaryReader): ClearUserProfileRequest;
+  static serializeBinaryToWriter(message: AutoplayPool, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AutoplayPool;
+  static deserializeBinaryFromReader(message: AutoplayPool, reader: jspb.BinaryReader): AutoplayPool;
 }
 
-export namespace ClearUserProfileRequest {
+export namespace AutoplayPool {
   export type AsObject = {
-    address: string,
+    id: string,
+    name: string,
+    source: AutoplayPoolSourceMap[keyof AutoplayPoolSourceMap],
+    enabled: boolean,
+    weight: number,
+    cooldown?: google_protobuf_duration_pb.Duration.AsObject,
+    activeFrom?: google_protobuf_duration_pb.Duration.AsObject,
+    activeUntil?: google_protobuf_duration_pb.Duration.AsObject,
+    historyLookback?: google_protobuf_duration_pb.Duration.AsObject,
+    historyMinPlays: number,
+    active: boolean,
+    channelId: string,
   }
 }
 
-export class ClearUserProfileResponse extends jspb.Message {
+export class AutoplayPoolsRequest extends jspb.Message {
+  getChannelId(): string;
+  setChannelId(value: string): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ClearUserProfileResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: ClearUserProfileResponse): ClearUserProfileResponse.AsObject;
+  toObject(includeInstance?: boolean): AutoplayPoolsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AutoplayPoolsRequest): AutoplayPoolsRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ClearUserProfileResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ClearUserProfileResponse;
-  static deserializeBinaryFromReader(message: ClearUserProfileResponse, reader: jspb.BinaryReader): ClearUserProfileResponse;
+  static serializeBinaryToWriter(message: AutoplayPoolsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AutoplayPoolsRequest;
+  static deserializeBinaryFromReader(message: AutoplayPoolsRequest, reader: jspb.BinaryReader): AutoplayPoolsRequest;
 }
 
-export namespace ClearUserProfileResponse {
+export namespace AutoplayPoolsRequest {
   export type AsObject = {
+    channelId: string,
   }
 }
 
-export class PlayedMediaHistoryRequest extends jspb.Message {
-  hasPaginationParams(): boolean;
-  clearPaginationParams(): void;
-  getPaginationParams(): common_pb.PaginationParameters | undefined;
-  setPaginationParams(value?: common_pb.PaginationParameters): void;
-
-  getSearchQuery(): string;
-  setSearchQuery(value: string): void;
+export class AutoplayPoolsResponse extends jspb.Message {
+  clearPoolsList(): void;
+  getPoolsList(): Array<AutoplayPool>;
+  setPoolsList(value: Array<AutoplayPool>): void;
+  addPools(value?: AutoplayPool, index?: number): AutoplayPool;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): PlayedMediaHistoryRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: PlayedMediaHistoryRequest): PlayedMediaHistoryRequest.AsObject;
+  toObject(includeInstance?: boolean): AutoplayPoolsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: AutoplayPoolsResponse): AutoplayPoolsResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: PlayedMediaHistoryRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): PlayedMediaHistoryRequest;
-  static deserializeBinaryFromReader(message: PlayedMediaHistoryRequest, reader: jspb.BinaryReader): PlayedMediaHistoryRequest;
+  static serializeBinaryToWriter(message: AutoplayPoolsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AutoplayPoolsResponse;
+  static deserializeBinaryFromReader(message: AutoplayPoolsResponse, reader: jspb.BinaryReader): AutoplayPoolsResponse;
 }
 
-export namespace PlayedMediaHistoryRequest {
+export namespace AutoplayPoolsResponse {
   export type AsObject = {
-    paginationParams?: common_pb.PaginationParameters.AsObject,
-    searchQuery: string,
+    poolsList: Array<AutoplayPool.AsObject>,
   }
 }
 
-export class PlayedMediaHistoryResponse extends jspb.Message {
-  clearPlayedMediaList(): void;
-  getPlayedMediaList(): Array<PlayedMedia>;
-  setPlayedMediaList(value: Array<PlayedMedia>): void;
-  addPlayedMedia(value?: PlayedMedia, index?: number): PlayedMedia;
+export class SetAutoplayPoolRequest extends jspb.Message {
+  hasId(): boolean;
+  clearId(): void;
+  getId(): string;
+  setId(value: string): void;
 
-  getOffset(): number;
-  setOffset(value: number): void;
+  getName(): string;
+  setName(value: string): void;
 
-  getTotal(): number;
-  setTotal(value: number): void;
+  getSource(): AutoplayPoolSourceMap[keyof AutoplayPoolSourceMap];
+  setSource(value: AutoplayPoolSourceMap[keyof AutoplayPoolSourceMap]): void;
+
+  getEnabled(): boolean;
+  setEnabled(value: boolean): void;
+
+  getWeight(): number;
+  setWeight(value: number): void;
+
+  hasCooldown(): boolean;
+  clearCooldown(): void;
+  getCooldown(): google_protobuf_duration_pb.Duration | undefined;
+  setCooldown(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasActiveFrom(): boolean;
+  clearActiveFrom(): void;
+  getActiveFrom(): google_protobuf_duration_pb.Duration | undefined;
+  setActiveFrom(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasActiveUntil(): boolean;
+  clearActiveUntil(): void;
+  getActiveUntil(): google_protobuf_duration_pb.Duration | undefined;
+  setActiveUntil(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasHistoryLookback(): boolean;
+  clearHistoryLookback(): void;
+  getHistoryLookback(): google_protobuf_duration_pb.Duration | undefined;
+  setHistoryLookback(value?: google_protobuf_duration_pb.Duration): void;
+
+  getHistoryMinPlays(): number;
+  setHistoryMinPlays(value: number): void;
+
+  getChannelId(): string;
+  setChannelId(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): PlayedMediaHistoryResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: PlayedMediaHistoryResponse): PlayedMediaHistoryResponse.AsObject;
+  toObject(includeInstance?: boolean): SetAutoplayPoolRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetAutoplayPoolRequest): SetAutoplayPoolRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: PlayedMediaHistoryResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): PlayedMediaHistoryResponse;
-  static deserializeBinaryFromReader(message: PlayedMediaHistoryResponse, reader: jspb.BinaryReader): PlayedMediaHistoryResponse;
+  static serializeBinaryToWriter(message: SetAutoplayPoolRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetAutoplayPoolRequest;
+  static deserializeBinaryFromReader(message: SetAutoplayPoolRequest, reader: jspb.BinaryReader): SetAutoplayPoolRequest;
 }
 
-export namespace PlayedMediaHistoryResponse {
+export namespace SetAutoplayPoolRequest {
   export type AsObject = {
-    playedMediaList: Array<PlayedMedia.AsObject>,
-    offset: number,
-    total: number,
+    id: string,
+    name: string,
+    source: AutoplayPoolSourceMap[keyof AutoplayPoolSourceMap],
+    enabled: boolean,
+    weight: number,
+    cooldown?: google_protobuf_duration_pb.Duration.AsObject,
+    activeFrom?: google_protobuf_duration_pb.Duration.AsObject,
+    activeUntil?: google_protobuf_duration_pb.Duration.AsObject,
+    historyLookback?: google_protobuf_duration_pb.Duration.AsObject,
+    historyMinPlays: number,
+    channelId: string,
   }
 }
 
-export class BlockUserRequest extends jspb.Message {
-  getAddress(): string;
-  setAddress(value: string): void;
+export class SetAutoplayPoolResponse extends jspb.Message {
+  hasPool(): boolean;
+  clearPool(): void;
+  getPool(): AutoplayPool | undefined;
+  setPool(value?: AutoplayPool): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): BlockUserRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: BlockUserRequest): BlockUserRequest.AsObject;
+  toObject(includeInstance?: boolean): SetAutoplayPoolResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetAutoplayPoolResponse): SetAutoplayPoolResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: BlockUserRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): BlockUserRequest;
-  static deserializeBinaryFromReader(message: BlockUserRequest, reader: jspb.BinaryReader): BlockUserRequest;
+  static serializeBinaryToWriter(message: SetAutoplayPoolResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetAutoplayPoolResponse;
+  static deserializeBinaryFromReader(message: SetAutoplayPoolResponse, reader: jspb.BinaryReader): SetAutoplayPoolResponse;
 }
 
-export namespace BlockUserRequest {
+export namespace SetAutoplayPoolResponse {
   export type AsObject = {
-    address: string,
+    pool?: AutoplayPool.AsObject,
   }
 }
 
-export class BlockUserResponse extends jspb.Message {
+export class RemoveAutoplayPoolRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): BlockUserResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: BlockUserResponse): BlockUserResponse.AsObject;
+  toObject(includeInstance?: boolean): RemoveAutoplayPoolRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveAutoplayPoolRequest): RemoveAutoplayPoolRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: BlockUserResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): BlockUserResponse;
-  static deserializeBinaryFromReader(message: BlockUserResponse, reader: jspb.BinaryReader): BlockUserResponse;
+  static serializeBinaryToWriter(message: RemoveAutoplayPoolRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveAutoplayPoolRequest;
+  static deserializeBinaryFromReader(message: RemoveAutoplayPoolRequest, reader: jspb.BinaryReader): RemoveAutoplayPoolRequest;
 }
 
-export namespace BlockUserResponse {
+export namespace RemoveAutoplayPoolRequest {
   export type AsObject = {
+    id: string,
   }
 }
 
-export class UnblockUserRequest extends jspb.Message {
-  hasBlockId(): boolean;
-  clearBlockId(): void;
-  getBlockId(): string;
-  setBlockId(value: string): void;
+export class RemoveAutoplayPoolResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RemoveAutoplayPoolResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveAutoplayPoolResponse): RemoveAutoplayPoolResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: RemoveAutoplayPoolResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveAutoplayPoolResponse;
+  static deserializeBinaryFromReader(message: RemoveAutoplayPoolResponse, reader: jspb.BinaryReader): RemoveAutoplayPoolResponse;
+}
 
-  hasAddress(): boolean;
-  clearAddress(): void;
-  getAddress(): string;
-  setAddress(value: string): void;
+export namespace RemoveAutoplayPoolResponse {
+  export type AsObject = {
+  }
+}
+
+export class AutoplayPoolEntry extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getPoolId(): string;
+  setPoolId(value: string): void;
 
-  getBlockIdentificationCase(): UnblockUserRequest.BlockIdentificationCase;
+  hasYoutubeVideoId(): boolean;
+  clearYoutubeVideoId(): void;
+  getYoutubeVideoId(): string;
+  setYoutubeVideoId(value: string): void;
+
+  hasSoundcloudTrackPermalink(): boolean;
+  clearSoundcloudTrackPermalink(): void;
+  getSoundcloudTrackPermalink(): string;
+  setSoundcloudTrackPermalink(value: string): void;
+
+  getWeight(): number;
+  setWeight(value: number): void;
+
+  getMediaCase(): AutoplayPoolEntry.MediaCase;
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): UnblockUserRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: UnblockUserRequest): UnblockUserRequest.AsObject;
+  toObject(includeInstance?: boolean): AutoplayPoolEntry.AsObject;
+  static toObject(includeInstance: boolean, msg: AutoplayPoolEntry): AutoplayPoolEntry.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: UnblockUserRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): UnblockUserRequest;
-  static deserializeBinaryFromReader(message: UnblockUserRequest, reader: jspb.BinaryReader): UnblockUserRequest;
+  static serializeBinaryToWriter(message: AutoplayPoolEntry, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AutoplayPoolEntry;
+  static deserializeBinaryFromReader(message: AutoplayPoolEntry, reader: jspb.BinaryReader): AutoplayPoolEntry;
 }
 
-export namespace UnblockUserRequest {
+export namespace AutoplayPoolEntry {
   export type AsObject = {
-    blockId: string,
-    address: string,
+    id: string,
+    poolId: string,
+    youtubeVideoId: string,
+    soundcloudTrackPermalink: string,
+    weight: number,
   }
 
-  export enum BlockIdentificationCase {
-    BLOCK_IDENTIFICATION_NOT_SET = 0,
-    BLOCK_ID = 1,
-    ADDRESS = 2,
+  export enum MediaCase {
+    MEDIA_NOT_SET = 0,
+    YOUTUBE_VIDEO_ID = 3,
+    SOUNDCLOUD_TRACK_PERMALINK = 4,
   }
 }
 
-export class UnblockUserResponse extends jspb.Message {
+export class AutoplayPoolEntriesRequest extends jspb.Message {
+  getPoolId(): string;
+  setPoolId(value: string): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): UnblockUserResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: UnblockUserResponse): UnblockUserResponse.AsObject;
+  toObject(includeInstance?: boolean): AutoplayPoolEntriesRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AutoplayPoolEntriesRequest): AutoplayPoolEntriesRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: UnblockUserResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): UnblockUserResponse;
-  static deserializeBinaryFromReader(message: UnblockUserResponse, reader: jspb.BinaryReader): UnblockUserResponse;
+  static serializeBinaryToWriter(message: AutoplayPoolEntriesRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AutoplayPoolEntriesRequest;
+  static deserializeBinaryFromReader(message: AutoplayPoolEntriesRequest, reader: jspb.BinaryReader): AutoplayPoolEntriesRequest;
 }
 
-export namespace UnblockUserResponse {
+export namespace AutoplayPoolEntriesRequest {
   export type AsObject = {
+    poolId: string,
   }
 }
 
-export class BlockedUsersRequest extends jspb.Message {
-  hasPaginationParams(): boolean;
-  clearPaginationParams(): void;
-  getPaginationParams(): common_pb.PaginationParameters | undefined;
-  setPaginationParams(value?: common_pb.PaginationParameters): void;
+export class AutoplayPoolEntriesResponse extends jspb.Message {
+  clearEntriesList(): void;
+  getEntriesList(): Array<AutoplayPoolEntry>;
+  setEntriesList(value: Array<AutoplayPoolEntry>): void;
+  addEntries(value?: AutoplayPoolEntry, index?: number): AutoplayPoolEntry;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): BlockedUsersRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: BlockedUsersRequest): BlockedUsersRequest.AsObject;
+  toObject(includeInstance?: boolean): AutoplayPoolEntriesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: AutoplayPoolEntriesResponse): AutoplayPoolEntriesResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: BlockedUsersRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): BlockedUsersRequest;
-  static deserializeBinaryFromReader(message: BlockedUsersRequest, reader: jspb.BinaryReader): BlockedUsersRequest;
+  static serializeBinaryToWriter(message: AutoplayPoolEntriesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AutoplayPoolEntriesResponse;
+  static deserializeBinaryFromReader(message: AutoplayPoolEntriesResponse, reader: jspb.BinaryReader): AutoplayPoolEntriesResponse;
 }
 
-export namespace BlockedUsersRequest {
+export namespace AutoplayPoolEntriesResponse {
   export type AsObject = {
-    paginationParams?: common_pb.PaginationParameters.AsObject,
+    entriesList: Array<AutoplayPoolEntry.AsObject>,
   }
 }
 
-export class BlockedUser extends jspb.Message {
-  getId(): string;
-  setId(value: string): void;
+export class AddAutoplayPoolEntryRequest extends jspb.Message {
+  getPoolId(): string;
+  setPoolId(value: string): void;
 
-  hasBlockedUser(): boolean;
-  clearBlockedUser(): void;
-  getBlockedUser(): common_pb.User | undefined;
-  setBlockedUser(value?: common_pb.User): void;
+  hasYoutubeVideoId(): boolean;
+  clearYoutubeVideoId(): void;
+  getYoutubeVideoId(): string;
+  setYoutubeVideoId(value: string): void;
 
-  hasBlockedBy(): boolean;
-  clearBlockedBy(): void;
-  getBlockedBy(): common_pb.User | undefined;
-  setBlockedBy(value?: common_pb.User): void;
+  hasSoundcloudTrackPermalink(): boolean;
+  clearSoundcloudTrackPermalink(): void;
+  getSoundcloudTrackPermalink(): string;
+  setSoundcloudTrackPermalink(value: string): void;
 
-  hasCreatedAt(): boolean;
-  clearCreatedAt(): void;
-  getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
-  setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+  getWeight(): number;
+  setWeight(value: number): void;
 
+  getMediaCase(): AddAutoplayPoolEntryRequest.MediaCase;
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): BlockedUser.AsObject;
-  static toObject(includeInstance: boolean, msg: BlockedUser): BlockedUser.AsObject;
+  toObject(includeInstance?: boolean): AddAutoplayPoolEntryRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AddAutoplayPoolEntryRequest): AddAutoplayPoolEntryRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: BlockedUser, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): BlockedUser;
-  static deserializeBinaryFromReader(message: BlockedUser, reader: jspb.BinaryReader): BlockedUser;
+  static serializeBinaryToWriter(message: AddAutoplayPoolEntryRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AddAutoplayPoolEntryRequest;
+  static deserializeBinaryFromReader(message: AddAutoplayPoolEntryRequest, reader: jspb.BinaryReader): AddAutoplayPoolEntryRequest;
 }
 
-export namespace BlockedUser {
+export namespace AddAutoplayPoolEntryRequest {
   export type AsObject = {
-    id: string,
-    blockedUser?: common_pb.User.AsObject,
-    blockedBy?: common_pb.User.AsObject,
-    createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    poolId: string,
+    youtubeVideoId: string,
+    soundcloudTrackPermalink: string,
+    weight: number,
   }
-}
 
-export class BlockedUsersResponse extends jspb.Message {
-  clearBlockedUsersList(): void;
-  getBlockedUsersList(): Array<BlockedUser>;
-  setBlockedUsersList(value: Array<BlockedUser>): void;
-  addBlockedUsers(value?: BlockedUser, index?: number): BlockedUser;
-
-  getOffset(): number;
-  setOffset(value: number): void;
+  export enum MediaCase {
+    MEDIA_NOT_SET = 0,
+    YOUTUBE_VIDEO_ID = 2,
+    SOUNDCLOUD_TRACK_PERMALINK = 3,
+  }
+}
 
-  getTotal(): number;
-  setTotal(value: number): void;
+export class AddAutoplayPoolEntryResponse extends jspb.Message {
+  hasEntry(): boolean;
+  clearEntry(): void;
+  getEntry(): AutoplayPoolEntry | undefined;
+  setEntry(value?: AutoplayPoolEntry): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): BlockedUsersResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: BlockedUsersResponse): BlockedUsersResponse.AsObject;
+  toObject(includeInstance?: boolean): AddAutoplayPoolEntryResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: AddAutoplayPoolEntryResponse): AddAutoplayPoolEntryResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: BlockedUsersResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): BlockedUsersResponse;
-  static deserializeBinaryFromReader(message: BlockedUsersResponse, reader: jspb.BinaryReader): BlockedUsersResponse;
+  static serializeBinaryToWriter(message: AddAutoplayPoolEntryResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AddAutoplayPoolEntryResponse;
+  static deserializeBinaryFromReader(message: AddAutoplayPoolEntryResponse, reader: jspb.BinaryReader): AddAutoplayPoolEntryResponse;
 }
 
-export namespace BlockedUsersResponse {
+export namespace AddAutoplayPoolEntryResponse {
   export type AsObject = {
-    blockedUsersList: Array<BlockedUser.AsObject>,
-    offset: number,
-    total: number,
+    entry?: AutoplayPoolEntry.AsObject,
   }
 }
 
-export class MarkAsActivelyModeratingRequest extends jspb.Message {
+export class RemoveAutoplayPoolEntryRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): MarkAsActivelyModeratingRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: MarkAsActivelyModeratingRequest): MarkAsActivelyModeratingRequest.AsObject;
+  toObject(includeInstance?: boolean): RemoveAutoplayPoolEntryRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveAutoplayPoolEntryRequest): RemoveAutoplayPoolEntryRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: MarkAsActivelyModeratingRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): MarkAsActivelyModeratingRequest;
-  static deserializeBinaryFromReader(message: MarkAsActivelyModeratingRequest, reader: jspb.BinaryReader): MarkAsActivelyModeratingRequest;
+  static serializeBinaryToWriter(message: RemoveAutoplayPoolEntryRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveAutoplayPoolEntryRequest;
+  static deserializeBinaryFromReader(message: RemoveAutoplayPoolEntryRequest, reader: jspb.BinaryReader): RemoveAutoplayPoolEntryRequest;
 }
 
-export namespace MarkAsActivelyModeratingRequest {
+export namespace RemoveAutoplayPoolEntryRequest {
   export type AsObject = {
+    id: string,
   }
 }
 
-export class MarkAsActivelyModeratingResponse extends jspb.Message {
+export class RemoveAutoplayPoolEntryResponse extends jspb.Message {
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): MarkAsActivelyModeratingResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: MarkAsActivelyModeratingResponse): MarkAsActivelyModeratingResponse.AsObject;
+  toObject(includeInstance?: boolean): RemoveAutoplayPoolEntryResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveAutoplayPoolEntryResponse): RemoveAutoplayPoolEntryResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: MarkAsActivelyModeratingResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): MarkAsActivelyModeratingResponse;
-  static deserializeBinaryFromReader(message: MarkAsActivelyModeratingResponse, reader: jspb.BinaryReader): MarkAsActivelyModeratingResponse;
+  static serializeBinaryToWriter(message: RemoveAutoplayPoolEntryResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveAutoplayPoolEntryResponse;
+  static deserializeBinaryFromReader(message: RemoveAutoplayPoolEntryResponse, reader: jspb.BinaryReader): RemoveAutoplayPoolEntryResponse;
 }
 
-export namespace MarkAsActivelyModeratingResponse {
+export namespace RemoveAutoplayPoolEntryResponse {
   export type AsObject = {
   }
 }
 
-export class StopActivelyModeratingRequest extends jspb.Message {
+export class QueueSnapshotsRequest extends jspb.Message {
+  hasPaginationParams(): boolean;
+  clearPaginationParams(): void;
+  getPaginationParams(): common_pb.PaginationParameters | undefined;
+  setPaginationParams(value?: common_pb.PaginationParameters): void;
+
+  getChannelId(): string;
+  setChannelId(value: string): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): StopActivelyModeratingRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: StopActivelyModeratingRequest): StopActivelyModeratingRequest.AsObject;
+  toObject(includeInstance?: boolean): QueueSnapshotsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: QueueSnapshotsRequest): QueueSnapshotsRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: StopActivelyModeratingRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): StopActivelyModeratingRequest;
-  static deserializeBinaryFromReader(message: StopActivelyModeratingRequest, reader: jspb.BinaryReader): StopActivelyModeratingRequest;
+  static serializeBinaryToWriter(message: QueueSnapshotsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): QueueSnapshotsRequest;
+  static deserializeBinaryFromReader(message: QueueSnapshotsRequest, reader: jspb.BinaryReader): QueueSnapshotsRequest;
 }
 
-export namespace StopActivelyModeratingRequest {
+export namespace QueueSnapshotsRequest {
   export type AsObject = {
+    paginationParams?: common_pb.PaginationParameters.AsObject,
+    channelId: string,
   }
 }
 
-export class StopActivelyModeratingResponse extends jspb.Message {
+export class QueueSnapshotSummary extends jspb.Message {
+  hasTakenAt(): boolean;
+  clearTakenAt(): void;
+  getTakenAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTakenAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getEntryCount(): number;
+  setEntryCount(value: number): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): StopActivelyModeratingResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: StopActivelyModeratingResponse): StopActivelyModeratingResponse.AsObject;
+  toObject(includeInstance?: boolean): QueueSnapshotSummary.AsObject;
+  static toObject(includeInstance: boolean, msg: QueueSnapshotSummary): QueueSnapshotSummary.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: StopActivelyModeratingResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): StopActivelyModeratingResponse;
-  static deserializeBinaryFromReader(message: StopActivelyModeratingResponse, reader: jspb.BinaryReader): StopActivelyModeratingResponse;
+  static serializeBinaryToWriter(message: QueueSnapshotSummary, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): QueueSnapshotSummary;
+  static deserializeBinaryFromReader(message: QueueSnapshotSummary, reader: jspb.BinaryReader): QueueSnapshotSummary;
 }
 
-export namespace StopActivelyModeratingResponse {
+export namespace QueueSnapshotSummary {
   export type AsObject = {
+    takenAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    entryCount: number,
   }
 }
 
-export class PointsInfoRequest extends jspb.Message {
+export class QueueSnapshotsResponse extends jspb.Message {
+  clearSnapshotsList(): void;
+  getSnapshotsList(): Array<QueueSnapshotSummary>;
+  setSnapshotsList(value: Array<QueueSnapshotSummary>): void;
+  addSnapshots(value?: QueueSnapshotSummary, index?: number): QueueSnapshotSummary;
+
+  getOffset(): number;
+  setOffset(value: number): void;
+
+  getTotal(): number;
+  setTotal(value: number): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): PointsInfoRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: PointsInfoRequest): PointsInfoRequest.AsObject;
+  toObject(includeInstance?: boolean): QueueSnapshotsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: QueueSnapshotsResponse): QueueSnapshotsResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: PointsInfoRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): PointsInfoRequest;
-  static deserializeBinaryFromReader(message: PointsInfoRequest, reader: jspb.BinaryReader): PointsInfoRequest;
+  static serializeBinaryToWriter(message: QueueSnapshotsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): QueueSnapshotsResponse;
+  static deserializeBinaryFromReader(message: QueueSnapshotsResponse, reader: jspb.BinaryReader): QueueSnapshotsResponse;
 }
 
-export namespace PointsInfoRequest {
+export namespace QueueSnapshotsResponse {
   export type AsObject = {
+    snapshotsList: Array<QueueSnapshotSummary.AsObject>,
+    offset: number,
+    total: number,
   }
 }
 
-export class PointsInfoResponse extends jspb.Message {
-  getBalance(): number;
-  setBalance(value: number): void;
+export class QueueSnapshotRequest extends jspb.Message {
+  hasTakenAt(): boolean;
+  clearTakenAt(): void;
+  getTakenAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTakenAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
 
-  hasCurrentSubscription(): boolean;
-  clearCurrentSubscription(): void;
-  getCurrentSubscription(): SubscriptionDetails | undefined;
-  setCurrentSubscription(value?: SubscriptionDetails): void;
+  getChannelId(): string;
+  setChannelId(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): PointsInfoResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: PointsInfoResponse): PointsInfoResponse.AsObject;
+  toObject(includeInstance?: boolean): QueueSnapshotRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: QueueSnapshotRequest): QueueSnapshotRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: PointsInfoResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): PointsInfoResponse;
-  static deserializeBinaryFromReader(message: PointsInfoResponse, reader: jspb.BinaryReader): PointsInfoResponse;
+  static serializeBinaryToWriter(message: QueueSnapshotRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): QueueSnapshotRequest;
+  static deserializeBinaryFromReader(message: QueueSnapshotRequest, reader: jspb.BinaryReader): QueueSnapshotRequest;
 }
 
-export namespace PointsInfoResponse {
+export namespace QueueSnapshotRequest {
   export type AsObject = {
-    balance: number,
-    currentSubscription?: SubscriptionDetails.AsObject,
+    takenAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    channelId: string,
   }
 }
 
-export class SubscriptionDetails extends jspb.Message {
-  hasSubscribedAt(): boolean;
-  clearSubscribedAt(): void;
-  getSubscribedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
-  setSubscribedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+export class QueueSnapshotResponse extends jspb.Message {
+  hasTakenAt(): boolean;
+  clearTakenAt(): void;
+  getTakenAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTakenAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
 
-  hasSubscribedUntil(): boolean;
-  clearSubscribedUntil(): void;
-  getSubscribedUntil(): google_protobuf_timestamp_pb.Timestamp | undefined;
-  setSubscribedUntil(value?: google_protobuf_timestamp_pb.Timestamp): void;
+  clearEntriesList(): void;
+  getEntriesList(): Array<QueueEntry>;
+  setEntriesList(value: Array<QueueEntry>): void;
+  addEntries(value?: QueueEntry, index?: number): QueueEntry;
+
+  hasInsertCursor(): boolean;
+  clearInsertCursor(): void;
+  getInsertCursor(): string;
+  setInsertCursor(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SubscriptionDetails.AsObject;
-  static toObject(includeInstance: boolean, msg: SubscriptionDetails): SubscriptionDetails.AsObject;
+  toObject(includeInstance?: boolean): QueueSnapshotResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: QueueSnapshotResponse): QueueSnapshotResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SubscriptionDetails, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SubscriptionDetails;
-  static deserializeBinaryFromReader(message: SubscriptionDetails, reader: jspb.BinaryReader): SubscriptionDetails;
+  static serializeBinaryToWriter(message: QueueSnapshotResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): QueueSnapshotResponse;
+  static deserializeBinaryFromReader(message: QueueSnapshotResponse, reader: jspb.BinaryReader): QueueSnapshotResponse;
 }
 
-export namespace SubscriptionDetails {
+export namespace QueueSnapshotResponse {
   export type AsObject = {
-    subscribedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
-    subscribedUntil?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    takenAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    entriesList: Array<QueueEntry.AsObject>,
+    insertCursor: string,
   }
 }
 
-export class PointsTransactionsRequest extends jspb.Message {
-  hasPaginationParams(): boolean;
-  clearPaginationParams(): void;
-  getPaginationParams(): common_pb.PaginationParameters | undefined;
-  setPaginationParams(value?: common_pb.PaginationParameters): void;
+export class RestoreQueueSnapshotRequest extends jspb.Message {
+  hasTakenAt(): boolean;
+  clearTakenAt(): void;
+  getTakenAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTakenAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getChannelId(): string;
+  setChannelId(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): PointsTransactionsRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: PointsTransactionsRequest): PointsTransactionsRequest.AsObject;
+  toObject(includeInstance?: boolean): RestoreQueueSnapshotRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RestoreQueueSnapshotRequest): RestoreQueueSnapshotRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: PointsTransactionsRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): PointsTransactionsRequest;
-  static deserializeBinaryFromReader(message: PointsTransactionsRequest, reader: jspb.BinaryReader): PointsTransactionsRequest;
+  static serializeBinaryToWriter(message: RestoreQueueSnapshotRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RestoreQueueSnapshotRequest;
+  static deserializeBinaryFromReader(message: RestoreQueueSnapshotRequest, reader: jspb.BinaryReader): RestoreQueueSnapshotRequest;
 }
 
-export namespace PointsTransactionsRequest {
+export namespace RestoreQueueSnapshotRequest {
   export type AsObject = {
-    paginationParams?: common_pb.PaginationParameters.AsObject,
+    takenAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    channelId: string,
   }
 }
 
-export class PointsTransactionsResponse extends jspb.Message {
-  clearTransactionsList(): void;
-  getTransactionsList(): Array<PointsTransaction>;
-  setTransactionsList(value: Array<PointsTransaction>): void;
-  addTransactions(value?: PointsTransaction, index?: number): PointsTransaction;
-
-  getOffset(): number;
-  setOffset(value: number): void;
-
-  getTotal(): number;
-  setTotal(value: number): void;
+export class RestoreQueueSnapshotResponse extends jspb.Message {
+  getRestoredEntryCount(): number;
+  setRestoredEntryCount(value: number): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): PointsTransactionsResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: PointsTransactionsResponse): PointsTransactionsResponse.AsObject;
+  toObject(includeInstance?: boolean): RestoreQueueSnapshotResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RestoreQueueSnapshotResponse): RestoreQueueSnapshotResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: PointsTransactionsResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): PointsTransactionsResponse;
-  static deserializeBinaryFromReader(message: PointsTransactionsResponse, reader: jspb.BinaryReader): PointsTransactionsResponse;
+  static serializeBinaryToWriter(message: RestoreQueueSnapshotResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RestoreQueueSnapshotResponse;
+  static deserializeBinaryFromReader(message: RestoreQueueSnapshotResponse, reader: jspb.BinaryReader): RestoreQueueSnapshotResponse;
 }
 
-export namespace PointsTransactionsResponse {
+export namespace RestoreQueueSnapshotResponse {
   export type AsObject = {
-    transactionsList: Array<PointsTransaction.AsObject>,
-    offset: number,
-    total: number,
+    restoredEntryCount: number,
   }
 }
 
-export class PointsTransaction extends jspb.Message {
+export class MediaReplayRule extends jspb.Message {
   getId(): string;
   setId(value: string): void;
 
-  getRewardsAddress(): string;
-  setRewardsAddress(value: string): void;
+  getMediaType(): string;
+  setMediaType(value: string): void;
 
-  hasCreatedAt(): boolean;
-  clearCreatedAt(): void;
-  getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
-  setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+  hasCollectionType(): boolean;
+  clearCollectionType(): void;
+  getCollectionType(): DisallowedMediaCollectionTypeMap[keyof DisallowedMediaCollectionTypeMap];
+  setCollectionType(value: DisallowedMediaCollectionTypeMap[keyof DisallowedMediaCollectionTypeMap]): void;
+
+  hasCollectionId(): boolean;
+  clearCollectionId(): void;
+  getCollectionId(): string;
+  setCollectionId(value: string): void;
+
+  hasRequester(): boolean;
+  clearRequester(): void;
+  getRequester(): string;
+  setRequester(value: string): void;
+
+  hasCooldown(): boolean;
+  clearCooldown(): void;
+  getCooldown(): google_protobuf_duration_pb.Duration | undefined;
+  setCooldown(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasOverlapTolerance(): boolean;
+  clearOverlapTolerance(): void;
+  getOverlapTolerance(): google_protobuf_duration_pb.Duration | undefined;
+  setOverlapTolerance(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasMaxBroadcastPlayTime(): boolean;
+  clearMaxBroadcastPlayTime(): void;
+  getMaxBroadcastPlayTime(): google_protobuf_duration_pb.Duration | undefined;
+  setMaxBroadcastPlayTime(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasUpdatedBy(): boolean;
+  clearUpdatedBy(): void;
+  getUpdatedBy(): common_pb.User | undefined;
+  setUpdatedBy(value?: common_pb.User): void;
 
   hasUpdatedAt(): boolean;
   clearUpdatedAt(): void;
   getUpdatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
   setUpdatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
 
-  getValue(): number;
-  setValue(value: number): void;
-
-  getType(): PointsTransactionTypeMap[keyof PointsTransactionTypeMap];
-  setType(value: PointsTransactionTypeMap[keyof PointsTransactionTypeMap]): void;
-
-  getExtraMap(): jspb.Map<string, string>;
-  clearExtraMap(): void;
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): PointsTransaction.AsObject;
-  static toObject(includeInstance: boolean, msg: PointsTransaction): PointsTransaction.AsObject;
+  toObject(includeInstance?: boolean): MediaReplayRule.AsObject;
+  static toObject(includeInstance: boolean, msg: MediaReplayRule): MediaReplayRule.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: PointsTransaction, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): PointsTransaction;
-  static deserializeBinaryFromReader(message: PointsTransaction, reader: jspb.BinaryReader): PointsTransaction;
+  static serializeBinaryToWriter(message: MediaReplayRule, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MediaReplayRule;
+  static deserializeBinaryFromReader(message: MediaReplayRule, reader: jspb.BinaryReader): MediaReplayRule;
 }
 
-export namespace PointsTransaction {
+export namespace MediaReplayRule {
   export type AsObject = {
     id: string,
-    rewardsAddress: string,
-    createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    mediaType: string,
+    collectionType: DisallowedMediaCollectionTypeMap[keyof DisallowedMediaCollectionTypeMap],
+    collectionId: string,
+    requester: string,
+    cooldown?: google_protobuf_duration_pb.Duration.AsObject,
+    overlapTolerance?: google_protobuf_duration_pb.Duration.AsObject,
+    maxBroadcastPlayTime?: google_protobuf_duration_pb.Duration.AsObject,
+    updatedBy?: common_pb.User.AsObject,
     updatedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
-    value: number,
-    type: PointsTransactionTypeMap[keyof PointsTransactionTypeMap],
-    extraMap: Array<[string, string]>,
   }
 }
 
-export class ChatGifSearchRequest extends jspb.Message {
-  getQuery(): string;
-  setQuery(value: string): void;
-
-  getCursor(): string;
-  setCursor(value: string): void;
+export class MediaReplayRulesRequest extends jspb.Message {
+  hasPaginationParams(): boolean;
+  clearPaginationParams(): void;
+  getPaginationParams(): common_pb.PaginationParameters | undefined;
+  setPaginationParams(value?: common_pb.PaginationParameters): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ChatGifSearchRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: ChatGifSearchRequest): ChatGifSearchRequest.AsObject;
+  toObject(includeInstance?: boolean): MediaReplayRulesRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: MediaReplayRulesRequest): MediaReplayRulesRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ChatGifSearchRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ChatGifSearchRequest;
-  static deserializeBinaryFromReader(message: ChatGifSearchRequest, reader: jspb.BinaryReader): ChatGifSearchRequest;
+  static serializeBinaryToWriter(message: MediaReplayRulesRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MediaReplayRulesRequest;
+  static deserializeBinaryFromReader(message: MediaReplayRulesRequest, reader: jspb.BinaryReader): MediaReplayRulesRequest;
 }
 
-export namespace ChatGifSearchRequest {
+export namespace MediaReplayRulesRequest {
   export type AsObject = {
-    query: string,
-    cursor: string,
+    paginationParams?: common_pb.PaginationParameters.AsObject,
   }
 }
 
-export class ChatGifSearchResponse extends jspb.Message {
-  clearResultsList(): void;
-  getResultsList(): Array<ChatGifSearchResult>;
-  setResultsList(value: Array<ChatGifSearchResult>): void;
-  addResults(value?: ChatGifSearchResult, index?: number): ChatGifSearchResult;
+export class MediaReplayRulesResponse extends jspb.Message {
+  clearRulesList(): void;
+  getRulesList(): Array<MediaReplayRule>;
+  setRulesList(value: Array<MediaReplayRule>): void;
+  addRules(value?: MediaReplayRule, index?: number): MediaReplayRule;
 
-  getNextCursor(): string;
-  setNextCursor(value: string): void;
+  getOffset(): number;
+  setOffset(value: number): void;
+
+  getTotal(): number;
+  setTotal(value: number): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ChatGifSearchResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: ChatGifSearchResponse): ChatGifSearchResponse.AsObject;
+  toObject(includeInstance?: boolean): MediaReplayRulesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: MediaReplayRulesResponse): MediaReplayRulesResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ChatGifSearchResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ChatGifSearchResponse;
-  static deserializeBinaryFromReader(message: ChatGifSearchResponse, reader: jspb.BinaryReader): ChatGifSearchResponse;
+  static serializeBinaryToWriter(message: MediaReplayRulesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MediaReplayRulesResponse;
+  static deserializeBinaryFromReader(message: MediaReplayRulesResponse, reader: jspb.BinaryReader): MediaReplayRulesResponse;
 }
 
-export namespace ChatGifSearchResponse {
+export namespace MediaReplayRulesResponse {
   export type AsObject = {
-    resultsList: Array<ChatGifSearchResult.AsObject>,
-    nextCursor: string,
+    rulesList: Array<MediaReplayRule.AsObject>,
+    offset: number,
+    total: number,
   }
 }
 
-export class ChatGifSearchResult extends jspb.Message {
+export class SetMediaReplayRuleRequest extends jspb.Message {
+  hasId(): boolean;
+  clearId(): void;
   getId(): string;
   setId(value: string): void;
 
-  getTitle(): string;
-  setTitle(value: string): void;
+  getMediaType(): string;
+  setMediaType(value: string): void;
 
-  getPreviewUrl(): string;
-  setPreviewUrl(value: string): void;
+  hasCollectionType(): boolean;
+  clearCollectionType(): void;
+  getCollectionType(): DisallowedMediaCollectionTypeMap[keyof DisallowedMediaCollectionTypeMap];
+  setCollectionType(value: DisallowedMediaCollectionTypeMap[keyof DisallowedMediaCollectionTypeMap]): void;
 
-  getPreviewFallbackUrl(): string;
-  setPreviewFallbackUrl(value: string): void;
+  hasCollectionId(): boolean;
+  clearCollectionId(): void;
+  getCollectionId(): string;
+  setCollectionId(value: string): void;
 
-  getWidth(): number;
-  setWidth(value: number): void;
+  hasRequester(): boolean;
+  clearRequester(): void;
+  getRequester(): string;
+  setRequester(value: string): void;
 
-  getHeight(): number;
-  setHeight(value: number): void;
+  hasCooldown(): boolean;
+  clearCooldown(): void;
+  getCooldown(): google_protobuf_duration_pb.Duration | undefined;
+  setCooldown(value?: google_protobuf_duration_pb.Duration): void;
 
-  getPointsCost(): number;
-  setPointsCost(value: number): void;
+  hasOverlapTolerance(): boolean;
+  clearOverlapTolerance(): void;
+  getOverlapTolerance(): google_protobuf_duration_pb.Duration | undefined;
+  setOverlapTolerance(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasMaxBroadcastPlayTime(): boolean;
+  clearMaxBroadcastPlayTime(): void;
+  getMaxBroadcastPlayTime(): google_protobuf_duration_pb.Duration | undefined;
+  setMaxBroadcastPlayTime(value?: google_protobuf_duration_pb.Duration): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ChatGifSearchResult.AsObject;
-  static toObject(includeInstance: boolean, msg: ChatGifSearchResult): ChatGifSearchResult.AsObject;
+  toObject(includeInstance?: boolean): SetMediaReplayRuleRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetMediaReplayRuleRequest): SetMediaReplayRuleRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ChatGifSearchResult, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ChatGifSearchResult;
-  static deserializeBinaryFromReader(message: ChatGifSearchResult, reader: jspb.BinaryReader): ChatGifSearchResult;
+  static serializeBinaryToWriter(message: SetMediaReplayRuleRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetMediaReplayRuleRequest;
+  static deserializeBinaryFromReader(message: SetMediaReplayRuleRequest, reader: jspb.BinaryReader): SetMediaReplayRuleRequest;
 }
 
-export namespace ChatGifSearchResult {
+export namespace SetMediaReplayRuleRequest {
   export type AsObject = {
     id: string,
-    title: string,
-    previewUrl: string,
-    previewFallbackUrl: string,
-    width: number,
-    height: number,
-    pointsCost: number,
+    mediaType: string,
+    collectionType: DisallowedMediaCollectionTypeMap[keyof DisallowedMediaCollectionTypeMap],
+    collectionId: string,
+    requester: string,
+    cooldown?: google_protobuf_duration_pb.Duration.AsObject,
+    overlapTolerance?: google_protobuf_duration_pb.Duration.AsObject,
+    maxBroadcastPlayTime?: google_protobuf_duration_pb.Duration.AsObject,
   }
 }
 
-export class AdjustPointsBalanceRequest extends jspb.Message {
-  getRewardsAddress(): string;
-  setRewardsAddress(value: string): void;
-
-  getValue(): number;
-  setValue(value: number): void;
-
-  getReason(): string;
-  setReason(value: string): void;
+export class SetMediaReplayRuleResponse extends jspb.Message {
+  hasRule(): boolean;
+  clearRule(): void;
+  getRule(): MediaReplayRule | undefined;
+  setRule(value?: MediaReplayRule): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): AdjustPointsBalanceRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: AdjustPointsBalanceRequest): AdjustPointsBalanceRequest.AsObject;
+  toObject(includeInstance?: boolean): SetMediaReplayRuleResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetMediaReplayRuleResponse): SetMediaReplayRuleResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: AdjustPointsBalanceRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): AdjustPointsBalanceRequest;
-  static deserializeBinaryFromReader(message: AdjustPointsBalanceRequest, reader: jspb.BinaryReader): AdjustPointsBalanceRequest;
+  static serializeBinaryToWriter(message: SetMediaReplayRuleResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetMediaReplayRuleResponse;
+  static deserializeBinaryFromReader(message: SetMediaReplayRuleResponse, reader: jspb.BinaryReader): SetMediaReplayRuleResponse;
 }
 
-export namespace AdjustPointsBalanceRequest {
+export namespace SetMediaReplayRuleResponse {
   export type AsObject = {
-    rewardsAddress: string,
-    value: number,
-    reason: string,
+    rule?: MediaReplayRule.AsObject,
   }
 }
 
-export class AdjustPointsBalanceResponse extends jspb.Message {
+export class RemoveMediaReplayRuleRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): AdjustPointsBalanceResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: AdjustPointsBalanceResponse): AdjustPointsBalanceResponse.AsObject;
+  toObject(includeInstance?: boolean): RemoveMediaReplayRuleRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveMediaReplayRuleRequest): RemoveMediaReplayRuleRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: AdjustPointsBalanceResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): AdjustPointsBalanceResponse;
-  static deserializeBinaryFromReader(message: AdjustPointsBalanceResponse, reader: jspb.BinaryReader): AdjustPointsBalanceResponse;
+  static serializeBinaryToWriter(message: RemoveMediaReplayRuleRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveMediaReplayRuleRequest;
+  static deserializeBinaryFromReader(message: RemoveMediaReplayRuleRequest, reader: jspb.BinaryReader): RemoveMediaReplayRuleRequest;
 }
 
-export namespace AdjustPointsBalanceResponse {
+export namespace RemoveMediaReplayRuleRequest {
   export type AsObject = {
+    id: string,
   }
 }
 
-export class ConvertBananoToPointsRequest extends jspb.Message {
+export class RemoveMediaReplayRuleResponse extends jspb.Message {
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ConvertBananoToPointsRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: ConvertBananoToPointsRequest): ConvertBananoToPointsRequest.AsObject;
+  toObject(includeInstance?: boolean): RemoveMediaReplayRuleResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveMediaReplayRuleResponse): RemoveMediaReplayRuleResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ConvertBananoToPointsRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ConvertBananoToPointsRequest;
-  static deserializeBinaryFromReader(message: ConvertBananoToPointsRequest, reader: jspb.BinaryReader): ConvertBananoToPointsRequest;
+  static serializeBinaryToWriter(message: RemoveMediaReplayRuleResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveMediaReplayRuleResponse;
+  static deserializeBinaryFromReader(message: RemoveMediaReplayRuleResponse, reader: jspb.BinaryReader): RemoveMediaReplayRuleResponse;
 }
 
-export namespace ConvertBananoToPointsRequest {
+export namespace RemoveMediaReplayRuleResponse {
   export type AsObject = {
   }
 }
 
-export class ConvertBananoToPointsStatus extends jspb.Message {
-  getPaymentAddress(): string;
-  setPaymentAddress(value: string): void;
+export class LibraryMediaFile extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
 
-  getBananoConverted(): string;
-  setBananoConverted(value: string): void;
+  getTitle(): string;
+  setTitle(value: string): void;
 
-  getPointsConverted(): number;
-  setPointsConverted(value: number): void;
+  getUrl(): string;
+  setUrl(value: string): void;
 
-  hasExpiration(): boolean;
-  clearExpiration(): void;
-  getExpiration(): google_protobuf_timestamp_pb.Timestamp | undefined;
-  setExpiration(value?: google_protobuf_timestamp_pb.Timestamp): void;
+  getFormat(): DirectMediaFormatMap[keyof DirectMediaFormatMap];
+  setFormat(value: DirectMediaFormatMap[keyof DirectMediaFormatMap]): void;
 
-  getExpired(): boolean;
-  setExpired(value: boolean): void;
+  hasDuration(): boolean;
+  clearDuration(): void;
+  getDuration(): google_protobuf_duration_pb.Duration | undefined;
+  setDuration(value?: google_protobuf_duration_pb.Duration): void;
+
+  getFileSize(): number;
+  setFileSize(value: number): void;
+
+  getPublic(): boolean;
+  setPublic(value: boolean): void;
+
+  hasUploadedBy(): boolean;
+  clearUploadedBy(): void;
+  getUploadedBy(): common_pb.User | undefined;
+  setUploadedBy(value?: common_pb.User): void;
+
+  hasUploadedAt(): boolean;
+  clearUploadedAt(): void;
+  getUploadedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setUploadedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ConvertBananoToPointsStatus.AsObject;
-  static toObject(includeInstance: boolean, msg: ConvertBananoToPointsStatus): ConvertBananoToPointsStatus.AsObject;
+  toObject(includeInstance?: boolean): LibraryMediaFile.AsObject;
+  static toObject(includeInstance: boolean, msg: LibraryMediaFile): LibraryMediaFile.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ConvertBananoToPointsStatus, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ConvertBananoToPointsStatus;
-  static deserializeBinaryFromReader(message: ConvertBananoToPointsStatus, reader: jspb.BinaryReader): ConvertBananoToPointsStatus;
+  static serializeBinaryToWriter(message: LibraryMediaFile, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LibraryMediaFile;
+  static deserializeBinaryFromReader(message: LibraryMediaFile, reader: jspb.BinaryReader): LibraryMediaFile;
 }
 
-export namespace ConvertBananoToPointsStatus {
+export namespace LibraryMediaFile {
   export type AsObject = {
-    paymentAddress: string,
-    bananoConverted: string,
-    pointsConverted: number,
-    expiration?: google_protobuf_timestamp_pb.Timestamp.AsObject,
-    expired: boolean,
+    id: string,
+    title: string,
+    url: string,
+    format: DirectMediaFormatMap[keyof DirectMediaFormatMap],
+    duration?: google_protobuf_duration_pb.Duration.AsObject,
+    fileSize: number,
+    pb_public: boolean,
+    uploadedBy?: common_pb.User.AsObject,
+    uploadedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
   }
 }
 
-export class StartOrExtendSubscriptionRequest extends jspb.Message {
+export class LibraryMediaRequest extends jspb.Message {
+  hasPaginationParams(): boolean;
+  clearPaginationParams(): void;
+  getPaginationParams(): common_pb.PaginationParameters | undefined;
+  setPaginationParams(value?: common_pb.PaginationParameters): void;
+
+  getSearchQuery(): string;
+  setSearchQuery(value: string): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): StartOrExtendSubscriptionRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: StartOrExtendSubscriptionRequest): StartOrExtendSubscriptionRequest.AsObject;
+  toObject(includeInstance?: boolean): LibraryMediaRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: LibraryMediaRequest): LibraryMediaRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: StartOrExtendSubscriptionRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): StartOrExtendSubscriptionRequest;
-  static deserializeBinaryFromReader(message: StartOrExtendSubscriptionRequest, reader: jspb.BinaryReader): StartOrExtendSubscriptionRequest;
+  static serializeBinaryToWriter(message: LibraryMediaRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LibraryMediaRequest;
+  static deserializeBinaryFromReader(message: LibraryMediaRequest, reader: jspb.BinaryReader): LibraryMediaRequest;
 }
 
-export namespace StartOrExtendSubscriptionRequest {
+export namespace LibraryMediaRequest {
   export type AsObject = {
+    paginationParams?: common_pb.PaginationParameters.AsObject,
+    searchQuery: string,
   }
 }
 
-export class StartOrExtendSubscriptionResponse extends jspb.Message {
-  hasSubscription(): boolean;
-  clearSubscription(): void;
-  getSubscription(): SubscriptionDetails | undefined;
-  setSubscription(value?: SubscriptionDetails): void;
+export class LibraryMediaResponse extends jspb.Message {
+  clearFilesList(): void;
+  getFilesList(): Array<LibraryMediaFile>;
+  setFilesList(value: Array<LibraryMediaFile>): void;
+  addFiles(value?: LibraryMediaFile, index?: number): LibraryMediaFile;
+
+  getOffset(): number;
+  setOffset(value: number): void;
+
+  getTotal(): number;
+  setTotal(value: number): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): StartOrExtendSubscriptionResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: StartOrExtendSubscriptionResponse): StartOrExtendSubscriptionResponse.AsObject;
+  toObject(includeInstance?: boolean): LibraryMediaResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: LibraryMediaResponse): LibraryMediaResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: StartOrExtendSubscriptionResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): StartOrExtendSubscriptionResponse;
-  static deserializeBinaryFromReader(message: StartOrExtendSubscriptionResponse, reader: jspb.BinaryReader): StartOrExtendSubscriptionResponse;
+  static serializeBinaryToWriter(message: LibraryMediaResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LibraryMediaResponse;
+  static deserializeBinaryFromReader(message: LibraryMediaResponse, reader: jspb.BinaryReader): LibraryMediaResponse;
 }
 
-export namespace StartOrExtendSubscriptionResponse {
+export namespace LibraryMediaResponse {
   export type AsObject = {
-    subscription?: SubscriptionDetails.AsObject,
+    filesList: Array<LibraryMediaFile.AsObject>,
+    offset: number,
+    total: number,
   }
 }
 
-export class SoundCloudTrackDetailsRequest extends jspb.Message {
-  getTrackUrl(): string;
-  setTrackUrl(value: string): void;
+export class UploadLibraryMediaRequest extends jspb.Message {
+  getTitle(): string;
+  setTitle(value: string): void;
+
+  getContent(): Uint8Array | string;
+  getContent_asU8(): Uint8Array;
+  getContent_asB64(): string;
+  setContent(value: Uint8Array | string): void;
+
+  getPublic(): boolean;
+  setPublic(value: boolean): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SoundCloudTrackDetailsRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: SoundCloudTrackDetailsRequest): SoundCloudTrackDetailsRequest.AsObject;
+  toObject(includeInstance?: boolean): UploadLibraryMediaRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: UploadLibraryMediaRequest): UploadLibraryMediaRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SoundCloudTrackDetailsRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SoundCloudTrackDetailsRequest;
-  static deserializeBinaryFromReader(message: SoundCloudTrackDetailsRequest, reader: jspb.BinaryReader): SoundCloudTrackDetailsRequest;
+  static serializeBinaryToWriter(message: UploadLibraryMediaRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UploadLibraryMediaRequest;
+  static deserializeBinaryFromReader(message: UploadLibraryMediaRequest, reader: jspb.BinaryReader): UploadLibraryMediaRequest;
 }
 
-export namespace SoundCloudTrackDetailsRequest {
+export namespace UploadLibraryMediaRequest {
   export type AsObject = {
-    trackUrl: string,
+    title: string,
+    content: Uint8Array | string,
+    pb_public: boolean,
   }
 }
 
-export class SoundCloudTrackDetailsResponse extends jspb.Message {
-  hasLength(): boolean;
-  clearLength(): void;
-  getLength(): google_protobuf_duration_pb.Duration | undefined;
-  setLength(value?: google_protobuf_duration_pb.Duration): void;
+export class UploadLibraryMediaResponse extends jspb.Message {
+  hasFile(): boolean;
+  clearFile(): void;
+  getFile(): LibraryMediaFile | undefined;
+  setFile(value?: LibraryMediaFile): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SoundCloudTrackDetailsResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: SoundCloudTrackDetailsResponse): SoundCloudTrackDetailsResponse.AsObject;
+  toObject(includeInstance?: boolean): UploadLibraryMediaResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: UploadLibraryMediaResponse): UploadLibraryMediaResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SoundCloudTrackDetailsResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SoundCloudTrackDetailsResponse;
-  static deserializeBinaryFromReader(message: SoundCloudTrackDetailsResponse, reader: jspb.BinaryReader): SoundCloudTrackDetailsResponse;
+  static serializeBinaryToWriter(message: UploadLibraryMediaResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UploadLibraryMediaResponse;
+  static deserializeBinaryFromReader(message: UploadLibraryMediaResponse, reader: jspb.BinaryReader): UploadLibraryMediaResponse;
 }
 
-export namespace SoundCloudTrackDetailsResponse {
+export namespace UploadLibraryMediaResponse {
   export type AsObject = {
-    length?: google_protobuf_duration_pb.Duration.AsObject,
+    file?: LibraryMediaFile.AsObject,
   }
 }
 
-export class AddVipUserRequest extends jspb.Message {
-  getRewardsAddress(): string;
-  setRewardsAddress(value: string): void;
-
-  getAppearance(): VipUserAppearanceMap[keyof VipUserAppearanceMap];
-  setAppearance(value: VipUserAppearanceMap[keyof VipUserAppearanceMap]): void;
+export class RemoveLibraryMediaRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): AddVipUserRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: AddVipUserRequest): AddVipUserRequest.AsObject;
+  toObject(includeInstance?: boolean): RemoveLibraryMediaRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveLibraryMediaRequest): RemoveLibraryMediaRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: AddVipUserRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): AddVipUserRequest;
-  static deserializeBinaryFromReader(message: AddVipUserRequest, reader: jspb.BinaryReader): AddVipUserRequest;
+  static serializeBinaryToWriter(message: RemoveLibraryMediaRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveLibraryMediaRequest;
+  static deserializeBinaryFromReader(message: RemoveLibraryMediaRequest, reader: jspb.BinaryReader): RemoveLibraryMediaRequest;
 }
 
-export namespace AddVipUserRequest {
+export namespace RemoveLibraryMediaRequest {
   export type AsObject = {
-    rewardsAddress: string,
-    appearance: VipUserAppearanceMap[keyof VipUserAppearanceMap],
+    id: string,
   }
 }
 
-export class AddVipUserResponse extends jspb.Message {
+export class RemoveLibraryMediaResponse extends jspb.Message {
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): AddVipUserResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: AddVipUserResponse): AddVipUserResponse.AsObject;
+  toObject(includeInstance?: boolean): RemoveLibraryMediaResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveLibraryMediaResponse): RemoveLibraryMediaResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: AddVipUserResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): AddVipUserResponse;
-  static deserializeBinaryFromReader(message: AddVipUserResponse, reader: jspb.BinaryReader): AddVipUserResponse;
+  static serializeBinaryToWriter(message: RemoveLibraryMediaResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveLibraryMediaResponse;
+  static deserializeBinaryFromReader(message: RemoveLibraryMediaResponse, reader: jspb.BinaryReader): RemoveLibraryMediaResponse;
+}
+
+export namespace RemoveLibraryMediaResponse {
+  export type AsObject = {
+  }
+}
+
+export class PlaylistFile extends jspb.Message {
+  getFileName(): string;
+  setFileName(value: string): void;
+
+  getContentType(): string;
+  setContentType(value: string): void;
+
+  getContent(): Uint8Array | string;
+  getContent_asU8(): Uint8Array;
+  getContent_asB64(): string;
+  setContent(value: Uint8Array | string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PlaylistFile.AsObject;
+  static toObject(includeInstance: boolean, msg: PlaylistFile): PlaylistFile.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PlaylistFile, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PlaylistFile;
+  static deserializeBinaryFromReader(message: PlaylistFile, reader: jspb.BinaryReader): PlaylistFile;
 }
 
-export namespace AddVipUserResponse {
+export namespace PlaylistFile {
   export type AsObject = {
+    fileName: string,
+    contentType: string,
+    content: Uint8Array | string,
   }
 }
 
-export class RemoveVipUserRequest extends jspb.Message {
-  getRewardsAddress(): string;
-  setRewardsAddress(value: string): void;
+export class ExportQueueRequest extends jspb.Message {
+  getChannelId(): string;
+  setChannelId(value: string): void;
+
+  getFormat(): PlaylistFormatMap[keyof PlaylistFormatMap];
+  setFormat(value: PlaylistFormatMap[keyof PlaylistFormatMap]): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): RemoveVipUserRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: RemoveVipUserRequest): RemoveVipUserRequest.AsObject;
+  toObject(includeInstance?: boolean): ExportQueueRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ExportQueueRequest): ExportQueueRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: RemoveVipUserRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): RemoveVipUserRequest;
-  static deserializeBinaryFromReader(message: RemoveVipUserRequest, reader: jspb.BinaryReader): RemoveVipUserRequest;
+  static serializeBinaryToWriter(message: ExportQueueRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExportQueueRequest;
+  static deserializeBinaryFromReader(message: ExportQueueRequest, reader: jspb.BinaryReader): ExportQueueRequest;
 }
 
-export namespace RemoveVipUserRequest {
+export namespace ExportQueueRequest {
   export type AsObject = {
-    rewardsAddress: string,
+    channelId: string,
+    format: PlaylistFormatMap[keyof PlaylistFormatMap],
   }
 }
 
-export class RemoveVipUserResponse extends jspb.Message {
+export class ExportQueueResponse extends jspb.Message {
+  hasFile(): boolean;
+  clearFile(): void;
+  getFile(): PlaylistFile | undefined;
+  setFile(value?: PlaylistFile): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): RemoveVipUserResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: RemoveVipUserResponse): RemoveVipUserResponse.AsObject;
+  toObject(includeInstance?: boolean): ExportQueueResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ExportQueueResponse): ExportQueueResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: RemoveVipUserResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): RemoveVipUserResponse;
-  static deserializeBinaryFromReader(message: RemoveVipUserResponse, reader: jspb.BinaryReader): RemoveVipUserResponse;
+  static serializeBinaryToWriter(message: ExportQueueResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExportQueueResponse;
+  static deserializeBinaryFromReader(message: ExportQueueResponse, reader: jspb.BinaryReader): ExportQueueResponse;
 }
 
-export namespace RemoveVipUserResponse {
+export namespace ExportQueueResponse {
   export type AsObject = {
+    file?: PlaylistFile.AsObject,
   }
 }
 
-export class TriggerClientReloadRequest extends jspb.Message {
+export class ExportPlayedMediaRequest extends jspb.Message {
+  getChannelId(): string;
+  setChannelId(value: string): void;
+
+  getFormat(): PlaylistFormatMap[keyof PlaylistFormatMap];
+  setFormat(value: PlaylistFormatMap[keyof PlaylistFormatMap]): void;
+
+  hasStartedSince(): boolean;
+  clearStartedSince(): void;
+  getStartedSince(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setStartedSince(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasStartedUntil(): boolean;
+  clearStartedUntil(): void;
+  getStartedUntil(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setStartedUntil(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): TriggerClientReloadRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: TriggerClientReloadRequest): TriggerClientReloadRequest.AsObject;
+  toObject(includeInstance?: boolean): ExportPlayedMediaRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ExportPlayedMediaRequest): ExportPlayedMediaRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: TriggerClientReloadRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): TriggerClientReloadRequest;
-  static deserializeBinaryFromReader(message: TriggerClientReloadRequest, reader: jspb.BinaryReader): TriggerClientReloadRequest;
+  static serializeBinaryToWriter(message: ExportPlayedMediaRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExportPlayedMediaRequest;
+  static deserializeBinaryFromReader(message: ExportPlayedMediaRequest, reader: jspb.BinaryReader): ExportPlayedMediaRequest;
 }
 
-export namespace TriggerClientReloadRequest {
+export namespace ExportPlayedMediaRequest {
   export type AsObject = {
+    channelId: string,
+    format: PlaylistFormatMap[keyof PlaylistFormatMap],
+    startedSince?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    startedUntil?: google_protobuf_timestamp_pb.Timestamp.AsObject,
   }
 }
 
-export class TriggerClientReloadResponse extends jspb.Message {
+export class ExportPlayedMediaResponse extends jspb.Message {
+  hasFile(): boolean;
+  clearFile(): void;
+  getFile(): PlaylistFile | undefined;
+  setFile(value?: PlaylistFile): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): TriggerClientReloadResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: TriggerClientReloadResponse): TriggerClientReloadResponse.AsObject;
+  toObject(includeInstance?: boolean): ExportPlayedMediaResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ExportPlayedMediaResponse): ExportPlayedMediaResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: TriggerClientReloadResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): TriggerClientReloadResponse;
-  static deserializeBinaryFromReader(message: TriggerClientReloadResponse, reader: jspb.BinaryReader): TriggerClientReloadResponse;
+  static serializeBinaryToWriter(message: ExportPlayedMediaResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExportPlayedMediaResponse;
+  static deserializeBinaryFromReader(message: ExportPlayedMediaResponse, reader: jspb.BinaryReader): ExportPlayedMediaResponse;
 }
 
-export namespace TriggerClientReloadResponse {
+export namespace ExportPlayedMediaResponse {
   export type AsObject = {
+    file?: PlaylistFile.AsObject,
   }
 }
 
-export class IncreaseOrReduceSkipThresholdRequest extends jspb.Message {
-  getIncrease(): boolean;
-  setIncrease(value: boolean): void;
+export class ImportQueueRequest extends jspb.Message {
+  getChannelId(): string;
+  setChannelId(value: string): void;
+
+  getFormat(): PlaylistFormatMap[keyof PlaylistFormatMap];
+  setFormat(value: PlaylistFormatMap[keyof PlaylistFormatMap]): void;
+
+  getContent(): Uint8Array | string;
+  getContent_asU8(): Uint8Array;
+  getContent_asB64(): string;
+  setContent(value: Uint8Array | string): void;
+
+  getSkipDuplicationChecks(): boolean;
+  setSkipDuplicationChecks(value: boolean): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): IncreaseOrReduceSkipThresholdRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: IncreaseOrReduceSkipThresholdRequest): IncreaseOrReduceSkipThresholdRequest.AsObject;
+  toObject(includeInstance?: boolean): ImportQueueRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ImportQueueRequest): ImportQueueRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: IncreaseOrReduceSkipThresholdRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): IncreaseOrReduceSkipThresholdRequest;
-  static deserializeBinaryFromReader(message: IncreaseOrReduceSkipThresholdRequest, reader: jspb.BinaryReader): IncreaseOrReduceSkipThresholdRequest;
+  static serializeBinaryToWriter(message: ImportQueueRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ImportQueueRequest;
+  static deserializeBinaryFromReader(message: ImportQueueRequest, reader: jspb.BinaryReader): ImportQueueRequest;
 }
 
-export namespace IncreaseOrReduceSkipThresholdRequest {
+export namespace ImportQueueRequest {
   export type AsObject = {
-    increase: boolean,
+    channelId: string,
+    format: PlaylistFormatMap[keyof PlaylistFormatMap],
+    content: Uint8Array | string,
+    skipDuplicationChecks: boolean,
   }
 }
 
-export class IncreaseOrReduceSkipThresholdResponse extends jspb.Message {
+export class ImportQueueResponse extends jspb.Message {
+  getEnqueuedCount(): number;
+  setEnqueuedCount(value: number): void;
+
+  clearFailedItemsList(): void;
+  getFailedItemsList(): Array<EnqueueMediaFailedItem>;
+  setFailedItemsList(value: Array<EnqueueMediaFailedItem>): void;
+  addFailedItems(value?: EnqueueMediaFailedItem, index?: number): EnqueueMediaFailedItem;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): IncreaseOrReduceSkipThresholdResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: IncreaseOrReduceSkipThresholdResponse): IncreaseOrReduceSkipThresholdResponse.AsObject;
+  toObject(includeInstance?: boolean): ImportQueueResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ImportQueueResponse): ImportQueueResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: IncreaseOrReduceSkipThresholdResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): IncreaseOrReduceSkipThresholdResponse;
-  static deserializeBinaryFromReader(message: IncreaseOrReduceSkipThresholdResponse, reader: jspb.BinaryReader): IncreaseOrReduceSkipThresholdResponse;
+  static serializeBinaryToWriter(message: ImportQueueResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ImportQueueResponse;
+  static deserializeBinaryFromReader(message: ImportQueueResponse, reader: jspb.BinaryReader): ImportQueueResponse;
 }
 
-export namespace IncreaseOrReduceSkipThresholdResponse {
+export namespace ImportQueueResponse {
   export type AsObject = {
+    enqueuedCount: number,
+    failedItemsList: Array<EnqueueMediaFailedItem.AsObject>,
   }
 }
 
-export class SetMulticurrencyPaymentsEnabledRequest extends jspb.Message {
-  getEnabled(): boolean;
-  setEnabled(value: boolean): void;
+export class PricingRule extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getLabel(): string;
+  setLabel(value: string): void;
+
+  getAppliesToEnqueue(): boolean;
+  setAppliesToEnqueue(value: boolean): void;
+
+  getAppliesToCrowdfundedSkip(): boolean;
+  setAppliesToCrowdfundedSkip(value: boolean): void;
+
+  getAppliesToQueueEntryMovement(): boolean;
+  setAppliesToQueueEntryMovement(value: boolean): void;
+
+  getMultiplier(): number;
+  setMultiplier(value: number): void;
+
+  clearWeekdaysList(): void;
+  getWeekdaysList(): Array<number>;
+  setWeekdaysList(value: Array<number>): void;
+  addWeekdays(value: number, index?: number): number;
+
+  hasStartTimeOfDay(): boolean;
+  clearStartTimeOfDay(): void;
+  getStartTimeOfDay(): google_protobuf_duration_pb.Duration | undefined;
+  setStartTimeOfDay(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasEndTimeOfDay(): boolean;
+  clearEndTimeOfDay(): void;
+  getEndTimeOfDay(): google_protobuf_duration_pb.Duration | undefined;
+  setEndTimeOfDay(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasMinQueueLength(): boolean;
+  clearMinQueueLength(): void;
+  getMinQueueLength(): number;
+  setMinQueueLength(value: number): void;
+
+  hasMaxQueueLength(): boolean;
+  clearMaxQueueLength(): void;
+  getMaxQueueLength(): number;
+  setMaxQueueLength(value: number): void;
+
+  hasMinSpectators(): boolean;
+  clearMinSpectators(): void;
+  getMinSpectators(): number;
+  setMinSpectators(value: number): void;
+
+  hasMaxSpectators(): boolean;
+  clearMaxSpectators(): void;
+  getMaxSpectators(): number;
+  setMaxSpectators(value: number): void;
+
+  hasActiveFrom(): boolean;
+  clearActiveFrom(): void;
+  getActiveFrom(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setActiveFrom(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasActiveUntil(): boolean;
+  clearActiveUntil(): void;
+  getActiveUntil(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setActiveUntil(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasUpdatedBy(): boolean;
+  clearUpdatedBy(): void;
+  getUpdatedBy(): common_pb.User | undefined;
+  setUpdatedBy(value?: common_pb.User): void;
+
+  hasUpdatedAt(): boolean;
+  clearUpdatedAt(): void;
+  getUpdatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setUpdatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetMulticurrencyPaymentsEnabledRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: SetMulticurrencyPaymentsEnabledRequest): SetMulticurrencyPaymentsEnabledRequest.AsObject;
+  toObject(includeInstance?: boolean): PricingRule.AsObject;
+  static toObject(includeInstance: boolean, msg: PricingRule): PricingRule.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetMulticurrencyPaymentsEnabledRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetMulticurrencyPaymentsEnabledRequest;
-  static deserializeBinaryFromReader(message: SetMulticurrencyPaymentsEnabledRequest, reader: jspb.BinaryReader): SetMulticurrencyPaymentsEnabledRequest;
+  static serializeBinaryToWriter(message: PricingRule, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PricingRule;
+  static deserializeBinaryFromReader(message: PricingRule, reader: jspb.BinaryReader): PricingRule;
 }
 
-export namespace SetMulticurrencyPaymentsEnabledRequest {
+export namespace PricingRule {
   export type AsObject = {
-    enabled: boolean,
+    id: string,
+    label: string,
+    appliesToEnqueue: boolean,
+    appliesToCrowdfundedSkip: boolean,
+    appliesToQueueEntryMovement: boolean,
+    multiplier: number,
+    weekdaysList: Array<number>,
+    startTimeOfDay?: google_protobuf_duration_pb.Duration.AsObject,
+    endTimeOfDay?: google_protobuf_duration_pb.Duration.AsObject,
+    minQueueLength: number,
+    maxQueueLength: number,
+    minSpectators: number,
+    maxSpectators: number,
+    activeFrom?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    activeUntil?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    updatedBy?: common_pb.User.AsObject,
+    updatedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
   }
 }
 
-export class SetMulticurrencyPaymentsEnabledResponse extends jspb.Message {
+export class PricingRulesRequest extends jspb.Message {
+  getChannelId(): string;
+  setChannelId(value: string): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetMulticurrencyPaymentsEnabledResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: SetMulticurrencyPaymentsEnabledResponse): SetMulticurrencyPaymentsEnabledResponse.AsObject;
+  toObject(includeInstance?: boolean): PricingRulesRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: PricingRulesRequest): PricingRulesRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetMulticurrencyPaymentsEnabledResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetMulticurrencyPaymentsEnabledResponse;
-  static deserializeBinaryFromReader(message: SetMulticurrencyPaymentsEnabledResponse, reader: jspb.BinaryReader): SetMulticurrencyPaymentsEnabledResponse;
+  static serializeBinaryToWriter(message: PricingRulesRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PricingRulesRequest;
+  static deserializeBinaryFromReader(message: PricingRulesRequest, reader: jspb.BinaryReader): PricingRulesRequest;
 }
 
-export namespace SetMulticurrencyPaymentsEnabledResponse {
+export namespace PricingRulesRequest {
   export type AsObject = {
+    channelId: string,
   }
 }
 
-export class CheckMediaEnqueuingPasswordRequest extends jspb.Message {
-  getPassword(): string;
-  setPassword(value: string): void;
+export class PricingRulesResponse extends jspb.Message {
+  clearRulesList(): void;
+  getRulesList(): Array<PricingRule>;
+  setRulesList(value: Array<PricingRule>): void;
+  addRules(value?: PricingRule, index?: number): PricingRule;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): CheckMediaEnqueuingPasswordRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: CheckMediaEnqueuingPasswordRequest): CheckMediaEnqueuingPasswordRequest.AsObject;
+  toObject(includeInstance?: boolean): PricingRulesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: PricingRulesResponse): PricingRulesResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: CheckMediaEnqueuingPasswordRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): CheckMediaEnqueuingPasswordRequest;
-  static deserializeBinaryFromReader(message: CheckMediaEnqueuingPasswordRequest, reader: jspb.BinaryReader): CheckMediaEnqueuingPasswordRequest;
+  static serializeBinaryToWriter(message: PricingRulesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PricingRulesResponse;
+  static deserializeBinaryFromReader(message: PricingRulesResponse, reader: jspb.BinaryReader): PricingRulesResponse;
 }
 
-export namespace CheckMediaEnqueuingPasswordRequest {
+export namespace PricingRulesResponse {
   export type AsObject = {
-    password: string,
+    rulesList: Array<PricingRule.AsObject>,
   }
 }
 
-export class CheckMediaEnqueuingPasswordResponse extends jspb.Message {
-  getPasswordEdition(): string;
-  setPasswordEdition(value: string): void;
+export class SetPricingRuleRequest extends jspb.Message {
+  getChannelId(): string;
+  setChannelId(value: string): void;
+
+  hasRule(): boolean;
+  clearRule(): void;
+  getRule(): PricingRule | undefined;
+  setRule(value?: PricingRule): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): CheckMediaEnqueuingPasswordResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: CheckMediaEnqueuingPasswordResponse): CheckMediaEnqueuingPasswordResponse.AsObject;
+  toObject(includeInstance?: boolean): SetPricingRuleRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetPricingRuleRequest): SetPricingRuleRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: CheckMediaEnqueuingPasswordResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): CheckMediaEnqueuingPasswordResponse;
-  static deserializeBinaryFromReader(message: CheckMediaEnqueuingPasswordResponse, reader: jspb.BinaryReader): CheckMediaEnqueuingPasswordResponse;
+  static serializeBinaryToWriter(message: SetPricingRuleRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetPricingRuleRequest;
+  static deserializeBinaryFromReader(message: SetPricingRuleRequest, reader: jspb.BinaryReader): SetPricingRuleRequest;
 }
 
-export namespace CheckMediaEnqueuingPasswordResponse {
+export namespace SetPricingRuleRequest {
   export type AsObject = {
-    passwordEdition: string,
+    channelId: string,
+    rule?: PricingRule.AsObject,
   }
 }
 
-export class MonitorMediaEnqueuingPermissionRequest extends jspb.Message {
+export class SetPricingRuleResponse extends jspb.Message {
+  hasRule(): boolean;
+  clearRule(): void;
+  getRule(): PricingRule | undefined;
+  setRule(value?: PricingRule): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): MonitorMediaEnqueuingPermissionRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: MonitorMediaEnqueuingPermissionRequest): MonitorMediaEnqueuingPermissionRequest.AsObject;
+  toObject(includeInstance?: boolean): SetPricingRuleResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetPricingRuleResponse): SetPricingRuleResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: MonitorMediaEnqueuingPermissionRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): MonitorMediaEnqueuingPermissionRequest;
-  static deserializeBinaryFromReader(message: MonitorMediaEnqueuingPermissionRequest, reader: jspb.BinaryReader): MonitorMediaEnqueuingPermissionRequest;
+  static serializeBinaryToWriter(message: SetPricingRuleResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetPricingRuleResponse;
+  static deserializeBinaryFromReader(message: SetPricingRuleResponse, reader: jspb.BinaryReader): SetPricingRuleResponse;
 }
 
-export namespace MonitorMediaEnqueuingPermissionRequest {
+export namespace SetPricingRuleResponse {
   export type AsObject = {
+    rule?: PricingRule.AsObject,
   }
 }
 
-export class MediaEnqueuingPermissionStatus extends jspb.Message {
-  getAllowedMediaEnqueuing(): AllowedMediaEnqueuingTypeMap[keyof AllowedMediaEnqueuingTypeMap];
-  setAllowedMediaEnqueuing(value: AllowedMediaEnqueuingTypeMap[keyof AllowedMediaEnqueuingTypeMap]): void;
+export class RemovePricingRuleRequest extends jspb.Message {
+  getChannelId(): string;
+  setChannelId(value: string): void;
 
-  getPasswordEdition(): string;
-  setPasswordEdition(value: string): void;
+  getId(): string;
+  setId(value: string): void;
 
-  getPasswordIsNumeric(): boolean;
-  setPasswordIsNumeric(value: boolean): void;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RemovePricingRuleRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RemovePricingRuleRequest): RemovePricingRuleRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: RemovePricingRuleRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemovePricingRuleRequest;
+  static deserializeBinaryFromReader(message: RemovePricingRuleRequest, reader: jspb.BinaryReader): RemovePricingRuleRequest;
+}
 
-  getHasElevatedPrivileges(): boolean;
-  setHasElevatedPrivileges(value: boolean): void;
+export namespace RemovePricingRuleRequest {
+  export type AsObject = {
+    channelId: string,
+    id: string,
+  }
+}
 
+export class RemovePricingRuleResponse extends jspb.Message {
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): MediaEnqueuingPermissionStatus.AsObject;
-  static toObject(includeInstance: boolean, msg: MediaEnqueuingPermissionStatus): MediaEnqueuingPermissionStatus.AsObject;
+  toObject(includeInstance?: boolean): RemovePricingRuleResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RemovePricingRuleResponse): RemovePricingRuleResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: MediaEnqueuingPermissionStatus, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): MediaEnqueuingPermissionStatus;
-  static deserializeBinaryFromReader(message: MediaEnqueuingPermissionStatus, reader: jspb.BinaryReader): MediaEnqueuingPermissionStatus;
+  static serializeBinaryToWriter(message: RemovePricingRuleResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemovePricingRuleResponse;
+  static deserializeBinaryFromReader(message: RemovePricingRuleResponse, reader: jspb.BinaryReader): RemovePricingRuleResponse;
 }
 
-export namespace MediaEnqueuingPermissionStatus {
+export namespace RemovePricingRuleResponse {
   export type AsObject = {
-    allowedMediaEnqueuing: AllowedMediaEnqueuingTypeMap[keyof AllowedMediaEnqueuingTypeMap],
-    passwordEdition: string,
-    passwordIsNumeric: boolean,
-    hasElevatedPrivileges: boolean,
   }
 }
 
-export class InvalidateAuthTokensRequest extends jspb.Message {
+export class SimulatePricingRequest extends jspb.Message {
+  getChannelId(): string;
+  setChannelId(value: string): void;
+
+  hasTime(): boolean;
+  clearTime(): void;
+  getTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTime(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getQueueLength(): number;
+  setQueueLength(value: number): void;
+
+  getEligibleSpectators(): number;
+  setEligibleSpectators(value: number): void;
+
+  hasMediaLength(): boolean;
+  clearMediaLength(): void;
+  getMediaLength(): google_protobuf_duration_pb.Duration | undefined;
+  setMediaLength(value?: google_protobuf_duration_pb.Duration): void;
+
+  getUnskippable(): boolean;
+  setUnskippable(value: boolean): void;
+
+  getConcealed(): boolean;
+  setConcealed(value: boolean): void;
+
+  getRecentCrowdfundedSkips(): number;
+  setRecentCrowdfundedSkips(value: number): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): InvalidateAuthTokensRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: InvalidateAuthTokensRequest): InvalidateAuthTokensRequest.AsObject;
+  toObject(includeInstance?: boolean): SimulatePricingRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SimulatePricingRequest): SimulatePricingRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: InvalidateAuthTokensRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): InvalidateAuthTokensRequest;
-  static deserializeBinaryFromReader(message: InvalidateAuthTokensRequest, reader: jspb.BinaryReader): InvalidateAuthTokensRequest;
+  static serializeBinaryToWriter(message: SimulatePricingRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SimulatePricingRequest;
+  static deserializeBinaryFromReader(message: SimulatePricingRequest, reader: jspb.BinaryReader): SimulatePricingRequest;
 }
 
-export namespace InvalidateAuthTokensRequest {
+export namespace SimulatePricingRequest {
   export type AsObject = {
+    channelId: string,
+    time?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    queueLength: number,
+    eligibleSpectators: number,
+    mediaLength?: google_protobuf_duration_pb.Duration.AsObject,
+    unskippable: boolean,
+    concealed: boolean,
+    recentCrowdfundedSkips: number,
   }
 }
 
-export class InvalidateAuthTokensResponse extends jspb.Message {
+export class SimulatePricingResponse extends jspb.Message {
+  getEnqueuePrice(): string;
+  setEnqueuePrice(value: string): void;
+
+  getPlayNextPrice(): string;
+  setPlayNextPrice(value: string): void;
+
+  getPlayNowPrice(): string;
+  setPlayNowPrice(value: string): void;
+
+  getCrowdfundedSkipPrice(): string;
+  setCrowdfundedSkipPrice(value: string): void;
+
+  getQueueEntryMovementCost(): number;
+  setQueueEntryMovementCost(value: number): void;
+
+  getSubscriberQueueEntryMovementCost(): number;
+  setSubscriberQueueEntryMovementCost(value: number): void;
+
+  clearMatchingEnqueueRuleIdsList(): void;
+  getMatchingEnqueueRuleIdsList(): Array<string>;
+  setMatchingEnqueueRuleIdsList(value: Array<string>): void;
+  addMatchingEnqueueRuleIds(value: string, index?: number): string;
+
+  clearMatchingCrowdfundedSkipRuleIdsList(): void;
+  getMatchingCrowdfundedSkipRuleIdsList(): Array<string>;
+  setMatchingCrowdfundedSkipRuleIdsList(value: Array<string>): void;
+  addMatchingCrowdfundedSkipRuleIds(value: string, index?: number): string;
+
+  clearMatchingQueueEntryMovementRuleIdsList(): void;
+  getMatchingQueueEntryMovementRuleIdsList(): Array<string>;
+  setMatchingQueueEntryMovementRuleIdsList(value: Array<string>): void;
+  addMatchingQueueEntryMovementRuleIds(value: string, index?: number): string;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): InvalidateAuthTokensResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: InvalidateAuthTokensResponse): InvalidateAuthTokensResponse.AsObject;
+  toObject(includeInstance?: boolean): SimulatePricingResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SimulatePricingResponse): SimulatePricingResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: InvalidateAuthTokensResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): InvalidateAuthTokensResponse;
-  static deserializeBinaryFromReader(message: InvalidateAuthTokensResponse, reader: jspb.BinaryReader): InvalidateAuthTokensResponse;
+  static serializeBinaryToWriter(message: SimulatePricingResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SimulatePricingResponse;
+  static deserializeBinaryFromReader(message: SimulatePricingResponse, reader: jspb.BinaryReader): SimulatePricingResponse;
 }
 
-export namespace InvalidateAuthTokensResponse {
+export namespace SimulatePricingResponse {
   export type AsObject = {
+    enqueuePrice: string,
+    playNextPrice: string,
+    playNowPrice: string,
+    crowdfundedSkipPrice: string,
+    queueEntryMovementCost: number,
+    subscriberQueueEntryMovementCost: number,
+    matchingEnqueueRuleIdsList: Array<string>,
+    matchingCrowdfundedSkipRuleIdsList: Array<string>,
+    matchingQueueEntryMovementRuleIdsList: Array<string>,
   }
 }
 
-export class InvalidateUserAuthTokensRequest extends jspb.Message {
-  getAddress(): string;
-  setAddress(value: string): void;
+export class PointsReward extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getTitle(): string;
+  setTitle(value: string): void;
+
+  getDescription(): string;
+  setDescription(value: string): void;
+
+  getCost(): number;
+  setCost(value: number): void;
+
+  hasStock(): boolean;
+  clearStock(): void;
+  getStock(): number;
+  setStock(value: number): void;
+
+  hasPerUserLimit(): boolean;
+  clearPerUserLimit(): void;
+  getPerUserLimit(): number;
+  setPerUserLimit(value: number): void;
+
+  getRequiresInput(): boolean;
+  setRequiresInput(value: boolean): void;
+
+  getEnabled(): boolean;
+  setEnabled(value: boolean): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): InvalidateUserAuthTokensRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: InvalidateUserAuthTokensRequest): InvalidateUserAuthTokensRequest.AsObject;
+  toObject(includeInstance?: boolean): PointsReward.AsObject;
+  static toObject(includeInstance: boolean, msg: PointsReward): PointsReward.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: InvalidateUserAuthTokensRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): InvalidateUserAuthTokensRequest;
-  static deserializeBinaryFromReader(message: InvalidateUserAuthTokensRequest, reader: jspb.BinaryReader): InvalidateUserAuthTokensRequest;
+  static serializeBinaryToWriter(message: PointsReward, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PointsReward;
+  static deserializeBinaryFromReader(message: PointsReward, reader: jspb.BinaryReader): PointsReward;
 }
 
-export namespace InvalidateUserAuthTokensRequest {
+export namespace PointsReward {
   export type AsObject = {
-    address: string,
+    id: string,
+    title: string,
+    description: string,
+    cost: number,
+    stock: number,
+    perUserLimit: number,
+    requiresInput: boolean,
+    enabled: boolean,
   }
 }
 
-export class InvalidateUserAuthTokensResponse extends jspb.Message {
+export class PointsRewardRedemption extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getRewardId(): string;
+  setRewardId(value: string): void;
+
+  hasUser(): boolean;
+  clearUser(): void;
+  getUser(): common_pb.User | undefined;
+  setUser(value?: common_pb.User): void;
+
+  getCost(): number;
+  setCost(value: number): void;
+
+  hasInput(): boolean;
+  clearInput(): void;
+  getInput(): string;
+  setInput(value: string): void;
+
+  getStatus(): PointsRewardRedemptionStatusMap[keyof PointsRewardRedemptionStatusMap];
+  setStatus(value: PointsRewardRedemptionStatusMap[keyof PointsRewardRedemptionStatusMap]): void;
+
+  hasStaffNote(): boolean;
+  clearStaffNote(): void;
+  getStaffNote(): string;
+  setStaffNote(value: string): void;
+
+  hasCreatedAt(): boolean;
+  clearCreatedAt(): void;
+  getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasUpdatedAt(): boolean;
+  clearUpdatedAt(): void;
+  getUpdatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setUpdatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): InvalidateUserAuthTokensResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: InvalidateUserAuthTokensResponse): InvalidateUserAuthTokensResponse.AsObject;
+  toObject(includeInstance?: boolean): PointsRewardRedemption.AsObject;
+  static toObject(includeInstance: boolean, msg: PointsRewardRedemption): PointsRewardRedemption.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: InvalidateUserAuthTokensResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): InvalidateUserAuthTokensResponse;
-  static deserializeBinaryFromReader(message: InvalidateUserAuthTokensResponse, reader: jspb.BinaryReader): InvalidateUserAuthTokensResponse;
+  static serializeBinaryToWriter(message: PointsRewardRedemption, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PointsRewardRedemption;
+  static deserializeBinaryFromReader(message: PointsRewardRedemption, reader: jspb.BinaryReader): PointsRewardRedemption;
 }
 
-export namespace InvalidateUserAuthTokensResponse {
+export namespace PointsRewardRedemption {
   export type AsObject = {
+    id: string,
+    rewardId: string,
+    user?: common_pb.User.AsObject,
+    cost: number,
+    input: string,
+    status: PointsRewardRedemptionStatusMap[keyof PointsRewardRedemptionStatusMap],
+    staffNote: string,
+    createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    updatedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
   }
 }
 
-export class SetRPCProxyEnabledRequest extends jspb.Message {
-  getEnabled(): boolean;
-  setEnabled(value: boolean): void;
+export class PointsRewardsRequest extends jspb.Message {
+  getIncludeDisabled(): boolean;
+  setIncludeDisabled(value: boolean): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetRPCProxyEnabledRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: SetRPCProxyEnabledRequest): SetRPCProxyEnabledRequest.AsObject;
+  toObject(includeInstance?: boolean): PointsRewardsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: PointsRewardsRequest): PointsRewardsRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetRPCProxyEnabledRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetRPCProxyEnabledRequest;
-  static deserializeBinaryFromReader(message: SetRPCProxyEnabledRequest, reader: jspb.BinaryReader): SetRPCProxyEnabledRequest;
+  static serializeBinaryToWriter(message: PointsRewardsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PointsRewardsRequest;
+  static deserializeBinaryFromReader(message: PointsRewardsRequest, reader: jspb.BinaryReader): PointsRewardsRequest;
 }
 
-export namespace SetRPCProxyEnabledRequest {
+export namespace PointsRewardsRequest {
   export type AsObject = {
-    enabled: boolean,
+    includeDisabled: boolean,
   }
 }
 
-export class SetRPCProxyEnabledResponse extends jspb.Message {
+export class PointsRewardsResponse extends jspb.Message {
+  clearRewardsList(): void;
+  getRewardsList(): Array<PointsReward>;
+  setRewardsList(value: Array<PointsReward>): void;
+  addRewards(value?: PointsReward, index?: number): PointsReward;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SetRPCProxyEnabledResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: SetRPCProxyEnabledResponse): SetRPCProxyEnabledResponse.AsObject;
+  toObject(includeInstance?: boolean): PointsRewardsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: PointsRewardsResponse): PointsRewardsResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SetRPCProxyEnabledResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SetRPCProxyEnabledResponse;
-  static deserializeBinaryFromReader(message: SetRPCProxyEnabledResponse, reader: jspb.BinaryReader): SetRPCProxyEnabledResponse;
+  static serializeBinaryToWriter(message: PointsRewardsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PointsRewardsResponse;
+  static deserializeBinaryFromReader(message: PointsRewardsResponse, reader: jspb.BinaryReader): PointsRewardsResponse;
 }
 
-export namespace SetRPCProxyEnabledResponse {
+export namespace PointsRewardsResponse {
   export type AsObject = {
+    rewardsList: Array<PointsReward.AsObject>,
   }
 }
 
-export class AuthorizeApplicationRequest extends jspb.Message {
-  getApplicationName(): string;
-  setApplicationName(value: string): void;
-
-  getDesiredPermissionLevel(): PermissionLevelMap[keyof PermissionLevelMap];
-  setDesiredPermissionLevel(value: PermissionLevelMap[keyof PermissionLevelMap]): void;
+export class RedeemPointsRewardRequest extends jspb.Message {
+  getRewardId(): string;
+  setRewardId(value: string): void;
 
-  getReason(): string;
-  setReason(value: string): void;
+  getInput(): string;
+  setInput(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): AuthorizeApplicationRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: AuthorizeApplicationRequest): AuthorizeApplicationRequest.AsObject;
+  toObject(includeInstance?: boolean): RedeemPointsRewardRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: RedeemPointsRewardRequest): RedeemPointsRewardRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: AuthorizeApplicationRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): AuthorizeApplicationRequest;
-  static deserializeBinaryFromReader(message: AuthorizeApplicationRequest, reader: jspb.BinaryReader): AuthorizeApplicationRequest;
+  static serializeBinaryToWriter(message: RedeemPointsRewardRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RedeemPointsRewardRequest;
+  static deserializeBinaryFromReader(message: RedeemPointsRewardRequest, reader: jspb.BinaryReader): RedeemPointsRewardRequest;
 }
 
-export namespace AuthorizeApplicationRequest {
+export namespace RedeemPointsRewardRequest {
   export type AsObject = {
-    applicationName: string,
-    desiredPermissionLevel: PermissionLevelMap[keyof PermissionLevelMap],
-    reason: string,
+    rewardId: string,
+    input: string,
   }
 }
 
-export class AuthorizeApplicationEvent extends jspb.Message {
-  hasHeartbeat(): boolean;
-  clearHeartbeat(): void;
-  getHeartbeat(): AuthorizeApplicationHeartbeatEvent | undefined;
-  setHeartbeat(value?: AuthorizeApplicationHeartbeatEvent): void;
-
-  hasAuthorizationUrl(): boolean;
-  clearAuthorizationUrl(): void;
-  getAuthorizationUrl(): AuthorizeApplicationAuthorizationURLEvent | undefined;
-  setAuthorizationUrl(value?: AuthorizeApplicationAuthorizationURLEvent): void;
-
-  hasApproved(): boolean;
-  clearApproved(): void;
-  getApproved(): AuthorizeApplicationApprovedEvent | undefined;
-  setApproved(value?: AuthorizeApplicationApprovedEvent): void;
+export class RedeemPointsRewardResponse extends jspb.Message {
+  hasRedemption(): boolean;
+  clearRedemption(): void;
+  getRedemption(): PointsRewardRedemption | undefined;
+  setRedemption(value?: PointsRewardRedemption): void;
 
-  getEventCase(): AuthorizeApplicationEvent.EventCase;
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): AuthorizeApplicationEvent.AsObject;
-  static toObject(includeInstance: boolean, msg: AuthorizeApplicationEvent): AuthorizeApplicationEvent.AsObject;
+  toObject(includeInstance?: boolean): RedeemPointsRewardResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RedeemPointsRewardResponse): RedeemPointsRewardResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: AuthorizeApplicationEvent, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): AuthorizeApplicationEvent;
-  static deserializeBinaryFromReader(message: AuthorizeApplicationEvent, reader: jspb.BinaryReader): AuthorizeApplicationEvent;
+  static serializeBinaryToWriter(message: RedeemPointsRewardResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RedeemPointsRewardResponse;
+  static deserializeBinaryFromReader(message: RedeemPointsRewardResponse, reader: jspb.BinaryReader): RedeemPointsRewardResponse;
 }
 
-export namespace AuthorizeApplicationEvent {
+export namespace RedeemPointsRewardResponse {
   export type AsObject = {
-    heartbeat?: AuthorizeApplicationHeartbeatEvent.AsObject,
-    authorizationUrl?: AuthorizeApplicationAuthorizationURLEvent.AsObject,
-    approved?: AuthorizeApplicationApprovedEvent.AsObject,
-  }
-
-  export enum EventCase {
-    EVENT_NOT_SET = 0,
-    HEARTBEAT = 1,
-    AUTHORIZATION_URL = 2,
-    APPROVED = 3,
+    redemption?: PointsRewardRedemption.AsObject,
   }
 }
 
-export class AuthorizeApplicationHeartbeatEvent extends jspb.Message {
+export class PointsRewardRedemptionsRequest extends jspb.Message {
+  hasPaginationParams(): boolean;
+  clearPaginationParams(): void;
+  getPaginationParams(): common_pb.PaginationParameters | undefined;
+  setPaginationParams(value?: common_pb.PaginationParameters): void;
+
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): AuthorizeApplicationHeartbeatEvent.AsObject;
-  static toObject(includeInstance: boolean, msg: AuthorizeApplicationHeartbeatEvent): AuthorizeApplicationHeartbeatEvent.AsObject;
+  toObject(includeInstance?: boolean): PointsRewardRedemptionsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: PointsRewardRedemptionsRequest): PointsRewardRedemptionsRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: AuthorizeApplicationHeartbeatEvent, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): AuthorizeApplicationHeartbeatEvent;
-  static deserializeBinaryFromReader(message: AuthorizeApplicationHeartbeatEvent, reader: jspb.BinaryReader): AuthorizeApplicationHeartbeatEvent;
+  static serializeBinaryToWriter(message: PointsRewardRedemptionsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PointsRewardRedemptionsRequest;
+  static deserializeBinaryFromReader(message: PointsRewardRedemptionsRequest, reader: jspb.BinaryReader): PointsRewardRedemptionsRequest;
 }
 
-export namespace AuthorizeApplicationHeartbeatEvent {
+export namespace PointsRewardRedemptionsRequest {
   export type AsObject = {
+    paginationParams?: common_pb.PaginationParameters.AsObject,
   }
 }
 
-export class AuthorizeApplicationAuthorizationURLEvent extends jspb.Message {
-  getAuthorizationUrl(): string;
-  setAuthorizationUrl(value: string): void;
+export class PointsRewardRedemptionsResponse extends jspb.Message {
+  clearRedemptionsList(): void;
+  getRedemptionsList(): Array<PointsRewardRedemption>;
+  setRedemptionsList(value: Array<PointsRewardRedemption>): void;
+  addRedemptions(value?: PointsRewardRedemption, index?: number): PointsRewardRedemption;
+
+  getOffset(): number;
+  setOffset(value: number): void;
+
+  getTotal(): number;
+  setTotal(value: number): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): AuthorizeApplicationAuthorizationURLEvent.AsObject;
-  static toObject(includeInstance: boolean, msg: AuthorizeApplicationAuthorizationURLEvent): AuthorizeApplicationAuthorizationURLEvent.AsObject;
+  toObject(includeInstance?: boolean): PointsRewardRedemptionsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: PointsRewardRedemptionsResponse): PointsRewardRedemptionsResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: AuthorizeApplicationAuthorizationURLEvent, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): AuthorizeApplicationAuthorizationURLEvent;
-  static deserializeBinaryFromReader(message: AuthorizeApplicationAuthorizationURLEvent, reader: jspb.BinaryReader): AuthorizeApplicationAuthorizationURLEvent;
+  static serializeBinaryToWriter(message: PointsRewardRedemptionsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PointsRewardRedemptionsResponse;
+  static deserializeBinaryFromReader(message: PointsRewardRedemptionsResponse, reader: jspb.BinaryReader): PointsRewardRedemptionsResponse;
 }
 
-export namespace AuthorizeApplicationAuthorizationURLEvent {
+export namespace PointsRewardRedemptionsResponse {
   export type AsObject = {
-    authorizationUrl: string,
+    redemptionsList: Array<PointsRewardRedemption.AsObject>,
+    offset: number,
+    total: number,
   }
 }
 
-export class AuthorizeApplicationApprovedEvent extends jspb.Message {
-  getAuthToken(): string;
-  setAuthToken(value: string): void;
+export class SetPointsRewardRequest extends jspb.Message {
+  hasReward(): boolean;
+  clearReward(): void;
+  getReward(): PointsReward | undefined;
+  setReward(value?: PointsReward): void;
 
-  hasTokenExpiration(): boolean;
-  clearTokenExpiration(): void;
-  getTokenExpiration(): google_protobuf_timestamp_pb.Timestamp | undefined;
-  setTokenExpiration(value?: google_protobuf_timestamp_pb.Timestamp): void;
+  getUpdateStock(): boolean;
+  setUpdateStock(value: boolean): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): AuthorizeApplicationApprovedEvent.AsObject;
-  static toObject(includeInstance: boolean, msg: AuthorizeApplicationApprovedEvent): AuthorizeApplicationApprovedEvent.AsObject;
+  toObject(includeInstance?: boolean): SetPointsRewardRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetPointsRewardRequest): SetPointsRewardRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: AuthorizeApplicationApprovedEvent, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): AuthorizeApplicationApprovedEvent;
-  static deserializeBinaryFromReader(message: AuthorizeApplicationApprovedEvent, reader: jspb.BinaryReader): AuthorizeApplicationApprovedEvent;
+  static serializeBinaryToWriter(message: SetPointsRewardRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetPointsRewardRequest;
+  static deserializeBinaryFromReader(message: SetPointsRewardRequest, reader: jspb.BinaryReader): SetPointsRewardRequest;
 }
 
-export namespace AuthorizeApplicationApprovedEvent {
+export namespace SetPointsRewardRequest {
   export type AsObject = {
-    authToken: string,
-    tokenExpiration?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    reward?: PointsReward.AsObject,
+    updateStock: boolean,
   }
 }
 
-export class AuthorizationProcessDataRequest extends jspb.Message {
-  getProcessId(): string;
-  setProcessId(value: string): void;
+export class SetPointsRewardResponse extends jspb.Message {
+  hasReward(): boolean;
+  clearReward(): void;
+  getReward(): PointsReward | undefined;
+  setReward(value?: PointsReward): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): AuthorizationProcessDataRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: AuthorizationProcessDataRequest): AuthorizationProcessDataRequest.AsObject;
+  toObject(includeInstance?: boolean): SetPointsRewardResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetPointsRewardResponse): SetPointsRewardResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: AuthorizationProcessDataRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): AuthorizationProcessDataRequest;
-  static deserializeBinaryFromReader(message: AuthorizationProcessDataRequest, reader: jspb.BinaryReader): AuthorizationProcessDataRequest;
+  static serializeBinaryToWriter(message: SetPointsRewardResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetPointsRewardResponse;
+  static deserializeBinaryFromReader(message: SetPointsRewardResponse, reader: jspb.BinaryReader): SetPointsRewardResponse;
 }
 
-export namespace AuthorizationProcessDataRequest {
+export namespace SetPointsRewardResponse {
   export type AsObject = {
-    processId: string,
+    reward?: PointsReward.AsObject,
   }
 }
 
-export class AuthorizationProcessDataResponse extends jspb.Message {
-  getApplicationName(): string;
-  setApplicationName(value: string): void;
+export class AllPointsRewardRedemptionsRequest extends jspb.Message {
+  hasPaginationParams(): boolean;
+  clearPaginationParams(): void;
+  getPaginationParams(): common_pb.PaginationParameters | undefined;
+  setPaginationParams(value?: common_pb.PaginationParameters): void;
 
-  getDesiredPermissionLevel(): PermissionLevelMap[keyof PermissionLevelMap];
-  setDesiredPermissionLevel(value: PermissionLevelMap[keyof PermissionLevelMap]): void;
+  clearStatusesList(): void;
+  getStatusesList(): Array<PointsRewardRedemptionStatusMap[keyof PointsRewardRedemptionStatusMap]>;
+  setStatusesList(value: Array<PointsRewardRedemptionStatusMap[keyof PointsRewardRedemptionStatusMap]>): void;
+  addStatuses(value: PointsRewardRedemptionStatusMap[keyof PointsRewardRedemptionStatusMap], index?: number): PointsRewardRedemptionStatusMap[keyof PointsRewardRedemptionStatusMap];
 
-  getReason(): string;
-  setReason(value: string): void;
+  getRewardsAddress(): string;
+  setRewardsAddress(value: string): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): AuthorizationProcessDataResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: AuthorizationProcessDataResponse): AuthorizationProcessDataResponse.AsObject;
+  toObject(includeInstance?: boolean): AllPointsRewardRedemptionsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AllPointsRewardRedemptionsRequest): AllPointsRewardRedemptionsRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: AuthorizationProcessDataResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): AuthorizationProcessDataResponse;
-  static deserializeBinaryFromReader(message: AuthorizationProcessDataResponse, reader: jspb.BinaryReader): AuthorizationProcessDataResponse;
+  static serializeBinaryToWriter(message: AllPointsRewardRedemptionsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AllPointsRewardRedemptionsRequest;
+  static deserializeBinaryFromReader(message: AllPointsRewardRedemptionsRequest, reader: jspb.BinaryReader): AllPointsRewardRedemptionsRequest;
 }
 
-export namespace AuthorizationProcessDataResponse {
+export namespace AllPointsRewardRedemptionsRequest {
   export type AsObject = {
-    applicationName: string,
-    desiredPermissionLevel: PermissionLevelMap[keyof PermissionLevelMap],
-    reason: string,
+    paginationParams?: common_pb.PaginationParameters.AsObject,
+    statusesList: Array<PointsRewardRedemptionStatusMap[keyof PointsRewardRedemptionStatusMap]>,
+    rewardsAddress: string,
   }
 }
 
-export class ConsentOrDissentToAuthorizationRequest extends jspb.Message {
-  getProcessId(): string;
-  setProcessId(value: string): void;
+export class AllPointsRewardRedemptionsResponse extends jspb.Message {
+  clearRedemptionsList(): void;
+  getRedemptionsList(): Array<PointsRewardRedemption>;
+  setRedemptionsList(value: Array<PointsRewardRedemption>): void;
+  addRedemptions(value?: PointsRewardRedemption, index?: number): PointsRewardRedemption;
 
-  getConsent(): boolean;
-  setConsent(value: boolean): void;
+  getOffset(): number;
+  setOffset(value: number): void;
+
+  getTotal(): number;
+  setTotal(value: number): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ConsentOrDissentToAuthorizationRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: ConsentOrDissentToAuthorizationRequest): ConsentOrDissentToAuthorizationRequest.AsObject;
+  toObject(includeInstance?: boolean): AllPointsRewardRedemptionsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: AllPointsRewardRedemptionsResponse): AllPointsRewardRedemptionsResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ConsentOrDissentToAuthorizationRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ConsentOrDissentToAuthorizationRequest;
-  static deserializeBinaryFromReader(message: ConsentOrDissentToAuthorizationRequest, reader: jspb.BinaryReader): ConsentOrDissentToAuthorizationRequest;
+  static serializeBinaryToWriter(message: AllPointsRewardRedemptionsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AllPointsRewardRedemptionsResponse;
+  static deserializeBinaryFromReader(message: AllPointsRewardRedemptionsResponse, reader: jspb.BinaryReader): AllPointsRewardRedemptionsResponse;
 }
 
-export namespace ConsentOrDissentToAuthorizationRequest {
+export namespace AllPointsRewardRedemptionsResponse {
   export type AsObject = {
-    processId: string,
-    consent: boolean,
+    redemptionsList: Array<PointsRewardRedemption.AsObject>,
+    offset: number,
+    total: number,
   }
 }
 
-export class ConsentOrDissentToAuthorizationResponse extends jspb.Message {
-  serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ConsentOrDissentToAuthorizationResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: ConsentOrDissentToAuthorizationResponse): ConsentOrDissentToAuthorizationResponse.AsObject;
-  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
-  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: ConsentOrDissentToAuthorizationResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ConsentOrDissentToAuthorizationResponse;
-  static deserializeBinaryFromReader(message: ConsentOrDissentToAuthorizationResponse, reader: jspb.BinaryReader): ConsentOrDissentToAuthorizationResponse;
-}
+export class UpdatePointsRewardRedemptionRequest extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
 
-export namespace ConsentOrDissentToAuthorizationResponse {
-  export type AsObject = {
-  }
-}
+  getStatus(): PointsRewardRedemptionStatusMap[keyof PointsRewardRedemptionStatusMap];
+  setStatus(value: PointsRewardRedemptionStatusMap[keyof PointsRewardRedemptionStatusMap]): void;
+
+  hasStaffNote(): boolean;
+  clearStaffNote(): void;
+  getStaffNote(): string;
+  setStaffNote(value: string): void;
 
-export class SpectatorsRequest extends jspb.Message {
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SpectatorsRequest.AsObject;
-  static toObject(includeInstance: boolean, msg: SpectatorsRequest): SpectatorsRequest.AsObject;
+  toObject(includeInstance?: boolean): UpdatePointsRewardRedemptionRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdatePointsRewardRedemptionRequest): UpdatePointsRewardRedemptionRequest.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SpectatorsRequest, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SpectatorsRequest;
-  static deserializeBinaryFromReader(message: SpectatorsRequest, reader: jspb.BinaryReader): SpectatorsRequest;
+  static serializeBinaryToWriter(message: UpdatePointsRewardRedemptionRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdatePointsRewardRedemptionRequest;
+  static deserializeBinaryFromReader(message: UpdatePointsRewardRedemptionRequest, reader: jspb.BinaryReader): UpdatePointsRewardRedemptionRequest;
 }
 
-export namespace SpectatorsRequest {
+export namespace UpdatePointsRewardRedemptionRequest {
   export type AsObject = {
+    id: string,
+    status: PointsRewardRedemptionStatusMap[keyof PointsRewardRedemptionStatusMap],
+    staffNote: string,
   }
 }
 
-export class SpectatorsResponse extends jspb.Message {
-  clearSpectatorsList(): void;
-  getSpectatorsList(): Array<Spectator>;
-  setSpectatorsList(value: Array<Spectator>): void;
-  addSpectators(value?: Spectator, index?: number): Spectator;
+export class UpdatePointsRewardRedemptionResponse extends jspb.Message {
+  hasRedemption(): boolean;
+  clearRedemption(): void;
+  getRedemption(): PointsRewardRedemption | undefined;
+  setRedemption(value?: PointsRewardRedemption): void;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): SpectatorsResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: SpectatorsResponse): SpectatorsResponse.AsObject;
+  toObject(includeInstance?: boolean): UpdatePointsRewardRedemptionResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdatePointsRewardRedemptionResponse): UpdatePointsRewardRedemptionResponse.AsObject;
   static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
   static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
-  static serializeBinaryToWriter(message: SpectatorsResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): SpectatorsResponse;
-  static deserializeBinaryFromReader(message: SpectatorsResponse, reader: jspb.BinaryReader): SpectatorsResponse;
+  static serializeBinaryToWriter(message: UpdatePointsRewardRedemptionResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdatePointsRewardRedemptionResponse;
+  static deserializeBinaryFromReader(message: UpdatePointsRewardRedemptionResponse, reader: jspb.BinaryReader): UpdatePointsRewardRedemptionResponse;
 }
 
-export namespace SpectatorsResponse {
+export namespace UpdatePointsRewardRedemptionResponse {
   export type AsObject = {
-    spectatorsList: Array<Spectator.AsObject>,
+    redemption?: PointsRewardRedemption.AsObject,
   }
 }
 
@@ -6749,6 +10815,22 @@ export interface QueueEntryMovementDirectionMap {
 
 export const QueueEntryMovementDirection: QueueEntryMovementDirectionMap;
 
+export interface DirectMediaFormatMap {
+  DIRECT_MEDIA_FORMAT_UNKNOWN: 0;
+  DIRECT_MEDIA_FORMAT_MP4: 1;
+  DIRECT_MEDIA_FORMAT_WEBM: 2;
+  DIRECT_MEDIA_FORMAT_HLS: 3;
+}
+
+export const DirectMediaFormat: DirectMediaFormatMap;
+
+export interface QueueEntrySchedulingModeMap {
+  QUEUE_ENTRY_SCHEDULING_MODE_NOT_BEFORE: 0;
+  QUEUE_ENTRY_SCHEDULING_MODE_EXACTLY_AT: 1;
+}
+
+export const QueueEntrySchedulingMode: QueueEntrySchedulingModeMap;
+
 export interface SkipStatusMap {
   SKIP_STATUS_ALLOWED: 0;
   SKIP_STATUS_UNSKIPPABLE: 1;
@@ -6798,6 +10880,7 @@ export interface DisallowedMediaTypeMap {
   UNKNOWN_DISALLOWED_MEDIA_TYPE: 0;
   DISALLOWED_MEDIA_TYPE_YOUTUBE_VIDEO: 1;
   DISALLOWED_MEDIA_TYPE_SOUNDCLOUD_TRACK: 2;
+  DISALLOWED_MEDIA_TYPE_DIRECT_MEDIA: 3;
 }
 
 export const DisallowedMediaType: DisallowedMediaTypeMap;
@@ -6806,6 +10889,7 @@ export interface DisallowedMediaCollectionTypeMap {
   UNKNOWN_DISALLOWED_MEDIA_COLLECTION_TYPE: 0;
   DISALLOWED_MEDIA_COLLECTION_TYPE_YOUTUBE_CHANNEL: 1;
   DISALLOWED_MEDIA_COLLECTION_TYPE_SOUNDCLOUD_USER: 2;
+  DISALLOWED_MEDIA_COLLECTION_TYPE_DIRECT_MEDIA_HOST: 3;
 }
 
 export const DisallowedMediaCollectionType: DisallowedMediaCollectionTypeMap;
@@ -6819,6 +10903,15 @@ export interface LeaderboardPeriodMap {
 
 export const LeaderboardPeriod: LeaderboardPeriodMap;
 
+export interface WithdrawalCadenceMap {
+  UNKNOWN_WITHDRAWAL_CADENCE: 0;
+  WITHDRAWAL_CADENCE_DAILY: 1;
+  WITHDRAWAL_CADENCE_WEEKLY: 2;
+  WITHDRAWAL_CADENCE_MONTHLY: 3;
+}
+
+export const WithdrawalCadence: WithdrawalCadenceMap;
+
 export interface RaffleDrawingStatusMap {
   UNKNOWN_RAFFLE_DRAWING_STATUS: 0;
   RAFFLE_DRAWING_STATUS_ONGOING: 1;
@@ -6830,6 +10923,61 @@ export interface RaffleDrawingStatusMap {
 
 export const RaffleDrawingStatus: RaffleDrawingStatusMap;
 
+export interface RefundReasonMap {
+  UNKNOWN_REFUND_REASON: 0;
+  REFUND_REASON_REMOVED_ENTRY: 1;
+  REFUND_REASON_NO_ELIGIBLE_SPECTATORS: 2;
+  REFUND_REASON_FAILED_TICKET: 3;
+  REFUND_REASON_LATE_PAYMENT: 4;
+  REFUND_REASON_GOODWILL: 5;
+  REFUND_REASON_CANCELLED_RAIN: 6;
+}
+
+export const RefundReason: RefundReasonMap;
+
+export interface RefundStatusMap {
+  UNKNOWN_REFUND_STATUS: 0;
+  REFUND_STATUS_PROPOSED: 1;
+  REFUND_STATUS_APPROVED: 2;
+  REFUND_STATUS_SENT: 3;
+  REFUND_STATUS_FAILED: 4;
+}
+
+export const RefundStatus: RefundStatusMap;
+
+export interface ScheduledRainAudienceMap {
+  UNKNOWN_SCHEDULED_RAIN_AUDIENCE: 0;
+  SCHEDULED_RAIN_AUDIENCE_SPECTATORS: 1;
+  SCHEDULED_RAIN_AUDIENCE_CHATTERS: 2;
+}
+
+export const ScheduledRainAudience: ScheduledRainAudienceMap;
+
+export interface ScheduledRainStatusMap {
+  UNKNOWN_SCHEDULED_RAIN_STATUS: 0;
+  SCHEDULED_RAIN_STATUS_SCHEDULED: 1;
+  SCHEDULED_RAIN_STATUS_COMPLETE: 2;
+  SCHEDULED_RAIN_STATUS_CANCELLED: 3;
+}
+
+export const ScheduledRainStatus: ScheduledRainStatusMap;
+
+export interface QueueOrderingPolicyMap {
+  QUEUE_ORDERING_POLICY_FIFO: 0;
+  QUEUE_ORDERING_POLICY_ROUND_ROBIN: 1;
+  QUEUE_ORDERING_POLICY_MAX_CONSECUTIVE: 2;
+}
+
+export const QueueOrderingPolicy: QueueOrderingPolicyMap;
+
+export interface RewardDistributionStrategyMap {
+  REWARD_DISTRIBUTION_STRATEGY_EQUAL: 0;
+  REWARD_DISTRIBUTION_STRATEGY_WATCH_TIME: 1;
+  REWARD_DISTRIBUTION_STRATEGY_ACTIVITY_TIER: 2;
+}
+
+export const RewardDistributionStrategy: RewardDistributionStrategyMap;
+
 export interface ConnectionServiceMap {
   UNKNOWN_CONNECTION_SERVICE: 0;
   CRYPTOMONKEYS: 1;
@@ -6852,10 +11000,23 @@ export interface PointsTransactionTypeMap {
   POINTS_TRANSACTION_TYPE_SKIP_THRESHOLD_INCREASE: 11;
   POINTS_TRANSACTION_TYPE_CONCEALED_ENTRY_ENQUEUING: 12;
   POINTS_TRANSACTION_TYPE_APPLICATION_DEFINED: 13;
+  POINTS_TRANSACTION_TYPE_SKIP_VOTE: 14;
+  POINTS_TRANSACTION_TYPE_TIP_SENT: 15;
+  POINTS_TRANSACTION_TYPE_TIP_RECEIVED: 16;
+  POINTS_TRANSACTION_TYPE_REWARD_REDEMPTION: 17;
+  POINTS_TRANSACTION_TYPE_REWARD_REDEMPTION_REFUND: 18;
 }
 
 export const PointsTransactionType: PointsTransactionTypeMap;
 
+export interface TipCurrencyMap {
+  UNKNOWN_TIP_CURRENCY: 0;
+  TIP_CURRENCY_POINTS: 1;
+  TIP_CURRENCY_BANANO: 2;
+}
+
+export const TipCurrency: TipCurrencyMap;
+
 export interface VipUserAppearanceMap {
   UNKNOWN_VIP_USER_APPEARANCE: 0;
   VIP_USER_APPEARANCE_NORMAL: 1;
@@ -6866,3 +11027,27 @@ export interface VipUserAppearanceMap {
 
 export const VipUserAppearance: VipUserAppearanceMap;
 
+export interface AutoplayPoolSourceMap {
+  AUTOPLAY_POOL_SOURCE_LIST: 0;
+  AUTOPLAY_POOL_SOURCE_HISTORY: 1;
+}
+
+export const AutoplayPoolSource: AutoplayPoolSourceMap;
+
+export interface PlaylistFormatMap {
+  PLAYLIST_FORMAT_UNKNOWN: 0;
+  PLAYLIST_FORMAT_M3U: 1;
+  PLAYLIST_FORMAT_XSPF: 2;
+  PLAYLIST_FORMAT_JSON: 3;
+}
+
+export const PlaylistFormat: PlaylistFormatMap;
+
+export interface PointsRewardRedemptionStatusMap {
+  POINTS_REWARD_REDEMPTION_STATUS_PENDING: 0;
+  POINTS_REWARD_REDEMPTION_STATUS_FULFILLED: 1;
+  POINTS_REWARD_REDEMPTION_STATUS_REJECTED: 2;
+}
+
+export const PointsRewardRedemptionStatus: PointsRewardRedemptionStatusMap;
+
@@ -60,6 +60,15 @@ type JungleTVMoveQueueEntry = {
   readonly responseType: typeof jungletv_pb.MoveQueueEntryResponse;
 };
 
+type JungleTVQueueEntryMovementCost = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.QueueEntryMovementCostRequest;
+  readonly responseType: typeof jungletv_pb.QueueEntryMovementCostResponse;
+};
+
 type JungleTVMonitorTicket = {
   readonly methodName: string;
   readonly service: typeof JungleTV;
@@ -96,6 +105,15 @@ type JungleTVMonitorSkipAndTip = {
   readonly responseType: typeof jungletv_pb.SkipAndTipStatus;
 };
 
+type JungleTVBroadcastChannels = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.BroadcastChannelsRequest;
+  readonly responseType: typeof jungletv_pb.BroadcastChannelsResponse;
+};
+
 type JungleTVRewardInfo = {
   readonly methodName: string;
   readonly service: typeof JungleTV;
@@ -204,6 +222,24 @@ type JungleTVWithdrawalHistory = {
   readonly responseType: typeof jungletv_pb.WithdrawalHistoryResponse;
 };
 
+type JungleTVWithdrawalPreferences = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.WithdrawalPreferencesRequest;
+  readonly responseType: typeof jungletv_pb.WithdrawalPreferencesResponse;
+};
+
+type JungleTVSetWithdrawalPreferences = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.SetWithdrawalPreferencesRequest;
+  readonly responseType: typeof jungletv_pb.SetWithdrawalPreferencesResponse;
+};
+
 type JungleTVOngoingRaffleInfo = {
   readonly methodName: string;
   readonly service: typeof JungleTV;
@@ -357,6 +393,51 @@ type JungleTVConvertBananoToPoints = {
   readonly responseType: typeof jungletv_pb.ConvertBananoToPointsStatus;
 };
 
+type JungleTVTipWithPoints = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.TipWithPointsRequest;
+  readonly responseType: typeof jungletv_pb.TipWithPointsResponse;
+};
+
+type JungleTVTipWithBanano = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: true;
+  readonly requestType: typeof jungletv_pb.TipWithBananoRequest;
+  readonly responseType: typeof jungletv_pb.TipWithBananoStatus;
+};
+
+type JungleTVPointsRewards = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.PointsRewardsRequest;
+  readonly responseType: typeof jungletv_pb.PointsRewardsResponse;
+};
+
+type JungleTVRedeemPointsReward = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.RedeemPointsRewardRequest;
+  readonly responseType: typeof jungletv_pb.RedeemPointsRewardResponse;
+};
+
+type JungleTVPointsRewardRedemptions = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.PointsRewardRedemptionsRequest;
+  readonly responseType: typeof jungletv_pb.PointsRewardRedemptionsResponse;
+};
+
 type JungleTVStartOrExtendSubscription = {
   readonly methodName: string;
   readonly service: typeof JungleTV;
@@ -384,6 +465,15 @@ type JungleTVIncreaseOrReduceSkipThreshold = {
   readonly responseType: typeof jungletv_pb.IncreaseOrReduceSkipThresholdResponse;
 };
 
+type JungleTVVoteToSkip = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.VoteToSkipRequest;
+  readonly responseType: typeof jungletv_pb.VoteToSkipResponse;
+};
+
 type JungleTVCheckMediaEnqueuingPassword = {
   readonly methodName: string;
   readonly service: typeof JungleTV;
@@ -690,6 +780,78 @@ type JungleTVRedrawRaffle = {
   readonly responseType: typeof jungletv_pb.RedrawRaffleResponse;
 };
 
+type JungleTVRefunds = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.RefundsRequest;
+  readonly responseType: typeof jungletv_pb.RefundsResponse;
+};
+
+type JungleTVProposeRefund = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.ProposeRefundRequest;
+  readonly responseType: typeof jungletv_pb.ProposeRefundResponse;
+};
+
+type JungleTVApproveRefund = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.ApproveRefundRequest;
+  readonly responseType: typeof jungletv_pb.ApproveRefundResponse;
+};
+
+type JungleTVRetryRefund = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.RetryRefundRequest;
+  readonly responseType: typeof jungletv_pb.RetryRefundResponse;
+};
+
+type JungleTVReconcileJournal = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.ReconcileJournalRequest;
+  readonly responseType: typeof jungletv_pb.ReconcileJournalResponse;
+};
+
+type JungleTVScheduledRains = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.ScheduledRainsRequest;
+  readonly responseType: typeof jungletv_pb.ScheduledRainsResponse;
+};
+
+type JungleTVScheduleRain = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.ScheduleRainRequest;
+  readonly responseType: typeof jungletv_pb.ScheduleRainResponse;
+};
+
+type JungleTVCancelScheduledRain = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.CancelScheduledRainRequest;
+  readonly responseType: typeof jungletv_pb.CancelScheduledRainResponse;
+};
+
 type JungleTVTriggerAnnouncementsNotification = {
   readonly methodName: string;
   readonly service: typeof JungleTV;
@@ -744,6 +906,42 @@ type JungleTVSetQueueEntryReorderingAllowed = {
   readonly responseType: typeof jungletv_pb.SetQueueEntryReorderingAllowedResponse;
 };
 
+type JungleTVSetQueueOrderingPolicy = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.SetQueueOrderingPolicyRequest;
+  readonly responseType: typeof jungletv_pb.SetQueueOrderingPolicyResponse;
+};
+
+type JungleTVSetRewardDistributionStrategy = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.SetRewardDistributionStrategyRequest;
+  readonly responseType: typeof jungletv_pb.SetRewardDistributionStrategyResponse;
+};
+
+type JungleTVPauseBroadcast = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.PauseBroadcastRequest;
+  readonly responseType: typeof jungletv_pb.PauseBroadcastResponse;
+};
+
+type JungleTVResumeBroadcast = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.ResumeBroadcastRequest;
+  readonly responseType: typeof jungletv_pb.ResumeBroadcastResponse;
+};
+
 type JungleTVSetNewQueueEntriesAlwaysUnskippable = {
   readonly methodName: string;
   readonly service: typeof JungleTV;
@@ -816,6 +1014,33 @@ type JungleTVAdjustPointsBalance = {
   readonly responseType: typeof jungletv_pb.AdjustPointsBalanceResponse;
 };
 
+type JungleTVSetPointsReward = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.SetPointsRewardRequest;
+  readonly responseType: typeof jungletv_pb.SetPointsRewardResponse;
+};
+
+type JungleTVAllPointsRewardRedemptions = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.AllPointsRewardRedemptionsRequest;
+  readonly responseType: typeof jungletv_pb.AllPointsRewardRedemptionsResponse;
+};
+
+type JungleTVUpdatePointsRewardRedemption = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.UpdatePointsRewardRedemptionRequest;
+  readonly responseType: typeof jungletv_pb.UpdatePointsRewardRedemptionResponse;
+};
+
 type JungleTVAddVipUser = {
   readonly methodName: string;
   readonly service: typeof JungleTV;
@@ -879,6 +1104,240 @@ type JungleTVSpectators = {
   readonly responseType: typeof jungletv_pb.SpectatorsResponse;
 };
 
+type JungleTVTimetableSlots = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.TimetableSlotsRequest;
+  readonly responseType: typeof jungletv_pb.TimetableSlotsResponse;
+};
+
+type JungleTVSetTimetableSlot = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.SetTimetableSlotRequest;
+  readonly responseType: typeof jungletv_pb.SetTimetableSlotResponse;
+};
+
+type JungleTVRemoveTimetableSlot = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.RemoveTimetableSlotRequest;
+  readonly responseType: typeof jungletv_pb.RemoveTimetableSlotResponse;
+};
+
+type JungleTVCreateBroadcastChannel = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.CreateBroadcastChannelRequest;
+  readonly responseType: typeof jungletv_pb.CreateBroadcastChannelResponse;
+};
+
+type JungleTVAutoplayPools = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.AutoplayPoolsRequest;
+  readonly responseType: typeof jungletv_pb.AutoplayPoolsResponse;
+};
+
+type JungleTVSetAutoplayPool = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.SetAutoplayPoolRequest;
+  readonly responseType: typeof jungletv_pb.SetAutoplayPoolResponse;
+};
+
+type JungleTVRemoveAutoplayPool = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.RemoveAutoplayPoolRequest;
+  readonly responseType: typeof jungletv_pb.RemoveAutoplayPoolResponse;
+};
+
+type JungleTVAutoplayPoolEntries = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.AutoplayPoolEntriesRequest;
+  readonly responseType: typeof jungletv_pb.AutoplayPoolEntriesResponse;
+};
+
+type JungleTVAddAutoplayPoolEntry = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.AddAutoplayPoolEntryRequest;
+  readonly responseType: typeof jungletv_pb.AddAutoplayPoolEntryResponse;
+};
+
+type JungleTVRemoveAutoplayPoolEntry = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.RemoveAutoplayPoolEntryRequest;
+  readonly responseType: typeof jungletv_pb.RemoveAutoplayPoolEntryResponse;
+};
+
+type JungleTVQueueSnapshots = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.QueueSnapshotsRequest;
+  readonly responseType: typeof jungletv_pb.QueueSnapshotsResponse;
+};
+
+type JungleTVQueueSnapshot = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.QueueSnapshotRequest;
+  readonly responseType: typeof jungletv_pb.QueueSnapshotResponse;
+};
+
+type JungleTVRestoreQueueSnapshot = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.RestoreQueueSnapshotRequest;
+  readonly responseType: typeof jungletv_pb.RestoreQueueSnapshotResponse;
+};
+
+type JungleTVMediaReplayRules = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.MediaReplayRulesRequest;
+  readonly responseType: typeof jungletv_pb.MediaReplayRulesResponse;
+};
+
+type JungleTVSetMediaReplayRule = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.SetMediaReplayRuleRequest;
+  readonly responseType: typeof jungletv_pb.SetMediaReplayRuleResponse;
+};
+
+type JungleTVRemoveMediaReplayRule = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.RemoveMediaReplayRuleRequest;
+  readonly responseType: typeof jungletv_pb.RemoveMediaReplayRuleResponse;
+};
+
+type JungleTVLibraryMedia = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.LibraryMediaRequest;
+  readonly responseType: typeof jungletv_pb.LibraryMediaResponse;
+};
+
+type JungleTVUploadLibraryMedia = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.UploadLibraryMediaRequest;
+  readonly responseType: typeof jungletv_pb.UploadLibraryMediaResponse;
+};
+
+type JungleTVRemoveLibraryMedia = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.RemoveLibraryMediaRequest;
+  readonly responseType: typeof jungletv_pb.RemoveLibraryMediaResponse;
+};
+
+type JungleTVExportQueue = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.ExportQueueRequest;
+  readonly responseType: typeof jungletv_pb.ExportQueueResponse;
+};
+
+type JungleTVExportPlayedMedia = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.ExportPlayedMediaRequest;
+  readonly responseType: typeof jungletv_pb.ExportPlayedMediaResponse;
+};
+
+type JungleTVImportQueue = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.ImportQueueRequest;
+  readonly responseType: typeof jungletv_pb.ImportQueueResponse;
+};
+
+type JungleTVPricingRules = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.PricingRulesRequest;
+  readonly responseType: typeof jungletv_pb.PricingRulesResponse;
+};
+
+type JungleTVSetPricingRule = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.SetPricingRuleRequest;
+  readonly responseType: typeof jungletv_pb.SetPricingRuleResponse;
+};
+
+type JungleTVRemovePricingRule = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.RemovePricingRuleRequest;
+  readonly responseType: typeof jungletv_pb.RemovePricingRuleResponse;
+};
+
+type JungleTVSimulatePricing = {
+  readonly methodName: string;
+  readonly service: typeof JungleTV;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof jungletv_pb.SimulatePricingRequest;
+  readonly responseType: typeof jungletv_pb.SimulatePricingResponse;
+};
+
 type JungleTVApplications = {
   readonly methodName: string;
   readonly service: typeof JungleTV;
@@ -1094,10 +1553,12 @@ export class JungleTV {
   static readonly EnqueueMedia: JungleTVEnqueueMedia;
   static readonly RemoveOwnQueueEntry: JungleTVRemoveOwnQueueEntry;
   static readonly MoveQueueEntry: JungleTVMoveQueueEntry;
+  static readonly QueueEntryMovementCost: JungleTVQueueEntryMovementCost;
   static readonly MonitorTicket: JungleTVMonitorTicket;
   static readonly ConsumeMedia: JungleTVConsumeMedia;
   static readonly MonitorQueue: JungleTVMonitorQueue;
   static readonly MonitorSkipAndTip: JungleTVMonitorSkipAndTip;
+  static readonly BroadcastChannels: JungleTVBroadcastChannels;
   static readonly RewardInfo: JungleTVRewardInfo;
   static readonly SubmitActivityChallenge: JungleTVSubmitActivityChallenge;
   static readonly ProduceSegchaChallenge: JungleTVProduceSegchaChallenge;
@@ -1110,6 +1571,8 @@ export class JungleTV {
   static readonly Leaderboards: JungleTVLeaderboards;
   static readonly RewardHistory: JungleTVRewardHistory;
   static readonly WithdrawalHistory: JungleTVWithdrawalHistory;
+  static readonly WithdrawalPreferences: JungleTVWithdrawalPreferences;
+  static readonly SetWithdrawalPreferences: JungleTVSetWithdrawalPreferences;
   static readonly OngoingRaffleInfo: JungleTVOngoingRaffleInfo;
   static readonly RaffleDrawings: JungleTVRaffleDrawings;
   static readonly Connections: JungleTVConnections;
@@ -1127,9 +1590,15 @@ export class JungleTV {
   static readonly PointsTransactions: JungleTVPointsTransactions;
   static readonly ChatGifSearch: JungleTVChatGifSearch;
   static readonly ConvertBananoToPoints: JungleTVConvertBananoToPoints;
+  static readonly TipWithPoints: JungleTVTipWithPoints;
+  static readonly TipWithBanano: JungleTVTipWithBanano;
+  static readonly PointsRewards: JungleTVPointsRewards;
+  static readonly RedeemPointsReward: JungleTVRedeemPointsReward;
+  static readonly PointsRewardRedemptions: JungleTVPointsRewardRedemptions;
   static readonly StartOrExtendSubscription: JungleTVStartOrExtendSubscription;
   static readonly SoundCloudTrackDetails: JungleTVSoundCloudTrackDetails;
   static readonly IncreaseOrReduceSkipThreshold: JungleTVIncreaseOrReduceSkipThreshold;
+  static readonly VoteToSkip: JungleTVVoteToSkip;
   static readonly CheckMediaEnqueuingPassword: JungleTVCheckMediaEnqueuingPassword;
   static readonly MonitorMediaEnqueuingPermission: JungleTVMonitorMediaEnqueuingPermission;
   static readonly InvalidateAuthTokens: JungleTVInvalidateAuthTokens;
@@ -1164,12 +1633,24 @@ export class JungleTV {
   static readonly ConfirmRaffleWinner: JungleTVConfirmRaffleWinner;
   static readonly CompleteRaffle: JungleTVCompleteRaffle;
   static readonly RedrawRaffle: JungleTVRedrawRaffle;
+  static readonly Refunds: JungleTVRefunds;
+  static readonly ProposeRefund: JungleTVProposeRefund;
+  static readonly ApproveRefund: JungleTVApproveRefund;
+  static readonly RetryRefund: JungleTVRetryRefund;
+  static readonly ReconcileJournal: JungleTVReconcileJournal;
+  static readonly ScheduledRains: JungleTVScheduledRains;
+  static readonly ScheduleRain: JungleTVScheduleRain;
+  static readonly CancelScheduledRain: JungleTVCancelScheduledRain;
   static readonly TriggerAnnouncementsNotification: JungleTVTriggerAnnouncementsNotification;
   static readonly SpectatorInfo: JungleTVSpectatorInfo;
   static readonly ResetSpectatorStatus: JungleTVResetSpectatorStatus;
   static readonly MonitorModerationStatus: JungleTVMonitorModerationStatus;
   static readonly SetOwnQueueEntryRemovalAllowed: JungleTVSetOwnQueueEntryRemovalAllowed;
   static readonly SetQueueEntryReorderingAllowed: JungleTVSetQueueEntryReorderingAllowed;
+  static readonly SetQueueOrderingPolicy: JungleTVSetQueueOrderingPolicy;
+  static readonly SetRewardDistributionStrategy: JungleTVSetRewardDistributionStrategy;
+  static readonly PauseBroadcast: JungleTVPauseBroadcast;
+  static readonly ResumeBroadcast: JungleTVResumeBroadcast;
   static readonly SetNewQueueEntriesAlwaysUnskippable: JungleTVSetNewQueueEntriesAlwaysUnskippable;
   static readonly SetSkippingEnabled: JungleTVSetSkippingEnabled;
   static readonly SetQueueInsertCursor: JungleTVSetQueueInsertCursor;
@@ -1178,6 +1659,9 @@ export class JungleTV {
   static readonly MarkAsActivelyModerating: JungleTVMarkAsActivelyModerating;
   static readonly StopActivelyModerating: JungleTVStopActivelyModerating;
   static readonly AdjustPointsBalance: JungleTVAdjustPointsBalance;
+  static readonly SetPointsReward: JungleTVSetPointsReward;
+  static readonly AllPointsRewardRedemptions: JungleTVAllPointsRewardRedemptions;
+  static readonly UpdatePointsRewardRedemption: JungleTVUpdatePointsRewardRedemption;
   static readonly AddVipUser: JungleTVAddVipUser;
   static readonly RemoveVipUser: JungleTVRemoveVipUser;
   static readonly TriggerClientReload: JungleTVTriggerClientReload;
@@ -1185,6 +1669,32 @@ export class JungleTV {
   static readonly InvalidateUserAuthTokens: JungleTVInvalidateUserAuthTokens;
   static readonly SetRPCProxyEnabled: JungleTVSetRPCProxyEnabled;
   static readonly Spectators: JungleTVSpectators;
+  static readonly TimetableSlots: JungleTVTimetableSlots;
+  static readonly SetTimetableSlot: JungleTVSetTimetableSlot;
+  static readonly RemoveTimetableSlot: JungleTVRemoveTimetableSlot;
+  static readonly CreateBroadcastChannel: JungleTVCreateBroadcastChannel;
+  static readonly AutoplayPools: JungleTVAutoplayPools;
+  static readonly SetAutoplayPool: JungleTVSetAutoplayPool;
+  static readonly RemoveAutoplayPool: JungleTVRemoveAutoplayPool;
+  static readonly AutoplayPoolEntries: JungleTVAutoplayPoolEntries;
+  static readonly AddAutoplayPoolEntry: JungleTVAddAutoplayPoolEntry;
+  static readonly RemoveAutoplayPoolEntry: JungleTVRemoveAutoplayPoolEntry;
+  static readonly QueueSnapshots: JungleTVQueueSnapshots;
+  static readonly QueueSnapshot: JungleTVQueueSnapshot;
+  static readonly RestoreQueueSnapshot: JungleTVRestoreQueueSnapshot;
+  static readonly MediaReplayRules: JungleTVMediaReplayRules;
+  static readonly SetMediaReplayRule: JungleTVSetMediaReplayRule;
+  static readonly RemoveMediaReplayRule: JungleTVRemoveMediaReplayRule;
+  static readonly LibraryMedia: JungleTVLibraryMedia;
+  static readonly UploadLibraryMedia: JungleTVUploadLibraryMedia;
+  static readonly RemoveLibraryMedia: JungleTVRemoveLibraryMedia;
+  static readonly ExportQueue: JungleTVExportQueue;
+  static readonly ExportPlayedMedia: JungleTVExportPlayedMedia;
+  static readonly ImportQueue: JungleTVImportQueue;
+  static readonly PricingRules: JungleTVPricingRules;
+  static readonly SetPricingRule: JungleTVSetPricingRule;
+  static readonly RemovePricingRule: JungleTVRemovePricingRule;
+  static readonly SimulatePricing: JungleTVSimulatePricing;
   static readonly Applications: JungleTVApplications;
   static readonly GetApplication: JungleTVGetApplication;
   static readonly UpdateApplication: JungleTVUpdateApplication;
@@ -1288,10 +1798,28 @@ export class JungleTVClient {
     requestMessage: jungletv_pb.MoveQueueEntryRequest,
     callback: (error: ServiceError|null, responseMessage: jungletv_pb.MoveQueueEntryResponse|null) => void
   ): UnaryResponse;
+  queueEntryMovementCost(
+    requestMessage: jungletv_pb.QueueEntryMovementCostRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.QueueEntryMovementCostResponse|null) => void
+  ): UnaryResponse;
+  queueEntryMovementCost(
+    requestMessage: jungletv_pb.QueueEntryMovementCostRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.QueueEntryMovementCostResponse|null) => void
+  ): UnaryResponse;
   monitorTicket(requestMessage: jungletv_pb.MonitorTicketRequest, metadata?: grpc.Metadata): ResponseStream<jungletv_pb.EnqueueMediaTicket>;
   consumeMedia(requestMessage: jungletv_pb.ConsumeMediaRequest, metadata?: grpc.Metadata): ResponseStream<jungletv_pb.MediaConsumptionCheckpoint>;
   monitorQueue(requestMessage: jungletv_pb.MonitorQueueRequest, metadata?: grpc.Metadata): ResponseStream<jungletv_pb.Queue>;
   monitorSkipAndTip(requestMessage: jungletv_pb.MonitorSkipAndTipRequest, metadata?: grpc.Metadata): ResponseStream<jungletv_pb.SkipAndTipStatus>;
+  broadcastChannels(
+    requestMessage: jungletv_pb.BroadcastChannelsRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.BroadcastChannelsResponse|null) => void
+  ): UnaryResponse;
+  broadcastChannels(
+    requestMessage: jungletv_pb.BroadcastChannelsRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.BroadcastChannelsResponse|null) => void
+  ): UnaryResponse;
   rewardInfo(
     requestMessage: jungletv_pb.RewardInfoRequest,
     metadata: grpc.Metadata,
@@ -1392,6 +1920,24 @@ export class JungleTVClient {
     requestMessage: jungletv_pb.WithdrawalHistoryRequest,
     callback: (error: ServiceError|null, responseMessage: jungletv_pb.WithdrawalHistoryResponse|null) => void
   ): UnaryResponse;
+  withdrawalPreferences(
+    requestMessage: jungletv_pb.WithdrawalPreferencesRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.WithdrawalPreferencesResponse|null) => void
+  ): UnaryResponse;
+  withdrawalPreferences(
+    requestMessage: jungletv_pb.WithdrawalPreferencesRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.WithdrawalPreferencesResponse|null) => void
+  ): UnaryResponse;
+  setWithdrawalPreferences(
+    requestMessage: jungletv_pb.SetWithdrawalPreferencesRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SetWithdrawalPreferencesResponse|null) => void
+  ): UnaryResponse;
+  setWithdrawalPreferences(
+    requestMessage: jungletv_pb.SetWithdrawalPreferencesRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SetWithdrawalPreferencesResponse|null) => void
+  ): UnaryResponse;
   ongoingRaffleInfo(
     requestMessage: jungletv_pb.OngoingRaffleInfoRequest,
     metadata: grpc.Metadata,
@@ -1537,6 +2083,43 @@ export class JungleTVClient {
     callback: (error: ServiceError|null, responseMessage: jungletv_pb.ChatGifSearchResponse|null) => void
   ): UnaryResponse;
   convertBananoToPoints(requestMessage: jungletv_pb.ConvertBananoToPointsRequest, metadata?: grpc.Metadata): ResponseStream<jungletv_pb.ConvertBananoToPointsStatus>;
+  tipWithPoints(
+    requestMessage: jungletv_pb.TipWithPointsRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.TipWithPointsResponse|null) => void
+  ): UnaryResponse;
+  tipWithPoints(
+    requestMessage: jungletv_pb.TipWithPointsRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.TipWithPointsResponse|null) => void
+  ): UnaryResponse;
+  tipWithBanano(requestMessage: jungletv_pb.TipWithBananoRequest, metadata?: grpc.Metadata): ResponseStream<jungletv_pb.TipWithBananoStatus>;
+  pointsRewards(
+    requestMessage: jungletv_pb.PointsRewardsRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.PointsRewardsResponse|null) => void
+  ): UnaryResponse;
+  pointsRewards(
+    requestMessage: jungletv_pb.PointsRewardsRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.PointsRewardsResponse|null) => void
+  ): UnaryResponse;
+  redeemPointsReward(
+    requestMessage: jungletv_pb.RedeemPointsRewardRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RedeemPointsRewardResponse|null) => void
+  ): UnaryResponse;
+  redeemPointsReward(
+    requestMessage: jungletv_pb.RedeemPointsRewardRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RedeemPointsRewardResponse|null) => void
+  ): UnaryResponse;
+  pointsRewardRedemptions(
+    requestMessage: jungletv_pb.PointsRewardRedemptionsRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.PointsRewardRedemptionsResponse|null) => void
+  ): UnaryResponse;
+  pointsRewardRedemptions(
+    requestMessage: jungletv_pb.PointsRewardRedemptionsRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.PointsRewardRedemptionsResponse|null) => void
+  ): UnaryResponse;
   startOrExtendSubscription(
     requestMessage: jungletv_pb.StartOrExtendSubscriptionRequest,
     metadata: grpc.Metadata,
@@ -1564,6 +2147,15 @@ export class JungleTVClient {
     requestMessage: jungletv_pb.IncreaseOrReduceSkipThresholdRequest,
     callback: (error: ServiceError|null, responseMessage: jungletv_pb.IncreaseOrReduceSkipThresholdResponse|null) => void
   ): UnaryResponse;
+  voteToSkip(
+    requestMessage: jungletv_pb.VoteToSkipRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.VoteToSkipResponse|null) => void
+  ): UnaryResponse;
+  voteToSkip(
+    requestMessage: jungletv_pb.VoteToSkipRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.VoteToSkipResponse|null) => void
+  ): UnaryResponse;
   checkMediaEnqueuingPassword(
     requestMessage: jungletv_pb.CheckMediaEnqueuingPasswordRequest,
     metadata: grpc.Metadata,
@@ -1854,6 +2446,78 @@ export class JungleTVClient {
     requestMessage: jungletv_pb.RedrawRaffleRequest,
     callback: (error: ServiceError|null, responseMessage: jungletv_pb.RedrawRaffleResponse|null) => void
   ): UnaryResponse;
+  refunds(
+    requestMessage: jungletv_pb.RefundsRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RefundsResponse|null) => void
+  ): UnaryResponse;
+  refunds(
+    requestMessage: jungletv_pb.RefundsRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RefundsResponse|null) => void
+  ): UnaryResponse;
+  proposeRefund(
+    requestMessage: jungletv_pb.ProposeRefundRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ProposeRefundResponse|null) => void
+  ): UnaryResponse;
+  proposeRefund(
+    requestMessage: jungletv_pb.ProposeRefundRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ProposeRefundResponse|null) => void
+  ): UnaryResponse;
+  approveRefund(
+    requestMessage: jungletv_pb.ApproveRefundRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ApproveRefundResponse|null) => void
+  ): UnaryResponse;
+  approveRefund(
+    requestMessage: jungletv_pb.ApproveRefundRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ApproveRefundResponse|null) => void
+  ): UnaryResponse;
+  retryRefund(
+    requestMessage: jungletv_pb.RetryRefundRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RetryRefundResponse|null) => void
+  ): UnaryResponse;
+  retryRefund(
+    requestMessage: jungletv_pb.RetryRefundRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RetryRefundResponse|null) => void
+  ): UnaryResponse;
+  reconcileJournal(
+    requestMessage: jungletv_pb.ReconcileJournalRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ReconcileJournalResponse|null) => void
+  ): UnaryResponse;
+  reconcileJournal(
+    requestMessage: jungletv_pb.ReconcileJournalRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ReconcileJournalResponse|null) => void
+  ): UnaryResponse;
+  scheduledRains(
+    requestMessage: jungletv_pb.ScheduledRainsRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ScheduledRainsResponse|null) => void
+  ): UnaryResponse;
+  scheduledRains(
+    requestMessage: jungletv_pb.ScheduledRainsRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ScheduledRainsResponse|null) => void
+  ): UnaryResponse;
+  scheduleRain(
+    requestMessage: jungletv_pb.ScheduleRainRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ScheduleRainResponse|null) => void
+  ): UnaryResponse;
+  scheduleRain(
+    requestMessage: jungletv_pb.ScheduleRainRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ScheduleRainResponse|null) => void
+  ): UnaryResponse;
+  cancelScheduledRain(
+    requestMessage: jungletv_pb.CancelScheduledRainRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.CancelScheduledRainResponse|null) => void
+  ): UnaryResponse;
+  cancelScheduledRain(
+    requestMessage: jungletv_pb.CancelScheduledRainRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.CancelScheduledRainResponse|null) => void
+  ): UnaryResponse;
   triggerAnnouncementsNotification(
     requestMessage: jungletv_pb.TriggerAnnouncementsNotificationRequest,
     metadata: grpc.Metadata,
@@ -1900,6 +2564,42 @@ export class JungleTVClient {
     requestMessage: jungletv_pb.SetQueueEntryReorderingAllowedRequest,
     callback: (error: ServiceError|null, responseMessage: jungletv_pb.SetQueueEntryReorderingAllowedResponse|null) => void
   ): UnaryResponse;
+  setQueueOrderingPolicy(
+    requestMessage: jungletv_pb.SetQueueOrderingPolicyRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SetQueueOrderingPolicyResponse|null) => void
+  ): UnaryResponse;
+  setQueueOrderingPolicy(
+    requestMessage: jungletv_pb.SetQueueOrderingPolicyRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SetQueueOrderingPolicyResponse|null) => void
+  ): UnaryResponse;
+  setRewardDistributionStrategy(
+    requestMessage: jungletv_pb.SetRewardDistributionStrategyRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SetRewardDistributionStrategyResponse|null) => void
+  ): UnaryResponse;
+  setRewardDistributionStrategy(
+    requestMessage: jungletv_pb.SetRewardDistributionStrategyRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SetRewardDistributionStrategyResponse|null) => void
+  ): UnaryResponse;
+  pauseBroadcast(
+    requestMessage: jungletv_pb.PauseBroadcastRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.PauseBroadcastResponse|null) => void
+  ): UnaryResponse;
+  pauseBroadcast(
+    requestMessage: jungletv_pb.PauseBroadcastRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.PauseBroadcastResponse|null) => void
+  ): UnaryResponse;
+  resumeBroadcast(
+    requestMessage: jungletv_pb.ResumeBroadcastRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ResumeBroadcastResponse|null) => void
+  ): UnaryResponse;
+  resumeBroadcast(
+    requestMessage: jungletv_pb.ResumeBroadcastRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ResumeBroadcastResponse|null) => void
+  ): UnaryResponse;
   setNewQueueEntriesAlwaysUnskippable(
     requestMessage: jungletv_pb.SetNewQueueEntriesAlwaysUnskippableRequest,
     metadata: grpc.Metadata,
@@ -1972,6 +2672,33 @@ export class JungleTVClient {
     requestMessage: jungletv_pb.AdjustPointsBalanceRequest,
     callback: (error: ServiceError|null, responseMessage: jungletv_pb.AdjustPointsBalanceResponse|null) => void
   ): UnaryResponse;
+  setPointsReward(
+    requestMessage: jungletv_pb.SetPointsRewardRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SetPointsRewardResponse|null) => void
+  ): UnaryResponse;
+  setPointsReward(
+    requestMessage: jungletv_pb.SetPointsRewardRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SetPointsRewardResponse|null) => void
+  ): UnaryResponse;
+  allPointsRewardRedemptions(
+    requestMessage: jungletv_pb.AllPointsRewardRedemptionsRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.AllPointsRewardRedemptionsResponse|null) => void
+  ): UnaryResponse;
+  allPointsRewardRedemptions(
+    requestMessage: jungletv_pb.AllPointsRewardRedemptionsRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.AllPointsRewardRedemptionsResponse|null) => void
+  ): UnaryResponse;
+  updatePointsRewardRedemption(
+    requestMessage: jungletv_pb.UpdatePointsRewardRedemptionRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.UpdatePointsRewardRedemptionResponse|null) => void
+  ): UnaryResponse;
+  updatePointsRewardRedemption(
+    requestMessage: jungletv_pb.UpdatePointsRewardRedemptionRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.UpdatePointsRewardRedemptionResponse|null) => void
+  ): UnaryResponse;
   addVipUser(
     requestMessage: jungletv_pb.AddVipUserRequest,
     metadata: grpc.Metadata,
@@ -2035,6 +2762,240 @@ export class JungleTVClient {
     requestMessage: jungletv_pb.SpectatorsRequest,
     callback: (error: ServiceError|null, responseMessage: jungletv_pb.SpectatorsResponse|null) => void
   ): UnaryResponse;
+  timetableSlots(
+    requestMessage: jungletv_pb.TimetableSlotsRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.TimetableSlotsResponse|null) => void
+  ): UnaryResponse;
+  timetableSlots(
+    requestMessage: jungletv_pb.TimetableSlotsRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.TimetableSlotsResponse|null) => void
+  ): UnaryResponse;
+  setTimetableSlot(
+    requestMessage: jungletv_pb.SetTimetableSlotRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SetTimetableSlotResponse|null) => void
+  ): UnaryResponse;
+  setTimetableSlot(
+    requestMessage: jungletv_pb.SetTimetableSlotRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SetTimetableSlotResponse|null) => void
+  ): UnaryResponse;
+  removeTimetableSlot(
+    requestMessage: jungletv_pb.RemoveTimetableSlotRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RemoveTimetableSlotResponse|null) => void
+  ): UnaryResponse;
+  removeTimetableSlot(
+    requestMessage: jungletv_pb.RemoveTimetableSlotRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RemoveTimetableSlotResponse|null) => void
+  ): UnaryResponse;
+  createBroadcastChannel(
+    requestMessage: jungletv_pb.CreateBroadcastChannelRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.CreateBroadcastChannelResponse|null) => void
+  ): UnaryResponse;
+  createBroadcastChannel(
+    requestMessage: jungletv_pb.CreateBroadcastChannelRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.CreateBroadcastChannelResponse|null) => void
+  ): UnaryResponse;
+  autoplayPools(
+    requestMessage: jungletv_pb.AutoplayPoolsRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.AutoplayPoolsResponse|null) => void
+  ): UnaryResponse;
+  autoplayPools(
+    requestMessage: jungletv_pb.AutoplayPoolsRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.AutoplayPoolsResponse|null) => void
+  ): UnaryResponse;
+  setAutoplayPool(
+    requestMessage: jungletv_pb.SetAutoplayPoolRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SetAutoplayPoolResponse|null) => void
+  ): UnaryResponse;
+  setAutoplayPool(
+    requestMessage: jungletv_pb.SetAutoplayPoolRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SetAutoplayPoolResponse|null) => void
+  ): UnaryResponse;
+  removeAutoplayPool(
+    requestMessage: jungletv_pb.RemoveAutoplayPoolRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RemoveAutoplayPoolResponse|null) => void
+  ): UnaryResponse;
+  removeAutoplayPool(
+    requestMessage: jungletv_pb.RemoveAutoplayPoolRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RemoveAutoplayPoolResponse|null) => void
+  ): UnaryResponse;
+  autoplayPoolEntries(
+    requestMessage: jungletv_pb.AutoplayPoolEntriesRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.AutoplayPoolEntriesResponse|null) => void
+  ): UnaryResponse;
+  autoplayPoolEntries(
+    requestMessage: jungletv_pb.AutoplayPoolEntriesRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.AutoplayPoolEntriesResponse|null) => void
+  ): UnaryResponse;
+  addAutoplayPoolEntry(
+    requestMessage: jungletv_pb.AddAutoplayPoolEntryRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.AddAutoplayPoolEntryResponse|null) => void
+  ): UnaryResponse;
+  addAutoplayPoolEntry(
+    requestMessage: jungletv_pb.AddAutoplayPoolEntryRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.AddAutoplayPoolEntryResponse|null) => void
+  ): UnaryResponse;
+  removeAutoplayPoolEntry(
+    requestMessage: jungletv_pb.RemoveAutoplayPoolEntryRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RemoveAutoplayPoolEntryResponse|null) => void
+  ): UnaryResponse;
+  removeAutoplayPoolEntry(
+    requestMessage: jungletv_pb.RemoveAutoplayPoolEntryRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RemoveAutoplayPoolEntryResponse|null) => void
+  ): UnaryResponse;
+  queueSnapshots(
+    requestMessage: jungletv_pb.QueueSnapshotsRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.QueueSnapshotsResponse|null) => void
+  ): UnaryResponse;
+  queueSnapshots(
+    requestMessage: jungletv_pb.QueueSnapshotsRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.QueueSnapshotsResponse|null) => void
+  ): UnaryResponse;
+  queueSnapshot(
+    requestMessage: jungletv_pb.QueueSnapshotRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.QueueSnapshotResponse|null) => void
+  ): UnaryResponse;
+  queueSnapshot(
+    requestMessage: jungletv_pb.QueueSnapshotRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.QueueSnapshotResponse|null) => void
+  ): UnaryResponse;
+  restoreQueueSnapshot(
+    requestMessage: jungletv_pb.RestoreQueueSnapshotRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RestoreQueueSnapshotResponse|null) => void
+  ): UnaryResponse;
+  restoreQueueSnapshot(
+    requestMessage: jungletv_pb.RestoreQueueSnapshotRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RestoreQueueSnapshotResponse|null) => void
+  ): UnaryResponse;
+  mediaReplayRules(
+    requestMessage: jungletv_pb.MediaReplayRulesRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.MediaReplayRulesResponse|null) => void
+  ): UnaryResponse;
+  mediaReplayRules(
+    requestMessage: jungletv_pb.MediaReplayRulesRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.MediaReplayRulesResponse|null) => void
+  ): UnaryResponse;
+  setMediaReplayRule(
+    requestMessage: jungletv_pb.SetMediaReplayRuleRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SetMediaReplayRuleResponse|null) => void
+  ): UnaryResponse;
+  setMediaReplayRule(
+    requestMessage: jungletv_pb.SetMediaReplayRuleRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SetMediaReplayRuleResponse|null) => void
+  ): UnaryResponse;
+  removeMediaReplayRule(
+    requestMessage: jungletv_pb.RemoveMediaReplayRuleRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RemoveMediaReplayRuleResponse|null) => void
+  ): UnaryResponse;
+  removeMediaReplayRule(
+    requestMessage: jungletv_pb.RemoveMediaReplayRuleRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RemoveMediaReplayRuleResponse|null) => void
+  ): UnaryResponse;
+  libraryMedia(
+    requestMessage: jungletv_pb.LibraryMediaRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.LibraryMediaResponse|null) => void
+  ): UnaryResponse;
+  libraryMedia(
+    requestMessage: jungletv_pb.LibraryMediaRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.LibraryMediaResponse|null) => void
+  ): UnaryResponse;
+  uploadLibraryMedia(
+    requestMessage: jungletv_pb.UploadLibraryMediaRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.UploadLibraryMediaResponse|null) => void
+  ): UnaryResponse;
+  uploadLibraryMedia(
+    requestMessage: jungletv_pb.UploadLibraryMediaRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.UploadLibraryMediaResponse|null) => void
+  ): UnaryResponse;
+  removeLibraryMedia(
+    requestMessage: jungletv_pb.RemoveLibraryMediaRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RemoveLibraryMediaResponse|null) => void
+  ): UnaryResponse;
+  removeLibraryMedia(
+    requestMessage: jungletv_pb.RemoveLibraryMediaRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RemoveLibraryMediaResponse|null) => void
+  ): UnaryResponse;
+  exportQueue(
+    requestMessage: jungletv_pb.ExportQueueRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ExportQueueResponse|null) => void
+  ): UnaryResponse;
+  exportQueue(
+    requestMessage: jungletv_pb.ExportQueueRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ExportQueueResponse|null) => void
+  ): UnaryResponse;
+  exportPlayedMedia(
+    requestMessage: jungletv_pb.ExportPlayedMediaRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ExportPlayedMediaResponse|null) => void
+  ): UnaryResponse;
+  exportPlayedMedia(
+    requestMessage: jungletv_pb.ExportPlayedMediaRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ExportPlayedMediaResponse|null) => void
+  ): UnaryResponse;
+  importQueue(
+    requestMessage: jungletv_pb.ImportQueueRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ImportQueueResponse|null) => void
+  ): UnaryResponse;
+  importQueue(
+    requestMessage: jungletv_pb.ImportQueueRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.ImportQueueResponse|null) => void
+  ): UnaryResponse;
+  pricingRules(
+    requestMessage: jungletv_pb.PricingRulesRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.PricingRulesResponse|null) => void
+  ): UnaryResponse;
+  pricingRules(
+    requestMessage: jungletv_pb.PricingRulesRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.PricingRulesResponse|null) => void
+  ): UnaryResponse;
+  setPricingRule(
+    requestMessage: jungletv_pb.SetPricingRuleRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SetPricingRuleResponse|null) => void
+  ): UnaryResponse;
+  setPricingRule(
+    requestMessage: jungletv_pb.SetPricingRuleRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SetPricingRuleResponse|null) => void
+  ): UnaryResponse;
+  removePricingRule(
+    requestMessage: jungletv_pb.RemovePricingRuleRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RemovePricingRuleResponse|null) => void
+  ): UnaryResponse;
+  removePricingRule(
+    requestMessage: jungletv_pb.RemovePricingRuleRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.RemovePricingRuleResponse|null) => void
+  ): UnaryResponse;
+  simulatePricing(
+    requestMessage: jungletv_pb.SimulatePricingRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SimulatePricingResponse|null) => void
+  ): UnaryResponse;
+  simulatePricing(
+    requestMessage: jungletv_pb.SimulatePricingRequest,
+    callback: (error: ServiceError|null, responseMessage: jungletv_pb.SimulatePricingResponse|null) => void
+  ): UnaryResponse;
   applications(
     requestMessage: application_editor_pb.ApplicationsRequest,
     metadata: grpc.Metadata,
@@ -66,6 +66,15 @@ JungleTV.MoveQueueEntry = {
   responseType: jungletv_pb.MoveQueueEntryResponse
 };
 
+JungleTV.QueueEntryMovementCost = {
+  methodName: "QueueEntryMovementCost",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.QueueEntryMovementCostRequest,
+  responseType: jungletv_pb.QueueEntryMovementCostResponse
+};
+
 JungleTV.MonitorTicket = {
   methodName: "MonitorTicket",
   service: JungleTV,
@@ -102,6 +111,15 @@ JungleTV.MonitorSkipAndTip = {
   responseType: jungletv_pb.SkipAndTipStatus
 };
 
+JungleTV.BroadcastChannels = {
+  methodName: "BroadcastChannels",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.BroadcastChannelsRequest,
+  responseType: jungletv_pb.BroadcastChannelsResponse
+};
+
 JungleTV.RewardInfo = {
   methodName: "RewardInfo",
   service: JungleTV,
@@ -210,6 +228,24 @@ JungleTV.WithdrawalHistory = {
   responseType: jungletv_pb.WithdrawalHistoryResponse
 };
 
+JungleTV.WithdrawalPreferences = {
+  methodName: "WithdrawalPreferences",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.WithdrawalPreferencesRequest,
+  responseType: jungletv_pb.WithdrawalPreferencesResponse
+};
+
+JungleTV.SetWithdrawalPreferences = {
+  methodName: "SetWithdrawalPreferences",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.SetWithdrawalPreferencesRequest,
+  responseType: jungletv_pb.SetWithdrawalPreferencesResponse
+};
+
 JungleTV.OngoingRaffleInfo = {
   methodName: "OngoingRaffleInfo",
   service: JungleTV,
@@ -363,6 +399,51 @@ JungleTV.ConvertBananoToPoints = {
   responseType: jungletv_pb.ConvertBananoToPointsStatus
 };
 
+JungleTV.TipWithPoints = {
+  methodName: "TipWithPoints",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.TipWithPointsRequest,
+  responseType: jungletv_pb.TipWithPointsResponse
+};
+
+JungleTV.TipWithBanano = {
+  methodName: "TipWithBanano",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: true,
+  requestType: jungletv_pb.TipWithBananoRequest,
+  responseType: jungletv_pb.TipWithBananoStatus
+};
+
+JungleTV.PointsRewards = {
+  methodName: "PointsRewards",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.PointsRewardsRequest,
+  responseType: jungletv_pb.PointsRewardsResponse
+};
+
+JungleTV.RedeemPointsReward = {
+  methodName: "RedeemPointsReward",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.RedeemPointsRewardRequest,
+  responseType: jungletv_pb.RedeemPointsRewardResponse
+};
+
+JungleTV.PointsRewardRedemptions = {
+  methodName: "PointsRewardRedemptions",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.PointsRewardRedemptionsRequest,
+  responseType: jungletv_pb.PointsRewardRedemptionsResponse
+};
+
 JungleTV.StartOrExtendSubscription = {
   methodName: "StartOrExtendSubscription",
   service: JungleTV,
@@ -390,6 +471,15 @@ JungleTV.IncreaseOrReduceSkipThreshold = {
   responseType: jungletv_pb.IncreaseOrReduceSkipThresholdResponse
 };
 
+JungleTV.VoteToSkip = {
+  methodName: "VoteToSkip",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.VoteToSkipRequest,
+  responseType: jungletv_pb.VoteToSkipResponse
+};
+
 JungleTV.CheckMediaEnqueuingPassword = {
   methodName: "CheckMediaEnqueuingPassword",
   service: JungleTV,
@@ -696,6 +786,78 @@ JungleTV.RedrawRaffle = {
   responseType: jungletv_pb.RedrawRaffleResponse
 };
 
+JungleTV.Refunds = {
+  methodName: "Refunds",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.RefundsRequest,
+  responseType: jungletv_pb.RefundsResponse
+};
+
+JungleTV.ProposeRefund = {
+  methodName: "ProposeRefund",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.ProposeRefundRequest,
+  responseType: jungletv_pb.ProposeRefundResponse
+};
+
+JungleTV.ApproveRefund = {
+  methodName: "ApproveRefund",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.ApproveRefundRequest,
+  responseType: jungletv_pb.ApproveRefundResponse
+};
+
+JungleTV.RetryRefund = {
+  methodName: "RetryRefund",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.RetryRefundRequest,
+  responseType: jungletv_pb.RetryRefundResponse
+};
+
+JungleTV.ReconcileJournal = {
+  methodName: "ReconcileJournal",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.ReconcileJournalRequest,
+  responseType: jungletv_pb.ReconcileJournalResponse
+};
+
+JungleTV.ScheduledRains = {
+  methodName: "ScheduledRains",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.ScheduledRainsRequest,
+  responseType: jungletv_pb.ScheduledRainsResponse
+};
+
+JungleTV.ScheduleRain = {
+  methodName: "ScheduleRain",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.ScheduleRainRequest,
+  responseType: jungletv_pb.ScheduleRainResponse
+};
+
+JungleTV.CancelScheduledRain = {
+  methodName: "CancelScheduledRain",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.CancelScheduledRainRequest,
+  responseType: jungletv_pb.CancelScheduledRainResponse
+};
+
 JungleTV.TriggerAnnouncementsNotification = {
   methodName: "TriggerAnnouncementsNotification",
   service: JungleTV,
@@ -750,6 +912,42 @@ JungleTV.SetQueueEntryReorderingAllowed = {
   responseType: jungletv_pb.SetQueueEntryReorderingAllowedResponse
 };
 
+JungleTV.SetQueueOrderingPolicy = {
+  methodName: "SetQueueOrderingPolicy",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.SetQueueOrderingPolicyRequest,
+  responseType: jungletv_pb.SetQueueOrderingPolicyResponse
+};
+
+JungleTV.SetRewardDistributionStrategy = {
+  methodName: "SetRewardDistributionStrategy",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.SetRewardDistributionStrategyRequest,
+  responseType: jungletv_pb.SetRewardDistributionStrategyResponse
+};
+
+JungleTV.PauseBroadcast = {
+  methodName: "PauseBroadcast",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.PauseBroadcastRequest,
+  responseType: jungletv_pb.PauseBroadcastResponse
+};
+
+JungleTV.ResumeBroadcast = {
+  methodName: "ResumeBroadcast",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.ResumeBroadcastRequest,
+  responseType: jungletv_pb.ResumeBroadcastResponse
+};
+
 JungleTV.SetNewQueueEntriesAlwaysUnskippable = {
   methodName: "SetNewQueueEntriesAlwaysUnskippable",
   service: JungleTV,
@@ -822,6 +1020,33 @@ JungleTV.AdjustPointsBalance = {
   responseType: jungletv_pb.AdjustPointsBalanceResponse
 };
 
+JungleTV.SetPointsReward = {
+  methodName: "SetPointsReward",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.SetPointsRewardRequest,
+  responseType: jungletv_pb.SetPointsRewardResponse
+};
+
+JungleTV.AllPointsRewardRedemptions = {
+  methodName: "AllPointsRewardRedemptions",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.AllPointsRewardRedemptionsRequest,
+  responseType: jungletv_pb.AllPointsRewardRedemptionsResponse
+};
+
+JungleTV.UpdatePointsRewardRedemption = {
+  methodName: "UpdatePointsRewardRedemption",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.UpdatePointsRewardRedemptionRequest,
+  responseType: jungletv_pb.UpdatePointsRewardRedemptionResponse
+};
+
 JungleTV.AddVipUser = {
   methodName: "AddVipUser",
   service: JungleTV,
@@ -885,6 +1110,240 @@ JungleTV.Spectators = {
   responseType: jungletv_pb.SpectatorsResponse
 };
 
+JungleTV.TimetableSlots = {
+  methodName: "TimetableSlots",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.TimetableSlotsRequest,
+  responseType: jungletv_pb.TimetableSlotsResponse
+};
+
+JungleTV.SetTimetableSlot = {
+  methodName: "SetTimetableSlot",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.SetTimetableSlotRequest,
+  responseType: jungletv_pb.SetTimetableSlotResponse
+};
+
+JungleTV.RemoveTimetableSlot = {
+  methodName: "RemoveTimetableSlot",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.RemoveTimetableSlotRequest,
+  responseType: jungletv_pb.RemoveTimetableSlotResponse
+};
+
+JungleTV.CreateBroadcastChannel = {
+  methodName: "CreateBroadcastChannel",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.CreateBroadcastChannelRequest,
+  responseType: jungletv_pb.CreateBroadcastChannelResponse
+};
+
+JungleTV.AutoplayPools = {
+  methodName: "AutoplayPools",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.AutoplayPoolsRequest,
+  responseType: jungletv_pb.AutoplayPoolsResponse
+};
+
+JungleTV.SetAutoplayPool = {
+  methodName: "SetAutoplayPool",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.SetAutoplayPoolRequest,
+  responseType: jungletv_pb.SetAutoplayPoolResponse
+};
+
+JungleTV.RemoveAutoplayPool = {
+  methodName: "RemoveAutoplayPool",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.RemoveAutoplayPoolRequest,
+  responseType: jungletv_pb.RemoveAutoplayPoolResponse
+};
+
+JungleTV.AutoplayPoolEntries = {
+  methodName: "AutoplayPoolEntries",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.AutoplayPoolEntriesRequest,
+  responseType: jungletv_pb.AutoplayPoolEntriesResponse
+};
+
+JungleTV.AddAutoplayPoolEntry = {
+  methodName: "AddAutoplayPoolEntry",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.AddAutoplayPoolEntryRequest,
+  responseType: jungletv_pb.AddAutoplayPoolEntryResponse
+};
+
+JungleTV.RemoveAutoplayPoolEntry = {
+  methodName: "RemoveAutoplayPoolEntry",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.RemoveAutoplayPoolEntryRequest,
+  responseType: jungletv_pb.RemoveAutoplayPoolEntryResponse
+};
+
+JungleTV.QueueSnapshots = {
+  methodName: "QueueSnapshots",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.QueueSnapshotsRequest,
+  responseType: jungletv_pb.QueueSnapshotsResponse
+};
+
+JungleTV.QueueSnapshot = {
+  methodName: "QueueSnapshot",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.QueueSnapshotRequest,
+  responseType: jungletv_pb.QueueSnapshotResponse
+};
+
+JungleTV.RestoreQueueSnapshot = {
+  methodName: "RestoreQueueSnapshot",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.RestoreQueueSnapshotRequest,
+  responseType: jungletv_pb.RestoreQueueSnapshotResponse
+};
+
+JungleTV.MediaReplayRules = {
+  methodName: "MediaReplayRules",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.MediaReplayRulesRequest,
+  responseType: jungletv_pb.MediaReplayRulesResponse
+};
+
+JungleTV.SetMediaReplayRule = {
+  methodName: "SetMediaReplayRule",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.SetMediaReplayRuleRequest,
+  responseType: jungletv_pb.SetMediaReplayRuleResponse
+};
+
+JungleTV.RemoveMediaReplayRule = {
+  methodName: "RemoveMediaReplayRule",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.RemoveMediaReplayRuleRequest,
+  responseType: jungletv_pb.RemoveMediaReplayRuleResponse
+};
+
+JungleTV.LibraryMedia = {
+  methodName: "LibraryMedia",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.LibraryMediaRequest,
+  responseType: jungletv_pb.LibraryMediaResponse
+};
+
+JungleTV.UploadLibraryMedia = {
+  methodName: "UploadLibraryMedia",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.UploadLibraryMediaRequest,
+  responseType: jungletv_pb.UploadLibraryMediaResponse
+};
+
+JungleTV.RemoveLibraryMedia = {
+  methodName: "RemoveLibraryMedia",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.RemoveLibraryMediaRequest,
+  responseType: jungletv_pb.RemoveLibraryMediaResponse
+};
+
+JungleTV.ExportQueue = {
+  methodName: "ExportQueue",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.ExportQueueRequest,
+  responseType: jungletv_pb.ExportQueueResponse
+};
+
+JungleTV.ExportPlayedMedia = {
+  methodName: "ExportPlayedMedia",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.ExportPlayedMediaRequest,
+  responseType: jungletv_pb.ExportPlayedMediaResponse
+};
+
+JungleTV.ImportQueue = {
+  methodName: "ImportQueue",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.ImportQueueRequest,
+  responseType: jungletv_pb.ImportQueueResponse
+};
+
+JungleTV.PricingRules = {
+  methodName: "PricingRules",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.PricingRulesRequest,
+  responseType: jungletv_pb.PricingRulesResponse
+};
+
+JungleTV.SetPricingRule = {
+  methodName: "SetPricingRule",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.SetPricingRuleRequest,
+  responseType: jungletv_pb.SetPricingRuleResponse
+};
+
+JungleTV.RemovePricingRule = {
+  methodName: "RemovePricingRule",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.RemovePricingRuleRequest,
+  responseType: jungletv_pb.RemovePricingRuleResponse
+};
+
+JungleTV.SimulatePricing = {
+  methodName: "SimulatePricing",
+  service: JungleTV,
+  requestStream: false,
+  responseStream: false,
+  requestType: jungletv_pb.SimulatePricingRequest,
+  responseType: jungletv_pb.SimulatePricingResponse
+};
+
 JungleTV.Applications = {
   methodName: "Applications",
   service: JungleTV,
@@ -1293,7 +1752,38 @@ JungleTVClient.prototype.moveQueueEntry = function moveQueueEntry(requestMessage
   };
 };
 
-JungleTVClient.prototype.monitorTicket = function monitorTicket(requestMessage, metadata) {
+JungleTVClient.prototype.queueEntryMovementCost = function queueEntryMovementCost(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.QueueEntryMovementCost, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.monitorTicket = function monitorTicket(requestMessage, metadata) {
   var listeners = {
     data: [],
     end: [],
@@ -1449,6 +1939,37 @@ JungleTVClient.prototype.monitorSkipAndTip = function monitorSkipAndTip(requestM
   };
 };
 
+JungleTVClient.prototype.broadcastChannels = function broadcastChannels(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.BroadcastChannels, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
 JungleTVClient.prototype.rewardInfo = function rewardInfo(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -1829,6 +2350,68 @@ JungleTVClient.prototype.withdrawalHistory = function withdrawalHistory(requestM
   };
 };
 
+JungleTVClient.prototype.withdrawalPreferences = function withdrawalPreferences(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.WithdrawalPreferences, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.setWithdrawalPreferences = function setWithdrawalPreferences(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.SetWithdrawalPreferences, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
 JungleTVClient.prototype.ongoingRaffleInfo = function ongoingRaffleInfo(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -2353,22 +2936,1534 @@ JungleTVClient.prototype.convertBananoToPoints = function convertBananoToPoints(
     }
   });
   return {
-    on: function (type, handler) {
-      listeners[type].push(handler);
-      return this;
-    },
+    on: function (type, handler) {
+      listeners[type].push(handler);
+      return this;
+    },
+    cancel: function () {
+      listeners = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.tipWithPoints = function tipWithPoints(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.TipWithPoints, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.tipWithBanano = function tipWithBanano(requestMessage, metadata) {
+  var listeners = {
+    data: [],
+    end: [],
+    status: []
+  };
+  var client = grpc.invoke(JungleTV.TipWithBanano, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onMessage: function (responseMessage) {
+      listeners.data.forEach(function (handler) {
+        handler(responseMessage);
+      });
+    },
+    onEnd: function (status, statusMessage, trailers) {
+      listeners.status.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners.end.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners = null;
+    }
+  });
+  return {
+    on: function (type, handler) {
+      listeners[type].push(handler);
+      return this;
+    },
+    cancel: function () {
+      listeners = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.pointsRewards = function pointsRewards(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.PointsRewards, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.redeemPointsReward = function redeemPointsReward(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.RedeemPointsReward, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.pointsRewardRedemptions = function pointsRewardRedemptions(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.PointsRewardRedemptions, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.startOrExtendSubscription = function startOrExtendSubscription(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.StartOrExtendSubscription, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.soundCloudTrackDetails = function soundCloudTrackDetails(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.SoundCloudTrackDetails, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.increaseOrReduceSkipThreshold = function increaseOrReduceSkipThreshold(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.IncreaseOrReduceSkipThreshold, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.voteToSkip = function voteToSkip(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.VoteToSkip, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.checkMediaEnqueuingPassword = function checkMediaEnqueuingPassword(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.CheckMediaEnqueuingPassword, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.monitorMediaEnqueuingPermission = function monitorMediaEnqueuingPermission(requestMessage, metadata) {
+  var listeners = {
+    data: [],
+    end: [],
+    status: []
+  };
+  var client = grpc.invoke(JungleTV.MonitorMediaEnqueuingPermission, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onMessage: function (responseMessage) {
+      listeners.data.forEach(function (handler) {
+        handler(responseMessage);
+      });
+    },
+    onEnd: function (status, statusMessage, trailers) {
+      listeners.status.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners.end.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners = null;
+    }
+  });
+  return {
+    on: function (type, handler) {
+      listeners[type].push(handler);
+      return this;
+    },
+    cancel: function () {
+      listeners = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.invalidateAuthTokens = function invalidateAuthTokens(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.InvalidateAuthTokens, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.authorizeApplication = function authorizeApplication(requestMessage, metadata) {
+  var listeners = {
+    data: [],
+    end: [],
+    status: []
+  };
+  var client = grpc.invoke(JungleTV.AuthorizeApplication, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onMessage: function (responseMessage) {
+      listeners.data.forEach(function (handler) {
+        handler(responseMessage);
+      });
+    },
+    onEnd: function (status, statusMessage, trailers) {
+      listeners.status.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners.end.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners = null;
+    }
+  });
+  return {
+    on: function (type, handler) {
+      listeners[type].push(handler);
+      return this;
+    },
+    cancel: function () {
+      listeners = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.authorizationProcessData = function authorizationProcessData(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.AuthorizationProcessData, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.consentOrDissentToAuthorization = function consentOrDissentToAuthorization(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.ConsentOrDissentToAuthorization, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.forciblyEnqueueTicket = function forciblyEnqueueTicket(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.ForciblyEnqueueTicket, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.removeQueueEntry = function removeQueueEntry(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.RemoveQueueEntry, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.removeChatMessage = function removeChatMessage(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.RemoveChatMessage, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.setChatSettings = function setChatSettings(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.SetChatSettings, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.setMediaEnqueuingEnabled = function setMediaEnqueuingEnabled(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.SetMediaEnqueuingEnabled, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.userBans = function userBans(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.UserBans, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.banUser = function banUser(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.BanUser, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.removeBan = function removeBan(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.RemoveBan, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.userVerifications = function userVerifications(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.UserVerifications, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.verifyUser = function verifyUser(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.VerifyUser, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.removeUserVerification = function removeUserVerification(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.RemoveUserVerification, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.userChatMessages = function userChatMessages(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.UserChatMessages, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.disallowedMedia = function disallowedMedia(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.DisallowedMedia, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.addDisallowedMedia = function addDisallowedMedia(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.AddDisallowedMedia, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.removeDisallowedMedia = function removeDisallowedMedia(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.RemoveDisallowedMedia, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.disallowedMediaCollections = function disallowedMediaCollections(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.DisallowedMediaCollections, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.addDisallowedMediaCollection = function addDisallowedMediaCollection(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.AddDisallowedMediaCollection, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.removeDisallowedMediaCollection = function removeDisallowedMediaCollection(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.RemoveDisallowedMediaCollection, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.updateDocument = function updateDocument(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.UpdateDocument, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.documents = function documents(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.Documents, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.setUserChatNickname = function setUserChatNickname(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.SetUserChatNickname, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.setPricesMultiplier = function setPricesMultiplier(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.SetPricesMultiplier, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.setMinimumPricesMultiplier = function setMinimumPricesMultiplier(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.SetMinimumPricesMultiplier, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.setCrowdfundedSkippingEnabled = function setCrowdfundedSkippingEnabled(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.SetCrowdfundedSkippingEnabled, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.setSkipPriceMultiplier = function setSkipPriceMultiplier(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.SetSkipPriceMultiplier, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.confirmRaffleWinner = function confirmRaffleWinner(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.ConfirmRaffleWinner, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.completeRaffle = function completeRaffle(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.CompleteRaffle, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.redrawRaffle = function redrawRaffle(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.RedrawRaffle, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.refunds = function refunds(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.Refunds, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.proposeRefund = function proposeRefund(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.ProposeRefund, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.approveRefund = function approveRefund(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.ApproveRefund, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.retryRefund = function retryRefund(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.RetryRefund, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+JungleTVClient.prototype.reconcileJournal = function reconcileJournal(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.ReconcileJournal, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
     cancel: function () {
-      listeners = null;
+      callback = null;
       client.close();
     }
   };
 };
 
-JungleTVClient.prototype.startOrExtendSubscription = function startOrExtendSubscription(requestMessage, metadata, callback) {
+JungleTVClient.prototype.scheduledRains = function scheduledRains(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.StartOrExtendSubscription, {
+  var client = grpc.unary(JungleTV.ScheduledRains, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2395,11 +4490,11 @@ JungleTVClient.prototype.startOrExtendSubscription = function startOrExtendSubsc
   };
 };
 
-JungleTVClient.prototype.soundCloudTrackDetails = function soundCloudTrackDetails(requestMessage, metadata, callback) {
+JungleTVClient.prototype.scheduleRain = function scheduleRain(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.SoundCloudTrackDetails, {
+  var client = grpc.unary(JungleTV.ScheduleRain, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2426,11 +4521,11 @@ JungleTVClient.prototype.soundCloudTrackDetails = function soundCloudTrackDetail
   };
 };
 
-JungleTVClient.prototype.increaseOrReduceSkipThreshold = function increaseOrReduceSkipThreshold(requestMessage, metadata, callback) {
+JungleTVClient.prototype.cancelScheduledRain = function cancelScheduledRain(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.IncreaseOrReduceSkipThreshold, {
+  var client = grpc.unary(JungleTV.CancelScheduledRain, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2457,11 +4552,11 @@ JungleTVClient.prototype.increaseOrReduceSkipThreshold = function increaseOrRedu
   };
 };
 
-JungleTVClient.prototype.checkMediaEnqueuingPassword = function checkMediaEnqueuingPassword(requestMessage, metadata, callback) {
+JungleTVClient.prototype.triggerAnnouncementsNotification = function triggerAnnouncementsNotification(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.CheckMediaEnqueuingPassword, {
+  var client = grpc.unary(JungleTV.TriggerAnnouncementsNotification, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2488,50 +4583,42 @@ JungleTVClient.prototype.checkMediaEnqueuingPassword = function checkMediaEnqueu
   };
 };
 
-JungleTVClient.prototype.monitorMediaEnqueuingPermission = function monitorMediaEnqueuingPermission(requestMessage, metadata) {
-  var listeners = {
-    data: [],
-    end: [],
-    status: []
-  };
-  var client = grpc.invoke(JungleTV.MonitorMediaEnqueuingPermission, {
+JungleTVClient.prototype.spectatorInfo = function spectatorInfo(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(JungleTV.SpectatorInfo, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
     transport: this.options.transport,
     debug: this.options.debug,
-    onMessage: function (responseMessage) {
-      listeners.data.forEach(function (handler) {
-        handler(responseMessage);
-      });
-    },
-    onEnd: function (status, statusMessage, trailers) {
-      listeners.status.forEach(function (handler) {
-        handler({ code: status, details: statusMessage, metadata: trailers });
-      });
-      listeners.end.forEach(function (handler) {
-        handler({ code: status, details: statusMessage, metadata: trailers });
-      });
-      listeners = null;
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
     }
   });
   return {
-    on: function (type, handler) {
-      listeners[type].push(handler);
-      return this;
-    },
     cancel: function () {
-      listeners = null;
+      callback = null;
       client.close();
     }
   };
 };
 
-JungleTVClient.prototype.invalidateAuthTokens = function invalidateAuthTokens(requestMessage, metadata, callback) {
+JungleTVClient.prototype.resetSpectatorStatus = function resetSpectatorStatus(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.InvalidateAuthTokens, {
+  var client = grpc.unary(JungleTV.ResetSpectatorStatus, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2558,13 +4645,13 @@ JungleTVClient.prototype.invalidateAuthTokens = function invalidateAuthTokens(re
   };
 };
 
-JungleTVClient.prototype.authorizeApplication = function authorizeApplication(requestMessage, metadata) {
+JungleTVClient.prototype.monitorModerationStatus = function monitorModerationStatus(requestMessage, metadata) {
   var listeners = {
     data: [],
     end: [],
     status: []
   };
-  var client = grpc.invoke(JungleTV.AuthorizeApplication, {
+  var client = grpc.invoke(JungleTV.MonitorModerationStatus, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2597,11 +4684,11 @@ JungleTVClient.prototype.authorizeApplication = function authorizeApplication(re
   };
 };
 
-JungleTVClient.prototype.authorizationProcessData = function authorizationProcessData(requestMessage, metadata, callback) {
+JungleTVClient.prototype.setOwnQueueEntryRemovalAllowed = function setOwnQueueEntryRemovalAllowed(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.AuthorizationProcessData, {
+  var client = grpc.unary(JungleTV.SetOwnQueueEntryRemovalAllowed, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2628,11 +4715,11 @@ JungleTVClient.prototype.authorizationProcessData = function authorizationProces
   };
 };
 
-JungleTVClient.prototype.consentOrDissentToAuthorization = function consentOrDissentToAuthorization(requestMessage, metadata, callback) {
+JungleTVClient.prototype.setQueueEntryReorderingAllowed = function setQueueEntryReorderingAllowed(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.ConsentOrDissentToAuthorization, {
+  var client = grpc.unary(JungleTV.SetQueueEntryReorderingAllowed, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2659,11 +4746,11 @@ JungleTVClient.prototype.consentOrDissentToAuthorization = function consentOrDis
   };
 };
 
-JungleTVClient.prototype.forciblyEnqueueTicket = function forciblyEnqueueTicket(requestMessage, metadata, callback) {
+JungleTVClient.prototype.setQueueOrderingPolicy = function setQueueOrderingPolicy(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.ForciblyEnqueueTicket, {
+  var client = grpc.unary(JungleTV.SetQueueOrderingPolicy, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2690,11 +4777,11 @@ JungleTVClient.prototype.forciblyEnqueueTicket = function forciblyEnqueueTicket(
   };
 };
 
-JungleTVClient.prototype.removeQueueEntry = function removeQueueEntry(requestMessage, metadata, callback) {
+JungleTVClient.prototype.setRewardDistributionStrategy = function setRewardDistributionStrategy(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.RemoveQueueEntry, {
+  var client = grpc.unary(JungleTV.SetRewardDistributionStrategy, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2721,11 +4808,11 @@ JungleTVClient.prototype.removeQueueEntry = function removeQueueEntry(requestMes
   };
 };
 
-JungleTVClient.prototype.removeChatMessage = function removeChatMessage(requestMessage, metadata, callback) {
+JungleTVClient.prototype.pauseBroadcast = function pauseBroadcast(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.RemoveChatMessage, {
+  var client = grpc.unary(JungleTV.PauseBroadcast, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2752,11 +4839,11 @@ JungleTVClient.prototype.removeChatMessage = function removeChatMessage(requestM
   };
 };
 
-JungleTVClient.prototype.setChatSettings = function setChatSettings(requestMessage, metadata, callback) {
+JungleTVClient.prototype.resumeBroadcast = function resumeBroadcast(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.SetChatSettings, {
+  var client = grpc.unary(JungleTV.ResumeBroadcast, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2783,11 +4870,11 @@ JungleTVClient.prototype.setChatSettings = function setChatSettings(requestMessa
   };
 };
 
-JungleTVClient.prototype.setMediaEnqueuingEnabled = function setMediaEnqueuingEnabled(requestMessage, metadata, callback) {
+JungleTVClient.prototype.setNewQueueEntriesAlwaysUnskippable = function setNewQueueEntriesAlwaysUnskippable(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.SetMediaEnqueuingEnabled, {
+  var client = grpc.unary(JungleTV.SetNewQueueEntriesAlwaysUnskippable, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2814,11 +4901,11 @@ JungleTVClient.prototype.setMediaEnqueuingEnabled = function setMediaEnqueuingEn
   };
 };
 
-JungleTVClient.prototype.userBans = function userBans(requestMessage, metadata, callback) {
+JungleTVClient.prototype.setSkippingEnabled = function setSkippingEnabled(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.UserBans, {
+  var client = grpc.unary(JungleTV.SetSkippingEnabled, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2845,11 +4932,11 @@ JungleTVClient.prototype.userBans = function userBans(requestMessage, metadata,
   };
 };
 
-JungleTVClient.prototype.banUser = function banUser(requestMessage, metadata, callback) {
+JungleTVClient.prototype.setQueueInsertCursor = function setQueueInsertCursor(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.BanUser, {
+  var client = grpc.unary(JungleTV.SetQueueInsertCursor, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2876,11 +4963,11 @@ JungleTVClient.prototype.banUser = function banUser(requestMessage, metadata, ca
   };
 };
 
-JungleTVClient.prototype.removeBan = function removeBan(requestMessage, metadata, callback) {
+JungleTVClient.prototype.clearQueueInsertCursor = function clearQueueInsertCursor(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.RemoveBan, {
+  var client = grpc.unary(JungleTV.ClearQueueInsertCursor, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2907,11 +4994,11 @@ JungleTVClient.prototype.removeBan = function removeBan(requestMessage, metadata
   };
 };
 
-JungleTVClient.prototype.userVerifications = function userVerifications(requestMessage, metadata, callback) {
+JungleTVClient.prototype.clearUserProfile = function clearUserProfile(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.UserVerifications, {
+  var client = grpc.unary(JungleTV.ClearUserProfile, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2938,11 +5025,11 @@ JungleTVClient.prototype.userVerifications = function userVerifications(requestM
   };
 };
 
-JungleTVClient.prototype.verifyUser = function verifyUser(requestMessage, metadata, callback) {
+JungleTVClient.prototype.markAsActivelyModerating = function markAsActivelyModerating(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.VerifyUser, {
+  var client = grpc.unary(JungleTV.MarkAsActivelyModerating, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -2969,11 +5056,11 @@ JungleTVClient.prototype.verifyUser = function verifyUser(requestMessage, metada
   };
 };
 
-JungleTVClient.prototype.removeUserVerification = function removeUserVerification(requestMessage, metadata, callback) {
+JungleTVClient.prototype.stopActivelyModerating = function stopActivelyModerating(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.RemoveUserVerification, {
+  var client = grpc.unary(JungleTV.StopActivelyModerating, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3000,11 +5087,11 @@ JungleTVClient.prototype.removeUserVerification = function removeUserVerificatio
   };
 };
 
-JungleTVClient.prototype.userChatMessages = function userChatMessages(requestMessage, metadata, callback) {
+JungleTVClient.prototype.adjustPointsBalance = function adjustPointsBalance(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.UserChatMessages, {
+  var client = grpc.unary(JungleTV.AdjustPointsBalance, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3031,11 +5118,11 @@ JungleTVClient.prototype.userChatMessages = function userChatMessages(requestMes
   };
 };
 
-JungleTVClient.prototype.disallowedMedia = function disallowedMedia(requestMessage, metadata, callback) {
+JungleTVClient.prototype.setPointsReward = function setPointsReward(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.DisallowedMedia, {
+  var client = grpc.unary(JungleTV.SetPointsReward, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3062,11 +5149,11 @@ JungleTVClient.prototype.disallowedMedia = function disallowedMedia(requestMessa
   };
 };
 
-JungleTVClient.prototype.addDisallowedMedia = function addDisallowedMedia(requestMessage, metadata, callback) {
+JungleTVClient.prototype.allPointsRewardRedemptions = function allPointsRewardRedemptions(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.AddDisallowedMedia, {
+  var client = grpc.unary(JungleTV.AllPointsRewardRedemptions, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3093,11 +5180,11 @@ JungleTVClient.prototype.addDisallowedMedia = function addDisallowedMedia(reques
   };
 };
 
-JungleTVClient.prototype.removeDisallowedMedia = function removeDisallowedMedia(requestMessage, metadata, callback) {
+JungleTVClient.prototype.updatePointsRewardRedemption = function updatePointsRewardRedemption(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.RemoveDisallowedMedia, {
+  var client = grpc.unary(JungleTV.UpdatePointsRewardRedemption, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3124,11 +5211,11 @@ JungleTVClient.prototype.removeDisallowedMedia = function removeDisallowedMedia(
   };
 };
 
-JungleTVClient.prototype.disallowedMediaCollections = function disallowedMediaCollections(requestMessage, metadata, callback) {
+JungleTVClient.prototype.addVipUser = function addVipUser(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.DisallowedMediaCollections, {
+  var client = grpc.unary(JungleTV.AddVipUser, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3155,11 +5242,11 @@ JungleTVClient.prototype.disallowedMediaCollections = function disallowedMediaCo
   };
 };
 
-JungleTVClient.prototype.addDisallowedMediaCollection = function addDisallowedMediaCollection(requestMessage, metadata, callback) {
+JungleTVClient.prototype.removeVipUser = function removeVipUser(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.AddDisallowedMediaCollection, {
+  var client = grpc.unary(JungleTV.RemoveVipUser, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3186,11 +5273,11 @@ JungleTVClient.prototype.addDisallowedMediaCollection = function addDisallowedMe
   };
 };
 
-JungleTVClient.prototype.removeDisallowedMediaCollection = function removeDisallowedMediaCollection(requestMessage, metadata, callback) {
+JungleTVClient.prototype.triggerClientReload = function triggerClientReload(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.RemoveDisallowedMediaCollection, {
+  var client = grpc.unary(JungleTV.TriggerClientReload, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3217,11 +5304,11 @@ JungleTVClient.prototype.removeDisallowedMediaCollection = function removeDisall
   };
 };
 
-JungleTVClient.prototype.updateDocument = function updateDocument(requestMessage, metadata, callback) {
+JungleTVClient.prototype.setMulticurrencyPaymentsEnabled = function setMulticurrencyPaymentsEnabled(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.UpdateDocument, {
+  var client = grpc.unary(JungleTV.SetMulticurrencyPaymentsEnabled, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3248,11 +5335,11 @@ JungleTVClient.prototype.updateDocument = function updateDocument(requestMessage
   };
 };
 
-JungleTVClient.prototype.documents = function documents(requestMessage, metadata, callback) {
+JungleTVClient.prototype.invalidateUserAuthTokens = function invalidateUserAuthTokens(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.Documents, {
+  var client = grpc.unary(JungleTV.InvalidateUserAuthTokens, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3279,11 +5366,11 @@ JungleTVClient.prototype.documents = function documents(requestMessage, metadata
   };
 };
 
-JungleTVClient.prototype.setUserChatNickname = function setUserChatNickname(requestMessage, metadata, callback) {
+JungleTVClient.prototype.setRPCProxyEnabled = function setRPCProxyEnabled(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.SetUserChatNickname, {
+  var client = grpc.unary(JungleTV.SetRPCProxyEnabled, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3310,11 +5397,11 @@ JungleTVClient.prototype.setUserChatNickname = function setUserChatNickname(requ
   };
 };
 
-JungleTVClient.prototype.setPricesMultiplier = function setPricesMultiplier(requestMessage, metadata, callback) {
+JungleTVClient.prototype.spectators = function spectators(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.SetPricesMultiplier, {
+  var client = grpc.unary(JungleTV.Spectators, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3341,11 +5428,11 @@ JungleTVClient.prototype.setPricesMultiplier = function setPricesMultiplier(requ
   };
 };
 
-JungleTVClient.prototype.setMinimumPricesMultiplier = function setMinimumPricesMultiplier(requestMessage, metadata, callback) {
+JungleTVClient.prototype.timetableSlots = function timetableSlots(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.SetMinimumPricesMultiplier, {
+  var client = grpc.unary(JungleTV.TimetableSlots, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3372,11 +5459,11 @@ JungleTVClient.prototype.setMinimumPricesMultiplier = function setMinimumPricesM
   };
 };
 
-JungleTVClient.prototype.setCrowdfundedSkippingEnabled = function setCrowdfundedSkippingEnabled(requestMessage, metadata, callback) {
+JungleTVClient.prototype.setTimetableSlot = function setTimetableSlot(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.SetCrowdfundedSkippingEnabled, {
+  var client = grpc.unary(JungleTV.SetTimetableSlot, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3403,11 +5490,11 @@ JungleTVClient.prototype.setCrowdfundedSkippingEnabled = function setCrowdfunded
   };
 };
 
-JungleTVClient.prototype.setSkipPriceMultiplier = function setSkipPriceMultiplier(requestMessage, metadata, callback) {
+JungleTVClient.prototype.removeTimetableSlot = function removeTimetableSlot(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.SetSkipPriceMultiplier, {
+  var client = grpc.unary(JungleTV.RemoveTimetableSlot, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3434,11 +5521,11 @@ JungleTVClient.prototype.setSkipPriceMultiplier = function setSkipPriceMultiplie
   };
 };
 
-JungleTVClient.prototype.confirmRaffleWinner = function confirmRaffleWinner(requestMessage, metadata, callback) {
+JungleTVClient.prototype.createBroadcastChannel = function createBroadcastChannel(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.ConfirmRaffleWinner, {
+  var client = grpc.unary(JungleTV.CreateBroadcastChannel, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3465,11 +5552,11 @@ JungleTVClient.prototype.confirmRaffleWinner = function confirmRaffleWinner(requ
   };
 };
 
-JungleTVClient.prototype.completeRaffle = function completeRaffle(requestMessage, metadata, callback) {
+JungleTVClient.prototype.autoplayPools = function autoplayPools(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.CompleteRaffle, {
+  var client = grpc.unary(JungleTV.AutoplayPools, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3496,11 +5583,11 @@ JungleTVClient.prototype.completeRaffle = function completeRaffle(requestMessage
   };
 };
 
-JungleTVClient.prototype.redrawRaffle = function redrawRaffle(requestMessage, metadata, callback) {
+JungleTVClient.prototype.setAutoplayPool = function setAutoplayPool(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.RedrawRaffle, {
+  var client = grpc.unary(JungleTV.SetAutoplayPool, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3527,11 +5614,11 @@ JungleTVClient.prototype.redrawRaffle = function redrawRaffle(requestMessage, me
   };
 };
 
-JungleTVClient.prototype.triggerAnnouncementsNotification = function triggerAnnouncementsNotification(requestMessage, metadata, callback) {
+JungleTVClient.prototype.removeAutoplayPool = function removeAutoplayPool(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.TriggerAnnouncementsNotification, {
+  var client = grpc.unary(JungleTV.RemoveAutoplayPool, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3558,11 +5645,11 @@ JungleTVClient.prototype.triggerAnnouncementsNotification = function triggerAnno
   };
 };
 
-JungleTVClient.prototype.spectatorInfo = function spectatorInfo(requestMessage, metadata, callback) {
+JungleTVClient.prototype.autoplayPoolEntries = function autoplayPoolEntries(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.SpectatorInfo, {
+  var client = grpc.unary(JungleTV.AutoplayPoolEntries, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3589,11 +5676,11 @@ JungleTVClient.prototype.spectatorInfo = function spectatorInfo(requestMessage,
   };
 };
 
-JungleTVClient.prototype.resetSpectatorStatus = function resetSpectatorStatus(requestMessage, metadata, callback) {
+JungleTVClient.prototype.addAutoplayPoolEntry = function addAutoplayPoolEntry(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.ResetSpectatorStatus, {
+  var client = grpc.unary(JungleTV.AddAutoplayPoolEntry, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3620,50 +5707,11 @@ JungleTVClient.prototype.resetSpectatorStatus = function resetSpectatorStatus(re
   };
 };
 
-JungleTVClient.prototype.monitorModerationStatus = function monitorModerationStatus(requestMessage, metadata) {
-  var listeners = {
-    data: [],
-    end: [],
-    status: []
-  };
-  var client = grpc.invoke(JungleTV.MonitorModerationStatus, {
-    request: requestMessage,
-    host: this.serviceHost,
-    metadata: metadata,
-    transport: this.options.transport,
-    debug: this.options.debug,
-    onMessage: function (responseMessage) {
-      listeners.data.forEach(function (handler) {
-        handler(responseMessage);
-      });
-    },
-    onEnd: function (status, statusMessage, trailers) {
-      listeners.status.forEach(function (handler) {
-        handler({ code: status, details: statusMessage, metadata: trailers });
-      });
-      listeners.end.forEach(function (handler) {
-        handler({ code: status, details: statusMessage, metadata: trailers });
-      });
-      listeners = null;
-    }
-  });
-  return {
-    on: function (type, handler) {
-      listeners[type].push(handler);
-      return this;
-    },
-    cancel: function () {
-      listeners = null;
-      client.close();
-    }
-  };
-};
-
-JungleTVClient.prototype.setOwnQueueEntryRemovalAllowed = function setOwnQueueEntryRemovalAllowed(requestMessage, metadata, callback) {
+JungleTVClient.prototype.removeAutoplayPoolEntry = function removeAutoplayPoolEntry(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.SetOwnQueueEntryRemovalAllowed, {
+  var client = grpc.unary(JungleTV.RemoveAutoplayPoolEntry, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3690,11 +5738,11 @@ JungleTVClient.prototype.setOwnQueueEntryRemovalAllowed = function setOwnQueueEn
   };
 };
 
-JungleTVClient.prototype.setQueueEntryReorderingAllowed = function setQueueEntryReorderingAllowed(requestMessage, metadata, callback) {
+JungleTVClient.prototype.queueSnapshots = function queueSnapshots(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.SetQueueEntryReorderingAllowed, {
+  var client = grpc.unary(JungleTV.QueueSnapshots, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3721,11 +5769,11 @@ JungleTVClient.prototype.setQueueEntryReorderingAllowed = function setQueueEntry
   };
 };
 
-JungleTVClient.prototype.setNewQueueEntriesAlwaysUnskippable = function setNewQueueEntriesAlwaysUnskippable(requestMessage, metadata, callback) {
+JungleTVClient.prototype.queueSnapshot = function queueSnapshot(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.SetNewQueueEntriesAlwaysUnskippable, {
+  var client = grpc.unary(JungleTV.QueueSnapshot, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3752,11 +5800,11 @@ JungleTVClient.prototype.setNewQueueEntriesAlwaysUnskippable = function setNewQu
   };
 };
 
-JungleTVClient.prototype.setSkippingEnabled = function setSkippingEnabled(requestMessage, metadata, callback) {
+JungleTVClient.prototype.restoreQueueSnapshot = function restoreQueueSnapshot(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.SetSkippingEnabled, {
+  var client = grpc.unary(JungleTV.RestoreQueueSnapshot, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3783,11 +5831,11 @@ JungleTVClient.prototype.setSkippingEnabled = function setSkippingEnabled(reques
   };
 };
 
-JungleTVClient.prototype.setQueueInsertCursor = function setQueueInsertCursor(requestMessage, metadata, callback) {
+JungleTVClient.prototype.mediaReplayRules = function mediaReplayRules(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.SetQueueInsertCursor, {
+  var client = grpc.unary(JungleTV.MediaReplayRules, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3814,11 +5862,11 @@ JungleTVClient.prototype.setQueueInsertCursor = function setQueueInsertCursor(re
   };
 };
 
-JungleTVClient.prototype.clearQueueInsertCursor = function clearQueueInsertCursor(requestMessage, metadata, callback) {
+JungleTVClient.prototype.setMediaReplayRule = function setMediaReplayRule(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.ClearQueueInsertCursor, {
+  var client = grpc.unary(JungleTV.SetMediaReplayRule, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3845,11 +5893,11 @@ JungleTVClient.prototype.clearQueueInsertCursor = function clearQueueInsertCurso
   };
 };
 
-JungleTVClient.prototype.clearUserProfile = function clearUserProfile(requestMessage, metadata, callback) {
+JungleTVClient.prototype.removeMediaReplayRule = function removeMediaReplayRule(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.ClearUserProfile, {
+  var client = grpc.unary(JungleTV.RemoveMediaReplayRule, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3876,11 +5924,11 @@ JungleTVClient.prototype.clearUserProfile = function clearUserProfile(requestMes
   };
 };
 
-JungleTVClient.prototype.markAsActivelyModerating = function markAsActivelyModerating(requestMessage, metadata, callback) {
+JungleTVClient.prototype.libraryMedia = function libraryMedia(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.MarkAsActivelyModerating, {
+  var client = grpc.unary(JungleTV.LibraryMedia, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3907,11 +5955,11 @@ JungleTVClient.prototype.markAsActivelyModerating = function markAsActivelyModer
   };
 };
 
-JungleTVClient.prototype.stopActivelyModerating = function stopActivelyModerating(requestMessage, metadata, callback) {
+JungleTVClient.prototype.uploadLibraryMedia = function uploadLibraryMedia(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.StopActivelyModerating, {
+  var client = grpc.unary(JungleTV.UploadLibraryMedia, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3938,11 +5986,11 @@ JungleTVClient.prototype.stopActivelyModerating = function stopActivelyModeratin
   };
 };
 
-JungleTVClient.prototype.adjustPointsBalance = function adjustPointsBalance(requestMessage, metadata, callback) {
+JungleTVClient.prototype.removeLibraryMedia = function removeLibraryMedia(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.AdjustPointsBalance, {
+  var client = grpc.unary(JungleTV.RemoveLibraryMedia, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -3969,11 +6017,11 @@ JungleTVClient.prototype.adjustPointsBalance = function adjustPointsBalance(requ
   };
 };
 
-JungleTVClient.prototype.addVipUser = function addVipUser(requestMessage, metadata, callback) {
+JungleTVClient.prototype.exportQueue = function exportQueue(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.AddVipUser, {
+  var client = grpc.unary(JungleTV.ExportQueue, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -4000,11 +6048,11 @@ JungleTVClient.prototype.addVipUser = function addVipUser(requestMessage, metada
   };
 };
 
-JungleTVClient.prototype.removeVipUser = function removeVipUser(requestMessage, metadata, callback) {
+JungleTVClient.prototype.exportPlayedMedia = function exportPlayedMedia(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.RemoveVipUser, {
+  var client = grpc.unary(JungleTV.ExportPlayedMedia, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -4031,11 +6079,11 @@ JungleTVClient.prototype.removeVipUser = function removeVipUser(requestMessage,
   };
 };
 
-JungleTVClient.prototype.triggerClientReload = function triggerClientReload(requestMessage, metadata, callback) {
+JungleTVClient.prototype.importQueue = function importQueue(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.TriggerClientReload, {
+  var client = grpc.unary(JungleTV.ImportQueue, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -4062,11 +6110,11 @@ JungleTVClient.prototype.triggerClientReload = function triggerClientReload(requ
   };
 };
 
-JungleTVClient.prototype.setMulticurrencyPaymentsEnabled = function setMulticurrencyPaymentsEnabled(requestMessage, metadata, callback) {
+JungleTVClient.prototype.pricingRules = function pricingRules(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.SetMulticurrencyPaymentsEnabled, {
+  var client = grpc.unary(JungleTV.PricingRules, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -4093,11 +6141,11 @@ JungleTVClient.prototype.setMulticurrencyPaymentsEnabled = function setMulticurr
   };
 };
 
-JungleTVClient.prototype.invalidateUserAuthTokens = function invalidateUserAuthTokens(requestMessage, metadata, callback) {
+JungleTVClient.prototype.setPricingRule = function setPricingRule(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.InvalidateUserAuthTokens, {
+  var client = grpc.unary(JungleTV.SetPricingRule, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -4124,11 +6172,11 @@ JungleTVClient.prototype.invalidateUserAuthTokens = function invalidateUserAuthT
   };
 };
 
-JungleTVClient.prototype.setRPCProxyEnabled = function setRPCProxyEnabled(requestMessage, metadata, callback) {
+JungleTVClient.prototype.removePricingRule = function removePricingRule(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.SetRPCProxyEnabled, {
+  var client = grpc.unary(JungleTV.RemovePricingRule, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
@@ -4155,11 +6203,11 @@ JungleTVClient.prototype.setRPCProxyEnabled = function setRPCProxyEnabled(reques
   };
 };
 
-JungleTVClient.prototype.spectators = function spectators(requestMessage, metadata, callback) {
+JungleTVClient.prototype.simulatePricing = function simulatePricing(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
   }
-  var client = grpc.unary(JungleTV.Spectators, {
+  var client = grpc.unary(JungleTV.SimulatePricing, {
     request: requestMessage,
     host: this.serviceHost,
     metadata: metadata,
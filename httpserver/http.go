@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/pprof"
 	"strings"
+	"time"
 
 	"github.com/arl/statsviz"
 	"github.com/gbl08ma/ssoclient"
@@ -16,9 +17,11 @@ import (
 	"github.com/tnyim/jungletv/buildconfig"
 	"github.com/tnyim/jungletv/server/auth"
 	"github.com/tnyim/jungletv/server/components/apprunner"
+	"github.com/tnyim/jungletv/server/components/medialibrary"
 	"github.com/tnyim/jungletv/server/components/oauth"
 	"github.com/tnyim/jungletv/server/components/raffle"
 	"github.com/tnyim/jungletv/server/interceptors/version"
+	"github.com/tnyim/jungletv/server/media/playlist"
 	"github.com/uptrace/bunrouter"
 	"github.com/uptrace/bunrouter/extra/basicauth"
 )
@@ -31,8 +34,10 @@ type HTTPServer struct {
 	jwtManager         *auth.JWTManager
 	oauthManager       *oauth.Manager
 	appRunner          *apprunner.AppRunner
+	mediaLibrary       *medialibrary.Library
 	versionInterceptor *version.VersionInterceptor
 	signatureVerifier  SignatureVerifier
+	playlistExporter   PlaylistExporter
 	templateCache      *templateCache
 	ssoCookieStore     *sessions.CookieStore                    // optional, needed if daClient is not nil
 	daClient           *ssoclient.SSOClient                     // optional
@@ -44,16 +49,23 @@ type SignatureVerifier interface {
 	VerifySignature(ctx context.Context, processID string, signature []byte, submissionMethod string) error
 }
 
+type PlaylistExporter interface {
+	ExportQueuePlaylist(ctx context.Context, channelID string, format playlist.Format) (string, []byte, error)
+	ExportPlayedMediaPlaylist(ctx context.Context, channelID string, since, until time.Time, format playlist.Format) (string, []byte, error)
+}
+
 func New(
 	log *log.Logger,
 	authLog *log.Logger,
 	jwtManager *auth.JWTManager,
 	oauthManager *oauth.Manager,
 	appRunner *apprunner.AppRunner,
+	mediaLibrary *medialibrary.Library,
 	websiteURL string,
 	raffleSecretKey string,
 	versionInterceptor *version.VersionInterceptor,
 	signatureVerifier SignatureVerifier,
+	playlistExporter PlaylistExporter,
 	daClient *ssoclient.SSOClient,
 	ssoCookieStore *sessions.CookieStore,
 	basicAuthChecker func(ip, username, password string) bool,
@@ -75,8 +87,10 @@ func New(
 		jwtManager:         jwtManager,
 		oauthManager:       oauthManager,
 		appRunner:          appRunner,
+		mediaLibrary:       mediaLibrary,
 		versionInterceptor: versionInterceptor,
 		signatureVerifier:  signatureVerifier,
+		playlistExporter:   playlistExporter,
 		templateCache:      templateCache,
 		ssoCookieStore:     ssoCookieStore,
 		daClient:           daClient,
@@ -129,6 +143,9 @@ func (s *HTTPServer) configureRoutes(router *bunrouter.Router) {
 	router.GET("/raffles/weekly/:year/:week", s.RaffleInfo)
 	router.GET("/oauth/callback", s.OAuthCallback)
 	router.GET("/oauth/monkeyconnect/callback", s.OAuthCallback)
+	router.GET("/assets/library/:id", s.LibraryMedia)
+	router.GET("/admin/export/queue", s.ExportQueue)
+	router.GET("/admin/export/played", s.ExportPlayedMedia)
 	router.GET("/assets/app/:app/:ignoredVersionForCacheBusting/:part", func(w http.ResponseWriter, r bunrouter.Request) error {
 		part := r.Param("part")
 		if part == "**appbridge.js" {
@@ -0,0 +1,45 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"os"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"github.com/uptrace/bunrouter"
+)
+
+func (s *HTTPServer) LibraryMedia(w http.ResponseWriter, r bunrouter.Request) error {
+	ctx, err := transaction.Begin(r.Context())
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() //read-only tx
+
+	libraryMedia, err := types.GetLibraryMediaWithID(ctx, r.Param("id"))
+	if err != nil {
+		if errors.Is(err, types.ErrLibraryMediaNotFound) {
+			http.NotFound(w, r.Request)
+			return nil
+		}
+		return stacktrace.Propagate(err, "")
+	}
+
+	f, err := s.mediaLibrary.Open(libraryMedia.ID)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			http.NotFound(w, r.Request)
+			return nil
+		}
+		return stacktrace.Propagate(err, "")
+	}
+	defer f.Close()
+
+	// audio-only files play just as well when served with a video content type
+	w.Header().Set("Content-Type", "video/"+libraryMedia.Format)
+	// ServeContent takes care of range requests, which players use for seeking
+	http.ServeContent(w, r.Request, "", libraryMedia.UploadedAt, f)
+	return nil
+}
@@ -0,0 +1,122 @@
+package httpserver
+
+import (
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/media/playlist"
+	"github.com/uptrace/bunrouter"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *HTTPServer) ExportQueue(w http.ResponseWriter, r bunrouter.Request) error {
+	if !s.isAdminRequest(r.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return nil
+	}
+
+	format, ok := playlistFormatFromQuery(r.Request)
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		return nil
+	}
+
+	fileName, content, err := s.playlistExporter.ExportQueuePlaylist(r.Context(), r.URL.Query().Get("channel"), format)
+	if err != nil {
+		return stacktrace.Propagate(writePlaylistExportError(w, err), "")
+	}
+	return stacktrace.Propagate(writePlaylistFile(w, fileName, format, content), "")
+}
+
+func (s *HTTPServer) ExportPlayedMedia(w http.ResponseWriter, r bunrouter.Request) error {
+	if !s.isAdminRequest(r.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return nil
+	}
+
+	format, ok := playlistFormatFromQuery(r.Request)
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		return nil
+	}
+
+	var since, until time.Time
+	var err error
+	if v := r.URL.Query().Get("since"); v != "" {
+		since, err = time.Parse(time.RFC3339, v)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return nil
+		}
+	}
+	if v := r.URL.Query().Get("until"); v != "" {
+		until, err = time.Parse(time.RFC3339, v)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return nil
+		}
+	}
+
+	fileName, content, err := s.playlistExporter.ExportPlayedMediaPlaylist(r.Context(), r.URL.Query().Get("channel"), since, until, format)
+	if err != nil {
+		return stacktrace.Propagate(writePlaylistExportError(w, err), "")
+	}
+	return stacktrace.Propagate(writePlaylistFile(w, fileName, format, content), "")
+}
+
+// isAdminRequest checks whether the request is authenticated with the token of an admin, which can be passed either
+// in the Authorization header (like in the gRPC API) or in the cookie set by the web application
+func (s *HTTPServer) isAdminRequest(r *http.Request) bool {
+	accessToken := r.Header.Get("Authorization")
+	if accessToken == "" {
+		accessTokenCookie, err := r.Cookie("auth-token")
+		if err != nil {
+			return false
+		}
+		accessToken = accessTokenCookie.Value
+	}
+	if accessToken == "" {
+		return false
+	}
+
+	claims, err := s.jwtManager.Verify(r.Context(), accessToken)
+	if err != nil {
+		return false
+	}
+	return auth.UserPermissionLevelIsAtLeast(claims, auth.AdminPermissionLevel)
+}
+
+func playlistFormatFromQuery(r *http.Request) (playlist.Format, bool) {
+	switch format := playlist.Format(r.URL.Query().Get("format")); format {
+	case "":
+		return playlist.FormatJSON, true
+	case playlist.FormatM3U, playlist.FormatXSPF, playlist.FormatJSON:
+		return format, true
+	default:
+		return "", false
+	}
+}
+
+func writePlaylistExportError(w http.ResponseWriter, err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		w.WriteHeader(http.StatusNotFound)
+		return nil
+	case codes.InvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+		return nil
+	default:
+		return err
+	}
+}
+
+func writePlaylistFile(w http.ResponseWriter, fileName string, format playlist.Format, content []byte) error {
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	_, err := w.Write(content)
+	return stacktrace.Propagate(err, "")
+}
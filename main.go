@@ -10,7 +10,9 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/dyson/certman"
@@ -23,7 +25,9 @@ import (
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	"github.com/palantir/stacktrace"
+	"github.com/samber/lo"
 	"github.com/sethvargo/go-limiter/memorystore"
+	"github.com/shopspring/decimal"
 	"github.com/tnyim/jungletv/buildconfig"
 	"github.com/tnyim/jungletv/httpserver"
 	"github.com/tnyim/jungletv/proto"
@@ -34,6 +38,8 @@ import (
 	"github.com/tnyim/jungletv/server/auth"
 	"github.com/tnyim/jungletv/server/components/apprunner"
 	"github.com/tnyim/jungletv/server/components/configurationmanager"
+	"github.com/tnyim/jungletv/server/components/medialibrary"
+	"github.com/tnyim/jungletv/server/components/node"
 	"github.com/tnyim/jungletv/server/components/notificationmanager"
 	"github.com/tnyim/jungletv/server/components/oauth"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
@@ -117,6 +123,11 @@ func main() {
 		mainLog.Fatalln(err)
 	}
 
+	nanoWallet, err := buildNanoWallet(secrets, appWalletBuilder)
+	if err != nil {
+		mainLog.Fatalln(err)
+	}
+
 	youtubeAPIkey, present := secrets.Get("youtubeAPIkey")
 	if !present {
 		mainLog.Fatalln("YouTube API key not present in keybox")
@@ -153,7 +164,13 @@ func main() {
 
 	autoEnqueueVideoListFile, present := secrets.Get("autoEnqueueVideosFile")
 	if !present {
-		mainLog.Println("Auto enqueue videos file path not present in keybox, will not auto enqueue videos")
+		mainLog.Println("Auto enqueue videos file path not present in keybox, will not import it into the autoplay pools")
+	}
+
+	mediaLibraryPath, present := secrets.Get("mediaLibraryPath")
+	if !present {
+		mediaLibraryPath = "media-library"
+		mainLog.Println("Media library path not present in keybox, using default", mediaLibraryPath)
 	}
 
 	websiteURL, present := secrets.Get("websiteURL")
@@ -237,6 +254,20 @@ func main() {
 		mainLog.Fatalln("Representative address not present in keybox")
 	}
 
+	nanoRepAddress, present := secrets.Get("nanoRepresentative")
+	if !present && nanoWallet != nil {
+		mainLog.Fatalln("XNO representative address not present in keybox")
+	}
+
+	var nanoExchangeRate decimal.Decimal
+	nanoExchangeRateStr, present := secrets.Get("nanoExchangeRate")
+	if present {
+		nanoExchangeRate, err = decimal.NewFromString(nanoExchangeRateStr)
+		if err != nil {
+			mainLog.Fatalln("Invalid XNO exchange rate specified")
+		}
+	}
+
 	ticketCheckPeriodMillis, present := secrets.Get("ticketCheckPeriod")
 	ticketCheckPeriod := 10 * time.Second
 	if present {
@@ -329,6 +360,33 @@ func main() {
 		mainLog.Println("Nanswap API key not present in keybox, multicurrency functions will not work properly")
 	}
 
+	var swapProviders []string
+	swapProvidersStr, present := secrets.Get("swapProviders")
+	if present {
+		swapProviders = strings.Split(swapProvidersStr, ",")
+	}
+
+	var mockSwapLiquidityWallet node.Wallet
+	mockSwapCompletionDelay := 10 * time.Second
+	if slices.Contains(swapProviders, "mock") {
+		if !buildconfig.LAB && !buildconfig.DEBUG {
+			mainLog.Fatalln("Mock swap provider not available in production environments")
+		}
+		mockSwapLiquidityWallet, err = appWalletBuilder.BuildMockSwapLiquidityWallet()
+		if err != nil {
+			mainLog.Fatalln(err)
+		}
+
+		mockSwapCompletionDelayMillis, present := secrets.Get("mockSwapCompletionDelay")
+		if present {
+			delay, err := strconv.Atoi(mockSwapCompletionDelayMillis)
+			if err != nil {
+				mainLog.Fatalln("invalid mockSwapCompletionDelay:", err)
+			}
+			mockSwapCompletionDelay = time.Duration(delay) * time.Millisecond
+		}
+	}
+
 	turnstileSecretKey, present := secrets.Get("turnstileSecretKey")
 	if !present {
 		mainLog.Fatalln("Cloudflare Turnstile Secret key not present in keybox")
@@ -359,12 +417,19 @@ func main() {
 	})
 
 	configManager := configurationmanager.New(ctx)
+	mediaLibrary, err := medialibrary.New(mediaLibraryPath)
+	if err != nil {
+		mainLog.Fatalln(err)
+	}
 	notifManager := notificationmanager.NewManager()
 	options := server.Options{
 		Log:                           apiLog,
 		StatsClient:                   statsClient,
 		Wallet:                        wallet,
 		RepresentativeAddress:         repAddress,
+		NanoWallet:                    nanoWallet,
+		NanoRepresentativeAddress:     nanoRepAddress,
+		NanoExchangeRate:              nanoExchangeRate,
 		JWTManager:                    jwtManager,
 		AuthInterceptor:               authInterceptor,
 		VersionInterceptor:            versionInterceptor,
@@ -383,10 +448,14 @@ func main() {
 		WebsiteURL:                    websiteURL,
 		OAuthManager:                  oauthManager,
 		NanswapAPIKey:                 nanswapAPIKey,
+		SwapProviders:                 swapProviders,
+		MockSwapLiquidityWallet:       mockSwapLiquidityWallet,
+		MockSwapCompletionDelay:       mockSwapCompletionDelay,
 		TurnstileSecretKey:            turnstileSecretKey,
 		ConfigManager:                 configManager,
 		NotificationManager:           notifManager,
 		AppRunner:                     apprunner.New(ctx, apiLog, configManager, notifManager, appWalletBuilder),
+		MediaLibrary:                  mediaLibrary,
 	}
 
 	rpcProxyKeybox, present := secrets.GetBox("rpcProxy")
@@ -435,7 +504,7 @@ func main() {
 	var pprofPassword string
 	pprofPassword, _ = secrets.Get("pprofPassword")
 
-	httpServer, err := buildHTTPserver(apiServer, apiServer, listenAddr, certFile, keyFile, options, daClient, ssoCookieStore, basicAuthChecker, pprofPassword)
+	httpServer, err := buildHTTPserver(apiServer, apiServer, apiServer, listenAddr, certFile, keyFile, options, daClient, ssoCookieStore, basicAuthChecker, pprofPassword)
 	if err != nil {
 		mainLog.Fatalln(err)
 	}
@@ -462,13 +531,21 @@ func init() {
 	mime.AddExtensionType(".js", "text/javascript") // https://github.com/golang/go/issues/32350
 }
 
+const libraryMediaUploadMethod = "/jungletv.JungleTV/UploadLibraryMedia"
+
 type combinedServer struct {
-	wrappedServer *grpcweb.WrappedGrpcServer
-	handler       http.Handler
-	websiteURL    string
+	wrappedServer       *grpcweb.WrappedGrpcServer
+	wrappedUploadServer *grpcweb.WrappedGrpcServer
+	handler             http.Handler
+	websiteURL          string
 }
 
 func (s *combinedServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == libraryMediaUploadMethod &&
+		(s.wrappedUploadServer.IsGrpcWebRequest(req) || s.wrappedUploadServer.IsAcceptableGrpcCorsRequest(req)) {
+		s.wrappedUploadServer.ServeHTTP(resp, req)
+		return
+	}
 	if s.wrappedServer.IsGrpcWebRequest(req) || s.wrappedServer.IsAcceptableGrpcCorsRequest(req) {
 		s.wrappedServer.ServeHTTP(resp, req)
 		return
@@ -476,7 +553,7 @@ func (s *combinedServer) ServeHTTP(resp http.ResponseWriter, req *http.Request)
 	s.handler.ServeHTTP(resp, req)
 }
 
-func buildHTTPserver(apiServer proto.JungleTVServer, signatureVerifier httpserver.SignatureVerifier, listenAddr, certFile, keyFile string, options server.Options, daClient *ssoclient.SSOClient, ssoCookieStore *sessions.CookieStore, basicAuthChecker func(ip, username, password string) bool, pprofPassword string) (*http.Server, error) {
+func buildHTTPserver(apiServer proto.JungleTVServer, signatureVerifier httpserver.SignatureVerifier, playlistExporter httpserver.PlaylistExporter, listenAddr, certFile, keyFile string, options server.Options, daClient *ssoclient.SSOClient, ssoCookieStore *sessions.CookieStore, basicAuthChecker func(ip, username, password string) bool, pprofPassword string) (*http.Server, error) {
 	unaryInterceptor := grpc_middleware.ChainUnaryServer(options.VersionInterceptor.Unary(), options.AuthInterceptor.Unary())
 	streamInterceptor := grpc_middleware.ChainStreamServer(options.VersionInterceptor.Stream(), options.AuthInterceptor.Stream())
 	grpcServer := grpc.NewServer(
@@ -484,16 +561,33 @@ func buildHTTPserver(apiServer proto.JungleTVServer, signatureVerifier httpserve
 		grpc.StreamInterceptor(streamInterceptor))
 	proto.RegisterJungleTVServer(grpcServer, apiServer)
 
+	// media library uploads are the only requests allowed to exceed the default maximum message size.
+	// They are served by a separate gRPC server that only exposes the upload method
+	uploadGrpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(unaryInterceptor),
+		grpc.StreamInterceptor(streamInterceptor),
+		grpc.MaxRecvMsgSize(server.MaxLibraryMediaFileSize+1024*1024)) // leave room for the rest of the upload request
+	uploadGrpcServer.RegisterService(&grpc.ServiceDesc{
+		ServiceName: proto.JungleTV_ServiceDesc.ServiceName,
+		HandlerType: proto.JungleTV_ServiceDesc.HandlerType,
+		Methods: lo.Filter(proto.JungleTV_ServiceDesc.Methods, func(m grpc.MethodDesc, _ int) bool {
+			return "/"+proto.JungleTV_ServiceDesc.ServiceName+"/"+m.MethodName == libraryMediaUploadMethod
+		}),
+		Metadata: proto.JungleTV_ServiceDesc.Metadata,
+	}, apiServer)
+
 	httpHandler, err := httpserver.New(
 		webLog,
 		authLog,
 		options.JWTManager,
 		options.OAuthManager,
 		options.AppRunner,
+		options.MediaLibrary,
 		options.WebsiteURL,
 		options.RaffleSecretKey,
 		options.VersionInterceptor,
 		signatureVerifier,
+		playlistExporter,
 		daClient,
 		ssoCookieStore,
 		basicAuthChecker,
@@ -502,7 +596,7 @@ func buildHTTPserver(apiServer proto.JungleTVServer, signatureVerifier httpserve
 		return nil, stacktrace.Propagate(err, "")
 	}
 
-	wrappedServer := grpcweb.WrapServer(grpcServer,
+	wrapOptions := []grpcweb.Option{
 		grpcweb.WithOriginFunc(func(origin string) bool {
 			if buildconfig.DEBUG || buildconfig.LAB {
 				return true
@@ -511,7 +605,10 @@ func buildHTTPserver(apiServer proto.JungleTVServer, signatureVerifier httpserve
 			return origin == options.WebsiteURL
 		}), grpcweb.WithAllowedRequestHeaders([]string{
 			"Accept", "Content-Type", "Content-Length", "Accept-Encoding", "X-CSRF-Token", "Authorization", tokens.HeaderName, "X-User-Agent", "User-Agent", "X-Grpc-Web",
-		}))
+		}),
+	}
+	wrappedServer := grpcweb.WrapServer(grpcServer, wrapOptions...)
+	wrappedUploadServer := grpcweb.WrapServer(uploadGrpcServer, wrapOptions...)
 
 	cm, err := certman.New(certFile, keyFile)
 	if err != nil {
@@ -527,9 +624,10 @@ func buildHTTPserver(apiServer proto.JungleTVServer, signatureVerifier httpserve
 	return &http.Server{
 		Addr: listenAddr,
 		Handler: &combinedServer{
-			wrappedServer: wrappedServer,
-			handler:       httpHandler,
-			websiteURL:    options.WebsiteURL,
+			wrappedServer:       wrappedServer,
+			wrappedUploadServer: wrappedUploadServer,
+			handler:             httpHandler,
+			websiteURL:          options.WebsiteURL,
 		},
 		TLSConfig: &tls.Config{
 			GetCertificate: cm.GetCertificate,
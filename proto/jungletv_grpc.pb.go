@@ -24,10 +24,12 @@ type JungleTVClient interface {
 	EnqueueMedia(ctx context.Context, in *EnqueueMediaRequest, opts ...grpc.CallOption) (*EnqueueMediaResponse, error)
 	RemoveOwnQueueEntry(ctx context.Context, in *RemoveOwnQueueEntryRequest, opts ...grpc.CallOption) (*RemoveOwnQueueEntryResponse, error)
 	MoveQueueEntry(ctx context.Context, in *MoveQueueEntryRequest, opts ...grpc.CallOption) (*MoveQueueEntryResponse, error)
+	QueueEntryMovementCost(ctx context.Context, in *QueueEntryMovementCostRequest, opts ...grpc.CallOption) (*QueueEntryMovementCostResponse, error)
 	MonitorTicket(ctx context.Context, in *MonitorTicketRequest, opts ...grpc.CallOption) (JungleTV_MonitorTicketClient, error)
 	ConsumeMedia(ctx context.Context, in *ConsumeMediaRequest, opts ...grpc.CallOption) (JungleTV_ConsumeMediaClient, error)
 	MonitorQueue(ctx context.Context, in *MonitorQueueRequest, opts ...grpc.CallOption) (JungleTV_MonitorQueueClient, error)
 	MonitorSkipAndTip(ctx context.Context, in *MonitorSkipAndTipRequest, opts ...grpc.CallOption) (JungleTV_MonitorSkipAndTipClient, error)
+	BroadcastChannels(ctx context.Context, in *BroadcastChannelsRequest, opts ...grpc.CallOption) (*BroadcastChannelsResponse, error)
 	RewardInfo(ctx context.Context, in *RewardInfoRequest, opts ...grpc.CallOption) (*RewardInfoResponse, error)
 	SubmitActivityChallenge(ctx context.Context, in *SubmitActivityChallengeRequest, opts ...grpc.CallOption) (*SubmitActivityChallengeResponse, error)
 	ProduceSegchaChallenge(ctx context.Context, in *ProduceSegchaChallengeRequest, opts ...grpc.CallOption) (*ProduceSegchaChallengeResponse, error)
@@ -40,6 +42,8 @@ type JungleTVClient interface {
 	Leaderboards(ctx context.Context, in *LeaderboardsRequest, opts ...grpc.CallOption) (*LeaderboardsResponse, error)
 	RewardHistory(ctx context.Context, in *RewardHistoryRequest, opts ...grpc.CallOption) (*RewardHistoryResponse, error)
 	WithdrawalHistory(ctx context.Context, in *WithdrawalHistoryRequest, opts ...grpc.CallOption) (*WithdrawalHistoryResponse, error)
+	WithdrawalPreferences(ctx context.Context, in *WithdrawalPreferencesRequest, opts ...grpc.CallOption) (*WithdrawalPreferencesResponse, error)
+	SetWithdrawalPreferences(ctx context.Context, in *SetWithdrawalPreferencesRequest, opts ...grpc.CallOption) (*SetWithdrawalPreferencesResponse, error)
 	OngoingRaffleInfo(ctx context.Context, in *OngoingRaffleInfoRequest, opts ...grpc.CallOption) (*OngoingRaffleInfoResponse, error)
 	RaffleDrawings(ctx context.Context, in *RaffleDrawingsRequest, opts ...grpc.CallOption) (*RaffleDrawingsResponse, error)
 	Connections(ctx context.Context, in *ConnectionsRequest, opts ...grpc.CallOption) (*ConnectionsResponse, error)
@@ -57,9 +61,15 @@ type JungleTVClient interface {
 	PointsTransactions(ctx context.Context, in *PointsTransactionsRequest, opts ...grpc.CallOption) (*PointsTransactionsResponse, error)
 	ChatGifSearch(ctx context.Context, in *ChatGifSearchRequest, opts ...grpc.CallOption) (*ChatGifSearchResponse, error)
 	ConvertBananoToPoints(ctx context.Context, in *ConvertBananoToPointsRequest, opts ...grpc.CallOption) (JungleTV_ConvertBananoToPointsClient, error)
+	TipWithPoints(ctx context.Context, in *TipWithPointsRequest, opts ...grpc.CallOption) (*TipWithPointsResponse, error)
+	TipWithBanano(ctx context.Context, in *TipWithBananoRequest, opts ...grpc.CallOption) (JungleTV_TipWithBananoClient, error)
+	PointsRewards(ctx context.Context, in *PointsRewardsRequest, opts ...grpc.CallOption) (*PointsRewardsResponse, error)
+	RedeemPointsReward(ctx context.Context, in *RedeemPointsRewardRequest, opts ...grpc.CallOption) (*RedeemPointsRewardResponse, error)
+	PointsRewardRedemptions(ctx context.Context, in *PointsRewardRedemptionsRequest, opts ...grpc.CallOption) (*PointsRewardRedemptionsResponse, error)
 	StartOrExtendSubscription(ctx context.Context, in *StartOrExtendSubscriptionRequest, opts ...grpc.CallOption) (*StartOrExtendSubscriptionResponse, error)
 	SoundCloudTrackDetails(ctx context.Context, in *SoundCloudTrackDetailsRequest, opts ...grpc.CallOption) (*SoundCloudTrackDetailsResponse, error)
 	IncreaseOrReduceSkipThreshold(ctx context.Context, in *IncreaseOrReduceSkipThresholdRequest, opts ...grpc.CallOption) (*IncreaseOrReduceSkipThresholdResponse, error)
+	VoteToSkip(ctx context.Context, in *VoteToSkipRequest, opts ...grpc.CallOption) (*VoteToSkipResponse, error)
 	CheckMediaEnqueuingPassword(ctx context.Context, in *CheckMediaEnqueuingPasswordRequest, opts ...grpc.CallOption) (*CheckMediaEnqueuingPasswordResponse, error)
 	MonitorMediaEnqueuingPermission(ctx context.Context, in *MonitorMediaEnqueuingPermissionRequest, opts ...grpc.CallOption) (JungleTV_MonitorMediaEnqueuingPermissionClient, error)
 	InvalidateAuthTokens(ctx context.Context, in *InvalidateAuthTokensRequest, opts ...grpc.CallOption) (*InvalidateAuthTokensResponse, error)
@@ -95,12 +105,24 @@ type JungleTVClient interface {
 	ConfirmRaffleWinner(ctx context.Context, in *ConfirmRaffleWinnerRequest, opts ...grpc.CallOption) (*ConfirmRaffleWinnerResponse, error)
 	CompleteRaffle(ctx context.Context, in *CompleteRaffleRequest, opts ...grpc.CallOption) (*CompleteRaffleResponse, error)
 	RedrawRaffle(ctx context.Context, in *RedrawRaffleRequest, opts ...grpc.CallOption) (*RedrawRaffleResponse, error)
+	Refunds(ctx context.Context, in *RefundsRequest, opts ...grpc.CallOption) (*RefundsResponse, error)
+	ProposeRefund(ctx context.Context, in *ProposeRefundRequest, opts ...grpc.CallOption) (*ProposeRefundResponse, error)
+	ApproveRefund(ctx context.Context, in *ApproveRefundRequest, opts ...grpc.CallOption) (*ApproveRefundResponse, error)
+	RetryRefund(ctx context.Context, in *RetryRefundRequest, opts ...grpc.CallOption) (*RetryRefundResponse, error)
+	ReconcileJournal(ctx context.Context, in *ReconcileJournalRequest, opts ...grpc.CallOption) (*ReconcileJournalResponse, error)
+	ScheduledRains(ctx context.Context, in *ScheduledRainsRequest, opts ...grpc.CallOption) (*ScheduledRainsResponse, error)
+	ScheduleRain(ctx context.Context, in *ScheduleRainRequest, opts ...grpc.CallOption) (*ScheduleRainResponse, error)
+	CancelScheduledRain(ctx context.Context, in *CancelScheduledRainRequest, opts ...grpc.CallOption) (*CancelScheduledRainResponse, error)
 	TriggerAnnouncementsNotification(ctx context.Context, in *TriggerAnnouncementsNotificationRequest, opts ...grpc.CallOption) (*TriggerAnnouncementsNotificationResponse, error)
 	SpectatorInfo(ctx context.Context, in *SpectatorInfoRequest, opts ...grpc.CallOption) (*Spectator, error)
 	ResetSpectatorStatus(ctx context.Context, in *ResetSpectatorStatusRequest, opts ...grpc.CallOption) (*ResetSpectatorStatusResponse, error)
 	MonitorModerationStatus(ctx context.Context, in *MonitorModerationStatusRequest, opts ...grpc.CallOption) (JungleTV_MonitorModerationStatusClient, error)
 	SetOwnQueueEntryRemovalAllowed(ctx context.Context, in *SetOwnQueueEntryRemovalAllowedRequest, opts ...grpc.CallOption) (*SetOwnQueueEntryRemovalAllowedResponse, error)
 	SetQueueEntryReorderingAllowed(ctx context.Context, in *SetQueueEntryReorderingAllowedRequest, opts ...grpc.CallOption) (*SetQueueEntryReorderingAllowedResponse, error)
+	SetQueueOrderingPolicy(ctx context.Context, in *SetQueueOrderingPolicyRequest, opts ...grpc.CallOption) (*SetQueueOrderingPolicyResponse, error)
+	SetRewardDistributionStrategy(ctx context.Context, in *SetRewardDistributionStrategyRequest, opts ...grpc.CallOption) (*SetRewardDistributionStrategyResponse, error)
+	PauseBroadcast(ctx context.Context, in *PauseBroadcastRequest, opts ...grpc.CallOption) (*PauseBroadcastResponse, error)
+	ResumeBroadcast(ctx context.Context, in *ResumeBroadcastRequest, opts ...grpc.CallOption) (*ResumeBroadcastResponse, error)
 	SetNewQueueEntriesAlwaysUnskippable(ctx context.Context, in *SetNewQueueEntriesAlwaysUnskippableRequest, opts ...grpc.CallOption) (*SetNewQueueEntriesAlwaysUnskippableResponse, error)
 	SetSkippingEnabled(ctx context.Context, in *SetSkippingEnabledRequest, opts ...grpc.CallOption) (*SetSkippingEnabledResponse, error)
 	SetQueueInsertCursor(ctx context.Context, in *SetQueueInsertCursorRequest, opts ...grpc.CallOption) (*SetQueueInsertCursorResponse, error)
@@ -109,6 +131,9 @@ type JungleTVClient interface {
 	MarkAsActivelyModerating(ctx context.Context, in *MarkAsActivelyModeratingRequest, opts ...grpc.CallOption) (*MarkAsActivelyModeratingResponse, error)
 	StopActivelyModerating(ctx context.Context, in *StopActivelyModeratingRequest, opts ...grpc.CallOption) (*StopActivelyModeratingResponse, error)
 	AdjustPointsBalance(ctx context.Context, in *AdjustPointsBalanceRequest, opts ...grpc.CallOption) (*AdjustPointsBalanceResponse, error)
+	SetPointsReward(ctx context.Context, in *SetPointsRewardRequest, opts ...grpc.CallOption) (*SetPointsRewardResponse, error)
+	AllPointsRewardRedemptions(ctx context.Context, in *AllPointsRewardRedemptionsRequest, opts ...grpc.CallOption) (*AllPointsRewardRedemptionsResponse, error)
+	UpdatePointsRewardRedemption(ctx context.Context, in *UpdatePointsRewardRedemptionRequest, opts ...grpc.CallOption) (*UpdatePointsRewardRedemptionResponse, error)
 	AddVipUser(ctx context.Context, in *AddVipUserRequest, opts ...grpc.CallOption) (*AddVipUserResponse, error)
 	RemoveVipUser(ctx context.Context, in *RemoveVipUserRequest, opts ...grpc.CallOption) (*RemoveVipUserResponse, error)
 	TriggerClientReload(ctx context.Context, in *TriggerClientReloadRequest, opts ...grpc.CallOption) (*TriggerClientReloadResponse, error)
@@ -116,6 +141,32 @@ type JungleTVClient interface {
 	InvalidateUserAuthTokens(ctx context.Context, in *InvalidateUserAuthTokensRequest, opts ...grpc.CallOption) (*InvalidateUserAuthTokensResponse, error)
 	SetRPCProxyEnabled(ctx context.Context, in *SetRPCProxyEnabledRequest, opts ...grpc.CallOption) (*SetRPCProxyEnabledResponse, error)
 	Spectators(ctx context.Context, in *SpectatorsRequest, opts ...grpc.CallOption) (*SpectatorsResponse, error)
+	TimetableSlots(ctx context.Context, in *TimetableSlotsRequest, opts ...grpc.CallOption) (*TimetableSlotsResponse, error)
+	SetTimetableSlot(ctx context.Context, in *SetTimetableSlotRequest, opts ...grpc.CallOption) (*SetTimetableSlotResponse, error)
+	RemoveTimetableSlot(ctx context.Context, in *RemoveTimetableSlotRequest, opts ...grpc.CallOption) (*RemoveTimetableSlotResponse, error)
+	CreateBroadcastChannel(ctx context.Context, in *CreateBroadcastChannelRequest, opts ...grpc.CallOption) (*CreateBroadcastChannelResponse, error)
+	AutoplayPools(ctx context.Context, in *AutoplayPoolsRequest, opts ...grpc.CallOption) (*AutoplayPoolsResponse, error)
+	SetAutoplayPool(ctx context.Context, in *SetAutoplayPoolRequest, opts ...grpc.CallOption) (*SetAutoplayPoolResponse, error)
+	RemoveAutoplayPool(ctx context.Context, in *RemoveAutoplayPoolRequest, opts ...grpc.CallOption) (*RemoveAutoplayPoolResponse, error)
+	AutoplayPoolEntries(ctx context.Context, in *AutoplayPoolEntriesRequest, opts ...grpc.CallOption) (*AutoplayPoolEntriesResponse, error)
+	AddAutoplayPoolEntry(ctx context.Context, in *AddAutoplayPoolEntryRequest, opts ...grpc.CallOption) (*AddAutoplayPoolEntryResponse, error)
+	RemoveAutoplayPoolEntry(ctx context.Context, in *RemoveAutoplayPoolEntryRequest, opts ...grpc.CallOption) (*RemoveAutoplayPoolEntryResponse, error)
+	QueueSnapshots(ctx context.Context, in *QueueSnapshotsRequest, opts ...grpc.CallOption) (*QueueSnapshotsResponse, error)
+	QueueSnapshot(ctx context.Context, in *QueueSnapshotRequest, opts ...grpc.CallOption) (*QueueSnapshotResponse, error)
+	RestoreQueueSnapshot(ctx context.Context, in *RestoreQueueSnapshotRequest, opts ...grpc.CallOption) (*RestoreQueueSnapshotResponse, error)
+	MediaReplayRules(ctx context.Context, in *MediaReplayRulesRequest, opts ...grpc.CallOption) (*MediaReplayRulesResponse, error)
+	SetMediaReplayRule(ctx context.Context, in *SetMediaReplayRuleRequest, opts ...grpc.CallOption) (*SetMediaReplayRuleResponse, error)
+	RemoveMediaReplayRule(ctx context.Context, in *RemoveMediaReplayRuleRequest, opts ...grpc.CallOption) (*RemoveMediaReplayRuleResponse, error)
+	LibraryMedia(ctx context.Context, in *LibraryMediaRequest, opts ...grpc.CallOption) (*LibraryMediaResponse, error)
+	UploadLibraryMedia(ctx context.Context, in *UploadLibraryMediaRequest, opts ...grpc.CallOption) (*UploadLibraryMediaResponse, error)
+	RemoveLibraryMedia(ctx context.Context, in *RemoveLibraryMediaRequest, opts ...grpc.CallOption) (*RemoveLibraryMediaResponse, error)
+	ExportQueue(ctx context.Context, in *ExportQueueRequest, opts ...grpc.CallOption) (*ExportQueueResponse, error)
+	ExportPlayedMedia(ctx context.Context, in *ExportPlayedMediaRequest, opts ...grpc.CallOption) (*ExportPlayedMediaResponse, error)
+	ImportQueue(ctx context.Context, in *ImportQueueRequest, opts ...grpc.CallOption) (*ImportQueueResponse, error)
+	PricingRules(ctx context.Context, in *PricingRulesRequest, opts ...grpc.CallOption) (*PricingRulesResponse, error)
+	SetPricingRule(ctx context.Context, in *SetPricingRuleRequest, opts ...grpc.CallOption) (*SetPricingRuleResponse, error)
+	RemovePricingRule(ctx context.Context, in *RemovePricingRuleRequest, opts ...grpc.CallOption) (*RemovePricingRuleResponse, error)
+	SimulatePricing(ctx context.Context, in *SimulatePricingRequest, opts ...grpc.CallOption) (*SimulatePricingResponse, error)
 	// application editor endpoints
 	Applications(ctx context.Context, in *ApplicationsRequest, opts ...grpc.CallOption) (*ApplicationsResponse, error)
 	GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*Application, error)
@@ -228,6 +279,15 @@ func (c *jungleTVClient) MoveQueueEntry(ctx context.Context, in *MoveQueueEntryR
 	return out, nil
 }
 
+func (c *jungleTVClient) QueueEntryMovementCost(ctx context.Context, in *QueueEntryMovementCostRequest, opts ...grpc.CallOption) (*QueueEntryMovementCostResponse, error) {
+	out := new(QueueEntryMovementCostResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/QueueEntryMovementCost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jungleTVClient) MonitorTicket(ctx context.Context, in *MonitorTicketRequest, opts ...grpc.CallOption) (JungleTV_MonitorTicketClient, error) {
 	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[1], "/jungletv.JungleTV/MonitorTicket", opts...)
 	if err != nil {
@@ -356,6 +416,15 @@ func (x *jungleTVMonitorSkipAndTipClient) Recv() (*SkipAndTipStatus, error) {
 	return m, nil
 }
 
+func (c *jungleTVClient) BroadcastChannels(ctx context.Context, in *BroadcastChannelsRequest, opts ...grpc.CallOption) (*BroadcastChannelsResponse, error) {
+	out := new(BroadcastChannelsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/BroadcastChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jungleTVClient) RewardInfo(ctx context.Context, in *RewardInfoRequest, opts ...grpc.CallOption) (*RewardInfoResponse, error) {
 	out := new(RewardInfoResponse)
 	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/RewardInfo", in, out, opts...)
@@ -487,6 +556,24 @@ func (c *jungleTVClient) WithdrawalHistory(ctx context.Context, in *WithdrawalHi
 	return out, nil
 }
 
+func (c *jungleTVClient) WithdrawalPreferences(ctx context.Context, in *WithdrawalPreferencesRequest, opts ...grpc.CallOption) (*WithdrawalPreferencesResponse, error) {
+	out := new(WithdrawalPreferencesResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/WithdrawalPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) SetWithdrawalPreferences(ctx context.Context, in *SetWithdrawalPreferencesRequest, opts ...grpc.CallOption) (*SetWithdrawalPreferencesResponse, error) {
+	out := new(SetWithdrawalPreferencesResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetWithdrawalPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jungleTVClient) OngoingRaffleInfo(ctx context.Context, in *OngoingRaffleInfoRequest, opts ...grpc.CallOption) (*OngoingRaffleInfoResponse, error) {
 	out := new(OngoingRaffleInfoResponse)
 	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/OngoingRaffleInfo", in, out, opts...)
@@ -663,6 +750,74 @@ func (x *jungleTVConvertBananoToPointsClient) Recv() (*ConvertBananoToPointsStat
 	return m, nil
 }
 
+func (c *jungleTVClient) TipWithPoints(ctx context.Context, in *TipWithPointsRequest, opts ...grpc.CallOption) (*TipWithPointsResponse, error) {
+	out := new(TipWithPointsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/TipWithPoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) TipWithBanano(ctx context.Context, in *TipWithBananoRequest, opts ...grpc.CallOption) (JungleTV_TipWithBananoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[7], "/jungletv.JungleTV/TipWithBanano", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jungleTVTipWithBananoClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JungleTV_TipWithBananoClient interface {
+	Recv() (*TipWithBananoStatus, error)
+	grpc.ClientStream
+}
+
+type jungleTVTipWithBananoClient struct {
+	grpc.ClientStream
+}
+
+func (x *jungleTVTipWithBananoClient) Recv() (*TipWithBananoStatus, error) {
+	m := new(TipWithBananoStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jungleTVClient) PointsRewards(ctx context.Context, in *PointsRewardsRequest, opts ...grpc.CallOption) (*PointsRewardsResponse, error) {
+	out := new(PointsRewardsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/PointsRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) RedeemPointsReward(ctx context.Context, in *RedeemPointsRewardRequest, opts ...grpc.CallOption) (*RedeemPointsRewardResponse, error) {
+	out := new(RedeemPointsRewardResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/RedeemPointsReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) PointsRewardRedemptions(ctx context.Context, in *PointsRewardRedemptionsRequest, opts ...grpc.CallOption) (*PointsRewardRedemptionsResponse, error) {
+	out := new(PointsRewardRedemptionsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/PointsRewardRedemptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jungleTVClient) StartOrExtendSubscription(ctx context.Context, in *StartOrExtendSubscriptionRequest, opts ...grpc.CallOption) (*StartOrExtendSubscriptionResponse, error) {
 	out := new(StartOrExtendSubscriptionResponse)
 	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/StartOrExtendSubscription", in, out, opts...)
@@ -690,6 +845,15 @@ func (c *jungleTVClient) IncreaseOrReduceSkipThreshold(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *jungleTVClient) VoteToSkip(ctx context.Context, in *VoteToSkipRequest, opts ...grpc.CallOption) (*VoteToSkipResponse, error) {
+	out := new(VoteToSkipResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/VoteToSkip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jungleTVClient) CheckMediaEnqueuingPassword(ctx context.Context, in *CheckMediaEnqueuingPasswordRequest, opts ...grpc.CallOption) (*CheckMediaEnqueuingPasswordResponse, error) {
 	out := new(CheckMediaEnqueuingPasswordResponse)
 	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/CheckMediaEnqueuingPassword", in, out, opts...)
@@ -700,7 +864,7 @@ func (c *jungleTVClient) CheckMediaEnqueuingPassword(ctx context.Context, in *Ch
 }
 
 func (c *jungleTVClient) MonitorMediaEnqueuingPermission(ctx context.Context, in *MonitorMediaEnqueuingPermissionRequest, opts ...grpc.CallOption) (JungleTV_MonitorMediaEnqueuingPermissionClient, error) {
-	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[7], "/jungletv.JungleTV/MonitorMediaEnqueuingPermission", opts...)
+	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[8], "/jungletv.JungleTV/MonitorMediaEnqueuingPermission", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -741,7 +905,7 @@ func (c *jungleTVClient) InvalidateAuthTokens(ctx context.Context, in *Invalidat
 }
 
 func (c *jungleTVClient) AuthorizeApplication(ctx context.Context, in *AuthorizeApplicationRequest, opts ...grpc.CallOption) (JungleTV_AuthorizeApplicationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[8], "/jungletv.JungleTV/AuthorizeApplication", opts...)
+	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[9], "/jungletv.JungleTV/AuthorizeApplication", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1042,6 +1206,78 @@ func (c *jungleTVClient) RedrawRaffle(ctx context.Context, in *RedrawRaffleReque
 	return out, nil
 }
 
+func (c *jungleTVClient) Refunds(ctx context.Context, in *RefundsRequest, opts ...grpc.CallOption) (*RefundsResponse, error) {
+	out := new(RefundsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/Refunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ProposeRefund(ctx context.Context, in *ProposeRefundRequest, opts ...grpc.CallOption) (*ProposeRefundResponse, error) {
+	out := new(ProposeRefundResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ProposeRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ApproveRefund(ctx context.Context, in *ApproveRefundRequest, opts ...grpc.CallOption) (*ApproveRefundResponse, error) {
+	out := new(ApproveRefundResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ApproveRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) RetryRefund(ctx context.Context, in *RetryRefundRequest, opts ...grpc.CallOption) (*RetryRefundResponse, error) {
+	out := new(RetryRefundResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/RetryRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ReconcileJournal(ctx context.Context, in *ReconcileJournalRequest, opts ...grpc.CallOption) (*ReconcileJournalResponse, error) {
+	out := new(ReconcileJournalResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ReconcileJournal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ScheduledRains(ctx context.Context, in *ScheduledRainsRequest, opts ...grpc.CallOption) (*ScheduledRainsResponse, error) {
+	out := new(ScheduledRainsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ScheduledRains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ScheduleRain(ctx context.Context, in *ScheduleRainRequest, opts ...grpc.CallOption) (*ScheduleRainResponse, error) {
+	out := new(ScheduleRainResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ScheduleRain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) CancelScheduledRain(ctx context.Context, in *CancelScheduledRainRequest, opts ...grpc.CallOption) (*CancelScheduledRainResponse, error) {
+	out := new(CancelScheduledRainResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/CancelScheduledRain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jungleTVClient) TriggerAnnouncementsNotification(ctx context.Context, in *TriggerAnnouncementsNotificationRequest, opts ...grpc.CallOption) (*TriggerAnnouncementsNotificationResponse, error) {
 	out := new(TriggerAnnouncementsNotificationResponse)
 	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/TriggerAnnouncementsNotification", in, out, opts...)
@@ -1070,7 +1306,7 @@ func (c *jungleTVClient) ResetSpectatorStatus(ctx context.Context, in *ResetSpec
 }
 
 func (c *jungleTVClient) MonitorModerationStatus(ctx context.Context, in *MonitorModerationStatusRequest, opts ...grpc.CallOption) (JungleTV_MonitorModerationStatusClient, error) {
-	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[9], "/jungletv.JungleTV/MonitorModerationStatus", opts...)
+	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[10], "/jungletv.JungleTV/MonitorModerationStatus", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1119,6 +1355,42 @@ func (c *jungleTVClient) SetQueueEntryReorderingAllowed(ctx context.Context, in
 	return out, nil
 }
 
+func (c *jungleTVClient) SetQueueOrderingPolicy(ctx context.Context, in *SetQueueOrderingPolicyRequest, opts ...grpc.CallOption) (*SetQueueOrderingPolicyResponse, error) {
+	out := new(SetQueueOrderingPolicyResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetQueueOrderingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) SetRewardDistributionStrategy(ctx context.Context, in *SetRewardDistributionStrategyRequest, opts ...grpc.CallOption) (*SetRewardDistributionStrategyResponse, error) {
+	out := new(SetRewardDistributionStrategyResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetRewardDistributionStrategy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) PauseBroadcast(ctx context.Context, in *PauseBroadcastRequest, opts ...grpc.CallOption) (*PauseBroadcastResponse, error) {
+	out := new(PauseBroadcastResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/PauseBroadcast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ResumeBroadcast(ctx context.Context, in *ResumeBroadcastRequest, opts ...grpc.CallOption) (*ResumeBroadcastResponse, error) {
+	out := new(ResumeBroadcastResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ResumeBroadcast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jungleTVClient) SetNewQueueEntriesAlwaysUnskippable(ctx context.Context, in *SetNewQueueEntriesAlwaysUnskippableRequest, opts ...grpc.CallOption) (*SetNewQueueEntriesAlwaysUnskippableResponse, error) {
 	out := new(SetNewQueueEntriesAlwaysUnskippableResponse)
 	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetNewQueueEntriesAlwaysUnskippable", in, out, opts...)
@@ -1191,6 +1463,33 @@ func (c *jungleTVClient) AdjustPointsBalance(ctx context.Context, in *AdjustPoin
 	return out, nil
 }
 
+func (c *jungleTVClient) SetPointsReward(ctx context.Context, in *SetPointsRewardRequest, opts ...grpc.CallOption) (*SetPointsRewardResponse, error) {
+	out := new(SetPointsRewardResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetPointsReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) AllPointsRewardRedemptions(ctx context.Context, in *AllPointsRewardRedemptionsRequest, opts ...grpc.CallOption) (*AllPointsRewardRedemptionsResponse, error) {
+	out := new(AllPointsRewardRedemptionsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/AllPointsRewardRedemptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) UpdatePointsRewardRedemption(ctx context.Context, in *UpdatePointsRewardRedemptionRequest, opts ...grpc.CallOption) (*UpdatePointsRewardRedemptionResponse, error) {
+	out := new(UpdatePointsRewardRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/UpdatePointsRewardRedemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jungleTVClient) AddVipUser(ctx context.Context, in *AddVipUserRequest, opts ...grpc.CallOption) (*AddVipUserResponse, error) {
 	out := new(AddVipUserResponse)
 	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/AddVipUser", in, out, opts...)
@@ -1254,172 +1553,406 @@ func (c *jungleTVClient) Spectators(ctx context.Context, in *SpectatorsRequest,
 	return out, nil
 }
 
-func (c *jungleTVClient) Applications(ctx context.Context, in *ApplicationsRequest, opts ...grpc.CallOption) (*ApplicationsResponse, error) {
-	out := new(ApplicationsResponse)
-	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/Applications", in, out, opts...)
+func (c *jungleTVClient) TimetableSlots(ctx context.Context, in *TimetableSlotsRequest, opts ...grpc.CallOption) (*TimetableSlotsResponse, error) {
+	out := new(TimetableSlotsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/TimetableSlots", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *jungleTVClient) GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*Application, error) {
-	out := new(Application)
-	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/GetApplication", in, out, opts...)
+func (c *jungleTVClient) SetTimetableSlot(ctx context.Context, in *SetTimetableSlotRequest, opts ...grpc.CallOption) (*SetTimetableSlotResponse, error) {
+	out := new(SetTimetableSlotResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetTimetableSlot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *jungleTVClient) UpdateApplication(ctx context.Context, in *Application, opts ...grpc.CallOption) (*UpdateApplicationResponse, error) {
-	out := new(UpdateApplicationResponse)
-	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/UpdateApplication", in, out, opts...)
+func (c *jungleTVClient) RemoveTimetableSlot(ctx context.Context, in *RemoveTimetableSlotRequest, opts ...grpc.CallOption) (*RemoveTimetableSlotResponse, error) {
+	out := new(RemoveTimetableSlotResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/RemoveTimetableSlot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *jungleTVClient) CloneApplication(ctx context.Context, in *CloneApplicationRequest, opts ...grpc.CallOption) (*CloneApplicationResponse, error) {
-	out := new(CloneApplicationResponse)
-	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/CloneApplication", in, out, opts...)
+func (c *jungleTVClient) CreateBroadcastChannel(ctx context.Context, in *CreateBroadcastChannelRequest, opts ...grpc.CallOption) (*CreateBroadcastChannelResponse, error) {
+	out := new(CreateBroadcastChannelResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/CreateBroadcastChannel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *jungleTVClient) DeleteApplication(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*DeleteApplicationResponse, error) {
-	out := new(DeleteApplicationResponse)
-	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/DeleteApplication", in, out, opts...)
+func (c *jungleTVClient) AutoplayPools(ctx context.Context, in *AutoplayPoolsRequest, opts ...grpc.CallOption) (*AutoplayPoolsResponse, error) {
+	out := new(AutoplayPoolsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/AutoplayPools", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *jungleTVClient) ApplicationFiles(ctx context.Context, in *ApplicationFilesRequest, opts ...grpc.CallOption) (*ApplicationFilesResponse, error) {
-	out := new(ApplicationFilesResponse)
-	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ApplicationFiles", in, out, opts...)
+func (c *jungleTVClient) SetAutoplayPool(ctx context.Context, in *SetAutoplayPoolRequest, opts ...grpc.CallOption) (*SetAutoplayPoolResponse, error) {
+	out := new(SetAutoplayPoolResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetAutoplayPool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *jungleTVClient) GetApplicationFile(ctx context.Context, in *GetApplicationFileRequest, opts ...grpc.CallOption) (*ApplicationFile, error) {
-	out := new(ApplicationFile)
-	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/GetApplicationFile", in, out, opts...)
+func (c *jungleTVClient) RemoveAutoplayPool(ctx context.Context, in *RemoveAutoplayPoolRequest, opts ...grpc.CallOption) (*RemoveAutoplayPoolResponse, error) {
+	out := new(RemoveAutoplayPoolResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/RemoveAutoplayPool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *jungleTVClient) UpdateApplicationFile(ctx context.Context, in *ApplicationFile, opts ...grpc.CallOption) (*UpdateApplicationFileResponse, error) {
-	out := new(UpdateApplicationFileResponse)
-	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/UpdateApplicationFile", in, out, opts...)
+func (c *jungleTVClient) AutoplayPoolEntries(ctx context.Context, in *AutoplayPoolEntriesRequest, opts ...grpc.CallOption) (*AutoplayPoolEntriesResponse, error) {
+	out := new(AutoplayPoolEntriesResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/AutoplayPoolEntries", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *jungleTVClient) CloneApplicationFile(ctx context.Context, in *CloneApplicationFileRequest, opts ...grpc.CallOption) (*CloneApplicationFileResponse, error) {
-	out := new(CloneApplicationFileResponse)
-	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/CloneApplicationFile", in, out, opts...)
+func (c *jungleTVClient) AddAutoplayPoolEntry(ctx context.Context, in *AddAutoplayPoolEntryRequest, opts ...grpc.CallOption) (*AddAutoplayPoolEntryResponse, error) {
+	out := new(AddAutoplayPoolEntryResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/AddAutoplayPoolEntry", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *jungleTVClient) DeleteApplicationFile(ctx context.Context, in *DeleteApplicationFileRequest, opts ...grpc.CallOption) (*DeleteApplicationFileResponse, error) {
-	out := new(DeleteApplicationFileResponse)
-	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/DeleteApplicationFile", in, out, opts...)
+func (c *jungleTVClient) RemoveAutoplayPoolEntry(ctx context.Context, in *RemoveAutoplayPoolEntryRequest, opts ...grpc.CallOption) (*RemoveAutoplayPoolEntryResponse, error) {
+	out := new(RemoveAutoplayPoolEntryResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/RemoveAutoplayPoolEntry", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *jungleTVClient) LaunchApplication(ctx context.Context, in *LaunchApplicationRequest, opts ...grpc.CallOption) (*LaunchApplicationResponse, error) {
-	out := new(LaunchApplicationResponse)
-	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/LaunchApplication", in, out, opts...)
+func (c *jungleTVClient) QueueSnapshots(ctx context.Context, in *QueueSnapshotsRequest, opts ...grpc.CallOption) (*QueueSnapshotsResponse, error) {
+	out := new(QueueSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/QueueSnapshots", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *jungleTVClient) StopApplication(ctx context.Context, in *StopApplicationRequest, opts ...grpc.CallOption) (*StopApplicationResponse, error) {
-	out := new(StopApplicationResponse)
-	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/StopApplication", in, out, opts...)
+func (c *jungleTVClient) QueueSnapshot(ctx context.Context, in *QueueSnapshotRequest, opts ...grpc.CallOption) (*QueueSnapshotResponse, error) {
+	out := new(QueueSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/QueueSnapshot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *jungleTVClient) ApplicationLog(ctx context.Context, in *ApplicationLogRequest, opts ...grpc.CallOption) (*ApplicationLogResponse, error) {
-	out := new(ApplicationLogResponse)
-	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ApplicationLog", in, out, opts...)
+func (c *jungleTVClient) RestoreQueueSnapshot(ctx context.Context, in *RestoreQueueSnapshotRequest, opts ...grpc.CallOption) (*RestoreQueueSnapshotResponse, error) {
+	out := new(RestoreQueueSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/RestoreQueueSnapshot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *jungleTVClient) ConsumeApplicationLog(ctx context.Context, in *ConsumeApplicationLogRequest, opts ...grpc.CallOption) (JungleTV_ConsumeApplicationLogClient, error) {
-	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[10], "/jungletv.JungleTV/ConsumeApplicationLog", opts...)
+func (c *jungleTVClient) MediaReplayRules(ctx context.Context, in *MediaReplayRulesRequest, opts ...grpc.CallOption) (*MediaReplayRulesResponse, error) {
+	out := new(MediaReplayRulesResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/MediaReplayRules", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &jungleTVConsumeApplicationLogClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
+	return out, nil
+}
+
+func (c *jungleTVClient) SetMediaReplayRule(ctx context.Context, in *SetMediaReplayRuleRequest, opts ...grpc.CallOption) (*SetMediaReplayRuleResponse, error) {
+	out := new(SetMediaReplayRuleResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetMediaReplayRule", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *jungleTVClient) RemoveMediaReplayRule(ctx context.Context, in *RemoveMediaReplayRuleRequest, opts ...grpc.CallOption) (*RemoveMediaReplayRuleResponse, error) {
+	out := new(RemoveMediaReplayRuleResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/RemoveMediaReplayRule", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return x, nil
+	return out, nil
 }
 
-type JungleTV_ConsumeApplicationLogClient interface {
-	Recv() (*ApplicationLogEntryContainer, error)
-	grpc.ClientStream
+func (c *jungleTVClient) LibraryMedia(ctx context.Context, in *LibraryMediaRequest, opts ...grpc.CallOption) (*LibraryMediaResponse, error) {
+	out := new(LibraryMediaResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/LibraryMedia", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type jungleTVConsumeApplicationLogClient struct {
-	grpc.ClientStream
+func (c *jungleTVClient) UploadLibraryMedia(ctx context.Context, in *UploadLibraryMediaRequest, opts ...grpc.CallOption) (*UploadLibraryMediaResponse, error) {
+	out := new(UploadLibraryMediaResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/UploadLibraryMedia", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *jungleTVConsumeApplicationLogClient) Recv() (*ApplicationLogEntryContainer, error) {
-	m := new(ApplicationLogEntryContainer)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *jungleTVClient) RemoveLibraryMedia(ctx context.Context, in *RemoveLibraryMediaRequest, opts ...grpc.CallOption) (*RemoveLibraryMediaResponse, error) {
+	out := new(RemoveLibraryMediaResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/RemoveLibraryMedia", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *jungleTVClient) MonitorRunningApplications(ctx context.Context, in *MonitorRunningApplicationsRequest, opts ...grpc.CallOption) (JungleTV_MonitorRunningApplicationsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[11], "/jungletv.JungleTV/MonitorRunningApplications", opts...)
+func (c *jungleTVClient) ExportQueue(ctx context.Context, in *ExportQueueRequest, opts ...grpc.CallOption) (*ExportQueueResponse, error) {
+	out := new(ExportQueueResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ExportQueue", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &jungleTVMonitorRunningApplicationsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
+	return out, nil
+}
+
+func (c *jungleTVClient) ExportPlayedMedia(ctx context.Context, in *ExportPlayedMediaRequest, opts ...grpc.CallOption) (*ExportPlayedMediaResponse, error) {
+	out := new(ExportPlayedMediaResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ExportPlayedMedia", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *jungleTVClient) ImportQueue(ctx context.Context, in *ImportQueueRequest, opts ...grpc.CallOption) (*ImportQueueResponse, error) {
+	out := new(ImportQueueResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ImportQueue", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return x, nil
+	return out, nil
 }
 
-type JungleTV_MonitorRunningApplicationsClient interface {
-	Recv() (*RunningApplications, error)
+func (c *jungleTVClient) PricingRules(ctx context.Context, in *PricingRulesRequest, opts ...grpc.CallOption) (*PricingRulesResponse, error) {
+	out := new(PricingRulesResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/PricingRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) SetPricingRule(ctx context.Context, in *SetPricingRuleRequest, opts ...grpc.CallOption) (*SetPricingRuleResponse, error) {
+	out := new(SetPricingRuleResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetPricingRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) RemovePricingRule(ctx context.Context, in *RemovePricingRuleRequest, opts ...grpc.CallOption) (*RemovePricingRuleResponse, error) {
+	out := new(RemovePricingRuleResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/RemovePricingRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) SimulatePricing(ctx context.Context, in *SimulatePricingRequest, opts ...grpc.CallOption) (*SimulatePricingResponse, error) {
+	out := new(SimulatePricingResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SimulatePricing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) Applications(ctx context.Context, in *ApplicationsRequest, opts ...grpc.CallOption) (*ApplicationsResponse, error) {
+	out := new(ApplicationsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/Applications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*Application, error) {
+	out := new(Application)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/GetApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) UpdateApplication(ctx context.Context, in *Application, opts ...grpc.CallOption) (*UpdateApplicationResponse, error) {
+	out := new(UpdateApplicationResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/UpdateApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) CloneApplication(ctx context.Context, in *CloneApplicationRequest, opts ...grpc.CallOption) (*CloneApplicationResponse, error) {
+	out := new(CloneApplicationResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/CloneApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) DeleteApplication(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*DeleteApplicationResponse, error) {
+	out := new(DeleteApplicationResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/DeleteApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ApplicationFiles(ctx context.Context, in *ApplicationFilesRequest, opts ...grpc.CallOption) (*ApplicationFilesResponse, error) {
+	out := new(ApplicationFilesResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ApplicationFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) GetApplicationFile(ctx context.Context, in *GetApplicationFileRequest, opts ...grpc.CallOption) (*ApplicationFile, error) {
+	out := new(ApplicationFile)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/GetApplicationFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) UpdateApplicationFile(ctx context.Context, in *ApplicationFile, opts ...grpc.CallOption) (*UpdateApplicationFileResponse, error) {
+	out := new(UpdateApplicationFileResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/UpdateApplicationFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) CloneApplicationFile(ctx context.Context, in *CloneApplicationFileRequest, opts ...grpc.CallOption) (*CloneApplicationFileResponse, error) {
+	out := new(CloneApplicationFileResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/CloneApplicationFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) DeleteApplicationFile(ctx context.Context, in *DeleteApplicationFileRequest, opts ...grpc.CallOption) (*DeleteApplicationFileResponse, error) {
+	out := new(DeleteApplicationFileResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/DeleteApplicationFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) LaunchApplication(ctx context.Context, in *LaunchApplicationRequest, opts ...grpc.CallOption) (*LaunchApplicationResponse, error) {
+	out := new(LaunchApplicationResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/LaunchApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) StopApplication(ctx context.Context, in *StopApplicationRequest, opts ...grpc.CallOption) (*StopApplicationResponse, error) {
+	out := new(StopApplicationResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/StopApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ApplicationLog(ctx context.Context, in *ApplicationLogRequest, opts ...grpc.CallOption) (*ApplicationLogResponse, error) {
+	out := new(ApplicationLogResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ApplicationLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ConsumeApplicationLog(ctx context.Context, in *ConsumeApplicationLogRequest, opts ...grpc.CallOption) (JungleTV_ConsumeApplicationLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[11], "/jungletv.JungleTV/ConsumeApplicationLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jungleTVConsumeApplicationLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JungleTV_ConsumeApplicationLogClient interface {
+	Recv() (*ApplicationLogEntryContainer, error)
+	grpc.ClientStream
+}
+
+type jungleTVConsumeApplicationLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *jungleTVConsumeApplicationLogClient) Recv() (*ApplicationLogEntryContainer, error) {
+	m := new(ApplicationLogEntryContainer)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jungleTVClient) MonitorRunningApplications(ctx context.Context, in *MonitorRunningApplicationsRequest, opts ...grpc.CallOption) (JungleTV_MonitorRunningApplicationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[12], "/jungletv.JungleTV/MonitorRunningApplications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jungleTVMonitorRunningApplicationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JungleTV_MonitorRunningApplicationsClient interface {
+	Recv() (*RunningApplications, error)
 	grpc.ClientStream
 }
 
@@ -1481,7 +2014,7 @@ func (c *jungleTVClient) ResolveApplicationPage(ctx context.Context, in *Resolve
 }
 
 func (c *jungleTVClient) ConsumeApplicationEvents(ctx context.Context, in *ConsumeApplicationEventsRequest, opts ...grpc.CallOption) (JungleTV_ConsumeApplicationEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[12], "/jungletv.JungleTV/ConsumeApplicationEvents", opts...)
+	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[13], "/jungletv.JungleTV/ConsumeApplicationEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1540,10 +2073,12 @@ type JungleTVServer interface {
 	EnqueueMedia(context.Context, *EnqueueMediaRequest) (*EnqueueMediaResponse, error)
 	RemoveOwnQueueEntry(context.Context, *RemoveOwnQueueEntryRequest) (*RemoveOwnQueueEntryResponse, error)
 	MoveQueueEntry(context.Context, *MoveQueueEntryRequest) (*MoveQueueEntryResponse, error)
+	QueueEntryMovementCost(context.Context, *QueueEntryMovementCostRequest) (*QueueEntryMovementCostResponse, error)
 	MonitorTicket(*MonitorTicketRequest, JungleTV_MonitorTicketServer) error
 	ConsumeMedia(*ConsumeMediaRequest, JungleTV_ConsumeMediaServer) error
 	MonitorQueue(*MonitorQueueRequest, JungleTV_MonitorQueueServer) error
 	MonitorSkipAndTip(*MonitorSkipAndTipRequest, JungleTV_MonitorSkipAndTipServer) error
+	BroadcastChannels(context.Context, *BroadcastChannelsRequest) (*BroadcastChannelsResponse, error)
 	RewardInfo(context.Context, *RewardInfoRequest) (*RewardInfoResponse, error)
 	SubmitActivityChallenge(context.Context, *SubmitActivityChallengeRequest) (*SubmitActivityChallengeResponse, error)
 	ProduceSegchaChallenge(context.Context, *ProduceSegchaChallengeRequest) (*ProduceSegchaChallengeResponse, error)
@@ -1556,6 +2091,8 @@ type JungleTVServer interface {
 	Leaderboards(context.Context, *LeaderboardsRequest) (*LeaderboardsResponse, error)
 	RewardHistory(context.Context, *RewardHistoryRequest) (*RewardHistoryResponse, error)
 	WithdrawalHistory(context.Context, *WithdrawalHistoryRequest) (*WithdrawalHistoryResponse, error)
+	WithdrawalPreferences(context.Context, *WithdrawalPreferencesRequest) (*WithdrawalPreferencesResponse, error)
+	SetWithdrawalPreferences(context.Context, *SetWithdrawalPreferencesRequest) (*SetWithdrawalPreferencesResponse, error)
 	OngoingRaffleInfo(context.Context, *OngoingRaffleInfoRequest) (*OngoingRaffleInfoResponse, error)
 	RaffleDrawings(context.Context, *RaffleDrawingsRequest) (*RaffleDrawingsResponse, error)
 	Connections(context.Context, *ConnectionsRequest) (*ConnectionsResponse, error)
@@ -1573,9 +2110,15 @@ type JungleTVServer interface {
 	PointsTransactions(context.Context, *PointsTransactionsRequest) (*PointsTransactionsResponse, error)
 	ChatGifSearch(context.Context, *ChatGifSearchRequest) (*ChatGifSearchResponse, error)
 	ConvertBananoToPoints(*ConvertBananoToPointsRequest, JungleTV_ConvertBananoToPointsServer) error
+	TipWithPoints(context.Context, *TipWithPointsRequest) (*TipWithPointsResponse, error)
+	TipWithBanano(*TipWithBananoRequest, JungleTV_TipWithBananoServer) error
+	PointsRewards(context.Context, *PointsRewardsRequest) (*PointsRewardsResponse, error)
+	RedeemPointsReward(context.Context, *RedeemPointsRewardRequest) (*RedeemPointsRewardResponse, error)
+	PointsRewardRedemptions(context.Context, *PointsRewardRedemptionsRequest) (*PointsRewardRedemptionsResponse, error)
 	StartOrExtendSubscription(context.Context, *StartOrExtendSubscriptionRequest) (*StartOrExtendSubscriptionResponse, error)
 	SoundCloudTrackDetails(context.Context, *SoundCloudTrackDetailsRequest) (*SoundCloudTrackDetailsResponse, error)
 	IncreaseOrReduceSkipThreshold(context.Context, *IncreaseOrReduceSkipThresholdRequest) (*IncreaseOrReduceSkipThresholdResponse, error)
+	VoteToSkip(context.Context, *VoteToSkipRequest) (*VoteToSkipResponse, error)
 	CheckMediaEnqueuingPassword(context.Context, *CheckMediaEnqueuingPasswordRequest) (*CheckMediaEnqueuingPasswordResponse, error)
 	MonitorMediaEnqueuingPermission(*MonitorMediaEnqueuingPermissionRequest, JungleTV_MonitorMediaEnqueuingPermissionServer) error
 	InvalidateAuthTokens(context.Context, *InvalidateAuthTokensRequest) (*InvalidateAuthTokensResponse, error)
@@ -1611,12 +2154,24 @@ type JungleTVServer interface {
 	ConfirmRaffleWinner(context.Context, *ConfirmRaffleWinnerRequest) (*ConfirmRaffleWinnerResponse, error)
 	CompleteRaffle(context.Context, *CompleteRaffleRequest) (*CompleteRaffleResponse, error)
 	RedrawRaffle(context.Context, *RedrawRaffleRequest) (*RedrawRaffleResponse, error)
+	Refunds(context.Context, *RefundsRequest) (*RefundsResponse, error)
+	ProposeRefund(context.Context, *ProposeRefundRequest) (*ProposeRefundResponse, error)
+	ApproveRefund(context.Context, *ApproveRefundRequest) (*ApproveRefundResponse, error)
+	RetryRefund(context.Context, *RetryRefundRequest) (*RetryRefundResponse, error)
+	ReconcileJournal(context.Context, *ReconcileJournalRequest) (*ReconcileJournalResponse, error)
+	ScheduledRains(context.Context, *ScheduledRainsRequest) (*ScheduledRainsResponse, error)
+	ScheduleRain(context.Context, *ScheduleRainRequest) (*ScheduleRainResponse, error)
+	CancelScheduledRain(context.Context, *CancelScheduledRainRequest) (*CancelScheduledRainResponse, error)
 	TriggerAnnouncementsNotification(context.Context, *TriggerAnnouncementsNotificationRequest) (*TriggerAnnouncementsNotificationResponse, error)
 	SpectatorInfo(context.Context, *SpectatorInfoRequest) (*Spectator, error)
 	ResetSpectatorStatus(context.Context, *ResetSpectatorStatusRequest) (*ResetSpectatorStatusResponse, error)
 	MonitorModerationStatus(*MonitorModerationStatusRequest, JungleTV_MonitorModerationStatusServer) error
 	SetOwnQueueEntryRemovalAllowed(context.Context, *SetOwnQueueEntryRemovalAllowedRequest) (*SetOwnQueueEntryRemovalAllowedResponse, error)
 	SetQueueEntryReorderingAllowed(context.Context, *SetQueueEntryReorderingAllowedRequest) (*SetQueueEntryReorderingAllowedResponse, error)
+	SetQueueOrderingPolicy(context.Context, *SetQueueOrderingPolicyRequest) (*SetQueueOrderingPolicyResponse, error)
+	SetRewardDistributionStrategy(context.Context, *SetRewardDistributionStrategyRequest) (*SetRewardDistributionStrategyResponse, error)
+	PauseBroadcast(context.Context, *PauseBroadcastRequest) (*PauseBroadcastResponse, error)
+	ResumeBroadcast(context.Context, *ResumeBroadcastRequest) (*ResumeBroadcastResponse, error)
 	SetNewQueueEntriesAlwaysUnskippable(context.Context, *SetNewQueueEntriesAlwaysUnskippableRequest) (*SetNewQueueEntriesAlwaysUnskippableResponse, error)
 	SetSkippingEnabled(context.Context, *SetSkippingEnabledRequest) (*SetSkippingEnabledResponse, error)
 	SetQueueInsertCursor(context.Context, *SetQueueInsertCursorRequest) (*SetQueueInsertCursorResponse, error)
@@ -1625,6 +2180,9 @@ type JungleTVServer interface {
 	MarkAsActivelyModerating(context.Context, *MarkAsActivelyModeratingRequest) (*MarkAsActivelyModeratingResponse, error)
 	StopActivelyModerating(context.Context, *StopActivelyModeratingRequest) (*StopActivelyModeratingResponse, error)
 	AdjustPointsBalance(context.Context, *AdjustPointsBalanceRequest) (*AdjustPointsBalanceResponse, error)
+	SetPointsReward(context.Context, *SetPointsRewardRequest) (*SetPointsRewardResponse, error)
+	AllPointsRewardRedemptions(context.Context, *AllPointsRewardRedemptionsRequest) (*AllPointsRewardRedemptionsResponse, error)
+	UpdatePointsRewardRedemption(context.Context, *UpdatePointsRewardRedemptionRequest) (*UpdatePointsRewardRedemptionResponse, error)
 	AddVipUser(context.Context, *AddVipUserRequest) (*AddVipUserResponse, error)
 	RemoveVipUser(context.Context, *RemoveVipUserRequest) (*RemoveVipUserResponse, error)
 	TriggerClientReload(context.Context, *TriggerClientReloadRequest) (*TriggerClientReloadResponse, error)
@@ -1632,6 +2190,32 @@ type JungleTVServer interface {
 	InvalidateUserAuthTokens(context.Context, *InvalidateUserAuthTokensRequest) (*InvalidateUserAuthTokensResponse, error)
 	SetRPCProxyEnabled(context.Context, *SetRPCProxyEnabledRequest) (*SetRPCProxyEnabledResponse, error)
 	Spectators(context.Context, *SpectatorsRequest) (*SpectatorsResponse, error)
+	TimetableSlots(context.Context, *TimetableSlotsRequest) (*TimetableSlotsResponse, error)
+	SetTimetableSlot(context.Context, *SetTimetableSlotRequest) (*SetTimetableSlotResponse, error)
+	RemoveTimetableSlot(context.Context, *RemoveTimetableSlotRequest) (*RemoveTimetableSlotResponse, error)
+	CreateBroadcastChannel(context.Context, *CreateBroadcastChannelRequest) (*CreateBroadcastChannelResponse, error)
+	AutoplayPools(context.Context, *AutoplayPoolsRequest) (*AutoplayPoolsResponse, error)
+	SetAutoplayPool(context.Context, *SetAutoplayPoolRequest) (*SetAutoplayPoolResponse, error)
+	RemoveAutoplayPool(context.Context, *RemoveAutoplayPoolRequest) (*RemoveAutoplayPoolResponse, error)
+	AutoplayPoolEntries(context.Context, *AutoplayPoolEntriesRequest) (*AutoplayPoolEntriesResponse, error)
+	AddAutoplayPoolEntry(context.Context, *AddAutoplayPoolEntryRequest) (*AddAutoplayPoolEntryResponse, error)
+	RemoveAutoplayPoolEntry(context.Context, *RemoveAutoplayPoolEntryRequest) (*RemoveAutoplayPoolEntryResponse, error)
+	QueueSnapshots(context.Context, *QueueSnapshotsRequest) (*QueueSnapshotsResponse, error)
+	QueueSnapshot(context.Context, *QueueSnapshotRequest) (*QueueSnapshotResponse, error)
+	RestoreQueueSnapshot(context.Context, *RestoreQueueSnapshotRequest) (*RestoreQueueSnapshotResponse, error)
+	MediaReplayRules(context.Context, *MediaReplayRulesRequest) (*MediaReplayRulesResponse, error)
+	SetMediaReplayRule(context.Context, *SetMediaReplayRuleRequest) (*SetMediaReplayRuleResponse, error)
+	RemoveMediaReplayRule(context.Context, *RemoveMediaReplayRuleRequest) (*RemoveMediaReplayRuleResponse, error)
+	LibraryMedia(context.Context, *LibraryMediaRequest) (*LibraryMediaResponse, error)
+	UploadLibraryMedia(context.Context, *UploadLibraryMediaRequest) (*UploadLibraryMediaResponse, error)
+	RemoveLibraryMedia(context.Context, *RemoveLibraryMediaRequest) (*RemoveLibraryMediaResponse, error)
+	ExportQueue(context.Context, *ExportQueueRequest) (*ExportQueueResponse, error)
+	ExportPlayedMedia(context.Context, *ExportPlayedMediaRequest) (*ExportPlayedMediaResponse, error)
+	ImportQueue(context.Context, *ImportQueueRequest) (*ImportQueueResponse, error)
+	PricingRules(context.Context, *PricingRulesRequest) (*PricingRulesResponse, error)
+	SetPricingRule(context.Context, *SetPricingRuleRequest) (*SetPricingRuleResponse, error)
+	RemovePricingRule(context.Context, *RemovePricingRuleRequest) (*RemovePricingRuleResponse, error)
+	SimulatePricing(context.Context, *SimulatePricingRequest) (*SimulatePricingResponse, error)
 	// application editor endpoints
 	Applications(context.Context, *ApplicationsRequest) (*ApplicationsResponse, error)
 	GetApplication(context.Context, *GetApplicationRequest) (*Application, error)
@@ -1682,6 +2266,9 @@ func (UnimplementedJungleTVServer) RemoveOwnQueueEntry(context.Context, *RemoveO
 func (UnimplementedJungleTVServer) MoveQueueEntry(context.Context, *MoveQueueEntryRequest) (*MoveQueueEntryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MoveQueueEntry not implemented")
 }
+func (UnimplementedJungleTVServer) QueueEntryMovementCost(context.Context, *QueueEntryMovementCostRequest) (*QueueEntryMovementCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueueEntryMovementCost not implemented")
+}
 func (UnimplementedJungleTVServer) MonitorTicket(*MonitorTicketRequest, JungleTV_MonitorTicketServer) error {
 	return status.Errorf(codes.Unimplemented, "method MonitorTicket not implemented")
 }
@@ -1694,6 +2281,9 @@ func (UnimplementedJungleTVServer) MonitorQueue(*MonitorQueueRequest, JungleTV_M
 func (UnimplementedJungleTVServer) MonitorSkipAndTip(*MonitorSkipAndTipRequest, JungleTV_MonitorSkipAndTipServer) error {
 	return status.Errorf(codes.Unimplemented, "method MonitorSkipAndTip not implemented")
 }
+func (UnimplementedJungleTVServer) BroadcastChannels(context.Context, *BroadcastChannelsRequest) (*BroadcastChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastChannels not implemented")
+}
 func (UnimplementedJungleTVServer) RewardInfo(context.Context, *RewardInfoRequest) (*RewardInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RewardInfo not implemented")
 }
@@ -1730,6 +2320,12 @@ func (UnimplementedJungleTVServer) RewardHistory(context.Context, *RewardHistory
 func (UnimplementedJungleTVServer) WithdrawalHistory(context.Context, *WithdrawalHistoryRequest) (*WithdrawalHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalHistory not implemented")
 }
+func (UnimplementedJungleTVServer) WithdrawalPreferences(context.Context, *WithdrawalPreferencesRequest) (*WithdrawalPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalPreferences not implemented")
+}
+func (UnimplementedJungleTVServer) SetWithdrawalPreferences(context.Context, *SetWithdrawalPreferencesRequest) (*SetWithdrawalPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawalPreferences not implemented")
+}
 func (UnimplementedJungleTVServer) OngoingRaffleInfo(context.Context, *OngoingRaffleInfoRequest) (*OngoingRaffleInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OngoingRaffleInfo not implemented")
 }
@@ -1781,6 +2377,21 @@ func (UnimplementedJungleTVServer) ChatGifSearch(context.Context, *ChatGifSearch
 func (UnimplementedJungleTVServer) ConvertBananoToPoints(*ConvertBananoToPointsRequest, JungleTV_ConvertBananoToPointsServer) error {
 	return status.Errorf(codes.Unimplemented, "method ConvertBananoToPoints not implemented")
 }
+func (UnimplementedJungleTVServer) TipWithPoints(context.Context, *TipWithPointsRequest) (*TipWithPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TipWithPoints not implemented")
+}
+func (UnimplementedJungleTVServer) TipWithBanano(*TipWithBananoRequest, JungleTV_TipWithBananoServer) error {
+	return status.Errorf(codes.Unimplemented, "method TipWithBanano not implemented")
+}
+func (UnimplementedJungleTVServer) PointsRewards(context.Context, *PointsRewardsRequest) (*PointsRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PointsRewards not implemented")
+}
+func (UnimplementedJungleTVServer) RedeemPointsReward(context.Context, *RedeemPointsRewardRequest) (*RedeemPointsRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemPointsReward not implemented")
+}
+func (UnimplementedJungleTVServer) PointsRewardRedemptions(context.Context, *PointsRewardRedemptionsRequest) (*PointsRewardRedemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PointsRewardRedemptions not implemented")
+}
 func (UnimplementedJungleTVServer) StartOrExtendSubscription(context.Context, *StartOrExtendSubscriptionRequest) (*StartOrExtendSubscriptionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartOrExtendSubscription not implemented")
 }
@@ -1790,6 +2401,9 @@ func (UnimplementedJungleTVServer) SoundCloudTrackDetails(context.Context, *Soun
 func (UnimplementedJungleTVServer) IncreaseOrReduceSkipThreshold(context.Context, *IncreaseOrReduceSkipThresholdRequest) (*IncreaseOrReduceSkipThresholdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IncreaseOrReduceSkipThreshold not implemented")
 }
+func (UnimplementedJungleTVServer) VoteToSkip(context.Context, *VoteToSkipRequest) (*VoteToSkipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteToSkip not implemented")
+}
 func (UnimplementedJungleTVServer) CheckMediaEnqueuingPassword(context.Context, *CheckMediaEnqueuingPasswordRequest) (*CheckMediaEnqueuingPasswordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckMediaEnqueuingPassword not implemented")
 }
@@ -1892,6 +2506,30 @@ func (UnimplementedJungleTVServer) CompleteRaffle(context.Context, *CompleteRaff
 func (UnimplementedJungleTVServer) RedrawRaffle(context.Context, *RedrawRaffleRequest) (*RedrawRaffleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RedrawRaffle not implemented")
 }
+func (UnimplementedJungleTVServer) Refunds(context.Context, *RefundsRequest) (*RefundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refunds not implemented")
+}
+func (UnimplementedJungleTVServer) ProposeRefund(context.Context, *ProposeRefundRequest) (*ProposeRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeRefund not implemented")
+}
+func (UnimplementedJungleTVServer) ApproveRefund(context.Context, *ApproveRefundRequest) (*ApproveRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRefund not implemented")
+}
+func (UnimplementedJungleTVServer) RetryRefund(context.Context, *RetryRefundRequest) (*RetryRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryRefund not implemented")
+}
+func (UnimplementedJungleTVServer) ReconcileJournal(context.Context, *ReconcileJournalRequest) (*ReconcileJournalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileJournal not implemented")
+}
+func (UnimplementedJungleTVServer) ScheduledRains(context.Context, *ScheduledRainsRequest) (*ScheduledRainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledRains not implemented")
+}
+func (UnimplementedJungleTVServer) ScheduleRain(context.Context, *ScheduleRainRequest) (*ScheduleRainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleRain not implemented")
+}
+func (UnimplementedJungleTVServer) CancelScheduledRain(context.Context, *CancelScheduledRainRequest) (*CancelScheduledRainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledRain not implemented")
+}
 func (UnimplementedJungleTVServer) TriggerAnnouncementsNotification(context.Context, *TriggerAnnouncementsNotificationRequest) (*TriggerAnnouncementsNotificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TriggerAnnouncementsNotification not implemented")
 }
@@ -1910,6 +2548,18 @@ func (UnimplementedJungleTVServer) SetOwnQueueEntryRemovalAllowed(context.Contex
 func (UnimplementedJungleTVServer) SetQueueEntryReorderingAllowed(context.Context, *SetQueueEntryReorderingAllowedRequest) (*SetQueueEntryReorderingAllowedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetQueueEntryReorderingAllowed not implemented")
 }
+func (UnimplementedJungleTVServer) SetQueueOrderingPolicy(context.Context, *SetQueueOrderingPolicyRequest) (*SetQueueOrderingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQueueOrderingPolicy not implemented")
+}
+func (UnimplementedJungleTVServer) SetRewardDistributionStrategy(context.Context, *SetRewardDistributionStrategyRequest) (*SetRewardDistributionStrategyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardDistributionStrategy not implemented")
+}
+func (UnimplementedJungleTVServer) PauseBroadcast(context.Context, *PauseBroadcastRequest) (*PauseBroadcastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseBroadcast not implemented")
+}
+func (UnimplementedJungleTVServer) ResumeBroadcast(context.Context, *ResumeBroadcastRequest) (*ResumeBroadcastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeBroadcast not implemented")
+}
 func (UnimplementedJungleTVServer) SetNewQueueEntriesAlwaysUnskippable(context.Context, *SetNewQueueEntriesAlwaysUnskippableRequest) (*SetNewQueueEntriesAlwaysUnskippableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetNewQueueEntriesAlwaysUnskippable not implemented")
 }
@@ -1934,6 +2584,15 @@ func (UnimplementedJungleTVServer) StopActivelyModerating(context.Context, *Stop
 func (UnimplementedJungleTVServer) AdjustPointsBalance(context.Context, *AdjustPointsBalanceRequest) (*AdjustPointsBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdjustPointsBalance not implemented")
 }
+func (UnimplementedJungleTVServer) SetPointsReward(context.Context, *SetPointsRewardRequest) (*SetPointsRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPointsReward not implemented")
+}
+func (UnimplementedJungleTVServer) AllPointsRewardRedemptions(context.Context, *AllPointsRewardRedemptionsRequest) (*AllPointsRewardRedemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllPointsRewardRedemptions not implemented")
+}
+func (UnimplementedJungleTVServer) UpdatePointsRewardRedemption(context.Context, *UpdatePointsRewardRedemptionRequest) (*UpdatePointsRewardRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePointsRewardRedemption not implemented")
+}
 func (UnimplementedJungleTVServer) AddVipUser(context.Context, *AddVipUserRequest) (*AddVipUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddVipUser not implemented")
 }
@@ -1955,6 +2614,84 @@ func (UnimplementedJungleTVServer) SetRPCProxyEnabled(context.Context, *SetRPCPr
 func (UnimplementedJungleTVServer) Spectators(context.Context, *SpectatorsRequest) (*SpectatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Spectators not implemented")
 }
+func (UnimplementedJungleTVServer) TimetableSlots(context.Context, *TimetableSlotsRequest) (*TimetableSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimetableSlots not implemented")
+}
+func (UnimplementedJungleTVServer) SetTimetableSlot(context.Context, *SetTimetableSlotRequest) (*SetTimetableSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTimetableSlot not implemented")
+}
+func (UnimplementedJungleTVServer) RemoveTimetableSlot(context.Context, *RemoveTimetableSlotRequest) (*RemoveTimetableSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTimetableSlot not implemented")
+}
+func (UnimplementedJungleTVServer) CreateBroadcastChannel(context.Context, *CreateBroadcastChannelRequest) (*CreateBroadcastChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBroadcastChannel not implemented")
+}
+func (UnimplementedJungleTVServer) AutoplayPools(context.Context, *AutoplayPoolsRequest) (*AutoplayPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoplayPools not implemented")
+}
+func (UnimplementedJungleTVServer) SetAutoplayPool(context.Context, *SetAutoplayPoolRequest) (*SetAutoplayPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoplayPool not implemented")
+}
+func (UnimplementedJungleTVServer) RemoveAutoplayPool(context.Context, *RemoveAutoplayPoolRequest) (*RemoveAutoplayPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAutoplayPool not implemented")
+}
+func (UnimplementedJungleTVServer) AutoplayPoolEntries(context.Context, *AutoplayPoolEntriesRequest) (*AutoplayPoolEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoplayPoolEntries not implemented")
+}
+func (UnimplementedJungleTVServer) AddAutoplayPoolEntry(context.Context, *AddAutoplayPoolEntryRequest) (*AddAutoplayPoolEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAutoplayPoolEntry not implemented")
+}
+func (UnimplementedJungleTVServer) RemoveAutoplayPoolEntry(context.Context, *RemoveAutoplayPoolEntryRequest) (*RemoveAutoplayPoolEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAutoplayPoolEntry not implemented")
+}
+func (UnimplementedJungleTVServer) QueueSnapshots(context.Context, *QueueSnapshotsRequest) (*QueueSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueueSnapshots not implemented")
+}
+func (UnimplementedJungleTVServer) QueueSnapshot(context.Context, *QueueSnapshotRequest) (*QueueSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueueSnapshot not implemented")
+}
+func (UnimplementedJungleTVServer) RestoreQueueSnapshot(context.Context, *RestoreQueueSnapshotRequest) (*RestoreQueueSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreQueueSnapshot not implemented")
+}
+func (UnimplementedJungleTVServer) MediaReplayRules(context.Context, *MediaReplayRulesRequest) (*MediaReplayRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaReplayRules not implemented")
+}
+func (UnimplementedJungleTVServer) SetMediaReplayRule(context.Context, *SetMediaReplayRuleRequest) (*SetMediaReplayRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMediaReplayRule not implemented")
+}
+func (UnimplementedJungleTVServer) RemoveMediaReplayRule(context.Context, *RemoveMediaReplayRuleRequest) (*RemoveMediaReplayRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMediaReplayRule not implemented")
+}
+func (UnimplementedJungleTVServer) LibraryMedia(context.Context, *LibraryMediaRequest) (*LibraryMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LibraryMedia not implemented")
+}
+func (UnimplementedJungleTVServer) UploadLibraryMedia(context.Context, *UploadLibraryMediaRequest) (*UploadLibraryMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadLibraryMedia not implemented")
+}
+func (UnimplementedJungleTVServer) RemoveLibraryMedia(context.Context, *RemoveLibraryMediaRequest) (*RemoveLibraryMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLibraryMedia not implemented")
+}
+func (UnimplementedJungleTVServer) ExportQueue(context.Context, *ExportQueueRequest) (*ExportQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportQueue not implemented")
+}
+func (UnimplementedJungleTVServer) ExportPlayedMedia(context.Context, *ExportPlayedMediaRequest) (*ExportPlayedMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPlayedMedia not implemented")
+}
+func (UnimplementedJungleTVServer) ImportQueue(context.Context, *ImportQueueRequest) (*ImportQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportQueue not implemented")
+}
+func (UnimplementedJungleTVServer) PricingRules(context.Context, *PricingRulesRequest) (*PricingRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PricingRules not implemented")
+}
+func (UnimplementedJungleTVServer) SetPricingRule(context.Context, *SetPricingRuleRequest) (*SetPricingRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPricingRule not implemented")
+}
+func (UnimplementedJungleTVServer) RemovePricingRule(context.Context, *RemovePricingRuleRequest) (*RemovePricingRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePricingRule not implemented")
+}
+func (UnimplementedJungleTVServer) SimulatePricing(context.Context, *SimulatePricingRequest) (*SimulatePricingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePricing not implemented")
+}
 func (UnimplementedJungleTVServer) Applications(context.Context, *ApplicationsRequest) (*ApplicationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Applications not implemented")
 }
@@ -2148,6 +2885,24 @@ func _JungleTV_MoveQueueEntry_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JungleTV_QueueEntryMovementCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueEntryMovementCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).QueueEntryMovementCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/QueueEntryMovementCost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).QueueEntryMovementCost(ctx, req.(*QueueEntryMovementCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JungleTV_MonitorTicket_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(MonitorTicketRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2232,17 +2987,35 @@ func (x *jungleTVMonitorSkipAndTipServer) Send(m *SkipAndTipStatus) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _JungleTV_RewardInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RewardInfoRequest)
+func _JungleTV_BroadcastChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastChannelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).RewardInfo(ctx, in)
+		return srv.(JungleTVServer).BroadcastChannels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/RewardInfo",
+		FullMethod: "/jungletv.JungleTV/BroadcastChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).BroadcastChannels(ctx, req.(*BroadcastChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_RewardInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).RewardInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/RewardInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(JungleTVServer).RewardInfo(ctx, req.(*RewardInfoRequest))
@@ -2451,6 +3224,42 @@ func _JungleTV_WithdrawalHistory_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JungleTV_WithdrawalPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).WithdrawalPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/WithdrawalPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).WithdrawalPreferences(ctx, req.(*WithdrawalPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetWithdrawalPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWithdrawalPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetWithdrawalPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetWithdrawalPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetWithdrawalPreferences(ctx, req.(*SetWithdrawalPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JungleTV_OngoingRaffleInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OngoingRaffleInfoRequest)
 	if err := dec(in); err != nil {
@@ -2760,6 +3569,99 @@ func (x *jungleTVConvertBananoToPointsServer) Send(m *ConvertBananoToPointsStatu
 	return x.ServerStream.SendMsg(m)
 }
 
+func _JungleTV_TipWithPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TipWithPointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).TipWithPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/TipWithPoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).TipWithPoints(ctx, req.(*TipWithPointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_TipWithBanano_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TipWithBananoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JungleTVServer).TipWithBanano(m, &jungleTVTipWithBananoServer{stream})
+}
+
+type JungleTV_TipWithBananoServer interface {
+	Send(*TipWithBananoStatus) error
+	grpc.ServerStream
+}
+
+type jungleTVTipWithBananoServer struct {
+	grpc.ServerStream
+}
+
+func (x *jungleTVTipWithBananoServer) Send(m *TipWithBananoStatus) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JungleTV_PointsRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PointsRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).PointsRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/PointsRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).PointsRewards(ctx, req.(*PointsRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_RedeemPointsReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemPointsRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).RedeemPointsReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/RedeemPointsReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).RedeemPointsReward(ctx, req.(*RedeemPointsRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_PointsRewardRedemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PointsRewardRedemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).PointsRewardRedemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/PointsRewardRedemptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).PointsRewardRedemptions(ctx, req.(*PointsRewardRedemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JungleTV_StartOrExtendSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartOrExtendSubscriptionRequest)
 	if err := dec(in); err != nil {
@@ -2814,6 +3716,24 @@ func _JungleTV_IncreaseOrReduceSkipThreshold_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JungleTV_VoteToSkip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteToSkipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).VoteToSkip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/VoteToSkip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).VoteToSkip(ctx, req.(*VoteToSkipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JungleTV_CheckMediaEnqueuingPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckMediaEnqueuingPasswordRequest)
 	if err := dec(in); err != nil {
@@ -3319,496 +4239,1234 @@ func _JungleTV_SetPricesMultiplier_Handler(srv interface{}, ctx context.Context,
 		FullMethod: "/jungletv.JungleTV/SetPricesMultiplier",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SetPricesMultiplier(ctx, req.(*SetPricesMultiplierRequest))
+		return srv.(JungleTVServer).SetPricesMultiplier(ctx, req.(*SetPricesMultiplierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetMinimumPricesMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMinimumPricesMultiplierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetMinimumPricesMultiplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetMinimumPricesMultiplier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetMinimumPricesMultiplier(ctx, req.(*SetMinimumPricesMultiplierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetCrowdfundedSkippingEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCrowdfundedSkippingEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetCrowdfundedSkippingEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetCrowdfundedSkippingEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetCrowdfundedSkippingEnabled(ctx, req.(*SetCrowdfundedSkippingEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetSkipPriceMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSkipPriceMultiplierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetSkipPriceMultiplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetSkipPriceMultiplier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetSkipPriceMultiplier(ctx, req.(*SetSkipPriceMultiplierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_ConfirmRaffleWinner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmRaffleWinnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).ConfirmRaffleWinner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/ConfirmRaffleWinner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).ConfirmRaffleWinner(ctx, req.(*ConfirmRaffleWinnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_CompleteRaffle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteRaffleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).CompleteRaffle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/CompleteRaffle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).CompleteRaffle(ctx, req.(*CompleteRaffleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_RedrawRaffle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedrawRaffleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).RedrawRaffle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/RedrawRaffle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).RedrawRaffle(ctx, req.(*RedrawRaffleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_Refunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).Refunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/Refunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).Refunds(ctx, req.(*RefundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_ProposeRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).ProposeRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/ProposeRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).ProposeRefund(ctx, req.(*ProposeRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_ApproveRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).ApproveRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/ApproveRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).ApproveRefund(ctx, req.(*ApproveRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_RetryRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).RetryRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/RetryRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).RetryRefund(ctx, req.(*RetryRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_ReconcileJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileJournalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).ReconcileJournal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/ReconcileJournal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).ReconcileJournal(ctx, req.(*ReconcileJournalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_ScheduledRains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledRainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).ScheduledRains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/ScheduledRains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).ScheduledRains(ctx, req.(*ScheduledRainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_ScheduleRain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleRainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).ScheduleRain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/ScheduleRain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).ScheduleRain(ctx, req.(*ScheduleRainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_CancelScheduledRain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledRainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).CancelScheduledRain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/CancelScheduledRain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).CancelScheduledRain(ctx, req.(*CancelScheduledRainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_TriggerAnnouncementsNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerAnnouncementsNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).TriggerAnnouncementsNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/TriggerAnnouncementsNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).TriggerAnnouncementsNotification(ctx, req.(*TriggerAnnouncementsNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SpectatorInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpectatorInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SpectatorInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SpectatorInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SpectatorInfo(ctx, req.(*SpectatorInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_ResetSpectatorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetSpectatorStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).ResetSpectatorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/ResetSpectatorStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).ResetSpectatorStatus(ctx, req.(*ResetSpectatorStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_MonitorModerationStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MonitorModerationStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JungleTVServer).MonitorModerationStatus(m, &jungleTVMonitorModerationStatusServer{stream})
+}
+
+type JungleTV_MonitorModerationStatusServer interface {
+	Send(*ModerationStatusOverview) error
+	grpc.ServerStream
+}
+
+type jungleTVMonitorModerationStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *jungleTVMonitorModerationStatusServer) Send(m *ModerationStatusOverview) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JungleTV_SetOwnQueueEntryRemovalAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOwnQueueEntryRemovalAllowedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetOwnQueueEntryRemovalAllowed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetOwnQueueEntryRemovalAllowed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetOwnQueueEntryRemovalAllowed(ctx, req.(*SetOwnQueueEntryRemovalAllowedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetQueueEntryReorderingAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQueueEntryReorderingAllowedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetQueueEntryReorderingAllowed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetQueueEntryReorderingAllowed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetQueueEntryReorderingAllowed(ctx, req.(*SetQueueEntryReorderingAllowedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetQueueOrderingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQueueOrderingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetQueueOrderingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetQueueOrderingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetQueueOrderingPolicy(ctx, req.(*SetQueueOrderingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetRewardDistributionStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRewardDistributionStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetRewardDistributionStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetRewardDistributionStrategy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetRewardDistributionStrategy(ctx, req.(*SetRewardDistributionStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_PauseBroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseBroadcastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).PauseBroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/PauseBroadcast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).PauseBroadcast(ctx, req.(*PauseBroadcastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_ResumeBroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeBroadcastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).ResumeBroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/ResumeBroadcast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).ResumeBroadcast(ctx, req.(*ResumeBroadcastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetNewQueueEntriesAlwaysUnskippable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNewQueueEntriesAlwaysUnskippableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetNewQueueEntriesAlwaysUnskippable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetNewQueueEntriesAlwaysUnskippable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetNewQueueEntriesAlwaysUnskippable(ctx, req.(*SetNewQueueEntriesAlwaysUnskippableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetSkippingEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSkippingEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetSkippingEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetSkippingEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetSkippingEnabled(ctx, req.(*SetSkippingEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetQueueInsertCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQueueInsertCursorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetQueueInsertCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetQueueInsertCursor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetQueueInsertCursor(ctx, req.(*SetQueueInsertCursorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_ClearQueueInsertCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearQueueInsertCursorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).ClearQueueInsertCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/ClearQueueInsertCursor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).ClearQueueInsertCursor(ctx, req.(*ClearQueueInsertCursorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_ClearUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearUserProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).ClearUserProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/ClearUserProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).ClearUserProfile(ctx, req.(*ClearUserProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_MarkAsActivelyModerating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAsActivelyModeratingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).MarkAsActivelyModerating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/MarkAsActivelyModerating",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).MarkAsActivelyModerating(ctx, req.(*MarkAsActivelyModeratingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_StopActivelyModerating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopActivelyModeratingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).StopActivelyModerating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/StopActivelyModerating",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).StopActivelyModerating(ctx, req.(*StopActivelyModeratingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_AdjustPointsBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustPointsBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).AdjustPointsBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/AdjustPointsBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).AdjustPointsBalance(ctx, req.(*AdjustPointsBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetPointsReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPointsRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetPointsReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetPointsReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetPointsReward(ctx, req.(*SetPointsRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_AllPointsRewardRedemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllPointsRewardRedemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).AllPointsRewardRedemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/AllPointsRewardRedemptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).AllPointsRewardRedemptions(ctx, req.(*AllPointsRewardRedemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_UpdatePointsRewardRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePointsRewardRedemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).UpdatePointsRewardRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/UpdatePointsRewardRedemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).UpdatePointsRewardRedemption(ctx, req.(*UpdatePointsRewardRedemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_AddVipUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVipUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).AddVipUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/AddVipUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).AddVipUser(ctx, req.(*AddVipUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_RemoveVipUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveVipUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).RemoveVipUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/RemoveVipUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).RemoveVipUser(ctx, req.(*RemoveVipUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_TriggerClientReload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerClientReloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).TriggerClientReload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/TriggerClientReload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).TriggerClientReload(ctx, req.(*TriggerClientReloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetMulticurrencyPaymentsEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMulticurrencyPaymentsEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetMulticurrencyPaymentsEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetMulticurrencyPaymentsEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetMulticurrencyPaymentsEnabled(ctx, req.(*SetMulticurrencyPaymentsEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_InvalidateUserAuthTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateUserAuthTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).InvalidateUserAuthTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/InvalidateUserAuthTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).InvalidateUserAuthTokens(ctx, req.(*InvalidateUserAuthTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetRPCProxyEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRPCProxyEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetRPCProxyEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetRPCProxyEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetRPCProxyEnabled(ctx, req.(*SetRPCProxyEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_Spectators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpectatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).Spectators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/Spectators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).Spectators(ctx, req.(*SpectatorsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_SetMinimumPricesMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetMinimumPricesMultiplierRequest)
+func _JungleTV_TimetableSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimetableSlotsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).SetMinimumPricesMultiplier(ctx, in)
+		return srv.(JungleTVServer).TimetableSlots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/SetMinimumPricesMultiplier",
+		FullMethod: "/jungletv.JungleTV/TimetableSlots",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SetMinimumPricesMultiplier(ctx, req.(*SetMinimumPricesMultiplierRequest))
+		return srv.(JungleTVServer).TimetableSlots(ctx, req.(*TimetableSlotsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_SetCrowdfundedSkippingEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetCrowdfundedSkippingEnabledRequest)
+func _JungleTV_SetTimetableSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTimetableSlotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).SetCrowdfundedSkippingEnabled(ctx, in)
+		return srv.(JungleTVServer).SetTimetableSlot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/SetCrowdfundedSkippingEnabled",
+		FullMethod: "/jungletv.JungleTV/SetTimetableSlot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SetCrowdfundedSkippingEnabled(ctx, req.(*SetCrowdfundedSkippingEnabledRequest))
+		return srv.(JungleTVServer).SetTimetableSlot(ctx, req.(*SetTimetableSlotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_SetSkipPriceMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetSkipPriceMultiplierRequest)
+func _JungleTV_RemoveTimetableSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTimetableSlotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).SetSkipPriceMultiplier(ctx, in)
+		return srv.(JungleTVServer).RemoveTimetableSlot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/SetSkipPriceMultiplier",
+		FullMethod: "/jungletv.JungleTV/RemoveTimetableSlot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SetSkipPriceMultiplier(ctx, req.(*SetSkipPriceMultiplierRequest))
+		return srv.(JungleTVServer).RemoveTimetableSlot(ctx, req.(*RemoveTimetableSlotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_ConfirmRaffleWinner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfirmRaffleWinnerRequest)
+func _JungleTV_CreateBroadcastChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBroadcastChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).ConfirmRaffleWinner(ctx, in)
+		return srv.(JungleTVServer).CreateBroadcastChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/ConfirmRaffleWinner",
+		FullMethod: "/jungletv.JungleTV/CreateBroadcastChannel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).ConfirmRaffleWinner(ctx, req.(*ConfirmRaffleWinnerRequest))
+		return srv.(JungleTVServer).CreateBroadcastChannel(ctx, req.(*CreateBroadcastChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_CompleteRaffle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CompleteRaffleRequest)
+func _JungleTV_AutoplayPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoplayPoolsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).CompleteRaffle(ctx, in)
+		return srv.(JungleTVServer).AutoplayPools(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/CompleteRaffle",
+		FullMethod: "/jungletv.JungleTV/AutoplayPools",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).CompleteRaffle(ctx, req.(*CompleteRaffleRequest))
+		return srv.(JungleTVServer).AutoplayPools(ctx, req.(*AutoplayPoolsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_RedrawRaffle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RedrawRaffleRequest)
+func _JungleTV_SetAutoplayPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAutoplayPoolRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).RedrawRaffle(ctx, in)
+		return srv.(JungleTVServer).SetAutoplayPool(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/RedrawRaffle",
+		FullMethod: "/jungletv.JungleTV/SetAutoplayPool",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).RedrawRaffle(ctx, req.(*RedrawRaffleRequest))
+		return srv.(JungleTVServer).SetAutoplayPool(ctx, req.(*SetAutoplayPoolRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_TriggerAnnouncementsNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TriggerAnnouncementsNotificationRequest)
+func _JungleTV_RemoveAutoplayPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAutoplayPoolRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).TriggerAnnouncementsNotification(ctx, in)
+		return srv.(JungleTVServer).RemoveAutoplayPool(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/TriggerAnnouncementsNotification",
+		FullMethod: "/jungletv.JungleTV/RemoveAutoplayPool",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).TriggerAnnouncementsNotification(ctx, req.(*TriggerAnnouncementsNotificationRequest))
+		return srv.(JungleTVServer).RemoveAutoplayPool(ctx, req.(*RemoveAutoplayPoolRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_SpectatorInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SpectatorInfoRequest)
+func _JungleTV_AutoplayPoolEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoplayPoolEntriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).SpectatorInfo(ctx, in)
+		return srv.(JungleTVServer).AutoplayPoolEntries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/SpectatorInfo",
+		FullMethod: "/jungletv.JungleTV/AutoplayPoolEntries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SpectatorInfo(ctx, req.(*SpectatorInfoRequest))
+		return srv.(JungleTVServer).AutoplayPoolEntries(ctx, req.(*AutoplayPoolEntriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_ResetSpectatorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetSpectatorStatusRequest)
+func _JungleTV_AddAutoplayPoolEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAutoplayPoolEntryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).ResetSpectatorStatus(ctx, in)
+		return srv.(JungleTVServer).AddAutoplayPoolEntry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/ResetSpectatorStatus",
+		FullMethod: "/jungletv.JungleTV/AddAutoplayPoolEntry",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).ResetSpectatorStatus(ctx, req.(*ResetSpectatorStatusRequest))
+		return srv.(JungleTVServer).AddAutoplayPoolEntry(ctx, req.(*AddAutoplayPoolEntryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_MonitorModerationStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(MonitorModerationStatusRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(JungleTVServer).MonitorModerationStatus(m, &jungleTVMonitorModerationStatusServer{stream})
-}
-
-type JungleTV_MonitorModerationStatusServer interface {
-	Send(*ModerationStatusOverview) error
-	grpc.ServerStream
-}
-
-type jungleTVMonitorModerationStatusServer struct {
-	grpc.ServerStream
-}
-
-func (x *jungleTVMonitorModerationStatusServer) Send(m *ModerationStatusOverview) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _JungleTV_SetOwnQueueEntryRemovalAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetOwnQueueEntryRemovalAllowedRequest)
+func _JungleTV_RemoveAutoplayPoolEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAutoplayPoolEntryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).SetOwnQueueEntryRemovalAllowed(ctx, in)
+		return srv.(JungleTVServer).RemoveAutoplayPoolEntry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/SetOwnQueueEntryRemovalAllowed",
+		FullMethod: "/jungletv.JungleTV/RemoveAutoplayPoolEntry",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SetOwnQueueEntryRemovalAllowed(ctx, req.(*SetOwnQueueEntryRemovalAllowedRequest))
+		return srv.(JungleTVServer).RemoveAutoplayPoolEntry(ctx, req.(*RemoveAutoplayPoolEntryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_SetQueueEntryReorderingAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetQueueEntryReorderingAllowedRequest)
+func _JungleTV_QueueSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueSnapshotsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).SetQueueEntryReorderingAllowed(ctx, in)
+		return srv.(JungleTVServer).QueueSnapshots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/SetQueueEntryReorderingAllowed",
+		FullMethod: "/jungletv.JungleTV/QueueSnapshots",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SetQueueEntryReorderingAllowed(ctx, req.(*SetQueueEntryReorderingAllowedRequest))
+		return srv.(JungleTVServer).QueueSnapshots(ctx, req.(*QueueSnapshotsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_SetNewQueueEntriesAlwaysUnskippable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetNewQueueEntriesAlwaysUnskippableRequest)
+func _JungleTV_QueueSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).SetNewQueueEntriesAlwaysUnskippable(ctx, in)
+		return srv.(JungleTVServer).QueueSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/SetNewQueueEntriesAlwaysUnskippable",
+		FullMethod: "/jungletv.JungleTV/QueueSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SetNewQueueEntriesAlwaysUnskippable(ctx, req.(*SetNewQueueEntriesAlwaysUnskippableRequest))
+		return srv.(JungleTVServer).QueueSnapshot(ctx, req.(*QueueSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_SetSkippingEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetSkippingEnabledRequest)
+func _JungleTV_RestoreQueueSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreQueueSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).SetSkippingEnabled(ctx, in)
+		return srv.(JungleTVServer).RestoreQueueSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/SetSkippingEnabled",
+		FullMethod: "/jungletv.JungleTV/RestoreQueueSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SetSkippingEnabled(ctx, req.(*SetSkippingEnabledRequest))
+		return srv.(JungleTVServer).RestoreQueueSnapshot(ctx, req.(*RestoreQueueSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_SetQueueInsertCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetQueueInsertCursorRequest)
+func _JungleTV_MediaReplayRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MediaReplayRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).SetQueueInsertCursor(ctx, in)
+		return srv.(JungleTVServer).MediaReplayRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/SetQueueInsertCursor",
+		FullMethod: "/jungletv.JungleTV/MediaReplayRules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SetQueueInsertCursor(ctx, req.(*SetQueueInsertCursorRequest))
+		return srv.(JungleTVServer).MediaReplayRules(ctx, req.(*MediaReplayRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_ClearQueueInsertCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearQueueInsertCursorRequest)
+func _JungleTV_SetMediaReplayRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMediaReplayRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).ClearQueueInsertCursor(ctx, in)
+		return srv.(JungleTVServer).SetMediaReplayRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/ClearQueueInsertCursor",
+		FullMethod: "/jungletv.JungleTV/SetMediaReplayRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).ClearQueueInsertCursor(ctx, req.(*ClearQueueInsertCursorRequest))
+		return srv.(JungleTVServer).SetMediaReplayRule(ctx, req.(*SetMediaReplayRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_ClearUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearUserProfileRequest)
+func _JungleTV_RemoveMediaReplayRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMediaReplayRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).ClearUserProfile(ctx, in)
+		return srv.(JungleTVServer).RemoveMediaReplayRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/ClearUserProfile",
+		FullMethod: "/jungletv.JungleTV/RemoveMediaReplayRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).ClearUserProfile(ctx, req.(*ClearUserProfileRequest))
+		return srv.(JungleTVServer).RemoveMediaReplayRule(ctx, req.(*RemoveMediaReplayRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_MarkAsActivelyModerating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarkAsActivelyModeratingRequest)
+func _JungleTV_LibraryMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryMediaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).MarkAsActivelyModerating(ctx, in)
+		return srv.(JungleTVServer).LibraryMedia(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/MarkAsActivelyModerating",
+		FullMethod: "/jungletv.JungleTV/LibraryMedia",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).MarkAsActivelyModerating(ctx, req.(*MarkAsActivelyModeratingRequest))
+		return srv.(JungleTVServer).LibraryMedia(ctx, req.(*LibraryMediaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_StopActivelyModerating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopActivelyModeratingRequest)
+func _JungleTV_UploadLibraryMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadLibraryMediaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).StopActivelyModerating(ctx, in)
+		return srv.(JungleTVServer).UploadLibraryMedia(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/StopActivelyModerating",
+		FullMethod: "/jungletv.JungleTV/UploadLibraryMedia",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).StopActivelyModerating(ctx, req.(*StopActivelyModeratingRequest))
+		return srv.(JungleTVServer).UploadLibraryMedia(ctx, req.(*UploadLibraryMediaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_AdjustPointsBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdjustPointsBalanceRequest)
+func _JungleTV_RemoveLibraryMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLibraryMediaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).AdjustPointsBalance(ctx, in)
+		return srv.(JungleTVServer).RemoveLibraryMedia(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/AdjustPointsBalance",
+		FullMethod: "/jungletv.JungleTV/RemoveLibraryMedia",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).AdjustPointsBalance(ctx, req.(*AdjustPointsBalanceRequest))
+		return srv.(JungleTVServer).RemoveLibraryMedia(ctx, req.(*RemoveLibraryMediaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_AddVipUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddVipUserRequest)
+func _JungleTV_ExportQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportQueueRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).AddVipUser(ctx, in)
+		return srv.(JungleTVServer).ExportQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/AddVipUser",
+		FullMethod: "/jungletv.JungleTV/ExportQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).AddVipUser(ctx, req.(*AddVipUserRequest))
+		return srv.(JungleTVServer).ExportQueue(ctx, req.(*ExportQueueRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_RemoveVipUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveVipUserRequest)
+func _JungleTV_ExportPlayedMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPlayedMediaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).RemoveVipUser(ctx, in)
+		return srv.(JungleTVServer).ExportPlayedMedia(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/RemoveVipUser",
+		FullMethod: "/jungletv.JungleTV/ExportPlayedMedia",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).RemoveVipUser(ctx, req.(*RemoveVipUserRequest))
+		return srv.(JungleTVServer).ExportPlayedMedia(ctx, req.(*ExportPlayedMediaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_TriggerClientReload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TriggerClientReloadRequest)
+func _JungleTV_ImportQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportQueueRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).TriggerClientReload(ctx, in)
+		return srv.(JungleTVServer).ImportQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/TriggerClientReload",
+		FullMethod: "/jungletv.JungleTV/ImportQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).TriggerClientReload(ctx, req.(*TriggerClientReloadRequest))
+		return srv.(JungleTVServer).ImportQueue(ctx, req.(*ImportQueueRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_SetMulticurrencyPaymentsEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetMulticurrencyPaymentsEnabledRequest)
+func _JungleTV_PricingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PricingRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).SetMulticurrencyPaymentsEnabled(ctx, in)
+		return srv.(JungleTVServer).PricingRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/SetMulticurrencyPaymentsEnabled",
+		FullMethod: "/jungletv.JungleTV/PricingRules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SetMulticurrencyPaymentsEnabled(ctx, req.(*SetMulticurrencyPaymentsEnabledRequest))
+		return srv.(JungleTVServer).PricingRules(ctx, req.(*PricingRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_InvalidateUserAuthTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InvalidateUserAuthTokensRequest)
+func _JungleTV_SetPricingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPricingRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).InvalidateUserAuthTokens(ctx, in)
+		return srv.(JungleTVServer).SetPricingRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/InvalidateUserAuthTokens",
+		FullMethod: "/jungletv.JungleTV/SetPricingRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).InvalidateUserAuthTokens(ctx, req.(*InvalidateUserAuthTokensRequest))
+		return srv.(JungleTVServer).SetPricingRule(ctx, req.(*SetPricingRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_SetRPCProxyEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetRPCProxyEnabledRequest)
+func _JungleTV_RemovePricingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePricingRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).SetRPCProxyEnabled(ctx, in)
+		return srv.(JungleTVServer).RemovePricingRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/SetRPCProxyEnabled",
+		FullMethod: "/jungletv.JungleTV/RemovePricingRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SetRPCProxyEnabled(ctx, req.(*SetRPCProxyEnabledRequest))
+		return srv.(JungleTVServer).RemovePricingRule(ctx, req.(*RemovePricingRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_Spectators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SpectatorsRequest)
+func _JungleTV_SimulatePricing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulatePricingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).Spectators(ctx, in)
+		return srv.(JungleTVServer).SimulatePricing(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/Spectators",
+		FullMethod: "/jungletv.JungleTV/SimulatePricing",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).Spectators(ctx, req.(*SpectatorsRequest))
+		return srv.(JungleTVServer).SimulatePricing(ctx, req.(*SimulatePricingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4263,6 +5921,14 @@ var JungleTV_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MoveQueueEntry",
 			Handler:    _JungleTV_MoveQueueEntry_Handler,
 		},
+		{
+			MethodName: "QueueEntryMovementCost",
+			Handler:    _JungleTV_QueueEntryMovementCost_Handler,
+		},
+		{
+			MethodName: "BroadcastChannels",
+			Handler:    _JungleTV_BroadcastChannels_Handler,
+		},
 		{
 			MethodName: "RewardInfo",
 			Handler:    _JungleTV_RewardInfo_Handler,
@@ -4307,6 +5973,14 @@ var JungleTV_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawalHistory",
 			Handler:    _JungleTV_WithdrawalHistory_Handler,
 		},
+		{
+			MethodName: "WithdrawalPreferences",
+			Handler:    _JungleTV_WithdrawalPreferences_Handler,
+		},
+		{
+			MethodName: "SetWithdrawalPreferences",
+			Handler:    _JungleTV_SetWithdrawalPreferences_Handler,
+		},
 		{
 			MethodName: "OngoingRaffleInfo",
 			Handler:    _JungleTV_OngoingRaffleInfo_Handler,
@@ -4371,6 +6045,22 @@ var JungleTV_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChatGifSearch",
 			Handler:    _JungleTV_ChatGifSearch_Handler,
 		},
+		{
+			MethodName: "TipWithPoints",
+			Handler:    _JungleTV_TipWithPoints_Handler,
+		},
+		{
+			MethodName: "PointsRewards",
+			Handler:    _JungleTV_PointsRewards_Handler,
+		},
+		{
+			MethodName: "RedeemPointsReward",
+			Handler:    _JungleTV_RedeemPointsReward_Handler,
+		},
+		{
+			MethodName: "PointsRewardRedemptions",
+			Handler:    _JungleTV_PointsRewardRedemptions_Handler,
+		},
 		{
 			MethodName: "StartOrExtendSubscription",
 			Handler:    _JungleTV_StartOrExtendSubscription_Handler,
@@ -4383,6 +6073,10 @@ var JungleTV_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IncreaseOrReduceSkipThreshold",
 			Handler:    _JungleTV_IncreaseOrReduceSkipThreshold_Handler,
 		},
+		{
+			MethodName: "VoteToSkip",
+			Handler:    _JungleTV_VoteToSkip_Handler,
+		},
 		{
 			MethodName: "CheckMediaEnqueuingPassword",
 			Handler:    _JungleTV_CheckMediaEnqueuingPassword_Handler,
@@ -4511,6 +6205,38 @@ var JungleTV_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RedrawRaffle",
 			Handler:    _JungleTV_RedrawRaffle_Handler,
 		},
+		{
+			MethodName: "Refunds",
+			Handler:    _JungleTV_Refunds_Handler,
+		},
+		{
+			MethodName: "ProposeRefund",
+			Handler:    _JungleTV_ProposeRefund_Handler,
+		},
+		{
+			MethodName: "ApproveRefund",
+			Handler:    _JungleTV_ApproveRefund_Handler,
+		},
+		{
+			MethodName: "RetryRefund",
+			Handler:    _JungleTV_RetryRefund_Handler,
+		},
+		{
+			MethodName: "ReconcileJournal",
+			Handler:    _JungleTV_ReconcileJournal_Handler,
+		},
+		{
+			MethodName: "ScheduledRains",
+			Handler:    _JungleTV_ScheduledRains_Handler,
+		},
+		{
+			MethodName: "ScheduleRain",
+			Handler:    _JungleTV_ScheduleRain_Handler,
+		},
+		{
+			MethodName: "CancelScheduledRain",
+			Handler:    _JungleTV_CancelScheduledRain_Handler,
+		},
 		{
 			MethodName: "TriggerAnnouncementsNotification",
 			Handler:    _JungleTV_TriggerAnnouncementsNotification_Handler,
@@ -4531,6 +6257,22 @@ var JungleTV_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetQueueEntryReorderingAllowed",
 			Handler:    _JungleTV_SetQueueEntryReorderingAllowed_Handler,
 		},
+		{
+			MethodName: "SetQueueOrderingPolicy",
+			Handler:    _JungleTV_SetQueueOrderingPolicy_Handler,
+		},
+		{
+			MethodName: "SetRewardDistributionStrategy",
+			Handler:    _JungleTV_SetRewardDistributionStrategy_Handler,
+		},
+		{
+			MethodName: "PauseBroadcast",
+			Handler:    _JungleTV_PauseBroadcast_Handler,
+		},
+		{
+			MethodName: "ResumeBroadcast",
+			Handler:    _JungleTV_ResumeBroadcast_Handler,
+		},
 		{
 			MethodName: "SetNewQueueEntriesAlwaysUnskippable",
 			Handler:    _JungleTV_SetNewQueueEntriesAlwaysUnskippable_Handler,
@@ -4563,6 +6305,18 @@ var JungleTV_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdjustPointsBalance",
 			Handler:    _JungleTV_AdjustPointsBalance_Handler,
 		},
+		{
+			MethodName: "SetPointsReward",
+			Handler:    _JungleTV_SetPointsReward_Handler,
+		},
+		{
+			MethodName: "AllPointsRewardRedemptions",
+			Handler:    _JungleTV_AllPointsRewardRedemptions_Handler,
+		},
+		{
+			MethodName: "UpdatePointsRewardRedemption",
+			Handler:    _JungleTV_UpdatePointsRewardRedemption_Handler,
+		},
 		{
 			MethodName: "AddVipUser",
 			Handler:    _JungleTV_AddVipUser_Handler,
@@ -4591,6 +6345,110 @@ var JungleTV_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Spectators",
 			Handler:    _JungleTV_Spectators_Handler,
 		},
+		{
+			MethodName: "TimetableSlots",
+			Handler:    _JungleTV_TimetableSlots_Handler,
+		},
+		{
+			MethodName: "SetTimetableSlot",
+			Handler:    _JungleTV_SetTimetableSlot_Handler,
+		},
+		{
+			MethodName: "RemoveTimetableSlot",
+			Handler:    _JungleTV_RemoveTimetableSlot_Handler,
+		},
+		{
+			MethodName: "CreateBroadcastChannel",
+			Handler:    _JungleTV_CreateBroadcastChannel_Handler,
+		},
+		{
+			MethodName: "AutoplayPools",
+			Handler:    _JungleTV_AutoplayPools_Handler,
+		},
+		{
+			MethodName: "SetAutoplayPool",
+			Handler:    _JungleTV_SetAutoplayPool_Handler,
+		},
+		{
+			MethodName: "RemoveAutoplayPool",
+			Handler:    _JungleTV_RemoveAutoplayPool_Handler,
+		},
+		{
+			MethodName: "AutoplayPoolEntries",
+			Handler:    _JungleTV_AutoplayPoolEntries_Handler,
+		},
+		{
+			MethodName: "AddAutoplayPoolEntry",
+			Handler:    _JungleTV_AddAutoplayPoolEntry_Handler,
+		},
+		{
+			MethodName: "RemoveAutoplayPoolEntry",
+			Handler:    _JungleTV_RemoveAutoplayPoolEntry_Handler,
+		},
+		{
+			MethodName: "QueueSnapshots",
+			Handler:    _JungleTV_QueueSnapshots_Handler,
+		},
+		{
+			MethodName: "QueueSnapshot",
+			Handler:    _JungleTV_QueueSnapshot_Handler,
+		},
+		{
+			MethodName: "RestoreQueueSnapshot",
+			Handler:    _JungleTV_RestoreQueueSnapshot_Handler,
+		},
+		{
+			MethodName: "MediaReplayRules",
+			Handler:    _JungleTV_MediaReplayRules_Handler,
+		},
+		{
+			MethodName: "SetMediaReplayRule",
+			Handler:    _JungleTV_SetMediaReplayRule_Handler,
+		},
+		{
+			MethodName: "RemoveMediaReplayRule",
+			Handler:    _JungleTV_RemoveMediaReplayRule_Handler,
+		},
+		{
+			MethodName: "LibraryMedia",
+			Handler:    _JungleTV_LibraryMedia_Handler,
+		},
+		{
+			MethodName: "UploadLibraryMedia",
+			Handler:    _JungleTV_UploadLibraryMedia_Handler,
+		},
+		{
+			MethodName: "RemoveLibraryMedia",
+			Handler:    _JungleTV_RemoveLibraryMedia_Handler,
+		},
+		{
+			MethodName: "ExportQueue",
+			Handler:    _JungleTV_ExportQueue_Handler,
+		},
+		{
+			MethodName: "ExportPlayedMedia",
+			Handler:    _JungleTV_ExportPlayedMedia_Handler,
+		},
+		{
+			MethodName: "ImportQueue",
+			Handler:    _JungleTV_ImportQueue_Handler,
+		},
+		{
+			MethodName: "PricingRules",
+			Handler:    _JungleTV_PricingRules_Handler,
+		},
+		{
+			MethodName: "SetPricingRule",
+			Handler:    _JungleTV_SetPricingRule_Handler,
+		},
+		{
+			MethodName: "RemovePricingRule",
+			Handler:    _JungleTV_RemovePricingRule_Handler,
+		},
+		{
+			MethodName: "SimulatePricing",
+			Handler:    _JungleTV_SimulatePricing_Handler,
+		},
 		{
 			MethodName: "Applications",
 			Handler:    _JungleTV_Applications_Handler,
@@ -4708,6 +6566,11 @@ var JungleTV_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _JungleTV_ConvertBananoToPoints_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "TipWithBanano",
+			Handler:       _JungleTV_TipWithBanano_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "MonitorMediaEnqueuingPermission",
 			Handler:       _JungleTV_MonitorMediaEnqueuingPermission_Handler,
@@ -1,7 +1,26 @@
+DROP TABLE IF EXISTS "points_reward_redemption";
+DROP TABLE IF EXISTS "points_reward_redemption_status";
+DROP TABLE IF EXISTS "points_reward";
+DROP TABLE IF EXISTS "pricing_rule";
+DROP TABLE IF EXISTS "tip";
+DROP TABLE IF EXISTS "tip_currency";
+DROP TABLE IF EXISTS "library_media";
+DROP TABLE IF EXISTS "media_replay_rule";
+DROP TABLE IF EXISTS "autoplay_pool_entry";
+DROP TABLE IF EXISTS "autoplay_pool";
+DROP TABLE IF EXISTS "autoplay_pool_source";
+DROP TABLE IF EXISTS "media_queue_timetable_slot";
+DROP TABLE IF EXISTS "media_queue_scheduled_entry";
+DROP TABLE IF EXISTS "media_queue_state";
+DROP TABLE IF EXISTS "media_queue_entry";
+DROP TABLE IF EXISTS "media_queue_scheduling_mode";
+DROP TABLE IF EXISTS "media_queue_ordering_policy";
 DROP TABLE IF EXISTS "auth_event";
 DROP TABLE IF EXISTS "auth_event_method";
 DROP TABLE IF EXISTS "auth_event_reason";
 DROP TABLE IF EXISTS "user_jwt_claim_season";
+DROP TABLE IF EXISTS "payment_intent";
+DROP TABLE IF EXISTS "payment_intent_status";
 DROP TABLE IF EXISTS "application_value";
 DROP TABLE IF EXISTS "application_file";
 DROP TABLE IF EXISTS "application";
@@ -22,12 +41,24 @@ DROP TABLE IF EXISTS "counter";
 DROP TABLE IF EXISTS "banned_user";
 DROP TABLE IF EXISTS "raffle_drawing";
 DROP TABLE IF EXISTS "raffle_drawing_status";
+DROP TABLE IF EXISTS "scheduled_rain";
+DROP TABLE IF EXISTS "scheduled_rain_audience";
+DROP TABLE IF EXISTS "scheduled_rain_status";
 DROP TABLE IF EXISTS "crowdfunded_transaction";
 DROP TABLE IF EXISTS "crowdfunded_transaction_type";
+DROP TABLE IF EXISTS "journal_line";
+DROP TABLE IF EXISTS "journal_entry";
+DROP TABLE IF EXISTS "refund";
+DROP TABLE IF EXISTS "refund_status";
+DROP TABLE IF EXISTS "refund_reason";
+DROP TABLE IF EXISTS "withdrawal_preference";
+DROP TABLE IF EXISTS "withdrawal_cadence";
 DROP TABLE IF EXISTS "withdrawal";
 DROP TABLE IF EXISTS "pending_withdrawal";
 DROP TABLE IF EXISTS "reward_balance";
 DROP TABLE IF EXISTS "received_reward";
+DROP TABLE IF EXISTS "reward_distribution_settings";
+DROP TABLE IF EXISTS "reward_distribution_strategy";
 DROP TABLE IF EXISTS "chat_message";
 DROP TABLE IF EXISTS "chat_user";
 DROP TABLE IF EXISTS "document";
@@ -35,12 +66,27 @@ DROP TABLE IF EXISTS "disallowed_media_collections";
 DROP TABLE IF EXISTS "media_collection_type";
 DROP TABLE IF EXISTS "disallowed_media";
 DROP TABLE IF EXISTS "played_media";
+DROP TABLE IF EXISTS "broadcast_channel";
 DROP TABLE IF EXISTS "media_type";
+DROP TABLE IF EXISTS "currency";
 
 CREATE TABLE IF NOT EXISTS "media_type" (
     media_type VARCHAR(10) PRIMARY KEY
 );
-INSERT INTO "media_type" VALUES ('yt_video'), ('sc_track'), ('document'), ('app_page');
+INSERT INTO "media_type" VALUES ('yt_video'), ('sc_track'), ('document'), ('app_page'), ('direct_url'), ('lib_media');
+
+CREATE TABLE IF NOT EXISTS "broadcast_channel" (
+    id VARCHAR(36) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    skip_account_index BIGINT NOT NULL UNIQUE,
+    rain_account_index BIGINT NOT NULL UNIQUE
+);
+INSERT INTO "broadcast_channel" VALUES ('main', 'JungleTV', 11575, 397007);
+
+CREATE TABLE IF NOT EXISTS "currency" (
+    ticker VARCHAR(5) PRIMARY KEY
+);
+INSERT INTO "currency" VALUES ('BAN'), ('XNO');
 
 CREATE TABLE IF NOT EXISTS "played_media" (
     id VARCHAR(36) PRIMARY KEY,
@@ -50,14 +96,18 @@ CREATE TABLE IF NOT EXISTS "played_media" (
     media_offset INTERVAL NOT NULL,
     media_length INTERVAL NOT NULL,
     requested_by VARCHAR(65) NOT NULL, -- must support XNO addresses
-    request_cost NUMERIC(39, 0) NOT NULL,
+    request_cost NUMERIC(39, 0) NOT NULL, -- in BAN raw, converted at the time of payment if paid in another currency
+    request_cost_currency VARCHAR(5) NOT NULL DEFAULT 'BAN' REFERENCES currency (ticker),
+    request_cost_in_currency NUMERIC(39, 0) NOT NULL DEFAULT 0, -- in raw units of request_cost_currency
     unskippable BOOLEAN NOT NULL,
     media_type VARCHAR(10) NOT NULL REFERENCES media_type (media_type),
     media_id VARCHAR(36) NOT NULL,
-    media_info JSONB NOT NULL
+    media_info JSONB NOT NULL,
+    channel_id VARCHAR(36) NOT NULL DEFAULT 'main' REFERENCES broadcast_channel (id)
 );
 CREATE INDEX index_requested_by_on_played_media ON played_media USING BTREE (requested_by);
 CREATE INDEX index_started_at_on_played_media ON played_media USING BTREE (started_at);
+CREATE INDEX index_channel_id_and_started_at_on_played_media ON played_media USING BTREE (channel_id, started_at);
 
 CREATE TABLE IF NOT EXISTS "disallowed_media" (
     id VARCHAR(36) PRIMARY KEY,
@@ -71,7 +121,7 @@ CREATE TABLE IF NOT EXISTS "disallowed_media" (
 CREATE TABLE IF NOT EXISTS "media_collection_type" (
     collection_type VARCHAR(10) PRIMARY KEY
 );
-INSERT INTO "media_collection_type" VALUES ('yt_channel'), ('sc_user');
+INSERT INTO "media_collection_type" VALUES ('yt_channel'), ('sc_user'), ('url_host');
 
 CREATE TABLE IF NOT EXISTS "disallowed_media_collection" (
     id VARCHAR(36) PRIMARY KEY,
@@ -111,12 +161,24 @@ CREATE TABLE IF NOT EXISTS "chat_message" (
 CREATE INDEX index_created_at_on_chat_message ON chat_message USING BTREE (created_at);
 CREATE INDEX index_reference_on_chat_message ON chat_message USING HASH (reference);
 
+CREATE TABLE IF NOT EXISTS "reward_distribution_strategy" (
+    distribution_strategy VARCHAR(20) PRIMARY KEY
+);
+INSERT INTO "reward_distribution_strategy" VALUES ('equal'), ('watch_time'), ('activity_tier'), ('requester_rain'), ('scheduled_rain');
+
+CREATE TABLE IF NOT EXISTS "reward_distribution_settings" (
+    channel_id VARCHAR(36) PRIMARY KEY REFERENCES broadcast_channel (id),
+    distribution_strategy VARCHAR(20) NOT NULL DEFAULT 'equal' REFERENCES reward_distribution_strategy (distribution_strategy),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
 CREATE TABLE IF NOT EXISTS "received_reward" (
     id VARCHAR(36) PRIMARY KEY,
     rewards_address VARCHAR(64) NOT NULL,
     received_at TIMESTAMP WITH TIME ZONE NOT NULL,
     amount NUMERIC(39, 0) NOT NULL,
-    media VARCHAR(36) NOT NULL REFERENCES played_media (id)
+    media VARCHAR(36) NOT NULL REFERENCES played_media (id),
+    distribution_strategy VARCHAR(20) NOT NULL DEFAULT 'equal' REFERENCES reward_distribution_strategy (distribution_strategy)
 );
 CREATE INDEX index_rewards_address_on_received_reward ON received_reward USING HASH (rewards_address);
 CREATE INDEX index_received_at_on_received_reward ON received_reward USING BTREE (received_at);
@@ -178,19 +240,122 @@ CREATE INDEX index_rewards_address_on_withdrawal ON withdrawal USING HASH (rewar
 CREATE INDEX index_started_at_on_withdrawal ON withdrawal USING BTREE (started_at);
 CREATE INDEX index_rewards_address_and_started_at_on_withdrawal ON withdrawal USING BTREE (rewards_address, started_at);
 
+CREATE TABLE IF NOT EXISTS "withdrawal_cadence" (
+    cadence VARCHAR(10) PRIMARY KEY
+);
+INSERT INTO "withdrawal_cadence" VALUES ('daily'), ('weekly'), ('monthly');
+
+-- addresses with a row in this table are not subject to the default automatic withdrawal rules
+CREATE TABLE IF NOT EXISTS "withdrawal_preference" (
+    rewards_address VARCHAR(64) PRIMARY KEY,
+    threshold NUMERIC(39, 0), -- nullable
+    cadence VARCHAR(10) REFERENCES withdrawal_cadence (cadence), -- nullable
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CHECK (threshold IS NOT NULL OR cadence IS NOT NULL)
+);
+
+CREATE TABLE IF NOT EXISTS "refund_reason" (
+    reason VARCHAR(20) PRIMARY KEY
+);
+INSERT INTO "refund_reason" VALUES ('removed_entry'), ('no_spectators'), ('failed_ticket'), ('late_payment'), ('goodwill'), ('cancelled_rain');
+
+CREATE TABLE IF NOT EXISTS "refund_status" (
+    refund_status VARCHAR(10) PRIMARY KEY
+);
+INSERT INTO "refund_status" VALUES ('proposed'), ('approved'), ('sent'), ('failed');
+
+-- (refund needing review created) -> proposed
+--   (moderator approves) -> approved
+-- (refund not needing review created) -> approved
+--   (refund sent) -> sent
+--   (sending fails) -> failed
+--     (moderator retries) -> approved
+
+CREATE TABLE IF NOT EXISTS "refund" (
+    id VARCHAR(36) PRIMARY KEY,
+    address VARCHAR(64) NOT NULL,
+    amount NUMERIC(39, 0) NOT NULL, -- in BAN raw, converted at the time of receipt if received in another currency
+    reason VARCHAR(20) NOT NULL REFERENCES refund_reason (reason),
+    status VARCHAR(10) NOT NULL REFERENCES refund_status (refund_status),
+    comment TEXT NOT NULL,
+    ticket_id VARCHAR(36), -- nullable
+    queue_entry_id VARCHAR(36), -- nullable. not a reference to played_media as entries may be removed before playing
+    payment_block_hash VARCHAR(64) UNIQUE, -- nullable. the payment being refunded, when it is a single known block
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reviewed_by VARCHAR(64), -- nullable
+    tx_hash VARCHAR(64), -- nullable
+    failure_reason TEXT, -- nullable
+    send_attempt_frontier VARCHAR(64) -- nullable. frontier of the sending account before the latest send attempt
+);
+CREATE INDEX index_status_on_refund ON refund USING HASH (status);
+CREATE INDEX index_created_at_on_refund ON refund USING BTREE (created_at);
+
+CREATE TABLE IF NOT EXISTS "journal_entry" (
+    id VARCHAR(36) PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    description TEXT NOT NULL,
+    reference VARCHAR(64) -- nullable. block hash, ticket ID, etc.
+);
+CREATE INDEX index_created_at_on_journal_entry ON journal_entry USING BTREE (created_at);
+
+-- debits are positive and credits are negative. the lines of each entry sum to zero
+CREATE TABLE IF NOT EXISTS "journal_line" (
+    entry_id VARCHAR(36) NOT NULL REFERENCES journal_entry (id),
+    account VARCHAR(100) NOT NULL, -- e.g. chain:ban_..., reward_balance:ban_..., pending_withdrawal:ban_..., equity
+    amount NUMERIC(40, 0) NOT NULL, -- in BAN raw
+    PRIMARY KEY (entry_id, account)
+);
+CREATE INDEX index_account_on_journal_line ON journal_line USING HASH (account);
+
 CREATE TABLE IF NOT EXISTS "crowdfunded_transaction_type" (
     transaction_type VARCHAR(10) PRIMARY KEY
 );
-INSERT INTO "crowdfunded_transaction_type" VALUES ('skip'), ('rain');
+INSERT INTO "crowdfunded_transaction_type" VALUES ('skip'), ('rain'), ('sched_rain');
 
 CREATE TABLE IF NOT EXISTS "crowdfunded_transaction" (
     tx_hash VARCHAR(64) PRIMARY KEY,
-    from_address VARCHAR(64) NOT NULL,
-    amount NUMERIC(39, 0) NOT NULL,
+    from_address VARCHAR(65) NOT NULL, -- must support XNO addresses
+    amount NUMERIC(39, 0) NOT NULL, -- in BAN raw, converted at the time of receipt if received in another currency
+    currency VARCHAR(5) NOT NULL DEFAULT 'BAN' REFERENCES currency (ticker),
+    amount_in_currency NUMERIC(39, 0) NOT NULL DEFAULT 0, -- in raw units of currency
     received_at TIMESTAMP WITH TIME ZONE NOT NULL,
     transaction_type VARCHAR(10) NOT NULL REFERENCES crowdfunded_transaction_type (transaction_type),
-    for_media VARCHAR(36) REFERENCES played_media (id) -- nullable
+    for_media VARCHAR(36) REFERENCES played_media (id), -- nullable
+    channel_id VARCHAR(36) NOT NULL DEFAULT 'main' REFERENCES broadcast_channel (id)
+);
+
+CREATE TABLE IF NOT EXISTS "scheduled_rain_status" (
+    rain_status VARCHAR(10) PRIMARY KEY
+);
+INSERT INTO "scheduled_rain_status" VALUES ('scheduled'), ('complete'), ('cancelled');
+
+CREATE TABLE IF NOT EXISTS "scheduled_rain_audience" (
+    audience VARCHAR(10) PRIMARY KEY
+);
+INSERT INTO "scheduled_rain_audience" VALUES ('spectators'), ('chatters');
+
+-- (rain scheduled by admin or application) -> scheduled
+--   (whole amount distributed) -> complete
+--   (admin or application cancels) -> cancelled
+
+CREATE TABLE IF NOT EXISTS "scheduled_rain" (
+    id VARCHAR(36) PRIMARY KEY,
+    channel_id VARCHAR(36) NOT NULL REFERENCES broadcast_channel (id),
+    amount NUMERIC(39, 0) NOT NULL,
+    distributed_amount NUMERIC(39, 0) NOT NULL,
+    audience VARCHAR(10) NOT NULL REFERENCES scheduled_rain_audience (audience),
+    chat_window INTERVAL NOT NULL, -- only relevant for the chatters audience
+    media_count INTEGER NOT NULL CHECK (media_count >= 0), -- 0 when the rain is distributed all at once
+    media_distributed INTEGER NOT NULL,
+    scheduled_for TIMESTAMP WITH TIME ZONE NOT NULL,
+    status VARCHAR(10) NOT NULL REFERENCES scheduled_rain_status (rain_status),
+    created_by VARCHAR(64) NOT NULL,
+    application_id VARCHAR(36), -- nullable. when set, the rain was funded by the wallet of this application
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
 );
+CREATE INDEX index_status_and_scheduled_for_on_scheduled_rain ON scheduled_rain USING BTREE (status, scheduled_for);
 
 CREATE TABLE IF NOT EXISTS "raffle_drawing_status" (
     drawing_status VARCHAR(10) PRIMARY KEY
@@ -266,12 +431,16 @@ CREATE TABLE IF NOT EXISTS "user_profile" (
 CREATE TABLE IF NOT EXISTS "media_queue_event_type" (
     event_type VARCHAR(20) PRIMARY KEY
 );
-INSERT INTO "media_queue_event_type" VALUES ('filled'), ('emptied');
+INSERT INTO "media_queue_event_type" VALUES ('filled'), ('emptied'), ('snapshot');
 
 CREATE TABLE IF NOT EXISTS "media_queue_event" (
-    created_at TIMESTAMP WITH TIME ZONE NOT NULL PRIMARY KEY,
-    event_type VARCHAR(10) NOT NULL REFERENCES media_queue_event_type (event_type)
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    event_type VARCHAR(10) NOT NULL REFERENCES media_queue_event_type (event_type),
+    channel_id VARCHAR(36) NOT NULL DEFAULT 'main' REFERENCES broadcast_channel (id),
+    snapshot JSONB, -- only present for snapshot events
+    PRIMARY KEY (created_at, event_type, channel_id)
 );
+CREATE INDEX index_channel_id_and_event_type_and_created_at_on_media_queue_event ON media_queue_event USING BTREE (channel_id, event_type, created_at);
 
 CREATE TABLE IF NOT EXISTS "blocked_user" (
     id VARCHAR(36) PRIMARY KEY,
@@ -320,7 +489,12 @@ INSERT INTO "points_tx_type" VALUES
     (10, 'skip_threshold_reduction'),
     (11, 'skip_threshold_increase'),
     (12, 'concealed_entry_enqueuing'),
-    (13, 'application_defined');
+    (13, 'application_defined'),
+    (14, 'skip_vote'),
+    (15, 'tip_sent'),
+    (16, 'tip_received'),
+    (17, 'reward_redemption'),
+    (18, 'reward_redemption_refund');
 
 CREATE TABLE IF NOT EXISTS "points_tx" (
     id BIGINT PRIMARY KEY,
@@ -386,6 +560,34 @@ CREATE TABLE IF NOT EXISTS "application_value" (
     PRIMARY KEY (application_id, "key")
 );
 
+CREATE TABLE IF NOT EXISTS "payment_intent_status" (
+    payment_intent_status VARCHAR(10) PRIMARY KEY
+);
+INSERT INTO "payment_intent_status" VALUES ('pending'), ('concluding'), ('paid'), ('expired'), ('cancelled');
+
+-- (intent created by application) -> pending
+--   (received amount reaches expected amount, expiry reached or application cancels) -> concluding
+--     (payment account emptied into the collector account) -> paid | expired | cancelled
+
+CREATE TABLE IF NOT EXISTS "payment_intent" (
+    id VARCHAR(36) PRIMARY KEY,
+    application_id VARCHAR(36) NOT NULL,
+    payment_address VARCHAR(64) NOT NULL,
+    payment_account_index BIGINT NOT NULL, -- index of the payment account in the wallet of the payment account pool
+    collector_address VARCHAR(64) NOT NULL, -- the application wallet, where the payment is sent once the intent concludes
+    expected_amount NUMERIC(39, 0) NOT NULL, -- in BAN raw
+    received_amount NUMERIC(39, 0) NOT NULL, -- in BAN raw
+    metadata JSONB NOT NULL,
+    status VARCHAR(10) NOT NULL REFERENCES payment_intent_status (payment_intent_status),
+    concluded_status VARCHAR(10) REFERENCES payment_intent_status (payment_intent_status), -- nullable. the status the intent will have once it is no longer concluding
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    delivered_at TIMESTAMP WITH TIME ZONE -- nullable. when the conclusion of the intent was delivered to the application
+);
+CREATE INDEX index_status_on_payment_intent ON payment_intent USING HASH (status);
+CREATE INDEX index_application_id_on_payment_intent ON payment_intent USING HASH (application_id);
+
 CREATE TABLE IF NOT EXISTS "user_jwt_claim_season" (
     "address" VARCHAR(64) PRIMARY KEY,
     season INTEGER NOT NULL,
@@ -410,4 +612,198 @@ CREATE TABLE IF NOT EXISTS "auth_event" (
     method VARCHAR(36) NOT NULL REFERENCES auth_event_method (auth_event_method),
     method_info JSONB NOT NULL,
     PRIMARY KEY ("address", authenticated_at)
-);
\ No newline at end of file
+);
+
+CREATE TABLE IF NOT EXISTS "media_queue_scheduling_mode" (
+    scheduling_mode VARCHAR(10) PRIMARY KEY
+);
+INSERT INTO "media_queue_scheduling_mode" VALUES ('not_before'), ('exactly_at');
+
+CREATE TABLE IF NOT EXISTS "media_queue_entry" (
+    id VARCHAR(36) PRIMARY KEY,
+    channel_id VARCHAR(36) NOT NULL REFERENCES broadcast_channel (id),
+    position INTEGER NOT NULL,
+    media_type VARCHAR(10) NOT NULL REFERENCES media_type (media_type),
+    entry_data JSONB NOT NULL,
+    planned_start TIMESTAMP WITH TIME ZONE, -- nullable
+    scheduling_mode VARCHAR(10) REFERENCES media_queue_scheduling_mode (scheduling_mode) -- nullable
+);
+CREATE INDEX index_channel_id_and_position_on_media_queue_entry ON media_queue_entry USING BTREE (channel_id, position);
+
+CREATE TABLE IF NOT EXISTS "media_queue_ordering_policy" (
+    ordering_policy VARCHAR(20) PRIMARY KEY
+);
+INSERT INTO "media_queue_ordering_policy" VALUES ('fifo'), ('round_robin'), ('max_consecutive');
+
+CREATE TABLE IF NOT EXISTS "media_queue_state" (
+    id VARCHAR(36) PRIMARY KEY REFERENCES broadcast_channel (id),
+    insert_cursor VARCHAR(36),
+    playing_since TIMESTAMP WITH TIME ZONE,
+    paused_at TIMESTAMP WITH TIME ZONE, -- null when the broadcast is not paused
+    pause_reason VARCHAR(512) NOT NULL DEFAULT '',
+    paused_entry_id VARCHAR(36), -- entry that was playing when the broadcast was paused, if any
+    paused_entry_played_for INTERVAL NOT NULL DEFAULT '0',
+    ordering_policy VARCHAR(20) NOT NULL DEFAULT 'fifo' REFERENCES media_queue_ordering_policy (ordering_policy),
+    ordering_policy_max_consecutive INTEGER NOT NULL DEFAULT 0, -- only used by the max_consecutive policy
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS "media_queue_scheduled_entry" (
+    id VARCHAR(36) PRIMARY KEY,
+    channel_id VARCHAR(36) NOT NULL REFERENCES broadcast_channel (id),
+    media_type VARCHAR(10) NOT NULL REFERENCES media_type (media_type),
+    entry_data JSONB NOT NULL,
+    planned_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    scheduling_mode VARCHAR(10) NOT NULL REFERENCES media_queue_scheduling_mode (scheduling_mode)
+);
+CREATE INDEX index_channel_id_and_planned_start_on_media_queue_scheduled_entry ON media_queue_scheduled_entry USING BTREE (channel_id, planned_start);
+
+CREATE TABLE IF NOT EXISTS "media_queue_timetable_slot" (
+    id VARCHAR(36) PRIMARY KEY,
+    channel_id VARCHAR(36) NOT NULL REFERENCES broadcast_channel (id),
+    label VARCHAR(100) NOT NULL,
+    weekday INTEGER NOT NULL CHECK (weekday >= 0 AND weekday <= 6),
+    start_time_of_day INTERVAL NOT NULL,
+    duration INTERVAL NOT NULL,
+    updated_by VARCHAR(64) NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS "autoplay_pool_source" (
+    source VARCHAR(10) PRIMARY KEY
+);
+INSERT INTO "autoplay_pool_source" VALUES ('list'), ('history');
+
+CREATE TABLE IF NOT EXISTS "autoplay_pool" (
+    id VARCHAR(36) PRIMARY KEY,
+    channel_id VARCHAR(36) NOT NULL DEFAULT 'main' REFERENCES broadcast_channel (id),
+    name VARCHAR(100) NOT NULL,
+    source VARCHAR(10) NOT NULL REFERENCES autoplay_pool_source (source),
+    enabled BOOLEAN NOT NULL,
+    weight INTEGER NOT NULL CHECK (weight >= 0),
+    cooldown INTERVAL NOT NULL,
+    active_from INTERVAL NOT NULL, -- since midnight UTC
+    active_until INTERVAL NOT NULL, -- since midnight UTC. when equal to active_from, the pool is active all day
+    history_lookback INTERVAL NOT NULL,
+    history_min_plays INTEGER NOT NULL,
+    updated_by VARCHAR(64) NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS "autoplay_pool_entry" (
+    id VARCHAR(36) PRIMARY KEY,
+    pool_id VARCHAR(36) NOT NULL REFERENCES autoplay_pool (id) ON DELETE CASCADE,
+    media_type VARCHAR(10) NOT NULL REFERENCES media_type (media_type),
+    media_reference VARCHAR(200) NOT NULL, -- YouTube video ID or SoundCloud track permalink
+    weight INTEGER NOT NULL CHECK (weight >= 0)
+);
+CREATE INDEX index_pool_id_on_autoplay_pool_entry ON autoplay_pool_entry USING BTREE (pool_id);
+CREATE TABLE IF NOT EXISTS "media_replay_rule" (
+    id VARCHAR(36) PRIMARY KEY,
+    media_type VARCHAR(10) NOT NULL REFERENCES media_type (media_type),
+    collection_type VARCHAR(10) REFERENCES media_collection_type (collection_type), -- NULL when not specific to a collection
+    collection_id VARCHAR(36),
+    requester VARCHAR(64), -- NULL when not specific to a requester
+    cooldown INTERVAL NOT NULL,
+    overlap_tolerance INTERVAL NOT NULL,
+    max_broadcast_play_time INTERVAL NOT NULL,
+    updated_by VARCHAR(64) NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CHECK ((collection_type IS NULL) = (collection_id IS NULL))
+);
+CREATE INDEX index_media_type_on_media_replay_rule ON media_replay_rule USING BTREE (media_type);
+
+CREATE TABLE IF NOT EXISTS "library_media" (
+    id VARCHAR(36) PRIMARY KEY,
+    title VARCHAR(100) NOT NULL,
+    "format" VARCHAR(10) NOT NULL,
+    duration INTERVAL NOT NULL,
+    file_size BIGINT NOT NULL,
+    public BOOLEAN NOT NULL,
+    uploaded_by VARCHAR(64) NOT NULL,
+    uploaded_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE INDEX index_uploaded_at_on_library_media ON library_media USING BTREE (uploaded_at);
+
+CREATE TABLE IF NOT EXISTS "tip_currency" (
+    currency VARCHAR(10) PRIMARY KEY
+);
+INSERT INTO "tip_currency" VALUES ('points'), ('banano');
+
+CREATE TABLE IF NOT EXISTS "tip" (
+    id VARCHAR(36) PRIMARY KEY,
+    from_address VARCHAR(64) NOT NULL,
+    to_address VARCHAR(64) NOT NULL,
+    currency VARCHAR(10) NOT NULL REFERENCES tip_currency (currency),
+    amount NUMERIC(39, 0) NOT NULL, -- in points, or in BAN raw (converted at the time of receipt if received in another currency)
+    queue_entry_id VARCHAR(36), -- nullable. set when the requester of a queue entry was tipped for it
+    announced BOOLEAN NOT NULL,
+    tx_hash VARCHAR(64) UNIQUE, -- nullable. set for banano tips
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CHECK (amount > 0)
+);
+CREATE INDEX index_from_address_on_tip ON tip USING HASH (from_address);
+CREATE INDEX index_to_address_on_tip ON tip USING HASH (to_address);
+CREATE INDEX index_created_at_on_tip ON tip USING BTREE (created_at);
+
+-- all the rules that match the pricing conditions apply, their multipliers being compounded
+CREATE TABLE IF NOT EXISTS "pricing_rule" (
+    id VARCHAR(36) PRIMARY KEY,
+    channel_id VARCHAR(36) NOT NULL REFERENCES broadcast_channel (id),
+    label VARCHAR(100) NOT NULL,
+    applies_to_enqueue BOOLEAN NOT NULL,
+    applies_to_crowdfunded_skip BOOLEAN NOT NULL,
+    applies_to_queue_entry_movement BOOLEAN NOT NULL,
+    multiplier INTEGER NOT NULL CHECK (multiplier >= 10 AND multiplier <= 1000), -- percentage applied to the price
+    weekdays INTEGER NOT NULL CHECK (weekdays >= 0 AND weekdays <= 127), -- bitmask where bit 0 is Sunday, in UTC. 0 means every day
+    start_time_of_day INTERVAL, -- nullable. since midnight UTC
+    end_time_of_day INTERVAL, -- nullable. since midnight UTC, before start_time_of_day when the period crosses midnight
+    min_queue_length INTEGER, -- nullable
+    max_queue_length INTEGER, -- nullable
+    min_spectators INTEGER, -- nullable
+    max_spectators INTEGER, -- nullable
+    active_from TIMESTAMP WITH TIME ZONE, -- nullable. used for temporary promotions
+    active_until TIMESTAMP WITH TIME ZONE, -- nullable. used for temporary promotions
+    updated_by VARCHAR(64) NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CHECK (applies_to_enqueue OR applies_to_crowdfunded_skip OR applies_to_queue_entry_movement),
+    CHECK ((start_time_of_day IS NULL) = (end_time_of_day IS NULL))
+);
+CREATE INDEX index_channel_id_on_pricing_rule ON pricing_rule USING HASH (channel_id);
+
+CREATE TABLE IF NOT EXISTS "points_reward" (
+    id VARCHAR(36) PRIMARY KEY,
+    title VARCHAR(100) NOT NULL,
+    description VARCHAR(1000) NOT NULL,
+    cost INTEGER NOT NULL,
+    stock INTEGER, -- nullable. remaining units, unlimited when null
+    per_user_limit INTEGER, -- nullable. counts redemptions that were not rejected, unlimited when null
+    requires_input BOOLEAN NOT NULL, -- whether users must provide some text when redeeming, e.g. the message to highlight
+    enabled BOOLEAN NOT NULL,
+    updated_by VARCHAR(64) NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CHECK (cost > 0),
+    CHECK (stock >= 0),
+    CHECK (per_user_limit > 0)
+);
+
+CREATE TABLE IF NOT EXISTS "points_reward_redemption_status" (
+    status VARCHAR(10) PRIMARY KEY
+);
+INSERT INTO "points_reward_redemption_status" VALUES ('pending'), ('fulfilled'), ('rejected');
+
+CREATE TABLE IF NOT EXISTS "points_reward_redemption" (
+    id VARCHAR(36) PRIMARY KEY,
+    reward_id VARCHAR(36) NOT NULL REFERENCES points_reward (id),
+    rewards_address VARCHAR(64) NOT NULL,
+    cost INTEGER NOT NULL, -- cost of the reward at the time of redemption
+    input VARCHAR(500), -- nullable
+    status VARCHAR(10) NOT NULL REFERENCES points_reward_redemption_status (status),
+    staff_note VARCHAR(500), -- nullable
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_by VARCHAR(64) -- nullable. staff member or application that last changed the status
+);
+CREATE INDEX index_reward_id_rewards_address_on_points_reward_redemption ON points_reward_redemption USING BTREE (reward_id, rewards_address);
+CREATE INDEX index_rewards_address_on_points_reward_redemption ON points_reward_redemption USING HASH (rewards_address);
+CREATE INDEX index_status_created_at_on_points_reward_redemption ON points_reward_redemption USING BTREE (status, created_at);
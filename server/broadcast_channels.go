@@ -0,0 +1,332 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"regexp"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/components/autoplay"
+	"github.com/tnyim/jungletv/server/components/enqueuemanager"
+	"github.com/tnyim/jungletv/server/components/mediaqueue"
+	"github.com/tnyim/jungletv/server/components/node"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/server/components/rewards"
+	"github.com/tnyim/jungletv/server/components/skipmanager"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/alexcesaro/statsd.v2"
+)
+
+// broadcastChannel groups the components that each independent broadcast channel has its own instance of
+type broadcastChannel struct {
+	id             string
+	name           string
+	statsClient    *statsd.Client
+	mediaProviders map[types.MediaType]media.Provider
+	mediaQueue     *mediaqueue.MediaQueue
+	pricer         *pricer.Pricer
+	skipManager    *skipmanager.Manager
+	enqueueManager *enqueuemanager.Manager
+	autoplay       *autoplay.Manager
+	skipAccount    node.Account
+	rainAccount    node.Account
+}
+
+// setupBroadcastChannels creates the queue, pricer and skip manager of each broadcast channel.
+// The enqueue and autoplay managers are only created in finishBroadcastChannelsSetup, as they depend on the rewards
+// handler, which in turn depends on the components created here
+func (s *grpcServer) setupBroadcastChannels(ctxCtx context.Context, repAddress, nanoRepAddress, legacyQueueFile string,
+	newMediaProviders func() (map[types.MediaType]media.Provider, error)) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	channels, err := types.GetBroadcastChannels(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	s.channels = make(map[string]*broadcastChannel, len(channels))
+	for _, c := range channels {
+		channel := &broadcastChannel{
+			id:          c.ID,
+			name:        c.Name,
+			statsClient: s.statsClient,
+		}
+		queueFile := ""
+		if c.ID == types.MainBroadcastChannelID {
+			queueFile = legacyQueueFile
+		} else {
+			channel.statsClient = s.statsClient.Clone(statsd.Prefix("channel_" + c.ID))
+		}
+
+		channel.skipAccount, err = s.wallet.NewAccount(&c.SkipAccountIndex)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		err = channel.skipAccount.SetRep(repAddress)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+
+		channel.rainAccount, err = s.wallet.NewAccount(&c.RainAccountIndex)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		err = channel.rainAccount.SetRep(repAddress)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+
+		channel.mediaProviders, err = newMediaProviders()
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+
+		channel.mediaQueue, err = mediaqueue.New(ctxCtx, s.log, channel.statsClient, c.ID, queueFile, channel.mediaProviders)
+		if err != nil {
+			return stacktrace.Propagate(err, "error creating queue for channel %s", c.ID)
+		}
+		channel.pricer = pricer.New(s.log, channel.mediaQueue, s.statsRegistry)
+		err = channel.pricer.LoadPricingRules(ctxCtx)
+		if err != nil {
+			return stacktrace.Propagate(err, "error loading pricing rules for channel %s", c.ID)
+		}
+		channel.skipManager = skipmanager.New(s.log, s.wallet.RPC(), channel.skipAccount, channel.rainAccount,
+			s.collectorAccount.Address(), channel.mediaQueue, channel.pricer)
+		channel.skipManager.SetExchangeRateSource(s.exchangeRateSource)
+		if s.nanoWallet != nil {
+			err = s.setupNanoSkipAndRainAccounts(channel, c, nanoRepAddress)
+			if err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+		}
+
+		s.channels[c.ID] = channel
+		s.channelIDs = append(s.channelIDs, c.ID)
+	}
+
+	main, ok := s.channels[types.MainBroadcastChannelID]
+	if !ok {
+		return stacktrace.NewError("main broadcast channel missing from database")
+	}
+
+	// most of the service (moderation, applications, chat announcements...) only concerns the main channel
+	s.mediaProviders = main.mediaProviders
+	s.mediaQueue = main.mediaQueue
+	s.pricer = main.pricer
+	s.skipManager = main.skipManager
+	s.skipAccount = main.skipAccount
+	s.rainAccount = main.rainAccount
+	return nil
+}
+
+// setupNanoSkipAndRainAccounts lets the channel be skipped and tipped in XNO, using the accounts with the same indexes
+// as the Banano ones, but from the XNO wallet
+func (s *grpcServer) setupNanoSkipAndRainAccounts(channel *broadcastChannel, c *types.BroadcastChannel, nanoRepAddress string) error {
+	skipAccount, err := s.nanoWallet.NewAccount(&c.SkipAccountIndex)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	err = skipAccount.SetRep(nanoRepAddress)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	rainAccount, err := s.nanoWallet.NewAccount(&c.RainAccountIndex)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	err = rainAccount.SetRep(nanoRepAddress)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	channel.skipManager.AddNativeCurrency(payment.CurrencyNano, s.nanoWallet.RPC(), skipAccount, rainAccount,
+		s.nanoCollectorAccount.Address(), pricer.NanoDustThreshold)
+	return nil
+}
+
+func (s *grpcServer) finishBroadcastChannelsSetup(ctx context.Context) error {
+	for _, channel := range s.channels {
+		channel.pricer.SetEligibleSpectatorsEstimator(s.rewardsHandler.ChannelEligibleSpectatorsEstimator(channel.id))
+		channel.skipManager.SetEligibleSpectatorsEstimator(s.rewardsHandler.ChannelEligibleSpectatorsEstimator(channel.id))
+
+		var err error
+		channel.enqueueManager, err = enqueuemanager.New(ctx, s.log, channel.statsClient, channel.mediaQueue, channel.pricer,
+			s.paymentAccountPool, s.rewardsHandler, s.refundManager, s.pointsManager, s.moderationStore, s.modLogWebhook)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		channel.autoplay = autoplay.New(s.log, channel.id, channel.mediaProviders, channel.enqueueManager)
+	}
+	s.enqueueManager = s.channels[types.MainBroadcastChannelID].enqueueManager
+	return nil
+}
+
+func (s *grpcServer) rewardsChannels() []rewards.Channel {
+	channels := make([]rewards.Channel, 0, len(s.channels))
+	for _, channelID := range s.channelIDs {
+		channel := s.channels[channelID]
+		channels = append(channels, rewards.Channel{
+			ID:          channel.id,
+			StatsClient: channel.statsClient,
+			MediaQueue:  channel.mediaQueue,
+			SkipManager: channel.skipManager,
+		})
+	}
+	return channels
+}
+
+// broadcastChannel returns the broadcast channel with the given ID. An empty ID refers to the main channel
+func (s *grpcServer) broadcastChannel(channelID string) (*broadcastChannel, error) {
+	if channelID == "" {
+		channelID = types.MainBroadcastChannelID
+	}
+	channel, ok := s.channels[channelID]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "unknown broadcast channel")
+	}
+	return channel, nil
+}
+
+// enqueueTicket finds the enqueue ticket with the given ID among the enqueue managers of all channels
+func (s *grpcServer) enqueueTicket(ticketID string) (enqueuemanager.EnqueueTicket, *broadcastChannel) {
+	for _, channelID := range s.channelIDs {
+		channel := s.channels[channelID]
+		ticket := channel.enqueueManager.GetTicket(ticketID)
+		if ticket != nil {
+			return ticket, channel
+		}
+	}
+	return nil, nil
+}
+
+func (s *grpcServer) BroadcastChannels(ctx context.Context, r *proto.BroadcastChannelsRequest) (*proto.BroadcastChannelsResponse, error) {
+	response := &proto.BroadcastChannelsResponse{
+		Channels: make([]*proto.BroadcastChannel, len(s.channelIDs)),
+	}
+	for i, channelID := range s.channelIDs {
+		response.Channels[i] = &proto.BroadcastChannel{
+			Id:   channelID,
+			Name: s.channels[channelID].name,
+		}
+	}
+	return response, nil
+}
+
+var broadcastChannelIDRegex = regexp.MustCompile("^[a-z0-9-]{1,36}$")
+
+func (s *grpcServer) CreateBroadcastChannel(ctxCtx context.Context, r *proto.CreateBroadcastChannelRequest) (*proto.CreateBroadcastChannelResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctxCtx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	if !broadcastChannelIDRegex.MatchString(r.Id) {
+		return nil, status.Error(codes.InvalidArgument, "invalid ID")
+	}
+	if r.Name == "" || len(r.Name) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "invalid name")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	existing, err := types.GetBroadcastChannels(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	usedIndexes := make(map[uint32]struct{}, len(existing)*2)
+	for _, c := range existing {
+		if c.ID == r.Id {
+			return nil, status.Error(codes.AlreadyExists, "a broadcast channel with this ID already exists")
+		}
+		usedIndexes[c.SkipAccountIndex] = struct{}{}
+		usedIndexes[c.RainAccountIndex] = struct{}{}
+	}
+
+	// indexes in the upper half of the range are never handed out to payment accounts, which are numbered sequentially
+	newAccountIndex := func() uint32 {
+		for {
+			index := uint32(1<<31) + uint32(rand.Int31())
+			if _, used := usedIndexes[index]; !used {
+				usedIndexes[index] = struct{}{}
+				return index
+			}
+		}
+	}
+	channel := &types.BroadcastChannel{
+		ID:               r.Id,
+		Name:             r.Name,
+		SkipAccountIndex: newAccountIndex(),
+		RainAccountIndex: newAccountIndex(),
+	}
+
+	err = types.Insert(ctx, channel)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Broadcast channel %s (%s) created by %s (remote address %s)", channel.ID, channel.Name, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) created broadcast channel \"%s\" (%s). It will begin broadcasting once the server is restarted",
+				moderator.Address()[:14], moderator.ModeratorName(), channel.Name, channel.ID))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.CreateBroadcastChannelResponse{
+		Channel: &proto.BroadcastChannel{
+			Id:   channel.ID,
+			Name: channel.Name,
+		},
+	}, nil
+}
+
+// mediaQueueWithEntry returns the queue of the channel containing the queue entry with the given ID.
+// If no channel contains the entry, the queue of the main channel is returned
+func (s *grpcServer) mediaQueueWithEntry(entryID string) *mediaqueue.MediaQueue {
+	return s.channelWithEntry(entryID).mediaQueue
+}
+
+// channelWithEntry returns the channel whose queue contains the entry with the given ID, or the main channel if no
+// queue contains it
+func (s *grpcServer) channelWithEntry(entryID string) *broadcastChannel {
+	for _, channelID := range s.channelIDs {
+		channel := s.channels[channelID]
+		for _, entry := range channel.mediaQueue.Entries() {
+			if entry.PerformanceID() == entryID {
+				return channel
+			}
+		}
+		for _, scheduled := range channel.mediaQueue.ScheduledEntries() {
+			if scheduled.Entry.PerformanceID() == entryID {
+				return channel
+			}
+		}
+	}
+	return s.channels[types.MainBroadcastChannelID]
+}
@@ -35,6 +35,9 @@ func (s *grpcServer) ChatSystemMessagesWorker(ctx context.Context) error {
 	crowdfundedSkippedC, crowdfundedSkippedU := s.skipManager.CrowdfundedSkip().Subscribe(event.BufferAll)
 	defer crowdfundedSkippedU()
 
+	skipVoteSucceededC, skipVoteSucceededU := s.skipManager.SkipVoteSucceeded().Subscribe(event.BufferAll)
+	defer skipVoteSucceededU()
+
 	crowdfundedTransactionReceivedC, crowdfundedTransactionReceivedU := s.skipManager.CrowdfundedTransactionReceived().Subscribe(event.BufferAll)
 	defer crowdfundedTransactionReceivedU()
 
@@ -44,6 +47,9 @@ func (s *grpcServer) ChatSystemMessagesWorker(ctx context.Context) error {
 	announcementsUpdatedC, announcementsUpdatedU := s.announcementsUpdated.Subscribe(event.BufferAll)
 	defer announcementsUpdatedU()
 
+	tipSentC, tipSentU := s.tipManager.TipSent().Subscribe(event.BufferAll)
+	defer tipSentU()
+
 	crowdfundedNotificationLimiter, err := utils.NewRateLimiterMemoryStoreWithContext(ctx, &memorystore.Config{
 		Tokens:   3,
 		Interval: 2 * time.Minute, // this also resets whenever the media changes, see below
@@ -53,6 +59,15 @@ func (s *grpcServer) ChatSystemMessagesWorker(ctx context.Context) error {
 	}
 	sentCrowdfundedLimiterMessage := make(map[types.CrowdfundedTransactionType]bool)
 
+	// keyed by sender address, so that one user can't flood the chat with tiny tips
+	tipAnnouncementLimiter, err := utils.NewRateLimiterMemoryStoreWithContext(ctx, &memorystore.Config{
+		Tokens:   3,
+		Interval: 2 * time.Minute,
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
 	for {
 		select {
 		case v := <-mediaChangedC:
@@ -156,6 +171,10 @@ func (s *grpcServer) ChatSystemMessagesWorker(ctx context.Context) error {
 				return stacktrace.Propagate(err, "")
 			}
 		case args := <-rewardsDistributedC:
+			if args.ChannelID != types.MainBroadcastChannelID {
+				// only the main channel is announced in chat
+				continue
+			}
 			amount := args.RewardBudget
 			eligibleCount := len(args.EligibleSpectators)
 			enqueuerTip := args.RequesterReward
@@ -190,6 +209,12 @@ func (s *grpcServer) ChatSystemMessagesWorker(ctx context.Context) error {
 			if err != nil {
 				return stacktrace.Propagate(err, "")
 			}
+		case votes := <-skipVoteSucceededC:
+			_, err := s.chat.CreateSystemMessage(ctx, fmt.Sprintf(
+				"_%d spectators voted to skip the previous queue entry!_", votes))
+			if err != nil {
+				return stacktrace.Propagate(err, "")
+			}
 		case milestone := <-skipThresholdReductionMilestoneReachedC:
 			_, err := s.chat.CreateSystemMessage(ctx, fmt.Sprintf(
 				"_Community skip target reduced to **%.0f%%** of the original!_", milestone*100.0))
@@ -201,6 +226,11 @@ func (s *grpcServer) ChatSystemMessagesWorker(ctx context.Context) error {
 			if err != nil {
 				return stacktrace.Propagate(err, "")
 			}
+		case tip := <-tipSentC:
+			err := s.handleTipSystemMessage(ctx, tip, tipAnnouncementLimiter)
+			if err != nil {
+				return stacktrace.Propagate(err, "")
+			}
 		case <-announcementsUpdatedC:
 			_, err := s.chat.CreateSystemMessage(ctx, "_**Announcements updated!**_")
 			if err != nil {
@@ -265,3 +295,51 @@ func (s *grpcServer) handleCrowdfundedTransactionSystemMessage(ctx context.Conte
 	_, err = s.chat.CreateSystemMessage(ctx, msg)
 	return stacktrace.Propagate(err, "")
 }
+
+func (s *grpcServer) handleTipSystemMessage(ctx context.Context, tip *types.Tip, limiter limiter.Store) error {
+	if !tip.Announced {
+		return nil
+	}
+
+	amountStr := ""
+	switch tip.Currency {
+	case types.TipCurrencyPoints:
+		amountStr = fmt.Sprintf("%s points", tip.Amount.String())
+	case types.TipCurrencyBanano:
+		amount := tip.Amount.BigInt()
+		if amount.Cmp(pricer.RewardRoundingFactor) < 0 {
+			// values below 0.01 wouldn't show properly with the FloatString(2) below, anyway
+			return nil
+		}
+		amountStr = fmt.Sprintf("%s BAN", new(big.Rat).SetFrac(amount, pricer.BananoUnit).FloatString(2))
+	default:
+		return nil
+	}
+
+	_, _, _, ok, err := limiter.Take(ctx, tip.FromAddress)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if !ok {
+		return nil
+	}
+
+	senderName, err := s.getChatFriendlyUserName(ctx, tip.FromAddress)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	senderName = utils.EscapeMarkdownCharacters(senderName)
+
+	recipientName, err := s.getChatFriendlyUserName(ctx, tip.ToAddress)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	recipientName = utils.EscapeMarkdownCharacters(recipientName)
+
+	msg := fmt.Sprintf("_%s just tipped **%s** to %s!_", senderName, amountStr, recipientName)
+	if tip.QueueEntryID != nil {
+		msg = fmt.Sprintf("_%s just tipped **%s** to %s for the media they enqueued!_", senderName, amountStr, recipientName)
+	}
+	_, err = s.chat.CreateSystemMessage(ctx, msg)
+	return stacktrace.Propagate(err, "")
+}
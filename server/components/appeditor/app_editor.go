@@ -370,7 +370,7 @@ func (e *AppEditor) DeleteApplication(ctxCtx context.Context, applicationID stri
 		return stacktrace.Propagate(err, "")
 	}
 
-	err = wallet.EmptyApplicationWallet(appWallet, e.paymentAccountPool)
+	err = wallet.EmptyApplicationWallet(ctx, appWallet, e.paymentAccountPool)
 	if err != nil {
 		return stacktrace.Propagate(err, "failed to empty application wallet")
 	}
@@ -24,7 +24,6 @@ import (
 	"github.com/dop251/goja_nodejs/console"
 	"github.com/dop251/goja_nodejs/eventloop"
 	"github.com/dop251/goja_nodejs/require"
-	gonano_wallet "github.com/hectorchu/gonano/wallet"
 	"github.com/palantir/stacktrace"
 	"github.com/tnyim/jungletv/proto"
 	"github.com/tnyim/jungletv/server/auth"
@@ -43,6 +42,7 @@ import (
 	"github.com/tnyim/jungletv/server/components/apprunner/modules/rpc"
 	"github.com/tnyim/jungletv/server/components/apprunner/modules/spectators"
 	"github.com/tnyim/jungletv/server/components/apprunner/modules/wallet"
+	"github.com/tnyim/jungletv/server/components/node"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
 	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils"
@@ -63,7 +63,7 @@ type appInstance struct {
 	applicationID      string
 	applicationVersion types.ApplicationVersion
 	applicationUser    auth.User
-	applicationWallet  *gonano_wallet.Wallet
+	applicationWallet  node.Wallet
 
 	mu                sync.RWMutex
 	ranInitCode       bool
@@ -118,7 +118,7 @@ var ErrApplicationFileTypeMismatch = errors.New("unexpected type for application
 // ErrApplicationInstanceNotRunning is returned when the specified application is not running
 var ErrApplicationInstanceNotRunning = errors.New("application instance not running")
 
-func (r *AppRunner) newAppInstance(applicationID string, applicationVersion types.ApplicationVersion, applicationWallet *gonano_wallet.Wallet) (*appInstance, error) {
+func (r *AppRunner) newAppInstance(applicationID string, applicationVersion types.ApplicationVersion, applicationWallet node.Wallet) (*appInstance, error) {
 	d := r.moduleDependencies
 	instance := &appInstance{
 		state: &appInstanceState{
@@ -149,18 +149,18 @@ func (r *AppRunner) newAppInstance(applicationID string, applicationVersion type
 
 	instance.modules.RegisterNativeModule(keyvalue.New(instance))
 	instance.modules.RegisterNativeModule(process.New(instance))
-	instance.modules.RegisterNativeModule(points.New(instance, d.PointsManager))
+	instance.modules.RegisterNativeModule(points.New(instance, d.PointsManager, d.PointsRewardsManager))
 	instance.modules.RegisterNativeModule(db.New(instance))
-	walletModule := wallet.New(instance, applicationWallet, d.PaymentAccountPool, d.DefaultAccountRepresentative)
+	walletModule := wallet.New(instance, r.log, applicationWallet, d.PaymentAccountPool, d.PaymentIntentManager, d.DefaultAccountRepresentative)
 	instance.modules.RegisterNativeModule(walletModule)
 	instance.pagesModule = pages.New(instance)
 	instance.modules.RegisterNativeModule(instance.pagesModule)
 	instance.modules.RegisterNativeModule(chat.New(instance, d.ChatManager, instance.pagesModule, instance.userSerializer))
-	instance.modules.RegisterNativeModule(queue.New(instance, d.PointsManager, d.MediaQueue, d.MediaProviders, d.Pricer, d.SkipManager, d.OtherMediaQueueMethods, instance.pagesModule, walletModule, instance.userSerializer))
+	instance.modules.RegisterNativeModule(queue.New(instance, d.PointsManager, d.MediaQueue, d.MediaProviders, d.Pricer, d.SkipManager, d.RewardsHandler, d.OtherMediaQueueMethods, instance.pagesModule, walletModule, instance.userSerializer))
 	instance.rpcModule = rpc.New(instance.userSerializer)
 	instance.modules.RegisterNativeModule(instance.rpcModule)
 	instance.modules.RegisterNativeModule(configuration.New(instance, r.configManager, r.notifManager, instance.pagesModule))
-	instance.modules.RegisterNativeModule(profile.New(instance, instance.userSerializer, d.ChatManager))
+	instance.modules.RegisterNativeModule(profile.New(instance, instance.userSerializer, d.ChatManager, d.TipManager))
 	instance.modules.RegisterNativeModule(spectators.New(instance, d.RewardsHandler, d.StatsRegistry, instance.userSerializer))
 	instance.ipcModule = ipc.New(instance)
 	instance.modules.RegisterNativeModule(instance.ipcModule)
@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/bytedance/sonic"
-	"github.com/hectorchu/gonano/wallet"
 	"github.com/palantir/stacktrace"
 	"github.com/patrickmn/go-cache"
 	"github.com/sethvargo/go-limiter"
@@ -20,6 +19,7 @@ import (
 	chatmodule "github.com/tnyim/jungletv/server/components/apprunner/modules/chat"
 	"github.com/tnyim/jungletv/server/components/apprunner/modules/pages"
 	"github.com/tnyim/jungletv/server/components/configurationmanager"
+	"github.com/tnyim/jungletv/server/components/node"
 	"github.com/tnyim/jungletv/server/components/notificationmanager"
 	"github.com/tnyim/jungletv/server/interceptors/auth"
 	"github.com/tnyim/jungletv/server/stores/chat"
@@ -119,7 +119,7 @@ type AppRunner struct {
 
 // WalletBuilder builds wallets for an application
 type WalletBuilder interface {
-	BuildApplicationWallet(applicationID string, earliestVersion types.ApplicationVersion) (*wallet.Wallet, error)
+	BuildApplicationWallet(applicationID string, earliestVersion types.ApplicationVersion) (node.Wallet, error)
 }
 
 // New returns a new initialized AppRunner
@@ -156,7 +156,7 @@ func (r *AppRunner) SetModuleDependencies(d modules.Dependencies) {
 	r.moduleDependencies.ChatManager.SetAttachmentLoaderForType("apppage", r.pageAttachmentLoader)
 }
 
-func (r *AppRunner) BuildApplicationWallet(ctxCtx context.Context, applicationID string) (*wallet.Wallet, error) {
+func (r *AppRunner) BuildApplicationWallet(ctxCtx context.Context, applicationID string) (node.Wallet, error) {
 	ctx, err := transaction.Begin(ctxCtx)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
@@ -9,11 +9,14 @@ import (
 	"github.com/tnyim/jungletv/server/components/chatmanager"
 	"github.com/tnyim/jungletv/server/components/mediaqueue"
 	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/paymentintentmanager"
 	"github.com/tnyim/jungletv/server/components/pointsmanager"
+	"github.com/tnyim/jungletv/server/components/pointsrewards"
 	"github.com/tnyim/jungletv/server/components/pricer"
 	"github.com/tnyim/jungletv/server/components/rewards"
 	"github.com/tnyim/jungletv/server/components/skipmanager"
 	"github.com/tnyim/jungletv/server/components/stats"
+	"github.com/tnyim/jungletv/server/components/tipmanager"
 	"github.com/tnyim/jungletv/server/media"
 	"github.com/tnyim/jungletv/server/usercache"
 	"github.com/tnyim/jungletv/types"
@@ -30,6 +33,9 @@ type Dependencies struct {
 	SkipManager                  *skipmanager.Manager
 	OtherMediaQueueMethods       OtherMediaQueueMethods
 	PaymentAccountPool           *payment.PaymentAccountPool
+	PaymentIntentManager         *paymentintentmanager.Manager
+	TipManager                   *tipmanager.Manager
+	PointsRewardsManager         *pointsrewards.Manager
 	DefaultAccountRepresentative string
 	UserCache                    usercache.UserCache
 	RewardsHandler               *rewards.Handler
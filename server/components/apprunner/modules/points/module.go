@@ -17,6 +17,7 @@ import (
 	"github.com/tnyim/jungletv/server/components/apprunner/gojautil"
 	"github.com/tnyim/jungletv/server/components/apprunner/modules"
 	"github.com/tnyim/jungletv/server/components/pointsmanager"
+	"github.com/tnyim/jungletv/server/components/pointsrewards"
 	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils/transaction"
 )
@@ -25,21 +26,23 @@ import (
 const ModuleName = "jungletv:points"
 
 type pointsModule struct {
-	runtime        *goja.Runtime
-	exports        *goja.Object
-	pointsManager  *pointsmanager.Manager
-	dateSerializer func(time.Time) interface{}
-	eventAdapter   *gojautil.EventAdapter
+	runtime              *goja.Runtime
+	exports              *goja.Object
+	pointsManager        *pointsmanager.Manager
+	pointsRewardsManager *pointsrewards.Manager
+	dateSerializer       func(time.Time) interface{}
+	eventAdapter         *gojautil.EventAdapter
 
 	appContext modules.ApplicationContext
 }
 
 // New returns a new points module
-func New(appContext modules.ApplicationContext, pointsManager *pointsmanager.Manager) modules.NativeModule {
+func New(appContext modules.ApplicationContext, pointsManager *pointsmanager.Manager, pointsRewardsManager *pointsrewards.Manager) modules.NativeModule {
 	return &pointsModule{
-		pointsManager: pointsManager,
-		appContext:    appContext,
-		eventAdapter:  gojautil.NewEventAdapter(appContext),
+		pointsManager:        pointsManager,
+		pointsRewardsManager: pointsRewardsManager,
+		appContext:           appContext,
+		eventAdapter:         gojautil.NewEventAdapter(appContext),
 	}
 }
 
@@ -57,6 +60,10 @@ func (m *pointsModule) ModuleLoader() require.ModuleLoader {
 		m.exports.Set("createTransaction", m.createTransaction)
 		m.exports.Set("getBalance", m.getBalance)
 		m.exports.Set("getNiceSubscription", m.getNiceSubscription)
+		m.exports.Set("getRewards", m.getRewards)
+		m.exports.Set("getRedemptions", m.getRedemptions)
+		m.exports.Set("redeemReward", m.redeemReward)
+		m.exports.Set("updateRedemption", m.updateRedemption)
 		m.exports.Set("addEventListener", m.eventAdapter.AddEventListener)
 		m.exports.Set("removeEventListener", m.eventAdapter.RemoveEventListener)
 
@@ -71,6 +78,16 @@ func (m *pointsModule) ModuleLoader() require.ModuleLoader {
 			t["pointsAdjustment"] = arg.AdjustmentValue
 			return vm.ToValue(t).ToObject(vm)
 		})
+		gojautil.AdaptEvent(m.eventAdapter, m.pointsRewardsManager.RedemptionCreated(), "rewardredeemed", func(vm *goja.Runtime, arg *types.PointsRewardRedemption) *goja.Object {
+			t := map[string]interface{}{}
+			t["redemption"] = serializeRedemptionForJS(arg, m.dateSerializer)
+			return vm.ToValue(t).ToObject(vm)
+		})
+		gojautil.AdaptEvent(m.eventAdapter, m.pointsRewardsManager.RedemptionUpdated(), "redemptionupdated", func(vm *goja.Runtime, arg *types.PointsRewardRedemption) *goja.Object {
+			t := map[string]interface{}{}
+			t["redemption"] = serializeRedemptionForJS(arg, m.dateSerializer)
+			return vm.ToValue(t).ToObject(vm)
+		})
 	}
 }
 func (m *pointsModule) ModuleName() string {
@@ -204,3 +221,152 @@ func serializeSubscriptionForJS(subscription *types.Subscription, dateSerializer
 		}),
 	}
 }
+
+func (m *pointsModule) getRewards(call goja.FunctionCall) goja.Value {
+	includeDisabled := call.Argument(0).ToBoolean()
+
+	rewards, err := m.pointsRewardsManager.Rewards(m.appContext.ExecutionContext(), includeDisabled)
+	if err != nil {
+		panic(m.runtime.NewGoError(stacktrace.Propagate(err, "")))
+	}
+
+	return m.runtime.ToValue(lo.Map(rewards, func(reward *types.PointsReward, _ int) map[string]interface{} {
+		return serializeRewardForJS(reward)
+	}))
+}
+
+func (m *pointsModule) getRedemptions(call goja.FunctionCall) goja.Value {
+	address := ""
+	if !goja.IsUndefined(call.Argument(0)) && !goja.IsNull(call.Argument(0)) {
+		address = call.Argument(0).String()
+		gojautil.ValidateBananoAddress(m.runtime, address, "Invalid user address")
+	}
+
+	statuses := []types.PointsRewardRedemptionStatus{}
+	if !goja.IsUndefined(call.Argument(1)) && !goja.IsNull(call.Argument(1)) {
+		status := m.parseRedemptionStatus(call.Argument(1))
+		statuses = append(statuses, status)
+	}
+
+	redemptions, _, err := m.pointsRewardsManager.Redemptions(m.appContext.ExecutionContext(), statuses, address, &types.PaginationParams{
+		Offset: 0,
+		Limit:  100,
+	})
+	if err != nil {
+		panic(m.runtime.NewGoError(stacktrace.Propagate(err, "")))
+	}
+
+	return m.runtime.ToValue(lo.Map(redemptions, func(redemption *types.PointsRewardRedemption, _ int) map[string]interface{} {
+		return serializeRedemptionForJS(redemption, m.dateSerializer)
+	}))
+}
+
+func (m *pointsModule) redeemReward(call goja.FunctionCall) goja.Value {
+	if len(call.Arguments) < 2 {
+		panic(m.runtime.NewTypeError("Missing argument"))
+	}
+	userAddress := call.Argument(0).String()
+	gojautil.ValidateBananoAddress(m.runtime, userAddress, "Invalid user address")
+
+	rewardID := call.Argument(1).String()
+
+	input := ""
+	if !goja.IsUndefined(call.Argument(2)) && !goja.IsNull(call.Argument(2)) {
+		input = call.Argument(2).String()
+	}
+
+	redemption, err := m.pointsRewardsManager.Redeem(m.appContext.ExecutionContext(), auth.NewAddressOnlyUser(userAddress), rewardID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrInsufficientPointsBalance):
+			panic(m.runtime.NewTypeError("Insufficient points balance"))
+		case errors.Is(err, pointsrewards.ErrRewardUnavailable):
+			panic(m.runtime.NewTypeError("Reward unavailable"))
+		case errors.Is(err, pointsrewards.ErrRewardOutOfStock):
+			panic(m.runtime.NewTypeError("Reward out of stock"))
+		case errors.Is(err, pointsrewards.ErrRedemptionLimitReached):
+			panic(m.runtime.NewTypeError("Redemption limit reached"))
+		case errors.Is(err, pointsrewards.ErrInvalidInput):
+			panic(m.runtime.NewTypeError("Invalid input"))
+		}
+		panic(m.runtime.NewGoError(stacktrace.Propagate(err, "")))
+	}
+
+	return m.runtime.ToValue(serializeRedemptionForJS(redemption, m.dateSerializer))
+}
+
+func (m *pointsModule) updateRedemption(call goja.FunctionCall) goja.Value {
+	if len(call.Arguments) < 2 {
+		panic(m.runtime.NewTypeError("Missing argument"))
+	}
+	redemptionID := call.Argument(0).String()
+	status := m.parseRedemptionStatus(call.Argument(1))
+
+	var staffNote *string
+	if !goja.IsUndefined(call.Argument(2)) && !goja.IsNull(call.Argument(2)) {
+		note := call.Argument(2).String()
+		staffNote = &note
+	}
+
+	redemption, err := m.pointsRewardsManager.UpdateRedemption(m.appContext.ExecutionContext(), redemptionID, status, staffNote, m.appContext.ApplicationID())
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrPointsRewardRedemptionNotFound):
+			panic(m.runtime.NewTypeError("Redemption not found"))
+		case errors.Is(err, pointsrewards.ErrInvalidStatusTransition):
+			panic(m.runtime.NewTypeError("Invalid status transition"))
+		case errors.Is(err, pointsrewards.ErrInvalidInput):
+			panic(m.runtime.NewTypeError("Staff note too long"))
+		}
+		panic(m.runtime.NewGoError(stacktrace.Propagate(err, "")))
+	}
+
+	return m.runtime.ToValue(serializeRedemptionForJS(redemption, m.dateSerializer))
+}
+
+func (m *pointsModule) parseRedemptionStatus(value goja.Value) types.PointsRewardRedemptionStatus {
+	status := types.PointsRewardRedemptionStatus(value.String())
+	switch status {
+	case types.PointsRewardRedemptionStatusPending, types.PointsRewardRedemptionStatusFulfilled, types.PointsRewardRedemptionStatusRejected:
+		return status
+	default:
+		panic(m.runtime.NewTypeError("Invalid redemption status"))
+	}
+}
+
+func serializeRewardForJS(reward *types.PointsReward) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":            reward.ID,
+		"title":         reward.Title,
+		"description":   reward.Description,
+		"cost":          reward.Cost,
+		"requiresInput": reward.RequiresInput,
+		"enabled":       reward.Enabled,
+	}
+	if reward.Stock != nil {
+		result["stock"] = *reward.Stock
+	}
+	if reward.PerUserLimit != nil {
+		result["perUserLimit"] = *reward.PerUserLimit
+	}
+	return result
+}
+
+func serializeRedemptionForJS(redemption *types.PointsRewardRedemption, dateSerializer func(time.Time) interface{}) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":        redemption.ID,
+		"rewardID":  redemption.RewardID,
+		"address":   redemption.RewardsAddress,
+		"cost":      redemption.Cost,
+		"status":    string(redemption.Status),
+		"createdAt": dateSerializer(redemption.CreatedAt),
+		"updatedAt": dateSerializer(redemption.UpdatedAt),
+	}
+	if redemption.Input != nil {
+		result["input"] = *redemption.Input
+	}
+	if redemption.StaffNote != nil {
+		result["staffNote"] = *redemption.StaffNote
+	}
+	return result
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/tnyim/jungletv/server/components/apprunner/modules"
 	"github.com/tnyim/jungletv/server/components/chatmanager"
 	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/tipmanager"
 	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils/transaction"
 )
@@ -27,14 +28,18 @@ type profileModule struct {
 
 	userSerializer gojautil.UserSerializer
 	chatManager    *chatmanager.Manager
+	tipManager     *tipmanager.Manager
+	eventAdapter   *gojautil.EventAdapter
 }
 
 // New returns a new profile module
-func New(appContext modules.ApplicationContext, userSerializer gojautil.UserSerializer, chatManager *chatmanager.Manager) modules.NativeModule {
+func New(appContext modules.ApplicationContext, userSerializer gojautil.UserSerializer, chatManager *chatmanager.Manager, tipManager *tipmanager.Manager) modules.NativeModule {
 	return &profileModule{
 		appContext:     appContext,
 		userSerializer: userSerializer,
 		chatManager:    chatManager,
+		tipManager:     tipManager,
+		eventAdapter:   gojautil.NewEventAdapter(appContext),
 	}
 }
 
@@ -53,6 +58,14 @@ func (m *profileModule) ModuleLoader() require.ModuleLoader {
 		exports.Set("clearProfile", m.clearProfile)
 		exports.Set("setUserNickname", m.setUserNickname)
 		exports.Set("getStatistics", m.getStatistics)
+		exports.Set("addEventListener", m.eventAdapter.AddEventListener)
+		exports.Set("removeEventListener", m.eventAdapter.RemoveEventListener)
+
+		gojautil.AdaptEvent(m.eventAdapter, m.tipManager.TipSent(), "tipsent", func(vm *goja.Runtime, arg *types.Tip) *goja.Object {
+			return vm.ToValue(map[string]interface{}{
+				"tip": m.serializeTip(vm, arg),
+			}).ToObject(vm)
+		})
 	}
 }
 func (m *profileModule) ModuleName() string {
@@ -61,7 +74,30 @@ func (m *profileModule) ModuleName() string {
 func (m *profileModule) AutoRequire() (bool, string) {
 	return false, ""
 }
-func (m *profileModule) ExecutionResumed(_ context.Context) {}
+func (m *profileModule) ExecutionResumed(ctx context.Context) {
+	m.eventAdapter.StartOrResume(ctx, m.runtime)
+}
+
+func (m *profileModule) serializeTip(vm *goja.Runtime, tip *types.Tip) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":           tip.ID,
+		"sender":       m.userSerializer.SerializeUser(vm, auth.NewAddressOnlyUser(tip.FromAddress)),
+		"recipient":    m.userSerializer.SerializeUser(vm, auth.NewAddressOnlyUser(tip.ToAddress)),
+		"currency":     string(tip.Currency),
+		"queueEntryID": goja.Undefined(),
+		"createdAt":    gojautil.SerializeTime(vm, tip.CreatedAt),
+	}
+	switch tip.Currency {
+	case types.TipCurrencyPoints:
+		result["amount"] = tip.Amount.IntPart()
+	case types.TipCurrencyBanano:
+		result["amount"] = payment.NewAmountFromDecimal(tip.Amount).SerializeForAPI()
+	}
+	if tip.QueueEntryID != nil {
+		result["queueEntryID"] = *tip.QueueEntryID
+	}
+	return result
+}
 
 func (m *profileModule) getUser(call goja.FunctionCall) goja.Value {
 	if len(call.Arguments) < 1 {
@@ -184,6 +184,10 @@ func parseMediaInfoArgument(vm *goja.Runtime, mediaType types.MediaType, arg goj
 		return parseSoundCloudTrackInfoArgument(vm, infoMap)
 	case types.MediaTypeDocument:
 		return parseDocumentInfoArgument(vm, infoMap)
+	case types.MediaTypeDirectMedia:
+		return parseDirectMediaInfoArgument(vm, infoMap)
+	case types.MediaTypeLibraryMedia:
+		return parseLibraryMediaInfoArgument(vm, infoMap)
 	default:
 		panic(vm.NewTypeError("First argument to %s must be a known media type", fnName))
 	}
@@ -257,6 +261,74 @@ func parseSoundCloudTrackInfoArgument(vm *goja.Runtime, infoMap map[string]goja.
 	return request
 }
 
+func parseDirectMediaInfoArgument(vm *goja.Runtime, infoMap map[string]goja.Value) proto.IsEnqueueMediaRequest_MediaInfo {
+	mediaURL, ok := infoMap["url"]
+	if !ok {
+		panic(vm.NewTypeError("Second argument is missing 'url' property"))
+	}
+	request := &proto.EnqueueMediaRequest_DirectMediaData{
+		DirectMediaData: &proto.EnqueueDirectMediaData{
+			Url: mediaURL.String(),
+		},
+	}
+
+	startOffsetValue, ok := infoMap["startOffset"]
+	if ok {
+		var startOffset int64
+		err := vm.ExportTo(startOffsetValue, &startOffset)
+		if err != nil || startOffset < 0 {
+			panic(vm.NewTypeError("If specified, startOffset must be a non-negative integer"))
+		}
+		request.DirectMediaData.StartOffset = durationpb.New(time.Duration(startOffset * int64(time.Millisecond)))
+	}
+
+	endOffsetValue, ok := infoMap["endOffset"]
+	if ok {
+		var endOffset int64
+		err := vm.ExportTo(endOffsetValue, &endOffset)
+		if err != nil || endOffset < 0 {
+			panic(vm.NewTypeError("If specified, endOffset must be a non-negative integer"))
+		}
+		request.DirectMediaData.EndOffset = durationpb.New(time.Duration(endOffset * int64(time.Millisecond)))
+	}
+
+	return request
+}
+
+func parseLibraryMediaInfoArgument(vm *goja.Runtime, infoMap map[string]goja.Value) proto.IsEnqueueMediaRequest_MediaInfo {
+	id, ok := infoMap["id"]
+	if !ok {
+		panic(vm.NewTypeError("Second argument is missing 'id' property"))
+	}
+	request := &proto.EnqueueMediaRequest_LibraryMediaData{
+		LibraryMediaData: &proto.EnqueueLibraryMediaData{
+			Id: id.String(),
+		},
+	}
+
+	startOffsetValue, ok := infoMap["startOffset"]
+	if ok {
+		var startOffset int64
+		err := vm.ExportTo(startOffsetValue, &startOffset)
+		if err != nil || startOffset < 0 {
+			panic(vm.NewTypeError("If specified, startOffset must be a non-negative integer"))
+		}
+		request.LibraryMediaData.StartOffset = durationpb.New(time.Duration(startOffset * int64(time.Millisecond)))
+	}
+
+	endOffsetValue, ok := infoMap["endOffset"]
+	if ok {
+		var endOffset int64
+		err := vm.ExportTo(endOffsetValue, &endOffset)
+		if err != nil || endOffset < 0 {
+			panic(vm.NewTypeError("If specified, endOffset must be a non-negative integer"))
+		}
+		request.LibraryMediaData.EndOffset = durationpb.New(time.Duration(endOffset * int64(time.Millisecond)))
+	}
+
+	return request
+}
+
 func parseDocumentInfoArgument(vm *goja.Runtime, infoMap map[string]goja.Value) proto.IsEnqueueMediaRequest_MediaInfo {
 	id, ok := infoMap["id"]
 	if !ok {
@@ -322,6 +394,8 @@ func formatStringForMediaEnqueueRequestCreationFailed(result media.EnqueueReques
 		return "Media %s failed because the content is disallowed"
 	case media.EnqueueRequestCreationFailedMediumIsNotATrack:
 		return "Media %s failed because the content is not a track"
+	case media.EnqueueRequestCreationFailedMediumFormatIsUnsupported:
+		return "Media %s failed because the content format is not supported"
 	}
 }
 
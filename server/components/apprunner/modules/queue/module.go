@@ -17,6 +17,7 @@ import (
 	"github.com/tnyim/jungletv/server/components/mediaqueue"
 	"github.com/tnyim/jungletv/server/components/pointsmanager"
 	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/server/components/rewards"
 	"github.com/tnyim/jungletv/server/components/skipmanager"
 	"github.com/tnyim/jungletv/server/media"
 	"github.com/tnyim/jungletv/types"
@@ -37,6 +38,7 @@ type queueModule struct {
 	mediaProviders           map[types.MediaType]media.Provider
 	pricer                   *pricer.Pricer
 	skipManager              *skipmanager.Manager
+	rewardsHandler           *rewards.Handler
 	queueMisc                modules.OtherMediaQueueMethods
 	dateSerializer           func(time.Time) interface{}
 	eventAdapter             *gojautil.EventAdapter
@@ -44,7 +46,7 @@ type queueModule struct {
 }
 
 // New returns a new queue module
-func New(appContext modules.ApplicationContext, pointsManager *pointsmanager.Manager, mediaQueue *mediaqueue.MediaQueue, mediaProviders map[types.MediaType]media.Provider, pricer *pricer.Pricer, skipManager *skipmanager.Manager, queueMisc modules.OtherMediaQueueMethods, pagesModule pages.PagesModule, paymentsModule wallet.WalletModule, userSerializer gojautil.UserSerializer) modules.NativeModule {
+func New(appContext modules.ApplicationContext, pointsManager *pointsmanager.Manager, mediaQueue *mediaqueue.MediaQueue, mediaProviders map[types.MediaType]media.Provider, pricer *pricer.Pricer, skipManager *skipmanager.Manager, rewardsHandler *rewards.Handler, queueMisc modules.OtherMediaQueueMethods, pagesModule pages.PagesModule, paymentsModule wallet.WalletModule, userSerializer gojautil.UserSerializer) modules.NativeModule {
 	return &queueModule{
 		appContext:               appContext,
 		pagesModule:              pagesModule,
@@ -55,6 +57,7 @@ func New(appContext modules.ApplicationContext, pointsManager *pointsmanager.Man
 		mediaProviders:           mediaProviders,
 		pricer:                   pricer,
 		skipManager:              skipManager,
+		rewardsHandler:           rewardsHandler,
 		queueMisc:                queueMisc,
 		eventAdapter:             gojautil.NewEventAdapter(appContext),
 		crowdfundingEventAdapter: gojautil.NewEventAdapter(appContext),
@@ -95,6 +98,9 @@ func (m *queueModule) ModuleLoader() require.ModuleLoader {
 		m.exports.Set("crowdfunding", crowdfunding)
 		crowdfunding.Set("addEventListener", m.crowdfundingEventAdapter.AddEventListener)
 		crowdfunding.Set("removeEventListener", m.crowdfundingEventAdapter.RemoveEventListener)
+		crowdfunding.Set("scheduleRain", m.scheduleRain)
+		crowdfunding.Set("getScheduledRain", m.getScheduledRain)
+		crowdfunding.Set("cancelScheduledRain", m.cancelScheduledRain)
 		m.setCrowdfundingPropertyExports(crowdfunding)
 
 		m.configureEvents()
@@ -0,0 +1,168 @@
+package queue
+
+import (
+	"errors"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/components/apprunner/gojautil"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/rewards"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+func (m *queueModule) scheduleRain(call goja.FunctionCall) goja.Value {
+	if len(call.Arguments) < 1 {
+		panic(m.runtime.NewTypeError("Missing argument"))
+	}
+
+	amount, err := payment.NewAmountFromAPIString(call.Argument(0).String())
+	if err != nil {
+		panic(m.runtime.NewTypeError("First argument to scheduleRain must be a valid amount string"))
+	}
+
+	params := rewards.ScheduledRainParams{
+		ChannelID:     m.mediaQueue.ChannelID(),
+		Amount:        amount,
+		Audience:      types.ScheduledRainAudienceSpectators,
+		ScheduledFor:  time.Now(),
+		CreatedBy:     m.appContext.ApplicationUser().Address(),
+		ApplicationID: m.appContext.ApplicationID(),
+	}
+
+	// second optional argument: options object
+	if len(call.Arguments) > 1 {
+		optionsMap := map[string]goja.Value{}
+		err := m.runtime.ExportTo(call.Argument(1), &optionsMap)
+		if err != nil {
+			panic(m.runtime.NewTypeError("Second argument to scheduleRain is not an object"))
+		}
+
+		if v, ok := optionsMap["scheduledFor"]; ok {
+			err = m.runtime.ExportTo(v, &params.ScheduledFor)
+			if err != nil {
+				panic(m.runtime.NewTypeError("If specified, scheduledFor must be a Date"))
+			}
+		}
+		if v, ok := optionsMap["audience"]; ok {
+			switch v.String() {
+			case "spectators":
+				params.Audience = types.ScheduledRainAudienceSpectators
+			case "chatters":
+				params.Audience = types.ScheduledRainAudienceChatters
+			default:
+				panic(m.runtime.NewTypeError("If specified, audience must be one of 'spectators', 'chatters'"))
+			}
+		}
+		if v, ok := optionsMap["chatWindow"]; ok {
+			var chatWindowms int64
+			err = m.runtime.ExportTo(v, &chatWindowms)
+			if err != nil {
+				panic(m.runtime.NewTypeError("If specified, chatWindow must be an integer"))
+			}
+			params.ChatWindow = time.Duration(chatWindowms) * time.Millisecond
+		}
+		if v, ok := optionsMap["mediaCount"]; ok {
+			err = m.runtime.ExportTo(v, &params.MediaCount)
+			if err != nil {
+				panic(m.runtime.NewTypeError("If specified, mediaCount must be an integer"))
+			}
+		}
+	}
+
+	if params.Audience == types.ScheduledRainAudienceChatters && params.ChatWindow == 0 {
+		params.ChatWindow = 10 * time.Minute
+	}
+
+	err = params.Validate()
+	if err != nil {
+		panic(m.runtime.NewTypeError("Invalid rain: %s", err.Error()))
+	}
+
+	return gojautil.DoAsyncWithTransformer(m.appContext, m.runtime, func(actx gojautil.AsyncContext) (*types.ScheduledRain, gojautil.PromiseResultTransformer[*types.ScheduledRain]) {
+		ctx, err := transaction.Begin(actx)
+		if err != nil {
+			panic(actx.NewGoError(stacktrace.Propagate(err, "")))
+		}
+		defer ctx.Rollback()
+
+		rain, err := m.rewardsHandler.ScheduleRain(ctx, params)
+		if err != nil {
+			panic(actx.NewGoError(stacktrace.Propagate(err, "")))
+		}
+
+		err = m.paymentsModule.DebitFromApplicationWallet(amount)
+		if err != nil {
+			panic(actx.NewGoError(stacktrace.Propagate(err, "")))
+		}
+
+		err = ctx.Commit()
+		if err != nil {
+			panic(actx.NewGoError(stacktrace.Propagate(err, "")))
+		}
+
+		return rain, serializeScheduledRain
+	})
+}
+
+func (m *queueModule) getScheduledRain(call goja.FunctionCall) goja.Value {
+	if len(call.Arguments) < 1 {
+		panic(m.runtime.NewTypeError("Missing argument"))
+	}
+	rainID := call.Argument(0).String()
+
+	return gojautil.DoAsyncWithTransformer(m.appContext, m.runtime, func(actx gojautil.AsyncContext) (*types.ScheduledRain, gojautil.PromiseResultTransformer[*types.ScheduledRain]) {
+		ctx, err := transaction.Begin(actx)
+		if err != nil {
+			panic(actx.NewGoError(stacktrace.Propagate(err, "")))
+		}
+		defer ctx.Commit() // read-only tx
+
+		rains, err := types.GetScheduledRainsWithIDs(ctx, []string{rainID})
+		if err != nil {
+			panic(actx.NewGoError(stacktrace.Propagate(err, "")))
+		}
+		rain, ok := rains[rainID]
+		if !ok || rain.ApplicationID == nil || *rain.ApplicationID != m.appContext.ApplicationID() {
+			return nil, serializeScheduledRain
+		}
+		return rain, serializeScheduledRain
+	})
+}
+
+func (m *queueModule) cancelScheduledRain(call goja.FunctionCall) goja.Value {
+	if len(call.Arguments) < 1 {
+		panic(m.runtime.NewTypeError("Missing argument"))
+	}
+	rainID := call.Argument(0).String()
+
+	return gojautil.DoAsyncWithTransformer(m.appContext, m.runtime, func(actx gojautil.AsyncContext) (*types.ScheduledRain, gojautil.PromiseResultTransformer[*types.ScheduledRain]) {
+		rain, err := m.rewardsHandler.CancelScheduledRain(actx, rainID, m.appContext.ApplicationID())
+		if err != nil {
+			if errors.Is(err, rewards.ErrScheduledRainNotFound) {
+				panic(actx.NewTypeError("Scheduled rain not found"))
+			}
+			panic(actx.NewGoError(stacktrace.Propagate(err, "")))
+		}
+		return rain, serializeScheduledRain
+	})
+}
+
+func serializeScheduledRain(vm *goja.Runtime, rain *types.ScheduledRain) interface{} {
+	if rain == nil {
+		return goja.Null()
+	}
+	o := vm.NewObject()
+	o.Set("id", rain.ID)
+	o.Set("amount", payment.NewAmountFromDecimal(rain.Amount).SerializeForAPI())
+	o.Set("distributedAmount", payment.NewAmountFromDecimal(rain.DistributedAmount).SerializeForAPI())
+	o.Set("audience", string(rain.Audience))
+	o.Set("chatWindow", time.Duration(rain.ChatWindow).Milliseconds())
+	o.Set("mediaCount", rain.MediaCount)
+	o.Set("mediaDistributed", rain.MediaDistributed)
+	o.Set("scheduledFor", gojautil.SerializeTime(vm, rain.ScheduledFor))
+	o.Set("status", string(rain.Status))
+	return o
+}
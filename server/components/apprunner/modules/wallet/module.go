@@ -2,6 +2,8 @@ package wallet
 
 import (
 	"context"
+	"fmt"
+	"log"
 	"math/big"
 	"strings"
 	"sync"
@@ -9,13 +11,16 @@ import (
 
 	"github.com/dop251/goja"
 	"github.com/dop251/goja_nodejs/require"
-	"github.com/hectorchu/gonano/wallet"
 	"github.com/palantir/stacktrace"
 	"github.com/tnyim/jungletv/server/components/apprunner/gojautil"
 	"github.com/tnyim/jungletv/server/components/apprunner/modules"
+	"github.com/tnyim/jungletv/server/components/node"
 	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/paymentintentmanager"
 	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils/event"
+	"github.com/tnyim/jungletv/utils/transaction"
 )
 
 // ModuleName is the name by which this module can be require()d in a script
@@ -28,25 +33,41 @@ type WalletModule interface {
 }
 
 type walletModule struct {
-	runtime            *goja.Runtime
-	appContext         modules.ApplicationContext
-	applicationWallet  *wallet.Wallet
-	applicationAccount *wallet.Account
-	paymentAccountPool *payment.PaymentAccountPool
-	defaultRep         string
+	runtime              *goja.Runtime
+	appContext           modules.ApplicationContext
+	log                  *log.Logger
+	applicationWallet    node.Wallet
+	applicationAccount   node.Account
+	paymentAccountPool   *payment.PaymentAccountPool
+	paymentIntentManager *paymentintentmanager.Manager
+	defaultRep           string
+	ctx                  context.Context
+	eventAdapter         *gojautil.EventAdapter
+
+	paymentIntentConcluded event.Event[*types.PaymentIntent]
+	deliveryMu             sync.Mutex
 }
 
 // New returns a new wallet module
-func New(appContext modules.ApplicationContext, applicationWallet *wallet.Wallet, paymentAccountPool *payment.PaymentAccountPool, defaultRepresentative string) WalletModule {
+func New(appContext modules.ApplicationContext, log *log.Logger, applicationWallet node.Wallet, paymentAccountPool *payment.PaymentAccountPool,
+	paymentIntentManager *paymentintentmanager.Manager, defaultRepresentative string) WalletModule {
 	account := applicationWallet.GetAccount(appContext.ApplicationUser().Address())
 
-	return &walletModule{
-		appContext:         appContext,
-		applicationWallet:  applicationWallet,
-		paymentAccountPool: paymentAccountPool,
-		applicationAccount: account,
-		defaultRep:         defaultRepresentative,
+	m := &walletModule{
+		appContext:           appContext,
+		log:                  log,
+		applicationWallet:    applicationWallet,
+		paymentAccountPool:   paymentAccountPool,
+		paymentIntentManager: paymentIntentManager,
+		applicationAccount:   account,
+		defaultRep:           defaultRepresentative,
+		eventAdapter:         gojautil.NewEventAdapter(appContext),
+	}
+	m.paymentIntentConcluded = &paymentIntentConcludedEvent{
+		Event: event.New[*types.PaymentIntent](),
+		m:     m,
 	}
+	return m
 }
 
 func (m *walletModule) IsNodeBuiltin() bool {
@@ -60,6 +81,11 @@ func (m *walletModule) ModuleLoader() require.ModuleLoader {
 		exports.Set("getBalance", m.getBalance)
 		exports.Set("send", m.send)
 		exports.Set("receivePayment", m.receivePayment)
+		exports.Set("createPaymentIntent", m.createPaymentIntent)
+		exports.Set("getPaymentIntent", m.getPaymentIntent)
+		exports.Set("cancelPaymentIntent", m.cancelPaymentIntent)
+		exports.Set("addEventListener", m.eventAdapter.AddEventListener)
+		exports.Set("removeEventListener", m.eventAdapter.RemoveEventListener)
 		exports.Set("compareAmounts", m.compareAmounts)
 		exports.Set("formatAmount", m.formatAmount)
 		exports.Set("parseAmount", m.parseAmount)
@@ -69,6 +95,12 @@ func (m *walletModule) ModuleLoader() require.ModuleLoader {
 		exports.DefineAccessorProperty("address", m.runtime.ToValue(func(call goja.FunctionCall) goja.Value {
 			return m.runtime.ToValue(m.applicationAccount.Address())
 		}), goja.Undefined(), goja.FLAG_FALSE, goja.FLAG_TRUE)
+
+		gojautil.AdaptEvent(m.eventAdapter, m.paymentIntentConcluded, "paymentintentconcluded", func(vm *goja.Runtime, arg *types.PaymentIntent) *goja.Object {
+			o := vm.NewObject()
+			o.Set("paymentIntent", serializePaymentIntent(vm, arg))
+			return o
+		})
 	}
 }
 func (m *walletModule) ModuleName() string {
@@ -77,14 +109,17 @@ func (m *walletModule) ModuleName() string {
 func (m *walletModule) AutoRequire() (bool, string) {
 	return false, ""
 }
-func (m *walletModule) ExecutionResumed(_ context.Context) {}
+func (m *walletModule) ExecutionResumed(ctx context.Context) {
+	m.ctx = ctx
+	m.eventAdapter.StartOrResume(ctx, m.runtime)
+}
 
 func (m *walletModule) DebitFromApplicationWallet(amount payment.Amount) error {
 	if amount.Cmp(big.NewInt(0)) == 0 {
 		return nil
 	}
 
-	_, err := m.sendFromApplicationWallet(nil, wallet.SendDestination{Account: m.paymentAccountPool.DefaultCollectorAccountAddress(), Amount: amount.Int})
+	_, err := m.sendFromApplicationWallet(nil, node.SendDestination{Account: m.paymentAccountPool.DefaultCollectorAccountAddress(), Amount: amount.Int})
 	if err != nil {
 		return stacktrace.Propagate(err, "failed to send to the collector account")
 	}
@@ -94,7 +129,7 @@ func (m *walletModule) DebitFromApplicationWallet(amount payment.Amount) error {
 // EmptyApplicationWallet is meant to be used when deleting an application
 // this is not a very clean approach but it's better than copying the code into the app editor,
 // or bringing up a true app instance just to delete the app (which would be unclean in its own ways)
-func EmptyApplicationWallet(applicationWallet *wallet.Wallet, paymentAccountPool *payment.PaymentAccountPool) error {
+func EmptyApplicationWallet(ctx context.Context, applicationWallet node.Wallet, paymentAccountPool *payment.PaymentAccountPool) error {
 	// build an incomplete module so we can use the helper functions
 
 	accountIndex := uint32(0)
@@ -110,6 +145,7 @@ func EmptyApplicationWallet(applicationWallet *wallet.Wallet, paymentAccountPool
 		// this rep is only used if the account had receivable balance but hadn't been opened
 		// doesn't really matter since the account will be emptied anyway
 		defaultRep: account.Address(),
+		ctx:        ctx,
 	}
 
 	balance, err := m.receivePendingsAndGetSendableBalance()
@@ -125,7 +161,7 @@ func EmptyApplicationWallet(applicationWallet *wallet.Wallet, paymentAccountPool
 }
 
 // DO NOT CALL SYNCHRONOUSLY in the JS main loop, as it's slow.
-func (m *walletModule) sendFromApplicationWallet(customRep *string, destinations ...wallet.SendDestination) ([]string, error) {
+func (m *walletModule) sendFromApplicationWallet(customRep *string, destinations ...node.SendDestination) ([]string, error) {
 	totalBalance, err := m.receivePendingsAndGetSendableBalance()
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
@@ -164,10 +200,33 @@ func (m *walletModule) sendFromApplicationWallet(customRep *string, destinations
 			return nil, stacktrace.Propagate(err, "failed to send to the destination accounts")
 		}
 		hashes = append(hashes, hash.String())
+		if destination.Account == m.paymentAccountPool.DefaultCollectorAccountAddress() {
+			err = m.recordSendToCollector(destination.Account, payment.NewAmount(destination.Amount), hash.String())
+			if err != nil {
+				// the funds were already sent, the journal will show a discrepancy
+				m.log.Printf("Failed to record send from application wallet %s in the journal: %v", m.applicationAccount.Address(), err)
+			}
+		}
 	}
 	return hashes, nil
 }
 
+// application wallets are not tracked by the journal, so their funds enter our custody once sent to the collector
+func (m *walletModule) recordSendToCollector(collectorAccountAddress string, amount payment.Amount, hash string) error {
+	ctx, err := transaction.Begin(m.ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	err = types.RecordJournalEntry(ctx, fmt.Sprintf("Application wallet %s sent funds to %s", m.applicationAccount.Address(), collectorAccountAddress), hash,
+		types.JournalTransfer(types.JournalAccountEquity, types.JournalAccountOnChain(collectorAccountAddress), amount.Decimal())...)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
+
 // DO NOT CALL SYNCHRONOUSLY in the JS main loop, as it's slow.
 // in addition to receiving pendings, the first return value of this function includes only the usable balance (excluding dust)
 // this "usable balance" includes receivable amounts whose sends are confirmed
@@ -176,7 +235,7 @@ func (m *walletModule) sendFromApplicationWallet(customRep *string, destinations
 // https://docs.nano.org/integration-guides/key-management/#transaction-order-and-correctness
 func (m *walletModule) receivePendingsAndGetSendableBalance() (payment.Amount, error) {
 	balance := big.NewInt(0)
-	info, err := m.applicationWallet.RPC.AccountInfo(m.applicationAccount.Address())
+	info, err := m.applicationWallet.RPC().AccountInfo(m.applicationAccount.Address())
 	if err != nil {
 		if !strings.Contains(err.Error(), "Account not found") {
 			return payment.Amount{}, stacktrace.Propagate(err, "failed to fetch account info")
@@ -233,7 +292,7 @@ func (m *walletModule) send(call goja.FunctionCall) goja.Value {
 	}
 
 	return gojautil.DoAsync(m.appContext, m.runtime, func(actx gojautil.AsyncContext) string {
-		hashes, err := m.sendFromApplicationWallet(customRep, wallet.SendDestination{Account: destinationAddress, Amount: amount.Int})
+		hashes, err := m.sendFromApplicationWallet(customRep, node.SendDestination{Account: destinationAddress, Amount: amount.Int})
 		if err != nil {
 			panic(m.runtime.NewGoError(stacktrace.Propagate(err, "")))
 		}
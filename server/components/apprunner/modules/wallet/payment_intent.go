@@ -0,0 +1,171 @@
+package wallet
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/dop251/goja"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/components/apprunner/gojautil"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/paymentintentmanager"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/event"
+)
+
+const maxPaymentIntentMetadataSize = 4096
+
+func (m *walletModule) createPaymentIntent(call goja.FunctionCall) goja.Value {
+	if len(call.Arguments) < 2 {
+		panic(m.runtime.NewTypeError("Missing argument"))
+	}
+
+	amount, err := payment.NewAmountFromAPIString(call.Argument(0).String())
+	if err != nil || amount.Cmp(big.NewInt(0)) <= 0 {
+		panic(m.runtime.NewTypeError("Invalid amount"))
+	}
+
+	var timeoutms int64
+	err = m.runtime.ExportTo(call.Argument(1), &timeoutms)
+	if err != nil {
+		panic(m.runtime.NewTypeError("Second argument to createPaymentIntent must be an integer"))
+	}
+
+	if timeoutms < 20*1000 {
+		panic(m.runtime.NewTypeError("Second argument to createPaymentIntent must not be shorter than twenty seconds"))
+	}
+
+	if timeoutms > 7*24*60*60*1000 {
+		panic(m.runtime.NewTypeError("Second argument to createPaymentIntent must not be longer than seven days"))
+	}
+
+	var metadata interface{}
+	if len(call.Arguments) > 2 {
+		metadata = call.Argument(2).Export()
+	}
+	metadataJSON, err := sonic.Marshal(metadata)
+	if err != nil {
+		panic(m.runtime.NewTypeError("Third argument to createPaymentIntent must be serializable as JSON"))
+	}
+	if len(metadataJSON) > maxPaymentIntentMetadataSize {
+		panic(m.runtime.NewTypeError("Third argument to createPaymentIntent must not be longer than %d bytes when serialized as JSON", maxPaymentIntentMetadataSize))
+	}
+
+	expiresAt := time.Now().Add(time.Duration(timeoutms) * time.Millisecond)
+
+	return gojautil.DoAsyncWithTransformer(m.appContext, m.runtime, func(actx gojautil.AsyncContext) (*types.PaymentIntent, gojautil.PromiseResultTransformer[*types.PaymentIntent]) {
+		intent, err := m.paymentIntentManager.Create(actx, m.appContext.ApplicationID(), m.applicationAccount.Address(), amount, expiresAt, metadataJSON)
+		if err != nil {
+			panic(actx.NewGoError(stacktrace.Propagate(err, "")))
+		}
+		return intent, serializePaymentIntentForPromise
+	})
+}
+
+func (m *walletModule) getPaymentIntent(call goja.FunctionCall) goja.Value {
+	if len(call.Arguments) < 1 {
+		panic(m.runtime.NewTypeError("Missing argument"))
+	}
+	intentID := call.Argument(0).String()
+
+	return gojautil.DoAsyncWithTransformer(m.appContext, m.runtime, func(actx gojautil.AsyncContext) (*types.PaymentIntent, gojautil.PromiseResultTransformer[*types.PaymentIntent]) {
+		intent, err := m.paymentIntentManager.Get(actx, m.appContext.ApplicationID(), intentID)
+		if err != nil {
+			if errors.Is(err, paymentintentmanager.ErrPaymentIntentNotFound) {
+				return nil, serializePaymentIntentForPromise
+			}
+			panic(actx.NewGoError(stacktrace.Propagate(err, "")))
+		}
+		return intent, serializePaymentIntentForPromise
+	})
+}
+
+func (m *walletModule) cancelPaymentIntent(call goja.FunctionCall) goja.Value {
+	if len(call.Arguments) < 1 {
+		panic(m.runtime.NewTypeError("Missing argument"))
+	}
+	intentID := call.Argument(0).String()
+
+	return gojautil.DoAsyncWithTransformer(m.appContext, m.runtime, func(actx gojautil.AsyncContext) (*types.PaymentIntent, gojautil.PromiseResultTransformer[*types.PaymentIntent]) {
+		intent, err := m.paymentIntentManager.Cancel(actx, m.appContext.ApplicationID(), intentID)
+		if err != nil {
+			if errors.Is(err, paymentintentmanager.ErrPaymentIntentNotFound) {
+				panic(actx.NewTypeError("Payment intent not found"))
+			}
+			panic(actx.NewGoError(stacktrace.Propagate(err, "")))
+		}
+		return intent, serializePaymentIntentForPromise
+	})
+}
+
+func serializePaymentIntentForPromise(vm *goja.Runtime, intent *types.PaymentIntent) interface{} {
+	if intent == nil {
+		return goja.Null()
+	}
+	return serializePaymentIntent(vm, intent)
+}
+
+func serializePaymentIntent(vm *goja.Runtime, intent *types.PaymentIntent) *goja.Object {
+	var metadata interface{}
+	_ = sonic.Unmarshal(intent.Metadata, &metadata)
+
+	o := vm.NewObject()
+	o.Set("id", intent.ID)
+	o.Set("address", intent.PaymentAddress)
+	o.Set("expectedAmount", payment.NewAmountFromDecimal(intent.ExpectedAmount).SerializeForAPI())
+	o.Set("receivedAmount", payment.NewAmountFromDecimal(intent.ReceivedAmount).SerializeForAPI())
+	status := intent.Status
+	if status == types.PaymentIntentStatusConcluding {
+		// to the application, the intent remains pending until its funds reach the application wallet
+		status = types.PaymentIntentStatusPending
+	}
+	o.Set("status", string(status))
+	o.Set("metadata", metadata)
+	o.Set("createdAt", gojautil.SerializeTime(vm, intent.CreatedAt))
+	o.Set("expiresAt", gojautil.SerializeTime(vm, intent.ExpiresAt))
+	return o
+}
+
+// paymentIntentConcludedEvent is adapted into the "paymentintentconcluded" event.
+// Subscription happens when the application attaches its first listener, or resumes execution with listeners attached.
+// At that point, intents that concluded while the application had no listeners (e.g. because it was not running) are
+// delivered, and so are the ones that conclude while the subscription lasts
+type paymentIntentConcludedEvent struct {
+	event.Event[*types.PaymentIntent]
+	m *walletModule
+}
+
+func (e *paymentIntentConcludedEvent) SubscribeUsingCallback(bufferStrategy event.BufferStrategy, cbFunction func(arg *types.PaymentIntent)) func() {
+	unsubscribe := e.Event.SubscribeUsingCallback(bufferStrategy, cbFunction)
+	concludedU := e.m.paymentIntentManager.PaymentIntentConcluded().SubscribeUsingCallback(e.m.appContext.ApplicationID(), event.BufferAll, func(*types.PaymentIntent) {
+		e.m.deliverConcludedPaymentIntents()
+	})
+	go e.m.deliverConcludedPaymentIntents()
+	return func() {
+		concludedU()
+		unsubscribe()
+	}
+}
+
+func (m *walletModule) deliverConcludedPaymentIntents() {
+	m.deliveryMu.Lock()
+	defer m.deliveryMu.Unlock()
+
+	ctx := m.appContext.ExecutionContext()
+	intents, err := m.paymentIntentManager.UndeliveredConcludedIntents(ctx, m.appContext.ApplicationID())
+	if err != nil {
+		m.log.Printf("Failed to get concluded payment intents of application %s: %v", m.appContext.ApplicationID(), err)
+		return
+	}
+
+	for _, intent := range intents {
+		m.paymentIntentConcluded.Notify(intent, false)
+		err = m.paymentIntentManager.MarkAsDelivered(ctx, intent)
+		if err != nil {
+			m.log.Printf("Failed to mark payment intent %s as delivered: %v", intent.ID, err)
+			return
+		}
+	}
+}
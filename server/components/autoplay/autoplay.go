@@ -0,0 +1,316 @@
+package autoplay
+
+import (
+	"context"
+	"log"
+	"math"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/components/enqueuemanager"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// maxCandidateAttempts is the maximum number of media considered in each autoplay attempt.
+// Each attempt consumes media provider API quota
+const maxCandidateAttempts = 5
+
+// maxHistoryCandidates is the maximum number of media considered from the play history
+const maxHistoryCandidates = 200
+
+// Manager automatically enqueues media from the autoplay pools
+type Manager struct {
+	log            *log.Logger
+	channelID      string
+	mediaProviders map[types.MediaType]media.Provider
+	enqueueManager *enqueuemanager.Manager
+}
+
+// New returns a new Manager
+func New(log *log.Logger, channelID string, mediaProviders map[types.MediaType]media.Provider, enqueueManager *enqueuemanager.Manager) *Manager {
+	return &Manager{
+		log:            log,
+		channelID:      channelID,
+		mediaProviders: mediaProviders,
+		enqueueManager: enqueueManager,
+	}
+}
+
+type candidate struct {
+	mediaType types.MediaType
+	reference string
+	weight    int
+}
+
+// EnqueueNext picks a media from the autoplay pools which are currently active and enqueues it.
+// Returns false if there were no suitable media to enqueue
+func (m *Manager) EnqueueNext(ctxCtx context.Context) (bool, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	allPools, err := types.GetAutoplayPools(ctx, m.channelID)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+
+	now := time.Now()
+	pools := []*types.AutoplayPool{}
+	for _, pool := range allPools {
+		if pool.Enabled && pool.Weight > 0 && PoolActiveAt(pool, now) {
+			pools = append(pools, pool)
+		}
+	}
+
+	attempts := 0
+	for _, pool := range weightedShuffle(pools, func(p *types.AutoplayPool) int { return p.Weight }) {
+		candidates, err := m.poolCandidates(ctx, pool, now)
+		if err != nil {
+			return false, stacktrace.Propagate(err, "")
+		}
+
+		for _, c := range weightedShuffle(candidates, func(c candidate) int { return c.weight }) {
+			if attempts >= maxCandidateAttempts {
+				return false, nil
+			}
+			attempts++
+
+			enqueued, err := m.tryEnqueuing(ctx, pool, c, now)
+			if err != nil {
+				return false, stacktrace.Propagate(err, "")
+			}
+			if enqueued {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (m *Manager) poolCandidates(ctx transaction.WrappingContext, pool *types.AutoplayPool, now time.Time) ([]candidate, error) {
+	switch pool.Source {
+	case types.AutoplayPoolSourceList:
+		entries, err := types.GetAutoplayPoolEntries(ctx, pool.ID)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		candidates := make([]candidate, 0, len(entries))
+		for _, entry := range entries {
+			if entry.Weight > 0 {
+				candidates = append(candidates, candidate{
+					mediaType: entry.MediaType,
+					reference: entry.MediaReference,
+					weight:    entry.Weight,
+				})
+			}
+		}
+		return candidates, nil
+	case types.AutoplayPoolSourceHistory:
+		wellReceived, err := types.GetWellReceivedMedia(ctx, m.channelID, now.Add(-time.Duration(pool.HistoryLookback)),
+			pool.HistoryMinPlays, []types.MediaType{types.MediaTypeYouTubeVideo, types.MediaTypeSoundCloudTrack}, maxHistoryCandidates)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		candidates := make([]candidate, 0, len(wellReceived))
+		for _, wr := range wellReceived {
+			c := candidate{
+				mediaType: wr.MediaType,
+				reference: wr.MediaID,
+				weight:    wr.Plays,
+			}
+			if wr.MediaType == types.MediaTypeSoundCloudTrack {
+				c.reference = wr.Permalink
+			}
+			if c.reference != "" {
+				candidates = append(candidates, c)
+			}
+		}
+		return candidates, nil
+	default:
+		return nil, stacktrace.NewError("unknown autoplay pool source %s", pool.Source)
+	}
+}
+
+func enqueueParameters(c candidate) (proto.IsEnqueueMediaRequest_MediaInfo, error) {
+	switch c.mediaType {
+	case types.MediaTypeYouTubeVideo:
+		return &proto.EnqueueMediaRequest_YoutubeVideoData{
+			YoutubeVideoData: &proto.EnqueueYouTubeVideoData{
+				Id: c.reference,
+			},
+		}, nil
+	case types.MediaTypeSoundCloudTrack:
+		return &proto.EnqueueMediaRequest_SoundcloudTrackData{
+			SoundcloudTrackData: &proto.EnqueueSoundCloudTrackData{
+				Permalink: c.reference,
+			},
+		}, nil
+	default:
+		return nil, stacktrace.NewError("media type %s not supported by autoplay", c.mediaType)
+	}
+}
+
+func (m *Manager) tryEnqueuing(ctx transaction.WrappingContext, pool *types.AutoplayPool, c candidate, now time.Time) (bool, error) {
+	provider, ok := m.mediaProviders[c.mediaType]
+	if !ok {
+		return false, stacktrace.NewError("no provider for media type %s", c.mediaType)
+	}
+
+	parameters, err := enqueueParameters(c)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+
+	preInfo, result, err := provider.BeginEnqueueRequest(ctx, parameters)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	if result != media.EnqueueRequestCreationSucceeded {
+		m.log.Printf("Autoplay candidate %s from pool %s skipped: enqueue request creation failed with result %d", c.reference, pool.Name, result)
+		return false, nil
+	}
+
+	allowed, err := media.IsAllowed(ctx, preInfo)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	if !allowed {
+		m.log.Printf("Autoplay candidate %s from pool %s skipped: media is disallowed", c.reference, pool.Name)
+		return false, nil
+	}
+
+	if pool.Cooldown > 0 {
+		mediaType, mediaID := preInfo.MediaID()
+		lastPlays, err := types.LastPlaysOfMedia(ctx, now.Add(-time.Duration(pool.Cooldown)), mediaType, mediaID)
+		if err != nil {
+			return false, stacktrace.Propagate(err, "")
+		}
+		if len(lastPlays) > 0 {
+			return false, nil
+		}
+	}
+
+	request, result, err := provider.ContinueEnqueueRequest(ctx, preInfo, false, false, false, false, false, false)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	if result != media.EnqueueRequestCreationSucceeded {
+		m.log.Printf("Autoplay candidate %s from pool %s skipped: enqueue request creation failed with result %d", c.reference, pool.Name, result)
+		return false, nil
+	}
+
+	ticket, err := m.enqueueManager.RegisterRequest(ctx, request, true)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	ticket.ForceEnqueuing(proto.ForcedTicketEnqueueType_ENQUEUE)
+	m.log.Printf("Autoplay enqueued %s \"%s\" from pool %s", c.reference, preInfo.Title(), pool.Name)
+	return true, nil
+}
+
+// PoolActiveAt returns whether the given time falls within the active period of the pool
+func PoolActiveAt(pool *types.AutoplayPool, t time.Time) bool {
+	if pool.ActiveFrom == pool.ActiveUntil {
+		return true
+	}
+	t = t.UTC()
+	timeOfDay := types.Duration(t.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)))
+	if pool.ActiveFrom < pool.ActiveUntil {
+		return timeOfDay >= pool.ActiveFrom && timeOfDay < pool.ActiveUntil
+	}
+	// active period wraps around midnight
+	return timeOfDay >= pool.ActiveFrom || timeOfDay < pool.ActiveUntil
+}
+
+// weightedShuffle returns the items in a random order where items with a greater weight are more likely to appear first
+func weightedShuffle[T any](items []T, weight func(T) int) []T {
+	type keyedItem struct {
+		item T
+		key  float64
+	}
+	keyed := make([]keyedItem, len(items))
+	for i, item := range items {
+		// Efraimidis-Spirakis weighted random sampling
+		keyed[i] = keyedItem{
+			item: item,
+			key:  math.Pow(rand.Float64(), 1/float64(weight(item))),
+		}
+	}
+	sort.Slice(keyed, func(i, j int) bool {
+		return keyed[i].key > keyed[j].key
+	})
+	result := make([]T, len(keyed))
+	for i := range keyed {
+		result[i] = keyed[i].item
+	}
+	return result
+}
+
+// ImportLegacyList creates a pool with the YouTube videos listed in the specified file (one video ID at the start of
+// each line), as long as the channel has no autoplay pools yet
+func (m *Manager) ImportLegacyList(ctxCtx context.Context, filename string) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	pools, err := types.GetAutoplayPools(ctx, m.channelID)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if len(pools) > 0 {
+		return nil
+	}
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return stacktrace.Propagate(err, "error reading auto enqueue videos from file")
+	}
+
+	pool := &types.AutoplayPool{
+		ID:        uuid.NewV4().String(),
+		ChannelID: m.channelID,
+		Name:      "Imported auto-enqueue list",
+		Source:    types.AutoplayPoolSourceList,
+		Enabled:   true,
+		Weight:    1,
+		UpdatedAt: time.Now(),
+	}
+	err = pool.Update(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	entries := []*types.AutoplayPoolEntry{}
+	for _, line := range strings.Split(strings.TrimSpace(string(b)), "\n") {
+		id := strings.TrimSpace(strings.Split(line, " ")[0])
+		if id == "" {
+			continue
+		}
+		entries = append(entries, &types.AutoplayPoolEntry{
+			ID:             uuid.NewV4().String(),
+			PoolID:         pool.ID,
+			MediaType:      types.MediaTypeYouTubeVideo,
+			MediaReference: id,
+			Weight:         1,
+		})
+	}
+	err = types.Update(ctx, entries...)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	m.log.Printf("Imported %d videos from the auto-enqueue list into a new autoplay pool", len(entries))
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
@@ -0,0 +1,43 @@
+package autoplay_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tnyim/jungletv/server/components/autoplay"
+	"github.com/tnyim/jungletv/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolActiveAt(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, time.March, 5, hour, minute, 0, 0, time.UTC)
+	}
+
+	allDay := &types.AutoplayPool{}
+	require.True(t, autoplay.PoolActiveAt(allDay, at(0, 0)))
+	require.True(t, autoplay.PoolActiveAt(allDay, at(23, 59)))
+
+	daytime := &types.AutoplayPool{
+		ActiveFrom:  types.Duration(9 * time.Hour),
+		ActiveUntil: types.Duration(17 * time.Hour),
+	}
+	require.False(t, autoplay.PoolActiveAt(daytime, at(8, 59)))
+	require.True(t, autoplay.PoolActiveAt(daytime, at(9, 0)))
+	require.True(t, autoplay.PoolActiveAt(daytime, at(16, 59)))
+	require.False(t, autoplay.PoolActiveAt(daytime, at(17, 0)))
+
+	overnight := &types.AutoplayPool{
+		ActiveFrom:  types.Duration(22 * time.Hour),
+		ActiveUntil: types.Duration(2 * time.Hour),
+	}
+	require.True(t, autoplay.PoolActiveAt(overnight, at(23, 0)))
+	require.True(t, autoplay.PoolActiveAt(overnight, at(1, 30)))
+	require.False(t, autoplay.PoolActiveAt(overnight, at(2, 0)))
+	require.False(t, autoplay.PoolActiveAt(overnight, at(12, 0)))
+
+	// times are compared in UTC regardless of the location of the given time
+	lisbonSummer := time.FixedZone("WEST", 3600)
+	require.True(t, autoplay.PoolActiveAt(daytime, time.Date(2024, time.July, 5, 17, 30, 0, 0, lisbonSummer)))
+}
@@ -15,10 +15,10 @@ import (
 	"github.com/tnyim/jungletv/proto"
 	"github.com/tnyim/jungletv/server/auth"
 	"github.com/tnyim/jungletv/server/components/mediaqueue"
-	"github.com/tnyim/jungletv/server/components/nanswapclient"
 	"github.com/tnyim/jungletv/server/components/payment"
 	"github.com/tnyim/jungletv/server/components/pointsmanager"
 	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/server/components/refundmanager"
 	"github.com/tnyim/jungletv/server/components/rewards"
 	"github.com/tnyim/jungletv/server/media"
 	"github.com/tnyim/jungletv/server/stores/moderation"
@@ -39,6 +39,7 @@ type Manager struct {
 	pricer                             *pricer.Pricer
 	paymentAccountPool                 *payment.PaymentAccountPool
 	rewardsHandler                     *rewards.Handler
+	refundManager                      *refundmanager.Manager
 	pointsManager                      *pointsmanager.Manager
 	log                                *log.Logger
 	moderationStore                    moderation.Store
@@ -56,6 +57,9 @@ type EnqueueTicket interface {
 	ID() string
 	CreatedAt() time.Time
 	RequestedBy() auth.User
+	// ActionableMediaInfos returns the information of all the media enqueued by this ticket.
+	// Tickets for collections of media contain more than one element, the first of which is returned by ActionableMediaInfo
+	ActionableMediaInfos() []media.ActionableInfo
 	PaymentAddress() string
 	SerializeForAPI() *proto.EnqueueMediaTicket
 	RequestPricing() pricer.EnqueuePricing
@@ -65,6 +69,8 @@ type EnqueueTicket interface {
 	StatusChanged() event.NoArgEvent
 	ForceEnqueuing(proto.ForcedTicketEnqueueType)
 	EnqueuingForced() (bool, proto.ForcedTicketEnqueueType)
+	ForceScheduling(plannedStart time.Time, mode types.MediaQueueSchedulingMode)
+	SchedulingForced() (bool, time.Time, types.MediaQueueSchedulingMode)
 }
 
 // New returns a new Manager
@@ -76,6 +82,7 @@ func New(
 	pricer *pricer.Pricer,
 	paymentAccountPool *payment.PaymentAccountPool,
 	rewardsHandler *rewards.Handler,
+	refundManager *refundmanager.Manager,
 	pointsManager *pointsmanager.Manager,
 	moderationStore moderation.Store,
 	modLogWebhook api.WebhookClient) (*Manager, error) {
@@ -87,6 +94,7 @@ func New(
 		pricer:                  pricer,
 		paymentAccountPool:      paymentAccountPool,
 		rewardsHandler:          rewardsHandler,
+		refundManager:           refundManager,
 		pointsManager:           pointsManager,
 		requests:                make(map[string]EnqueueTicket),
 		moderationStore:         moderationStore,
@@ -104,7 +112,29 @@ func (e *Manager) SetNewQueueEntriesAlwaysUnskippableForFree(enabled bool) {
 }
 
 func (e *Manager) RegisterRequest(ctx context.Context, request media.EnqueueRequest, forceAnonymous bool) (EnqueueTicket, error) {
-	pricing := e.pricer.ComputeEnqueuePricing(request.ActionableMediaInfo().Length(), request.Unskippable(), request.Concealed())
+	ticket, err := e.RegisterBatchRequest(ctx, []media.EnqueueRequest{request}, forceAnonymous)
+	return ticket, stacktrace.Propagate(err, "")
+}
+
+// RegisterBatchRequest creates a single ticket for enqueuing multiple media, which are enqueued in the specified order
+// once the ticket is paid. All requests must have the same requester and the same unskippable and concealed settings
+func (e *Manager) RegisterBatchRequest(ctx context.Context, requests []media.EnqueueRequest, forceAnonymous bool) (EnqueueTicket, error) {
+	if len(requests) == 0 {
+		return nil, stacktrace.NewError("batch contains no requests")
+	}
+	request := requests[0]
+
+	mediaInfos := make([]media.ActionableInfo, len(requests))
+	durations := make([]time.Duration, len(requests))
+	for i, r := range requests {
+		if r.Unskippable() != request.Unskippable() || r.Concealed() != request.Concealed() {
+			return nil, stacktrace.NewError("requests in batch have different settings")
+		}
+		mediaInfos[i] = r.ActionableMediaInfo()
+		durations[i] = mediaInfos[i].Length()
+	}
+
+	pricing, itemPricing := e.pricer.ComputeBatchEnqueuePricing(durations, request.Unskippable(), request.Concealed())
 
 	amounts := []payment.Amount{
 		pricing.EnqueuePrice,
@@ -112,7 +142,7 @@ func (e *Manager) RegisterRequest(ctx context.Context, request media.EnqueueRequ
 		pricing.PlayNowPrice,
 	}
 
-	paymentReceiver, err := e.paymentAccountPool.ReceiveMulticurrencyPayment(ctx, amounts, []nanswapclient.Ticker{nanswapclient.TickerNano}, TicketExpiration)
+	paymentReceiver, err := e.paymentAccountPool.ReceiveMulticurrencyPayment(ctx, amounts, []payment.Currency{payment.CurrencyNano}, TicketExpiration)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
@@ -125,11 +155,12 @@ func (e *Manager) RegisterRequest(ctx context.Context, request media.EnqueueRequ
 		id:              uuid.NewV4().String(),
 		createdAt:       time.Now(),
 		requestedBy:     request.RequestedBy(),
-		mediaInfo:       request.ActionableMediaInfo(),
+		mediaInfos:      mediaInfos,
 		unskippable:     request.Unskippable(),
 		concealed:       request.Concealed(),
 		forceAnonymous:  forceAnonymous,
 		pricing:         pricing,
+		itemPricing:     itemPricing,
 		paymentReceiver: paymentReceiver,
 		statusChanged:   event.NewNoArg(),
 	}
@@ -157,12 +188,25 @@ func (e *Manager) GetTicket(id string) EnqueueTicket {
 	return nil
 }
 
-func (e *Manager) tryEnqueuingTicket(ctx context.Context, balance payment.Amount, senderAmount payment.Amount, senderCurrency nanswapclient.Ticker, ticket *ticket) error {
+// revertTicketPayment reverts the payment of a ticket that can't be enqueued and records it in the refund ledger
+func (e *Manager) revertTicketPayment(ctx context.Context, ticket *ticket, refundAddress string, amount payment.Amount, comment string) error {
+	revertErr := ticket.paymentReceiver.Revert(refundAddress)
+	_, err := e.refundManager.RecordRevert(ctx, refundAddress, amount, comment,
+		refundmanager.Origin{TicketID: ticket.ID()}, revertErr)
+	if err != nil {
+		e.log.Printf("Failed to record revert of ticket %s in refund ledger: %v", ticket.ID(), err)
+	}
+	return stacktrace.Propagate(revertErr, "")
+}
+
+func (e *Manager) tryEnqueuingTicket(ctx context.Context, paymentArgs payment.PaymentReceivedEventArgs, ticket *ticket) error {
 	if ticket.Status() == proto.EnqueueMediaTicketStatus_PAID {
 		return nil
 	}
+	balance, senderAmount, senderCurrency := paymentArgs.Balance, paymentArgs.SenderAmount, paymentArgs.SenderCurrency
 	pricing := ticket.RequestPricing()
 	forceEnqueuing, forcedEnqueuingType := ticket.EnqueuingForced()
+	forceScheduling, plannedStart, schedulingMode := ticket.SchedulingForced()
 
 	var mcpd *payment.MulticurrencyPaymentData
 
@@ -173,19 +217,30 @@ func (e *Manager) tryEnqueuingTicket(ctx context.Context, balance payment.Amount
 		}
 	}
 
-	var playFn func(media.QueueEntry)
-	if balance.Cmp(pricing.PlayNowPrice.Int) >= 0 ||
+	var playFn func([]media.QueueEntry)
+	if forceScheduling {
+		playFn = func(entries []media.QueueEntry) {
+			for _, entry := range entries {
+				e.mediaQueue.Schedule(entry, plannedStart, schedulingMode)
+				plannedStart = plannedStart.Add(entry.MediaInfo().Length())
+			}
+		}
+	} else if balance.Cmp(pricing.PlayNowPrice.Int) >= 0 ||
 		(mcpd != nil && mcpd.ExpectedAmounts[2].Cmp(big.NewInt(0)) > 0 && senderAmount.Cmp(mcpd.ExpectedAmounts[2].Int) >= 0) ||
 		(forceEnqueuing && forcedEnqueuingType == proto.ForcedTicketEnqueueType_PLAY_NOW) {
-		playFn = e.mediaQueue.PlayNow
+		playFn = e.mediaQueue.PlayNowMany
 	} else if balance.Cmp(pricing.PlayAfterCurrentPrice.Int) >= 0 ||
 		(mcpd != nil && mcpd.ExpectedAmounts[1].Cmp(big.NewInt(0)) > 0 && senderAmount.Cmp(mcpd.ExpectedAmounts[1].Int) >= 0) ||
 		(forceEnqueuing && forcedEnqueuingType == proto.ForcedTicketEnqueueType_PLAY_NEXT) {
-		playFn = e.mediaQueue.PlayAfterCurrent
+		playFn = e.mediaQueue.PlayAfterCurrentMany
 	} else if balance.Cmp(pricing.EnqueuePrice.Int) >= 0 ||
 		(mcpd != nil && mcpd.ExpectedAmounts[0].Cmp(big.NewInt(0)) > 0 && senderAmount.Cmp(mcpd.ExpectedAmounts[0].Int) >= 0) ||
 		(forceEnqueuing && forcedEnqueuingType == proto.ForcedTicketEnqueueType_ENQUEUE) {
-		playFn = e.mediaQueue.Enqueue
+		playFn = func(entries []media.QueueEntry) {
+			for _, entry := range entries {
+				e.mediaQueue.Enqueue(entry)
+			}
+		}
 	} else {
 		// yet to receive enough money
 		return nil
@@ -207,7 +262,7 @@ func (e *Manager) tryEnqueuingTicket(ctx context.Context, balance payment.Amount
 				return nil
 			}
 			// revert all transactions that came from the banned address
-			err = ticket.paymentReceiver.Revert(requestedByStr)
+			err = e.revertTicketPayment(ctx, ticket, requestedByStr, balance, "Requester is banned from enqueuing")
 			return stacktrace.Propagate(err, "")
 		}
 	}
@@ -228,30 +283,50 @@ func (e *Manager) tryEnqueuingTicket(ctx context.Context, balance payment.Amount
 				return nil
 			}
 			// refund the paid amount
-			err = ticket.paymentReceiver.Revert(requestedByStr)
+			err = e.revertTicketPayment(ctx, ticket, requestedByStr, balance, "Insufficient points balance for concealed entry")
 			return stacktrace.Propagate(err, "")
 		}
 	}
 
-	mi := ticket.ActionableMediaInfo()
-	playFn(mi.ProduceMediaQueueEntry(requestedBy, balance,
-		ticket.Unskippable() || e.newEntriesAlwaysUnskippableForFree,
-		ticket.Concealed(), ticket.ID()))
+	mediaInfos := ticket.ActionableMediaInfos()
+	costs := ticket.splitCost(balance)
+	receivedNatively := paymentArgs.ReceivedCurrency != "" && paymentArgs.ReceivedCurrency != payment.HomeCurrency
+	var costsInCurrency []payment.Amount
+	if receivedNatively {
+		costsInCurrency = ticket.splitCost(senderAmount)
+	}
+	entries := make([]media.QueueEntry, len(mediaInfos))
+	for i, mi := range mediaInfos {
+		// the first entry takes the ID of the ticket, like in tickets for a single media
+		entryID := ticket.ID()
+		if i > 0 {
+			entryID = uuid.NewV4().String()
+		}
+		entries[i] = mi.ProduceMediaQueueEntry(requestedBy, costs[i],
+			ticket.Unskippable() || e.newEntriesAlwaysUnskippableForFree,
+			ticket.Concealed(), entryID)
+		if receivedNatively {
+			entries[i].SetRequestCostInCurrency(paymentArgs.ReceivedCurrency, costsInCurrency[i])
+		}
+	}
+	playFn(entries)
 
 	err := ticket.SetPaid()
 	if err != nil {
 		return stacktrace.Propagate(err, "failed to set ticket %v as paid", ticket.ID())
 	}
 
-	_, mediaID := mi.MediaID()
-	e.log.Printf("Enqueued ticket %s (p.a. %s) - video \"%s\" (%s) with length %s - requested by %s with cost %s",
-		ticket.ID(),
-		ticket.PaymentAddress(),
-		mi.Title(),
-		mediaID,
-		mi.Length().String(),
-		requestedByStr,
-		balance.String())
+	for i, mi := range mediaInfos {
+		_, mediaID := mi.MediaID()
+		e.log.Printf("Enqueued ticket %s (p.a. %s) - video \"%s\" (%s) with length %s - requested by %s with cost %s",
+			ticket.ID(),
+			ticket.PaymentAddress(),
+			mi.Title(),
+			mediaID,
+			mi.Length().String(),
+			requestedByStr,
+			costs[i].String())
+	}
 
 	return nil
 }
@@ -341,11 +416,14 @@ type ticket struct {
 	forceAnonymous           bool
 	requestedBy              auth.User
 	createdAt                time.Time
-	mediaInfo                media.ActionableInfo
+	mediaInfos               []media.ActionableInfo
 	paymentReceiver          payment.PaymentReceiver
 	pricing                  pricer.EnqueuePricing
+	itemPricing              []pricer.EnqueuePricing
 	statusChanged            event.NoArgEvent
 	forceEnqueuing           *proto.ForcedTicketEnqueueType
+	forceSchedulingAt        time.Time
+	forceSchedulingMode      types.MediaQueueSchedulingMode
 }
 
 func (t *ticket) Unskippable() bool {
@@ -357,7 +435,29 @@ func (t *ticket) Concealed() bool {
 }
 
 func (t *ticket) ActionableMediaInfo() media.ActionableInfo {
-	return t.mediaInfo
+	return t.mediaInfos[0]
+}
+
+func (t *ticket) ActionableMediaInfos() []media.ActionableInfo {
+	return t.mediaInfos
+}
+
+// splitCost splits the amount paid for the ticket among its media, proportionally to the enqueue price of each media
+func (t *ticket) splitCost(balance payment.Amount) []payment.Amount {
+	costs := make([]payment.Amount, len(t.mediaInfos))
+	remaining := payment.NewAmount(balance.Int)
+	for i := range costs {
+		if i == len(costs)-1 || t.pricing.EnqueuePrice.Sign() == 0 {
+			costs[i] = remaining
+			remaining = payment.NewAmount()
+			continue
+		}
+		costs[i] = payment.NewAmount()
+		costs[i].Mul(balance.Int, t.itemPricing[i].EnqueuePrice.Int)
+		costs[i].Div(costs[i].Int, t.pricing.EnqueuePrice.Int)
+		remaining.Sub(remaining.Int, costs[i].Int)
+	}
+	return costs
 }
 
 func (t *ticket) ID() string {
@@ -405,10 +505,38 @@ func (t *ticket) SerializeForAPI() *proto.EnqueueMediaTicket {
 
 		serialized.ExtraCurrencyPaymentData = append(serialized.ExtraCurrencyPaymentData, protoData)
 	}
-	t.mediaInfo.FillAPITicketMediaInfo(serialized)
+	t.mediaInfos[0].FillAPITicketMediaInfo(serialized)
+	if len(t.mediaInfos) > 1 {
+		for _, mi := range t.mediaInfos {
+			serialized.Items = append(serialized.Items, serializeTicketItemForAPI(mi))
+		}
+	}
 	return serialized
 }
 
+func serializeTicketItemForAPI(mediaInfo media.ActionableInfo) *proto.EnqueueMediaTicketItem {
+	// reuse the ticket serialization of each media type, to avoid having to touch every media provider
+	t := &proto.EnqueueMediaTicket{}
+	mediaInfo.FillAPITicketMediaInfo(t)
+	item := &proto.EnqueueMediaTicketItem{
+		Length: t.Length,
+		Offset: t.Offset,
+	}
+	switch m := t.MediaInfo.(type) {
+	case *proto.EnqueueMediaTicket_YoutubeVideoData:
+		item.MediaInfo = &proto.EnqueueMediaTicketItem_YoutubeVideoData{YoutubeVideoData: m.YoutubeVideoData}
+	case *proto.EnqueueMediaTicket_SoundcloudTrackData:
+		item.MediaInfo = &proto.EnqueueMediaTicketItem_SoundcloudTrackData{SoundcloudTrackData: m.SoundcloudTrackData}
+	case *proto.EnqueueMediaTicket_DocumentData:
+		item.MediaInfo = &proto.EnqueueMediaTicketItem_DocumentData{DocumentData: m.DocumentData}
+	case *proto.EnqueueMediaTicket_DirectMediaData:
+		item.MediaInfo = &proto.EnqueueMediaTicketItem_DirectMediaData{DirectMediaData: m.DirectMediaData}
+	case *proto.EnqueueMediaTicket_LibraryMediaData:
+		item.MediaInfo = &proto.EnqueueMediaTicketItem_LibraryMediaData{LibraryMediaData: m.LibraryMediaData}
+	}
+	return item
+}
+
 func (t *ticket) RequestPricing() pricer.EnqueuePricing {
 	return t.pricing
 }
@@ -460,6 +588,15 @@ func (t *ticket) EnqueuingForced() (bool, proto.ForcedTicketEnqueueType) {
 	return false, 0
 }
 
+func (t *ticket) ForceScheduling(plannedStart time.Time, mode types.MediaQueueSchedulingMode) {
+	t.forceSchedulingAt = plannedStart
+	t.forceSchedulingMode = mode
+}
+
+func (t *ticket) SchedulingForced() (bool, time.Time, types.MediaQueueSchedulingMode) {
+	return !t.forceSchedulingAt.IsZero(), t.forceSchedulingAt, t.forceSchedulingMode
+}
+
 func (t *ticket) worker(ctx context.Context, e *Manager) {
 	defer e.cleanupTicket(t)
 
@@ -483,9 +620,21 @@ func (t *ticket) worker(ctx context.Context, e *Manager) {
 	onStatusChanged, onStatusChangedUnsub := t.statusChanged.Subscribe(event.BufferFirst)
 	defer onStatusChangedUnsub()
 
-	lastSeenBalance := payment.NewAmount()
-	lastSeenSenderAmount := payment.NewAmount()
-	var lastSeenSenderCurrency nanswapclient.Ticker
+	for _, cData := range t.paymentReceiver.MulticurrencyPaymentData() {
+		if cData.OrderID != "" {
+			continue
+		}
+		e.log.Printf("Ticket %s (p.a. %s) accepts currency %s natively with payment address %s",
+			t.ID(),
+			t.PaymentAddress(),
+			cData.Currency,
+			cData.PaymentAddress)
+	}
+
+	lastSeenPayment := payment.PaymentReceivedEventArgs{
+		Balance:      payment.NewAmount(),
+		SenderAmount: payment.NewAmount(),
+	}
 	for {
 		var err error
 		select {
@@ -495,9 +644,14 @@ func (t *ticket) worker(ctx context.Context, e *Manager) {
 			if ok {
 				t.statusChanged.Notify(false)
 				for _, cData := range data {
-					e.log.Printf("Ticket %s (p.a. %s) has Nanswap order ID %s for currency %s with payment address %s",
+					if cData.OrderID == "" {
+						// received natively, logged when the worker started
+						continue
+					}
+					e.log.Printf("Ticket %s (p.a. %s) has %s order ID %s for currency %s with payment address %s",
 						t.ID(),
 						t.PaymentAddress(),
+						cData.SwapProvider.Name(),
 						cData.OrderID,
 						cData.Currency,
 						cData.PaymentAddress)
@@ -518,12 +672,10 @@ func (t *ticket) worker(ctx context.Context, e *Manager) {
 			if (t.requestedBy == nil || t.requestedBy.IsUnknown()) && !t.forceAnonymous {
 				t.requestedBy = auth.NewAddressOnlyUser(paymentArgs.From)
 			}
-			lastSeenBalance = paymentArgs.Balance
-			lastSeenSenderAmount = paymentArgs.SenderAmount
-			lastSeenSenderCurrency = paymentArgs.SenderCurrency
-			err = e.tryEnqueuingTicket(ctx, paymentArgs.Balance, paymentArgs.SenderAmount, paymentArgs.SenderCurrency, t)
+			lastSeenPayment = paymentArgs
+			err = e.tryEnqueuingTicket(ctx, paymentArgs, t)
 		case <-checkForcedEnqueuingTicker.C:
-			err = e.tryEnqueuingTicket(ctx, lastSeenBalance, lastSeenSenderAmount, lastSeenSenderCurrency, t)
+			err = e.tryEnqueuingTicket(ctx, lastSeenPayment, t)
 		}
 		if err != nil {
 			e.log.Println(stacktrace.Propagate(err, "failed to enqueue ticket %s, now terminating ticket worker", t.id))
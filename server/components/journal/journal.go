@@ -0,0 +1,340 @@
+package journal
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hectorchu/gonano/rpc"
+	"github.com/palantir/stacktrace"
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/server/components/node"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/event"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// Journal records the movements of funds performed by the payment account pool in the double-entry journal, and
+// reconciles the journal against the ledger and the database.
+// Components that move funds within database transactions record their movements directly, using
+// types.RecordJournalEntry.
+// Application wallets are not tracked: funds collected into them leave our custody, and funds they send to the default
+// collector account enter it
+type Journal struct {
+	log                *log.Logger
+	rpcClient          node.RPC
+	paymentAccountPool *payment.PaymentAccountPool
+}
+
+// Discrepancy is a journal account whose balance does not match reality.
+// For liability accounts, the amounts are the ones owed, i.e. the negated journal balance
+type Discrepancy struct {
+	Account  types.JournalAccount
+	Expected payment.Amount
+	Actual   payment.Amount
+}
+
+// Report is the result of a reconciliation
+type Report struct {
+	CheckedAt       time.Time
+	CheckedAccounts int
+	Discrepancies   []Discrepancy
+}
+
+// New returns a new initialized Journal
+func New(log *log.Logger, rpcClient node.RPC, paymentAccountPool *payment.PaymentAccountPool) *Journal {
+	return &Journal{
+		log:                log,
+		rpcClient:          rpcClient,
+		paymentAccountPool: paymentAccountPool,
+	}
+}
+
+// Worker records the movements of funds reported by the payment account pool
+func (j *Journal) Worker(ctx context.Context) error {
+	onFundsMoved, fundsMovedU := j.paymentAccountPool.FundsMoved().Subscribe(event.BufferAll)
+	defer fundsMovedU()
+
+	onLatePaymentDetected, latePaymentDetectedU := j.paymentAccountPool.LatePaymentDetected().Subscribe(event.BufferAll)
+	defer latePaymentDetectedU()
+
+	for {
+		select {
+		case args := <-onFundsMoved:
+			err := j.recordFundsMovement(ctx, args)
+			if err != nil {
+				j.log.Printf("Failed to record %s funds movement %s in the journal: %v", args.Type, args.BlockHash, err)
+			}
+		case args := <-onLatePaymentDetected:
+			err := j.recordLatePayment(ctx, args)
+			if err != nil {
+				j.log.Printf("Failed to record late payment %s in the journal: %v", args.BlockHash, err)
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (j *Journal) recordFundsMovement(ctxCtx context.Context, args payment.FundsMovedEventArgs) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	var description string
+	var lines []*types.JournalLine
+	amount := args.Amount.Decimal()
+	switch args.Type {
+	case payment.FundsMovementTypeReceived:
+		// payments are reported again when a payment flow is resumed after a restart
+		recorded, err := types.JournalHasEntryWithReference(ctx, args.BlockHash)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		if recorded {
+			return nil
+		}
+		description = fmt.Sprintf("Payment from %s received by payment account %s", args.From, args.To)
+		lines = types.JournalTransfer(types.JournalAccountEquity, types.JournalAccountOnChain(args.To), amount)
+	case payment.FundsMovementTypeCollected:
+		description = fmt.Sprintf("Payment account %s emptied into %s", args.From, args.To)
+		to := types.JournalAccountEquity
+		if args.To == j.paymentAccountPool.DefaultCollectorAccountAddress() {
+			to = types.JournalAccountOnChain(args.To)
+		}
+		lines = types.JournalTransfer(types.JournalAccountOnChain(args.From), to, amount)
+	case payment.FundsMovementTypeConverted:
+		// the journal only tracks the home currency: the converted funds enter it as they reach the collector account
+		description = fmt.Sprintf("Funds in %s swapped into %s", args.From, args.To)
+		lines = types.JournalTransfer(types.JournalAccountEquity, types.JournalAccountOnChain(args.To), amount)
+	case payment.FundsMovementTypeReverted:
+		description = fmt.Sprintf("Payments received by payment account %s reverted to %s", args.From, args.To)
+		lines = types.JournalTransfer(types.JournalAccountOnChain(args.From), types.JournalAccountEquity, amount)
+	default:
+		return stacktrace.NewError("unknown funds movement type %s", args.Type)
+	}
+
+	err = types.RecordJournalEntry(ctx, description, args.BlockHash, lines...)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
+
+func (j *Journal) recordLatePayment(ctxCtx context.Context, args payment.LatePaymentEventArgs) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	// the same late payment is reported again if the server restarts before it is received
+	recorded, err := types.JournalHasEntryWithReference(ctx, args.BlockHash)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if recorded {
+		return nil
+	}
+
+	err = types.RecordJournalEntry(ctx,
+		fmt.Sprintf("Late payment from %s received by payment account %s", args.From, args.PaymentAccount),
+		args.BlockHash,
+		types.JournalTransfer(types.JournalAccountEquity, types.JournalAccountOnChain(args.PaymentAccount), args.Amount.Decimal())...)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// RecordOpeningBalances records the current balances of the specified Banano accounts, of the reward balances and of
+// the pending withdrawals, if the journal is still empty. It should be called on startup, before any funds move
+func (j *Journal) RecordOpeningBalances(ctxCtx context.Context, addresses []string) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	empty, err := types.JournalIsEmpty(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if !empty {
+		return nil
+	}
+
+	rewardBalances, err := types.GetNonZeroRewardBalances(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	pendingWithdrawals, err := types.GetPendingWithdrawals(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	lines, err := j.OpeningBalanceLines(addresses, rewardBalances, pendingWithdrawals)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = types.RecordJournalEntry(ctx, "Opening balances", "", lines...)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	j.log.Printf("Recorded opening balances of %d accounts in the journal", len(lines))
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// OpeningBalanceLines returns the lines of the opening balances entry for the specified Banano accounts, reward
+// balances and pending withdrawals. The difference between assets and liabilities is credited to the equity account
+func (j *Journal) OpeningBalanceLines(addresses []string, rewardBalances []*types.RewardBalance, pendingWithdrawals []*types.PendingWithdrawal) ([]*types.JournalLine, error) {
+	chainBalances, err := j.onChainBalances(addresses)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	total := decimal.Zero
+	lines := []*types.JournalLine{}
+	for address, balance := range chainBalances {
+		amount := balance.Decimal()
+		lines = append(lines, &types.JournalLine{Account: types.JournalAccountOnChain(address), Amount: amount})
+		total = total.Add(amount)
+	}
+	for _, balance := range rewardBalances {
+		lines = append(lines, &types.JournalLine{Account: types.JournalAccountRewardBalance(balance.RewardsAddress), Amount: balance.Balance.Neg()})
+		total = total.Sub(balance.Balance)
+	}
+	for _, pending := range pendingWithdrawals {
+		lines = append(lines, &types.JournalLine{Account: types.JournalAccountPendingWithdrawal(pending.RewardsAddress), Amount: pending.Amount.Neg()})
+		total = total.Sub(pending.Amount)
+	}
+
+	lines = append(lines, &types.JournalLine{Account: types.JournalAccountEquity, Amount: total.Neg()})
+	return lines, nil
+}
+
+// Reconcile compares the balances of the journal accounts against the balances on the ledger and in the database.
+// Funds stuck in payment accounts show up as a surplus in their on-chain accounts, and withdrawals that never made it
+// to the ledger show up as a surplus in the collector account.
+// Discrepancies may also appear transiently, while funds are in flight
+func (j *Journal) Reconcile(ctxCtx context.Context) (*Report, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	journalBalances, err := types.GetJournalAccountBalances(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	rewardBalances, err := types.GetNonZeroRewardBalances(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	pendingWithdrawals, err := types.GetPendingWithdrawals(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	report, err := j.ReconcileBalances(journalBalances, rewardBalances, pendingWithdrawals)
+	return report, stacktrace.Propagate(err, "")
+}
+
+// ReconcileBalances compares the specified balances of the journal accounts against the specified reward balances and
+// pending withdrawals, and against the balances on the ledger
+func (j *Journal) ReconcileBalances(journalBalances map[types.JournalAccount]decimal.Decimal, rewardBalances []*types.RewardBalance, pendingWithdrawals []*types.PendingWithdrawal) (*Report, error) {
+	report := &Report{
+		CheckedAt: time.Now(),
+	}
+
+	// liabilities are expected to match the database
+	actualLiabilities := make(map[types.JournalAccount]decimal.Decimal)
+	for _, balance := range rewardBalances {
+		actualLiabilities[types.JournalAccountRewardBalance(balance.RewardsAddress)] = balance.Balance
+	}
+	for _, pending := range pendingWithdrawals {
+		actualLiabilities[types.JournalAccountPendingWithdrawal(pending.RewardsAddress)] = pending.Amount
+	}
+
+	addresses := []string{}
+	for account, balance := range journalBalances {
+		if address, ok := account.OnChainAddress(); ok {
+			addresses = append(addresses, address)
+			continue
+		}
+		_, isRewardBalance := account.RewardBalanceAddress()
+		_, isPendingWithdrawal := account.PendingWithdrawalAddress()
+		if isRewardBalance || isPendingWithdrawal {
+			report.check(account, balance.Neg(), actualLiabilities[account])
+			delete(actualLiabilities, account)
+		}
+	}
+	for account, actual := range actualLiabilities {
+		// liabilities that never went through the journal
+		report.check(account, decimal.Zero, actual)
+	}
+
+	chainBalances, err := j.onChainBalances(addresses)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	for _, address := range addresses {
+		account := types.JournalAccountOnChain(address)
+		report.check(account, journalBalances[account], chainBalances[address].Decimal())
+	}
+
+	sort.Slice(report.Discrepancies, func(i, k int) bool {
+		return report.Discrepancies[i].Account < report.Discrepancies[k].Account
+	})
+	return report, nil
+}
+
+func (r *Report) check(account types.JournalAccount, expected, actual decimal.Decimal) {
+	r.CheckedAccounts++
+	if !expected.Equal(actual) {
+		r.Discrepancies = append(r.Discrepancies, Discrepancy{
+			Account:  account,
+			Expected: payment.NewAmountFromDecimal(expected),
+			Actual:   payment.NewAmountFromDecimal(actual),
+		})
+	}
+}
+
+// onChainBalances returns the balance of each of the specified accounts, including receivables that are not dust
+func (j *Journal) onChainBalances(addresses []string) (map[string]payment.Amount, error) {
+	result := make(map[string]payment.Amount, len(addresses))
+	if len(addresses) == 0 {
+		return result, nil
+	}
+
+	// like the payment account pool, receivables below the dust threshold are not considered
+	pendings, err := j.rpcClient.AccountsPending(addresses, -1, &rpc.RawAmount{Int: *pricer.DustThreshold})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to get receivables")
+	}
+
+	for _, address := range addresses {
+		balance := payment.NewAmount()
+		// we use AccountInfo instead of balance because we want the balance including unconfirmed blocks
+		info, err := j.rpcClient.AccountInfo(address)
+		if err != nil {
+			if !strings.Contains(err.Error(), "Account not found") {
+				return nil, stacktrace.Propagate(err, "failed to get balance of account %s", address)
+			}
+		} else {
+			balance.Add(balance.Int, &info.Balance.Int)
+		}
+		for _, pending := range pendings[address] {
+			balance.Add(balance.Int, &pending.Amount.Int)
+		}
+		result[address] = balance
+	}
+	return result, nil
+}
@@ -0,0 +1,148 @@
+package journal_test
+
+import (
+	"io"
+	"log"
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/server/components/journal"
+	"github.com/tnyim/jungletv/server/components/node"
+	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func bananos(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), pricer.BananoUnit)
+}
+
+func bananosDecimal(n int64) decimal.Decimal {
+	return decimal.NewFromBigInt(bananos(n), 0)
+}
+
+// ledger keeps the journal balances in memory, as the database would
+type ledger map[types.JournalAccount]decimal.Decimal
+
+func (l ledger) record(t *testing.T, description string, lines ...*types.JournalLine) {
+	_, merged, err := types.NewJournalEntry(description, "", lines...)
+	require.NoError(t, err)
+	for _, line := range merged {
+		l[line.Account] = l[line.Account].Add(line.Amount)
+	}
+}
+
+func TestReconcileAfterFundsMovements(t *testing.T) {
+	fakeNode := node.NewFakeNode()
+	w, err := fakeNode.NewWallet(make([]byte, 32))
+	require.NoError(t, err)
+	accounts := make([]node.Account, 5)
+	for i := range accounts {
+		index := uint32(i)
+		accounts[i], err = w.NewAccount(&index)
+		require.NoError(t, err)
+	}
+	// users are not simulated by the fake node, so any valid address will do
+	collector, paymentAccount, alice, bob, payer := accounts[0], accounts[1], accounts[2].Address(), accounts[3].Address(), accounts[4].Address()
+	onChainCollector := types.JournalAccountOnChain(collector.Address())
+	onChainPayment := types.JournalAccountOnChain(paymentAccount.Address())
+
+	_, err = fakeNode.Fund(payer, collector.Address(), bananos(100))
+	require.NoError(t, err)
+	require.NoError(t, collector.ReceivePendings(pricer.DustThreshold))
+
+	j := journal.New(log.New(io.Discard, "", 0), fakeNode, nil)
+	l := ledger{}
+	rewardBalances := map[string]decimal.Decimal{alice: bananosDecimal(5)}
+	pendingWithdrawals := map[string]decimal.Decimal{}
+	reconcile := func() *journal.Report {
+		rewardBalanceList := []*types.RewardBalance{}
+		for address, balance := range rewardBalances {
+			rewardBalanceList = append(rewardBalanceList, &types.RewardBalance{RewardsAddress: address, Balance: balance})
+		}
+		pendingWithdrawalList := []*types.PendingWithdrawal{}
+		for address, amount := range pendingWithdrawals {
+			pendingWithdrawalList = append(pendingWithdrawalList, &types.PendingWithdrawal{RewardsAddress: address, Amount: amount})
+		}
+		report, err := j.ReconcileBalances(l, rewardBalanceList, pendingWithdrawalList)
+		require.NoError(t, err)
+		return report
+	}
+
+	// opening balances: the equity account is credited with the assets minus the liabilities
+	lines, err := j.OpeningBalanceLines([]string{collector.Address(), paymentAccount.Address()},
+		[]*types.RewardBalance{{RewardsAddress: alice, Balance: bananosDecimal(5)}}, nil)
+	require.NoError(t, err)
+	l.record(t, "Opening balances", lines...)
+	require.True(t, l[types.JournalAccountEquity].Equal(bananosDecimal(-95)))
+	require.True(t, l[onChainCollector].Equal(bananosDecimal(100)))
+	require.True(t, l[types.JournalAccountRewardBalance(alice)].Equal(bananosDecimal(-5)))
+	_, hasPaymentAccount := l[onChainPayment]
+	require.False(t, hasPaymentAccount, "lines with a zero amount are omitted")
+
+	report := reconcile()
+	require.Empty(t, report.Discrepancies)
+
+	// payment received and collected
+	_, err = fakeNode.Fund(payer, paymentAccount.Address(), bananos(10))
+	require.NoError(t, err)
+	l.record(t, "Payment received", types.JournalTransfer(types.JournalAccountEquity, onChainPayment, bananosDecimal(10))...)
+	require.NoError(t, paymentAccount.ReceivePendings(pricer.DustThreshold))
+	_, err = paymentAccount.Send(collector.Address(), bananos(10))
+	require.NoError(t, err)
+	l.record(t, "Payment collected", types.JournalTransfer(onChainPayment, onChainCollector, bananosDecimal(10))...)
+	require.Empty(t, reconcile().Discrepancies)
+
+	// rewards distributed
+	l.record(t, "Rewards distributed", append(
+		types.JournalTransfer(types.JournalAccountRewardBalance(alice), types.JournalAccountEquity, bananosDecimal(4)),
+		types.JournalTransfer(types.JournalAccountRewardBalance(bob), types.JournalAccountEquity, bananosDecimal(6))...)...)
+	rewardBalances[alice] = bananosDecimal(9)
+	rewardBalances[bob] = bananosDecimal(6)
+	require.Empty(t, reconcile().Discrepancies)
+
+	// withdrawal started and completed
+	l.record(t, "Withdrawal started",
+		types.JournalTransfer(types.JournalAccountPendingWithdrawal(bob), types.JournalAccountRewardBalance(bob), bananosDecimal(6))...)
+	delete(rewardBalances, bob)
+	pendingWithdrawals[bob] = bananosDecimal(6)
+	require.Empty(t, reconcile().Discrepancies)
+
+	fakeNode.SetAutoConfirm(false)
+	_, err = collector.Send(bob, bananos(6))
+	require.NoError(t, err)
+	l.record(t, "Withdrawal completed",
+		types.JournalTransfer(onChainCollector, types.JournalAccountPendingWithdrawal(bob), bananosDecimal(6))...)
+	delete(pendingWithdrawals, bob)
+	require.Empty(t, reconcile().Discrepancies)
+
+	// the withdrawal never makes it to the ledger, so the collector has a surplus until the withdrawal is undone
+	fakeNode.DropUnconfirmed()
+	fakeNode.SetAutoConfirm(true)
+	report = reconcile()
+	require.Len(t, report.Discrepancies, 1)
+	require.Equal(t, onChainCollector, report.Discrepancies[0].Account)
+	require.Zero(t, report.Discrepancies[0].Expected.Cmp(bananos(104)))
+	require.Zero(t, report.Discrepancies[0].Actual.Cmp(bananos(110)))
+
+	l.record(t, "Withdrawal undone",
+		types.JournalTransfer(types.JournalAccountRewardBalance(bob), onChainCollector, bananosDecimal(6))...)
+	rewardBalances[bob] = bananosDecimal(6)
+	report = reconcile()
+	require.Empty(t, report.Discrepancies)
+	require.Equal(t, 5, report.CheckedAccounts)
+
+	// liabilities are reported as the amounts owed
+	rewardBalances[alice] = bananosDecimal(10)
+	pendingWithdrawals[bob] = bananosDecimal(1)
+	report = reconcile()
+	require.Len(t, report.Discrepancies, 2)
+	require.Equal(t, types.JournalAccountPendingWithdrawal(bob), report.Discrepancies[0].Account)
+	require.Zero(t, report.Discrepancies[0].Expected.Sign())
+	require.Zero(t, report.Discrepancies[0].Actual.Cmp(bananos(1)))
+	require.Equal(t, types.JournalAccountRewardBalance(alice), report.Discrepancies[1].Account)
+	require.Zero(t, report.Discrepancies[1].Expected.Cmp(bananos(9)))
+	require.Zero(t, report.Discrepancies[1].Actual.Cmp(bananos(10)))
+}
@@ -0,0 +1,94 @@
+package medialibrary
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Library stores the contents of the files in the server-hosted media library, in a local directory.
+// Metadata about each file is kept in the database, see types.LibraryMedia
+type Library struct {
+	dir string
+}
+
+// New returns a new Library that stores files in dir, creating it if necessary
+func New(dir string) (*Library, error) {
+	err := os.MkdirAll(dir, 0o750)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &Library{
+		dir: dir,
+	}, nil
+}
+
+// URL returns the URL at which the file with the specified ID is served
+func URL(websiteURL, id string) string {
+	return websiteURL + "/assets/library/" + id
+}
+
+func (l *Library) path(id string) (string, error) {
+	// IDs reach us from user input (e.g. HTTP paths), only accept the UUIDs we generate to avoid path traversal
+	if _, err := uuid.FromString(id); err != nil {
+		return "", stacktrace.Propagate(os.ErrNotExist, "invalid library media ID")
+	}
+	return filepath.Join(l.dir, id), nil
+}
+
+// Store writes the contents of the file with the specified ID
+func (l *Library) Store(id string, content []byte) error {
+	p, err := l.path(id)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	// write to a temporary file first, so that a partially written file is never served
+	tmp, err := os.CreateTemp(l.dir, ".upload-*")
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(content)
+	if err != nil {
+		tmp.Close()
+		return stacktrace.Propagate(err, "")
+	}
+	err = tmp.Close()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = os.Rename(tmp.Name(), p)
+	return stacktrace.Propagate(err, "")
+}
+
+// Open opens the file with the specified ID for reading.
+// The returned error matches os.ErrNotExist if the file does not exist
+func (l *Library) Open(id string) (*os.File, error) {
+	p, err := l.path(id)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return f, nil
+}
+
+// Remove deletes the file with the specified ID. Removing a file that does not exist is not an error
+func (l *Library) Remove(id string) error {
+	p, err := l.path(id)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	err = os.Remove(p)
+	if err != nil && !os.IsNotExist(err) {
+		return stacktrace.Propagate(err, "")
+	}
+	return nil
+}
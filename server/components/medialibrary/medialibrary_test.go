@@ -0,0 +1,40 @@
+package medialibrary_test
+
+import (
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/server/components/medialibrary"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLibrary(t *testing.T) {
+	library, err := medialibrary.New(t.TempDir())
+	require.NoError(t, err)
+
+	id := uuid.NewV4().String()
+	require.NoError(t, library.Store(id, []byte("content")))
+
+	f, err := library.Open(id)
+	require.NoError(t, err)
+	content, err := io.ReadAll(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.Equal(t, []byte("content"), content)
+
+	require.NoError(t, library.Remove(id))
+	_, err = library.Open(id)
+	require.True(t, errors.Is(err, os.ErrNotExist))
+
+	// removing a file that no longer exists is not an error
+	require.NoError(t, library.Remove(id))
+
+	// IDs that are not UUIDs never reach the file system
+	_, err = library.Open("../medialibrary.go")
+	require.True(t, errors.Is(err, os.ErrNotExist))
+	require.Error(t, library.Store("../escape", []byte("content")))
+}
@@ -29,7 +29,7 @@ type EntryAddedEventArg struct {
 
 // EntryRemovedEventArg is the argument of the event for when a queue entry is removed
 type EntryRemovedEventArg struct {
-	Index       int
+	Index       int // -1 for entries removed before being released from the schedule
 	Entry       media.QueueEntry
 	SelfRemoval bool
 }
@@ -53,6 +53,11 @@ func (q *MediaQueue) SkippingAllowedUpdated() event.NoArgEvent {
 	return q.skippingAllowedUpdated
 }
 
+// PauseStateChanged is the event that is fired when the broadcast is paused or resumed, or when the pause reason changes
+func (q *MediaQueue) PauseStateChanged() event.Event[PauseState] {
+	return q.pauseStateChanged
+}
+
 // MediaChanged is the event that is fired when the currently playing entry changes
 func (q *MediaQueue) MediaChanged() event.Event[media.QueueEntry] {
 	return q.mediaChanged
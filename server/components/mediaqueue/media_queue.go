@@ -3,16 +3,13 @@ package mediaqueue
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
 	"log"
 	"math"
-	"os"
 	"sync"
 	"time"
 
 	"github.com/Yiling-J/theine-go"
-	"github.com/bytedance/sonic"
 	"github.com/palantir/stacktrace"
 	"github.com/sethvargo/go-limiter"
 	"github.com/sethvargo/go-limiter/memorystore"
@@ -34,6 +31,7 @@ import (
 // MediaQueue queues media for synced broadcast
 type MediaQueue struct {
 	log                        *log.Logger
+	channelID                  string
 	statsClient                *statsd.Client
 	queue                      []media.QueueEntry
 	queueMutex                 sync.RWMutex
@@ -44,7 +42,18 @@ type MediaQueue struct {
 	entryReorderingAllowed     bool
 	skippingEnabled            bool // all entries will behave as unskippable when false
 	insertCursor               string
+	orderingPolicy             OrderingPolicy
 	playingSince               time.Time
+	persistenceCtx             context.Context
+	persistenceOutOfSync       bool
+	scheduledEntries           []ScheduledEntry         // ordered by planned start
+	entrySchedules             map[string]entrySchedule // schedules of entries in the queue that were released from the schedule
+	timetableSlots             []*types.MediaQueueTimetableSlot
+	lastSnapshot               []byte // serialized contents of the most recently recorded queue snapshot
+	pauseState                 PauseState
+	// entry that was playing when the broadcast was paused before a restart, and the position it had reached
+	restoredPausedEntryID        string
+	restoredPausedEntryPlayedFor time.Duration
 
 	mediaProviders map[types.MediaType]media.Provider
 
@@ -52,6 +61,7 @@ type MediaQueue struct {
 
 	queueUpdated           event.NoArgEvent
 	skippingAllowedUpdated event.NoArgEvent
+	pauseStateChanged      event.Event[PauseState]
 	mediaChanged           event.Event[media.QueueEntry]
 	entryAdded             event.Event[EntryAddedEventArg]
 	entryMoved             event.Event[EntryMovedEventArg]
@@ -64,21 +74,28 @@ type MediaQueue struct {
 // ErrInsufficientPermissionsToRemoveEntry indicates the user has insufficient permissions to remove an entry
 var ErrInsufficientPermissionsToRemoveEntry = errors.New("insufficient permissions to remove queue entry")
 
-func New(ctx context.Context, log *log.Logger, statsClient *statsd.Client, persistenceFile string, mediaProviders map[types.MediaType]media.Provider) (*MediaQueue, error) {
+// New returns a new MediaQueue for the specified broadcast channel, restored from the database.
+// legacyPersistenceFile is only read if the queue has never been persisted in the database
+func New(ctx context.Context, log *log.Logger, statsClient *statsd.Client, channelID string, legacyPersistenceFile string, mediaProviders map[types.MediaType]media.Provider) (*MediaQueue, error) {
 	q := &MediaQueue{
 		log:                        log,
+		channelID:                  channelID,
 		statsClient:                statsClient,
 		recentEntryCounts:          make(map[string]map[string]struct{}),
 		queueUpdated:               event.NewNoArg(),
 		mediaChanged:               event.New[media.QueueEntry](),
 		skippingAllowedUpdated:     event.NewNoArg(),
+		pauseStateChanged:          event.New[PauseState](),
 		entryAdded:                 event.New[EntryAddedEventArg](),
 		entryRemoved:               event.New[EntryRemovedEventArg](),
 		entryMoved:                 event.New[EntryMovedEventArg](),
 		removalOfOwnEntriesAllowed: true,
 		entryReorderingAllowed:     true,
 		skippingEnabled:            true,
+		orderingPolicy:             FIFOOrderingPolicy{},
 		mediaProviders:             mediaProviders,
+		persistenceCtx:             ctx,
+		entrySchedules:             make(map[string]entrySchedule),
 	}
 	for _, provider := range mediaProviders {
 		provider.SetMediaQueue(q)
@@ -95,21 +112,18 @@ func New(ctx context.Context, log *log.Logger, statsClient *statsd.Client, persi
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
-	if persistenceFile != "" {
-		err := q.restoreQueueFromFile(ctx, persistenceFile)
-		if err != nil {
-			return nil, stacktrace.Propagate(err, "")
-		}
-
-		err = q.restorePlayingSinceFromDatabase(ctx)
-		if err != nil {
-			return nil, stacktrace.Propagate(err, "")
-		}
-		go q.persistenceWorker(ctx, persistenceFile)
+	err = q.restoreQueue(ctx, legacyPersistenceFile)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
 	}
 	return q, nil
 }
 
+// ChannelID returns the ID of the broadcast channel this queue belongs to
+func (q *MediaQueue) ChannelID() string {
+	return q.channelID
+}
+
 func (q *MediaQueue) EntryReorderingAllowed() bool {
 	return q.entryReorderingAllowed
 }
@@ -147,6 +161,33 @@ func (q *MediaQueue) SetSkippingEnabled(enabled bool) {
 	q.skippingAllowedUpdated.Notify(false)
 }
 
+// OrderingPolicy returns the policy that decides where enqueued entries are placed
+func (q *MediaQueue) OrderingPolicy() OrderingPolicy {
+	q.queueMutex.RLock()
+	defer q.queueMutex.RUnlock()
+	return q.orderingPolicy
+}
+
+// SetOrderingPolicy sets the policy that decides where enqueued entries are placed.
+// Entries that are already in the queue are not moved.
+// The built-in policies are persisted with the queue state, other policies revert to FIFO when the queue is restored
+func (q *MediaQueue) SetOrderingPolicy(policy OrderingPolicy) {
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+	q.orderingPolicy = policy
+	q.persistInMutex(q.persistStateInMutex)
+	q.queueUpdated.Notify(false)
+}
+
+// PredictedEnqueuePosition returns the index at which an entry requested by requester would be placed, if it were
+// enqueued right now
+func (q *MediaQueue) PredictedEnqueuePosition(requester auth.User) int {
+	q.queueMutex.RLock()
+	defer q.queueMutex.RUnlock()
+	index, _ := q.enqueueIndexInMutex(requester)
+	return index
+}
+
 func (q *MediaQueue) InsertCursor() (string, bool) {
 	q.queueMutex.RLock()
 	defer q.queueMutex.RUnlock()
@@ -161,6 +202,7 @@ func (q *MediaQueue) SetInsertCursor(entryID string) error {
 		// never allow for setting the cursor to the currently playing entry
 		if i != 0 && entryID == entry.PerformanceID() {
 			q.insertCursor = entryID
+			q.persistInMutex(q.persistStateInMutex)
 			q.queueUpdated.Notify(false)
 			return nil
 		}
@@ -175,6 +217,7 @@ func (q *MediaQueue) ClearInsertCursor() {
 
 	if q.insertCursor != "" {
 		q.insertCursor = ""
+		q.persistInMutex(q.persistStateInMutex)
 		q.queueUpdated.Notify(false)
 	}
 }
@@ -219,28 +262,14 @@ func (q *MediaQueue) Enqueue(newEntry media.QueueEntry) {
 	q.queueMutex.Lock()
 	defer q.queueMutex.Unlock()
 
-	insertedAtCursor := false
-	insertionIndex := 0
-	if q.insertCursor != "" {
-		for i, entry := range q.queue {
-			if i == 0 {
-				// never insert at the beginning (skip) even if that's where the cursor is
-				continue
-			}
-			if q.insertCursor == entry.PerformanceID() {
-				q.queue = append(q.queue[:i+1], q.queue[i:]...)
-				q.queue[i] = newEntry
-				insertedAtCursor = true
-				insertionIndex = i
-				break
-			}
-		}
-	}
-	if !insertedAtCursor {
+	insertionIndex, cursorValid := q.enqueueIndexInMutex(newEntry.RequestedBy())
+	if !cursorValid {
 		q.insertCursor = "" // if we had a cursor, it has clearly become invalid, so clear it
-		q.queue = append(q.queue, newEntry)
-		insertionIndex = len(q.queue) - 1
 	}
+	q.queue = slices.Insert(q.queue, insertionIndex, newEntry)
+	q.persistInMutex(func(ctx transaction.WrappingContext) error {
+		return q.persistInsertionInMutex(ctx, insertionIndex)
+	})
 	go q.statsClient.Gauge("queue_length", len(q.queue))
 	reqBy := newEntry.RequestedBy()
 	if reqBy != nil && !reqBy.IsUnknown() {
@@ -250,49 +279,99 @@ func (q *MediaQueue) Enqueue(newEntry media.QueueEntry) {
 	q.entryAdded.Notify(EntryAddedEventArg{insertionIndex, EntryAddedPlacementEnqueue, newEntry}, false)
 }
 
-func (q *MediaQueue) playAfterNextNoMutex(entry media.QueueEntry) int {
-	reqBy := entry.RequestedBy()
-	if reqBy != nil && !reqBy.IsUnknown() {
-		q.recentEntryCountsCache.Delete(reqBy.Address())
+// enqueueIndexInMutex returns the index at which Enqueue would place an entry requested by requester, according to
+// the insert cursor and the ordering policy, and whether the insert cursor is valid
+func (q *MediaQueue) enqueueIndexInMutex(requester auth.User) (int, bool) {
+	limit := len(q.queue)
+	cursorValid := false
+	if q.insertCursor != "" {
+		for i, entry := range q.queue {
+			// never insert at the beginning (skip) even if that's where the cursor is
+			if i != 0 && q.insertCursor == entry.PerformanceID() {
+				limit = i
+				cursorValid = true
+				break
+			}
+		}
+	}
+	if limit == 0 {
+		return 0, cursorValid
 	}
+	return q.orderingPolicy.InsertionIndex(q.queue, requester, limit), cursorValid
+}
 
-	if len(q.queue) < 2 {
-		q.queue = append(q.queue, entry)
-		return len(q.queue) - 1
+// insertAfterCurrentInMutex inserts entries, in order, right after the currently playing entry (or at the start of the
+// queue, if it is empty) and returns the index of the first inserted entry
+func (q *MediaQueue) insertAfterCurrentInMutex(entries []media.QueueEntry) int {
+	for _, entry := range entries {
+		reqBy := entry.RequestedBy()
+		if reqBy != nil && !reqBy.IsUnknown() {
+			q.recentEntryCountsCache.Delete(reqBy.Address())
+		}
 	}
-	q.queue = append(q.queue, nil)
-	copy(q.queue[2:], q.queue[1:])
-	q.queue[1] = entry
-	return 1
+
+	insertionIndex := min(1, len(q.queue))
+	q.queue = slices.Insert(q.queue, insertionIndex, entries...)
+	return insertionIndex
 }
 
 func (q *MediaQueue) PlayAfterCurrent(entry media.QueueEntry) {
+	q.PlayAfterCurrentMany([]media.QueueEntry{entry})
+}
+
+// PlayAfterCurrentMany places entries, in order, right after the currently playing entry.
+// The entries are inserted at once, so they can't be interleaved with entries added concurrently
+func (q *MediaQueue) PlayAfterCurrentMany(entries []media.QueueEntry) {
+	if len(entries) == 0 {
+		return
+	}
 	q.queueMutex.Lock()
 	defer q.queueMutex.Unlock()
 
-	insertionIndex := q.playAfterNextNoMutex(entry)
+	insertionIndex := q.insertAfterCurrentInMutex(entries)
+	q.persistInMutex(func(ctx transaction.WrappingContext) error {
+		return q.persistInsertionsInMutex(ctx, insertionIndex, len(entries))
+	})
 	go q.statsClient.Gauge("queue_length", len(q.queue))
 	q.queueUpdated.Notify(false)
-	q.entryAdded.Notify(EntryAddedEventArg{insertionIndex, EntryAddedPlacementPlayNext, entry}, false)
+	for i, entry := range entries {
+		q.entryAdded.Notify(EntryAddedEventArg{insertionIndex + i, EntryAddedPlacementPlayNext, entry}, false)
+	}
 }
 
 func (q *MediaQueue) PlayNow(entry media.QueueEntry) {
+	q.PlayNowMany([]media.QueueEntry{entry})
+}
+
+// PlayNowMany places entries, in order, right after the currently playing entry, and stops the latter if it can be
+// skipped. The entries are inserted at once, so they can't be interleaved with entries added concurrently
+func (q *MediaQueue) PlayNowMany(entries []media.QueueEntry) {
+	if len(entries) == 0 {
+		return
+	}
 	q.queueMutex.Lock()
 	defer q.queueMutex.Unlock()
 
-	q.playAfterNextNoMutex(entry)
+	hadCurrentEntry := len(q.queue) > 0
+	insertionIndex := q.insertAfterCurrentInMutex(entries)
+	q.persistInMutex(func(ctx transaction.WrappingContext) error {
+		return q.persistInsertionsInMutex(ctx, insertionIndex, len(entries))
+	})
 	placement := EntryAddedPlacementPlayNext
-	if len(q.queue) <= 1 {
+	if !hadCurrentEntry {
 		placement = EntryAddedPlacementEnqueue
 	}
-	if len(q.queue) > 1 && !q.queue[0].Unskippable() && q.SkippingEnabled() {
+	if hadCurrentEntry && !q.queue[0].Unskippable() && q.SkippingEnabled() {
 		placement = EntryAddedPlacementPlayNow
 		q.queue[0].Stop()
 	}
 
 	go q.statsClient.Gauge("queue_length", len(q.queue))
 	q.queueUpdated.Notify(false)
-	q.entryAdded.Notify(EntryAddedEventArg{0, placement, entry}, false)
+	for i, entry := range entries {
+		// entries are reported at the positions they take once the current entry is done
+		q.entryAdded.Notify(EntryAddedEventArg{i, placement, entry}, false)
+	}
 }
 
 func (q *MediaQueue) SkipCurrentEntry() {
@@ -311,6 +390,10 @@ func (q *MediaQueue) RemoveEntry(entryID string) (media.QueueEntry, error) {
 	q.queueMutex.Lock()
 	defer q.queueMutex.Unlock()
 
+	if entry, ok := q.removeScheduledEntryInMutex(entryID); ok {
+		return entry, nil
+	}
+
 	entry, err := q.removeEntryInMutex(entryID, false)
 	return entry, stacktrace.Propagate(err, "")
 }
@@ -365,6 +448,10 @@ func (q *MediaQueue) removeEntryInMutex(entryID string, selfRemoval bool) (media
 			if entryID == q.insertCursor {
 				q.insertCursor = ""
 			}
+			q.persistInMutex(func(ctx transaction.WrappingContext) error {
+				return q.persistRemovalInMutex(ctx, i, entry)
+			})
+			delete(q.entrySchedules, entryID)
 			q.entryRemoved.Notify(EntryRemovedEventArg{i, entry, selfRemoval}, false)
 			go q.statsClient.Gauge("queue_length", len(q.queue))
 			q.queueUpdated.Notify(false)
@@ -402,6 +489,13 @@ func (q *MediaQueue) MoveEntry(entryID string, user auth.User, up bool) error {
 			newIndex = i - 1
 		}
 		q.queue[newIndex], q.queue[i] = q.queue[i], q.queue[newIndex]
+		q.persistInMutex(func(ctx transaction.WrappingContext) error {
+			err := q.persistEntryInMutex(ctx, i)
+			if err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+			return stacktrace.Propagate(q.persistEntryInMutex(ctx, newIndex), "")
+		})
 		q.queueUpdated.Notify(false)
 		q.entryMoved.Notify(EntryMovedEventArg{
 			PreviousIndex: i,
@@ -459,10 +553,15 @@ func (q *MediaQueue) ProcessQueueWorker(ctx context.Context) {
 		onNextMedia := make(<-chan struct{})
 		unsubscribe := func() {}
 		var currentQueueEntry media.QueueEntry
+		var nextScheduledRelease time.Time
 		func() {
 			q.queueMutex.Lock()
 			defer q.queueMutex.Unlock()
 
+			q.releaseDueScheduledEntriesInMutex(time.Now())
+			nextScheduledRelease = q.nextScheduledReleaseInMutex()
+
+			prevInsertCursor, prevPlayingSince := q.insertCursor, q.playingSince
 			if len(q.queue) > 0 {
 				currentQueueEntry = q.queue[0]
 				if currentQueueEntry.PerformanceID() == q.insertCursor {
@@ -475,6 +574,9 @@ func (q *MediaQueue) ProcessQueueWorker(ctx context.Context) {
 				q.insertCursor = ""
 				q.playingSince = time.Time{}
 			}
+			if prevInsertCursor != q.insertCursor || !prevPlayingSince.Equal(q.playingSince) {
+				q.persistInMutex(q.persistStateInMutex)
+			}
 		}()
 
 		if prevQueueEntry != currentQueueEntry {
@@ -495,7 +597,11 @@ func (q *MediaQueue) ProcessQueueWorker(ctx context.Context) {
 				continue
 			}
 			if !currentQueueEntry.Playing() {
-				currentQueueEntry.Play()
+				func() {
+					q.queueMutex.Lock()
+					defer q.queueMutex.Unlock()
+					q.playInMutex(currentQueueEntry)
+				}()
 			}
 			ev := currentQueueEntry.DonePlaying()
 			onNextMedia, unsubscribe = ev.Subscribe(event.BufferFirst)
@@ -509,16 +615,28 @@ func (q *MediaQueue) ProcessQueueWorker(ctx context.Context) {
 			q.log.Println("No current queue entry")
 		}
 
+		var onScheduledRelease <-chan time.Time
+		stopScheduledReleaseTimer := func() bool { return false }
+		if !nextScheduledRelease.IsZero() {
+			t := time.NewTimer(time.Until(nextScheduledRelease))
+			onScheduledRelease = t.C
+			stopScheduledReleaseTimer = t.Stop
+		}
+
 		select {
 		case <-ctx.Done():
 			unsubscribe()
+			stopScheduledReleaseTimer()
 			return
 		case <-onNextMedia:
 			q.playNext()
 		case <-onQueueUpdated:
 			// loop again to update currentQueueEntry and nextMediaChan
+		case <-onScheduledRelease:
+			// loop again to release the scheduled entries
 		}
 		unsubscribe()
+		stopScheduledReleaseTimer()
 	}
 }
 
@@ -531,8 +649,13 @@ func (q *MediaQueue) playNext() {
 		return
 	}
 
+	removedEntry := q.queue[0]
 	q.queue = q.queue[1:]
 	length = length - 1
+	q.persistInMutex(func(ctx transaction.WrappingContext) error {
+		return q.persistRemovalInMutex(ctx, 0, removedEntry)
+	})
+	delete(q.entrySchedules, removedEntry.PerformanceID())
 
 	go q.statsClient.Gauge("queue_length", length)
 	q.queueUpdated.Notify(false)
@@ -548,13 +671,23 @@ func (q *MediaQueue) CurrentlyPlaying() (media.QueueEntry, bool) {
 }
 
 func (q *MediaQueue) ProduceCheckpointForAPI(ctx context.Context, userSerializer auth.APIUserSerializer, needsTitle bool) *proto.MediaConsumptionCheckpoint {
+	pauseState := q.PauseState()
+	var pauseReason *string
+	if pauseState.Paused {
+		pauseReason = &pauseState.Reason
+	}
 	currentEntry, playingSomething := q.CurrentlyPlaying()
 	if !playingSomething {
-		return &proto.MediaConsumptionCheckpoint{}
+		return &proto.MediaConsumptionCheckpoint{
+			Paused:      pauseState.Paused,
+			PauseReason: pauseReason,
+		}
 	}
 	// the user serializer may request the queue lock. hence why we get the currently playing entry separately
 	cp := currentEntry.ProduceCheckpointForAPI(ctx)
 	cp.MediaPresent = true
+	cp.Paused = pauseState.Paused
+	cp.PauseReason = pauseReason
 	cp.CurrentPosition = durationpb.New(currentEntry.MediaInfo().Offset() + currentEntry.PlayedFor())
 	cp.RequestCost = currentEntry.RequestCost().SerializeForAPI()
 	if needsTitle {
@@ -567,104 +700,6 @@ func (q *MediaQueue) ProduceCheckpointForAPI(ctx context.Context, userSerializer
 	return cp
 }
 
-func (q *MediaQueue) persistenceWorker(ctx context.Context, file string) {
-	c, queueUpdatedU := q.queueUpdated.Subscribe(event.BufferFirst)
-	defer queueUpdatedU()
-
-	for {
-		select {
-		case <-c:
-			marshalled, err := sonic.Marshal(q.Entries())
-			if err != nil {
-				q.log.Printf("error serializing queue: %v", err)
-				continue
-			}
-			err = os.WriteFile(file, marshalled, 0644)
-			if err != nil {
-				q.log.Printf("error writing queue to file: %v", err)
-				continue
-			}
-		case <-ctx.Done():
-			return
-		}
-	}
-}
-
-func (q *MediaQueue) restoreQueueFromFile(ctx context.Context, file string) error {
-	b, err := os.ReadFile(file)
-	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return nil
-		}
-		return stacktrace.Propagate(err, "error reading queue from file: %v", err)
-	}
-
-	type unknownTypeEntry struct {
-		Type string
-	}
-
-	var entries []json.RawMessage
-	err = sonic.Unmarshal(b, &entries)
-	if err != nil {
-		return stacktrace.Propagate(err, "error decoding queue from file: %v", err)
-	}
-	q.queueMutex.Lock()
-	defer q.queueMutex.Unlock()
-
-	q.queue = make([]media.QueueEntry, 0, len(entries))
-	for i := range entries {
-		unknownEntry := unknownTypeEntry{}
-		err := sonic.Unmarshal(entries[i], &unknownEntry)
-		if err != nil {
-			return stacktrace.Propagate(err, "")
-		}
-
-		provider, ok := q.mediaProviders[types.MediaType(unknownEntry.Type)]
-		if !ok {
-			return stacktrace.NewError("unknown media queue entry type %s in persisted queue", unknownEntry.Type)
-		}
-
-		entry, keepInQueue, err := provider.UnmarshalQueueEntryJSON(ctx, entries[i])
-		if err != nil {
-			return stacktrace.Propagate(err, "")
-		}
-
-		if entry != nil && keepInQueue {
-			q.queue = append(q.queue, entry)
-		}
-	}
-	go q.statsClient.Gauge("queue_length", len(q.queue))
-	q.queueUpdated.Notify(false)
-	return nil
-}
-
-func (q *MediaQueue) restorePlayingSinceFromDatabase(ctxCtx context.Context) error {
-	ctx, err := transaction.Begin(ctxCtx)
-	if err != nil {
-		return stacktrace.Propagate(err, "")
-	}
-	defer ctx.Commit() // read-only tx
-
-	q.queueMutex.Lock()
-	defer q.queueMutex.Unlock()
-
-	mostRecentEvent, err := types.GetMostRecentMediaQueueEventWithType(ctx, types.MediaQueueEmptied, types.MediaQueueFilled)
-	if err != nil {
-		if !errors.Is(err, types.ErrMediaQueueEventNotFound) {
-			return stacktrace.Propagate(err, "")
-		}
-		q.playingSince = time.Time{}
-		return nil
-	}
-
-	if mostRecentEvent.EventType == types.MediaQueueEmptied {
-		q.playingSince = time.Time{}
-	} else {
-		q.playingSince = mostRecentEvent.CreatedAt
-	}
-	return nil
-}
-
 func (q *MediaQueue) logPlayedMedia(ctxCtx context.Context, prevMedia media.QueueEntry, newMedia media.QueueEntry) error {
 	ctx, err := transaction.Begin(ctxCtx)
 	if err != nil {
@@ -708,6 +743,7 @@ func (q *MediaQueue) logPlayedMedia(ctxCtx context.Context, prevMedia media.Queu
 			return stacktrace.Propagate(err, "")
 		}
 		newPlayedMedia.StartedAt = now
+		newPlayedMedia.ChannelID = q.channelID
 
 		err = newPlayedMedia.Update(ctx)
 		if err != nil {
@@ -718,6 +754,11 @@ func (q *MediaQueue) logPlayedMedia(ctxCtx context.Context, prevMedia media.Queu
 		})
 	}
 
+	if q.channelID != types.MainBroadcastChannelID {
+		// queue filled/emptied events are only tracked for the main channel
+		return stacktrace.Propagate(ctx.Commit(), "")
+	}
+
 	mostRecentEvent, err := types.GetMostRecentMediaQueueEventWithType(ctx, types.MediaQueueEmptied, types.MediaQueueFilled)
 	if err != nil {
 		if !errors.Is(err, types.ErrMediaQueueEventNotFound) {
@@ -732,6 +773,7 @@ func (q *MediaQueue) logPlayedMedia(ctxCtx context.Context, prevMedia media.Queu
 			{
 				CreatedAt: now,
 				EventType: types.MediaQueueFilled,
+				ChannelID: q.channelID,
 			},
 		})
 		if err != nil {
@@ -745,6 +787,7 @@ func (q *MediaQueue) logPlayedMedia(ctxCtx context.Context, prevMedia media.Queu
 			{
 				CreatedAt: now,
 				EventType: types.MediaQueueEmptied,
+				ChannelID: q.channelID,
 			},
 		})
 		if err != nil {
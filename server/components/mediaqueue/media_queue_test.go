@@ -0,0 +1,86 @@
+package mediaqueue_test
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/mediaqueue"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/server/media/applicationpage"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction/transactiontest"
+	"gopkg.in/alexcesaro/statsd.v2"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestQueue(t *testing.T, ctx context.Context) *mediaqueue.MediaQueue {
+	statsClient, err := statsd.New(statsd.Mute(true))
+	require.NoError(t, err)
+	q, err := mediaqueue.New(ctx, log.New(io.Discard, "", 0), statsClient, "", "", map[types.MediaType]media.Provider{})
+	require.NoError(t, err)
+	return q
+}
+
+func newTestEntries(titles ...string) []media.QueueEntry {
+	entries := make([]media.QueueEntry, len(titles))
+	for i, title := range titles {
+		entries[i] = applicationpage.NewApplicationPageQueueEntry("app", types.ApplicationVersion(time.Now()), "page", title, "",
+			10*time.Minute, auth.NewAddressOnlyUser("requester"), payment.NewAmount(), false, false)
+	}
+	return entries
+}
+
+func requireQueueOrder(t *testing.T, q *mediaqueue.MediaQueue, expected ...media.QueueEntry) {
+	entries := q.Entries()
+	require.Len(t, entries, len(expected))
+	for i := range expected {
+		require.Equal(t, expected[i].PerformanceID(), entries[i].PerformanceID(), "unexpected entry at index %d", i)
+	}
+}
+
+func TestPlayAfterCurrentManyKeepsOrder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = transactiontest.NullDatabaseContext(ctx)
+
+	q := newTestQueue(t, ctx)
+	batch := newTestEntries("a", "b", "c")
+	q.PlayAfterCurrentMany(batch)
+	requireQueueOrder(t, q, batch...)
+
+	q = newTestQueue(t, ctx)
+	existing := newTestEntries("current", "x")
+	q.Enqueue(existing[0])
+	q.Enqueue(existing[1])
+	q.PlayAfterCurrentMany(batch)
+	requireQueueOrder(t, q, existing[0], batch[0], batch[1], batch[2], existing[1])
+}
+
+func TestPlayNowManyPlaysFirstEntryFirst(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = transactiontest.NullDatabaseContext(ctx)
+
+	q := newTestQueue(t, ctx)
+	existing := newTestEntries("current", "x")
+	q.Enqueue(existing[0])
+	q.Enqueue(existing[1])
+	go q.ProcessQueueWorker(ctx)
+	require.Eventually(t, existing[0].Playing, 5*time.Second, 10*time.Millisecond)
+
+	batch := newTestEntries("a", "b", "c")
+	q.PlayNowMany(batch)
+
+	// the current entry is stopped and the batch plays in order, before the entries that were already enqueued
+	require.Eventually(t, func() bool {
+		return len(q.Entries()) == 4
+	}, 5*time.Second, 10*time.Millisecond)
+	requireQueueOrder(t, q, batch[0], batch[1], batch[2], existing[1])
+	require.Eventually(t, batch[0].Playing, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,120 @@
+package mediaqueue
+
+import (
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/types"
+)
+
+// OrderingPolicy decides where entries are placed when they are enqueued normally
+// (i.e. not to play next or to play now, and not through scheduling)
+type OrderingPolicy interface {
+	// InsertionIndex returns the index at which an entry requested by requester should be inserted in queue.
+	// queue[0] is the currently playing entry and limit is the index at which the entry would be inserted in a
+	// first-in, first-out queue (the position of the insert cursor, or the length of the queue).
+	// limit is always at least 1, and the returned index must be between 1 and limit, inclusive
+	InsertionIndex(queue []media.QueueEntry, requester auth.User, limit int) int
+}
+
+// FIFOOrderingPolicy places new entries at the end of the queue, or at the insert cursor
+type FIFOOrderingPolicy struct{}
+
+// InsertionIndex implements OrderingPolicy
+func (FIFOOrderingPolicy) InsertionIndex(queue []media.QueueEntry, requester auth.User, limit int) int {
+	return limit
+}
+
+// RoundRobinOrderingPolicy interleaves the entries of different requesters, so that each requester gets one entry
+// played per round. An entry that is the n-th entry of its requester in the queue is placed after all the n-th entries
+// of the other requesters
+type RoundRobinOrderingPolicy struct{}
+
+// InsertionIndex implements OrderingPolicy
+func (RoundRobinOrderingPolicy) InsertionIndex(queue []media.QueueEntry, requester auth.User, limit int) int {
+	newKey := requesterKey(requester)
+	rounds := make([]int, limit)
+	counts := make(map[string]int)
+	lastOwnIndex := 0
+	for i := 1; i < limit; i++ {
+		key := requesterKey(queue[i].RequestedBy())
+		rounds[i] = counts[key]
+		counts[key]++
+		if key == newKey {
+			lastOwnIndex = i
+		}
+	}
+
+	newRound := counts[newKey]
+	for i := lastOwnIndex + 1; i < limit; i++ {
+		if rounds[i] > newRound {
+			return i
+		}
+	}
+	return limit
+}
+
+// MaxConsecutiveOrderingPolicy prevents requesters from having more than MaxConsecutive entries in a row, when
+// entries from other requesters are waiting: new entries are placed right after the first MaxConsecutive entries of
+// the first sequence of entries that is too long, as long as that doesn't place them ahead of earlier entries from the
+// same requester
+type MaxConsecutiveOrderingPolicy struct {
+	MaxConsecutive int
+}
+
+// InsertionIndex implements OrderingPolicy
+func (p MaxConsecutiveOrderingPolicy) InsertionIndex(queue []media.QueueEntry, requester auth.User, limit int) int {
+	newKey := requesterKey(requester)
+	lastOwnIndex := 0
+	for i := 1; i < limit; i++ {
+		if requesterKey(queue[i].RequestedBy()) == newKey {
+			lastOwnIndex = i
+		}
+	}
+
+	runKey, runLength := "", 0
+	for i := lastOwnIndex + 1; i < limit; i++ {
+		key := requesterKey(queue[i].RequestedBy())
+		if runLength > 0 && key == runKey {
+			runLength++
+		} else {
+			runKey, runLength = key, 1
+		}
+		if runLength > p.MaxConsecutive && runKey != newKey {
+			return i
+		}
+	}
+	return limit
+}
+
+// persistableOrderingPolicy returns the persisted representation of policy.
+// Policies that can't be persisted are persisted as FIFO, the default policy
+func persistableOrderingPolicy(policy OrderingPolicy) (types.MediaQueueOrderingPolicy, int) {
+	switch p := policy.(type) {
+	case RoundRobinOrderingPolicy:
+		return types.MediaQueueOrderingPolicyRoundRobin, 0
+	case MaxConsecutiveOrderingPolicy:
+		return types.MediaQueueOrderingPolicyMaxConsecutive, p.MaxConsecutive
+	default:
+		return types.MediaQueueOrderingPolicyFIFO, 0
+	}
+}
+
+// orderingPolicyFromPersisted is the inverse of persistableOrderingPolicy
+func orderingPolicyFromPersisted(policy types.MediaQueueOrderingPolicy, maxConsecutive int) OrderingPolicy {
+	switch policy {
+	case types.MediaQueueOrderingPolicyRoundRobin:
+		return RoundRobinOrderingPolicy{}
+	case types.MediaQueueOrderingPolicyMaxConsecutive:
+		if maxConsecutive >= 1 {
+			return MaxConsecutiveOrderingPolicy{MaxConsecutive: maxConsecutive}
+		}
+	}
+	return FIFOOrderingPolicy{}
+}
+
+func requesterKey(user auth.User) string {
+	if user == nil || user.IsUnknown() {
+		return ""
+	}
+	return user.Address()
+}
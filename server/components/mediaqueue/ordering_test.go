@@ -0,0 +1,74 @@
+package mediaqueue_test
+
+import (
+	"testing"
+
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/mediaqueue"
+	"github.com/tnyim/jungletv/server/media"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeQueueEntry struct {
+	media.QueueEntry
+	requestedBy auth.User
+}
+
+func (e *fakeQueueEntry) RequestedBy() auth.User {
+	return e.requestedBy
+}
+
+func queueRequestedBy(requesters ...string) []media.QueueEntry {
+	queue := make([]media.QueueEntry, len(requesters))
+	for i, requester := range requesters {
+		queue[i] = &fakeQueueEntry{requestedBy: auth.NewAddressOnlyUser(requester)}
+	}
+	return queue
+}
+
+func TestFIFOOrderingPolicy(t *testing.T) {
+	policy := mediaqueue.FIFOOrderingPolicy{}
+	queue := queueRequestedBy("x", "a", "a", "b")
+	require.Equal(t, 4, policy.InsertionIndex(queue, auth.NewAddressOnlyUser("c"), 4))
+	require.Equal(t, 2, policy.InsertionIndex(queue, auth.NewAddressOnlyUser("c"), 2))
+}
+
+func TestRoundRobinOrderingPolicy(t *testing.T) {
+	policy := mediaqueue.RoundRobinOrderingPolicy{}
+	b := auth.NewAddressOnlyUser("b")
+
+	queue := queueRequestedBy("x", "a", "a", "a")
+	require.Equal(t, 2, policy.InsertionIndex(queue, b, 4))
+
+	queue = queueRequestedBy("x", "a", "b", "a", "a")
+	require.Equal(t, 4, policy.InsertionIndex(queue, b, 5))
+
+	// never placed ahead of earlier entries of the same requester
+	queue = queueRequestedBy("x", "a", "a", "b", "a")
+	require.Equal(t, 4, policy.InsertionIndex(queue, b, 5))
+
+	// the currently playing entry doesn't count
+	queue = queueRequestedBy("b", "a", "a")
+	require.Equal(t, 2, policy.InsertionIndex(queue, b, 3))
+
+	// the insert cursor is respected
+	queue = queueRequestedBy("x", "a", "a", "a", "a")
+	require.Equal(t, 2, policy.InsertionIndex(queue, b, 2))
+}
+
+func TestMaxConsecutiveOrderingPolicy(t *testing.T) {
+	policy := mediaqueue.MaxConsecutiveOrderingPolicy{MaxConsecutive: 2}
+	a := auth.NewAddressOnlyUser("a")
+	b := auth.NewAddressOnlyUser("b")
+
+	queue := queueRequestedBy("x", "a", "a", "a", "a")
+	require.Equal(t, 3, policy.InsertionIndex(queue, b, 5))
+	require.Equal(t, 5, policy.InsertionIndex(queue, a, 5))
+
+	queue = queueRequestedBy("x", "a", "a", "b", "a", "a", "a")
+	require.Equal(t, 6, policy.InsertionIndex(queue, b, 7))
+
+	queue = queueRequestedBy("x", "a", "a", "b", "a", "a")
+	require.Equal(t, 6, policy.InsertionIndex(queue, b, 6))
+}
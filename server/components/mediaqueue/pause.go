@@ -0,0 +1,83 @@
+package mediaqueue
+
+import (
+	"errors"
+	"time"
+
+	"github.com/tnyim/jungletv/server/media"
+)
+
+// PauseState describes whether the broadcast of a queue is paused
+type PauseState struct {
+	Paused bool
+	Reason string
+	// PausedAt is the moment the broadcast was paused.
+	// When Paused is false, it refers to the most recently ended pause, if any
+	PausedAt time.Time
+}
+
+// ErrBroadcastNotPaused is returned when attempting to resume a broadcast that is not paused
+var ErrBroadcastNotPaused = errors.New("broadcast not paused")
+
+// PauseState returns the current pause state of the broadcast
+func (q *MediaQueue) PauseState() PauseState {
+	q.queueMutex.RLock()
+	defer q.queueMutex.RUnlock()
+	return q.pauseState
+}
+
+// Pause freezes the playback of the currently playing entry, as well as of any entry that begins playing until the
+// broadcast is resumed. If the broadcast is already paused, only the reason is updated
+func (q *MediaQueue) Pause(reason string) {
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+
+	pausedAt := time.Now()
+	if q.pauseState.Paused {
+		pausedAt = q.pauseState.PausedAt
+	} else if len(q.queue) > 0 {
+		q.queue[0].Pause()
+	}
+	q.pauseState = PauseState{
+		Paused:   true,
+		Reason:   reason,
+		PausedAt: pausedAt,
+	}
+	q.persistInMutex(q.persistStateInMutex)
+	q.pauseStateChanged.Notify(q.pauseState, false)
+}
+
+// Resume continues the playback of the currently playing entry from where it was paused
+func (q *MediaQueue) Resume() error {
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+
+	if !q.pauseState.Paused {
+		return ErrBroadcastNotPaused
+	}
+
+	if len(q.queue) > 0 {
+		q.queue[0].Resume()
+	}
+	q.pauseState = PauseState{
+		PausedAt: q.pauseState.PausedAt,
+	}
+	q.restoredPausedEntryID = ""
+	q.persistInMutex(q.persistStateInMutex)
+	q.pauseStateChanged.Notify(q.pauseState, false)
+	return nil
+}
+
+// playInMutex begins playing the entry, immediately pausing it if the broadcast is paused.
+// If the entry was playing when the broadcast was paused before a restart, it is paused at the position it had reached
+func (q *MediaQueue) playInMutex(entry media.QueueEntry) {
+	entry.Play()
+	if q.pauseState.Paused {
+		playedFor := time.Duration(0)
+		if entry.PerformanceID() == q.restoredPausedEntryID {
+			playedFor = q.restoredPausedEntryPlayedFor
+		}
+		entry.PauseAt(playedFor)
+	}
+	q.restoredPausedEntryID = ""
+}
@@ -0,0 +1,426 @@
+package mediaqueue
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// restoreQueue rebuilds the queue from the database.
+// If nothing was ever persisted in the database and a legacy queue file is specified, the queue is restored from that
+// file instead, and then persisted in the database
+func (q *MediaQueue) restoreQueue(ctxCtx context.Context, legacyPersistenceFile string) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+
+	state, err := types.GetMediaQueueState(ctx, q.channelID)
+	if err != nil {
+		if !errors.Is(err, types.ErrMediaQueueStateNotFound) {
+			return stacktrace.Propagate(err, "")
+		}
+		state = nil
+	}
+
+	if state == nil && legacyPersistenceFile != "" {
+		err = q.restoreQueueFromFileInMutex(ctx, legacyPersistenceFile)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		err = q.restorePlayingSinceFromDatabaseInMutex(ctx)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	} else {
+		err = q.restoreQueueFromDatabaseInMutex(ctx)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		if state != nil {
+			if state.InsertCursor.Valid {
+				for i, entry := range q.queue {
+					if i != 0 && entry.PerformanceID() == state.InsertCursor.String {
+						q.insertCursor = state.InsertCursor.String
+						break
+					}
+				}
+			}
+			if state.PlayingSince.Valid && len(q.queue) > 0 {
+				q.playingSince = state.PlayingSince.Time
+			}
+			if state.PausedAt.Valid {
+				q.pauseState = PauseState{
+					Paused:   true,
+					Reason:   state.PauseReason,
+					PausedAt: state.PausedAt.Time,
+				}
+				q.restoredPausedEntryID = state.PausedEntryID.String
+				q.restoredPausedEntryPlayedFor = time.Duration(state.PausedEntryPlayedFor)
+			}
+			q.orderingPolicy = orderingPolicyFromPersisted(state.OrderingPolicy, state.OrderingPolicyMaxConsecutive)
+		}
+		err = q.restoreScheduledEntriesFromDatabaseInMutex(ctx)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+
+	q.timetableSlots, err = types.GetMediaQueueTimetableSlots(ctx, q.channelID)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	// entries may have been dropped by their providers during deserialization,
+	// so rewrite everything to ensure the persisted positions are contiguous
+	err = q.persistWholeQueueInMutex(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	go q.statsClient.Gauge("queue_length", len(q.queue))
+	q.queueUpdated.Notify(false)
+	return nil
+}
+
+func (q *MediaQueue) restoreQueueFromDatabaseInMutex(ctx transaction.WrappingContext) error {
+	persistedEntries, err := types.GetMediaQueueEntries(ctx, q.channelID)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	q.queue = make([]media.QueueEntry, 0, len(persistedEntries))
+	for _, persistedEntry := range persistedEntries {
+		provider, ok := q.mediaProviders[persistedEntry.MediaType]
+		if !ok {
+			return stacktrace.NewError("unknown media queue entry type %s in persisted queue", persistedEntry.MediaType)
+		}
+
+		entry, keepInQueue, err := provider.UnmarshalQueueEntryJSON(ctx, persistedEntry.EntryData)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+
+		if entry != nil && keepInQueue {
+			q.queue = append(q.queue, entry)
+			if persistedEntry.PlannedStart.Valid && persistedEntry.SchedulingMode != nil {
+				q.entrySchedules[entry.PerformanceID()] = entrySchedule{
+					plannedStart: persistedEntry.PlannedStart.Time,
+					mode:         *persistedEntry.SchedulingMode,
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (q *MediaQueue) restoreScheduledEntriesFromDatabaseInMutex(ctx transaction.WrappingContext) error {
+	persistedEntries, err := types.GetMediaQueueScheduledEntries(ctx, q.channelID)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	q.scheduledEntries = make([]ScheduledEntry, 0, len(persistedEntries))
+	for _, persistedEntry := range persistedEntries {
+		provider, ok := q.mediaProviders[persistedEntry.MediaType]
+		if !ok {
+			return stacktrace.NewError("unknown media queue entry type %s in persisted schedule", persistedEntry.MediaType)
+		}
+
+		entry, keepInQueue, err := provider.UnmarshalQueueEntryJSON(ctx, persistedEntry.EntryData)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+
+		if entry != nil && keepInQueue {
+			q.scheduledEntries = append(q.scheduledEntries, ScheduledEntry{
+				Entry:        entry,
+				PlannedStart: persistedEntry.PlannedStart,
+				Mode:         persistedEntry.SchedulingMode,
+			})
+		}
+	}
+	return nil
+}
+
+func (q *MediaQueue) restoreQueueFromFileInMutex(ctx context.Context, file string) error {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return stacktrace.Propagate(err, "error reading queue from file: %v", err)
+	}
+
+	type unknownTypeEntry struct {
+		Type string
+	}
+
+	var entries []json.RawMessage
+	err = sonic.Unmarshal(b, &entries)
+	if err != nil {
+		return stacktrace.Propagate(err, "error decoding queue from file: %v", err)
+	}
+
+	q.queue = make([]media.QueueEntry, 0, len(entries))
+	for i := range entries {
+		unknownEntry := unknownTypeEntry{}
+		err := sonic.Unmarshal(entries[i], &unknownEntry)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+
+		provider, ok := q.mediaProviders[types.MediaType(unknownEntry.Type)]
+		if !ok {
+			return stacktrace.NewError("unknown media queue entry type %s in persisted queue", unknownEntry.Type)
+		}
+
+		entry, keepInQueue, err := provider.UnmarshalQueueEntryJSON(ctx, entries[i])
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+
+		if entry != nil && keepInQueue {
+			q.queue = append(q.queue, entry)
+		}
+	}
+	return nil
+}
+
+func (q *MediaQueue) restorePlayingSinceFromDatabaseInMutex(ctx transaction.WrappingContext) error {
+	mostRecentEvent, err := types.GetMostRecentMediaQueueEventWithType(ctx, types.MediaQueueEmptied, types.MediaQueueFilled)
+	if err != nil {
+		if !errors.Is(err, types.ErrMediaQueueEventNotFound) {
+			return stacktrace.Propagate(err, "")
+		}
+		q.playingSince = time.Time{}
+		return nil
+	}
+
+	if mostRecentEvent.EventType == types.MediaQueueEmptied {
+		q.playingSince = time.Time{}
+	} else {
+		q.playingSince = mostRecentEvent.CreatedAt
+	}
+	return nil
+}
+
+// persistInMutex runs the given persistence function in a database transaction, and records a snapshot of the queue in
+// the same transaction.
+// If persistence fails, the next persistence attempt will rewrite the whole queue instead,
+// so that the database doesn't remain out of sync with the in-memory queue
+func (q *MediaQueue) persistInMutex(f func(ctx transaction.WrappingContext) error) {
+	var snapshot []byte
+	err := func() error {
+		ctx, err := transaction.Begin(q.persistenceCtx)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		defer ctx.Rollback()
+
+		if q.persistenceOutOfSync {
+			err = q.persistWholeQueueInMutex(ctx)
+		} else {
+			err = f(ctx)
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+
+		snapshot, err = q.recordSnapshotInMutex(ctx)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		return stacktrace.Propagate(ctx.Commit(), "")
+	}()
+	if err != nil {
+		q.persistenceOutOfSync = true
+		q.log.Println("Error persisting media queue:", err)
+		return
+	}
+	q.persistenceOutOfSync = false
+	q.lastSnapshot = snapshot
+}
+
+func (q *MediaQueue) persistWholeQueueInMutex(ctx transaction.WrappingContext) error {
+	err := types.ClearMediaQueueEntries(ctx, q.channelID)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	persistedEntries := make([]*types.MediaQueueEntry, len(q.queue))
+	for i, entry := range q.queue {
+		persistedEntries[i], err = q.persistableQueueEntryInMutex(entry, i)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+	err = types.InsertMediaQueueEntries(ctx, persistedEntries)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = types.ClearMediaQueueScheduledEntries(ctx, q.channelID)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	for _, scheduled := range q.scheduledEntries {
+		err = q.persistScheduledEntryInMutex(ctx, scheduled)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+
+	return stacktrace.Propagate(q.persistStateInMutex(ctx), "")
+}
+
+func (q *MediaQueue) persistStateInMutex(ctx transaction.WrappingContext) error {
+	state := &types.MediaQueueState{
+		ID: q.channelID,
+		InsertCursor: sql.NullString{
+			String: q.insertCursor,
+			Valid:  q.insertCursor != "",
+		},
+		PlayingSince: sql.NullTime{
+			Time:  q.playingSince,
+			Valid: !q.playingSince.IsZero(),
+		},
+		UpdatedAt: time.Now(),
+	}
+	state.OrderingPolicy, state.OrderingPolicyMaxConsecutive = persistableOrderingPolicy(q.orderingPolicy)
+	if q.pauseState.Paused {
+		state.PausedAt = sql.NullTime{
+			Time:  q.pauseState.PausedAt,
+			Valid: true,
+		}
+		state.PauseReason = q.pauseState.Reason
+		if len(q.queue) > 0 && q.queue[0].Playing() {
+			state.PausedEntryID = sql.NullString{
+				String: q.queue[0].PerformanceID(),
+				Valid:  true,
+			}
+			state.PausedEntryPlayedFor = types.Duration(q.queue[0].PlayedFor())
+		} else if q.restoredPausedEntryID != "" {
+			// the entry that was paused before a restart is yet to begin playing again
+			state.PausedEntryID = sql.NullString{
+				String: q.restoredPausedEntryID,
+				Valid:  true,
+			}
+			state.PausedEntryPlayedFor = types.Duration(q.restoredPausedEntryPlayedFor)
+		}
+	}
+	return stacktrace.Propagate(state.Update(ctx), "")
+}
+
+func (q *MediaQueue) persistInsertionInMutex(ctx transaction.WrappingContext, index int) error {
+	return stacktrace.Propagate(q.persistInsertionsInMutex(ctx, index, 1), "")
+}
+
+// persistInsertionsInMutex persists the insertion of count consecutive entries starting at index
+func (q *MediaQueue) persistInsertionsInMutex(ctx transaction.WrappingContext, index, count int) error {
+	err := types.ShiftMediaQueueEntryPositions(ctx, q.channelID, index, count)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	for i := index; i < index+count; i++ {
+		err = q.persistEntryInMutex(ctx, i)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+
+	return stacktrace.Propagate(q.persistStateInMutex(ctx), "")
+}
+
+func (q *MediaQueue) persistRemovalInMutex(ctx transaction.WrappingContext, index int, entry media.QueueEntry) error {
+	persistedEntry, err := q.persistableQueueEntryInMutex(entry, index)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = persistedEntry.Delete(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = types.ShiftMediaQueueEntryPositions(ctx, q.channelID, index+1, -1)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	return stacktrace.Propagate(q.persistStateInMutex(ctx), "")
+}
+
+func (q *MediaQueue) persistEntryInMutex(ctx transaction.WrappingContext, index int) error {
+	persistedEntry, err := q.persistableQueueEntryInMutex(q.queue[index], index)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return stacktrace.Propagate(persistedEntry.Update(ctx), "")
+}
+
+func (q *MediaQueue) persistScheduledEntryInMutex(ctx transaction.WrappingContext, scheduled ScheduledEntry) error {
+	entryData, err := sonic.Marshal(scheduled.Entry)
+	if err != nil {
+		return stacktrace.Propagate(err, "error serializing scheduled entry %s", scheduled.Entry.PerformanceID())
+	}
+	mediaType, _ := scheduled.Entry.MediaInfo().MediaID()
+	persistedEntry := &types.MediaQueueScheduledEntry{
+		ID:             scheduled.Entry.PerformanceID(),
+		ChannelID:      q.channelID,
+		MediaType:      mediaType,
+		EntryData:      entryData,
+		PlannedStart:   scheduled.PlannedStart,
+		SchedulingMode: scheduled.Mode,
+	}
+	return stacktrace.Propagate(persistedEntry.Update(ctx), "")
+}
+
+func (q *MediaQueue) deleteScheduledEntryInMutex(ctx transaction.WrappingContext, scheduled ScheduledEntry) error {
+	persistedEntry := &types.MediaQueueScheduledEntry{
+		ID: scheduled.Entry.PerformanceID(),
+	}
+	return stacktrace.Propagate(persistedEntry.Delete(ctx), "")
+}
+
+func (q *MediaQueue) persistableQueueEntryInMutex(entry media.QueueEntry, position int) (*types.MediaQueueEntry, error) {
+	entryData, err := sonic.Marshal(entry)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "error serializing queue entry %s", entry.PerformanceID())
+	}
+	mediaType, _ := entry.MediaInfo().MediaID()
+	persistedEntry := &types.MediaQueueEntry{
+		ID:        entry.PerformanceID(),
+		ChannelID: q.channelID,
+		Position:  position,
+		MediaType: mediaType,
+		EntryData: entryData,
+	}
+	if schedule, ok := q.entrySchedules[entry.PerformanceID()]; ok {
+		persistedEntry.PlannedStart = sql.NullTime{
+			Time:  schedule.plannedStart,
+			Valid: true,
+		}
+		persistedEntry.SchedulingMode = &schedule.mode
+	}
+	return persistedEntry, nil
+}
@@ -0,0 +1,162 @@
+package mediaqueue
+
+import (
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"golang.org/x/exp/slices"
+)
+
+// ScheduledEntry is a queue entry with a planned start time
+type ScheduledEntry struct {
+	Entry        media.QueueEntry
+	PlannedStart time.Time
+	Mode         types.MediaQueueSchedulingMode
+}
+
+type entrySchedule struct {
+	plannedStart time.Time
+	mode         types.MediaQueueSchedulingMode
+}
+
+// Schedule adds an entry that will only be released into the queue at the planned start time.
+// Entries with the "not before" mode are placed after the currently playing entry and after any other scheduled entries
+// that were already released, so they may begin playing later than planned.
+// Entries with the "exactly at" mode interrupt the currently playing entry so they can begin playing on time.
+// While a timetable slot is active, all scheduled entries interrupt entries that were not scheduled.
+// Timetable slots have no other effect: they don't keep entries that were not scheduled from playing during a slot.
+func (q *MediaQueue) Schedule(entry media.QueueEntry, plannedStart time.Time, mode types.MediaQueueSchedulingMode) {
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+
+	scheduled := ScheduledEntry{
+		Entry:        entry,
+		PlannedStart: plannedStart,
+		Mode:         mode,
+	}
+	index, _ := slices.BinarySearchFunc(q.scheduledEntries, plannedStart, func(e ScheduledEntry, t time.Time) int {
+		if e.PlannedStart.After(t) {
+			return 1
+		}
+		return -1 // keep entries with the same planned start in the order they were scheduled
+	})
+	q.scheduledEntries = slices.Insert(q.scheduledEntries, index, scheduled)
+	q.persistInMutex(func(ctx transaction.WrappingContext) error {
+		return q.persistScheduledEntryInMutex(ctx, scheduled)
+	})
+
+	reqBy := entry.RequestedBy()
+	if reqBy != nil && !reqBy.IsUnknown() {
+		q.recentEntryCountsCache.Delete(reqBy.Address())
+	}
+	q.queueUpdated.Notify(false)
+}
+
+// ScheduledEntries returns the entries that are yet to be released into the queue, ordered by planned start time
+func (q *MediaQueue) ScheduledEntries() []ScheduledEntry {
+	q.queueMutex.RLock()
+	defer q.queueMutex.RUnlock()
+	return slices.Clone(q.scheduledEntries)
+}
+
+// EntrySchedule returns the planned start time and scheduling mode of a queue entry that was released from the schedule
+func (q *MediaQueue) EntrySchedule(entryID string) (time.Time, types.MediaQueueSchedulingMode, bool) {
+	q.queueMutex.RLock()
+	defer q.queueMutex.RUnlock()
+	schedule, ok := q.entrySchedules[entryID]
+	return schedule.plannedStart, schedule.mode, ok
+}
+
+func (q *MediaQueue) removeScheduledEntryInMutex(entryID string) (media.QueueEntry, bool) {
+	for i, scheduled := range q.scheduledEntries {
+		if scheduled.Entry.PerformanceID() != entryID {
+			continue
+		}
+		q.scheduledEntries = slices.Delete(q.scheduledEntries, i, i+1)
+		q.persistInMutex(func(ctx transaction.WrappingContext) error {
+			return stacktrace.Propagate(q.deleteScheduledEntryInMutex(ctx, scheduled), "")
+		})
+		q.entryRemoved.Notify(EntryRemovedEventArg{-1, scheduled.Entry, false}, false)
+		q.queueUpdated.Notify(false)
+		reqBy := scheduled.Entry.RequestedBy()
+		if reqBy != nil && !reqBy.IsUnknown() {
+			q.recentEntryCountsCache.Delete(reqBy.Address())
+		}
+		return scheduled.Entry, true
+	}
+	return nil, false
+}
+
+// nextScheduledReleaseInMutex returns the planned start of the next scheduled entry, or the zero time if nothing is scheduled
+func (q *MediaQueue) nextScheduledReleaseInMutex() time.Time {
+	if len(q.scheduledEntries) == 0 {
+		return time.Time{}
+	}
+	return q.scheduledEntries[0].PlannedStart
+}
+
+func (q *MediaQueue) releaseDueScheduledEntriesInMutex(now time.Time) {
+	for len(q.scheduledEntries) > 0 && !q.scheduledEntries[0].PlannedStart.After(now) {
+		scheduled := q.scheduledEntries[0]
+		q.scheduledEntries = q.scheduledEntries[1:]
+
+		_, slotActive := q.activeTimetableSlotInMutex(now)
+		insertAfterCurrent := false
+		interrupt := scheduled.Mode == types.MediaQueueSchedulingModeExactlyAt ||
+			(slotActive && len(q.queue) > 0 && !q.entryWasScheduledInMutex(q.queue[0]))
+		// like PlayNow, never cut off entries that were paid for as unskippable, nor skip while skipping is disabled;
+		// in that case the scheduled entry plays as soon as the current one ends
+		if interrupt && len(q.queue) > 0 && q.queue[0].Playing() && (q.queue[0].Unskippable() || !q.SkippingEnabled()) {
+			interrupt = false
+			insertAfterCurrent = true
+		}
+
+		insertionIndex := 0
+		switch {
+		case len(q.queue) == 0:
+		case interrupt && !q.queue[0].Playing():
+			// the current entry hasn't started playing yet, take its place
+		case interrupt, insertAfterCurrent:
+			insertionIndex = 1
+		default:
+			insertionIndex = 1
+			for insertionIndex < len(q.queue) && q.entryWasScheduledInMutex(q.queue[insertionIndex]) {
+				insertionIndex++
+			}
+		}
+
+		q.queue = slices.Insert(q.queue, insertionIndex, scheduled.Entry)
+		q.entrySchedules[scheduled.Entry.PerformanceID()] = entrySchedule{
+			plannedStart: scheduled.PlannedStart,
+			mode:         scheduled.Mode,
+		}
+		q.persistInMutex(func(ctx transaction.WrappingContext) error {
+			err := q.deleteScheduledEntryInMutex(ctx, scheduled)
+			if err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+			return stacktrace.Propagate(q.persistInsertionInMutex(ctx, insertionIndex), "")
+		})
+
+		placement := EntryAddedPlacementPlayNext
+		if insertionIndex == 0 {
+			placement = EntryAddedPlacementEnqueue
+		} else if interrupt {
+			placement = EntryAddedPlacementPlayNow
+			q.queue[0].Stop()
+		}
+
+		q.log.Printf("Released scheduled entry \"%s\" planned for %s", scheduled.Entry.MediaInfo().Title(), scheduled.PlannedStart.String())
+		go q.statsClient.Gauge("queue_length", len(q.queue))
+		q.queueUpdated.Notify(false)
+		q.entryAdded.Notify(EntryAddedEventArg{insertionIndex, placement, scheduled.Entry}, false)
+	}
+}
+
+func (q *MediaQueue) entryWasScheduledInMutex(entry media.QueueEntry) bool {
+	_, ok := q.entrySchedules[entry.PerformanceID()]
+	return ok
+}
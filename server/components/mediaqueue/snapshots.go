@@ -0,0 +1,267 @@
+package mediaqueue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/bytedance/sonic"
+	sqltypes "github.com/jmoiron/sqlx/types"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// snapshotRetention is for how long queue snapshots are kept before being deleted
+const snapshotRetention = 7 * 24 * time.Hour
+
+// SnapshotSummary describes a queue snapshot without including its entries
+type SnapshotSummary struct {
+	TakenAt    time.Time
+	EntryCount int
+}
+
+// Snapshot is the contents of the queue at a point in the past
+type Snapshot struct {
+	TakenAt      time.Time
+	Entries      []media.QueueEntry
+	InsertCursor string
+}
+
+type persistedSnapshot struct {
+	Entries      []persistedSnapshotEntry `json:"entries"`
+	InsertCursor string                   `json:"insertCursor,omitempty"`
+}
+
+type persistedSnapshotEntry struct {
+	MediaType types.MediaType `json:"mediaType"`
+	EntryData json.RawMessage `json:"entryData"`
+}
+
+// recordSnapshotInMutex records the current contents of the queue as a snapshot, unless they are the same as those of
+// the last recorded snapshot. Returns the serialized snapshot, which should become q.lastSnapshot once the transaction
+// is committed
+func (q *MediaQueue) recordSnapshotInMutex(ctx transaction.WrappingContext) ([]byte, error) {
+	snapshot := persistedSnapshot{
+		Entries:      make([]persistedSnapshotEntry, len(q.queue)),
+		InsertCursor: q.insertCursor,
+	}
+	for i, entry := range q.queue {
+		entryData, err := sonic.Marshal(entry)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "error serializing queue entry %s", entry.PerformanceID())
+		}
+		mediaType, _ := entry.MediaInfo().MediaID()
+		snapshot.Entries[i] = persistedSnapshotEntry{
+			MediaType: mediaType,
+			EntryData: entryData,
+		}
+	}
+
+	snapshotData, err := sonic.Marshal(snapshot)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if bytes.Equal(snapshotData, q.lastSnapshot) {
+		return snapshotData, nil
+	}
+
+	now := time.Now()
+	err = types.InsertMediaQueueEvents(ctx, []*types.MediaQueueEvent{
+		{
+			CreatedAt: now,
+			EventType: types.MediaQueueSnapshotTaken,
+			ChannelID: q.channelID,
+			Snapshot: sqltypes.NullJSONText{
+				JSONText: snapshotData,
+				Valid:    true,
+			},
+		},
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = types.DeleteMediaQueueSnapshotsOlderThan(ctx, q.channelID, now.Add(-snapshotRetention))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return snapshotData, nil
+}
+
+// Snapshots returns summaries of the recorded snapshots of the queue, most recent first
+func (q *MediaQueue) Snapshots(ctxCtx context.Context, pagParams *types.PaginationParams) ([]SnapshotSummary, uint64, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	events, total, err := types.GetMediaQueueSnapshots(ctx, q.channelID, pagParams)
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "")
+	}
+
+	summaries := make([]SnapshotSummary, len(events))
+	for i, event := range events {
+		var snapshot persistedSnapshot
+		err = event.Snapshot.Unmarshal(&snapshot)
+		if err != nil {
+			return nil, 0, stacktrace.Propagate(err, "")
+		}
+		summaries[i] = SnapshotSummary{
+			TakenAt:    event.CreatedAt,
+			EntryCount: len(snapshot.Entries),
+		}
+	}
+	return summaries, total, nil
+}
+
+// Snapshot returns the snapshot of the queue taken at the specified time.
+// Entries whose media providers no longer consider valid (e.g. application pages) are omitted
+func (q *MediaQueue) Snapshot(ctxCtx context.Context, takenAt time.Time) (*Snapshot, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	return q.loadSnapshot(ctx, takenAt)
+}
+
+func (q *MediaQueue) loadSnapshot(ctx transaction.WrappingContext, takenAt time.Time) (*Snapshot, error) {
+	event, err := types.GetMediaQueueSnapshot(ctx, q.channelID, takenAt)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	var persisted persistedSnapshot
+	err = event.Snapshot.Unmarshal(&persisted)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	snapshot := &Snapshot{
+		TakenAt:      event.CreatedAt,
+		Entries:      make([]media.QueueEntry, 0, len(persisted.Entries)),
+		InsertCursor: persisted.InsertCursor,
+	}
+	for _, persistedEntry := range persisted.Entries {
+		provider, ok := q.mediaProviders[persistedEntry.MediaType]
+		if !ok {
+			return nil, stacktrace.NewError("unknown media queue entry type %s in queue snapshot", persistedEntry.MediaType)
+		}
+
+		entry, keepInQueue, err := provider.UnmarshalQueueEntryJSON(ctx, persistedEntry.EntryData)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+
+		if entry != nil && keepInQueue {
+			snapshot.Entries = append(snapshot.Entries, entry)
+		}
+	}
+	return snapshot, nil
+}
+
+// RestoreSnapshot reorganizes the queue so that it matches the snapshot taken at the specified time, as closely as
+// possible without charging or refunding anyone:
+//   - the currently playing entry is kept playing;
+//   - entries of the snapshot that are still in the queue are moved to their position in the snapshot;
+//   - entries of the snapshot that have since been removed are brought back, with no request cost, since their
+//     requesters were already reimbursed when they were removed;
+//   - entries of the snapshot that have since been played are not brought back;
+//   - entries that were added to the queue after the snapshot was taken are placed at the end of the queue.
+//
+// Returns the number of entries that were brought back into the queue
+func (q *MediaQueue) RestoreSnapshot(ctxCtx context.Context, takenAt time.Time) (int, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	snapshot, err := q.loadSnapshot(ctx, takenAt)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+
+	liveEntries := make(map[string]media.QueueEntry, len(q.queue))
+	for _, entry := range q.queue {
+		liveEntries[entry.PerformanceID()] = entry
+	}
+
+	missingIDs := []string{}
+	for _, entry := range snapshot.Entries {
+		if _, ok := liveEntries[entry.PerformanceID()]; !ok {
+			missingIDs = append(missingIDs, entry.PerformanceID())
+		}
+	}
+	playedMedia, err := types.GetPlayedMediaWithIDs(ctx, missingIDs)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+
+	newQueue := make([]media.QueueEntry, 0, len(q.queue)+len(snapshot.Entries))
+	placed := make(map[string]struct{}, cap(newQueue))
+	place := func(entry media.QueueEntry) {
+		newQueue = append(newQueue, entry)
+		placed[entry.PerformanceID()] = struct{}{}
+	}
+
+	if len(q.queue) > 0 {
+		place(q.queue[0])
+	}
+
+	restored := 0
+	affectedRequesters := make(map[string]struct{})
+	for _, entry := range snapshot.Entries {
+		if _, ok := placed[entry.PerformanceID()]; ok {
+			continue
+		}
+		if liveEntry, ok := liveEntries[entry.PerformanceID()]; ok {
+			place(liveEntry)
+			continue
+		}
+		if _, ok := playedMedia[entry.PerformanceID()]; ok {
+			// this includes the entry that was playing when the snapshot was taken
+			continue
+		}
+		place(entry.ActionableMediaInfo().ProduceMediaQueueEntry(
+			entry.RequestedBy(), payment.NewAmount(), entry.Unskippable(), entry.Concealed(), entry.PerformanceID()))
+		restored++
+		if reqBy := entry.RequestedBy(); reqBy != nil && !reqBy.IsUnknown() {
+			affectedRequesters[reqBy.Address()] = struct{}{}
+		}
+	}
+
+	for _, entry := range q.queue {
+		if _, ok := placed[entry.PerformanceID()]; !ok {
+			place(entry)
+		}
+	}
+
+	q.queue = newQueue
+	q.insertCursor = ""
+	for i, entry := range q.queue {
+		if i != 0 && entry.PerformanceID() == snapshot.InsertCursor {
+			q.insertCursor = snapshot.InsertCursor
+			break
+		}
+	}
+
+	q.persistInMutex(q.persistWholeQueueInMutex)
+	for address := range affectedRequesters {
+		q.recentEntryCountsCache.Delete(address)
+	}
+
+	go q.statsClient.Gauge("queue_length", len(q.queue))
+	q.queueUpdated.Notify(false)
+	return restored, nil
+}
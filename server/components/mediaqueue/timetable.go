@@ -0,0 +1,147 @@
+package mediaqueue
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"golang.org/x/exp/slices"
+)
+
+const week = 7 * 24 * time.Hour
+
+// TimetableSlotOccurrence returns the start of the occurrence of the slot that is either ongoing or next, at the
+// given time, and whether that occurrence is ongoing
+func TimetableSlotOccurrence(slot *types.MediaQueueTimetableSlot, now time.Time) (time.Time, bool) {
+	now = now.UTC()
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	weekStart := midnight.AddDate(0, 0, -int(now.Weekday()))
+	start := weekStart.AddDate(0, 0, slot.Weekday).Add(time.Duration(slot.StartTimeOfDay))
+
+	// the occurrence from the previous week may still be ongoing if the slot crosses the end of the week
+	for _, s := range []time.Time{start.Add(-week), start, start.Add(week)} {
+		if now.Before(s.Add(time.Duration(slot.Duration))) {
+			return s, !now.Before(s)
+		}
+	}
+	return start.Add(2 * week), false
+}
+
+// TimetableSlots returns the timetable slots, in weekly order
+func (q *MediaQueue) TimetableSlots() []*types.MediaQueueTimetableSlot {
+	q.queueMutex.RLock()
+	defer q.queueMutex.RUnlock()
+	return slices.Clone(q.timetableSlots)
+}
+
+// ActiveTimetableSlot returns the timetable slot that is currently active, if any
+func (q *MediaQueue) ActiveTimetableSlot() (*types.MediaQueueTimetableSlot, bool) {
+	q.queueMutex.RLock()
+	defer q.queueMutex.RUnlock()
+	return q.activeTimetableSlotInMutex(time.Now())
+}
+
+func (q *MediaQueue) activeTimetableSlotInMutex(now time.Time) (*types.MediaQueueTimetableSlot, bool) {
+	for _, slot := range q.timetableSlots {
+		if _, active := TimetableSlotOccurrence(slot, now); active {
+			return slot, true
+		}
+	}
+	return nil, false
+}
+
+// SetTimetableSlot creates or updates a timetable slot of the channel this queue belongs to
+func (q *MediaQueue) SetTimetableSlot(ctxCtx context.Context, slot *types.MediaQueueTimetableSlot) error {
+	slot.ChannelID = q.channelID
+	if slot.Weekday < 0 || slot.Weekday > 6 {
+		return stacktrace.NewError("invalid weekday")
+	}
+	if slot.StartTimeOfDay < 0 || time.Duration(slot.StartTimeOfDay) >= 24*time.Hour {
+		return stacktrace.NewError("invalid start time of day")
+	}
+	if slot.Duration <= 0 || time.Duration(slot.Duration) > week {
+		return stacktrace.NewError("invalid duration")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+
+	existing, err := types.GetMediaQueueTimetableSlotWithID(ctx, slot.ID)
+	if err == nil && existing.ChannelID != q.channelID {
+		return stacktrace.NewError("timetable slot belongs to a different channel")
+	} else if err != nil && !errors.Is(err, types.ErrMediaQueueTimetableSlotNotFound) {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = slot.Update(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	q.timetableSlots = slices.DeleteFunc(q.timetableSlots, func(s *types.MediaQueueTimetableSlot) bool {
+		return s.ID == slot.ID
+	})
+	q.timetableSlots = append(q.timetableSlots, slot)
+	sortTimetableSlots(q.timetableSlots)
+	q.queueUpdated.Notify(false)
+	return nil
+}
+
+// RemoveTimetableSlot removes the timetable slot with the given ID
+func (q *MediaQueue) RemoveTimetableSlot(ctxCtx context.Context, slotID string) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	q.queueMutex.Lock()
+	defer q.queueMutex.Unlock()
+
+	slot, err := types.GetMediaQueueTimetableSlotWithID(ctx, slotID)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if slot.ChannelID != q.channelID {
+		return stacktrace.Propagate(types.ErrMediaQueueTimetableSlotNotFound, "")
+	}
+
+	err = slot.Delete(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	q.timetableSlots = slices.DeleteFunc(q.timetableSlots, func(s *types.MediaQueueTimetableSlot) bool {
+		return s.ID == slotID
+	})
+	q.queueUpdated.Notify(false)
+	return nil
+}
+
+func sortTimetableSlots(slots []*types.MediaQueueTimetableSlot) {
+	slices.SortStableFunc(slots, func(a, b *types.MediaQueueTimetableSlot) int {
+		if a.Weekday != b.Weekday {
+			return a.Weekday - b.Weekday
+		}
+		return int(a.StartTimeOfDay - b.StartTimeOfDay)
+	})
+}
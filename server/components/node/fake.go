@@ -0,0 +1,625 @@
+package node
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"sync"
+
+	"github.com/hectorchu/gonano/rpc"
+	"github.com/hectorchu/gonano/util"
+	"github.com/hectorchu/gonano/wallet"
+	"github.com/palantir/stacktrace"
+)
+
+// fakeEpoch is the local timestamp of the first block created by a FakeNode. Each subsequent block is one second newer
+const fakeEpoch = 1600000000
+
+// FakeNode is a deterministic, in-memory simulation of a node, meant for exercising the components that move funds
+// without a real node. It implements RPC and keeps track of account chains, receivables and block confirmations.
+// Blocks are confirmed as soon as they are created, unless automatic confirmation is disabled with SetAutoConfirm
+type FakeNode struct {
+	mu          sync.Mutex
+	chains      map[string][]*fakeBlock
+	blocks      map[string]*fakeBlock
+	receivables map[string][]*fakeBlock // unreceived send blocks, by destination account, in creation order
+	blockSeq    uint64
+	autoConfirm bool
+}
+
+type fakeBlock struct {
+	hash           rpc.BlockHash
+	account        string
+	subtype        string
+	previous       rpc.BlockHash
+	link           rpc.BlockHash // for receives, the hash of the send block being received
+	destination    string        // for sends, the account receiving the funds
+	amount         *big.Int
+	balance        *big.Int
+	height         uint64
+	representative string
+	timestamp      uint64
+	confirmed      bool
+	external       bool // whether the block was published by an account the node does not keep the chain of
+}
+
+// NewFakeNode returns a new FakeNode with an empty ledger
+func NewFakeNode() *FakeNode {
+	return &FakeNode{
+		chains:      make(map[string][]*fakeBlock),
+		blocks:      make(map[string]*fakeBlock),
+		receivables: make(map[string][]*fakeBlock),
+		autoConfirm: true,
+	}
+}
+
+// NewWallet returns a Wallet whose accounts are derived from seed in the same way as in a real wallet, but which live
+// on this fake node
+func (n *FakeNode) NewWallet(seed []byte) (Wallet, error) {
+	derivation, err := wallet.NewBananoWallet(seed)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &fakeWallet{
+		node:       n,
+		derivation: derivation,
+		accounts:   make(map[string]*fakeAccount),
+	}, nil
+}
+
+// SetAutoConfirm sets whether new blocks are confirmed as soon as they are created
+func (n *FakeNode) SetAutoConfirm(autoConfirm bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.autoConfirm = autoConfirm
+}
+
+// Fund publishes a send block from an account whose chain is not simulated, such as the account of a user paying for
+// an enqueue request. The destination can then receive the amount once the block is confirmed
+func (n *FakeNode) Fund(from, to string, amount *big.Int) (rpc.BlockHash, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if _, err := util.AddressToPubkey(to); err != nil {
+		return nil, stacktrace.Propagate(err, "invalid destination address")
+	}
+
+	b := n.newBlock(from, "send")
+	b.destination = to
+	b.amount = new(big.Int).Set(amount)
+	b.balance = big.NewInt(0)
+	b.height = 1
+	b.external = true
+	n.receivables[to] = append(n.receivables[to], b)
+	return b.hash, nil
+}
+
+// Confirm confirms the block with the given hash, along with all the blocks that precede it in its account chain
+func (n *FakeNode) Confirm(hash rpc.BlockHash) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	b, ok := n.blocks[hash.String()]
+	if !ok {
+		return stacktrace.NewError("Block not found")
+	}
+	n.confirm(b)
+	return nil
+}
+
+// ConfirmAll confirms all the blocks in the ledger
+func (n *FakeNode) ConfirmAll() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for _, b := range n.blocks {
+		b.confirmed = true
+	}
+}
+
+// DropUnconfirmed removes all unconfirmed blocks from the ledger, as happens with blocks that never get confirmed by
+// the network. Funds involved in dropped receive blocks become receivable again
+func (n *FakeNode) DropUnconfirmed() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for account, chain := range n.chains {
+		cut := len(chain)
+		for cut > 0 && !chain[cut-1].confirmed {
+			cut--
+		}
+		for i := len(chain) - 1; i >= cut; i-- {
+			b := chain[i]
+			delete(n.blocks, b.hash.String())
+			switch b.subtype {
+			case "send":
+				n.removeReceivable(b.destination, b)
+			case "receive", "open":
+				n.receivables[account] = append(n.receivables[account], n.blocks[b.link.String()])
+			}
+		}
+		if cut == 0 {
+			delete(n.chains, account)
+		} else {
+			n.chains[account] = chain[:cut]
+		}
+	}
+
+	for hash, b := range n.blocks {
+		if b.external && !b.confirmed {
+			delete(n.blocks, hash)
+			n.removeReceivable(b.destination, b)
+		}
+	}
+}
+
+// AccountBalance returns the balance of the account including unconfirmed blocks, or zero if the account is not open
+func (n *FakeNode) AccountBalance(account string) *big.Int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.frontierBalance(account)
+}
+
+// Receivable returns the sum of all the amounts that were sent to the account and not yet received by it, including
+// amounts whose send blocks are not confirmed
+func (n *FakeNode) Receivable(account string) *big.Int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	sum := big.NewInt(0)
+	for _, b := range n.receivables[account] {
+		sum.Add(sum, b.amount)
+	}
+	return sum
+}
+
+// AccountInfo implements RPC
+func (n *FakeNode) AccountInfo(account string) (rpc.AccountInfo, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	chain, ok := n.chains[account]
+	if !ok {
+		return rpc.AccountInfo{}, stacktrace.NewError("Account not found")
+	}
+	frontier := chain[len(chain)-1]
+	info := rpc.AccountInfo{
+		Frontier:            frontier.hash,
+		OpenBlock:           chain[0].hash,
+		RepresentativeBlock: frontier.hash,
+		Balance:             &rpc.RawAmount{Int: *new(big.Int).Set(frontier.balance)},
+		ModifiedTimestamp:   frontier.timestamp,
+		BlockCount:          uint64(len(chain)),
+		Representative:      frontier.representative,
+		Weight:              &rpc.RawAmount{},
+		Pending:             &rpc.RawAmount{Int: *n.confirmedReceivable(account, nil)},
+	}
+	if confirmedFrontier := n.confirmedFrontier(account); confirmedFrontier != nil {
+		info.ConfirmationHeight = confirmedFrontier.height
+		info.ConfirmationHeightFrontier = confirmedFrontier.hash
+	}
+	return info, nil
+}
+
+// AccountRepresentative implements RPC
+func (n *FakeNode) AccountRepresentative(account string) (string, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	chain, ok := n.chains[account]
+	if !ok {
+		return "", stacktrace.NewError("Account not found")
+	}
+	return chain[len(chain)-1].representative, nil
+}
+
+// AccountHistory implements RPC. Entries are returned from the newest to the oldest
+func (n *FakeNode) AccountHistory(account string, count int64, head rpc.BlockHash) ([]rpc.AccountHistory, rpc.BlockHash, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	chain := n.chains[account]
+	start := len(chain) - 1
+	if head != nil {
+		b, ok := n.blocks[head.String()]
+		if !ok || b.account != account || b.external {
+			return nil, nil, stacktrace.NewError("Block not found")
+		}
+		start = int(b.height) - 1
+	}
+
+	history := []rpc.AccountHistory{}
+	i := start
+	for ; i >= 0 && (count < 0 || int64(len(history)) < count); i-- {
+		b := chain[i]
+		entry := rpc.AccountHistory{
+			Type:           "send",
+			Account:        b.destination,
+			Amount:         &rpc.RawAmount{Int: *new(big.Int).Set(b.amount)},
+			LocalTimestamp: b.timestamp,
+			Height:         b.height,
+			Hash:           b.hash,
+		}
+		if b.subtype != "send" {
+			entry.Type = "receive"
+			entry.Account = n.blocks[b.link.String()].account
+		}
+		history = append(history, entry)
+	}
+
+	var previous rpc.BlockHash
+	if i >= 0 {
+		previous = chain[i].hash
+	}
+	return history, previous, nil
+}
+
+// AccountsPending implements RPC. Like on a real node, only receivables whose send blocks are confirmed are included,
+// and accounts without receivables are left out of the result
+func (n *FakeNode) AccountsPending(accounts []string, count int64, threshold *rpc.RawAmount) (map[string]rpc.HashToPendingMap, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	result := make(map[string]rpc.HashToPendingMap)
+	for _, account := range accounts {
+		pendings := n.pendings(account, count, threshold)
+		if len(pendings) > 0 {
+			result[account] = pendings
+		}
+	}
+	return result, nil
+}
+
+// BlockInfo implements RPC
+func (n *FakeNode) BlockInfo(hash rpc.BlockHash) (rpc.BlockInfo, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	b, ok := n.blocks[hash.String()]
+	if !ok {
+		return rpc.BlockInfo{}, stacktrace.NewError("Block not found")
+	}
+	return n.blockInfo(b), nil
+}
+
+// BlocksInfoIncludingNotFound implements RPC
+func (n *FakeNode) BlocksInfoIncludingNotFound(hashes []rpc.BlockHash) (map[string]*rpc.BlockInfo, []rpc.BlockHash, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	blocks := make(map[string]*rpc.BlockInfo)
+	notFound := []rpc.BlockHash{}
+	for _, hash := range hashes {
+		b, ok := n.blocks[hash.String()]
+		if !ok {
+			notFound = append(notFound, hash)
+			continue
+		}
+		info := n.blockInfo(b)
+		blocks[hash.String()] = &info
+	}
+	return blocks, notFound, nil
+}
+
+// BlockCount implements RPC
+func (n *FakeNode) BlockCount() (cemented, count, unchecked uint64, err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for _, b := range n.blocks {
+		if b.confirmed {
+			cemented++
+		}
+	}
+	return cemented, uint64(len(n.blocks)), 0, nil
+}
+
+// DelegatorsCount implements RPC
+func (n *FakeNode) DelegatorsCount(account string) (uint64, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	count := uint64(0)
+	for _, chain := range n.chains {
+		if chain[len(chain)-1].representative == account {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// newBlock must be called with the lock held
+func (n *FakeNode) newBlock(account, subtype string) *fakeBlock {
+	n.blockSeq++
+	hashInput := make([]byte, 8)
+	binary.BigEndian.PutUint64(hashInput, n.blockSeq)
+	hash := sha256.Sum256(append([]byte("fakenode"), hashInput...))
+
+	b := &fakeBlock{
+		hash:      rpc.BlockHash(hash[:]),
+		account:   account,
+		subtype:   subtype,
+		timestamp: fakeEpoch + n.blockSeq,
+		confirmed: n.autoConfirm,
+	}
+	n.blocks[b.hash.String()] = b
+	return b
+}
+
+// appendBlock must be called with the lock held
+func (n *FakeNode) appendBlock(b *fakeBlock) {
+	chain := n.chains[b.account]
+	if len(chain) > 0 {
+		b.previous = chain[len(chain)-1].hash
+	}
+	b.height = uint64(len(chain) + 1)
+	n.chains[b.account] = append(chain, b)
+	if b.confirmed {
+		n.confirm(b)
+	}
+}
+
+// confirm must be called with the lock held
+func (n *FakeNode) confirm(b *fakeBlock) {
+	b.confirmed = true
+	if b.external {
+		return
+	}
+	for _, preceding := range n.chains[b.account][:b.height] {
+		preceding.confirmed = true
+	}
+}
+
+// frontierBalance must be called with the lock held
+func (n *FakeNode) frontierBalance(account string) *big.Int {
+	chain := n.chains[account]
+	if len(chain) == 0 {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Set(chain[len(chain)-1].balance)
+}
+
+// confirmedFrontier must be called with the lock held
+func (n *FakeNode) confirmedFrontier(account string) *fakeBlock {
+	chain := n.chains[account]
+	for i := len(chain) - 1; i >= 0; i-- {
+		if chain[i].confirmed {
+			return chain[i]
+		}
+	}
+	return nil
+}
+
+// pendings must be called with the lock held
+func (n *FakeNode) pendings(account string, count int64, threshold *rpc.RawAmount) rpc.HashToPendingMap {
+	pendings := make(rpc.HashToPendingMap)
+	for _, b := range n.receivables[account] {
+		if count >= 0 && int64(len(pendings)) >= count {
+			break
+		}
+		if !b.confirmed || (threshold != nil && b.amount.Cmp(&threshold.Int) < 0) {
+			continue
+		}
+		pendings[b.hash.String()] = rpc.AccountPending{
+			Amount: &rpc.RawAmount{Int: *new(big.Int).Set(b.amount)},
+			Source: b.account,
+		}
+	}
+	return pendings
+}
+
+// confirmedReceivable must be called with the lock held
+func (n *FakeNode) confirmedReceivable(account string, threshold *big.Int) *big.Int {
+	sum := big.NewInt(0)
+	var t *rpc.RawAmount
+	if threshold != nil {
+		t = &rpc.RawAmount{Int: *threshold}
+	}
+	for _, pending := range n.pendings(account, -1, t) {
+		sum.Add(sum, &pending.Amount.Int)
+	}
+	return sum
+}
+
+// removeReceivable must be called with the lock held
+func (n *FakeNode) removeReceivable(account string, b *fakeBlock) {
+	receivables := n.receivables[account]
+	for i := range receivables {
+		if receivables[i] == b {
+			n.receivables[account] = append(receivables[:i:i], receivables[i+1:]...)
+			return
+		}
+	}
+}
+
+// blockInfo must be called with the lock held
+func (n *FakeNode) blockInfo(b *fakeBlock) rpc.BlockInfo {
+	contents := &rpc.Block{
+		Type:           "state",
+		Account:        b.account,
+		Previous:       b.previous,
+		Representative: b.representative,
+		Balance:        &rpc.RawAmount{Int: *new(big.Int).Set(b.balance)},
+		Link:           b.link,
+	}
+	if b.subtype == "send" {
+		contents.LinkAsAccount = b.destination
+		if pubkey, err := util.AddressToPubkey(b.destination); err == nil {
+			contents.Link = pubkey
+		}
+	}
+	return rpc.BlockInfo{
+		BlockAccount:   b.account,
+		Amount:         &rpc.RawAmount{Int: *new(big.Int).Set(b.amount)},
+		Balance:        &rpc.RawAmount{Int: *new(big.Int).Set(b.balance)},
+		Height:         b.height,
+		LocalTimestamp: b.timestamp,
+		Confirmed:      b.confirmed,
+		Contents:       contents,
+		Subtype:        b.subtype,
+	}
+}
+
+// send must be called with the lock held
+func (n *FakeNode) send(from, to, representative string, amount *big.Int) (rpc.BlockHash, error) {
+	if _, err := util.AddressToPubkey(to); err != nil {
+		return nil, stacktrace.Propagate(err, "invalid destination address")
+	}
+	if _, ok := n.chains[from]; !ok {
+		return nil, stacktrace.NewError("Account not found")
+	}
+	if amount.Sign() < 0 {
+		return nil, stacktrace.NewError("cannot send a negative amount")
+	}
+	balance := n.frontierBalance(from)
+	if balance.Cmp(amount) < 0 {
+		return nil, stacktrace.NewError("insufficient balance")
+	}
+
+	b := n.newBlock(from, "send")
+	b.destination = to
+	b.amount = new(big.Int).Set(amount)
+	b.balance = balance.Sub(balance, amount)
+	b.representative = representative
+	n.appendBlock(b)
+	n.receivables[to] = append(n.receivables[to], b)
+	return b.hash, nil
+}
+
+// receive must be called with the lock held
+func (n *FakeNode) receive(account, representative string, threshold *big.Int) rpc.HashToPendingMap {
+	var t *rpc.RawAmount
+	if threshold != nil {
+		t = &rpc.RawAmount{Int: *threshold}
+	}
+	pendings := n.pendings(account, -1, t)
+
+	// go through the receivables rather than the map, so that blocks are created in a deterministic order
+	for _, source := range append([]*fakeBlock{}, n.receivables[account]...) {
+		if _, ok := pendings[source.hash.String()]; !ok {
+			continue
+		}
+		subtype := "receive"
+		if len(n.chains[account]) == 0 {
+			subtype = "open"
+		}
+		balance := n.frontierBalance(account)
+		b := n.newBlock(account, subtype)
+		b.link = source.hash
+		b.amount = new(big.Int).Set(source.amount)
+		b.balance = balance.Add(balance, source.amount)
+		b.representative = representative
+		n.appendBlock(b)
+		n.removeReceivable(account, source)
+	}
+	return pendings
+}
+
+type fakeWallet struct {
+	node       *FakeNode
+	derivation *wallet.Wallet // only used to derive account addresses, never to talk to a node
+	mu         sync.Mutex
+	accounts   map[string]*fakeAccount
+}
+
+func (w *fakeWallet) NewAccount(index *uint32) (Account, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	derived, err := w.derivation.NewAccount(index)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if a, ok := w.accounts[derived.Address()]; ok {
+		return a, nil
+	}
+	a := &fakeAccount{
+		node:    w.node,
+		address: derived.Address(),
+		index:   derived.Index(),
+		// like in real wallets, accounts have some representative even before one is explicitly set
+		representative: derived.Address(),
+	}
+	w.accounts[a.address] = a
+	return a, nil
+}
+
+func (w *fakeWallet) GetAccount(address string) Account {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	a, ok := w.accounts[address]
+	if !ok {
+		return nil
+	}
+	return a
+}
+
+func (w *fakeWallet) RPC() RPC {
+	return w.node
+}
+
+type fakeAccount struct {
+	node           *FakeNode
+	address        string
+	index          uint32
+	mu             sync.Mutex
+	representative string
+}
+
+func (a *fakeAccount) Address() string {
+	return a.address
+}
+
+func (a *fakeAccount) Index() uint32 {
+	return a.index
+}
+
+func (a *fakeAccount) Balance() (*big.Int, *big.Int, error) {
+	a.node.mu.Lock()
+	defer a.node.mu.Unlock()
+
+	balance := big.NewInt(0)
+	if frontier := a.node.confirmedFrontier(a.address); frontier != nil {
+		balance.Set(frontier.balance)
+	}
+	return balance, a.node.confirmedReceivable(a.address, nil), nil
+}
+
+func (a *fakeAccount) Send(account string, amount *big.Int) (rpc.BlockHash, error) {
+	representative := a.currentRep()
+
+	a.node.mu.Lock()
+	defer a.node.mu.Unlock()
+	hash, err := a.node.send(a.address, account, representative, amount)
+	return hash, stacktrace.Propagate(err, "")
+}
+
+func (a *fakeAccount) ReceivePendings(threshold *big.Int) error {
+	_, err := a.ReceiveAndReturnPendings(threshold)
+	return stacktrace.Propagate(err, "")
+}
+
+func (a *fakeAccount) ReceiveAndReturnPendings(threshold *big.Int) (rpc.HashToPendingMap, error) {
+	representative := a.currentRep()
+
+	a.node.mu.Lock()
+	defer a.node.mu.Unlock()
+	return a.node.receive(a.address, representative, threshold), nil
+}
+
+func (a *fakeAccount) SetRep(representative string) error {
+	if _, err := util.AddressToPubkey(representative); err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.representative = representative
+	return nil
+}
+
+func (a *fakeAccount) currentRep() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.representative
+}
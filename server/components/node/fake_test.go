@@ -0,0 +1,114 @@
+package node_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hectorchu/gonano/rpc"
+	"github.com/tnyim/jungletv/server/components/node"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAccount(t *testing.T, n *node.FakeNode, seedByte byte, index uint32) node.Account {
+	seed := make([]byte, 32)
+	seed[0] = seedByte
+	w, err := n.NewWallet(seed)
+	require.NoError(t, err)
+	account, err := w.NewAccount(&index)
+	require.NoError(t, err)
+	return account
+}
+
+func TestFakeNodeReceiveAndSend(t *testing.T) {
+	n := node.NewFakeNode()
+	alice := newTestAccount(t, n, 1, 0)
+	bob := newTestAccount(t, n, 2, 0)
+
+	_, err := n.AccountInfo(alice.Address())
+	require.Error(t, err, "unopened accounts must not have account info")
+
+	_, err = n.Fund(bob.Address(), alice.Address(), big.NewInt(1000))
+	require.NoError(t, err)
+
+	balance, pending, err := alice.Balance()
+	require.NoError(t, err)
+	require.Zero(t, balance.Int64())
+	require.EqualValues(t, 1000, pending.Int64())
+
+	received, err := alice.ReceiveAndReturnPendings(big.NewInt(0))
+	require.NoError(t, err)
+	require.Len(t, received, 1)
+
+	_, err = alice.Send(bob.Address(), big.NewInt(1001))
+	require.Error(t, err, "sends above the balance must fail")
+
+	hash, err := alice.Send(bob.Address(), big.NewInt(400))
+	require.NoError(t, err)
+
+	info, err := n.AccountInfo(alice.Address())
+	require.NoError(t, err)
+	require.EqualValues(t, 600, info.Balance.Int64())
+	require.EqualValues(t, 2, info.BlockCount)
+	require.Equal(t, hash, info.Frontier)
+
+	history, _, err := n.AccountHistory(alice.Address(), -1, nil)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, "send", history[0].Type)
+	require.Equal(t, bob.Address(), history[0].Account)
+	require.Equal(t, "receive", history[1].Type)
+
+	require.EqualValues(t, 400, n.Receivable(bob.Address()).Int64())
+}
+
+func TestFakeNodeConfirmations(t *testing.T) {
+	n := node.NewFakeNode()
+	n.SetAutoConfirm(false)
+	alice := newTestAccount(t, n, 1, 0)
+
+	hash, err := n.Fund(alice.Address(), alice.Address(), big.NewInt(1000))
+	require.NoError(t, err)
+
+	pendings, err := n.AccountsPending([]string{alice.Address()}, -1, nil)
+	require.NoError(t, err)
+	require.Empty(t, pendings, "receivables of unconfirmed sends must not be returned")
+
+	require.NoError(t, n.Confirm(hash))
+	require.NoError(t, alice.ReceivePendings(big.NewInt(0)))
+
+	// the receive is not yet confirmed, so the confirmed balance excludes it
+	balance, pending, err := alice.Balance()
+	require.NoError(t, err)
+	require.Zero(t, balance.Int64())
+	require.Zero(t, pending.Int64())
+	require.EqualValues(t, 1000, n.AccountBalance(alice.Address()).Int64())
+
+	sendHash, err := alice.Send(alice.Address(), big.NewInt(300))
+	require.NoError(t, err)
+	cemented, count, _, err := n.BlockCount()
+	require.NoError(t, err)
+	require.EqualValues(t, 1, cemented)
+	require.EqualValues(t, 3, count)
+
+	// dropping unconfirmed blocks makes the funded amount receivable again
+	n.DropUnconfirmed()
+	blocks, notFound, err := n.BlocksInfoIncludingNotFound([]rpc.BlockHash{hash, sendHash})
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+	require.Len(t, notFound, 1)
+	require.Zero(t, n.AccountBalance(alice.Address()).Int64())
+	require.EqualValues(t, 1000, n.Receivable(alice.Address()).Int64())
+}
+
+func TestFakeNodeIsDeterministic(t *testing.T) {
+	hashes := []rpc.BlockHash{}
+	for i := 0; i < 2; i++ {
+		n := node.NewFakeNode()
+		alice := newTestAccount(t, n, 1, 5)
+		hash, err := n.Fund(alice.Address(), alice.Address(), big.NewInt(1000))
+		require.NoError(t, err)
+		hashes = append(hashes, hash)
+	}
+	require.Equal(t, hashes[0], hashes[1])
+}
@@ -0,0 +1,79 @@
+package node
+
+import (
+	"math/big"
+
+	"github.com/hectorchu/gonano/rpc"
+	"github.com/hectorchu/gonano/wallet"
+)
+
+// RPC is the subset of the node RPC that the server uses
+type RPC interface {
+	AccountInfo(account string) (rpc.AccountInfo, error)
+	AccountRepresentative(account string) (string, error)
+	AccountHistory(account string, count int64, head rpc.BlockHash) ([]rpc.AccountHistory, rpc.BlockHash, error)
+	// AccountsPending only returns receivables whose send blocks are confirmed
+	AccountsPending(accounts []string, count int64, threshold *rpc.RawAmount) (map[string]rpc.HashToPendingMap, error)
+	BlockInfo(hash rpc.BlockHash) (rpc.BlockInfo, error)
+	BlocksInfoIncludingNotFound(hashes []rpc.BlockHash) (map[string]*rpc.BlockInfo, []rpc.BlockHash, error)
+	BlockCount() (cemented, count, unchecked uint64, err error)
+	DelegatorsCount(account string) (uint64, error)
+}
+
+// Account is an account of a Wallet, whose blocks are created and published on behalf of the server
+type Account interface {
+	Address() string
+	Index() uint32
+	// Balance returns the confirmed balance of the account and the sum of its confirmed receivables
+	Balance() (balance, pending *big.Int, err error)
+	Send(account string, amount *big.Int) (rpc.BlockHash, error)
+	ReceivePendings(threshold *big.Int) error
+	ReceiveAndReturnPendings(threshold *big.Int) (rpc.HashToPendingMap, error)
+	// SetRep sets the representative to use in future blocks of the account
+	SetRep(representative string) error
+}
+
+// SendDestination is the destination of a send from an Account
+type SendDestination struct {
+	Account string
+	Amount  *big.Int
+}
+
+// Wallet derives accounts from a seed and provides access to the node they live on
+type Wallet interface {
+	// NewAccount derives the account with the given index, or with the next unused index if index is nil
+	NewAccount(index *uint32) (Account, error)
+	// GetAccount returns a previously derived account, or nil if the wallet has not derived it
+	GetAccount(address string) Account
+	RPC() RPC
+}
+
+type gonanoWallet struct {
+	w *wallet.Wallet
+}
+
+// NewGonanoWallet returns a Wallet backed by a gonano wallet, which talks to a real node
+func NewGonanoWallet(w *wallet.Wallet) Wallet {
+	return &gonanoWallet{w: w}
+}
+
+func (g *gonanoWallet) NewAccount(index *uint32) (Account, error) {
+	a, err := g.w.NewAccount(index)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (g *gonanoWallet) GetAccount(address string) Account {
+	a := g.w.GetAccount(address)
+	if a == nil {
+		// avoid returning a non-nil interface holding a nil pointer
+		return nil
+	}
+	return a
+}
+
+func (g *gonanoWallet) RPC() RPC {
+	return &g.w.RPC
+}
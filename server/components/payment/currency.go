@@ -0,0 +1,10 @@
+package payment
+
+// Currency identifies a currency by its ticker
+type Currency string
+
+// CurrencyBanano is Banano, the home currency
+const CurrencyBanano Currency = "BAN"
+
+// CurrencyNano is Nano
+const CurrencyNano Currency = "XNO"
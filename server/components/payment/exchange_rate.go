@@ -0,0 +1,92 @@
+package payment
+
+import (
+	"context"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/patrickmn/go-cache"
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRateSource provides the rates used to convert between the home currency and the currencies whose payments
+// are received natively
+type ExchangeRateSource interface {
+	// HomeCurrencyPerUnit returns how many units of the home currency one unit of currency is worth
+	HomeCurrencyPerUnit(ctx context.Context, currency Currency) (decimal.Decimal, error)
+}
+
+// FixedExchangeRateSource is an ExchangeRateSource with manually configured rates
+type FixedExchangeRateSource map[Currency]decimal.Decimal
+
+// HomeCurrencyPerUnit implements ExchangeRateSource
+func (f FixedExchangeRateSource) HomeCurrencyPerUnit(_ context.Context, currency Currency) (decimal.Decimal, error) {
+	if currency == HomeCurrency {
+		return decimal.NewFromInt(1), nil
+	}
+	rate, ok := f[currency]
+	if !ok || !rate.IsPositive() {
+		return decimal.Decimal{}, stacktrace.NewError("no exchange rate configured for currency %s", currency)
+	}
+	return rate, nil
+}
+
+// SwapProviderExchangeRateSource is an ExchangeRateSource that uses the estimates provided by a SwapProvider
+type SwapProviderExchangeRateSource struct {
+	provider SwapProvider
+	rates    *cache.Cache[Currency, decimal.Decimal]
+}
+
+// NewSwapProviderExchangeRateSource returns a new SwapProviderExchangeRateSource which reuses each rate for cacheDuration
+func NewSwapProviderExchangeRateSource(provider SwapProvider, cacheDuration time.Duration) *SwapProviderExchangeRateSource {
+	return &SwapProviderExchangeRateSource{
+		provider: provider,
+		rates:    cache.New[Currency, decimal.Decimal](cacheDuration, 10*time.Minute),
+	}
+}
+
+// HomeCurrencyPerUnit implements ExchangeRateSource
+func (n *SwapProviderExchangeRateSource) HomeCurrencyPerUnit(ctx context.Context, currency Currency) (decimal.Decimal, error) {
+	if currency == HomeCurrency {
+		return decimal.NewFromInt(1), nil
+	}
+	if rate, ok := n.rates.Get(currency); ok {
+		return rate, nil
+	}
+	estimation, err := n.provider.GetEstimate(ctx, currency, HomeCurrency, decimal.NewFromInt(1))
+	if err != nil {
+		return decimal.Decimal{}, stacktrace.Propagate(err, "failed to get estimate for %s", currency)
+	}
+	if !estimation.AmountTo.IsPositive() {
+		return decimal.Decimal{}, stacktrace.NewError("invalid estimate for %s", currency)
+	}
+	n.rates.SetDefault(currency, estimation.AmountTo)
+	return estimation.AmountTo, nil
+}
+
+// ConvertToHomeCurrency converts an amount in raw units of currency to raw units of the home currency, rounding down
+func ConvertToHomeCurrency(amount Amount, currency Currency, rate decimal.Decimal) Amount {
+	if currency == HomeCurrency {
+		return NewAmount(amount.Int)
+	}
+	numerator := amount.Decimal().Mul(rate).Mul(decimal.NewFromBigInt(units[HomeCurrency], 0))
+	q, _ := numerator.QuoRem(decimal.NewFromBigInt(units[currency], 0), 0)
+	return NewAmountFromDecimal(q)
+}
+
+// ConvertFromHomeCurrency converts an amount in raw units of the home currency to raw units of currency, rounding up
+// to the rounding factor of the currency so that prices remain presentable
+func ConvertFromHomeCurrency(amount Amount, currency Currency, rate decimal.Decimal) Amount {
+	if currency == HomeCurrency {
+		return NewAmount(amount.Int)
+	}
+	// computed as a single division so that no precision is lost before rounding
+	factor := decimal.NewFromBigInt(roundingFactor[currency], 0)
+	numerator := amount.Decimal().Mul(decimal.NewFromBigInt(units[currency], 0))
+	denominator := decimal.NewFromBigInt(units[HomeCurrency], 0).Mul(rate).Mul(factor)
+	q, r := numerator.QuoRem(denominator, 0)
+	if r.IsPositive() {
+		q = q.Add(decimal.NewFromInt(1))
+	}
+	return NewAmountFromDecimal(q.Mul(factor))
+}
@@ -0,0 +1,41 @@
+package payment_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/server/components/payment"
+
+	"github.com/stretchr/testify/require"
+)
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(n), nil)
+}
+
+func TestExchangeRateConversions(t *testing.T) {
+	source := payment.FixedExchangeRateSource{payment.CurrencyNano: decimal.NewFromInt(100)}
+	rate, err := source.HomeCurrencyPerUnit(context.Background(), payment.CurrencyNano)
+	require.NoError(t, err)
+
+	_, err = payment.FixedExchangeRateSource{}.HomeCurrencyPerUnit(context.Background(), payment.CurrencyNano)
+	require.Error(t, err)
+
+	// 1 BAN is worth 0.01 XNO
+	oneBanano := payment.NewAmount(pow10(29))
+	inNano := payment.ConvertFromHomeCurrency(oneBanano, payment.CurrencyNano, rate)
+	require.Zero(t, inNano.Cmp(pow10(28)))
+	require.Zero(t, payment.ConvertToHomeCurrency(inNano, payment.CurrencyNano, rate).Cmp(oneBanano.Int))
+
+	// prices are rounded up to the rounding factor of the currency
+	inNano = payment.ConvertFromHomeCurrency(payment.NewAmount(big.NewInt(1)), payment.CurrencyNano, rate)
+	require.Zero(t, inNano.Cmp(pow10(24)))
+
+	// values are rounded down
+	inBanano := payment.ConvertToHomeCurrency(payment.NewAmount(big.NewInt(1)), payment.CurrencyNano, rate)
+	require.Zero(t, inBanano.Cmp(big.NewInt(10)))
+	inBanano = payment.ConvertToHomeCurrency(payment.NewAmount(big.NewInt(19)), payment.CurrencyNano, decimal.RequireFromString("0.01"))
+	require.Zero(t, inBanano.Cmp(big.NewInt(0)))
+}
@@ -0,0 +1,195 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/server/components/node"
+)
+
+// MockSwapProvider is a SwapProvider that swaps to the home currency at the rates of an ExchangeRateSource, without
+// involving any external service. It is meant for testing the multicurrency payment flow: it does not check whether
+// anything is paid to its orders, which are fulfilled by sending the home currency from a liquidity account, either
+// when CompleteOrder is called or automatically after a delay
+type MockSwapProvider struct {
+	liquidityAccount  node.Account
+	rates             ExchangeRateSource
+	autoCompleteAfter time.Duration
+
+	payinAddresses map[Currency]string
+
+	ordersMutex  sync.Mutex
+	orders       map[string]*SwapOrder
+	orderCounter uint64
+}
+
+// NewMockSwapProvider returns a new MockSwapProvider which pays out from liquidityAccount.
+// If autoCompleteAfter is zero, orders are only completed when CompleteOrder is called
+func NewMockSwapProvider(liquidityAccount node.Account, rates ExchangeRateSource, autoCompleteAfter time.Duration) *MockSwapProvider {
+	return &MockSwapProvider{
+		liquidityAccount:  liquidityAccount,
+		rates:             rates,
+		autoCompleteAfter: autoCompleteAfter,
+		payinAddresses:    make(map[Currency]string),
+		orders:            make(map[string]*SwapOrder),
+	}
+}
+
+// SetPayinAddress makes orders from currency be paid to address, instead of to a placeholder address that can't
+// receive funds. Must be called before the provider is used
+func (m *MockSwapProvider) SetPayinAddress(currency Currency, address string) {
+	m.payinAddresses[currency] = address
+}
+
+// Name implements SwapProvider
+func (m *MockSwapProvider) Name() string {
+	return "mock"
+}
+
+// IsSwapSource implements SwapProvider
+func (m *MockSwapProvider) IsSwapSource(address string) bool {
+	return address == m.liquidityAccount.Address()
+}
+
+func (m *MockSwapProvider) rate(ctx context.Context, from, to Currency) (decimal.Decimal, error) {
+	if to != HomeCurrency {
+		return decimal.Decimal{}, stacktrace.NewError("mock swaps are only possible to the home currency")
+	}
+	rate, err := m.rates.HomeCurrencyPerUnit(ctx, from)
+	return rate, stacktrace.Propagate(err, "")
+}
+
+// GetEstimate implements SwapProvider
+func (m *MockSwapProvider) GetEstimate(ctx context.Context, from, to Currency, fromAmount decimal.Decimal) (SwapEstimate, error) {
+	rate, err := m.rate(ctx, from, to)
+	if err != nil {
+		return SwapEstimate{}, stacktrace.Propagate(err, "")
+	}
+	return SwapEstimate{
+		From:       from,
+		To:         to,
+		AmountFrom: fromAmount,
+		AmountTo:   fromAmount.Mul(rate),
+	}, nil
+}
+
+// GetEstimateReverse implements SwapProvider
+func (m *MockSwapProvider) GetEstimateReverse(ctx context.Context, from, to Currency, toAmount decimal.Decimal) (SwapEstimate, error) {
+	rate, err := m.rate(ctx, from, to)
+	if err != nil {
+		return SwapEstimate{}, stacktrace.Propagate(err, "")
+	}
+	// round up so that swapping the estimated amount never yields less than toAmount
+	amountFrom := toAmount.DivRound(rate, 40).RoundCeil(30)
+	return SwapEstimate{
+		From:       from,
+		To:         to,
+		AmountFrom: amountFrom,
+		AmountTo:   amountFrom.Mul(rate),
+	}, nil
+}
+
+// GetLimits implements SwapProvider. The maximum is determined by the balance of the liquidity account
+func (m *MockSwapProvider) GetLimits(ctx context.Context, from, to Currency) (SwapLimits, error) {
+	rate, err := m.rate(ctx, from, to)
+	if err != nil {
+		return SwapLimits{}, stacktrace.Propagate(err, "")
+	}
+	balance, _, err := m.liquidityAccount.Balance()
+	if err != nil {
+		return SwapLimits{}, stacktrace.Propagate(err, "failed to get balance of liquidity account")
+	}
+	return SwapLimits{
+		From: from,
+		To:   to,
+		Min:  decimal.Zero,
+		Max:  rawToBananoDecimal(NewAmount(balance)).Div(rate),
+	}, nil
+}
+
+// CreateOrder implements SwapProvider. maxDuration is ignored
+func (m *MockSwapProvider) CreateOrder(ctx context.Context, from, to Currency, amount decimal.Decimal, toAddress string, maxDuration time.Duration) (SwapOrder, error) {
+	estimation, err := m.GetEstimate(ctx, from, to, amount)
+	if err != nil {
+		return SwapOrder{}, stacktrace.Propagate(err, "")
+	}
+
+	m.ordersMutex.Lock()
+	defer m.ordersMutex.Unlock()
+	m.orderCounter++
+	id := fmt.Sprintf("mock-%d", m.orderCounter)
+	payinAddress, ok := m.payinAddresses[from]
+	if !ok {
+		// nothing is ever received by the mock, so there is no real address to pay to
+		payinAddress = fmt.Sprintf("mock-payin-%d", m.orderCounter)
+	}
+	order := &SwapOrder{
+		ID:                 id,
+		Status:             SwapOrderStatusWaiting,
+		From:               from,
+		To:                 to,
+		ExpectedAmountFrom: estimation.AmountFrom,
+		ExpectedAmountTo:   estimation.AmountTo,
+		PayinAddress:       payinAddress,
+		PayoutAddress:      toAddress,
+	}
+	m.orders[id] = order
+
+	if m.autoCompleteAfter > 0 {
+		time.AfterFunc(m.autoCompleteAfter, func() {
+			// there is no alien chain sender to report, so pretend the liquidity account sent the payment
+			_ = m.CompleteOrder(id, m.liquidityAccount.Address())
+		})
+	}
+	return *order, nil
+}
+
+// GetOrder implements SwapProvider
+func (m *MockSwapProvider) GetOrder(ctx context.Context, id string) (SwapOrder, error) {
+	m.ordersMutex.Lock()
+	defer m.ordersMutex.Unlock()
+	order, ok := m.orders[id]
+	if !ok {
+		return SwapOrder{}, stacktrace.NewError("order not found")
+	}
+	return *order, nil
+}
+
+// CompleteOrder simulates the reception of the expected amount of the order from senderAddress, sending the
+// corresponding amount of the home currency to the payout address
+func (m *MockSwapProvider) CompleteOrder(id, senderAddress string) error {
+	m.ordersMutex.Lock()
+	defer m.ordersMutex.Unlock()
+	order, ok := m.orders[id]
+	if !ok {
+		return stacktrace.NewError("order not found")
+	}
+	if order.Status != SwapOrderStatusWaiting {
+		return stacktrace.NewError("order is not waiting for payment")
+	}
+
+	// mark the order as completed before sending, as it must already be so when the payment is noticed
+	order.Status = SwapOrderStatusCompleted
+	order.AmountFrom = order.ExpectedAmountFrom
+	order.AmountTo = order.ExpectedAmountTo
+	order.SenderAddress = senderAddress
+
+	// allows for topping up the liquidity account by simply sending to it
+	err := m.liquidityAccount.ReceivePendings(big.NewInt(0))
+	if err != nil {
+		order.Status = SwapOrderStatusError
+		return stacktrace.Propagate(err, "failed to receive pendings of liquidity account")
+	}
+
+	_, err = m.liquidityAccount.Send(order.PayoutAddress, currencyDecimalToItsRawAmount(order.AmountTo, order.To).Int)
+	if err != nil {
+		order.Status = SwapOrderStatusError
+		return stacktrace.Propagate(err, "failed to send from liquidity account")
+	}
+	return nil
+}
@@ -7,9 +7,8 @@ import (
 	"time"
 
 	"github.com/hectorchu/gonano/rpc"
-	"github.com/hectorchu/gonano/wallet"
 	"github.com/palantir/stacktrace"
-	"github.com/tnyim/jungletv/server/components/nanswapclient"
+	"github.com/tnyim/jungletv/server/components/node"
 	"github.com/tnyim/jungletv/utils/event"
 )
 
@@ -17,7 +16,8 @@ import (
 type monitoredAccount struct {
 	mu                                  sync.RWMutex // mainly protects receivableBalance, as it is changed on both Revert and processPaymentsToAccount
 	p                                   *PaymentAccountPool
-	account                             *wallet.Account
+	currency                            *currencyAccounts
+	account                             node.Account
 	onPaymentReceived                   event.Event[PaymentReceivedEventArgs]
 	onMulticurrencyPaymentDataAvailable event.Event[[]MulticurrencyPaymentData]
 	seenPendings                        map[string]struct{}
@@ -26,12 +26,23 @@ type monitoredAccount struct {
 	paymentWaitingGroup                 *sync.WaitGroup
 	multicurrencyPaymentData            []MulticurrencyPaymentData
 	collectorAccountAddress             string
+
+	// accounts receiving payments in native currencies on behalf of this one, whose payments are reported as if
+	// they had been made to this account
+	nativeChildren []*monitoredAccount
+	nativeValue    Amount // home currency value of what nativeChildren received, excluded from receivableBalance
+	parent         *monitoredAccount
+	lastSender     string
 }
 
 func (m *monitoredAccount) Address() string {
 	return m.account.Address()
 }
 
+func (m *monitoredAccount) AccountIndex() uint32 {
+	return m.account.Index()
+}
+
 func (m *monitoredAccount) MulticurrencyPaymentData() []MulticurrencyPaymentData {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -49,25 +60,64 @@ func (m *monitoredAccount) PaymentReceived() event.Event[PaymentReceivedEventArg
 }
 
 func (m *monitoredAccount) Revert(refundAddress string) error {
+	m.mu.RLock()
+	children := m.nativeChildren
+	m.mu.RUnlock()
+
+	// payments in native currencies are returned to whoever sent them, as refundAddress is on a different chain
+	allChildrenReverted := true
+	for _, child := range children {
+		child.mu.RLock()
+		sender := child.lastSender
+		child.mu.RUnlock()
+		if sender != "" {
+			allChildrenReverted = child.revert(sender) && allChildrenReverted
+		}
+	}
+
+	reverted := m.revert(refundAddress)
+
+	if allChildrenReverted {
+		m.mu.Lock()
+		m.nativeValue = NewAmount()
+		m.mu.Unlock()
+	}
+	if !reverted || !allChildrenReverted {
+		return stacktrace.NewError("failed to revert all payments received by account %s", m.Address())
+	}
+	return nil
+}
+
+func (m *monitoredAccount) revert(refundAddress string) bool {
 	// blocks payment processing for this account for a while, and therefore the main payment processing loop, but shouldn't be too bad as this is rare
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	retry := 0
 	for ; retry < 5; retry++ {
-		err := m.account.ReceivePendings(m.p.dustThreshold.Int)
+		err := m.account.ReceivePendings(m.currency.dustThreshold.Int)
 		if err != nil {
 			m.p.log.Printf("failed to receive pendings in account %v: %v", m.account.Address(), err)
 			time.Sleep(5 * time.Second)
 			continue
 		}
 		if m.receivableBalance.Cmp(big.NewInt(0)) > 0 {
-			_, err = m.account.Send(refundAddress, m.receivableBalance.Int)
+			var hash rpc.BlockHash
+			hash, err = m.account.Send(refundAddress, m.receivableBalance.Int)
 			if err != nil {
 				m.p.log.Printf("failed to send balance in account %v to the refund address %v: %v", m.account.Address(), refundAddress, err)
 				time.Sleep(5 * time.Second)
 				continue
 			}
+			if m.currency == m.p.home {
+				m.p.fundsMoved.Notify(FundsMovedEventArgs{
+					Type:      FundsMovementTypeReverted,
+					From:      m.account.Address(),
+					To:        refundAddress,
+					Amount:    NewAmount(m.receivableBalance.Int),
+					BlockHash: hash.String(),
+				}, false)
+			}
 		}
 		break
 	}
@@ -75,10 +125,34 @@ func (m *monitoredAccount) Revert(refundAddress string) error {
 	if retry < 5 {
 		m.receivableBalance = NewAmount()
 	}
-	return nil
+	return retry < 5
 }
 
 func (m *monitoredAccount) Close() <-chan struct{} {
+	m.mu.RLock()
+	children := m.nativeChildren
+	m.mu.RUnlock()
+
+	closed := m.close()
+	if len(children) == 0 {
+		return closed
+	}
+
+	toWait := []<-chan struct{}{closed}
+	for _, child := range children {
+		toWait = append(toWait, child.close())
+	}
+	allClosed := make(chan struct{})
+	go func() {
+		for _, c := range toWait {
+			<-c
+		}
+		close(allClosed)
+	}()
+	return allClosed
+}
+
+func (m *monitoredAccount) close() <-chan struct{} {
 	m.p.monitoredAccountsLock.Lock()
 	defer m.p.monitoredAccountsLock.Unlock()
 	m.mu.Lock()
@@ -100,15 +174,15 @@ func (m *monitoredAccount) Close() <-chan struct{} {
 func (m *monitoredAccount) ReceivableBalance() Amount {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	return m.receivableBalance
+	return NewAmount(m.receivableBalance.Int, m.nativeValue.Int)
 }
 
 func (m *monitoredAccount) processPaymentsToAccount(ctx context.Context) error {
 	// note: both the RPC.Balance and RPC.AccountsPending calls return only confirmed blocks
 	// so the RPC.Balance call done after RPC.AccountsPending should account for all pending receives that we'll
 	// actually be able to receive
-	allPendings, err := m.p.wallet.RPC.AccountsPending([]string{m.account.Address()}, -1,
-		&rpc.RawAmount{Int: *m.p.dustThreshold.Int})
+	allPendings, err := m.currency.wallet.RPC().AccountsPending([]string{m.account.Address()}, -1,
+		&rpc.RawAmount{Int: *m.currency.dustThreshold.Int})
 	if err != nil {
 		return stacktrace.Propagate(err, "")
 	}
@@ -122,6 +196,11 @@ func (m *monitoredAccount) processPaymentsToAccount(ctx context.Context) error {
 		return stacktrace.NewError("account not present in pendings response")
 	}
 
+	if m.parent != nil {
+		m.processNativeCurrencyPayments(ctx, pendings)
+		return nil
+	}
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -136,30 +215,44 @@ func (m *monitoredAccount) processPaymentsToAccount(ctx context.Context) error {
 			m.paymentWaitingGroup.Add(1)
 			m.incrementedWaitingGroup = true
 		}
+		m.p.fundsMoved.Notify(FundsMovedEventArgs{
+			Type:      FundsMovementTypeReceived,
+			From:      pending.Source,
+			To:        m.account.Address(),
+			Amount:    NewAmount(&pending.Amount.Int),
+			BlockHash: hash,
+		}, false)
 
 		senderAmount := Amount{&pending.Amount.Int}
-		senderCurrency := nanswapclient.TickerBanano
+		senderCurrency := CurrencyBanano
 		from := pending.Source
-		if pending.Source == "ban_3zz761jb16zowd148jb6xpxszgpnk3fw35wnhfatuzah89uruginfdrw8sk7" {
-			// source is Nanswap, attempt to fill alien chain info accurately
+		if m.p.isSwapSource(pending.Source) {
+			// source is a swap provider, attempt to fill alien chain info accurately
 			foundOrder := false
 			for _, extraCurrencyData := range m.multicurrencyPaymentData {
-				order, err := m.p.nanswapClient.GetOrder(ctx, extraCurrencyData.OrderID)
+				if extraCurrencyData.OrderID == "" || !extraCurrencyData.SwapProvider.IsSwapSource(pending.Source) {
+					// received natively or through a different provider
+					continue
+				}
+				providerName := extraCurrencyData.SwapProvider.Name()
+				order, err := extraCurrencyData.SwapProvider.GetOrder(ctx, extraCurrencyData.OrderID)
 				if err != nil {
-					m.p.log.Printf("failed to get order after receiving payment from Nanswap in account %s, order ID %s: %v",
+					m.p.log.Printf("failed to get order after receiving payment from %s in account %s, order ID %s: %v",
+						providerName,
 						m.Address(),
 						extraCurrencyData.OrderID,
 						stacktrace.Propagate(err, ""),
 					)
 					continue
 				}
-				if order.Status == nanswapclient.OrderStatusCompleted {
+				if order.Status == SwapOrderStatusCompleted {
 					senderCurrency = extraCurrencyData.Currency
 					senderAmount = currencyDecimalToItsRawAmount(order.AmountFrom, senderCurrency)
 					from = order.SenderAddress
 					foundOrder = true
 
-					m.p.log.Printf("received payment from Nanswap in account %s, order ID %s, %v %s -> %v %s",
+					m.p.log.Printf("received payment from %s in account %s, order ID %s, %v %s -> %v %s",
+						providerName,
 						m.Address(),
 						extraCurrencyData.OrderID,
 						order.AmountFrom, order.From,
@@ -169,74 +262,170 @@ func (m *monitoredAccount) processPaymentsToAccount(ctx context.Context) error {
 				}
 			}
 			if !foundOrder {
-				m.p.log.Printf("received payment from Nanswap in account %s but could not find a matching completed order", m.Address())
+				m.p.log.Printf("received payment from swap provider %s in account %s but could not find a matching completed order",
+					pending.Source, m.Address())
 			}
 		}
 
 		m.onPaymentReceived.Notify(PaymentReceivedEventArgs{
-			Amount:         Amount{&pending.Amount.Int},
-			SenderAmount:   senderAmount,
-			SenderCurrency: senderCurrency,
-			From:           from,
-			Balance:        m.receivableBalance,
-			BlockHash:      hash,
+			Amount:           Amount{&pending.Amount.Int},
+			SenderAmount:     senderAmount,
+			SenderCurrency:   senderCurrency,
+			ReceivedCurrency: HomeCurrency,
+			From:             from,
+			Balance:          NewAmount(m.receivableBalance.Int, m.nativeValue.Int),
+			BlockHash:        hash,
 		}, true)
 	}
 
 	return nil
 }
 
-func (m *monitoredAccount) setupMulticurrencySwap(ctx context.Context, expectedAmounts []Amount, extraCurrencies []nanswapclient.Ticker, swapTimeout time.Duration) {
-	resultMap := make(map[nanswapclient.Ticker][]Amount)
-	currencyOrders := make(map[nanswapclient.Ticker]nanswapclient.CreateOrderResponse)
-
-	paymentData := []MulticurrencyPaymentData{}
+// processNativeCurrencyPayments handles the receivables of an account that receives payments in a native currency on
+// behalf of its parent, converting them to the home currency and reporting them as payments to the parent
+func (m *monitoredAccount) processNativeCurrencyPayments(ctx context.Context, pendings rpc.HashToPendingMap) {
+	rate, err := m.p.exchangeRateSource.HomeCurrencyPerUnit(ctx, m.currency.currency)
+	if err != nil {
+		// the receivables will be processed on a later attempt
+		m.p.log.Printf("failed to get exchange rate for %s payment in account %s: %v", m.currency.currency, m.Address(), err)
+		return
+	}
 
-	for _, expectedAmount := range expectedAmounts {
-		bananoDecimalAmount := rawToBananoDecimal(expectedAmount)
-		for _, currency := range extraCurrencies {
+	payments := []PaymentReceivedEventArgs{}
+	func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
 
-			estimation, err := m.p.nanswapClient.GetEstimateReverse(ctx, currency, homeCurrency, bananoDecimalAmount)
-			if err != nil {
-				resultMap[currency] = append(resultMap[currency], NewAmount(big.NewInt(-1)))
+		for hash, pending := range pendings {
+			_, alreadySeen := m.seenPendings[hash]
+			if alreadySeen {
 				continue
 			}
+			m.seenPendings[hash] = struct{}{}
+			m.receivableBalance.Add(m.receivableBalance.Int, &pending.Amount.Int)
+			if !m.incrementedWaitingGroup {
+				m.paymentWaitingGroup.Add(1)
+				m.incrementedWaitingGroup = true
+			}
+			m.lastSender = pending.Source
+
+			payments = append(payments, PaymentReceivedEventArgs{
+				Amount: ConvertToHomeCurrency(Amount{&pending.Amount.Int}, m.currency.currency, rate),
+				// the total received so far, so that expected amounts can be reached through multiple payments
+				SenderAmount:     NewAmount(m.receivableBalance.Int),
+				SenderCurrency:   m.currency.currency,
+				ReceivedCurrency: m.currency.currency,
+				From:             pending.Source,
+				BlockHash:        hash,
+			})
+		}
+	}()
+
+	for _, args := range payments {
+		m.parent.notifyNativeCurrencyPayment(args)
+	}
+}
 
-			amount := currencyDecimalToItsRawAmount(estimation.AmountFrom, currency)
-			amount.Div(amount.Int, roundingFactor[currency])
-			amount.Add(amount.Int, big.NewInt(1)) // increase price slightly / dumb round up (helps adding tolerance for slippage)
-			amount.Mul(amount.Int, roundingFactor[currency])
+func (m *monitoredAccount) notifyNativeCurrencyPayment(args PaymentReceivedEventArgs) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
-			resultMap[currency] = append(resultMap[currency], amount)
+	m.nativeValue.Add(m.nativeValue.Int, args.Amount.Int)
+	args.Balance = NewAmount(m.receivableBalance.Int, m.nativeValue.Int)
+	m.onPaymentReceived.Notify(args, true)
+}
 
-			// create only one order per currency. NanSwap will attempt to fulfill orders regardless of sent amount
-			if _, hasOrder := currencyOrders[currency]; hasOrder {
-				continue
-			}
-			currencyOrders[currency], err = m.p.nanswapClient.CreateOrder(ctx, currency, homeCurrency, estimation.AmountFrom, m.Address(), swapTimeout)
+func (m *monitoredAccount) setupNativeCurrencyPayment(ctx context.Context, c *currencyAccounts, expectedAmounts []Amount) error {
+	rate, err := m.p.exchangeRateSource.HomeCurrencyPerUnit(ctx, c.currency)
+	if err != nil {
+		return stacktrace.Propagate(err, "failed to get exchange rate for %s", c.currency)
+	}
+
+	amounts := make([]Amount, len(expectedAmounts))
+	for i, expectedAmount := range expectedAmounts {
+		amounts[i] = ConvertFromHomeCurrency(expectedAmount, c.currency, rate)
+	}
+
+	child, err := m.p.receivePaymentImpl(c, c.defaultCollectorAccountAddress, m)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nativeChildren = append(m.nativeChildren, child)
+	m.multicurrencyPaymentData = append(m.multicurrencyPaymentData, MulticurrencyPaymentData{
+		Currency:        c.currency,
+		PaymentAddress:  child.Address(),
+		ExpectedAmounts: amounts,
+	})
+	return nil
+}
+
+func (m *monitoredAccount) setupMulticurrencySwap(ctx context.Context, expectedAmounts []Amount, extraCurrencies []Currency, swapTimeout time.Duration) {
+	paymentData := []MulticurrencyPaymentData{}
+
+	for _, currency := range extraCurrencies {
+		// providers are tried in order of preference, falling back to the next one when a swap can't be set up
+		for _, provider := range m.p.swapProviders {
+			data, err := m.setupSwapWithProvider(ctx, provider, expectedAmounts, currency, swapTimeout)
 			if err != nil {
-				delete(currencyOrders, currency)
+				m.p.log.Printf("failed to set up %s swap with %s for account %s: %v", currency, provider.Name(), m.Address(), err)
 				continue
 			}
+			paymentData = append(paymentData, data)
+			break
 		}
 	}
 
-	for currency, order := range currencyOrders {
-		paymentData = append(paymentData, MulticurrencyPaymentData{
-			Currency:        currency,
-			PaymentAddress:  order.PayinAddress,
-			ExpectedAmounts: resultMap[currency],
-			OrderID:         order.ID,
-		})
-	}
-
 	if len(paymentData) == 0 {
 		return
 	}
 
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.multicurrencyPaymentData = paymentData
+	m.multicurrencyPaymentData = append(m.multicurrencyPaymentData, paymentData...)
+
+	allPaymentData := make([]MulticurrencyPaymentData, len(m.multicurrencyPaymentData))
+	copy(allPaymentData, m.multicurrencyPaymentData)
+	m.onMulticurrencyPaymentDataAvailable.Notify(allPaymentData, true)
+}
+
+func (m *monitoredAccount) setupSwapWithProvider(ctx context.Context, provider SwapProvider, expectedAmounts []Amount, currency Currency, swapTimeout time.Duration) (MulticurrencyPaymentData, error) {
+	amounts := []Amount{}
+	var order SwapOrder
+	hasOrder := false
 
-	m.onMulticurrencyPaymentDataAvailable.Notify(paymentData, true)
+	for _, expectedAmount := range expectedAmounts {
+		estimation, err := provider.GetEstimateReverse(ctx, currency, HomeCurrency, rawToBananoDecimal(expectedAmount))
+		if err != nil {
+			amounts = append(amounts, NewAmount(big.NewInt(-1)))
+			continue
+		}
+
+		amount := currencyDecimalToItsRawAmount(estimation.AmountFrom, currency)
+		amount.Div(amount.Int, roundingFactor[currency])
+		amount.Add(amount.Int, big.NewInt(1)) // increase price slightly / dumb round up (helps adding tolerance for slippage)
+		amount.Mul(amount.Int, roundingFactor[currency])
+
+		amounts = append(amounts, amount)
+
+		// create only one order per currency. Providers will attempt to fulfill orders regardless of sent amount
+		if hasOrder {
+			continue
+		}
+		order, err = provider.CreateOrder(ctx, currency, HomeCurrency, estimation.AmountFrom, m.Address(), swapTimeout)
+		hasOrder = err == nil
+	}
+
+	if !hasOrder {
+		return MulticurrencyPaymentData{}, stacktrace.NewError("failed to create order")
+	}
+	return MulticurrencyPaymentData{
+		Currency:        currency,
+		PaymentAddress:  order.PayinAddress,
+		ExpectedAmounts: amounts,
+		OrderID:         order.ID,
+		SwapProvider:    provider,
+	}, nil
 }
@@ -7,20 +7,24 @@ import (
 
 	"github.com/palantir/stacktrace"
 	"github.com/shopspring/decimal"
-	"github.com/tnyim/jungletv/server/components/nanswapclient"
 )
 
 type MulticurrencyPaymentData struct {
-	Currency        nanswapclient.Ticker
+	Currency        Currency
 	PaymentAddress  string
 	ExpectedAmounts []Amount
 	OrderID         string
+	SwapProvider    SwapProvider // nil when the currency is received natively
 }
 
-var homeCurrency = nanswapclient.TickerBanano
+// HomeCurrency is the currency in which prices are defined and in which most payments are received
+var HomeCurrency = CurrencyBanano
 
-func (p *PaymentAccountPool) ReceiveMulticurrencyPayment(ctx context.Context, expectedAmounts []Amount, extraCurrencies []nanswapclient.Ticker, swapTimeout time.Duration) (PaymentReceiver, error) {
-	receiver, err := p.receivePaymentImpl(p.defaultCollectorAccountAddress)
+// ReceiveMulticurrencyPayment begins a payment flow that, in addition to the home currency, accepts payments in the
+// specified extra currencies. Extra currencies that are enabled as native currencies are received directly into
+// accounts of their own; the remaining ones are swapped to the home currency through the configured swap providers
+func (p *PaymentAccountPool) ReceiveMulticurrencyPayment(ctx context.Context, expectedAmounts []Amount, extraCurrencies []Currency, swapTimeout time.Duration) (PaymentReceiver, error) {
+	receiver, err := p.receivePaymentImpl(p.home, p.home.defaultCollectorAccountAddress, nil)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
@@ -32,8 +36,22 @@ func (p *PaymentAccountPool) ReceiveMulticurrencyPayment(ctx context.Context, ex
 		return nil, stacktrace.NewError("missing extra currency")
 	}
 
-	if p.enableMulticurrencyPayments {
-		go receiver.setupMulticurrencySwap(context.Background(), expectedAmounts, extraCurrencies, swapTimeout)
+	swapCurrencies := []Currency{}
+	for _, currency := range extraCurrencies {
+		c, isNative := p.nativeCurrencies[currency]
+		if !isNative {
+			swapCurrencies = append(swapCurrencies, currency)
+			continue
+		}
+		err = receiver.setupNativeCurrencyPayment(ctx, c, expectedAmounts)
+		if err != nil {
+			// the payment can still be made in the home currency
+			p.log.Printf("failed to set up native %s payment for account %s: %v", currency, receiver.Address(), err)
+		}
+	}
+
+	if p.enableMulticurrencyPayments && len(swapCurrencies) > 0 && len(p.swapProviders) > 0 {
+		go receiver.setupMulticurrencySwap(context.Background(), expectedAmounts, swapCurrencies, swapTimeout)
 	}
 
 	return receiver, nil
@@ -41,20 +59,20 @@ func (p *PaymentAccountPool) ReceiveMulticurrencyPayment(ctx context.Context, ex
 
 func rawToBananoDecimal(amount Amount) decimal.Decimal {
 	rawDecimal := amount.Decimal()
-	unitDecimal := decimal.NewFromBigInt(units[nanswapclient.TickerBanano], 0)
+	unitDecimal := decimal.NewFromBigInt(units[CurrencyBanano], 0)
 	return rawDecimal.Div(unitDecimal)
 }
 
-func currencyDecimalToItsRawAmount(d decimal.Decimal, ticker nanswapclient.Ticker) Amount {
+func currencyDecimalToItsRawAmount(d decimal.Decimal, ticker Currency) Amount {
 	return NewAmountFromDecimal(d.Mul(decimal.NewFromBigInt(units[ticker], 0)))
 }
 
-var units map[nanswapclient.Ticker]*big.Int = map[nanswapclient.Ticker]*big.Int{
-	nanswapclient.TickerBanano: big.NewInt(1).Exp(big.NewInt(10), big.NewInt(29), big.NewInt(0)),
-	nanswapclient.TickerNano:   big.NewInt(1).Exp(big.NewInt(10), big.NewInt(30), big.NewInt(0)),
+var units map[Currency]*big.Int = map[Currency]*big.Int{
+	CurrencyBanano: big.NewInt(1).Exp(big.NewInt(10), big.NewInt(29), big.NewInt(0)),
+	CurrencyNano:   big.NewInt(1).Exp(big.NewInt(10), big.NewInt(30), big.NewInt(0)),
 }
 
-var roundingFactor map[nanswapclient.Ticker]*big.Int = map[nanswapclient.Ticker]*big.Int{
-	nanswapclient.TickerBanano: big.NewInt(1).Exp(big.NewInt(10), big.NewInt(27), big.NewInt(0)),
-	nanswapclient.TickerNano:   big.NewInt(1).Exp(big.NewInt(10), big.NewInt(24), big.NewInt(0)),
+var roundingFactor map[Currency]*big.Int = map[Currency]*big.Int{
+	CurrencyBanano: big.NewInt(1).Exp(big.NewInt(10), big.NewInt(27), big.NewInt(0)),
+	CurrencyNano:   big.NewInt(1).Exp(big.NewInt(10), big.NewInt(24), big.NewInt(0)),
 }
@@ -0,0 +1,146 @@
+package payment
+
+import (
+	"context"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/shopspring/decimal"
+)
+
+// nativeCurrencyConversionTimeout is how long to wait for the swap of the balance of a native currency collector
+// account to complete
+const nativeCurrencyConversionTimeout = 5 * time.Minute
+
+const nativeCurrencyConversionPollInterval = 1 * time.Second
+
+// ConvertNativeCurrencyFunds swaps the balances of the collector accounts of the native currencies into the default
+// collector account, through the swap providers, and waits for the swaps to complete.
+// Rewards, refunds and withdrawals are always paid in the home currency, so funds received natively must be converted
+// before they are distributed. Balances that can't be swapped yet (e.g. because they are below the minimum of every
+// provider) are left in the collector accounts and are included in the next conversion
+func (p *PaymentAccountPool) ConvertNativeCurrencyFunds(ctx context.Context) error {
+	p.nativeCurrencyConversionLock.Lock()
+	defer p.nativeCurrencyConversionLock.Unlock()
+
+	for _, c := range p.nativeCurrencies {
+		err := p.convertNativeCurrencyFunds(ctx, c)
+		if err != nil {
+			return stacktrace.Propagate(err, "failed to convert %s funds", c.currency)
+		}
+	}
+	return nil
+}
+
+func (p *PaymentAccountPool) convertNativeCurrencyFunds(ctx context.Context, c *currencyAccounts) error {
+	// payment accounts may still be sending to the collector account
+	p.AwaitConclusionOfInFlightPayments(c.defaultCollectorAccountAddress)
+
+	collectorAccount := c.wallet.GetAccount(c.defaultCollectorAccountAddress)
+	if collectorAccount == nil {
+		return stacktrace.NewError("collector account %s not in wallet", c.defaultCollectorAccountAddress)
+	}
+
+	err := collectorAccount.ReceivePendings(c.dustThreshold.Int)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	balance, _, err := collectorAccount.Balance()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if balance.Cmp(c.dustThreshold.Int) < 0 {
+		return nil
+	}
+
+	for _, provider := range p.swapProviders {
+		sent, err := p.convertWithProvider(ctx, provider, c, NewAmount(balance))
+		if err != nil {
+			if sent {
+				// the funds are no longer ours to retry with
+				return stacktrace.Propagate(err, "")
+			}
+			p.log.Printf("failed to convert %s funds with %s: %v", c.currency, provider.Name(), err)
+			continue
+		}
+		return nil
+	}
+	p.log.Printf("%s funds in collector account %s could not be converted, will retry on next conversion",
+		c.currency, c.defaultCollectorAccountAddress)
+	return nil
+}
+
+// convertWithProvider swaps balance through provider. Returns whether the funds were sent to the provider
+func (p *PaymentAccountPool) convertWithProvider(ctx context.Context, provider SwapProvider, c *currencyAccounts, balance Amount) (bool, error) {
+	amount := balance.Decimal().Div(decimal.NewFromBigInt(units[c.currency], 0))
+
+	limits, err := provider.GetLimits(ctx, c.currency, HomeCurrency)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	if amount.LessThan(limits.Min) {
+		return false, stacktrace.NewError("amount %s is below the minimum of %s", amount, limits.Min)
+	}
+	if limits.Max.IsPositive() && amount.GreaterThan(limits.Max) {
+		// the remainder is converted next time
+		amount = limits.Max
+	}
+
+	order, err := provider.CreateOrder(ctx, c.currency, HomeCurrency, amount, p.home.defaultCollectorAccountAddress,
+		nativeCurrencyConversionTimeout)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+
+	collectorAccount := c.wallet.GetAccount(c.defaultCollectorAccountAddress)
+	hash, err := collectorAccount.Send(order.PayinAddress, currencyDecimalToItsRawAmount(amount, c.currency).Int)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	p.log.Printf("Sent %s %s from collector account %s to %s swap order %s", amount, c.currency,
+		c.defaultCollectorAccountAddress, provider.Name(), order.ID)
+
+	order, err = p.awaitSwapOrderCompletion(ctx, provider, order.ID)
+	if err != nil {
+		return true, stacktrace.Propagate(err, "swap order %s for funds sent in block %s did not complete", order.ID, hash)
+	}
+
+	received := currencyDecimalToItsRawAmount(order.AmountTo, HomeCurrency)
+	p.fundsMoved.Notify(FundsMovedEventArgs{
+		Type:      FundsMovementTypeConverted,
+		From:      c.defaultCollectorAccountAddress,
+		To:        p.home.defaultCollectorAccountAddress,
+		Amount:    received,
+		BlockHash: hash.String(),
+	}, false)
+	return true, nil
+}
+
+func (p *PaymentAccountPool) awaitSwapOrderCompletion(ctx context.Context, provider SwapProvider, orderID string) (SwapOrder, error) {
+	ctx, cancel := context.WithTimeout(ctx, nativeCurrencyConversionTimeout)
+	defer cancel()
+
+	t := time.NewTicker(nativeCurrencyConversionPollInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			order, err := provider.GetOrder(ctx, orderID)
+			if err != nil {
+				p.log.Printf("failed to get status of swap order %s: %v", orderID, err)
+				continue
+			}
+			switch order.Status {
+			case SwapOrderStatusCompleted:
+				if !order.AmountTo.IsPositive() {
+					return order, stacktrace.NewError("completed swap order has no output amount")
+				}
+				return order, nil
+			case SwapOrderStatusError:
+				return order, stacktrace.NewError("swap order failed")
+			}
+		case <-ctx.Done():
+			return SwapOrder{ID: orderID}, stacktrace.Propagate(ctx.Err(), "")
+		}
+	}
+}
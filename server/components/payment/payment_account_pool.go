@@ -10,62 +10,164 @@ import (
 
 	"github.com/DisgoOrg/disgohook/api"
 	"github.com/hectorchu/gonano/rpc"
-	"github.com/hectorchu/gonano/wallet"
 	"github.com/palantir/stacktrace"
-	"github.com/tnyim/jungletv/server/components/nanswapclient"
+	"github.com/tnyim/jungletv/server/components/node"
 	"github.com/tnyim/jungletv/utils/event"
 	"gopkg.in/alexcesaro/statsd.v2"
 )
 
 type PaymentAccountPool struct {
-	log                            *log.Logger
-	statsClient                    *statsd.Client
-	availableAccounts              map[*wallet.Account]struct{}
-	wallet                         *wallet.Wallet
-	accountsMutex                  sync.RWMutex
-	repAddress                     string
-	modLogWebhook                  api.WebhookClient
-	dustThreshold                  Amount
-	defaultCollectorAccountAddress string
-	nanswapClient                  *nanswapclient.Client
-	enableMulticurrencyPayments    bool
+	log                         *log.Logger
+	statsClient                 *statsd.Client
+	modLogWebhook               api.WebhookClient
+	home                        *currencyAccounts
+	nativeCurrencies            map[Currency]*currencyAccounts
+	exchangeRateSource          ExchangeRateSource
+	swapProviders               []SwapProvider
+	enableMulticurrencyPayments bool
 
 	monitoredAccounts     map[string]*monitoredAccount
 	monitoredAccountsLock sync.RWMutex
 
 	collectorAccountPendingBalanceWaitGroups     map[string]*sync.WaitGroup
 	collectorAccountPendingBalanceWaitGroupsLock sync.Mutex
+
+	nativeCurrencyConversionLock sync.Mutex
+
+	latePaymentDetected event.Event[LatePaymentEventArgs]
+	fundsMoved          event.Event[FundsMovedEventArgs]
 }
 
-func New(log *log.Logger, statsClient *statsd.Client, w *wallet.Wallet, repAddress string, modLogWebhook api.WebhookClient,
-	dustThreshold Amount, defaultCollectorAccountAddress string, nanswapClient *nanswapclient.Client) *PaymentAccountPool {
+// LatePaymentEventArgs describes a payment that was sent to a payment account when it was no longer being monitored,
+// e.g. because it arrived after the respective ticket expired
+type LatePaymentEventArgs struct {
+	PaymentAccount string
+	From           string
+	Amount         Amount
+	BlockHash      string
+}
+
+// FundsMovementType is the type of a movement of funds performed by the pool
+type FundsMovementType string
+
+// FundsMovementTypeReceived is used when a payment is received by a payment account
+const FundsMovementTypeReceived FundsMovementType = "received"
+
+// FundsMovementTypeCollected is used when the balance of a payment account is sent to its collector account
+const FundsMovementTypeCollected FundsMovementType = "collected"
+
+// FundsMovementTypeReverted is used when the balance of a payment account is sent back to whoever paid
+const FundsMovementTypeReverted FundsMovementType = "reverted"
+
+// FundsMovementTypeConverted is used when the balance of the collector account of a native currency is swapped into
+// the default collector account. From is the address of the native currency collector account and Amount is the amount
+// of the home currency that was received
+const FundsMovementTypeConverted FundsMovementType = "converted"
+
+// FundsMovedEventArgs describes a movement of funds in the home currency to, from or between accounts of the pool
+type FundsMovedEventArgs struct {
+	Type      FundsMovementType
+	From      string
+	To        string
+	Amount    Amount
+	BlockHash string
+}
+
+// currencyAccounts holds the payment accounts of one of the currencies whose payments are received directly by the pool
+type currencyAccounts struct {
+	currency                       Currency
+	wallet                         node.Wallet
+	repAddress                     string
+	dustThreshold                  Amount
+	defaultCollectorAccountAddress string
+	availableAccounts              map[node.Account]struct{}
+	accountsMutex                  sync.Mutex
+}
+
+func newCurrencyAccounts(currency Currency, w node.Wallet, repAddress string, dustThreshold Amount,
+	defaultCollectorAccountAddress string) *currencyAccounts {
+	return &currencyAccounts{
+		currency:                       currency,
+		wallet:                         w,
+		repAddress:                     repAddress,
+		dustThreshold:                  dustThreshold,
+		defaultCollectorAccountAddress: defaultCollectorAccountAddress,
+		availableAccounts:              make(map[node.Account]struct{}),
+	}
+}
+
+// New returns a new PaymentAccountPool. swapProviders are used, in order of preference, to receive payments in extra
+// currencies that are not enabled as native currencies
+func New(log *log.Logger, statsClient *statsd.Client, w node.Wallet, repAddress string, modLogWebhook api.WebhookClient,
+	dustThreshold Amount, defaultCollectorAccountAddress string, swapProviders []SwapProvider) *PaymentAccountPool {
 	return &PaymentAccountPool{
 		log:                                      log,
 		statsClient:                              statsClient,
-		availableAccounts:                        make(map[*wallet.Account]struct{}),
-		wallet:                                   w,
-		repAddress:                               repAddress,
 		modLogWebhook:                            modLogWebhook,
-		dustThreshold:                            dustThreshold,
-		defaultCollectorAccountAddress:           defaultCollectorAccountAddress,
+		home:                                     newCurrencyAccounts(HomeCurrency, w, repAddress, dustThreshold, defaultCollectorAccountAddress),
+		nativeCurrencies:                         make(map[Currency]*currencyAccounts),
+		exchangeRateSource:                       FixedExchangeRateSource{},
 		monitoredAccounts:                        make(map[string]*monitoredAccount),
-		nanswapClient:                            nanswapClient,
+		swapProviders:                            swapProviders,
 		enableMulticurrencyPayments:              true,
 		collectorAccountPendingBalanceWaitGroups: make(map[string]*sync.WaitGroup),
+		latePaymentDetected:                      event.New[LatePaymentEventArgs](),
+		fundsMoved:                               event.New[FundsMovedEventArgs](),
 	}
 }
 
 func (p *PaymentAccountPool) DefaultCollectorAccountAddress() string {
-	return p.defaultCollectorAccountAddress
+	return p.home.defaultCollectorAccountAddress
+}
+
+// EnableNativeCurrency makes the pool receive payments in the specified currency directly, using accounts from the
+// specified wallet, instead of relying on swaps to the home currency.
+// Must be called before the pool is used
+func (p *PaymentAccountPool) EnableNativeCurrency(currency Currency, w node.Wallet, repAddress string,
+	dustThreshold Amount, collectorAccountAddress string) {
+	p.nativeCurrencies[currency] = newCurrencyAccounts(currency, w, repAddress, dustThreshold, collectorAccountAddress)
+}
+
+// SetExchangeRateSource sets the source of the rates used to price payments in native currencies and to determine
+// their value in the home currency. Must be called before the pool is used
+func (p *PaymentAccountPool) SetExchangeRateSource(source ExchangeRateSource) {
+	p.exchangeRateSource = source
 }
 
 func (p *PaymentAccountPool) SetMulticurrencyPaymentsEnabled(enabled bool) {
 	p.enableMulticurrencyPayments = enabled
 }
 
-func (p *PaymentAccountPool) RequestAccount() (*wallet.Account, error) {
+// LatePaymentDetected is the event that is fired when a payment in the home currency is found in a payment account
+// that is about to be reused. Such accounts are removed from the pool and the payment is not processed further
+func (p *PaymentAccountPool) LatePaymentDetected() event.Event[LatePaymentEventArgs] {
+	return p.latePaymentDetected
+}
+
+// FundsMoved is the event that is fired when the pool receives, collects or reverts funds in the home currency, and
+// when it converts funds received in native currencies into the home currency (see ConvertNativeCurrencyFunds).
+// Funds received in other currencies are not reported, and neither are late payments (see LatePaymentDetected)
+func (p *PaymentAccountPool) FundsMoved() event.Event[FundsMovedEventArgs] {
+	return p.fundsMoved
+}
+
+func (p *PaymentAccountPool) isSwapSource(address string) bool {
+	for _, provider := range p.swapProviders {
+		if provider.IsSwapSource(address) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *PaymentAccountPool) RequestAccount() (node.Account, error) {
+	account, err := p.requestAccount(p.home)
+	return account, stacktrace.Propagate(err, "")
+}
+
+func (p *PaymentAccountPool) requestAccount(c *currencyAccounts) (node.Account, error) {
 	for {
-		paymentAccount, err := p.getAvailableAccount()
+		paymentAccount, err := c.getAvailableAccount()
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "")
 		}
@@ -79,7 +181,7 @@ func (p *PaymentAccountPool) RequestAccount() (*wallet.Account, error) {
 		balance.Add(balance, pending)
 
 		// obtain the unconfirmed balance so this failsafe works properly when the network is super slow at confirming blocks
-		accountInfo, err := p.wallet.RPC.AccountInfo(paymentAccount.Address())
+		accountInfo, err := c.wallet.RPC().AccountInfo(paymentAccount.Address())
 		if err != nil {
 			// an error most likely means unopened account, just continue
 			accountInfo.Balance = &rpc.RawAmount{Int: *big.NewInt(0)}
@@ -88,50 +190,79 @@ func (p *PaymentAccountPool) RequestAccount() (*wallet.Account, error) {
 		if balance.Cmp(big.NewInt(0)) == 0 && accountInfo.Balance.Cmp(big.NewInt(0)) == 0 {
 			return paymentAccount, nil
 		}
-		p.modLogWebhook.SendContent(fmt.Sprintf(
-			"Address %v (%d) has unhandled balance! (gbl08ma will issue a refund)\n"+
-				"Most likely, someone sent money to this address after their payment ticket had already expired.\n"+
-				"This address has been removed from the payment account pool for the time being.",
-			paymentAccount.Address(), paymentAccount.Index()))
+		if c == p.home {
+			p.notifyLatePayments(c, paymentAccount)
+		}
+		if p.modLogWebhook != nil {
+			p.modLogWebhook.SendContent(fmt.Sprintf(
+				"Address %v (%d) has unhandled balance! (refunds of receivable payments have been proposed for review)\n"+
+					"Most likely, someone sent money to this address after their payment ticket had already expired.\n"+
+					"This address has been removed from the payment account pool for the time being.",
+				paymentAccount.Address(), paymentAccount.Index()))
+		}
 	}
 }
 
-func (p *PaymentAccountPool) getAvailableAccount() (*wallet.Account, error) {
-	p.accountsMutex.Lock()
-	defer p.accountsMutex.Unlock()
+func (p *PaymentAccountPool) notifyLatePayments(c *currencyAccounts, paymentAccount node.Account) {
+	allPendings, err := c.wallet.RPC().AccountsPending([]string{paymentAccount.Address()}, -1,
+		&rpc.RawAmount{Int: *c.dustThreshold.Int})
+	if err != nil {
+		p.log.Printf("failed to get receivables of account %v with unhandled balance: %v", paymentAccount.Address(), err)
+		return
+	}
+	for hash, pending := range allPendings[paymentAccount.Address()] {
+		p.latePaymentDetected.Notify(LatePaymentEventArgs{
+			PaymentAccount: paymentAccount.Address(),
+			From:           pending.Source,
+			Amount:         NewAmount(&pending.Amount.Int),
+			BlockHash:      hash,
+		}, false)
+	}
+}
+
+func (c *currencyAccounts) getAvailableAccount() (node.Account, error) {
+	c.accountsMutex.Lock()
+	defer c.accountsMutex.Unlock()
 
-	for a := range p.availableAccounts {
-		delete(p.availableAccounts, a)
+	for a := range c.availableAccounts {
+		delete(c.availableAccounts, a)
 		return a, nil
 	}
 
-	newAccount, err := p.wallet.NewAccount(nil)
+	newAccount, err := c.wallet.NewAccount(nil)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
-	err = newAccount.SetRep(p.repAddress)
+	err = newAccount.SetRep(c.repAddress)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
 	return newAccount, nil
 }
 
-func (p *PaymentAccountPool) ReturnAccount(account *wallet.Account) {
-	p.accountsMutex.Lock()
-	defer p.accountsMutex.Unlock()
+func (p *PaymentAccountPool) ReturnAccount(account node.Account) {
+	p.home.returnAccount(account)
+}
+
+func (c *currencyAccounts) returnAccount(account node.Account) {
+	c.accountsMutex.Lock()
+	defer c.accountsMutex.Unlock()
 
-	p.availableAccounts[account] = struct{}{}
+	c.availableAccounts[account] = struct{}{}
 }
 
 // PaymentReceiver represents a payment flow (one monitored account)
 type PaymentReceiver interface {
 	Address() string
+	// AccountIndex returns the index of the payment account in its wallet, which can be used to resume receiving
+	// payments into it after a restart (see ResumeReceivingPaymentIntoCollectorAccount)
+	AccountIndex() uint32
 	MulticurrencyPaymentData() []MulticurrencyPaymentData
 	PaymentReceived() event.Event[PaymentReceivedEventArgs]
 	MulticurrencyPaymentDataAvailable() event.Event[[]MulticurrencyPaymentData]
 
 	// ReceivableBalance may block for a significant amount of time when receiving multicurrency payments
-	// (refactor the Nanswap order fetching code in processPaymentsToAccount to fix this)
+	// (refactor the swap order fetching code in processPaymentsToAccount to fix this)
 	ReceivableBalance() Amount
 
 	// Revert should be called when one wants to return anything that was received.
@@ -143,30 +274,48 @@ type PaymentReceiver interface {
 	Close() <-chan struct{}
 }
 
-// PaymentReceivedEventArgs contains the data associated with the event that is fired when a payment is received
+// PaymentReceivedEventArgs contains the data associated with the event that is fired when a payment is received.
+// Amount and Balance are always in the home currency; payments received in a native currency are converted at the
+// time they are detected
 type PaymentReceivedEventArgs struct {
-	Amount         Amount
-	SenderAmount   Amount // the amount as "seen" by the sender in SenderCurrency units, before swap/conversion
-	SenderCurrency nanswapclient.Ticker
-	From           string
-	Balance        Amount
-	BlockHash      string
+	Amount           Amount
+	SenderAmount     Amount // the amount as "seen" by the sender in SenderCurrency units, before swap/conversion
+	SenderCurrency   Currency
+	ReceivedCurrency Currency // the currency the service received, which differs from SenderCurrency when swapped
+	From             string
+	Balance          Amount
+	BlockHash        string
 }
 
 func (p *PaymentAccountPool) ReceivePayment() (PaymentReceiver, error) {
-	return p.receivePaymentImpl(p.defaultCollectorAccountAddress)
+	return p.receivePaymentImpl(p.home, p.home.defaultCollectorAccountAddress, nil)
 }
 
 func (p *PaymentAccountPool) ReceivePaymentIntoCollectorAccount(collectorAccountAddress string) (PaymentReceiver, error) {
-	return p.receivePaymentImpl(collectorAccountAddress)
+	return p.receivePaymentImpl(p.home, collectorAccountAddress, nil)
 }
 
-func (p *PaymentAccountPool) receivePaymentImpl(collectorAccountAddress string) (*monitoredAccount, error) {
-	account, err := p.RequestAccount()
+// ResumeReceivingPaymentIntoCollectorAccount resumes a payment flow that was interrupted by a restart, monitoring the
+// account with the specified index (obtained from PaymentReceiver.AccountIndex) again. Receivables that were already
+// seen before the restart are reported again.
+// Must be called before the pool is used, so that the account is not handed out to other payment flows
+func (p *PaymentAccountPool) ResumeReceivingPaymentIntoCollectorAccount(accountIndex uint32, collectorAccountAddress string) (PaymentReceiver, error) {
+	account, err := p.home.wallet.NewAccount(&accountIndex)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
+	return p.monitorAccount(p.home, account, collectorAccountAddress, nil), nil
+}
 
+func (p *PaymentAccountPool) receivePaymentImpl(c *currencyAccounts, collectorAccountAddress string, parent *monitoredAccount) (*monitoredAccount, error) {
+	account, err := p.requestAccount(c)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return p.monitorAccount(c, account, collectorAccountAddress, parent), nil
+}
+
+func (p *PaymentAccountPool) monitorAccount(c *currencyAccounts, account node.Account, collectorAccountAddress string, parent *monitoredAccount) *monitoredAccount {
 	var wg *sync.WaitGroup
 	func() {
 		p.collectorAccountPendingBalanceWaitGroupsLock.Lock()
@@ -181,10 +330,13 @@ func (p *PaymentAccountPool) receivePaymentImpl(collectorAccountAddress string)
 
 	m := &monitoredAccount{
 		p:                                   p,
+		currency:                            c,
+		parent:                              parent,
 		account:                             account,
 		onPaymentReceived:                   event.New[PaymentReceivedEventArgs](),
 		onMulticurrencyPaymentDataAvailable: event.New[[]MulticurrencyPaymentData](),
 		receivableBalance:                   NewAmount(),
+		nativeValue:                         NewAmount(),
 		seenPendings:                        make(map[string]struct{}),
 		collectorAccountAddress:             collectorAccountAddress,
 		paymentWaitingGroup:                 wg,
@@ -194,7 +346,7 @@ func (p *PaymentAccountPool) receivePaymentImpl(collectorAccountAddress string)
 	defer p.monitoredAccountsLock.Unlock()
 
 	p.monitoredAccounts[account.Address()] = m
-	return m, nil
+	return m
 }
 
 func (p *PaymentAccountPool) Worker(ctx context.Context, interval time.Duration) error {
@@ -269,7 +421,7 @@ func (p *PaymentAccountPool) freePreviouslyMonitoredAccount(m *monitoredAccount,
 	retry := 0
 	var hash rpc.BlockHash
 	for ; retry < 5; retry++ {
-		err := m.account.ReceivePendings(p.dustThreshold.Int)
+		err := m.account.ReceivePendings(m.currency.dustThreshold.Int)
 		if err != nil {
 			p.log.Printf("failed to receive pendings in account %v: %v", m.account.Address(), err)
 			time.Sleep(5 * time.Second)
@@ -282,6 +434,15 @@ func (p *PaymentAccountPool) freePreviouslyMonitoredAccount(m *monitoredAccount,
 				time.Sleep(5 * time.Second)
 				continue
 			}
+			if m.currency == p.home {
+				p.fundsMoved.Notify(FundsMovedEventArgs{
+					Type:      FundsMovementTypeCollected,
+					From:      m.account.Address(),
+					To:        m.collectorAccountAddress,
+					Amount:    NewAmount(m.receivableBalance.Int),
+					BlockHash: hash.String(),
+				}, false)
+			}
 		}
 		break
 	}
@@ -291,7 +452,7 @@ func (p *PaymentAccountPool) freePreviouslyMonitoredAccount(m *monitoredAccount,
 		// but we don't want this to truly block the account being marked as available and the done chan being closed
 		for confirmationCheckRetry := 0; confirmationCheckRetry < 5 && len(hash) > 0; confirmationCheckRetry++ {
 			time.Sleep(1 * time.Second)
-			info, err := m.p.wallet.RPC.BlockInfo(hash)
+			info, err := m.currency.wallet.RPC().BlockInfo(hash)
 			if err != nil {
 				p.log.Printf("failed to check whether block %v is confirmed: %v", hash, err)
 				continue
@@ -302,7 +463,7 @@ func (p *PaymentAccountPool) freePreviouslyMonitoredAccount(m *monitoredAccount,
 		}
 
 		// only reuse the account if no funds got stuck there
-		p.ReturnAccount(m.account)
+		m.currency.returnAccount(m.account)
 	} else {
 		p.log.Printf("failed to clean up previously monitored account %v, not returning it to the pool", m.account.Address())
 	}
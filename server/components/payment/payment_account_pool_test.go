@@ -0,0 +1,365 @@
+package payment_test
+
+import (
+	"context"
+	"io"
+	"log"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/server/components/node"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/utils/event"
+	"gopkg.in/alexcesaro/statsd.v2"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentIsForwardedToCollectorAccount(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	fakeNode := node.NewFakeNode()
+	w, err := fakeNode.NewWallet(make([]byte, 32))
+	require.NoError(t, err)
+	collectorIndex := uint32(0)
+	collector, err := w.NewAccount(&collectorIndex)
+	require.NoError(t, err)
+
+	statsClient, err := statsd.New(statsd.Mute(true))
+	require.NoError(t, err)
+
+	pool := payment.New(log.New(io.Discard, "", 0), statsClient, w, collector.Address(), nil,
+		payment.NewAmount(big.NewInt(10)), collector.Address(), nil)
+	go pool.Worker(ctx, 10*time.Millisecond)
+
+	receiver, err := pool.ReceivePayment()
+	require.NoError(t, err)
+	onPaymentReceived, paymentReceivedU := receiver.PaymentReceived().Subscribe(event.BufferAll)
+	defer paymentReceivedU()
+
+	// the sender is not simulated by the fake node, so any address will do
+	senderIndex := uint32(1000)
+	sender, err := w.NewAccount(&senderIndex)
+	require.NoError(t, err)
+	_, err = fakeNode.Fund(sender.Address(), receiver.Address(), big.NewInt(1000))
+	require.NoError(t, err)
+
+	select {
+	case args := <-onPaymentReceived:
+		require.EqualValues(t, 1000, args.Amount.Int64())
+		require.Equal(t, sender.Address(), args.From)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "payment was not detected")
+	}
+	require.EqualValues(t, 1000, receiver.ReceivableBalance().Int64())
+
+	<-receiver.Close()
+	require.Zero(t, fakeNode.AccountBalance(receiver.Address()).Int64())
+	require.EqualValues(t, 1000, fakeNode.Receivable(collector.Address()).Int64())
+}
+
+func TestNativeCurrencyPaymentIsReportedInHomeCurrency(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	collectorIndex := uint32(0)
+	bananoNode := node.NewFakeNode()
+	bananoWallet, err := bananoNode.NewWallet(make([]byte, 32))
+	require.NoError(t, err)
+	collector, err := bananoWallet.NewAccount(&collectorIndex)
+	require.NoError(t, err)
+
+	nanoNode := node.NewFakeNode()
+	nanoSeed := make([]byte, 32)
+	nanoSeed[0] = 1
+	nanoWallet, err := nanoNode.NewWallet(nanoSeed)
+	require.NoError(t, err)
+	nanoCollector, err := nanoWallet.NewAccount(&collectorIndex)
+	require.NoError(t, err)
+
+	statsClient, err := statsd.New(statsd.Mute(true))
+	require.NoError(t, err)
+
+	pool := payment.New(log.New(io.Discard, "", 0), statsClient, bananoWallet, collector.Address(), nil,
+		payment.NewAmount(big.NewInt(10)), collector.Address(), nil)
+	pool.SetExchangeRateSource(payment.FixedExchangeRateSource{payment.CurrencyNano: decimal.NewFromInt(100)})
+	pool.EnableNativeCurrency(payment.CurrencyNano, nanoWallet, nanoCollector.Address(),
+		payment.NewAmount(big.NewInt(10)), nanoCollector.Address())
+	go pool.Worker(ctx, 10*time.Millisecond)
+
+	fiftyBanano := payment.NewAmount(new(big.Int).Mul(big.NewInt(50), pow10(29)))
+	halfNano := new(big.Int).Mul(big.NewInt(5), pow10(29))
+
+	receiver, err := pool.ReceiveMulticurrencyPayment(ctx, []payment.Amount{fiftyBanano},
+		[]payment.Currency{payment.CurrencyNano}, time.Minute)
+	require.NoError(t, err)
+	onPaymentReceived, paymentReceivedU := receiver.PaymentReceived().Subscribe(event.BufferAll)
+	defer paymentReceivedU()
+
+	data := receiver.MulticurrencyPaymentData()
+	require.Len(t, data, 1)
+	require.Equal(t, payment.CurrencyNano, data[0].Currency)
+	require.Empty(t, data[0].OrderID)
+	require.NotEqual(t, receiver.Address(), data[0].PaymentAddress)
+	require.Zero(t, data[0].ExpectedAmounts[0].Cmp(halfNano))
+
+	senderIndex := uint32(1000)
+	sender, err := nanoWallet.NewAccount(&senderIndex)
+	require.NoError(t, err)
+	_, err = nanoNode.Fund(sender.Address(), data[0].PaymentAddress, halfNano)
+	require.NoError(t, err)
+
+	select {
+	case args := <-onPaymentReceived:
+		require.Zero(t, args.Amount.Cmp(fiftyBanano.Int))
+		require.Zero(t, args.Balance.Cmp(fiftyBanano.Int))
+		require.Zero(t, args.SenderAmount.Cmp(halfNano))
+		require.Equal(t, payment.CurrencyNano, args.SenderCurrency)
+		require.Equal(t, payment.CurrencyNano, args.ReceivedCurrency)
+		require.Equal(t, sender.Address(), args.From)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "payment was not detected")
+	}
+	require.Zero(t, receiver.ReceivableBalance().Cmp(fiftyBanano.Int))
+
+	<-receiver.Close()
+	require.Zero(t, nanoNode.Receivable(nanoCollector.Address()).Cmp(halfNano))
+	require.Zero(t, bananoNode.Receivable(collector.Address()).Int64())
+}
+
+func TestNativeCurrencyFundsAreConvertedIntoCollectorAccount(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	collectorIndex := uint32(0)
+	bananoNode := node.NewFakeNode()
+	bananoWallet, err := bananoNode.NewWallet(make([]byte, 32))
+	require.NoError(t, err)
+	collector, err := bananoWallet.NewAccount(&collectorIndex)
+	require.NoError(t, err)
+	liquidityIndex := uint32(500)
+	liquidityAccount, err := bananoWallet.NewAccount(&liquidityIndex)
+	require.NoError(t, err)
+	_, err = bananoNode.Fund(collector.Address(), liquidityAccount.Address(), new(big.Int).Mul(big.NewInt(1000), pow10(29)))
+	require.NoError(t, err)
+
+	nanoNode := node.NewFakeNode()
+	nanoSeed := make([]byte, 32)
+	nanoSeed[0] = 1
+	nanoWallet, err := nanoNode.NewWallet(nanoSeed)
+	require.NoError(t, err)
+	nanoCollector, err := nanoWallet.NewAccount(&collectorIndex)
+	require.NoError(t, err)
+	payinIndex := uint32(500)
+	payinAccount, err := nanoWallet.NewAccount(&payinIndex)
+	require.NoError(t, err)
+
+	statsClient, err := statsd.New(statsd.Mute(true))
+	require.NoError(t, err)
+
+	rates := payment.FixedExchangeRateSource{payment.CurrencyNano: decimal.NewFromInt(100)}
+	provider := payment.NewMockSwapProvider(liquidityAccount, rates, 10*time.Millisecond)
+	provider.SetPayinAddress(payment.CurrencyNano, payinAccount.Address())
+	pool := payment.New(log.New(io.Discard, "", 0), statsClient, bananoWallet, collector.Address(), nil,
+		payment.NewAmount(big.NewInt(10)), collector.Address(), []payment.SwapProvider{provider})
+	pool.SetExchangeRateSource(rates)
+	pool.EnableNativeCurrency(payment.CurrencyNano, nanoWallet, nanoCollector.Address(),
+		payment.NewAmount(big.NewInt(10)), nanoCollector.Address())
+
+	onFundsMoved, fundsMovedU := pool.FundsMoved().Subscribe(event.BufferAll)
+	defer fundsMovedU()
+
+	// nothing to convert
+	require.NoError(t, pool.ConvertNativeCurrencyFunds(ctx))
+	require.Zero(t, bananoNode.Receivable(collector.Address()).Int64())
+
+	halfNano := new(big.Int).Mul(big.NewInt(5), pow10(29))
+	fiftyBanano := new(big.Int).Mul(big.NewInt(50), pow10(29))
+	senderIndex := uint32(1000)
+	sender, err := nanoWallet.NewAccount(&senderIndex)
+	require.NoError(t, err)
+	_, err = nanoNode.Fund(sender.Address(), nanoCollector.Address(), halfNano)
+	require.NoError(t, err)
+
+	require.NoError(t, pool.ConvertNativeCurrencyFunds(ctx))
+	require.Zero(t, nanoNode.AccountBalance(nanoCollector.Address()).Int64())
+	require.Zero(t, nanoNode.Receivable(payinAccount.Address()).Cmp(halfNano))
+	require.Zero(t, bananoNode.Receivable(collector.Address()).Cmp(fiftyBanano))
+
+	select {
+	case args := <-onFundsMoved:
+		require.Equal(t, payment.FundsMovementTypeConverted, args.Type)
+		require.Equal(t, nanoCollector.Address(), args.From)
+		require.Equal(t, collector.Address(), args.To)
+		require.Zero(t, args.Amount.Cmp(fiftyBanano))
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "conversion was not reported")
+	}
+}
+
+func TestSwappedPaymentIsAttributedToAlienChainSender(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	fakeNode := node.NewFakeNode()
+	w, err := fakeNode.NewWallet(make([]byte, 32))
+	require.NoError(t, err)
+	collectorIndex := uint32(0)
+	collector, err := w.NewAccount(&collectorIndex)
+	require.NoError(t, err)
+	liquidityIndex := uint32(500)
+	liquidityAccount, err := w.NewAccount(&liquidityIndex)
+	require.NoError(t, err)
+	_, err = fakeNode.Fund(collector.Address(), liquidityAccount.Address(), new(big.Int).Mul(big.NewInt(1000), pow10(29)))
+	require.NoError(t, err)
+
+	statsClient, err := statsd.New(statsd.Mute(true))
+	require.NoError(t, err)
+
+	rates := payment.FixedExchangeRateSource{payment.CurrencyNano: decimal.NewFromInt(100)}
+	provider := payment.NewMockSwapProvider(liquidityAccount, rates, 0)
+	pool := payment.New(log.New(io.Discard, "", 0), statsClient, w, collector.Address(), nil,
+		payment.NewAmount(big.NewInt(10)), collector.Address(), []payment.SwapProvider{provider})
+	go pool.Worker(ctx, 10*time.Millisecond)
+
+	fiftyBanano := payment.NewAmount(new(big.Int).Mul(big.NewInt(50), pow10(29)))
+	halfNano := new(big.Int).Mul(big.NewInt(5), pow10(29))
+
+	receiver, err := pool.ReceiveMulticurrencyPayment(ctx, []payment.Amount{fiftyBanano},
+		[]payment.Currency{payment.CurrencyNano}, time.Minute)
+	require.NoError(t, err)
+	onPaymentReceived, paymentReceivedU := receiver.PaymentReceived().Subscribe(event.BufferAll)
+	defer paymentReceivedU()
+
+	// swaps are set up asynchronously
+	require.Eventually(t, func() bool {
+		return len(receiver.MulticurrencyPaymentData()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	data := receiver.MulticurrencyPaymentData()[0]
+	require.Equal(t, payment.CurrencyNano, data.Currency)
+	require.NotEmpty(t, data.OrderID)
+	require.Equal(t, provider, data.SwapProvider)
+	// prices of swapped payments are increased slightly to account for slippage
+	require.Zero(t, data.ExpectedAmounts[0].Cmp(new(big.Int).Add(halfNano, pow10(24))))
+
+	const sender = "nano_1alienchainsender"
+	require.NoError(t, provider.CompleteOrder(data.OrderID, sender))
+	require.Error(t, provider.CompleteOrder(data.OrderID, sender), "orders must not be completed twice")
+
+	select {
+	case args := <-onPaymentReceived:
+		require.Zero(t, args.Amount.Cmp(fiftyBanano.Int))
+		require.Zero(t, args.SenderAmount.Cmp(halfNano))
+		require.Equal(t, payment.CurrencyNano, args.SenderCurrency)
+		require.Equal(t, payment.HomeCurrency, args.ReceivedCurrency)
+		require.Equal(t, sender, args.From)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "payment was not detected")
+	}
+
+	<-receiver.Close()
+	require.Zero(t, fakeNode.Receivable(collector.Address()).Cmp(fiftyBanano.Int))
+}
+
+func TestLatePaymentIsDetectedWhenAccountIsReused(t *testing.T) {
+	fakeNode := node.NewFakeNode()
+	w, err := fakeNode.NewWallet(make([]byte, 32))
+	require.NoError(t, err)
+	collectorIndex := uint32(0)
+	collector, err := w.NewAccount(&collectorIndex)
+	require.NoError(t, err)
+
+	statsClient, err := statsd.New(statsd.Mute(true))
+	require.NoError(t, err)
+
+	pool := payment.New(log.New(io.Discard, "", 0), statsClient, w, collector.Address(), nil,
+		payment.NewAmount(big.NewInt(10)), collector.Address(), nil)
+
+	onLatePayment, latePaymentU := pool.LatePaymentDetected().Subscribe(event.BufferAll)
+	defer latePaymentU()
+
+	receiver, err := pool.ReceivePayment()
+	require.NoError(t, err)
+	<-receiver.Close()
+
+	senderIndex := uint32(1000)
+	sender, err := w.NewAccount(&senderIndex)
+	require.NoError(t, err)
+	hash, err := fakeNode.Fund(sender.Address(), receiver.Address(), big.NewInt(1000))
+	require.NoError(t, err)
+
+	nextReceiver, err := pool.ReceivePayment()
+	require.NoError(t, err)
+	require.NotEqual(t, receiver.Address(), nextReceiver.Address(), "accounts with unhandled balance must not be reused")
+
+	select {
+	case args := <-onLatePayment:
+		require.Equal(t, receiver.Address(), args.PaymentAccount)
+		require.Equal(t, sender.Address(), args.From)
+		require.EqualValues(t, 1000, args.Amount.Int64())
+		require.Equal(t, hash.String(), args.BlockHash)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "late payment was not detected")
+	}
+}
+
+func TestPaymentFlowIsResumedAfterRestart(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	fakeNode := node.NewFakeNode()
+	w, err := fakeNode.NewWallet(make([]byte, 32))
+	require.NoError(t, err)
+	collectorIndex := uint32(0)
+	collector, err := w.NewAccount(&collectorIndex)
+	require.NoError(t, err)
+
+	statsClient, err := statsd.New(statsd.Mute(true))
+	require.NoError(t, err)
+
+	pool := payment.New(log.New(io.Discard, "", 0), statsClient, w, collector.Address(), nil,
+		payment.NewAmount(big.NewInt(10)), collector.Address(), nil)
+
+	receiver, err := pool.ReceivePayment()
+	require.NoError(t, err)
+
+	senderIndex := uint32(1000)
+	sender, err := w.NewAccount(&senderIndex)
+	require.NoError(t, err)
+	_, err = fakeNode.Fund(sender.Address(), receiver.Address(), big.NewInt(1000))
+	require.NoError(t, err)
+
+	// simulate a restart, with a new wallet with the same seed and a new pool
+	w, err = fakeNode.NewWallet(make([]byte, 32))
+	require.NoError(t, err)
+	_, err = w.NewAccount(&collectorIndex)
+	require.NoError(t, err)
+	pool = payment.New(log.New(io.Discard, "", 0), statsClient, w, collector.Address(), nil,
+		payment.NewAmount(big.NewInt(10)), collector.Address(), nil)
+	go pool.Worker(ctx, 10*time.Millisecond)
+
+	resumed, err := pool.ResumeReceivingPaymentIntoCollectorAccount(receiver.AccountIndex(), collector.Address())
+	require.NoError(t, err)
+	require.Equal(t, receiver.Address(), resumed.Address())
+	onPaymentReceived, paymentReceivedU := resumed.PaymentReceived().Subscribe(event.BufferAll)
+	defer paymentReceivedU()
+
+	// the resumed account is not handed out to other payment flows
+	other, err := pool.ReceivePayment()
+	require.NoError(t, err)
+	require.NotEqual(t, resumed.Address(), other.Address())
+	<-other.Close()
+
+	select {
+	case args := <-onPaymentReceived:
+		require.EqualValues(t, 1000, args.Amount.Int64())
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "payment was not detected after resuming")
+	}
+
+	<-resumed.Close()
+	require.EqualValues(t, 1000, fakeNode.Receivable(collector.Address()).Int64())
+}
@@ -0,0 +1,192 @@
+package payment
+
+import (
+	"context"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/server/components/nanswapclient"
+)
+
+// SwapProvider is a service that receives payments in other currencies and sends their equivalent in the home currency.
+// Amounts are in whole units of each currency, not in raw units
+type SwapProvider interface {
+	// Name identifies the provider in logs and payment data
+	Name() string
+	// IsSwapSource returns whether address is the one the provider sends swapped funds from
+	IsSwapSource(address string) bool
+	GetEstimate(ctx context.Context, from, to Currency, fromAmount decimal.Decimal) (SwapEstimate, error)
+	// GetEstimateReverse takes the desired toAmount and returns the fromAmount estimation
+	GetEstimateReverse(ctx context.Context, from, to Currency, toAmount decimal.Decimal) (SwapEstimate, error)
+	GetLimits(ctx context.Context, from, to Currency) (SwapLimits, error)
+	CreateOrder(ctx context.Context, from, to Currency, amount decimal.Decimal, toAddress string, maxDuration time.Duration) (SwapOrder, error)
+	GetOrder(ctx context.Context, id string) (SwapOrder, error)
+}
+
+// SwapEstimate is the estimated result of a swap
+type SwapEstimate struct {
+	From       Currency
+	To         Currency
+	AmountFrom decimal.Decimal
+	AmountTo   decimal.Decimal
+}
+
+// SwapLimits are the minimum and maximum amounts of a currency that can be swapped
+type SwapLimits struct {
+	From Currency
+	To   Currency
+	Min  decimal.Decimal
+	Max  decimal.Decimal
+}
+
+// SwapOrderStatus represents the status of a swap order
+type SwapOrderStatus string
+
+// SwapOrderStatusWaiting means the provider is waiting for the payment
+const SwapOrderStatusWaiting SwapOrderStatus = "waiting"
+
+// SwapOrderStatusExchanging means the payment was received and is being exchanged
+const SwapOrderStatusExchanging SwapOrderStatus = "exchanging"
+
+// SwapOrderStatusSending means the swapped funds are being sent
+const SwapOrderStatusSending SwapOrderStatus = "sending"
+
+// SwapOrderStatusCompleted means the swapped funds were sent
+const SwapOrderStatusCompleted SwapOrderStatus = "completed"
+
+// SwapOrderStatusError means the order failed
+const SwapOrderStatusError SwapOrderStatus = "error"
+
+// SwapOrder is a swap order. AmountFrom, AmountTo and SenderAddress are only known once the payment is received
+type SwapOrder struct {
+	ID                 string
+	Status             SwapOrderStatus
+	From               Currency
+	To                 Currency
+	ExpectedAmountFrom decimal.Decimal
+	ExpectedAmountTo   decimal.Decimal
+	AmountFrom         decimal.Decimal
+	AmountTo           decimal.Decimal
+	PayinAddress       string
+	PayoutAddress      string
+	SenderAddress      string
+}
+
+type nanswapSwapProvider struct {
+	client *nanswapclient.Client
+}
+
+// NewNanswapSwapProvider returns a SwapProvider backed by the Nanswap API
+func NewNanswapSwapProvider(client *nanswapclient.Client) SwapProvider {
+	return &nanswapSwapProvider{client: client}
+}
+
+func (n *nanswapSwapProvider) Name() string {
+	return "Nanswap"
+}
+
+func (n *nanswapSwapProvider) IsSwapSource(address string) bool {
+	return address == "ban_3zz761jb16zowd148jb6xpxszgpnk3fw35wnhfatuzah89uruginfdrw8sk7"
+}
+
+func (n *nanswapSwapProvider) GetEstimate(ctx context.Context, from, to Currency, fromAmount decimal.Decimal) (SwapEstimate, error) {
+	response, err := n.client.GetEstimate(ctx, nanswapTicker(from), nanswapTicker(to), fromAmount)
+	if err != nil {
+		return SwapEstimate{}, stacktrace.Propagate(err, "")
+	}
+	return swapEstimateFromNanswap(response), nil
+}
+
+func (n *nanswapSwapProvider) GetEstimateReverse(ctx context.Context, from, to Currency, toAmount decimal.Decimal) (SwapEstimate, error) {
+	response, err := n.client.GetEstimateReverse(ctx, nanswapTicker(from), nanswapTicker(to), toAmount)
+	if err != nil {
+		return SwapEstimate{}, stacktrace.Propagate(err, "")
+	}
+	return swapEstimateFromNanswap(response), nil
+}
+
+func (n *nanswapSwapProvider) GetLimits(ctx context.Context, from, to Currency) (SwapLimits, error) {
+	response, err := n.client.GetLimits(ctx, nanswapTicker(from), nanswapTicker(to))
+	if err != nil {
+		return SwapLimits{}, stacktrace.Propagate(err, "")
+	}
+	return SwapLimits{
+		From: Currency(response.From),
+		To:   Currency(response.To),
+		Min:  response.Min,
+		Max:  response.Max,
+	}, nil
+}
+
+func (n *nanswapSwapProvider) CreateOrder(ctx context.Context, from, to Currency, amount decimal.Decimal, toAddress string, maxDuration time.Duration) (SwapOrder, error) {
+	response, err := n.client.CreateOrder(ctx, nanswapTicker(from), nanswapTicker(to), amount, toAddress, maxDuration)
+	if err != nil {
+		return SwapOrder{}, stacktrace.Propagate(err, "")
+	}
+	return SwapOrder{
+		ID:                 response.ID,
+		Status:             SwapOrderStatusWaiting,
+		From:               Currency(response.From),
+		To:                 Currency(response.To),
+		ExpectedAmountFrom: response.ExpectedAmountFrom,
+		ExpectedAmountTo:   response.ExpectedAmountTo,
+		PayinAddress:       response.PayinAddress,
+		PayoutAddress:      response.PayoutAddress,
+	}, nil
+}
+
+func (n *nanswapSwapProvider) GetOrder(ctx context.Context, id string) (SwapOrder, error) {
+	response, err := n.client.GetOrder(ctx, id)
+	if err != nil {
+		return SwapOrder{}, stacktrace.Propagate(err, "")
+	}
+	status, err := swapOrderStatusFromNanswap(response.Status)
+	if err != nil {
+		return SwapOrder{}, stacktrace.Propagate(err, "")
+	}
+	return SwapOrder{
+		ID:                 response.ID,
+		Status:             status,
+		From:               Currency(response.From),
+		To:                 Currency(response.To),
+		ExpectedAmountFrom: response.ExpectedAmountFrom,
+		ExpectedAmountTo:   response.ExpectedAmountTo,
+		AmountFrom:         response.AmountFrom,
+		AmountTo:           response.AmountTo,
+		PayinAddress:       response.PayinAddress,
+		PayoutAddress:      response.PayoutAddress,
+		SenderAddress:      response.SenderAddress,
+	}, nil
+}
+
+// nanswapTicker returns the Nanswap ticker of a currency. Nanswap identifies currencies by their usual ticker
+func nanswapTicker(currency Currency) nanswapclient.Ticker {
+	return nanswapclient.Ticker(currency)
+}
+
+func swapEstimateFromNanswap(response nanswapclient.GetEstimateResponse) SwapEstimate {
+	return SwapEstimate{
+		From:       Currency(response.From),
+		To:         Currency(response.To),
+		AmountFrom: response.AmountFrom,
+		AmountTo:   response.AmountTo,
+	}
+}
+
+func swapOrderStatusFromNanswap(status nanswapclient.OrderStatus) (SwapOrderStatus, error) {
+	switch status {
+	case nanswapclient.OrderStatusWaiting:
+		return SwapOrderStatusWaiting, nil
+	case nanswapclient.OrderStatusExchanging:
+		return SwapOrderStatusExchanging, nil
+	case nanswapclient.OrderStatusSending:
+		return SwapOrderStatusSending, nil
+	case nanswapclient.OrderStatusCompleted:
+		return SwapOrderStatusCompleted, nil
+	case nanswapclient.OrderStatusError:
+		return SwapOrderStatusError, nil
+	default:
+		return "", stacktrace.NewError("unknown Nanswap order status %s", status)
+	}
+}
@@ -0,0 +1,396 @@
+package paymentintentmanager
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/event"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// ErrPaymentIntentNotFound is returned when the specified payment intent does not exist or belongs to a different
+// application
+var ErrPaymentIntentNotFound = errors.New("payment intent not found")
+
+// resumedIntentGracePeriod is how long intents that expired while the server was down remain monitored after a
+// restart, so that the payments they received before expiring are detected again
+const resumedIntentGracePeriod = 1 * time.Minute
+
+// concludeRetryInterval is how long to wait before trying again to record the conclusion of an intent
+const concludeRetryInterval = 10 * time.Second
+
+// Manager keeps track of payment intents, which are requests for payments to applications that are stored in the
+// database and survive restarts
+type Manager struct {
+	ctx                context.Context
+	log                *log.Logger
+	paymentAccountPool *payment.PaymentAccountPool
+
+	// keyed by application ID
+	paymentIntentConcluded event.Keyed[string, *types.PaymentIntent]
+
+	activeMu sync.Mutex
+	active   map[string]*activeIntent
+}
+
+type activeIntent struct {
+	intent     *types.PaymentIntent
+	receiver   payment.PaymentReceiver
+	cancelOnce sync.Once
+	cancelled  chan struct{}
+	concluded  chan struct{}
+}
+
+// New returns a new initialized Manager, which resumes monitoring the intents that were pending when the server last
+// stopped. Must be called before the payment account pool is used.
+// Intents are monitored for as long as ctx is not cancelled
+func New(ctx context.Context, log *log.Logger, paymentAccountPool *payment.PaymentAccountPool) (*Manager, error) {
+	m := &Manager{
+		ctx:                    ctx,
+		log:                    log,
+		paymentAccountPool:     paymentAccountPool,
+		paymentIntentConcluded: event.NewKeyed[string, *types.PaymentIntent](),
+		active:                 make(map[string]*activeIntent),
+	}
+
+	err := m.resumePendingIntents()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return m, nil
+}
+
+func (m *Manager) resumePendingIntents() error {
+	ctx, err := transaction.Begin(m.ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	intents, err := types.GetPaymentIntentsWithStatus(ctx, types.PaymentIntentStatusPending)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	concludingIntents, err := types.GetPaymentIntentsWithStatus(ctx, types.PaymentIntentStatusConcluding)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	for _, intent := range intents {
+		receiver, err := m.paymentAccountPool.ResumeReceivingPaymentIntoCollectorAccount(
+			uint32(intent.PaymentAccountIndex), intent.CollectorAddress)
+		if err != nil {
+			return stacktrace.Propagate(err, "failed to resume payment intent %s", intent.ID)
+		}
+
+		deadline := intent.ExpiresAt
+		if minDeadline := time.Now().Add(resumedIntentGracePeriod); deadline.Before(minDeadline) {
+			deadline = minDeadline
+		}
+		m.startMonitoring(intent, receiver, deadline)
+	}
+
+	for _, intent := range concludingIntents {
+		// the server stopped before the payment account of the intent was emptied
+		receiver, err := m.paymentAccountPool.ResumeReceivingPaymentIntoCollectorAccount(
+			uint32(intent.PaymentAccountIndex), intent.CollectorAddress)
+		if err != nil {
+			return stacktrace.Propagate(err, "failed to resume conclusion of payment intent %s", intent.ID)
+		}
+
+		ai := m.addActive(intent, receiver)
+		go m.completeConclusion(ai)
+	}
+
+	if len(intents)+len(concludingIntents) > 0 {
+		m.log.Printf("Resumed monitoring of %d payment intents", len(intents)+len(concludingIntents))
+	}
+	return nil
+}
+
+// PaymentIntentConcluded is the event that is fired, keyed by application ID, when an intent of the application stops
+// being pending and its payment account has been emptied into the application wallet
+func (m *Manager) PaymentIntentConcluded() event.Keyed[string, *types.PaymentIntent] {
+	return m.paymentIntentConcluded
+}
+
+// Create creates a payment intent for an application, whose payments are sent to collectorAddress once it concludes
+func (m *Manager) Create(ctxCtx context.Context, applicationID, collectorAddress string, expectedAmount payment.Amount,
+	expiresAt time.Time, metadata []byte) (*types.PaymentIntent, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	receiver, err := m.paymentAccountPool.ReceivePaymentIntoCollectorAccount(collectorAddress)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	now := time.Now()
+	intent := &types.PaymentIntent{
+		ID:                  uuid.NewV4().String(),
+		ApplicationID:       applicationID,
+		PaymentAddress:      receiver.Address(),
+		PaymentAccountIndex: int64(receiver.AccountIndex()),
+		CollectorAddress:    collectorAddress,
+		ExpectedAmount:      expectedAmount.Decimal(),
+		ReceivedAmount:      payment.NewAmount().Decimal(),
+		Metadata:            metadata,
+		Status:              types.PaymentIntentStatusPending,
+		CreatedAt:           now,
+		ExpiresAt:           expiresAt,
+		UpdatedAt:           now,
+	}
+	err = intent.Update(ctx)
+	if err == nil {
+		err = ctx.Commit()
+	}
+	if err != nil {
+		// nothing can have been paid yet, as the payment address was never revealed
+		<-receiver.Close()
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	// the monitored intent is modified as payments are received
+	intentCopy := *intent
+	m.startMonitoring(&intentCopy, receiver, expiresAt)
+	return intent, nil
+}
+
+// Get returns a payment intent of an application
+func (m *Manager) Get(ctxCtx context.Context, applicationID, intentID string) (*types.PaymentIntent, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	intent, found, err := types.GetPaymentIntentOfApplication(ctx, applicationID, intentID)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if !found {
+		return nil, stacktrace.Propagate(ErrPaymentIntentNotFound, "")
+	}
+	return intent, nil
+}
+
+// Cancel cancels a pending payment intent of an application, returning once it has concluded.
+// If the intent had already concluded, it is returned unchanged
+func (m *Manager) Cancel(ctx context.Context, applicationID, intentID string) (*types.PaymentIntent, error) {
+	m.activeMu.Lock()
+	ai, ok := m.active[intentID]
+	m.activeMu.Unlock()
+
+	if !ok || ai.intent.ApplicationID != applicationID {
+		intent, err := m.Get(ctx, applicationID, intentID)
+		return intent, stacktrace.Propagate(err, "")
+	}
+
+	ai.cancelOnce.Do(func() { close(ai.cancelled) })
+	select {
+	case <-ai.concluded:
+		intent := *ai.intent
+		return &intent, nil
+	case <-ctx.Done():
+		return nil, stacktrace.Propagate(ctx.Err(), "")
+	}
+}
+
+// UndeliveredConcludedIntents returns the intents of an application whose conclusion has not yet been delivered to it
+func (m *Manager) UndeliveredConcludedIntents(ctxCtx context.Context, applicationID string) ([]*types.PaymentIntent, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	intents, err := types.GetUndeliveredConcludedPaymentIntentsOfApplication(ctx, applicationID)
+	return intents, stacktrace.Propagate(err, "")
+}
+
+// MarkAsDelivered records that the conclusion of an intent was delivered to its application
+func (m *Manager) MarkAsDelivered(ctxCtx context.Context, intent *types.PaymentIntent) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	intent.DeliveredAt = sql.NullTime{Time: time.Now(), Valid: true}
+	err = intent.Update(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
+
+func (m *Manager) startMonitoring(intent *types.PaymentIntent, receiver payment.PaymentReceiver, deadline time.Time) {
+	ai := m.addActive(intent, receiver)
+	go m.monitor(ai, deadline)
+}
+
+func (m *Manager) addActive(intent *types.PaymentIntent, receiver payment.PaymentReceiver) *activeIntent {
+	ai := &activeIntent{
+		intent:    intent,
+		receiver:  receiver,
+		cancelled: make(chan struct{}),
+		concluded: make(chan struct{}),
+	}
+
+	m.activeMu.Lock()
+	defer m.activeMu.Unlock()
+	m.active[intent.ID] = ai
+	return ai
+}
+
+func (m *Manager) monitor(ai *activeIntent, deadline time.Time) {
+	status, ok := m.awaitConclusion(ai, deadline)
+	if !ok {
+		// the server is shutting down. the intent remains pending and will be resumed on the next start
+		return
+	}
+
+	ok = m.retryConclusionStep(ai, func() error {
+		return m.beginConclusion(ai, status)
+	})
+	if !ok {
+		return
+	}
+	m.completeConclusion(ai)
+}
+
+// retryConclusionStep calls step until it succeeds. Returns false if the server began shutting down first
+func (m *Manager) retryConclusionStep(ai *activeIntent, step func() error) bool {
+	for {
+		err := step()
+		if err == nil {
+			return true
+		}
+		m.log.Printf("failed to conclude payment intent %s: %v", ai.intent.ID, err)
+		select {
+		case <-time.After(concludeRetryInterval):
+		case <-m.ctx.Done():
+			return false
+		}
+	}
+}
+
+func (m *Manager) awaitConclusion(ai *activeIntent, deadline time.Time) (types.PaymentIntentStatus, bool) {
+	onPaymentReceived, paymentReceivedU := ai.receiver.PaymentReceived().Subscribe(event.BufferAll)
+	defer paymentReceivedU()
+
+	t := time.NewTimer(time.Until(deadline))
+	defer t.Stop()
+
+	for {
+		select {
+		case args := <-onPaymentReceived:
+			if args.Balance.Decimal().GreaterThanOrEqual(ai.intent.ExpectedAmount) {
+				return types.PaymentIntentStatusPaid, true
+			}
+			err := m.updateReceivedAmount(ai.intent, args.Balance)
+			if err != nil {
+				// not critical, the received amount is recorded again when the intent concludes
+				m.log.Printf("failed to update received amount of payment intent %s: %v", ai.intent.ID, err)
+			}
+		case <-t.C:
+			return types.PaymentIntentStatusExpired, true
+		case <-ai.cancelled:
+			return types.PaymentIntentStatusCancelled, true
+		case <-m.ctx.Done():
+			return "", false
+		}
+	}
+}
+
+func (m *Manager) updateReceivedAmount(intent *types.PaymentIntent, received payment.Amount) error {
+	ctx, err := transaction.Begin(m.ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	intent.ReceivedAmount = received.Decimal()
+	intent.UpdatedAt = time.Now()
+	err = intent.Update(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// beginConclusion records that the intent will conclude with the specified status, once its payment account has been
+// emptied. Should the server stop before that happens, the conclusion is completed on the next start
+func (m *Manager) beginConclusion(ai *activeIntent, status types.PaymentIntentStatus) error {
+	ctx, err := transaction.Begin(m.ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	// what is received is what will be sent to the application wallet
+	ai.intent.ReceivedAmount = ai.receiver.ReceivableBalance().Decimal()
+	ai.intent.Status = types.PaymentIntentStatusConcluding
+	ai.intent.ConcludedStatus = &status
+	ai.intent.UpdatedAt = time.Now()
+	err = ai.intent.Update(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// completeConclusion empties the payment account of a concluding intent into its collector account, then records its
+// final status
+func (m *Manager) completeConclusion(ai *activeIntent) {
+	<-ai.receiver.Close()
+
+	ok := m.retryConclusionStep(ai, func() error {
+		return m.conclude(ai)
+	})
+	if !ok {
+		// the intent remains concluding and its (already empty) payment account will be swept again on the next start
+		return
+	}
+
+	func() {
+		m.activeMu.Lock()
+		defer m.activeMu.Unlock()
+		delete(m.active, ai.intent.ID)
+	}()
+
+	close(ai.concluded)
+	intent := *ai.intent
+	m.paymentIntentConcluded.Notify(intent.ApplicationID, &intent, false)
+}
+
+func (m *Manager) conclude(ai *activeIntent) error {
+	ctx, err := transaction.Begin(m.ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	if ai.intent.ConcludedStatus == nil {
+		return stacktrace.NewError("concluding payment intent has no concluded status")
+	}
+	ai.intent.Status = *ai.intent.ConcludedStatus
+	ai.intent.UpdatedAt = time.Now()
+	err = ai.intent.Update(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
@@ -191,6 +191,11 @@ var pointsTxAllowedDirectionByType = map[types.PointsTxType]pointsTxDirection{
 	types.PointsTxTypeSkipThresholdIncrease:       pointsTxDirectionDecrease,
 	types.PointsTxTypeConcealedEntryEnqueuing:     pointsTxDirectionDecrease,
 	types.PointsTxTypeApplicationDefined:          pointsTxDirectionIncreaseOrDecrease,
+	types.PointsTxTypeSkipVote:                    pointsTxDirectionDecrease,
+	types.PointsTxTypeTipSent:                     pointsTxDirectionDecrease,
+	types.PointsTxTypeTipReceived:                 pointsTxDirectionIncrease,
+	types.PointsTxTypeRewardRedemption:            pointsTxDirectionDecrease,
+	types.PointsTxTypeRewardRedemptionRefund:      pointsTxDirectionIncrease,
 }
 
 // to save on DB storage space, for "uninteresting" transaction types, we collapse consecutive records of the same type
@@ -210,4 +215,9 @@ var pointsTxTypeMandatoryExtraFields = map[types.PointsTxType][]string{
 	types.PointsTxTypeQueueEntryReordering:        {"media", "direction"},
 	types.PointsTxTypeConcealedEntryEnqueuing:     {"media"},
 	types.PointsTxTypeApplicationDefined:          {"application_id", "application_version", "description"},
+	types.PointsTxTypeSkipVote:                    {"media"},
+	types.PointsTxTypeTipSent:                     {"tip_id", "recipient"},
+	types.PointsTxTypeTipReceived:                 {"tip_id", "sender"},
+	types.PointsTxTypeRewardRedemption:            {"redemption_id", "reward_id"},
+	types.PointsTxTypeRewardRedemptionRefund:      {"redemption_id", "reward_id"},
 }
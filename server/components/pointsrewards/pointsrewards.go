@@ -0,0 +1,304 @@
+package pointsrewards
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/pointsmanager"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/event"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// MaxInputLength is the maximum length, in characters, of the text users can provide when redeeming a reward
+const MaxInputLength = 500
+
+// MaxStaffNoteLength is the maximum length, in characters, of the note that can be attached to a redemption
+const MaxStaffNoteLength = 500
+
+// ErrInvalidReward is returned when attempting to save a reward with invalid properties
+var ErrInvalidReward = errors.New("invalid points reward")
+
+// ErrRewardUnavailable is returned when attempting to redeem a reward that does not exist or is disabled
+var ErrRewardUnavailable = errors.New("points reward unavailable")
+
+// ErrRewardOutOfStock is returned when attempting to redeem a reward that has no remaining stock
+var ErrRewardOutOfStock = errors.New("points reward out of stock")
+
+// ErrRedemptionLimitReached is returned when the user already redeemed a reward as many times as allowed
+var ErrRedemptionLimitReached = errors.New("points reward redemption limit reached")
+
+// ErrInvalidInput is returned when the text provided on redemption is missing or too long
+var ErrInvalidInput = errors.New("invalid redemption input")
+
+// ErrInvalidStatusTransition is returned when attempting to change the status of a redemption in a way that is not
+// allowed, e.g. fulfilling a rejected redemption
+var ErrInvalidStatusTransition = errors.New("invalid redemption status transition")
+
+// Manager manages the catalog of points rewards and their redemptions
+type Manager struct {
+	log           *log.Logger
+	pointsManager *pointsmanager.Manager
+
+	// serializes redemptions, status changes and reward updates so that per-user limits can't be exceeded by
+	// concurrent requests and stock changes are not lost
+	redemptionLock sync.Mutex
+
+	redemptionCreated event.Event[*types.PointsRewardRedemption]
+	redemptionUpdated event.Event[*types.PointsRewardRedemption]
+}
+
+// New returns a new initialized Manager
+func New(log *log.Logger, pointsManager *pointsmanager.Manager) *Manager {
+	return &Manager{
+		log:               log,
+		pointsManager:     pointsManager,
+		redemptionCreated: event.New[*types.PointsRewardRedemption](),
+		redemptionUpdated: event.New[*types.PointsRewardRedemption](),
+	}
+}
+
+// RedemptionCreated returns the event that is fired when a user redeems a reward
+func (m *Manager) RedemptionCreated() event.Event[*types.PointsRewardRedemption] {
+	return m.redemptionCreated
+}
+
+// RedemptionUpdated returns the event that is fired when the status or staff note of a redemption changes
+func (m *Manager) RedemptionUpdated() event.Event[*types.PointsRewardRedemption] {
+	return m.redemptionUpdated
+}
+
+// Rewards returns the rewards in the catalog, optionally including the disabled ones
+func (m *Manager) Rewards(ctxCtx context.Context, includeDisabled bool) ([]*types.PointsReward, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	rewards, err := types.GetPointsRewards(ctx, includeDisabled)
+	return rewards, stacktrace.Propagate(err, "")
+}
+
+func validateReward(reward *types.PointsReward) error {
+	if reward.Title == "" || utf8.RuneCountInString(reward.Title) > 100 {
+		return stacktrace.Propagate(ErrInvalidReward, "invalid title")
+	}
+	if utf8.RuneCountInString(reward.Description) > 1000 {
+		return stacktrace.Propagate(ErrInvalidReward, "description too long")
+	}
+	if reward.Cost <= 0 {
+		return stacktrace.Propagate(ErrInvalidReward, "cost must be positive")
+	}
+	if reward.Stock != nil && *reward.Stock < 0 {
+		return stacktrace.Propagate(ErrInvalidReward, "stock must not be negative")
+	}
+	if reward.PerUserLimit != nil && *reward.PerUserLimit <= 0 {
+		return stacktrace.Propagate(ErrInvalidReward, "per-user limit must be positive")
+	}
+	return nil
+}
+
+// SetReward creates or updates a reward of the catalog.
+// When updateStock is false, an existing reward keeps its current stock, so that redemptions made while the reward was
+// being edited are not undone
+func (m *Manager) SetReward(ctxCtx context.Context, reward *types.PointsReward, updateStock bool) error {
+	err := validateReward(reward)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	// the stock must not change between being read here and being written back
+	m.redemptionLock.Lock()
+	defer m.redemptionLock.Unlock()
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	if !updateStock {
+		existing, err := types.GetPointsRewardWithID(ctx, reward.ID)
+		if err == nil {
+			reward.Stock = existing.Stock
+		} else if !errors.Is(err, types.ErrPointsRewardNotFound) {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+
+	err = reward.Update(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// Redeem redeems a reward on behalf of the user, charging them the current cost of the reward.
+// input is the text provided by the user, which is mandatory for rewards that require input and ignored otherwise
+func (m *Manager) Redeem(ctxCtx context.Context, user auth.User, rewardID, input string) (*types.PointsRewardRedemption, error) {
+	if user == nil || user.IsUnknown() {
+		return nil, stacktrace.NewError("can't redeem rewards as unknown user")
+	}
+	if utf8.RuneCountInString(input) > MaxInputLength {
+		return nil, stacktrace.Propagate(ErrInvalidInput, "")
+	}
+
+	m.redemptionLock.Lock()
+	defer m.redemptionLock.Unlock()
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	reward, err := types.GetPointsRewardWithID(ctx, rewardID)
+	if err != nil {
+		if errors.Is(err, types.ErrPointsRewardNotFound) {
+			return nil, stacktrace.Propagate(ErrRewardUnavailable, "")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if !reward.Enabled {
+		return nil, stacktrace.Propagate(ErrRewardUnavailable, "")
+	}
+
+	if reward.PerUserLimit != nil {
+		count, err := types.CountActivePointsRewardRedemptions(ctx, reward.ID, user.Address())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		if count >= *reward.PerUserLimit {
+			return nil, stacktrace.Propagate(ErrRedemptionLimitReached, "")
+		}
+	}
+
+	now := time.Now()
+	redemption := &types.PointsRewardRedemption{
+		ID:             uuid.NewV4().String(),
+		RewardID:       reward.ID,
+		RewardsAddress: user.Address(),
+		Cost:           reward.Cost,
+		Status:         types.PointsRewardRedemptionStatusPending,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if reward.RequiresInput {
+		if input == "" {
+			return nil, stacktrace.Propagate(ErrInvalidInput, "")
+		}
+		redemption.Input = &input
+	}
+
+	inStock, err := reward.AdjustStock(ctx, -1)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if !inStock {
+		return nil, stacktrace.Propagate(ErrRewardOutOfStock, "")
+	}
+
+	err = redemption.Update(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	_, err = m.pointsManager.CreateTransaction(ctx, user, types.PointsTxTypeRewardRedemption, -reward.Cost,
+		pointsmanager.TxExtraField{Key: "redemption_id", Value: redemption.ID},
+		pointsmanager.TxExtraField{Key: "reward_id", Value: reward.ID})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	ctx.DeferToCommit(func() {
+		m.log.Printf("User %s redeemed points reward %s (%s) for %d points", user.Address(), reward.ID, reward.Title, reward.Cost)
+		m.redemptionCreated.Notify(redemption, false)
+	})
+	return redemption, stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// Redemptions returns redemptions, most recent first, optionally filtered by status and/or by the address of the user
+// who redeemed them
+func (m *Manager) Redemptions(ctxCtx context.Context, statuses []types.PointsRewardRedemptionStatus, address string, pagParams *types.PaginationParams) ([]*types.PointsRewardRedemption, uint64, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	redemptions, total, err := types.GetPointsRewardRedemptions(ctx, statuses, address, pagParams)
+	return redemptions, total, stacktrace.Propagate(err, "")
+}
+
+// UpdateRedemption changes the status and staff note of a redemption.
+// Only pending redemptions can change status. Rejecting a redemption refunds its cost and restores the reward stock.
+// updatedBy is the address of the staff member, or the ID of the application, making the change
+func (m *Manager) UpdateRedemption(ctxCtx context.Context, redemptionID string, status types.PointsRewardRedemptionStatus, staffNote *string, updatedBy string) (*types.PointsRewardRedemption, error) {
+	switch status {
+	case types.PointsRewardRedemptionStatusPending, types.PointsRewardRedemptionStatusFulfilled, types.PointsRewardRedemptionStatusRejected:
+	default:
+		return nil, stacktrace.Propagate(ErrInvalidStatusTransition, "unknown status %s", status)
+	}
+	if staffNote != nil && utf8.RuneCountInString(*staffNote) > MaxStaffNoteLength {
+		return nil, stacktrace.Propagate(ErrInvalidInput, "staff note too long")
+	}
+
+	m.redemptionLock.Lock()
+	defer m.redemptionLock.Unlock()
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	redemption, err := types.GetPointsRewardRedemptionWithID(ctx, redemptionID)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if redemption.Status != status && redemption.Status != types.PointsRewardRedemptionStatusPending {
+		return nil, stacktrace.Propagate(ErrInvalidStatusTransition, "")
+	}
+
+	if redemption.Status != status && status == types.PointsRewardRedemptionStatusRejected {
+		reward, err := types.GetPointsRewardWithID(ctx, redemption.RewardID)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		_, err = reward.AdjustStock(ctx, 1)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+
+		_, err = m.pointsManager.CreateTransaction(ctx, auth.NewAddressOnlyUser(redemption.RewardsAddress),
+			types.PointsTxTypeRewardRedemptionRefund, redemption.Cost,
+			pointsmanager.TxExtraField{Key: "redemption_id", Value: redemption.ID},
+			pointsmanager.TxExtraField{Key: "reward_id", Value: redemption.RewardID})
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+
+	redemption.Status = status
+	redemption.StaffNote = staffNote
+	redemption.UpdatedAt = time.Now()
+	redemption.UpdatedBy = &updatedBy
+	err = redemption.Update(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	ctx.DeferToCommit(func() {
+		m.redemptionUpdated.Notify(redemption, false)
+	})
+	return redemption, stacktrace.Propagate(ctx.Commit(), "")
+}
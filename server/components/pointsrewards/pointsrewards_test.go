@@ -0,0 +1,241 @@
+package pointsrewards_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"testing"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/pointsmanager"
+	"github.com/tnyim/jungletv/server/components/pointsrewards"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction/transactiontest"
+
+	"github.com/stretchr/testify/require"
+)
+
+// rewardsDatabase is the part of the database touched by points rewards
+type rewardsDatabase struct {
+	rewards       map[string]map[string]driver.Value
+	redemptions   map[string]map[string]driver.Value
+	pointsBalance map[string]int64
+	pointsTxs     []map[string]driver.Value
+}
+
+func (d *rewardsDatabase) clone() *rewardsDatabase {
+	c := &rewardsDatabase{
+		rewards:       make(map[string]map[string]driver.Value),
+		redemptions:   make(map[string]map[string]driver.Value),
+		pointsBalance: make(map[string]int64),
+		pointsTxs:     append([]map[string]driver.Value{}, d.pointsTxs...),
+	}
+	for k, v := range d.rewards {
+		c.rewards[k] = make(map[string]driver.Value)
+		for column, value := range v {
+			c.rewards[k][column] = value
+		}
+	}
+	for k, v := range d.redemptions {
+		c.redemptions[k] = v
+	}
+	for k, v := range d.pointsBalance {
+		c.pointsBalance[k] = v
+	}
+	return c
+}
+
+func newRewardsDatabase(pointsBalance map[string]int64) *transactiontest.FakeDatabase[*rewardsDatabase] {
+	type statement = transactiontest.FakeStatement
+	type result = transactiontest.FakeResult
+
+	db := transactiontest.NewFakeDatabase((&rewardsDatabase{pointsBalance: pointsBalance}).clone(), (*rewardsDatabase).clone)
+	db.Handle(`^SELECT .* FROM points_reward WHERE points_reward.id = \$1$`, func(s *rewardsDatabase, stmt *statement) (*result, error) {
+		reward, present := s.rewards[stmt.Args[0].(string)]
+		if !present {
+			return nil, nil
+		}
+		return &result{Rows: [][]any{stmt.Row(reward)}}, nil
+	})
+	db.Handle(`^INSERT INTO points_reward `, func(s *rewardsDatabase, stmt *statement) (*result, error) {
+		for _, row := range stmt.InsertedRows() {
+			s.rewards[row["id"].(string)] = row
+		}
+		return nil, nil
+	})
+	db.Handle(`^UPDATE points_reward SET stock = stock \+ \$1 WHERE id = \$2 AND stock \+ \$3 >= 0$`, func(s *rewardsDatabase, stmt *statement) (*result, error) {
+		reward, present := s.rewards[stmt.Args[1].(string)]
+		if !present || reward["stock"] == nil {
+			return nil, nil
+		}
+		stock := reward["stock"].(int64) + stmt.Args[0].(int64)
+		if stock < 0 {
+			return nil, nil
+		}
+		reward["stock"] = stock
+		return &result{RowsAffected: 1}, nil
+	})
+	db.Handle(`^SELECT COUNT\(\*\) FROM points_reward_redemption WHERE points_reward_redemption.reward_id = \$1 AND points_reward_redemption.rewards_address = \$2 AND points_reward_redemption.status <> \$3$`, func(s *rewardsDatabase, stmt *statement) (*result, error) {
+		count := 0
+		for _, redemption := range s.redemptions {
+			if redemption["reward_id"] == stmt.Args[0] && redemption["rewards_address"] == stmt.Args[1] && redemption["status"] != stmt.Args[2] {
+				count++
+			}
+		}
+		return &result{Rows: [][]any{{count}}}, nil
+	})
+	db.Handle(`^SELECT .* FROM points_reward_redemption WHERE points_reward_redemption.id = \$1$`, func(s *rewardsDatabase, stmt *statement) (*result, error) {
+		redemption, present := s.redemptions[stmt.Args[0].(string)]
+		if !present {
+			return nil, nil
+		}
+		return &result{Rows: [][]any{stmt.Row(redemption)}}, nil
+	})
+	db.Handle(`^INSERT INTO points_reward_redemption `, func(s *rewardsDatabase, stmt *statement) (*result, error) {
+		for _, row := range stmt.InsertedRows() {
+			s.redemptions[row["id"].(string)] = row
+		}
+		return nil, nil
+	})
+	db.Handle(`^INSERT INTO points_balance `, func(s *rewardsDatabase, stmt *statement) (*result, error) {
+		address := stmt.Args[0].(string)
+		if _, present := s.pointsBalance[address]; !present {
+			s.pointsBalance[address] = 0
+		}
+		return nil, nil
+	})
+	db.Handle(`^UPDATE points_balance SET balance = balance \+ \$1 WHERE points_balance.rewards_address = \$2$`, func(s *rewardsDatabase, stmt *statement) (*result, error) {
+		address := stmt.Args[1].(string)
+		balance := s.pointsBalance[address] + stmt.Args[0].(int64)
+		if balance < 0 {
+			return nil, errors.New(`new row for relation "points_balance" violates check constraint "points_balance_balance_check"`)
+		}
+		s.pointsBalance[address] = balance
+		return &result{RowsAffected: 1}, nil
+	})
+	db.Handle(`^INSERT INTO points_tx `, func(s *rewardsDatabase, stmt *statement) (*result, error) {
+		s.pointsTxs = append(s.pointsTxs, stmt.InsertedRows()...)
+		return nil, nil
+	})
+	return db
+}
+
+func newManager(t *testing.T, ctx context.Context) *pointsrewards.Manager {
+	snowflakeNode, err := snowflake.NewNode(1)
+	require.NoError(t, err)
+	pointsManager, err := pointsmanager.New(ctx, log.New(io.Discard, "", 0), snowflakeNode, nil)
+	require.NoError(t, err)
+	return pointsrewards.New(log.New(io.Discard, "", 0), pointsManager)
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func requireRedemptionTx(t *testing.T, tx map[string]driver.Value, address string, value int, txType types.PointsTxType, redemption *types.PointsRewardRedemption) {
+	require.Equal(t, address, tx["rewards_address"])
+	require.EqualValues(t, value, tx["value"])
+	require.EqualValues(t, txType, tx["type"])
+
+	extra := map[string]string{}
+	require.NoError(t, json.Unmarshal(tx["extra"].([]byte), &extra))
+	require.Equal(t, redemption.ID, extra["redemption_id"])
+	require.Equal(t, redemption.RewardID, extra["reward_id"])
+}
+
+func TestRedeem(t *testing.T) {
+	db := newRewardsDatabase(map[string]int64{
+		"ban_1alice": 100,
+		"ban_1bob":   100,
+		"ban_1carol": 100,
+		"ban_1dave":  20,
+	})
+	ctx := db.Context(context.Background())
+	m := newManager(t, ctx)
+
+	reward := &types.PointsReward{
+		ID:           "reward",
+		Title:        "Sticker",
+		Cost:         30,
+		Stock:        intPtr(2),
+		PerUserLimit: intPtr(1),
+		Enabled:      true,
+	}
+	require.NoError(t, m.SetReward(ctx, reward, true))
+
+	alice := auth.NewAddressOnlyUser("ban_1alice")
+	aliceRedemption, err := m.Redeem(ctx, alice, "reward", "")
+	require.NoError(t, err)
+	require.Equal(t, types.PointsRewardRedemptionStatusPending, aliceRedemption.Status)
+
+	state := db.State()
+	require.EqualValues(t, 1, state.rewards["reward"]["stock"])
+	require.EqualValues(t, 70, state.pointsBalance["ban_1alice"])
+	require.Contains(t, state.redemptions, aliceRedemption.ID)
+	require.Len(t, state.pointsTxs, 1)
+	requireRedemptionTx(t, state.pointsTxs[0], "ban_1alice", -30, types.PointsTxTypeRewardRedemption, aliceRedemption)
+
+	// each user can only redeem the reward once
+	_, err = m.Redeem(ctx, alice, "reward", "")
+	require.ErrorIs(t, err, pointsrewards.ErrRedemptionLimitReached)
+
+	// users can't redeem rewards they can't afford
+	_, err = m.Redeem(ctx, auth.NewAddressOnlyUser("ban_1dave"), "reward", "")
+	require.ErrorIs(t, err, types.ErrInsufficientPointsBalance)
+
+	state = db.State()
+	require.EqualValues(t, 1, state.rewards["reward"]["stock"])
+	require.EqualValues(t, 70, state.pointsBalance["ban_1alice"])
+	require.EqualValues(t, 20, state.pointsBalance["ban_1dave"])
+	require.Len(t, state.redemptions, 1)
+	require.Len(t, state.pointsTxs, 1)
+
+	// editing the reward without asking to update the stock leaves the stock alone
+	edited := *reward
+	edited.Title = "Shiny sticker"
+	edited.Stock = intPtr(10)
+	require.NoError(t, m.SetReward(ctx, &edited, false))
+	state = db.State()
+	require.Equal(t, "Shiny sticker", state.rewards["reward"]["title"])
+	require.EqualValues(t, 1, state.rewards["reward"]["stock"])
+
+	bobRedemption, err := m.Redeem(ctx, auth.NewAddressOnlyUser("ban_1bob"), "reward", "")
+	require.NoError(t, err)
+
+	_, err = m.Redeem(ctx, auth.NewAddressOnlyUser("ban_1carol"), "reward", "")
+	require.ErrorIs(t, err, pointsrewards.ErrRewardOutOfStock)
+
+	state = db.State()
+	require.EqualValues(t, 0, state.rewards["reward"]["stock"])
+	require.EqualValues(t, 70, state.pointsBalance["ban_1bob"])
+	require.EqualValues(t, 100, state.pointsBalance["ban_1carol"])
+	require.Len(t, state.pointsTxs, 2)
+	requireRedemptionTx(t, state.pointsTxs[1], "ban_1bob", -30, types.PointsTxTypeRewardRedemption, bobRedemption)
+
+	// the stock changes when explicitly asked to
+	restocked := edited
+	restocked.Stock = intPtr(5)
+	require.NoError(t, m.SetReward(ctx, &restocked, true))
+	require.EqualValues(t, 5, db.State().rewards["reward"]["stock"])
+
+	// rejecting a redemption refunds it, restores the stock and no longer counts towards the per-user limit
+	_, err = m.UpdateRedemption(ctx, aliceRedemption.ID, types.PointsRewardRedemptionStatusRejected, nil, "ban_1staff")
+	require.NoError(t, err)
+
+	state = db.State()
+	require.EqualValues(t, 6, state.rewards["reward"]["stock"])
+	require.EqualValues(t, 100, state.pointsBalance["ban_1alice"])
+	require.EqualValues(t, types.PointsRewardRedemptionStatusRejected, state.redemptions[aliceRedemption.ID]["status"])
+	require.Len(t, state.pointsTxs, 3)
+	requireRedemptionTx(t, state.pointsTxs[2], "ban_1alice", 30, types.PointsTxTypeRewardRedemptionRefund, aliceRedemption)
+
+	_, err = m.Redeem(ctx, alice, "reward", "")
+	require.NoError(t, err)
+	state = db.State()
+	require.EqualValues(t, 5, state.rewards["reward"]["stock"])
+	require.EqualValues(t, 70, state.pointsBalance["ban_1alice"])
+}
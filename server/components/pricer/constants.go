@@ -14,5 +14,18 @@ var PriceRoundingFactor *big.Int = new(big.Int).Div(BananoUnit, big.NewInt(100))
 // RewardRoundingFactor is the rounding factor for per-user rewards
 var RewardRoundingFactor *big.Int = new(big.Int).Div(BananoUnit, big.NewInt(100))
 
+// QueueEntryMovementCost is the cost, in points, of moving a queue entry, before pricing rules are applied
+const QueueEntryMovementCost = 119
+
+// SubscriberQueueEntryMovementCost is the cost, in points, of moving a queue entry for users with a subscription,
+// before pricing rules are applied
+const SubscriberQueueEntryMovementCost = 69
+
 // DustThreshold is the minimum admissible amount for a transaction to be processed
 var DustThreshold *big.Int = new(big.Int).Div(BananoUnit, big.NewInt(1000))
+
+// NanoUnit is 1 XNO
+var NanoUnit *big.Int = big.NewInt(1).Exp(big.NewInt(10), big.NewInt(30), big.NewInt(0)) // 1000000000000000000000000000000
+
+// NanoDustThreshold is the minimum admissible amount for a XNO transaction to be processed
+var NanoDustThreshold *big.Int = new(big.Int).Div(NanoUnit, big.NewInt(1000000))
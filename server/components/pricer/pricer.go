@@ -5,12 +5,14 @@ import (
 	"log"
 	"math"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/palantir/stacktrace"
 	"github.com/tnyim/jungletv/server/components/mediaqueue"
 	"github.com/tnyim/jungletv/server/components/payment"
 	"github.com/tnyim/jungletv/server/components/stats"
+	"github.com/tnyim/jungletv/types"
 )
 
 // MinimumMinimumPricesMultiplier is the minimum value of the minimum prices multiplier
@@ -34,6 +36,9 @@ type Pricer struct {
 	minimumPricesMultiplier   int
 	finalPricesMultiplier     int
 	crowdfundedSkipMultiplier int
+
+	rulesMutex sync.RWMutex
+	rules      []*types.PricingRule
 }
 
 type EligibleSpectatorsEstimator interface {
@@ -110,14 +115,38 @@ func (p *Pricer) ComputeEnqueuePricing(mediaDuration time.Duration, unskippable,
 	// EnqueuePrice = ( BaseEnqueuePrice * LengthInSeconds * (1000 + QueueLengthFactor + currentlyWatching * minimumPricesMultiplier) ) / 500000 * UnskippableFactor
 	// PlayNextPrice = EnqueuePrice * 3
 	// PlayNowPrice = EnqueuePrice * (10 + floor(queueLength / 10))
-	currentlyWatching := p.currentlyWatchingEligible()
-	if currentlyWatching <= 0 {
-		currentlyWatching = 1
+	// All prices are then adjusted by the pricing rules that match the current conditions
+	c := p.currentConditions()
+	rulesMultiplier, _ := p.rulesMultiplier(PriceKindEnqueue, c)
+	return p.computeEnqueuePricing(mediaDuration, c.QueueLength, c.EligibleSpectators, unskippable, concealed, rulesMultiplier)
+}
+
+// ComputeBatchEnqueuePricing calculates the prices to charge for enqueuing multiple media under a single ticket.
+// Each media is priced as if it were enqueued after the preceding media of the batch.
+// Returns the pricing for the whole batch as well as the pricing of each media
+func (p *Pricer) ComputeBatchEnqueuePricing(mediaDurations []time.Duration, unskippable, concealed bool) (EnqueuePricing, []EnqueuePricing) {
+	c := p.currentConditions()
+
+	total := EnqueuePricing{
+		EnqueuePrice:          payment.NewAmount(),
+		PlayAfterCurrentPrice: payment.NewAmount(),
+		PlayNowPrice:          payment.NewAmount(),
 	}
-	queueLength := p.mediaQueue.LengthUpToCursor() - 1
-	if queueLength < 0 {
-		queueLength = 0
+	itemPricing := make([]EnqueuePricing, len(mediaDurations))
+	for i, mediaDuration := range mediaDurations {
+		itemConditions := c
+		itemConditions.QueueLength += i
+		rulesMultiplier, _ := p.rulesMultiplier(PriceKindEnqueue, itemConditions)
+		itemPricing[i] = p.computeEnqueuePricing(mediaDuration, itemConditions.QueueLength, itemConditions.EligibleSpectators,
+			unskippable, concealed, rulesMultiplier)
+		total.EnqueuePrice.Add(total.EnqueuePrice.Int, itemPricing[i].EnqueuePrice.Int)
+		total.PlayAfterCurrentPrice.Add(total.PlayAfterCurrentPrice.Int, itemPricing[i].PlayAfterCurrentPrice.Int)
+		total.PlayNowPrice.Add(total.PlayNowPrice.Int, itemPricing[i].PlayNowPrice.Int)
 	}
+	return total, itemPricing
+}
+
+func (p *Pricer) computeEnqueuePricing(mediaDuration time.Duration, queueLength, currentlyWatching int, unskippable, concealed bool, rulesMultiplier int64) EnqueuePricing {
 	queueLengthFactor := int64(100.0 * math.Pow(float64(queueLength), 1.3))
 
 	lengthInSeconds := int64(mediaDuration.Seconds())
@@ -148,6 +177,9 @@ func (p *Pricer) ComputeEnqueuePricing(mediaDuration time.Duration, unskippable,
 	pricing.EnqueuePrice.Div(pricing.EnqueuePrice.Int, big.NewInt(100))
 	pricing.EnqueuePrice.Mul(pricing.EnqueuePrice.Int, big.NewInt(int64(p.finalPricesMultiplier)))
 
+	pricing.EnqueuePrice.Mul(pricing.EnqueuePrice.Int, big.NewInt(rulesMultiplier))
+	pricing.EnqueuePrice.Div(pricing.EnqueuePrice.Int, big.NewInt(100))
+
 	// never allow prices to go below a certain amount per spectator as otherwise we risk having 0 to distribute per user
 	// (e.g. if the number of eligible spectators increases until the entry plays)
 	minimumUnit := big.NewInt(0).Div(BananoUnit, big.NewInt(1000))
@@ -170,11 +202,19 @@ func (p *Pricer) ComputeEnqueuePricing(mediaDuration time.Duration, unskippable,
 }
 
 func (p *Pricer) ComputeCrowdfundedSkipPricing(recentCrowdfundedSkips int) payment.Amount {
-	pricing := p.ComputeEnqueuePricing(5*time.Minute, false, false)
+	return p.computeCrowdfundedSkipPricing(p.currentConditions(), recentCrowdfundedSkips)
+}
+
+func (p *Pricer) computeCrowdfundedSkipPricing(c Conditions, recentCrowdfundedSkips int) payment.Amount {
+	// the rules that adjust enqueue prices do not apply here, as there are rules specific to crowdfunded skipping
+	pricing := p.computeEnqueuePricing(5*time.Minute, c.QueueLength, c.EligibleSpectators, false, false, 100)
 	v := big.NewInt(0).Mul(
 		pricing.PlayNowPrice.Int,
 		big.NewInt(int64(p.crowdfundedSkipMultiplier)),
 	)
+	rulesMultiplier, _ := p.rulesMultiplier(PriceKindCrowdfundedSkip, c)
+	v.Mul(v, big.NewInt(rulesMultiplier))
+	v.Div(v, big.NewInt(100))
 	if recentCrowdfundedSkips > 0 {
 		recentSkipMul := math.Pow(1.1, float64(recentCrowdfundedSkips)) * 10000
 		v.Mul(v, big.NewInt(int64(recentSkipMul)))
@@ -186,6 +226,75 @@ func (p *Pricer) ComputeCrowdfundedSkipPricing(recentCrowdfundedSkips int) payme
 	return payment.NewAmount(v)
 }
 
+// ComputeQueueEntryMovementCost returns the cost, in points, of moving a queue entry up or down
+func (p *Pricer) ComputeQueueEntryMovementCost(subscribed bool) int {
+	return p.computeQueueEntryMovementCost(p.currentConditions(), subscribed)
+}
+
+func (p *Pricer) computeQueueEntryMovementCost(c Conditions, subscribed bool) int {
+	cost := int64(QueueEntryMovementCost)
+	if subscribed {
+		cost = SubscriberQueueEntryMovementCost
+	}
+	rulesMultiplier, _ := p.rulesMultiplier(PriceKindQueueEntryMovement, c)
+	cost = cost * rulesMultiplier / 100
+	if cost < 1 {
+		cost = 1
+	}
+	return int(cost)
+}
+
+// Simulation contains the prices that would be charged under hypothetical conditions
+type Simulation struct {
+	EnqueuePricing                   EnqueuePricing
+	CrowdfundedSkipPrice             payment.Amount
+	QueueEntryMovementCost           int
+	SubscriberQueueEntryMovementCost int
+	MatchingRules                    map[PriceKind][]*types.PricingRule
+}
+
+// Simulate computes the prices that would be charged under the specified conditions, for a media with the specified
+// properties, using the current multipliers and pricing rules
+func (p *Pricer) Simulate(c Conditions, mediaDuration time.Duration, unskippable, concealed bool, recentCrowdfundedSkips int) Simulation {
+	if c.EligibleSpectators <= 0 {
+		c.EligibleSpectators = 1
+	}
+	if c.QueueLength < 0 {
+		c.QueueLength = 0
+	}
+
+	enqueueMultiplier, enqueueRules := p.rulesMultiplier(PriceKindEnqueue, c)
+	_, skipRules := p.rulesMultiplier(PriceKindCrowdfundedSkip, c)
+	_, movementRules := p.rulesMultiplier(PriceKindQueueEntryMovement, c)
+	return Simulation{
+		EnqueuePricing:                   p.computeEnqueuePricing(mediaDuration, c.QueueLength, c.EligibleSpectators, unskippable, concealed, enqueueMultiplier),
+		CrowdfundedSkipPrice:             p.computeCrowdfundedSkipPricing(c, recentCrowdfundedSkips),
+		QueueEntryMovementCost:           p.computeQueueEntryMovementCost(c, false),
+		SubscriberQueueEntryMovementCost: p.computeQueueEntryMovementCost(c, true),
+		MatchingRules: map[PriceKind][]*types.PricingRule{
+			PriceKindEnqueue:            enqueueRules,
+			PriceKindCrowdfundedSkip:    skipRules,
+			PriceKindQueueEntryMovement: movementRules,
+		},
+	}
+}
+
+func (p *Pricer) currentConditions() Conditions {
+	currentlyWatching := p.currentlyWatchingEligible()
+	if currentlyWatching <= 0 {
+		currentlyWatching = 1
+	}
+	queueLength := p.mediaQueue.LengthUpToCursor() - 1
+	if queueLength < 0 {
+		queueLength = 0
+	}
+	return Conditions{
+		Time:               time.Now(),
+		QueueLength:        queueLength,
+		EligibleSpectators: currentlyWatching,
+	}
+}
+
 func (p *Pricer) currentlyWatchingEligible() int {
 	if p.eligibleEstimator == nil {
 		return 0
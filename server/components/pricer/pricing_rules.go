@@ -0,0 +1,245 @@
+package pricer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"golang.org/x/exp/slices"
+)
+
+// MinimumPricingRuleMultiplier is the minimum value of the multiplier of a pricing rule
+const MinimumPricingRuleMultiplier = 10
+
+// MaximumPricingRuleMultiplier is the maximum value of the multiplier of a pricing rule
+const MaximumPricingRuleMultiplier = 1000
+
+// ErrInvalidPricingRule is returned when attempting to set a pricing rule with invalid conditions
+var ErrInvalidPricingRule = errors.New("invalid pricing rule")
+
+// PriceKind identifies one of the prices that pricing rules can adjust
+type PriceKind int
+
+const (
+	PriceKindEnqueue PriceKind = iota
+	PriceKindCrowdfundedSkip
+	PriceKindQueueEntryMovement
+)
+
+// Conditions are the circumstances under which prices are computed
+type Conditions struct {
+	Time               time.Time
+	QueueLength        int // not counting the currently playing entry
+	EligibleSpectators int
+}
+
+// PricingRuleMatches returns whether the rule adjusts the specified kind of price under the specified conditions
+func PricingRuleMatches(rule *types.PricingRule, kind PriceKind, c Conditions) bool {
+	switch kind {
+	case PriceKindEnqueue:
+		if !rule.AppliesToEnqueue {
+			return false
+		}
+	case PriceKindCrowdfundedSkip:
+		if !rule.AppliesToCrowdfundedSkip {
+			return false
+		}
+	case PriceKindQueueEntryMovement:
+		if !rule.AppliesToQueueEntryMovement {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if rule.ActiveFrom.Valid && c.Time.Before(rule.ActiveFrom.Time) {
+		return false
+	}
+	if rule.ActiveUntil.Valid && !c.Time.Before(rule.ActiveUntil.Time) {
+		return false
+	}
+
+	t := c.Time.UTC()
+	if rule.Weekdays != 0 && rule.Weekdays&(1<<int(t.Weekday())) == 0 {
+		return false
+	}
+	if rule.StartTimeOfDay != nil && rule.EndTimeOfDay != nil {
+		timeOfDay := types.Duration(t.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)))
+		start, end := *rule.StartTimeOfDay, *rule.EndTimeOfDay
+		if start <= end && (timeOfDay < start || timeOfDay >= end) {
+			return false
+		}
+		if start > end && timeOfDay < start && timeOfDay >= end {
+			return false
+		}
+	}
+
+	if rule.MinQueueLength != nil && c.QueueLength < *rule.MinQueueLength {
+		return false
+	}
+	if rule.MaxQueueLength != nil && c.QueueLength > *rule.MaxQueueLength {
+		return false
+	}
+	if rule.MinSpectators != nil && c.EligibleSpectators < *rule.MinSpectators {
+		return false
+	}
+	if rule.MaxSpectators != nil && c.EligibleSpectators > *rule.MaxSpectators {
+		return false
+	}
+	return true
+}
+
+// rulesMultiplier returns the percentage to apply to the specified kind of price under the specified conditions,
+// along with the rules that contributed to it
+func (p *Pricer) rulesMultiplier(kind PriceKind, c Conditions) (int64, []*types.PricingRule) {
+	p.rulesMutex.RLock()
+	defer p.rulesMutex.RUnlock()
+
+	multiplier := int64(100)
+	matching := []*types.PricingRule{}
+	for _, rule := range p.rules {
+		if PricingRuleMatches(rule, kind, c) {
+			multiplier = multiplier * int64(rule.Multiplier) / 100
+			matching = append(matching, rule)
+		}
+	}
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	return multiplier, matching
+}
+
+// LoadPricingRules loads the pricing rules of the channel this pricer belongs to from the database
+func (p *Pricer) LoadPricingRules(ctxCtx context.Context) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	rules, err := types.GetPricingRules(ctx, p.mediaQueue.ChannelID())
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	p.rulesMutex.Lock()
+	defer p.rulesMutex.Unlock()
+	p.rules = rules
+	return nil
+}
+
+// PricingRules returns the pricing rules of the channel this pricer belongs to
+func (p *Pricer) PricingRules() []*types.PricingRule {
+	p.rulesMutex.RLock()
+	defer p.rulesMutex.RUnlock()
+	return slices.Clone(p.rules)
+}
+
+func validatePricingRule(rule *types.PricingRule) error {
+	if !rule.AppliesToEnqueue && !rule.AppliesToCrowdfundedSkip && !rule.AppliesToQueueEntryMovement {
+		return stacktrace.Propagate(ErrInvalidPricingRule, "pricing rule must apply to at least one price")
+	}
+	if rule.Multiplier < MinimumPricingRuleMultiplier || rule.Multiplier > MaximumPricingRuleMultiplier {
+		return stacktrace.Propagate(ErrMultiplierOutOfBounds, "")
+	}
+	if rule.Weekdays < 0 || rule.Weekdays > 0b1111111 {
+		return stacktrace.Propagate(ErrInvalidPricingRule, "invalid weekdays")
+	}
+	if (rule.StartTimeOfDay == nil) != (rule.EndTimeOfDay == nil) {
+		return stacktrace.Propagate(ErrInvalidPricingRule, "start and end times of day must be set together")
+	}
+	for _, t := range []*types.Duration{rule.StartTimeOfDay, rule.EndTimeOfDay} {
+		if t != nil && (*t < 0 || time.Duration(*t) >= 24*time.Hour) {
+			return stacktrace.Propagate(ErrInvalidPricingRule, "invalid time of day")
+		}
+	}
+	if rule.MinQueueLength != nil && rule.MaxQueueLength != nil && *rule.MinQueueLength > *rule.MaxQueueLength {
+		return stacktrace.Propagate(ErrInvalidPricingRule, "invalid queue length range")
+	}
+	if rule.MinSpectators != nil && rule.MaxSpectators != nil && *rule.MinSpectators > *rule.MaxSpectators {
+		return stacktrace.Propagate(ErrInvalidPricingRule, "invalid spectator count range")
+	}
+	if rule.ActiveFrom.Valid && rule.ActiveUntil.Valid && !rule.ActiveFrom.Time.Before(rule.ActiveUntil.Time) {
+		return stacktrace.Propagate(ErrInvalidPricingRule, "invalid active period")
+	}
+	return nil
+}
+
+// SetPricingRule creates or updates a pricing rule of the channel this pricer belongs to
+func (p *Pricer) SetPricingRule(ctxCtx context.Context, rule *types.PricingRule) error {
+	rule.ChannelID = p.mediaQueue.ChannelID()
+	err := validatePricingRule(rule)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	p.rulesMutex.Lock()
+	defer p.rulesMutex.Unlock()
+
+	existing, err := types.GetPricingRuleWithID(ctx, rule.ID)
+	if err == nil && existing.ChannelID != rule.ChannelID {
+		return stacktrace.NewError("pricing rule belongs to a different channel")
+	} else if err != nil && !errors.Is(err, types.ErrPricingRuleNotFound) {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = rule.Update(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	p.rules = slices.DeleteFunc(p.rules, func(r *types.PricingRule) bool {
+		return r.ID == rule.ID
+	})
+	p.rules = append(p.rules, rule)
+	return nil
+}
+
+// RemovePricingRule removes the pricing rule with the given ID
+func (p *Pricer) RemovePricingRule(ctxCtx context.Context, ruleID string) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	p.rulesMutex.Lock()
+	defer p.rulesMutex.Unlock()
+
+	rule, err := types.GetPricingRuleWithID(ctx, ruleID)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if rule.ChannelID != p.mediaQueue.ChannelID() {
+		return stacktrace.Propagate(types.ErrPricingRuleNotFound, "")
+	}
+
+	err = rule.Delete(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	p.rules = slices.DeleteFunc(p.rules, func(r *types.PricingRule) bool {
+		return r.ID == ruleID
+	})
+	return nil
+}
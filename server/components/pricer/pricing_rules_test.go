@@ -0,0 +1,67 @@
+package pricer_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPricingRuleMatches(t *testing.T) {
+	start := types.Duration(22 * time.Hour)
+	end := types.Duration(2 * time.Hour)
+	minQueueLength := 5
+	maxSpectators := 20
+	rule := &types.PricingRule{
+		AppliesToEnqueue: true,
+		Multiplier:       80,
+		Weekdays:         1<<time.Saturday | 1<<time.Sunday,
+		StartTimeOfDay:   &start,
+		EndTimeOfDay:     &end,
+		MinQueueLength:   &minQueueLength,
+		MaxSpectators:    &maxSpectators,
+	}
+
+	// Saturday 2023-01-07, 23:00 UTC
+	conditions := pricer.Conditions{
+		Time:               time.Date(2023, time.January, 7, 23, 0, 0, 0, time.UTC),
+		QueueLength:        5,
+		EligibleSpectators: 10,
+	}
+	require.True(t, pricer.PricingRuleMatches(rule, pricer.PriceKindEnqueue, conditions))
+	require.False(t, pricer.PricingRuleMatches(rule, pricer.PriceKindCrowdfundedSkip, conditions))
+	require.False(t, pricer.PricingRuleMatches(rule, pricer.PriceKindQueueEntryMovement, conditions))
+
+	// the time of day window crosses midnight
+	c := conditions
+	c.Time = time.Date(2023, time.January, 8, 1, 30, 0, 0, time.UTC)
+	require.True(t, pricer.PricingRuleMatches(rule, pricer.PriceKindEnqueue, c))
+	c.Time = time.Date(2023, time.January, 8, 2, 0, 0, 0, time.UTC)
+	require.False(t, pricer.PricingRuleMatches(rule, pricer.PriceKindEnqueue, c))
+	c.Time = time.Date(2023, time.January, 7, 21, 59, 0, 0, time.UTC)
+	require.False(t, pricer.PricingRuleMatches(rule, pricer.PriceKindEnqueue, c))
+
+	// Monday
+	c.Time = time.Date(2023, time.January, 9, 23, 0, 0, 0, time.UTC)
+	require.False(t, pricer.PricingRuleMatches(rule, pricer.PriceKindEnqueue, c))
+
+	c = conditions
+	c.QueueLength = 4
+	require.False(t, pricer.PricingRuleMatches(rule, pricer.PriceKindEnqueue, c))
+
+	c = conditions
+	c.EligibleSpectators = 21
+	require.False(t, pricer.PricingRuleMatches(rule, pricer.PriceKindEnqueue, c))
+
+	rule.ActiveUntil = sql.NullTime{Time: conditions.Time, Valid: true}
+	require.False(t, pricer.PricingRuleMatches(rule, pricer.PriceKindEnqueue, conditions))
+	rule.ActiveUntil = sql.NullTime{}
+	rule.ActiveFrom = sql.NullTime{Time: conditions.Time.Add(time.Second), Valid: true}
+	require.False(t, pricer.PricingRuleMatches(rule, pricer.PriceKindEnqueue, conditions))
+	rule.ActiveFrom = sql.NullTime{Time: conditions.Time, Valid: true}
+	require.True(t, pricer.PricingRuleMatches(rule, pricer.PriceKindEnqueue, conditions))
+}
@@ -0,0 +1,377 @@
+package refundmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/DisgoOrg/disgohook/api"
+	"github.com/hectorchu/gonano/rpc"
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/buildconfig"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/node"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/event"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// Manager keeps the refund ledger and sends approved refunds from the collector account
+type Manager struct {
+	log                   *log.Logger
+	collectorAccountQueue chan func(node.Account)
+	rpcClient             node.RPC
+	paymentAccountPool    *payment.PaymentAccountPool
+	modLogWebhook         api.WebhookClient
+
+	// serializes status transitions, so that the same refund can't be sent twice
+	transitionMutex sync.Mutex
+}
+
+// Origin identifies what a refund refers to. All fields are optional
+type Origin struct {
+	TicketID         string
+	QueueEntryID     string
+	PaymentBlockHash string
+}
+
+// New returns a new initialized Manager
+func New(log *log.Logger,
+	collectorAccountQueue chan func(node.Account),
+	rpcClient node.RPC,
+	paymentAccountPool *payment.PaymentAccountPool,
+	modLogWebhook api.WebhookClient) *Manager {
+	return &Manager{
+		log:                   log,
+		collectorAccountQueue: collectorAccountQueue,
+		rpcClient:             rpcClient,
+		paymentAccountPool:    paymentAccountPool,
+		modLogWebhook:         modLogWebhook,
+	}
+}
+
+// Worker proposes refunds for the late payments detected by the payment account pool
+func (m *Manager) Worker(ctx context.Context) error {
+	onLatePaymentDetected, latePaymentDetectedU := m.paymentAccountPool.LatePaymentDetected().Subscribe(event.BufferAll)
+	defer latePaymentDetectedU()
+
+	for {
+		select {
+		case args := <-onLatePaymentDetected:
+			_, err := m.Propose(ctx, args.From, args.Amount, types.RefundReasonLatePayment,
+				fmt.Sprintf("Payment received by %s after it was no longer monitored", args.PaymentAccount),
+				Origin{PaymentBlockHash: args.BlockHash})
+			if err != nil {
+				// the payment will be detected again the next time its account is requested from the pool
+				m.log.Printf("Failed to propose refund of late payment %s: %v", args.BlockHash, err)
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func (m *Manager) newRefund(address string, amount payment.Amount, reason types.RefundReason, status types.RefundStatus,
+	comment string, origin Origin) *types.Refund {
+	now := time.Now()
+	return &types.Refund{
+		ID:               uuid.NewV4().String(),
+		Address:          address,
+		Amount:           amount.Decimal(),
+		Reason:           reason,
+		Status:           status,
+		Comment:          comment,
+		TicketID:         optionalString(origin.TicketID),
+		QueueEntryID:     optionalString(origin.QueueEntryID),
+		PaymentBlockHash: optionalString(origin.PaymentBlockHash),
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+}
+
+// Propose records a refund that is only sent once approved by a moderator.
+// If a refund already exists for the payment block of origin, that refund is returned instead
+func (m *Manager) Propose(ctxCtx context.Context, address string, amount payment.Amount, reason types.RefundReason,
+	comment string, origin Origin) (*types.Refund, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	if origin.PaymentBlockHash != "" {
+		existing, found, err := types.GetRefundOfPaymentBlock(ctx, origin.PaymentBlockHash)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		if found {
+			return existing, nil
+		}
+	}
+
+	refund := m.newRefund(address, amount, reason, types.RefundStatusProposed, comment, origin)
+	err = refund.Update(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	m.log.Printf("Proposed %s refund %s of %v to %s", reason, refund.ID, amount, address)
+	return refund, stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// Issue records a refund that does not need review and sends it, returning once it has been sent or failed
+func (m *Manager) Issue(ctx context.Context, address string, amount payment.Amount, reason types.RefundReason,
+	comment string, origin Origin) (*types.Refund, error) {
+	m.transitionMutex.Lock()
+	defer m.transitionMutex.Unlock()
+
+	refund := m.newRefund(address, amount, reason, types.RefundStatusApproved, comment, origin)
+	err := m.update(ctx, refund)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = m.send(ctx, refund)
+	return refund, stacktrace.Propagate(err, "")
+}
+
+// RecordRevert records a refund that was made by reverting the payments received by a payment account, which happens
+// outside of the ledger. revertErr is the result of the revert
+func (m *Manager) RecordRevert(ctx context.Context, address string, amount payment.Amount, comment string,
+	origin Origin, revertErr error) (*types.Refund, error) {
+	refund := m.newRefund(address, amount, types.RefundReasonFailedTicket, types.RefundStatusSent, comment, origin)
+	if revertErr != nil {
+		refund.Status = types.RefundStatusFailed
+		failureReason := revertErr.Error()
+		refund.FailureReason = &failureReason
+	}
+	err := m.update(ctx, refund)
+	return refund, stacktrace.Propagate(err, "")
+}
+
+// Approve approves a proposed refund and sends it, returning once it has been sent or failed
+func (m *Manager) Approve(ctx context.Context, refundID string, moderator auth.User) (*types.Refund, error) {
+	refund, err := m.transition(ctx, refundID, types.RefundStatusProposed, moderator)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer m.transitionMutex.Unlock()
+
+	err = m.send(ctx, refund)
+	return refund, stacktrace.Propagate(err, "")
+}
+
+// Retry sends a failed refund again, returning once it has been sent or failed.
+// Refunds are always retried from the collector account, even if they were originally attempted through a revert.
+// If the failed attempt went through after all, the refund is marked as sent without being sent again
+func (m *Manager) Retry(ctx context.Context, refundID string, moderator auth.User) (*types.Refund, error) {
+	refund, err := m.transition(ctx, refundID, types.RefundStatusFailed, moderator)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer m.transitionMutex.Unlock()
+
+	err = m.send(ctx, refund)
+	return refund, stacktrace.Propagate(err, "")
+}
+
+// transition moves the refund from the expected status to the approved status.
+// On success, the transition mutex is left locked
+func (m *Manager) transition(ctxCtx context.Context, refundID string, expectedStatus types.RefundStatus, moderator auth.User) (*types.Refund, error) {
+	m.transitionMutex.Lock()
+
+	refund, err := func() (*types.Refund, error) {
+		ctx, err := transaction.Begin(ctxCtx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		defer ctx.Rollback()
+
+		refunds, err := types.GetRefundsWithIDs(ctx, []string{refundID})
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		refund, ok := refunds[refundID]
+		if !ok {
+			return nil, stacktrace.NewError("refund not found")
+		}
+		if refund.Status != expectedStatus {
+			return nil, stacktrace.NewError("refund is not in %s status", expectedStatus)
+		}
+
+		reviewedBy := moderator.Address()
+		refund.Status = types.RefundStatusApproved
+		refund.ReviewedBy = &reviewedBy
+		refund.FailureReason = nil
+		refund.UpdatedAt = time.Now()
+		err = refund.Update(ctx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		return refund, stacktrace.Propagate(ctx.Commit(), "")
+	}()
+	if err != nil {
+		m.transitionMutex.Unlock()
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return refund, nil
+}
+
+func (m *Manager) update(ctxCtx context.Context, refund *types.Refund) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	refund.UpdatedAt = time.Now()
+	err = refund.Update(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// send sends an approved refund from the collector account and records the outcome.
+// Must be called with the transition mutex locked
+func (m *Manager) send(ctx context.Context, refund *types.Refund) error {
+	if !buildconfig.AllowWithdrawalsAndRefunds {
+		// the refund remains approved
+		return nil
+	}
+
+	done := make(chan struct{})
+	var blockHash rpc.BlockHash
+	var sendErr error
+	var collectorAccountAddress string
+	m.collectorAccountQueue <- func(collectorAccount node.Account) {
+		defer close(done)
+		collectorAccountAddress = collectorAccount.Address()
+		blockHash, sendErr = m.sendFromCollectorAccount(ctx, collectorAccount, refund)
+	}
+	<-done
+
+	if sendErr != nil {
+		m.log.Printf("Error sending refund %s of %v to %s: %v", refund.ID, refund.Amount, refund.Address, sendErr)
+		refund.Status = types.RefundStatusFailed
+		failureReason := sendErr.Error()
+		refund.FailureReason = &failureReason
+		if m.modLogWebhook != nil {
+			_, err := m.modLogWebhook.SendContent(fmt.Sprintf(
+				"Refund `%s` of %v raw to %s failed and can be retried by an admin", refund.ID, refund.Amount, refund.Address))
+			if err != nil {
+				m.log.Println("Failed to send mod log webhook:", err)
+			}
+		}
+	} else {
+		m.log.Printf("Sent refund %s of %v to %s, block hash %s", refund.ID, refund.Amount, refund.Address, blockHash.String())
+		refund.Status = types.RefundStatusSent
+		txHash := blockHash.String()
+		refund.TxHash = &txHash
+	}
+
+	return stacktrace.Propagate(m.updateAfterSend(ctx, refund, collectorAccountAddress), "")
+}
+
+// sendFromCollectorAccount sends the refund from the collector account, unless a previous attempt to send it went
+// through despite having failed, in which case the hash of the block of that attempt is returned instead.
+// Must be called from the collector account queue
+func (m *Manager) sendFromCollectorAccount(ctx context.Context, collectorAccount node.Account, refund *types.Refund) (rpc.BlockHash, error) {
+	if refund.SendAttemptFrontier != nil {
+		blockHash, found, err := m.findPreviousSend(ctx, collectorAccount.Address(), refund)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		if found {
+			m.log.Printf("Previous attempt to send refund %s went through in block %s", refund.ID, blockHash.String())
+			return blockHash, nil
+		}
+	}
+
+	// errors from Send don't mean the block wasn't published, so we remember where the chain of the collector account
+	// was before this attempt, for a retry to be able to find out whether this attempt went through
+	info, err := m.rpcClient.AccountInfo(collectorAccount.Address())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	frontier := info.Frontier.String()
+	refund.SendAttemptFrontier = &frontier
+	err = m.update(ctx, refund)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	blockHash, err := collectorAccount.Send(refund.Address, refund.Amount.BigInt())
+	return blockHash, stacktrace.Propagate(err, "")
+}
+
+// findPreviousSend looks for a send of the refund among the blocks added to the chain of the collector account since
+// the latest attempt to send it. Sends which the journal already accounts for, such as other refunds of the same
+// amount to the same address, are ignored
+func (m *Manager) findPreviousSend(ctxCtx context.Context, collectorAccountAddress string, refund *types.Refund) (rpc.BlockHash, bool, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	var head rpc.BlockHash
+	for {
+		history, previous, err := m.rpcClient.AccountHistory(collectorAccountAddress, 100, head)
+		if err != nil {
+			return nil, false, stacktrace.Propagate(err, "")
+		}
+		for _, block := range history {
+			if block.Hash.String() == *refund.SendAttemptFrontier {
+				return nil, false, nil
+			}
+			if block.Type != "send" || block.Account != refund.Address || block.Amount.Cmp(refund.Amount.BigInt()) != 0 {
+				continue
+			}
+			accountedFor, err := types.JournalHasEntryWithReference(ctx, block.Hash.String())
+			if err != nil {
+				return nil, false, stacktrace.Propagate(err, "")
+			}
+			if !accountedFor {
+				return block.Hash, true, nil
+			}
+		}
+		if len(previous) == 0 {
+			return nil, false, nil
+		}
+		head = previous
+	}
+}
+
+func (m *Manager) updateAfterSend(ctxCtx context.Context, refund *types.Refund, collectorAccountAddress string) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	if refund.Status == types.RefundStatusSent {
+		err = types.RecordJournalEntry(ctx, fmt.Sprintf("Refund %s to %s sent", refund.ID, refund.Address), *refund.TxHash,
+			types.JournalTransfer(types.JournalAccountOnChain(collectorAccountAddress), types.JournalAccountEquity, refund.Amount)...)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+
+	err = m.update(ctx, refund)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
@@ -0,0 +1,214 @@
+package refundmanager_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"log"
+	"math/big"
+	"testing"
+
+	"github.com/hectorchu/gonano/rpc"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/node"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/refundmanager"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction/transactiontest"
+
+	"github.com/stretchr/testify/require"
+)
+
+// refundDatabase is the part of the database touched by refunds
+type refundDatabase struct {
+	refunds           map[string]map[string]driver.Value
+	journalReferences []string
+}
+
+func (d *refundDatabase) clone() *refundDatabase {
+	c := &refundDatabase{
+		refunds:           make(map[string]map[string]driver.Value),
+		journalReferences: append([]string{}, d.journalReferences...),
+	}
+	for k, v := range d.refunds {
+		c.refunds[k] = v
+	}
+	return c
+}
+
+func newRefundDatabase() *transactiontest.FakeDatabase[*refundDatabase] {
+	type statement = transactiontest.FakeStatement
+	type result = transactiontest.FakeResult
+
+	db := transactiontest.NewFakeDatabase((&refundDatabase{}).clone(), (*refundDatabase).clone)
+	db.Handle(`^INSERT INTO refund `, func(s *refundDatabase, stmt *statement) (*result, error) {
+		for _, row := range stmt.InsertedRows() {
+			s.refunds[row["id"].(string)] = row
+		}
+		return nil, nil
+	})
+	db.Handle(`^SELECT .* FROM refund WHERE refund.id IN \(`, func(s *refundDatabase, stmt *statement) (*result, error) {
+		r := &result{}
+		for _, id := range stmt.Args {
+			if refund, present := s.refunds[id.(string)]; present {
+				r.Rows = append(r.Rows, stmt.Row(refund))
+			}
+		}
+		return r, nil
+	})
+	db.Handle(`^INSERT INTO journal_entry `, func(s *refundDatabase, stmt *statement) (*result, error) {
+		for _, row := range stmt.InsertedRows() {
+			if reference, ok := row["reference"].(string); ok {
+				s.journalReferences = append(s.journalReferences, reference)
+			}
+		}
+		return nil, nil
+	})
+	db.Handle(`^INSERT INTO journal_line `, func(s *refundDatabase, stmt *statement) (*result, error) {
+		return nil, nil
+	})
+	db.Handle(`^SELECT EXISTS \(SELECT 1 FROM journal_entry WHERE reference = \$1\)$`, func(s *refundDatabase, stmt *statement) (*result, error) {
+		for _, reference := range s.journalReferences {
+			if reference == stmt.Args[0] {
+				return &result{Rows: [][]any{{true}}}, nil
+			}
+		}
+		return &result{Rows: [][]any{{false}}}, nil
+	})
+	return db
+}
+
+// unreliableAccount is an account whose sends report an error even though their blocks were published
+type unreliableAccount struct {
+	node.Account
+	failAfterSending bool
+}
+
+func (a *unreliableAccount) Send(account string, amount *big.Int) (rpc.BlockHash, error) {
+	hash, err := a.Account.Send(account, amount)
+	if err == nil && a.failAfterSending {
+		return nil, errors.New("connection reset by peer")
+	}
+	return hash, err
+}
+
+type refundTestEnvironment struct {
+	ctx       context.Context
+	db        *transactiontest.FakeDatabase[*refundDatabase]
+	fakeNode  *node.FakeNode
+	collector *unreliableAccount
+	funder    string
+	recipient string
+	manager   *refundmanager.Manager
+}
+
+func newRefundTestEnvironment(t *testing.T) *refundTestEnvironment {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	fakeNode := node.NewFakeNode()
+	w, err := fakeNode.NewWallet(make([]byte, 32))
+	require.NoError(t, err)
+	collectorIndex := uint32(0)
+	collectorAccount, err := w.NewAccount(&collectorIndex)
+	require.NoError(t, err)
+	recipientIndex := uint32(1000)
+	recipient, err := w.NewAccount(&recipientIndex)
+	require.NoError(t, err)
+	// the funder is not simulated by the fake node, so any address will do
+	funderIndex := uint32(1001)
+	funder, err := w.NewAccount(&funderIndex)
+	require.NoError(t, err)
+
+	collector := &unreliableAccount{Account: collectorAccount}
+	collectorAccountQueue := make(chan func(node.Account))
+	go func() {
+		for {
+			select {
+			case f := <-collectorAccountQueue:
+				f(collector)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	db := newRefundDatabase()
+	return &refundTestEnvironment{
+		ctx:       db.Context(ctx),
+		db:        db,
+		fakeNode:  fakeNode,
+		collector: collector,
+		funder:    funder.Address(),
+		recipient: recipient.Address(),
+		manager:   refundmanager.New(log.New(io.Discard, "", 0), collectorAccountQueue, w.RPC(), nil, nil),
+	}
+}
+
+func TestRetryDoesNotResendRefundThatWentThrough(t *testing.T) {
+	env := newRefundTestEnvironment(t)
+	_, err := env.fakeNode.Fund(env.funder, env.collector.Address(), big.NewInt(10000))
+	require.NoError(t, err)
+	require.NoError(t, env.collector.ReceivePendings(big.NewInt(0)))
+
+	// a previous refund of the same amount to the same address must not be mistaken for the failed attempt
+	previous, err := env.manager.Issue(env.ctx, env.recipient, payment.NewAmount(big.NewInt(1000)),
+		types.RefundReasonGoodwill, "previous refund", refundmanager.Origin{})
+	require.NoError(t, err)
+	require.Equal(t, types.RefundStatusSent, previous.Status)
+
+	env.collector.failAfterSending = true
+	refund, err := env.manager.Issue(env.ctx, env.recipient, payment.NewAmount(big.NewInt(1000)),
+		types.RefundReasonGoodwill, "refund", refundmanager.Origin{})
+	require.NoError(t, err)
+	require.Equal(t, types.RefundStatusFailed, refund.Status)
+	require.EqualValues(t, 2000, env.fakeNode.Receivable(env.recipient).Int64())
+
+	env.collector.failAfterSending = false
+	refund, err = env.manager.Retry(env.ctx, refund.ID, auth.NewAddressOnlyUser("ban_1moderator"))
+	require.NoError(t, err)
+	require.Equal(t, types.RefundStatusSent, refund.Status)
+	require.NotNil(t, refund.TxHash)
+	require.NotEqual(t, *previous.TxHash, *refund.TxHash)
+
+	// the refund was only paid once
+	require.EqualValues(t, 2000, env.fakeNode.Receivable(env.recipient).Int64())
+	require.EqualValues(t, 8000, env.fakeNode.AccountBalance(env.collector.Address()).Int64())
+
+	state := env.db.State()
+	require.Equal(t, string(types.RefundStatusSent), state.refunds[refund.ID]["status"])
+	require.Equal(t, *refund.TxHash, state.refunds[refund.ID]["tx_hash"])
+	require.Contains(t, state.journalReferences, *refund.TxHash)
+}
+
+func TestRetryResendsRefundThatFailed(t *testing.T) {
+	env := newRefundTestEnvironment(t)
+	_, err := env.fakeNode.Fund(env.funder, env.collector.Address(), big.NewInt(500))
+	require.NoError(t, err)
+	require.NoError(t, env.collector.ReceivePendings(big.NewInt(0)))
+
+	// the collector account can't afford the refund yet
+	refund, err := env.manager.Issue(env.ctx, env.recipient, payment.NewAmount(big.NewInt(1000)),
+		types.RefundReasonGoodwill, "refund", refundmanager.Origin{})
+	require.NoError(t, err)
+	require.Equal(t, types.RefundStatusFailed, refund.Status)
+	require.NotNil(t, refund.FailureReason)
+	require.Zero(t, env.fakeNode.Receivable(env.recipient).Int64())
+
+	_, err = env.fakeNode.Fund(env.funder, env.collector.Address(), big.NewInt(500))
+	require.NoError(t, err)
+	require.NoError(t, env.collector.ReceivePendings(big.NewInt(0)))
+
+	refund, err = env.manager.Retry(env.ctx, refund.ID, auth.NewAddressOnlyUser("ban_1moderator"))
+	require.NoError(t, err)
+	require.Equal(t, types.RefundStatusSent, refund.Status)
+	require.Nil(t, refund.FailureReason)
+	require.EqualValues(t, 1000, env.fakeNode.Receivable(env.recipient).Int64())
+	require.Zero(t, env.fakeNode.AccountBalance(env.collector.Address()).Int64())
+
+	// only failed refunds can be retried
+	_, err = env.manager.Retry(env.ctx, refund.ID, auth.NewAddressOnlyUser("ban_1moderator"))
+	require.Error(t, err)
+	require.EqualValues(t, 1000, env.fakeNode.Receivable(env.recipient).Int64())
+}
@@ -216,6 +216,7 @@ func (r *Handler) SolveActivityChallenge(ctxCtx context.Context, challenge strin
 	}
 
 	if legitimateAsOfThisChallenge {
+		spectator.lastChallengeSolvedAt = now
 		r.log.Println("Spectator", spectator.user.Address(), spectator.remoteAddress,
 			"solved", strings.Join(utils.CastStringLikeSlice[ActivityChallengeType, string](spectator.activityChallenge.Types), ", "),
 			"activity challenge after", timeUntilChallengeResponse)
@@ -0,0 +1,202 @@
+package rewards
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	movingaverage "github.com/RobinUS2/golang-moving-average"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/components/mediaqueue"
+	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/server/components/skipmanager"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/event"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"gopkg.in/alexcesaro/statsd.v2"
+)
+
+// Channel contains the components of a broadcast channel that the rewards handler distributes rewards for
+type Channel struct {
+	ID          string
+	StatsClient *statsd.Client
+	MediaQueue  *mediaqueue.MediaQueue
+	SkipManager *skipmanager.Manager
+}
+
+type channelState struct {
+	Channel
+	lastMedia                 media.QueueEntry
+	eligibleMovingAverage     *movingaverage.MovingAverage
+	distributionStrategy      DistributionStrategy
+	distributionStrategyMutex sync.RWMutex
+}
+
+func (r *Handler) channelWorker(ctx context.Context, channel *channelState) error {
+	onEntryAdded, entryAddedU := channel.MediaQueue.EntryAdded().Subscribe(event.BufferFirst)
+	defer entryAddedU()
+
+	onMediaChanged, mediaChangedU := channel.MediaQueue.MediaChanged().Subscribe(event.BufferAll)
+	defer mediaChangedU()
+
+	onEntryRemoved, entryRemovedU := channel.MediaQueue.EntryRemoved().Subscribe(event.BufferAll)
+	defer entryRemovedU()
+
+	onPauseStateChanged, pauseStateChangedU := channel.MediaQueue.PauseStateChanged().Subscribe(event.BufferAll)
+	defer pauseStateChangedU()
+
+	// the rewards handler might be starting at a time when there are things already playing,
+	// in that case we need to update lastMedia
+	entries := channel.MediaQueue.Entries()
+	if len(entries) > 0 {
+		channel.lastMedia = entries[0]
+	}
+	for {
+		select {
+		case v := <-onMediaChanged:
+			r.onMediaChanged(ctx, channel, v)
+		case args := <-onEntryRemoved:
+			if args.Index != 0 {
+				err := r.onMediaRemoved(ctx, args.Entry)
+				if err != nil {
+					return stacktrace.Propagate(err, "")
+				}
+			}
+		case pauseState := <-onPauseStateChanged:
+			if !pauseState.Paused {
+				r.discountPausedWatchingTime(channel.ID, pauseState.PausedAt, time.Now())
+			}
+		case entryAddedArgs := <-onEntryAdded:
+			err := r.handleQueueEntryAdded(ctx, entryAddedArgs.Entry)
+			if err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (r *Handler) onMediaChanged(ctx context.Context, channel *channelState, newMedia media.QueueEntry) {
+	if newMedia == channel.lastMedia {
+		return
+	}
+	defer func() { channel.lastMedia = newMedia }()
+	if channel.lastMedia == nil {
+		return
+	}
+	lastMedia := channel.lastMedia
+
+	go func() {
+		err := r.rewardUsers(ctx, channel, lastMedia)
+		if err != nil {
+			r.log.Println("Error rewarding users:", err)
+		}
+	}()
+}
+
+// DistributionStrategy returns the strategy used to split rewards among the spectators of the specified channel
+func (r *Handler) DistributionStrategy(channelID string) (DistributionStrategy, error) {
+	channel, ok := r.channels[channelID]
+	if !ok {
+		return nil, stacktrace.NewError("unknown broadcast channel %s", channelID)
+	}
+	channel.distributionStrategyMutex.RLock()
+	defer channel.distributionStrategyMutex.RUnlock()
+	return channel.distributionStrategy, nil
+}
+
+// SetDistributionStrategy sets the strategy used to split rewards among the spectators of the specified channel.
+// The new strategy applies starting with the rewards for the media that is currently playing.
+// The strategy is persisted by name, strategies other than the built-in ones revert to the equal split on restart
+func (r *Handler) SetDistributionStrategy(ctxCtx context.Context, channelID string, strategy DistributionStrategy) error {
+	channel, ok := r.channels[channelID]
+	if !ok {
+		return stacktrace.NewError("unknown broadcast channel %s", channelID)
+	}
+	channel.distributionStrategyMutex.Lock()
+	defer channel.distributionStrategyMutex.Unlock()
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	settings := &types.RewardDistributionSettings{
+		ChannelID:            channelID,
+		DistributionStrategy: strategy.Name(),
+		UpdatedAt:            time.Now(),
+	}
+	err = settings.Update(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	channel.distributionStrategy = strategy
+	return nil
+}
+
+// loadDistributionStrategies restores the persisted distribution strategy of each channel
+func (r *Handler) loadDistributionStrategies(ctxCtx context.Context) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	for channelID, channel := range r.channels {
+		settings, err := types.GetRewardDistributionSettings(ctx, channelID)
+		if err != nil {
+			if errors.Is(err, types.ErrRewardDistributionSettingsNotFound) {
+				continue
+			}
+			return stacktrace.Propagate(err, "")
+		}
+		channel.distributionStrategy = distributionStrategyFromPersisted(settings.DistributionStrategy)
+	}
+	return nil
+}
+
+type channelEligibleSpectatorsEstimator struct {
+	r         *Handler
+	channelID string
+}
+
+func (e *channelEligibleSpectatorsEstimator) EstimateEligibleSpectators() (int, bool) {
+	return e.r.EstimateEligibleSpectatorsOnChannel(e.channelID)
+}
+
+// ChannelEligibleSpectatorsEstimator returns an estimator of the spectators eligible for rewards on the specified
+// channel, for use by the pricer of that channel
+func (r *Handler) ChannelEligibleSpectatorsEstimator(channelID string) pricer.EligibleSpectatorsEstimator {
+	return &channelEligibleSpectatorsEstimator{
+		r:         r,
+		channelID: channelID,
+	}
+}
+
+// discountPausedWatchingTime excludes the time during which the broadcast of a channel was paused from the watching
+// time of the spectators of that channel, so that they don't become eligible for rewards just by waiting out a pause
+func (r *Handler) discountPausedWatchingTime(channelID string, pausedAt, resumedAt time.Time) {
+	r.spectatorsMutex.Lock()
+	defer r.spectatorsMutex.Unlock()
+
+	for _, spectator := range r.spectatorsByRewardAddress {
+		if spectator.channelID != channelID {
+			continue
+		}
+		pausedSince := pausedAt
+		if spectator.startedWatching.After(pausedSince) {
+			pausedSince = spectator.startedWatching
+		}
+		spectator.pausedWatchingTime += resumedAt.Sub(pausedSince)
+	}
+}
@@ -3,19 +3,21 @@ package rewards
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"math/big"
 	"math/rand"
 	"sync"
 	"time"
 
 	"github.com/hectorchu/gonano/rpc"
-	"github.com/hectorchu/gonano/wallet"
 	"github.com/palantir/stacktrace"
 	uuid "github.com/satori/go.uuid"
-	"github.com/tnyim/jungletv/buildconfig"
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/server/components/node"
 	"github.com/tnyim/jungletv/server/components/notificationmanager/notifications"
 	"github.com/tnyim/jungletv/server/components/payment"
 	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/server/components/refundmanager"
 	"github.com/tnyim/jungletv/server/media"
 	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils"
@@ -23,14 +25,14 @@ import (
 	"golang.org/x/exp/maps"
 )
 
-func (r *Handler) rewardUsers(ctx context.Context, media media.QueueEntry) error {
+func (r *Handler) rewardUsers(ctx context.Context, channel *channelState, media media.QueueEntry) error {
 	defer func() {
 		err := r.withdrawalHandler.AutoWithdrawBalances(ctx)
 		if err != nil {
 			r.log.Println(stacktrace.Propagate(err, ""))
 		}
 	}()
-	r.log.Printf("Rewarding users for \"%s\"", media.MediaInfo().Title())
+	r.log.Printf("Rewarding users on channel %s for \"%s\"", channel.ID, media.MediaInfo().Title())
 
 	requestedByValidUser := media.RequestedBy() != nil && !media.RequestedBy().IsUnknown() && !media.RequestedBy().IsFromAlienChain()
 
@@ -41,20 +43,28 @@ func (r *Handler) rewardUsers(ctx context.Context, media media.QueueEntry) error
 		requestedBy = &address
 	}
 
-	skipBudget, rainBudget, rainedByRequester, err := r.skipManager.EmptySkipAndRainAccounts(ctx, media.PerformanceID(), requestedBy)
+	skipBudget, rainBudget, rainedByRequester, err := channel.SkipManager.EmptySkipAndRainAccounts(ctx, media.PerformanceID(), requestedBy)
 	if err != nil {
 		return stacktrace.Propagate(err, "")
 	}
 
 	rewardBudget := payment.NewAmount(mediaCostBudget.Int, skipBudget.Int)
 
-	r.receiveCollectorPending(payment.NewAmount(rewardBudget.Int, rainBudget.Int))
+	// the budget includes the value of payments received natively in other currencies, which must reach the collector
+	// account before rewards are credited
+	err = r.paymentAccountPool.ConvertNativeCurrencyFunds(ctx)
+	if err != nil {
+		r.log.Printf("Error converting native currency funds: %v", err)
+	}
+
+	r.receiveCollectorPending(ctx, payment.NewAmount(rewardBudget.Int, rainBudget.Int))
 
 	r.spectatorsMutex.RLock()
 	defer r.spectatorsMutex.RUnlock()
 
 	requesterReward := payment.NewAmount()
 	requesterSpectator, requesterIsSpectator := r.spectatorsByRewardAddress[media.RequestedBy().Address()]
+	requesterIsSpectator = requesterIsSpectator && requesterSpectator.channelID == channel.ID
 	if requestedByValidUser && requesterIsSpectator && rainBudget.Cmp(big.NewInt(0)) > 0 {
 		banned, err := r.moderationStore.LoadPaymentAddressBannedFromRewards(ctx, requesterSpectator.user.Address())
 		if err != nil {
@@ -82,9 +92,16 @@ func (r *Handler) rewardUsers(ctx context.Context, media media.QueueEntry) error
 	}
 	rewardBudget.Add(rewardBudget.Int, rainBudget.Int)
 
-	eligible := r.getEligibleSpectators(ctx, media.RequestedBy().Address(), media.PlayedFor())
-	go r.statsClient.Gauge("eligible", len(eligible))
-	r.eligibleMovingAverage.Add(float64(len(eligible)))
+	// spectators don't accrue watching time while the broadcast is paused
+	eligibilityTime := time.Now()
+	if pauseState := channel.MediaQueue.PauseState(); pauseState.Paused {
+		eligibilityTime = pauseState.PausedAt
+	}
+	eligible := r.getEligibleSpectators(ctx, channel.ID, media.RequestedBy().Address(), media.PlayedFor(), eligibilityTime)
+	go channel.StatsClient.Gauge("eligible", len(eligible))
+	channel.eligibleMovingAverage.Add(float64(len(eligible)))
+
+	r.distributeScheduledRainsOverMedia(ctx, channel.ID, media.PerformanceID(), eligible)
 
 	if rewardBudget.Cmp(big.NewInt(0)) == 0 {
 		r.log.Println("Request cost was 0 and additional budget is 0, nothing to reward")
@@ -94,30 +111,45 @@ func (r *Handler) rewardUsers(ctx context.Context, media media.QueueEntry) error
 	if len(eligible) == 0 {
 		if requestedByValidUser && media.RequestCost().Cmp(big.NewInt(0)) > 0 {
 			// reimburse who added to queue
-			go r.reimburseRequester(ctx, media.RequestedBy().Address(), mediaCostBudget)
+			go r.reimburseRequester(ctx, media.RequestedBy().Address(), mediaCostBudget,
+				types.RefundReasonNoEligibleSpectators, "No spectators were eligible for rewards", media)
 		}
 		return nil
 	}
 
-	amountForEach := ComputeReward(rewardBudget, len(eligible))
+	channel.distributionStrategyMutex.RLock()
+	strategy := channel.distributionStrategy
+	channel.distributionStrategyMutex.RUnlock()
+
+	recipients := r.getDistributionRecipients(eligible, media.PlayedFor(), eligibilityTime)
+	amounts := strategy.Distribute(rewardBudget, recipients)
+	amountsByAddress := make(map[string]payment.Amount, len(recipients))
+	totalDistributed := payment.NewAmount()
+	for i, recipient := range recipients {
+		if amounts[i].Cmp(big.NewInt(0)) > 0 {
+			amountsByAddress[recipient.Address] = amounts[i]
+			totalDistributed.Add(totalDistributed.Int, amounts[i].Int)
+		}
+	}
 	go func() {
-		r.statsClient.Gauge("reward_per_spectator",
-			float64(new(big.Int).Div(amountForEach.Int, pricer.RewardRoundingFactor).Int64())/100.0)
+		averageAmount := new(big.Int).Div(totalDistributed.Int, big.NewInt(int64(len(recipients))))
+		channel.StatsClient.Gauge("reward_per_spectator",
+			float64(new(big.Int).Div(averageAmount, pricer.RewardRoundingFactor).Int64())/100.0)
 	}()
-	if amountForEach.Int.Cmp(big.NewInt(0)) <= 0 {
+	if len(amountsByAddress) == 0 {
 		r.log.Printf("Not rewarding because the amount for each user would be zero")
 	} else {
-		err = r.rewardEligible(ctx, media.PerformanceID(), eligible, amountForEach)
+		err = r.rewardEligible(ctx, media.PerformanceID(), eligible, amountsByAddress, strategy.Name())
 		if err != nil {
 			return stacktrace.Propagate(err, "")
 		}
 	}
 
-	r.rewardsDistributed.Notify(RewardsDistributedEventArgs{rewardBudget, maps.Keys(eligible), requesterReward, media}, true)
+	r.rewardsDistributed.Notify(RewardsDistributedEventArgs{rewardBudget, maps.Keys(eligible), requesterReward, media, channel.ID}, true)
 	return nil
 }
 
-func (r *Handler) getEligibleSpectators(ctx context.Context, exceptAddress string, mediaPlayedFor time.Duration) map[string]*spectator {
+func (r *Handler) getEligibleSpectators(ctx context.Context, channelID, exceptAddress string, mediaPlayedFor time.Duration, eligibilityTime time.Time) map[string]*spectator {
 	// maps addresses to spectators
 	toBeRewarded := make(map[string]*spectator)
 
@@ -144,7 +176,11 @@ func (r *Handler) getEligibleSpectators(ctx context.Context, exceptAddress strin
 				// spectator not currently watching
 				continue
 			}
-			watchingFor := time.Since(spectators[j].startedWatching)
+			if spectators[j].channelID != channelID {
+				// spectator watching a different channel
+				continue
+			}
+			watchingFor := eligibilityTime.Sub(spectators[j].startedWatching) - spectators[j].pausedWatchingTime
 			if goodRemoteAddressRep, _, checked := spectators[j].RemoteAddressInformation(ctx, r); checked && !goodRemoteAddressRep {
 				r.log.Println("Skipped rewarding", spectators[j].user.Address(), spectators[j].remoteAddress, "due to bad IP reputation")
 				continue
@@ -184,10 +220,32 @@ func (r *Handler) getEligibleSpectators(ctx context.Context, exceptAddress strin
 	return toBeRewarded
 }
 
-func (r *Handler) receiveCollectorPending(minExpectedBalance payment.Amount) {
+// getDistributionRecipients gathers the information about eligible spectators that distribution strategies use.
+// Must be called with spectatorsMutex held
+func (r *Handler) getDistributionRecipients(eligible map[string]*spectator, mediaPlayedFor time.Duration, eligibilityTime time.Time) []DistributionRecipient {
+	mediaStartedAt := eligibilityTime.Add(-mediaPlayedFor)
+	recipients := make([]DistributionRecipient, 0, len(eligible))
+	for address, spectator := range eligible {
+		watchedFor := eligibilityTime.Sub(spectator.startedWatching) - spectator.pausedWatchingTime
+		if watchedFor > mediaPlayedFor {
+			watchedFor = mediaPlayedFor
+		}
+		_, participatedInChat := r.chatLessFrequentParticipation.Get(address)
+		recipients = append(recipients, DistributionRecipient{
+			Address:             address,
+			WatchedFor:          watchedFor,
+			SolvedChallenge:     spectator.lastChallengeSolvedAt.After(mediaStartedAt),
+			SolvedHardChallenge: spectator.lastHardChallengeSolvedAt.After(mediaStartedAt),
+			ParticipatedInChat:  participatedInChat,
+		})
+	}
+	return recipients
+}
+
+func (r *Handler) receiveCollectorPending(ctx context.Context, minExpectedBalance payment.Amount) {
 	wg := new(sync.WaitGroup)
 	wg.Add(1)
-	r.collectorAccountQueue <- func(collectorAccount *wallet.Account, RPC *rpc.Client, RPCWork *rpc.Client) {
+	r.collectorAccountQueue <- func(collectorAccount node.Account) {
 		defer wg.Done()
 		balance, pending, err := collectorAccount.Balance()
 		if err != nil {
@@ -219,7 +277,7 @@ func (r *Handler) receiveCollectorPending(minExpectedBalance payment.Amount) {
 			if balance.Cmp(minExpectedBalance.Int) < 0 {
 				// oh boy. let's go through all ever-used accounts, see if anything got stuck in them and send to the collector account
 				r.log.Println("Funds still not enough, desperately trying to find more")
-				err = r.desperatelyTryToFindFundsStuckInPaymentAccounts()
+				err = r.desperatelyTryToFindFundsStuckInPaymentAccounts(ctx)
 				if err != nil {
 					r.log.Printf("Error desperately trying to find funds: %v", err)
 					return
@@ -235,51 +293,48 @@ func (r *Handler) receiveCollectorPending(minExpectedBalance payment.Amount) {
 	wg.Wait()
 }
 
-func (r *Handler) rewardEligible(ctxCtx context.Context, mediaID string, eligible map[string]*spectator, amountForEach payment.Amount) error {
+func (r *Handler) rewardEligible(ctxCtx context.Context, mediaID string, eligible map[string]*spectator,
+	amounts map[string]payment.Amount, strategy types.RewardDistributionStrategy) error {
 	ctx, err := transaction.Begin(ctxCtx)
 	if err != nil {
 		return stacktrace.Propagate(err, "")
 	}
 	defer ctx.Rollback()
 
-	addresses := make([]string, len(eligible))
-	i := 0
-	for address := range eligible {
-		addresses[i] = address
-		i++
+	decimalAmounts := make(map[string]decimal.Decimal, len(amounts))
+	for address, amount := range amounts {
+		decimalAmounts[address] = amount.Decimal()
 	}
-
-	newBalances, err := types.AdjustRewardBalanceOfAddresses(ctx, addresses, amountForEach.Decimal())
+	newBalances, err := types.AdjustRewardBalancesOfAddresses(ctx, decimalAmounts)
 	if err != nil {
 		return stacktrace.Propagate(err, "")
 	}
-
-	balancesByAddress := make(map[string]*types.RewardBalance)
+	balancesByAddress := make(map[string]*types.RewardBalance, len(newBalances))
 	for _, balance := range newBalances {
 		balancesByAddress[balance.RewardsAddress] = balance
 	}
 
-	rewards := make([]*types.ReceivedReward, len(eligible))
-	rewardsIdx := 0
+	rewards := make([]*types.ReceivedReward, 0, len(amounts))
 	now := time.Now()
-	for _, spectator := range eligible {
-		rewardBalance, ok := balancesByAddress[spectator.user.Address()]
+	for address, amount := range amounts {
+		spectator := eligible[address]
+		rewardBalance, ok := balancesByAddress[address]
 		if ok {
 			r.notificationManager.Notify(
 				notifications.NewRewardBalanceUpdatedNotification(
 					spectator.user,
 					payment.NewAmountFromDecimal(rewardBalance.Balance),
-					amountForEach))
+					amount))
 		}
 
-		rewards[rewardsIdx] = &types.ReceivedReward{
-			ID:             uuid.NewV4().String(),
-			RewardsAddress: spectator.user.Address(),
-			ReceivedAt:     now,
-			Amount:         amountForEach.Decimal(),
-			Media:          mediaID,
-		}
-		rewardsIdx++
+		rewards = append(rewards, &types.ReceivedReward{
+			ID:                   uuid.NewV4().String(),
+			RewardsAddress:       address,
+			ReceivedAt:           now,
+			Amount:               amount.Decimal(),
+			Media:                mediaID,
+			DistributionStrategy: strategy,
+		})
 	}
 
 	err = types.InsertReceivedRewards(ctx, rewards)
@@ -287,6 +342,17 @@ func (r *Handler) rewardEligible(ctxCtx context.Context, mediaID string, eligibl
 		return stacktrace.Propagate(err, "")
 	}
 
+	journalLines := []*types.JournalLine{}
+	for address, amount := range amounts {
+		journalLines = append(journalLines,
+			types.JournalTransfer(types.JournalAccountRewardBalance(address), types.JournalAccountEquity, amount.Decimal())...)
+	}
+	err = types.RecordJournalEntry(ctx, fmt.Sprintf("Rewards for %s distributed to %d spectators", mediaID, len(amounts)), mediaID,
+		journalLines...)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
 	return stacktrace.Propagate(ctx.Commit(), "")
 }
 
@@ -314,37 +380,44 @@ func (r *Handler) rewardRequester(ctxCtx context.Context, mediaID string, reques
 			reward))
 
 	err = types.InsertReceivedRewards(ctx, []*types.ReceivedReward{{
-		ID:             uuid.NewV4().String(),
-		RewardsAddress: requester.user.Address(),
-		ReceivedAt:     time.Now(),
-		Amount:         reward.Decimal(),
-		Media:          mediaID,
+		ID:                   uuid.NewV4().String(),
+		RewardsAddress:       requester.user.Address(),
+		ReceivedAt:           time.Now(),
+		Amount:               reward.Decimal(),
+		Media:                mediaID,
+		DistributionStrategy: types.RewardDistributionStrategyRequesterRainShare,
 	}})
 	if err != nil {
 		return stacktrace.Propagate(err, "")
 	}
 
+	err = types.RecordJournalEntry(ctx, fmt.Sprintf("Rain share for %s distributed to its requester", mediaID), mediaID,
+		types.JournalTransfer(types.JournalAccountRewardBalance(requester.user.Address()), types.JournalAccountEquity, reward.Decimal())...)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
 	return stacktrace.Propagate(ctx.Commit(), "")
 }
 
-func (r *Handler) reimburseRequester(ctx context.Context, address string, amount payment.Amount) {
-	r.receiveCollectorPending(amount)
+func (r *Handler) reimburseRequester(ctx context.Context, address string, amount payment.Amount,
+	reason types.RefundReason, comment string, entry media.QueueEntry) {
+	r.receiveCollectorPending(ctx, amount)
 
-	if ctx.Err() != nil || !buildconfig.AllowWithdrawalsAndRefunds {
+	if ctx.Err() != nil {
 		return
 	}
 
-	r.collectorAccountQueue <- func(collectorAccount *wallet.Account, _, _ *rpc.Client) {
-		blockHash, err := collectorAccount.Send(address, amount.Int)
-		if err != nil {
-			r.log.Printf("Error reimbursing %s with %v: %v", address, amount.Int, err)
-		} else {
-			r.log.Printf("Reimbursed %s with %v, block hash %s", address, amount.Int, blockHash.String())
-		}
+	refund, err := r.refundManager.Issue(ctx, address, amount, reason, comment,
+		refundmanager.Origin{QueueEntryID: entry.PerformanceID()})
+	if err != nil {
+		r.log.Printf("Error reimbursing %s with %v: %v", address, amount.Int, err)
+		return
 	}
+	r.log.Printf("Reimbursed %s with %v, refund %s is %s", address, amount.Int, refund.ID, refund.Status)
 }
 
-func (r *Handler) desperatelyTryToFindFundsStuckInPaymentAccounts() error {
+func (r *Handler) desperatelyTryToFindFundsStuckInPaymentAccounts(ctx context.Context) error {
 	for accountIdx := uint32(1); ; accountIdx++ {
 		r.log.Printf("Attempting to find lost funds in account %d", accountIdx)
 		account, err := r.wallet.NewAccount(&accountIdx)
@@ -352,7 +425,7 @@ func (r *Handler) desperatelyTryToFindFundsStuckInPaymentAccounts() error {
 			return stacktrace.Propagate(err, "")
 		}
 		r.log.Printf("Attempting to receive pendings in account %s", account.Address())
-		history, _, err := r.wallet.RPC.AccountHistory(account.Address(), 10, nil)
+		history, _, err := r.wallet.RPC().AccountHistory(account.Address(), 10, nil)
 		if err != nil {
 			if _, ok := err.(*json.UnmarshalTypeError); !ok {
 				return stacktrace.Propagate(err, "failed to retrieve history for account %v", account.Address())
@@ -376,8 +449,12 @@ func (r *Handler) desperatelyTryToFindFundsStuckInPaymentAccounts() error {
 			continue
 		}
 		r.log.Printf("Sending all balance in account %s to collector account", account.Address())
-		r.collectorAccountQueue <- func(collectorAccount *wallet.Account, _, _ *rpc.Client) {
-			_, err = account.Send(collectorAccount.Address(), balance)
+		r.collectorAccountQueue <- func(collectorAccount node.Account) {
+			var hash rpc.BlockHash
+			hash, err = account.Send(collectorAccount.Address(), balance)
+			if err == nil {
+				r.recordStuckFundsRecovery(ctx, account.Address(), collectorAccount.Address(), payment.NewAmount(balance), hash)
+			}
 		}
 		if err != nil {
 			return stacktrace.Propagate(err, "")
@@ -385,6 +462,24 @@ func (r *Handler) desperatelyTryToFindFundsStuckInPaymentAccounts() error {
 	}
 }
 
+func (r *Handler) recordStuckFundsRecovery(ctxCtx context.Context, from, collectorAccountAddress string, amount payment.Amount, hash rpc.BlockHash) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		r.log.Printf("Failed to record recovery of funds stuck in account %s in the journal: %v", from, err)
+		return
+	}
+	defer ctx.Rollback()
+
+	err = types.RecordJournalEntry(ctx, fmt.Sprintf("Funds stuck in account %s recovered into %s", from, collectorAccountAddress), hash.String(),
+		types.JournalTransfer(types.JournalAccountOnChain(from), types.JournalAccountOnChain(collectorAccountAddress), amount.Decimal())...)
+	if err == nil {
+		err = ctx.Commit()
+	}
+	if err != nil {
+		r.log.Printf("Failed to record recovery of funds stuck in account %s in the journal: %v", from, err)
+	}
+}
+
 // ComputeReward calculates how much each user should receive
 func ComputeReward(totalAmount payment.Amount, numUsers int) payment.Amount {
 	amountForEach := payment.NewAmount()
@@ -14,6 +14,7 @@ type RewardsDistributedEventArgs struct {
 	EligibleSpectators []string
 	RequesterReward    payment.Amount
 	Media              media.QueueEntry
+	ChannelID          string
 }
 
 // RewardsDistributed is the event that is fired when rewards are distributed for a queue entry
@@ -0,0 +1,20 @@
+package rewards
+
+import (
+	"context"
+
+	"github.com/tnyim/jungletv/server/auth"
+)
+
+// DistributeScheduledRain distributes the next share of a scheduled rain as if the spectators with the specified
+// addresses were the ones eligible for the rewards of the specified media
+func (r *Handler) DistributeScheduledRain(ctx context.Context, rainID, mediaID string, eligibleAddresses ...string) error {
+	r.spectatorsMutex.RLock()
+	defer r.spectatorsMutex.RUnlock()
+
+	eligible := make(map[string]*spectator, len(eligibleAddresses))
+	for _, address := range eligibleAddresses {
+		eligible[address] = &spectator{user: auth.NewAddressOnlyUser(address)}
+	}
+	return r.distributeScheduledRain(ctx, rainID, mediaID, eligible)
+}
@@ -11,21 +11,19 @@ import (
 	"time"
 
 	movingaverage "github.com/RobinUS2/golang-moving-average"
-	"github.com/hectorchu/gonano/rpc"
-	"github.com/hectorchu/gonano/wallet"
 	"github.com/palantir/stacktrace"
 	"github.com/patrickmn/go-cache"
 	"github.com/tnyim/jungletv/proto"
 	"github.com/tnyim/jungletv/server/auth"
 	"github.com/tnyim/jungletv/server/components/chatmanager"
 	"github.com/tnyim/jungletv/server/components/ipreputation"
-	"github.com/tnyim/jungletv/server/components/mediaqueue"
+	"github.com/tnyim/jungletv/server/components/node"
 	"github.com/tnyim/jungletv/server/components/notificationmanager"
 	"github.com/tnyim/jungletv/server/components/notificationmanager/notifications"
 	"github.com/tnyim/jungletv/server/components/payment"
 	"github.com/tnyim/jungletv/server/components/pointsmanager"
 	"github.com/tnyim/jungletv/server/components/pricer"
-	"github.com/tnyim/jungletv/server/components/skipmanager"
+	"github.com/tnyim/jungletv/server/components/refundmanager"
 	"github.com/tnyim/jungletv/server/components/staffactivitymanager"
 	"github.com/tnyim/jungletv/server/components/withdrawalhandler"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
@@ -47,18 +45,16 @@ type ChallengeCheckFunction func(context.Context, *ActivityChallenge, string) (b
 type Handler struct {
 	log                   *log.Logger
 	statsClient           *statsd.Client
-	mediaQueue            *mediaqueue.MediaQueue
+	channels              map[string]*channelState
 	ipReputationChecker   *ipreputation.Checker
 	withdrawalHandler     *withdrawalhandler.Handler
-	wallet                *wallet.Wallet
-	collectorAccountQueue chan func(*wallet.Account, *rpc.Client, *rpc.Client)
-	skipManager           *skipmanager.Manager
+	refundManager         *refundmanager.Manager
+	wallet                node.Wallet
+	collectorAccountQueue chan func(node.Account)
 	chatManager           *chatmanager.Manager
 	paymentAccountPool    *payment.PaymentAccountPool
-	lastMedia             media.QueueEntry
 	moderationStore       moderation.Store
 	staffActivityManager  *staffactivitymanager.Manager
-	eligibleMovingAverage *movingaverage.MovingAverage
 	challengeCheckers     map[ActivityChallengeType]ChallengeCheckFunction
 	versionHashGetter     func() string
 	pointsManager         *pointsmanager.Manager
@@ -80,6 +76,9 @@ type Handler struct {
 
 	chatParticipation             *cache.Cache[string, struct{}]
 	chatLessFrequentParticipation *cache.Cache[string, struct{}]
+
+	// serializes the distribution and cancellation of scheduled rains
+	scheduledRainMutex sync.Mutex
 }
 
 type Spectator interface {
@@ -87,6 +86,7 @@ type Spectator interface {
 	OnActivityChallenge() event.Event[*ActivityChallenge]
 	CurrentActivityChallenge() *ActivityChallenge
 	Legitimate() (bool, time.Time)
+	ChannelID() string
 	CurrentRemoteAddress() string
 	RemoteAddressInformation(context.Context, *Handler) (bool, int, bool)
 	CountOtherConnectedSpectatorsOnSameRemoteAddress(*Handler) int
@@ -101,10 +101,12 @@ type spectator struct {
 	legitimacyFailures         int
 	stoppedBeingLegitimate     time.Time
 	user                       auth.User
+	channelID                  string // the channel the spectator most recently connected to
 	remoteAddress              string
 	remoteAddresses            map[string]struct{}
 	startedWatching            time.Time
 	stoppedWatching            time.Time
+	pausedWatchingTime         time.Duration // time spent watching while the broadcast was paused, which doesn't count towards rewards
 	activityCheckTimer         *time.Timer
 	nextActivityCheckTime      time.Time
 	onDisconnected             event.NoArgEvent
@@ -112,6 +114,7 @@ type spectator struct {
 	onActivityChallenge        event.Event[*ActivityChallenge]
 	activityChallenge          *ActivityChallenge
 	lastHardChallengeSolvedAt  time.Time
+	lastChallengeSolvedAt      time.Time
 	connectionCount            int
 	noToleranceOnNextChallenge bool
 }
@@ -161,6 +164,10 @@ func (s *spectator) Legitimate() (bool, time.Time) {
 	return s.legitimate, s.stoppedBeingLegitimate
 }
 
+func (s *spectator) ChannelID() string {
+	return s.channelID
+}
+
 func (s *spectator) CurrentRemoteAddress() string {
 	return s.remoteAddress
 }
@@ -202,14 +209,15 @@ func (s *spectator) RemoteAddressInformation(ctx context.Context, r *Handler) (b
 }
 
 // NewHandler creates a new RewardsHandler
-func NewHandler(log *log.Logger,
+func NewHandler(ctx context.Context,
+	log *log.Logger,
 	statsClient *statsd.Client,
-	mediaQueue *mediaqueue.MediaQueue,
+	channels []Channel,
 	ipReputationChecker *ipreputation.Checker,
 	withdrawalHandler *withdrawalhandler.Handler,
-	wallet *wallet.Wallet,
-	collectorAccountQueue chan func(*wallet.Account, *rpc.Client, *rpc.Client),
-	skipManager *skipmanager.Manager,
+	refundManager *refundmanager.Manager,
+	wallet node.Wallet,
+	collectorAccountQueue chan func(node.Account),
 	chatManager *chatmanager.Manager,
 	pointsManager *pointsmanager.Manager,
 	notificationManager *notificationmanager.Manager,
@@ -218,20 +226,31 @@ func NewHandler(log *log.Logger,
 	staffActivityManager *staffactivitymanager.Manager,
 	challengeCheckers map[ActivityChallengeType]ChallengeCheckFunction,
 	versionHashGetter func() string) (*Handler, error) {
-	return &Handler{
+	channelStates := make(map[string]*channelState, len(channels))
+	for _, channel := range channels {
+		channelStates[channel.ID] = &channelState{
+			Channel:               channel,
+			eligibleMovingAverage: movingaverage.New(3),
+			distributionStrategy:  EqualDistributionStrategy{},
+		}
+	}
+	if _, ok := channelStates[types.MainBroadcastChannelID]; !ok {
+		return nil, stacktrace.NewError("main broadcast channel missing")
+	}
+
+	r := &Handler{
 		log:                   log,
 		statsClient:           statsClient,
-		mediaQueue:            mediaQueue,
+		channels:              channelStates,
 		ipReputationChecker:   ipReputationChecker,
 		withdrawalHandler:     withdrawalHandler,
+		refundManager:         refundManager,
 		wallet:                wallet,
 		collectorAccountQueue: collectorAccountQueue,
-		skipManager:           skipManager,
 		chatManager:           chatManager,
 		paymentAccountPool:    paymentAccountPool,
 		staffActivityManager:  staffActivityManager,
 		moderationStore:       moderationStore,
-		eligibleMovingAverage: movingaverage.New(3),
 		challengeCheckers:     maps.Clone(challengeCheckers),
 		pointsManager:         pointsManager,
 		notificationManager:   notificationManager,
@@ -250,10 +269,22 @@ func NewHandler(log *log.Logger,
 
 		chatParticipation:             cache.New[string, struct{}](2*time.Minute+45*time.Second, 10*time.Minute),
 		chatLessFrequentParticipation: cache.New[string, struct{}](15*time.Minute, 10*time.Minute),
-	}, nil
+	}
+
+	err := r.loadDistributionStrategies(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return r, nil
 }
 
-func (r *Handler) RegisterSpectator(ctx context.Context, user auth.User) (Spectator, error) {
+// RegisterSpectator registers a new connection of a spectator watching the specified channel.
+// Spectators are only rewarded for the channel they most recently connected to
+func (r *Handler) RegisterSpectator(ctx context.Context, user auth.User, channelID string) (Spectator, error) {
+	if _, ok := r.channels[channelID]; !ok {
+		return nil, stacktrace.NewError("unknown broadcast channel %s", channelID)
+	}
+
 	ipCountry := authinterceptor.IPCountryFromContext(ctx)
 	if ipCountry == "T1" {
 		return &spectator{
@@ -274,6 +305,7 @@ func (r *Handler) RegisterSpectator(ctx context.Context, user auth.User) (Specta
 	if found {
 		// refresh user (e.g. to update permission level)
 		s.user = user
+		s.channelID = channelID
 		s.stoppedWatching = time.Time{}
 		if s.remoteAddress != remoteAddress {
 			// changing IPs makes one lose human verification status
@@ -296,6 +328,7 @@ func (r *Handler) RegisterSpectator(ctx context.Context, user auth.User) (Specta
 		s = &spectator{
 			legitimate:            true, // everyone starts in good standings
 			user:                  user,
+			channelID:             channelID,
 			remoteAddress:         remoteAddress,
 			startedWatching:       now,
 			nextActivityCheckTime: now.Add(d),
@@ -321,7 +354,7 @@ func (r *Handler) RegisterSpectator(ctx context.Context, user auth.User) (Specta
 		}
 	}
 
-	r.log.Printf("Re%sgistered spectator with reward address %s and remote address %s, %d connections", reconnectingStr, s.user.Address(), s.remoteAddress, s.connectionCount)
+	r.log.Printf("Re%sgistered spectator with reward address %s and remote address %s on channel %s, %d connections", reconnectingStr, s.user.Address(), s.remoteAddress, channelID, s.connectionCount)
 	if s.connectionCount == 1 {
 		go spectatorActivityWatchdog(ctx, s, r)
 	}
@@ -389,14 +422,15 @@ func (r *Handler) purgeOldDisconnectedSpectators() {
 }
 
 func (r *Handler) Worker(ctx context.Context) error {
-	onEntryAdded, entryAddedU := r.mediaQueue.EntryAdded().Subscribe(event.BufferFirst)
-	defer entryAddedU()
-
-	onMediaChanged, mediaChangedU := r.mediaQueue.MediaChanged().Subscribe(event.BufferAll)
-	defer mediaChangedU()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-	onEntryRemoved, entryRemovedU := r.mediaQueue.EntryRemoved().Subscribe(event.BufferAll)
-	defer entryRemovedU()
+	channelWorkerErrors := make(chan error, len(r.channels))
+	for _, channel := range r.channels {
+		go func(channel *channelState) {
+			channelWorkerErrors <- r.channelWorker(ctx, channel)
+		}(channel)
+	}
 
 	onPendingWithdrawalsCreated, pendingWithdrawalsCreatedU := r.withdrawalHandler.PendingWithdrawalsCreated().Subscribe(event.BufferFirst)
 	defer pendingWithdrawalsCreatedU()
@@ -404,37 +438,23 @@ func (r *Handler) Worker(ctx context.Context) error {
 	onChatMessageCreated, onChatMessageCreatedU := r.chatManager.OnMessageCreated().Subscribe(event.BufferFirst)
 	defer onChatMessageCreatedU()
 
-	// the rewards handler might be starting at a time when there are things already playing,
-	// in that case we need to update lastMedia
-	entries := r.mediaQueue.Entries()
-	if len(entries) > 0 {
-		r.lastMedia = entries[0]
-	}
 	purgeTicker := time.NewTicker(10 * time.Minute)
 	defer purgeTicker.Stop()
+
+	scheduledRainTicker := time.NewTicker(10 * time.Second)
+	defer scheduledRainTicker.Stop()
 	for {
 		select {
-		case v := <-onMediaChanged:
-			err := r.onMediaChanged(ctx, v)
+		case err := <-channelWorkerErrors:
 			if err != nil {
 				return stacktrace.Propagate(err, "")
 			}
-		case args := <-onEntryRemoved:
-			if args.Index != 0 {
-				err := r.onMediaRemoved(ctx, args.Entry)
-				if err != nil {
-					return stacktrace.Propagate(err, "")
-				}
-			}
 		case pendingWithdrawals := <-onPendingWithdrawalsCreated:
 			r.onPendingWithdrawalCreated(pendingWithdrawals)
 		case <-purgeTicker.C:
 			r.purgeOldDisconnectedSpectators()
-		case entryAddedArgs := <-onEntryAdded:
-			err := r.handleQueueEntryAdded(ctx, entryAddedArgs.Entry)
-			if err != nil {
-				return stacktrace.Propagate(err, "")
-			}
+		case <-scheduledRainTicker.C:
+			r.distributeDueScheduledRains(ctx)
 		case msgCreatedArgs := <-onChatMessageCreated:
 			err := r.handleNewChatMessage(ctx, msgCreatedArgs.Message)
 			if err != nil {
@@ -458,26 +478,6 @@ func (r *Handler) onPendingWithdrawalCreated(pending []*types.PendingWithdrawal)
 	}
 }
 
-func (r *Handler) onMediaChanged(ctx context.Context, newMedia media.QueueEntry) error {
-	if newMedia == r.lastMedia {
-		return nil
-	}
-	defer func() { r.lastMedia = newMedia }()
-	if r.lastMedia == nil {
-		return nil
-	}
-	lastMedia := r.lastMedia
-
-	go func() {
-		err := r.rewardUsers(ctx, lastMedia)
-		if err != nil {
-			r.log.Println("Error rewarding users:", err)
-		}
-	}()
-
-	return nil
-}
-
 func (r *Handler) onMediaRemoved(ctx context.Context, removed media.QueueEntry) error {
 	r.log.Printf("Media with ID %s removed from queue", removed.PerformanceID())
 	amountToReimburse := removed.RequestCost()
@@ -508,7 +508,8 @@ func (r *Handler) onMediaRemoved(ctx context.Context, removed media.QueueEntry)
 	}
 
 	if amountToReimburse.Cmp(big.NewInt(0)) > 0 && !removed.RequestedBy().IsFromAlienChain() {
-		go r.reimburseRequester(ctx, removed.RequestedBy().Address(), amountToReimburse)
+		go r.reimburseRequester(ctx, removed.RequestedBy().Address(), amountToReimburse,
+			types.RefundReasonRemovedEntry, "Queue entry removed before it finished playing", removed)
 	}
 	return nil
 }
@@ -620,9 +621,41 @@ func (r *Handler) CountConnectedSpectatorsOnRemoteAddress(remoteAddress string)
 	return count
 }
 
+// EstimateEligibleSpectators estimates how many spectators of the main channel are eligible for rewards
 func (r *Handler) EstimateEligibleSpectators() (int, bool) {
-	if r.eligibleMovingAverage.Count() > 0 {
-		return int(r.eligibleMovingAverage.Avg()), true
+	return r.EstimateEligibleSpectatorsOnChannel(types.MainBroadcastChannelID)
+}
+
+// EstimateEligibleSpectatorsOnChannel estimates how many spectators of the specified channel are eligible for rewards
+func (r *Handler) EstimateEligibleSpectatorsOnChannel(channelID string) (int, bool) {
+	channel, ok := r.channels[channelID]
+	if !ok || channel.eligibleMovingAverage.Count() == 0 {
+		return 0, false
+	}
+	return int(channel.eligibleMovingAverage.Avg()), true
+}
+
+// IsEligibleSpectator returns whether the user with the specified address is currently watching the specified channel,
+// has no pending activity challenge and would not be excluded from rewards due to bans or lack of legitimacy
+func (r *Handler) IsEligibleSpectator(ctx context.Context, address, channelID string) bool {
+	r.spectatorsMutex.RLock()
+	defer r.spectatorsMutex.RUnlock()
+
+	spectator, ok := r.spectatorsByRewardAddress[address]
+	if !ok || spectator.connectionCount <= 0 || spectator.channelID != channelID {
+		return false
+	}
+	if spectator.activityChallenge != nil || !spectator.legitimate {
+		return false
+	}
+	if goodRemoteAddressRep, _, checked := spectator.RemoteAddressInformation(ctx, r); checked && !goodRemoteAddressRep {
+		return false
+	}
+	if banned, err := r.moderationStore.LoadPaymentAddressBannedFromRewards(ctx, address); err != nil || banned {
+		return false
+	}
+	if banned, err := r.moderationStore.LoadRemoteAddressBannedFromRewards(ctx, spectator.remoteAddress); err != nil || banned {
+		return false
 	}
-	return 0, false
+	return true
 }
@@ -0,0 +1,404 @@
+package rewards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/server/components/notificationmanager/notifications"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/server/components/refundmanager"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"golang.org/x/exp/maps"
+)
+
+// ErrScheduledRainNotFound is returned when the specified scheduled rain does not exist or belongs to a different
+// application
+var ErrScheduledRainNotFound = errors.New("scheduled rain not found")
+
+// MaxScheduledRainMediaCount is the maximum number of media a scheduled rain can be split over
+const MaxScheduledRainMediaCount = 100
+
+// MinScheduledRainChatWindow and MaxScheduledRainChatWindow bound how far back the chat is looked at to find the
+// recipients of scheduled rains whose audience is restricted to chatters
+const MinScheduledRainChatWindow = 1 * time.Minute
+const MaxScheduledRainChatWindow = 24 * time.Hour
+
+// MaxScheduledRainAdvance is how far in the future rains can be scheduled
+const MaxScheduledRainAdvance = 30 * 24 * time.Hour
+
+// ScheduledRainParams are the parameters of a rain to be scheduled
+type ScheduledRainParams struct {
+	ChannelID     string
+	Amount        payment.Amount
+	Audience      types.ScheduledRainAudience
+	ChatWindow    time.Duration // only relevant when Audience is types.ScheduledRainAudienceChatters
+	MediaCount    int           // zero to distribute the whole amount at once
+	ScheduledFor  time.Time
+	CreatedBy     string
+	ApplicationID string // set when the rain is funded by the wallet of an application
+}
+
+// Validate returns an error describing the first problem with the parameters, if any
+func (p ScheduledRainParams) Validate() error {
+	if p.MediaCount < 0 || p.MediaCount > MaxScheduledRainMediaCount {
+		return fmt.Errorf("the rain must be split over at most %d media", MaxScheduledRainMediaCount)
+	}
+	parts := int64(p.MediaCount)
+	if parts == 0 {
+		parts = 1
+	}
+	// every distribution must be able to reward at least one spectator
+	if p.Amount.Int == nil || p.Amount.Cmp(new(big.Int).Mul(pricer.RewardRoundingFactor, big.NewInt(parts))) < 0 {
+		return errors.New("the amount is too small")
+	}
+	switch p.Audience {
+	case types.ScheduledRainAudienceSpectators:
+	case types.ScheduledRainAudienceChatters:
+		if p.ChatWindow < MinScheduledRainChatWindow || p.ChatWindow > MaxScheduledRainChatWindow {
+			return fmt.Errorf("the chat window must be between %v and %v", MinScheduledRainChatWindow, MaxScheduledRainChatWindow)
+		}
+	default:
+		return errors.New("invalid audience")
+	}
+	if time.Until(p.ScheduledFor) > MaxScheduledRainAdvance {
+		return errors.New("the rain is scheduled too far in the future")
+	}
+	return nil
+}
+
+// ScheduleRain schedules a rain on a channel.
+// Rains funded by applications must only be scheduled after the funds are sent to the collector account, ideally in
+// the same transaction, so the rain is not scheduled if the funds can't be sent
+func (r *Handler) ScheduleRain(ctxCtx context.Context, params ScheduledRainParams) (*types.ScheduledRain, error) {
+	if _, ok := r.channels[params.ChannelID]; !ok {
+		return nil, stacktrace.NewError("unknown broadcast channel %s", params.ChannelID)
+	}
+	err := params.Validate()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	now := time.Now()
+	scheduledFor := params.ScheduledFor
+	if scheduledFor.Before(now) {
+		scheduledFor = now
+	}
+	rain := &types.ScheduledRain{
+		ID:                uuid.NewV4().String(),
+		ChannelID:         params.ChannelID,
+		Amount:            params.Amount.Decimal(),
+		DistributedAmount: decimal.Zero,
+		Audience:          params.Audience,
+		ChatWindow:        types.Duration(params.ChatWindow),
+		MediaCount:        params.MediaCount,
+		ScheduledFor:      scheduledFor,
+		Status:            types.ScheduledRainStatusScheduled,
+		CreatedBy:         params.CreatedBy,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+	if params.ApplicationID != "" {
+		rain.ApplicationID = &params.ApplicationID
+	}
+	err = rain.Update(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	r.log.Printf("Scheduled rain %s of %v on channel %s for %v, by %s", rain.ID, params.Amount, rain.ChannelID, rain.ScheduledFor, rain.CreatedBy)
+	return rain, stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// CancelScheduledRain cancels a rain that has not been fully distributed yet.
+// If applicationID is not empty, only rains of that application can be cancelled, and the amount yet to be distributed
+// is refunded to the application wallet
+func (r *Handler) CancelScheduledRain(ctxCtx context.Context, rainID, applicationID string) (*types.ScheduledRain, error) {
+	r.scheduledRainMutex.Lock()
+	defer r.scheduledRainMutex.Unlock()
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	rains, err := types.GetScheduledRainsWithIDs(ctx, []string{rainID})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	rain, ok := rains[rainID]
+	if !ok || (applicationID != "" && (rain.ApplicationID == nil || *rain.ApplicationID != applicationID)) {
+		return nil, stacktrace.Propagate(ErrScheduledRainNotFound, "")
+	}
+	if rain.Status != types.ScheduledRainStatusScheduled {
+		return nil, stacktrace.NewError("scheduled rain is already %s", rain.Status)
+	}
+
+	rain.Status = types.ScheduledRainStatusCancelled
+	rain.UpdatedAt = time.Now()
+	err = rain.Update(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	r.log.Printf("Cancelled scheduled rain %s", rain.ID)
+
+	remaining := payment.NewAmountFromDecimal(rain.RemainingAmount())
+	if rain.ApplicationID != nil && remaining.Cmp(big.NewInt(0)) > 0 {
+		// if sending fails, the refund can be retried by an admin
+		_, err = r.refundManager.Issue(ctxCtx, rain.CreatedBy, remaining, types.RefundReasonCancelledRain,
+			fmt.Sprintf("Scheduled rain %s cancelled", rain.ID), refundmanager.Origin{})
+		if err != nil {
+			r.log.Printf("Failed to refund cancelled scheduled rain %s: %v", rain.ID, err)
+		}
+	}
+	return rain, nil
+}
+
+// distributeDueScheduledRains distributes the rains that are due to be distributed all at once.
+// Such rains wait until there is media playing on their channel, and until they have at least one recipient
+func (r *Handler) distributeDueScheduledRains(ctx context.Context) {
+	for _, channel := range r.channels {
+		err := r.distributeDueScheduledRainsOfChannel(ctx, channel)
+		if err != nil {
+			r.log.Printf("Error distributing scheduled rains on channel %s: %v", channel.ID, err)
+		}
+	}
+}
+
+func (r *Handler) distributeDueScheduledRainsOfChannel(ctx context.Context, channel *channelState) error {
+	rains, err := r.getDueScheduledRains(ctx, channel.ID, false)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if len(rains) == 0 {
+		return nil
+	}
+
+	// received rewards must refer to a media
+	currentMedia, playing := channel.MediaQueue.CurrentlyPlaying()
+	if !playing {
+		return nil
+	}
+
+	r.spectatorsMutex.RLock()
+	defer r.spectatorsMutex.RUnlock()
+
+	eligible := r.getEligibleSpectators(ctx, channel.ID, "", 0, time.Now())
+	for _, rain := range rains {
+		err = r.distributeScheduledRain(ctx, rain.ID, currentMedia.PerformanceID(), eligible)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+	return nil
+}
+
+// distributeScheduledRainsOverMedia distributes a share of each due rain that is split over multiple media, as part
+// of the rewards for the specified media. Must be called with spectatorsMutex held
+func (r *Handler) distributeScheduledRainsOverMedia(ctx context.Context, channelID, mediaID string, eligible map[string]*spectator) {
+	rains, err := r.getDueScheduledRains(ctx, channelID, true)
+	if err != nil {
+		r.log.Printf("Error getting scheduled rains for %s: %v", mediaID, err)
+		return
+	}
+	for _, rain := range rains {
+		err = r.distributeScheduledRain(ctx, rain.ID, mediaID, eligible)
+		if err != nil {
+			r.log.Printf("Error distributing scheduled rain %s for %s: %v", rain.ID, mediaID, err)
+		}
+	}
+}
+
+func (r *Handler) getDueScheduledRains(ctxCtx context.Context, channelID string, overMedia bool) ([]*types.ScheduledRain, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	rains, err := types.GetDueScheduledRains(ctx, channelID, overMedia, time.Now())
+	return rains, stacktrace.Propagate(err, "")
+}
+
+// distributeScheduledRain distributes the next share of a scheduled rain among the eligible spectators that are part
+// of its audience. Nothing is distributed if no spectator is part of its audience.
+// Must be called with spectatorsMutex held
+func (r *Handler) distributeScheduledRain(ctxCtx context.Context, rainID, mediaID string, eligible map[string]*spectator) error {
+	r.scheduledRainMutex.Lock()
+	defer r.scheduledRainMutex.Unlock()
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	rains, err := types.GetScheduledRainsWithIDs(ctx, []string{rainID})
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	rain, ok := rains[rainID]
+	if !ok || rain.Status != types.ScheduledRainStatusScheduled {
+		// cancelled in the meantime
+		return nil
+	}
+
+	recipients, err := r.getScheduledRainRecipients(ctx, rain, eligible)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	share := payment.NewAmountFromDecimal(rain.RemainingAmount())
+	if rain.MediaCount > 0 {
+		share.Div(share.Int, big.NewInt(int64(rain.MediaCount-rain.MediaDistributed)))
+	}
+	// if there are more recipients than the share can reward, reward a random subset of them
+	maxRecipients := new(big.Int).Div(share.Int, pricer.RewardRoundingFactor)
+	if maxRecipients.Cmp(big.NewInt(int64(len(recipients)))) < 0 {
+		rand.Shuffle(len(recipients), func(i, j int) {
+			recipients[i], recipients[j] = recipients[j], recipients[i]
+		})
+		recipients = recipients[:maxRecipients.Int64()]
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+	amountForEach := ComputeReward(share, len(recipients))
+	total := payment.NewAmount(new(big.Int).Mul(amountForEach.Int, big.NewInt(int64(len(recipients)))))
+
+	newBalances, err := types.AdjustRewardBalanceOfAddresses(ctx, recipients, amountForEach.Decimal())
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	now := time.Now()
+	rewards := make([]*types.ReceivedReward, len(recipients))
+	journalLines := []*types.JournalLine{}
+	for i, address := range recipients {
+		rewards[i] = &types.ReceivedReward{
+			ID:                   uuid.NewV4().String(),
+			RewardsAddress:       address,
+			ReceivedAt:           now,
+			Amount:               amountForEach.Decimal(),
+			Media:                mediaID,
+			DistributionStrategy: types.RewardDistributionStrategyScheduledRain,
+		}
+		journalLines = append(journalLines,
+			types.JournalTransfer(types.JournalAccountRewardBalance(address), types.JournalAccountEquity, amountForEach.Decimal())...)
+	}
+	err = types.InsertReceivedRewards(ctx, rewards)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	rain.MediaDistributed++
+	txHash := fmt.Sprintf("%s:%d", rain.ID, rain.MediaDistributed)
+	err = types.RecordJournalEntry(ctx, fmt.Sprintf("Scheduled rain %s distributed to %d spectators", rain.ID, len(recipients)), txHash,
+		journalLines...)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = types.InsertCrowdfundedTransactions(ctx, []*types.CrowdfundedTransaction{{
+		TxHash:           txHash,
+		FromAddress:      rain.CreatedBy,
+		Amount:           total.Decimal(),
+		Currency:         string(payment.HomeCurrency),
+		AmountInCurrency: total.Decimal(),
+		ReceivedAt:       now,
+		TransactionType:  types.CrowdfundedTransactionTypeScheduledRain,
+		ForMedia:         &mediaID,
+		ChannelID:        rain.ChannelID,
+	}})
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	// whatever can't be split evenly in the last distribution stays in the collector account
+	rain.DistributedAmount = rain.DistributedAmount.Add(total.Decimal())
+	if rain.MediaCount == 0 || rain.MediaDistributed >= rain.MediaCount {
+		rain.Status = types.ScheduledRainStatusComplete
+	}
+	rain.UpdatedAt = now
+	err = rain.Update(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	r.log.Printf("Distributed %v of scheduled rain %s to %d spectators", total, rain.ID, len(recipients))
+
+	for _, balance := range newBalances {
+		if spectator, ok := eligible[balance.RewardsAddress]; ok {
+			r.notificationManager.Notify(
+				notifications.NewRewardBalanceUpdatedNotification(
+					spectator.user,
+					payment.NewAmountFromDecimal(balance.Balance),
+					amountForEach))
+		}
+	}
+
+	if rain.ChannelID == types.MainBroadcastChannelID {
+		banStr := new(big.Rat).SetFrac(total.Int, pricer.BananoUnit).FloatString(2)
+		_, err = r.chatManager.CreateSystemMessage(ctxCtx,
+			fmt.Sprintf("_A scheduled rain of **%s BAN** was just distributed among %d spectators!_", banStr, len(recipients)))
+		if err != nil {
+			r.log.Printf("Failed to announce distribution of scheduled rain %s: %v", rain.ID, err)
+		}
+	}
+	return nil
+}
+
+func (r *Handler) getScheduledRainRecipients(ctx context.Context, rain *types.ScheduledRain, eligible map[string]*spectator) ([]string, error) {
+	if rain.Audience != types.ScheduledRainAudienceChatters {
+		return maps.Keys(eligible), nil
+	}
+
+	now := time.Now()
+	messages, err := r.chatManager.LoadMessagesBetween(ctx, nil, now.Add(-time.Duration(rain.ChatWindow)), now)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	recipients := []string{}
+	seen := make(map[string]struct{})
+	for _, message := range messages {
+		if message.Author == nil || message.Author.IsUnknown() || message.Shadowbanned {
+			continue
+		}
+		address := message.Author.Address()
+		if _, ok := seen[address]; ok {
+			continue
+		}
+		seen[address] = struct{}{}
+		if _, ok := eligible[address]; ok {
+			recipients = append(recipients, address)
+		}
+	}
+	return recipients, nil
+}
@@ -0,0 +1,599 @@
+package rewards_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/chatmanager"
+	"github.com/tnyim/jungletv/server/components/node"
+	"github.com/tnyim/jungletv/server/components/notificationmanager"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/refundmanager"
+	"github.com/tnyim/jungletv/server/components/rewards"
+	"github.com/tnyim/jungletv/server/stores/blockeduser"
+	"github.com/tnyim/jungletv/server/stores/chat"
+	"github.com/tnyim/jungletv/server/stores/moderation"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction/transactiontest"
+	"gopkg.in/alexcesaro/statsd.v2"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduledRainParamsValidation(t *testing.T) {
+	valid := rewards.ScheduledRainParams{
+		ChannelID:    types.MainBroadcastChannelID,
+		Amount:       units(300),
+		Audience:     types.ScheduledRainAudienceSpectators,
+		MediaCount:   3,
+		ScheduledFor: time.Now().Add(time.Hour),
+	}
+	require.NoError(t, valid.Validate())
+
+	p := valid
+	p.Amount = units(2)
+	require.Error(t, p.Validate(), "each media must receive at least the rounding factor")
+
+	p = valid
+	p.MediaCount = rewards.MaxScheduledRainMediaCount + 1
+	require.Error(t, p.Validate())
+
+	p = valid
+	p.Audience = types.ScheduledRainAudienceChatters
+	require.Error(t, p.Validate(), "chatters audience requires a chat window")
+	p.ChatWindow = 10 * time.Minute
+	require.NoError(t, p.Validate())
+
+	p = valid
+	p.ScheduledFor = time.Now().Add(rewards.MaxScheduledRainAdvance + time.Hour)
+	require.Error(t, p.Validate())
+
+	p = valid
+	p.Audience = "everyone"
+	require.Error(t, p.Validate())
+}
+
+// rainDatabase is the part of the database touched by scheduled rains
+type rainDatabase struct {
+	rains             map[string]map[string]driver.Value
+	rewardBalances    map[string]decimal.Decimal
+	receivedRewards   []map[string]driver.Value
+	crowdfundedTxs    []map[string]driver.Value
+	journalReferences []string
+	refunds           map[string]map[string]driver.Value
+}
+
+func (d *rainDatabase) clone() *rainDatabase {
+	c := &rainDatabase{
+		rains:             make(map[string]map[string]driver.Value),
+		rewardBalances:    make(map[string]decimal.Decimal),
+		receivedRewards:   append([]map[string]driver.Value{}, d.receivedRewards...),
+		crowdfundedTxs:    append([]map[string]driver.Value{}, d.crowdfundedTxs...),
+		journalReferences: append([]string{}, d.journalReferences...),
+		refunds:           make(map[string]map[string]driver.Value),
+	}
+	for k, v := range d.rains {
+		c.rains[k] = v
+	}
+	for k, v := range d.rewardBalances {
+		c.rewardBalances[k] = v
+	}
+	for k, v := range d.refunds {
+		c.refunds[k] = v
+	}
+	return c
+}
+
+// postgresInterval formats a duration the way PostgreSQL returns intervals
+func postgresInterval(value driver.Value) driver.Value {
+	d, err := time.ParseDuration(value.(string))
+	if err != nil {
+		return value
+	}
+	return []byte(fmt.Sprintf("%02d:%02d:%02d", int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60))
+}
+
+// fakeChatStore keeps chat messages in memory
+type fakeChatStore struct {
+	chat.Store
+	mu       sync.Mutex
+	messages []*chat.Message
+}
+
+func (s *fakeChatStore) StoreMessage(_ context.Context, m *chat.Message) (*string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, m)
+	return nil, nil
+}
+
+func (s *fakeChatStore) LoadMessagesBetween(_ context.Context, _ auth.User, since, until time.Time) ([]*chat.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	messages := []*chat.Message{}
+	for _, m := range s.messages {
+		if m.CreatedAt.After(since) && m.CreatedAt.Before(until) {
+			messages = append(messages, m)
+		}
+	}
+	return messages, nil
+}
+
+func (s *fakeChatStore) SetAttachmentLoaderForType(string, chat.AttachmentLoader) {}
+
+func (s *fakeChatStore) systemMessages() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	contents := []string{}
+	for _, m := range s.messages {
+		if m.Author == nil {
+			contents = append(contents, m.Content)
+		}
+	}
+	return contents
+}
+
+type rainTestEnvironment struct {
+	ctx       context.Context
+	db        *transactiontest.FakeDatabase[*rainDatabase]
+	fakeNode  *node.FakeNode
+	collector node.Account
+	funder    string
+	creator   string
+	chatStore *fakeChatStore
+	handler   *rewards.Handler
+
+	failCrowdfundedTransactions bool
+}
+
+func newRainTestEnvironment(t *testing.T) *rainTestEnvironment {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	fakeNode := node.NewFakeNode()
+	w, err := fakeNode.NewWallet(make([]byte, 32))
+	require.NoError(t, err)
+	collectorIndex := uint32(0)
+	collector, err := w.NewAccount(&collectorIndex)
+	require.NoError(t, err)
+	creatorIndex := uint32(1000)
+	creator, err := w.NewAccount(&creatorIndex)
+	require.NoError(t, err)
+	// the funder is not simulated by the fake node, so any address will do
+	funderIndex := uint32(1001)
+	funder, err := w.NewAccount(&funderIndex)
+	require.NoError(t, err)
+
+	collectorAccountQueue := make(chan func(node.Account))
+	go func() {
+		for {
+			select {
+			case f := <-collectorAccountQueue:
+				f(collector)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	env := &rainTestEnvironment{
+		fakeNode:  fakeNode,
+		collector: collector,
+		funder:    funder.Address(),
+		creator:   creator.Address(),
+		chatStore: &fakeChatStore{},
+	}
+	env.db = env.newDatabase()
+	env.ctx = env.db.Context(ctx)
+
+	logger := log.New(io.Discard, "", 0)
+	statsClient, err := statsd.New(statsd.Mute(true))
+	require.NoError(t, err)
+	snowflakeNode, err := snowflake.NewNode(1)
+	require.NoError(t, err)
+	chatManager, err := chatmanager.New(env.ctx, logger, statsClient, env.chatStore, &moderation.StoreNoOp{},
+		blockeduser.NewStoreDatabase(), nil, nil, snowflakeNode, "")
+	require.NoError(t, err)
+
+	refundManager := refundmanager.New(logger, collectorAccountQueue, w.RPC(), nil, nil)
+	env.handler, err = rewards.NewHandler(env.ctx, logger, statsClient,
+		[]rewards.Channel{{ID: types.MainBroadcastChannelID}, {ID: "other"}},
+		nil, nil, refundManager, w, collectorAccountQueue, chatManager, nil, notificationmanager.NewManager(), nil,
+		&moderation.StoreNoOp{}, nil, nil, nil)
+	require.NoError(t, err)
+	return env
+}
+
+func (env *rainTestEnvironment) newDatabase() *transactiontest.FakeDatabase[*rainDatabase] {
+	type statement = transactiontest.FakeStatement
+	type result = transactiontest.FakeResult
+
+	db := transactiontest.NewFakeDatabase((&rainDatabase{}).clone(), (*rainDatabase).clone)
+	db.Handle(`^SELECT .* FROM reward_distribution_settings `, func(s *rainDatabase, stmt *statement) (*result, error) {
+		return nil, nil
+	})
+	db.Handle(`^INSERT INTO scheduled_rain `, func(s *rainDatabase, stmt *statement) (*result, error) {
+		for _, row := range stmt.InsertedRows() {
+			row["chat_window"] = postgresInterval(row["chat_window"])
+			s.rains[row["id"].(string)] = row
+		}
+		return nil, nil
+	})
+	db.Handle(`^SELECT .* FROM scheduled_rain WHERE scheduled_rain.id IN \(`, func(s *rainDatabase, stmt *statement) (*result, error) {
+		r := &result{}
+		for _, id := range stmt.Args {
+			if rain, present := s.rains[id.(string)]; present {
+				r.Rows = append(r.Rows, stmt.Row(rain))
+			}
+		}
+		return r, nil
+	})
+	db.Handle(`^INSERT INTO reward_balance `, func(s *rainDatabase, stmt *statement) (*result, error) {
+		r := &result{Columns: []string{"rewards_address", "balance", "updated_at"}}
+		for _, row := range stmt.InsertedRows() {
+			address := row["rewards_address"].(string)
+			s.rewardBalances[address] = s.rewardBalances[address].Add(decimal.RequireFromString(row["balance"].(string)))
+			r.Rows = append(r.Rows, []any{address, s.rewardBalances[address], row["updated_at"]})
+		}
+		return r, nil
+	})
+	db.Handle(`^INSERT INTO received_reward `, func(s *rainDatabase, stmt *statement) (*result, error) {
+		s.receivedRewards = append(s.receivedRewards, stmt.InsertedRows()...)
+		return nil, nil
+	})
+	db.Handle(`^INSERT INTO crowdfunded_transaction `, func(s *rainDatabase, stmt *statement) (*result, error) {
+		if env.failCrowdfundedTransactions {
+			return nil, errors.New("connection reset by peer")
+		}
+		s.crowdfundedTxs = append(s.crowdfundedTxs, stmt.InsertedRows()...)
+		return nil, nil
+	})
+	db.Handle(`^INSERT INTO journal_entry `, func(s *rainDatabase, stmt *statement) (*result, error) {
+		for _, row := range stmt.InsertedRows() {
+			if reference, ok := row["reference"].(string); ok {
+				s.journalReferences = append(s.journalReferences, reference)
+			}
+		}
+		return nil, nil
+	})
+	db.Handle(`^INSERT INTO journal_line `, func(s *rainDatabase, stmt *statement) (*result, error) {
+		return nil, nil
+	})
+	db.Handle(`^SELECT EXISTS \(SELECT 1 FROM journal_entry WHERE reference = \$1\)$`, func(s *rainDatabase, stmt *statement) (*result, error) {
+		for _, reference := range s.journalReferences {
+			if reference == stmt.Args[0] {
+				return &result{Rows: [][]any{{true}}}, nil
+			}
+		}
+		return &result{Rows: [][]any{{false}}}, nil
+	})
+	db.Handle(`^INSERT INTO refund `, func(s *rainDatabase, stmt *statement) (*result, error) {
+		for _, row := range stmt.InsertedRows() {
+			s.refunds[row["id"].(string)] = row
+		}
+		return nil, nil
+	})
+	db.Handle(`^SELECT .* FROM refund WHERE refund.id IN \(`, func(s *rainDatabase, stmt *statement) (*result, error) {
+		r := &result{}
+		for _, id := range stmt.Args {
+			if refund, present := s.refunds[id.(string)]; present {
+				r.Rows = append(r.Rows, stmt.Row(refund))
+			}
+		}
+		return r, nil
+	})
+	return db
+}
+
+func (env *rainTestEnvironment) scheduleRain(t *testing.T, params rewards.ScheduledRainParams) *types.ScheduledRain {
+	params.ScheduledFor = time.Now()
+	if params.CreatedBy == "" {
+		params.CreatedBy = env.creator
+	}
+	rain, err := env.handler.ScheduleRain(env.ctx, params)
+	require.NoError(t, err)
+	return rain
+}
+
+func (env *rainTestEnvironment) fundCollector(t *testing.T, amount payment.Amount) {
+	_, err := env.fakeNode.Fund(env.funder, env.collector.Address(), amount.Int)
+	require.NoError(t, err)
+	require.NoError(t, env.collector.ReceivePendings(big.NewInt(0)))
+}
+
+func requireDecimalUnits(t *testing.T, expected int64, value any) {
+	var d decimal.Decimal
+	switch v := value.(type) {
+	case decimal.Decimal:
+		d = v
+	case string:
+		d = decimal.RequireFromString(v)
+	default:
+		require.Failf(t, "unexpected value type", "%T", value)
+	}
+	require.True(t, units(expected).Decimal().Equal(d), "expected %d units, got %v", expected, d)
+}
+
+func TestScheduledRainDistributedAllAtOnce(t *testing.T) {
+	env := newRainTestEnvironment(t)
+	rain := env.scheduleRain(t, rewards.ScheduledRainParams{
+		ChannelID: types.MainBroadcastChannelID,
+		Amount:    units(100),
+		Audience:  types.ScheduledRainAudienceSpectators,
+	})
+
+	require.NoError(t, env.handler.DistributeScheduledRain(env.ctx, rain.ID, "media1", "ban_1alice", "ban_1bob", "ban_1carol"))
+
+	// what can't be split evenly stays in the collector account
+	state := env.db.State()
+	for _, address := range []string{"ban_1alice", "ban_1bob", "ban_1carol"} {
+		requireDecimalUnits(t, 33, state.rewardBalances[address])
+	}
+	require.Len(t, state.receivedRewards, 3)
+	for _, reward := range state.receivedRewards {
+		requireDecimalUnits(t, 33, reward["amount"])
+		require.Equal(t, "media1", reward["media"])
+		require.EqualValues(t, types.RewardDistributionStrategyScheduledRain, reward["distribution_strategy"])
+	}
+
+	require.Len(t, state.crowdfundedTxs, 1)
+	tx := state.crowdfundedTxs[0]
+	require.Equal(t, rain.ID+":1", tx["tx_hash"])
+	require.Equal(t, env.creator, tx["from_address"])
+	requireDecimalUnits(t, 99, tx["amount"])
+	require.EqualValues(t, types.CrowdfundedTransactionTypeScheduledRain, tx["transaction_type"])
+	require.Equal(t, "media1", tx["for_media"])
+	require.Equal(t, types.MainBroadcastChannelID, tx["channel_id"])
+	require.Contains(t, state.journalReferences, rain.ID+":1")
+
+	stored := state.rains[rain.ID]
+	requireDecimalUnits(t, 99, stored["distributed_amount"])
+	require.EqualValues(t, 1, stored["media_distributed"])
+	require.EqualValues(t, types.ScheduledRainStatusComplete, stored["status"])
+
+	// rains on the main channel are announced in chat
+	systemMessages := env.chatStore.systemMessages()
+	require.Len(t, systemMessages, 1)
+	require.Contains(t, systemMessages[0], "**0.99 BAN**")
+	require.Contains(t, systemMessages[0], "among 3 spectators")
+
+	// complete rains are not distributed again
+	require.NoError(t, env.handler.DistributeScheduledRain(env.ctx, rain.ID, "media2", "ban_1alice"))
+	state = env.db.State()
+	requireDecimalUnits(t, 33, state.rewardBalances["ban_1alice"])
+	require.Len(t, state.receivedRewards, 3)
+	require.Len(t, state.crowdfundedTxs, 1)
+
+	_, err := env.handler.CancelScheduledRain(env.ctx, rain.ID, "")
+	require.Error(t, err)
+}
+
+func TestScheduledRainSplitOverMedia(t *testing.T) {
+	env := newRainTestEnvironment(t)
+	rain := env.scheduleRain(t, rewards.ScheduledRainParams{
+		ChannelID:  "other",
+		Amount:     units(100),
+		Audience:   types.ScheduledRainAudienceSpectators,
+		MediaCount: 2,
+	})
+
+	// nothing is distributed while there is no one to distribute to
+	require.NoError(t, env.handler.DistributeScheduledRain(env.ctx, rain.ID, "media1"))
+	state := env.db.State()
+	require.EqualValues(t, 0, state.rains[rain.ID]["media_distributed"])
+	require.Empty(t, state.receivedRewards)
+	require.Empty(t, state.crowdfundedTxs)
+
+	require.NoError(t, env.handler.DistributeScheduledRain(env.ctx, rain.ID, "media2", "ban_1alice", "ban_1bob"))
+	state = env.db.State()
+	requireDecimalUnits(t, 25, state.rewardBalances["ban_1alice"])
+	requireDecimalUnits(t, 25, state.rewardBalances["ban_1bob"])
+	requireDecimalUnits(t, 50, state.rains[rain.ID]["distributed_amount"])
+	require.EqualValues(t, 1, state.rains[rain.ID]["media_distributed"])
+	require.EqualValues(t, types.ScheduledRainStatusScheduled, state.rains[rain.ID]["status"])
+	require.Len(t, state.crowdfundedTxs, 1)
+	require.Equal(t, rain.ID+":1", state.crowdfundedTxs[0]["tx_hash"])
+	require.Equal(t, "media2", state.crowdfundedTxs[0]["for_media"])
+	require.Equal(t, "other", state.crowdfundedTxs[0]["channel_id"])
+
+	// the last share is whatever remains
+	require.NoError(t, env.handler.DistributeScheduledRain(env.ctx, rain.ID, "media3", "ban_1alice", "ban_1bob", "ban_1carol"))
+	state = env.db.State()
+	requireDecimalUnits(t, 41, state.rewardBalances["ban_1alice"])
+	requireDecimalUnits(t, 41, state.rewardBalances["ban_1bob"])
+	requireDecimalUnits(t, 16, state.rewardBalances["ban_1carol"])
+	requireDecimalUnits(t, 98, state.rains[rain.ID]["distributed_amount"])
+	require.EqualValues(t, 2, state.rains[rain.ID]["media_distributed"])
+	require.EqualValues(t, types.ScheduledRainStatusComplete, state.rains[rain.ID]["status"])
+	require.Len(t, state.receivedRewards, 5)
+	require.Len(t, state.crowdfundedTxs, 2)
+	require.Equal(t, rain.ID+":2", state.crowdfundedTxs[1]["tx_hash"])
+	requireDecimalUnits(t, 48, state.crowdfundedTxs[1]["amount"])
+	require.Equal(t, "media3", state.crowdfundedTxs[1]["for_media"])
+	require.Subset(t, state.journalReferences, []string{rain.ID + ":1", rain.ID + ":2"})
+
+	// only rains on the main channel are announced in chat
+	require.Empty(t, env.chatStore.systemMessages())
+}
+
+func TestScheduledRainRewardsRandomSubsetWhenShareIsTooSmall(t *testing.T) {
+	env := newRainTestEnvironment(t)
+	rain := env.scheduleRain(t, rewards.ScheduledRainParams{
+		ChannelID: "other",
+		Amount:    units(2),
+		Audience:  types.ScheduledRainAudienceSpectators,
+	})
+
+	eligible := []string{"ban_1alice", "ban_1bob", "ban_1carol", "ban_1dave", "ban_1erin"}
+	require.NoError(t, env.handler.DistributeScheduledRain(env.ctx, rain.ID, "media1", eligible...))
+
+	state := env.db.State()
+	require.Len(t, state.rewardBalances, 2)
+	for address, balance := range state.rewardBalances {
+		require.Contains(t, eligible, address)
+		requireDecimalUnits(t, 1, balance)
+	}
+	require.Len(t, state.receivedRewards, 2)
+	requireDecimalUnits(t, 2, state.crowdfundedTxs[0]["amount"])
+	require.EqualValues(t, types.ScheduledRainStatusComplete, state.rains[rain.ID]["status"])
+}
+
+func TestScheduledRainForChatters(t *testing.T) {
+	env := newRainTestEnvironment(t)
+	rain := env.scheduleRain(t, rewards.ScheduledRainParams{
+		ChannelID:  "other",
+		Amount:     units(100),
+		Audience:   types.ScheduledRainAudienceChatters,
+		ChatWindow: 10 * time.Minute,
+	})
+
+	now := time.Now()
+	for _, m := range []*chat.Message{
+		{CreatedAt: now.Add(-20 * time.Minute), Author: auth.NewAddressOnlyUser("ban_1bob")}, // outside the chat window
+		{CreatedAt: now.Add(-5 * time.Minute), Author: auth.NewAddressOnlyUser("ban_1alice")},
+		{CreatedAt: now.Add(-4 * time.Minute), Author: auth.NewAddressOnlyUser("ban_1alice")},
+		{CreatedAt: now.Add(-3 * time.Minute), Author: auth.NewAddressOnlyUser("ban_1carol"), Shadowbanned: true},
+		{CreatedAt: now.Add(-2 * time.Minute), Author: auth.NewAddressOnlyUser("ban_1dave")}, // not eligible
+		{CreatedAt: now.Add(-1 * time.Minute), Author: auth.NewAddressOnlyUser("ban_1erin")},
+		{CreatedAt: now.Add(-1 * time.Minute)}, // system message
+	} {
+		_, err := env.chatStore.StoreMessage(env.ctx, m)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, env.handler.DistributeScheduledRain(env.ctx, rain.ID, "media1", "ban_1alice", "ban_1bob", "ban_1carol", "ban_1erin"))
+
+	state := env.db.State()
+	require.Len(t, state.rewardBalances, 2)
+	requireDecimalUnits(t, 50, state.rewardBalances["ban_1alice"])
+	requireDecimalUnits(t, 50, state.rewardBalances["ban_1erin"])
+	require.Len(t, state.receivedRewards, 2)
+	requireDecimalUnits(t, 100, state.crowdfundedTxs[0]["amount"])
+}
+
+func TestScheduledRainDistributionFailure(t *testing.T) {
+	env := newRainTestEnvironment(t)
+	rain := env.scheduleRain(t, rewards.ScheduledRainParams{
+		ChannelID:  types.MainBroadcastChannelID,
+		Amount:     units(100),
+		Audience:   types.ScheduledRainAudienceSpectators,
+		MediaCount: 2,
+	})
+
+	env.failCrowdfundedTransactions = true
+	require.Error(t, env.handler.DistributeScheduledRain(env.ctx, rain.ID, "media1", "ban_1alice", "ban_1bob"))
+
+	// nothing is credited and the share remains to be distributed
+	state := env.db.State()
+	require.Empty(t, state.rewardBalances)
+	require.Empty(t, state.receivedRewards)
+	require.Empty(t, state.journalReferences)
+	requireDecimalUnits(t, 0, state.rains[rain.ID]["distributed_amount"])
+	require.EqualValues(t, 0, state.rains[rain.ID]["media_distributed"])
+	require.Empty(t, env.chatStore.systemMessages())
+
+	env.failCrowdfundedTransactions = false
+	require.NoError(t, env.handler.DistributeScheduledRain(env.ctx, rain.ID, "media2", "ban_1alice", "ban_1bob"))
+	state = env.db.State()
+	requireDecimalUnits(t, 25, state.rewardBalances["ban_1alice"])
+	require.Len(t, state.crowdfundedTxs, 1)
+	require.Equal(t, rain.ID+":1", state.crowdfundedTxs[0]["tx_hash"])
+	require.Equal(t, "media2", state.crowdfundedTxs[0]["for_media"])
+}
+
+func TestCancelScheduledRainRefundsApplication(t *testing.T) {
+	env := newRainTestEnvironment(t)
+	env.fundCollector(t, units(100))
+	rain := env.scheduleRain(t, rewards.ScheduledRainParams{
+		ChannelID:     "other",
+		Amount:        units(100),
+		Audience:      types.ScheduledRainAudienceSpectators,
+		MediaCount:    2,
+		ApplicationID: "app",
+	})
+	require.NoError(t, env.handler.DistributeScheduledRain(env.ctx, rain.ID, "media1", "ban_1alice", "ban_1bob"))
+
+	// applications can only cancel their own rains
+	_, err := env.handler.CancelScheduledRain(env.ctx, rain.ID, "otherapp")
+	require.ErrorIs(t, err, rewards.ErrScheduledRainNotFound)
+
+	cancelled, err := env.handler.CancelScheduledRain(env.ctx, rain.ID, "app")
+	require.NoError(t, err)
+	require.Equal(t, types.ScheduledRainStatusCancelled, cancelled.Status)
+
+	// what was not distributed yet goes back to the application wallet
+	require.Zero(t, units(50).Cmp(env.fakeNode.Receivable(env.creator)))
+	state := env.db.State()
+	require.EqualValues(t, types.ScheduledRainStatusCancelled, state.rains[rain.ID]["status"])
+	require.Len(t, state.refunds, 1)
+	for _, refund := range state.refunds {
+		require.Equal(t, env.creator, refund["address"])
+		requireDecimalUnits(t, 50, refund["amount"])
+		require.EqualValues(t, types.RefundReasonCancelledRain, refund["reason"])
+		require.EqualValues(t, types.RefundStatusSent, refund["status"])
+	}
+
+	// cancelled rains are no longer distributed
+	require.NoError(t, env.handler.DistributeScheduledRain(env.ctx, rain.ID, "media2", "ban_1alice", "ban_1bob"))
+	state = env.db.State()
+	requireDecimalUnits(t, 25, state.rewardBalances["ban_1alice"])
+	require.Len(t, state.crowdfundedTxs, 1)
+
+	_, err = env.handler.CancelScheduledRain(env.ctx, rain.ID, "app")
+	require.Error(t, err)
+	require.Len(t, env.db.State().refunds, 1)
+}
+
+func TestCancelScheduledRainRefundFailure(t *testing.T) {
+	env := newRainTestEnvironment(t)
+	rain := env.scheduleRain(t, rewards.ScheduledRainParams{
+		ChannelID:     "other",
+		Amount:        units(100),
+		Audience:      types.ScheduledRainAudienceSpectators,
+		ApplicationID: "app",
+	})
+
+	// the collector account doesn't have the funds to refund the rain, so the refund is left for an admin to retry
+	cancelled, err := env.handler.CancelScheduledRain(env.ctx, rain.ID, "app")
+	require.NoError(t, err)
+	require.Equal(t, types.ScheduledRainStatusCancelled, cancelled.Status)
+	require.Zero(t, env.fakeNode.Receivable(env.creator).Int64())
+
+	state := env.db.State()
+	require.Len(t, state.refunds, 1)
+	for _, refund := range state.refunds {
+		requireDecimalUnits(t, 100, refund["amount"])
+		require.EqualValues(t, types.RefundStatusFailed, refund["status"])
+	}
+}
+
+func TestCancelScheduledRainOfStaff(t *testing.T) {
+	env := newRainTestEnvironment(t)
+	env.fundCollector(t, units(100))
+	rain := env.scheduleRain(t, rewards.ScheduledRainParams{
+		ChannelID: "other",
+		Amount:    units(100),
+		Audience:  types.ScheduledRainAudienceSpectators,
+	})
+
+	// rains scheduled by staff can't be cancelled by applications, and are not refunded
+	_, err := env.handler.CancelScheduledRain(env.ctx, rain.ID, "app")
+	require.ErrorIs(t, err, rewards.ErrScheduledRainNotFound)
+
+	_, err = env.handler.CancelScheduledRain(env.ctx, rain.ID, "")
+	require.NoError(t, err)
+	require.Zero(t, env.fakeNode.Receivable(env.creator).Int64())
+	require.Empty(t, env.db.State().refunds)
+	require.EqualValues(t, types.ScheduledRainStatusCancelled, env.db.State().rains[rain.ID]["status"])
+}
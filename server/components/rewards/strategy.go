@@ -0,0 +1,134 @@
+package rewards
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/types"
+)
+
+// DistributionRecipient contains the information about an eligible spectator that distribution strategies can take
+// into account
+type DistributionRecipient struct {
+	Address             string
+	WatchedFor          time.Duration // how long the spectator watched the media for, at most the time it played for
+	SolvedChallenge     bool          // whether the spectator solved an activity challenge while the media played
+	SolvedHardChallenge bool          // whether the spectator solved a captcha while the media played
+	ParticipatedInChat  bool          // whether the spectator recently sent meaningful chat messages
+}
+
+// DistributionStrategy decides how the reward budget for a queue entry is split among the eligible spectators
+type DistributionStrategy interface {
+	// Name identifies the strategy in the received rewards it produces
+	Name() types.RewardDistributionStrategy
+	// Distribute returns the amount each recipient should receive, in the same order as recipients.
+	// The amounts must not add up to more than budget. recipients is never empty
+	Distribute(budget payment.Amount, recipients []DistributionRecipient) []payment.Amount
+}
+
+// EqualDistributionStrategy splits the budget equally among all eligible spectators
+type EqualDistributionStrategy struct{}
+
+// Name implements DistributionStrategy
+func (EqualDistributionStrategy) Name() types.RewardDistributionStrategy {
+	return types.RewardDistributionStrategyEqual
+}
+
+// Distribute implements DistributionStrategy
+func (EqualDistributionStrategy) Distribute(budget payment.Amount, recipients []DistributionRecipient) []payment.Amount {
+	amountForEach := ComputeReward(budget, len(recipients))
+	amounts := make([]payment.Amount, len(recipients))
+	for i := range amounts {
+		amounts[i] = amountForEach
+	}
+	return amounts
+}
+
+// WatchTimeDistributionStrategy splits the budget in proportion to how long each spectator watched the media for
+type WatchTimeDistributionStrategy struct{}
+
+// Name implements DistributionStrategy
+func (WatchTimeDistributionStrategy) Name() types.RewardDistributionStrategy {
+	return types.RewardDistributionStrategyWatchTime
+}
+
+// Distribute implements DistributionStrategy
+func (WatchTimeDistributionStrategy) Distribute(budget payment.Amount, recipients []DistributionRecipient) []payment.Amount {
+	weights := make([]int64, len(recipients))
+	for i, recipient := range recipients {
+		weights[i] = recipient.WatchedFor.Milliseconds()
+	}
+	return splitByWeight(budget, weights)
+}
+
+// activityTierWeights are the weights of each activity tier. The tier of a spectator is the number of activity
+// criteria they meet, so the most active spectators receive 75% more than the least active ones
+var activityTierWeights = []int64{4, 5, 6, 7}
+
+// ActivityTierDistributionStrategy splits the budget according to the activity tier of each spectator, which is
+// determined by whether they solved activity challenges and captchas while the media played, and whether they have
+// been participating in chat
+type ActivityTierDistributionStrategy struct{}
+
+// Name implements DistributionStrategy
+func (ActivityTierDistributionStrategy) Name() types.RewardDistributionStrategy {
+	return types.RewardDistributionStrategyActivityTier
+}
+
+// Distribute implements DistributionStrategy
+func (ActivityTierDistributionStrategy) Distribute(budget payment.Amount, recipients []DistributionRecipient) []payment.Amount {
+	weights := make([]int64, len(recipients))
+	for i, recipient := range recipients {
+		tier := 0
+		for _, criterion := range []bool{recipient.SolvedChallenge, recipient.SolvedHardChallenge, recipient.ParticipatedInChat} {
+			if criterion {
+				tier++
+			}
+		}
+		weights[i] = activityTierWeights[tier]
+	}
+	return splitByWeight(budget, weights)
+}
+
+// distributionStrategyFromPersisted returns the strategy with the given name.
+// Strategies that can't be assigned to a channel are replaced by EqualDistributionStrategy, the default strategy
+func distributionStrategyFromPersisted(name types.RewardDistributionStrategy) DistributionStrategy {
+	switch name {
+	case types.RewardDistributionStrategyWatchTime:
+		return WatchTimeDistributionStrategy{}
+	case types.RewardDistributionStrategyActivityTier:
+		return ActivityTierDistributionStrategy{}
+	default:
+		return EqualDistributionStrategy{}
+	}
+}
+
+// splitByWeight splits budget in proportion to weights, rounding each amount down to prevent dust amounts.
+// If all weights are zero, the budget is split equally
+func splitByWeight(budget payment.Amount, weights []int64) []payment.Amount {
+	totalWeight := big.NewInt(0)
+	for _, weight := range weights {
+		totalWeight.Add(totalWeight, big.NewInt(weight))
+	}
+
+	amounts := make([]payment.Amount, len(weights))
+	if totalWeight.Sign() <= 0 {
+		amountForEach := ComputeReward(budget, len(weights))
+		for i := range amounts {
+			amounts[i] = amountForEach
+		}
+		return amounts
+	}
+
+	for i, weight := range weights {
+		amount := payment.NewAmount()
+		amount.Mul(budget.Int, big.NewInt(weight))
+		amount.Div(amount.Int, totalWeight)
+		amount.Div(amount.Int, pricer.RewardRoundingFactor)
+		amount.Mul(amount.Int, pricer.RewardRoundingFactor)
+		amounts[i] = amount
+	}
+	return amounts
+}
@@ -0,0 +1,58 @@
+package rewards_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/server/components/rewards"
+
+	"github.com/stretchr/testify/require"
+)
+
+// units returns an amount equal to n times the reward rounding factor
+func units(n int64) payment.Amount {
+	return payment.NewAmount(new(big.Int).Mul(big.NewInt(n), pricer.RewardRoundingFactor))
+}
+
+func requireAmounts(t *testing.T, expected []int64, amounts []payment.Amount) {
+	require.Len(t, amounts, len(expected))
+	for i := range expected {
+		require.Zero(t, units(expected[i]).Cmp(amounts[i].Int), "amount %d: expected %d units, got %v", i, expected[i], amounts[i])
+	}
+}
+
+func TestEqualDistributionStrategy(t *testing.T) {
+	recipients := []rewards.DistributionRecipient{
+		{Address: "a", WatchedFor: time.Minute},
+		{Address: "b", WatchedFor: 3 * time.Minute},
+		{Address: "c", WatchedFor: 2 * time.Minute},
+	}
+	// remainders are rounded down
+	requireAmounts(t, []int64{33, 33, 33}, rewards.EqualDistributionStrategy{}.Distribute(units(100), recipients))
+}
+
+func TestWatchTimeDistributionStrategy(t *testing.T) {
+	recipients := []rewards.DistributionRecipient{
+		{Address: "a", WatchedFor: time.Minute},
+		{Address: "b", WatchedFor: 3 * time.Minute},
+		{Address: "c", WatchedFor: 0},
+	}
+	requireAmounts(t, []int64{25, 75, 0}, rewards.WatchTimeDistributionStrategy{}.Distribute(units(100), recipients))
+
+	// with no watch time information, the budget is split equally
+	recipients = []rewards.DistributionRecipient{{Address: "a"}, {Address: "b"}}
+	requireAmounts(t, []int64{50, 50}, rewards.WatchTimeDistributionStrategy{}.Distribute(units(100), recipients))
+}
+
+func TestActivityTierDistributionStrategy(t *testing.T) {
+	recipients := []rewards.DistributionRecipient{
+		{Address: "a"},
+		{Address: "b", SolvedChallenge: true},
+		{Address: "c", SolvedChallenge: true, ParticipatedInChat: true},
+		{Address: "d", SolvedChallenge: true, SolvedHardChallenge: true, ParticipatedInChat: true},
+	}
+	requireAmounts(t, []int64{90, 113, 136, 159}, rewards.ActivityTierDistributionStrategy{}.Distribute(units(500), recipients))
+}
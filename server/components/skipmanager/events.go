@@ -28,6 +28,12 @@ func (s *Manager) CrowdfundedSkip() event.Event[payment.Amount] {
 	return s.crowdfundedSkip
 }
 
+// SkipVoteSucceeded is the event that is fired when the community skips a track by voting.
+// The number of votes cast is sent as the argument
+func (s *Manager) SkipVoteSucceeded() event.Event[int] {
+	return s.skipVoteSucceeded
+}
+
 // CrowdfundedTransactionReceived is the event that is fired when a community skipping or community tipping transaction is received
 func (s *Manager) CrowdfundedTransactionReceived() event.Event[*types.CrowdfundedTransaction] {
 	return s.crowdfundedTransactionReceived
@@ -2,6 +2,7 @@ package skipmanager
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"math"
 	"math/big"
@@ -9,12 +10,12 @@ import (
 	"time"
 
 	"github.com/hectorchu/gonano/rpc"
-	"github.com/hectorchu/gonano/wallet"
 	"github.com/palantir/stacktrace"
 	"github.com/patrickmn/go-cache"
 	"github.com/shopspring/decimal"
 	"github.com/tnyim/jungletv/proto"
 	"github.com/tnyim/jungletv/server/components/mediaqueue"
+	"github.com/tnyim/jungletv/server/components/node"
 	"github.com/tnyim/jungletv/server/components/payment"
 	"github.com/tnyim/jungletv/server/components/pricer"
 	"github.com/tnyim/jungletv/server/media"
@@ -24,17 +25,22 @@ import (
 )
 
 var NoSkipPeriodBeforeMediaEnd = 30 * time.Second
+var NoSkipPeriodAfterStartup = 30 * time.Second
+var NoSkipPeriodAfterMediaStart = 10 * time.Second
 
 // Manager manages skipping and tipping
 type Manager struct {
 	log                     *log.Logger
-	rpc                     rpc.Client
-	skipAccount             *wallet.Account
-	rainAccount             *wallet.Account
+	rpc                     node.RPC
+	skipAccount             node.Account
+	rainAccount             node.Account
 	collectorAccountAddress string
 	mediaQueue              *mediaqueue.MediaQueue
 	pricer                  *pricer.Pricer
 
+	nativeCurrencies   []*nativeCurrencyAccounts
+	exchangeRateSource payment.ExchangeRateSource
+
 	accountMovementLock sync.Mutex
 	cachedSkipBalance   payment.Amount
 	cachedRainBalance   payment.Amount
@@ -45,6 +51,8 @@ type Manager struct {
 	minSkipThreshold       payment.Amount
 	skipThresholdChangedBy payment.Amount
 	skipThresholdLowerable bool
+	skipVotes              map[string]struct{} // addresses of the users who voted to skip the current media
+	eligibleEstimator      pricer.EligibleSpectatorsEstimator
 
 	rainedByRequester          payment.Amount
 	currentMediaID             *string
@@ -56,16 +64,28 @@ type Manager struct {
 	statusUpdated                          event.Event[SkipStatusUpdatedEventArgs]
 	skipThresholdReductionMilestoneReached event.Event[float64]
 	crowdfundedSkip                        event.Event[payment.Amount]
+	skipVoteSucceeded                      event.Event[int]
 	crowdfundedTransactionReceived         event.Event[*types.CrowdfundedTransaction]
 
 	recentCrowdfundedSkips *cache.Cache[string, struct{}]
 }
 
+// nativeCurrencyAccounts are the skip and rain accounts of a currency that is received directly, rather than through
+// swaps to Banano
+type nativeCurrencyAccounts struct {
+	currency                payment.Currency
+	rpc                     node.RPC
+	skipAccount             node.Account
+	rainAccount             node.Account
+	collectorAccountAddress string
+	dustThreshold           *big.Int
+}
+
 // New returns an initialized skip manager
 func New(log *log.Logger,
-	rpc rpc.Client,
-	skipAccount *wallet.Account,
-	rainAccount *wallet.Account,
+	rpc node.RPC,
+	skipAccount node.Account,
+	rainAccount node.Account,
 	collectorAccountAddress string,
 	mediaQueue *mediaqueue.MediaQueue,
 	pricer *pricer.Pricer,
@@ -81,19 +101,44 @@ func New(log *log.Logger,
 		statusUpdated:                          event.New[SkipStatusUpdatedEventArgs](),
 		skipThresholdReductionMilestoneReached: event.New[float64](),
 		crowdfundedSkip:                        event.New[payment.Amount](),
+		skipVoteSucceeded:                      event.New[int](),
 		cachedSkipBalance:                      payment.NewAmount(),
 		cachedRainBalance:                      payment.NewAmount(),
 		originalSkipThreshold:                  payment.NewAmount(),
 		currentSkipThreshold:                   payment.NewAmount(),
 		minSkipThreshold:                       payment.NewAmount(),
 		skipThresholdChangedBy:                 payment.NewAmount(),
+		skipVotes:                              make(map[string]struct{}),
 		rainedByRequester:                      payment.NewAmount(),
 		skippingEnabled:                        true,
 		crowdfundedTransactionReceived:         event.New[*types.CrowdfundedTransaction](),
 		recentCrowdfundedSkips:                 cache.New[string, struct{}](30*time.Minute, 15*time.Minute),
+		exchangeRateSource:                     payment.FixedExchangeRateSource{},
 	}
 }
 
+// AddNativeCurrency makes the manager also accept skipping and tipping in the specified currency, through a separate
+// pair of accounts. Amounts received in the currency count towards the skip threshold and the rain by their value in
+// Banano, and are sent to a collector account of the same currency.
+// Must be called before the workers are started
+func (s *Manager) AddNativeCurrency(currency payment.Currency, rpc node.RPC, skipAccount, rainAccount node.Account,
+	collectorAccountAddress string, dustThreshold *big.Int) {
+	s.nativeCurrencies = append(s.nativeCurrencies, &nativeCurrencyAccounts{
+		currency:                currency,
+		rpc:                     rpc,
+		skipAccount:             skipAccount,
+		rainAccount:             rainAccount,
+		collectorAccountAddress: collectorAccountAddress,
+		dustThreshold:           dustThreshold,
+	})
+}
+
+// SetExchangeRateSource sets the source of the rates used to value amounts received in native currencies.
+// Must be called before the workers are started
+func (s *Manager) SetExchangeRateSource(source payment.ExchangeRateSource) {
+	s.exchangeRateSource = source
+}
+
 func (s *Manager) Worker(ctx context.Context) error {
 	onMediaChanged, mediaChangedU := s.mediaQueue.MediaChanged().Subscribe(event.BufferFirst)
 	defer mediaChangedU()
@@ -103,7 +148,7 @@ func (s *Manager) Worker(ctx context.Context) error {
 
 	s.UpdateSkipThreshold(false)
 
-	startupNoSkipTimer := time.NewTimer(30 * time.Second)
+	startupNoSkipTimer := time.NewTimer(NoSkipPeriodAfterStartup)
 	defer startupNoSkipTimer.Stop()
 	mediaStartTimer := time.NewTimer(time.Duration(math.MaxInt64))
 	defer mediaStartTimer.Stop()
@@ -118,7 +163,7 @@ func (s *Manager) Worker(ctx context.Context) error {
 				s.currentMediaRequester = nil
 				mediaStartTimer.Reset(time.Duration(math.MaxInt64))
 			} else {
-				mediaStartTimer.Reset(10 * time.Second)
+				mediaStartTimer.Reset(NoSkipPeriodAfterMediaStart)
 				id := entry.PerformanceID()
 				s.currentMediaID = &id
 				if entry.RequestedBy() != nil && !entry.RequestedBy().IsUnknown() {
@@ -176,7 +221,7 @@ func (s *Manager) retroactivelyUpdateForMedia(ctxCtx context.Context, mediaID st
 	}
 	defer ctx.Rollback()
 
-	err = types.SetMediaOfCrowdfundedTransactionsWithoutMedia(ctx, mediaID)
+	err = types.SetMediaOfCrowdfundedTransactionsWithoutMedia(ctx, s.mediaQueue.ChannelID(), mediaID)
 	if err != nil {
 		return stacktrace.Propagate(err, "")
 	}
@@ -203,30 +248,22 @@ func (s *Manager) computeMediaEndTimer() *time.Timer {
 func (s *Manager) checkBalances(ctx context.Context) error {
 	s.accountMovementLock.Lock()
 	defer s.accountMovementLock.Unlock()
-	_, err := s.receiveAndRegisterPendings(ctx, s.skipAccount, types.CrowdfundedTransactionTypeSkip, s.currentMediaID, s.currentMediaRequester)
+	homeCurrencyRate := decimal.NewFromInt(1)
+	_, err := s.receiveAndRegisterPendings(ctx, s.skipAccount, payment.HomeCurrency, homeCurrencyRate, pricer.DustThreshold,
+		types.CrowdfundedTransactionTypeSkip, s.currentMediaID, s.currentMediaRequester)
 	if err != nil {
 		return stacktrace.Propagate(err, "failed to receive pendings in skip account")
 	}
 
 	oldSkipBalance := s.cachedSkipBalance
-	skipBalance, _, err := s.skipAccount.Balance()
+	skipBalance, err := s.skipAccountBalance(s.rpc, s.skipAccount)
 	if err != nil {
-		return stacktrace.Propagate(err, "failed to get balance of skip account")
-	}
-	if skipBalance.Cmp(big.NewInt(0)) > 0 {
-		accountInfo, err := s.rpc.AccountInfo(s.skipAccount.Address())
-		if err != nil {
-			return stacktrace.Propagate(err, "failed to get account info of skip account")
-		}
-		if accountInfo.Balance.Int.Cmp(skipBalance) < 0 {
-			// if the unconfirmed balance is lower, prefer that
-			// ensures we don't count yet-unconfirmed balance after account emptying towards the next video
-			skipBalance = &accountInfo.Balance.Int
-		}
+		return stacktrace.Propagate(err, "")
 	}
 	s.cachedSkipBalance = payment.NewAmount(skipBalance)
 
-	rainedByRequester, err := s.receiveAndRegisterPendings(ctx, s.rainAccount, types.CrowdfundedTransactionTypeRain, s.currentMediaID, s.currentMediaRequester)
+	rainedByRequester, err := s.receiveAndRegisterPendings(ctx, s.rainAccount, payment.HomeCurrency, homeCurrencyRate, pricer.DustThreshold,
+		types.CrowdfundedTransactionTypeRain, s.currentMediaID, s.currentMediaRequester)
 	if err != nil {
 		return stacktrace.Propagate(err, "failed to receive pendings in rain account")
 	}
@@ -239,6 +276,18 @@ func (s *Manager) checkBalances(ctx context.Context) error {
 	}
 	s.cachedRainBalance = payment.NewAmount(rainBalance)
 
+	for _, n := range s.nativeCurrencies {
+		nativeSkipBalance, nativeRainBalance, nativeRainedByRequester, err := s.checkNativeCurrencyBalances(ctx, n)
+		if err != nil {
+			// problems with the node of a native currency must not prevent skipping and tipping in the home currency
+			s.log.Printf("failed to check %s skip and rain balances: %v", n.currency, err)
+			continue
+		}
+		s.cachedSkipBalance.Add(s.cachedSkipBalance.Int, nativeSkipBalance.Int)
+		s.cachedRainBalance.Add(s.cachedRainBalance.Int, nativeRainBalance.Int)
+		s.rainedByRequester.Add(s.rainedByRequester.Int, nativeRainedByRequester.Int)
+	}
+
 	skipStatus := s.SkipAccountStatus()
 	if oldSkipBalance.Cmp(s.cachedSkipBalance.Int) != 0 || oldRainBalance.Cmp(s.cachedRainBalance.Int) != 0 {
 		s.statusUpdated.Notify(SkipStatusUpdatedEventArgs{skipStatus, s.RainAccountStatus()}, false)
@@ -261,6 +310,59 @@ func (s *Manager) checkBalances(ctx context.Context) error {
 	return nil
 }
 
+// skipAccountBalance returns the balance of a skip account, preferring the unconfirmed balance if it is lower
+func (s *Manager) skipAccountBalance(rpc node.RPC, account node.Account) (*big.Int, error) {
+	skipBalance, _, err := account.Balance()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to get balance of skip account")
+	}
+	if skipBalance.Cmp(big.NewInt(0)) > 0 {
+		accountInfo, err := rpc.AccountInfo(account.Address())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "failed to get account info of skip account")
+		}
+		if accountInfo.Balance.Int.Cmp(skipBalance) < 0 {
+			// if the unconfirmed balance is lower, prefer that
+			// ensures we don't count yet-unconfirmed balance after account emptying towards the next video
+			skipBalance = &accountInfo.Balance.Int
+		}
+	}
+	return skipBalance, nil
+}
+
+// checkNativeCurrencyBalances receives the pending amounts in the skip and rain accounts of a native currency and
+// returns their balances, and the amount rained by the media requester, in the home currency
+func (s *Manager) checkNativeCurrencyBalances(ctx context.Context, n *nativeCurrencyAccounts) (payment.Amount, payment.Amount, payment.Amount, error) {
+	rate, err := s.exchangeRateSource.HomeCurrencyPerUnit(ctx, n.currency)
+	if err != nil {
+		return payment.Amount{}, payment.Amount{}, payment.Amount{}, stacktrace.Propagate(err, "")
+	}
+
+	_, err = s.receiveAndRegisterPendings(ctx, n.skipAccount, n.currency, rate, n.dustThreshold,
+		types.CrowdfundedTransactionTypeSkip, s.currentMediaID, s.currentMediaRequester)
+	if err != nil {
+		return payment.Amount{}, payment.Amount{}, payment.Amount{}, stacktrace.Propagate(err, "failed to receive pendings in skip account")
+	}
+	skipBalance, err := s.skipAccountBalance(n.rpc, n.skipAccount)
+	if err != nil {
+		return payment.Amount{}, payment.Amount{}, payment.Amount{}, stacktrace.Propagate(err, "")
+	}
+
+	rainedByRequester, err := s.receiveAndRegisterPendings(ctx, n.rainAccount, n.currency, rate, n.dustThreshold,
+		types.CrowdfundedTransactionTypeRain, s.currentMediaID, s.currentMediaRequester)
+	if err != nil {
+		return payment.Amount{}, payment.Amount{}, payment.Amount{}, stacktrace.Propagate(err, "failed to receive pendings in rain account")
+	}
+	rainBalance, _, err := n.rainAccount.Balance()
+	if err != nil {
+		return payment.Amount{}, payment.Amount{}, payment.Amount{}, stacktrace.Propagate(err, "failed to get balance of rain account")
+	}
+
+	return payment.ConvertToHomeCurrency(payment.NewAmount(skipBalance), n.currency, rate),
+		payment.ConvertToHomeCurrency(payment.NewAmount(rainBalance), n.currency, rate),
+		rainedByRequester, nil
+}
+
 func (s *Manager) computeSkipStatus() proto.SkipStatus {
 	currentEntry, isCurrentlyPlaying := s.mediaQueue.CurrentlyPlaying()
 	if !isCurrentlyPlaying {
@@ -290,15 +392,30 @@ func (s *Manager) EmptySkipAndRainAccounts(ctx context.Context, forMedia string,
 	s.accountMovementLock.Lock()
 	defer s.accountMovementLock.Unlock()
 
-	skipTotal, _, err = s.sendFullBalanceToCollector(ctx, s.skipAccount, types.CrowdfundedTransactionTypeSkip, &forMedia, mediaRequestedBy)
+	homeCurrencyRate := decimal.NewFromInt(1)
+	skipTotal, _, err = s.sendFullBalanceToCollector(ctx, s.rpc, s.skipAccount, s.collectorAccountAddress,
+		payment.HomeCurrency, homeCurrencyRate, pricer.DustThreshold, types.CrowdfundedTransactionTypeSkip, &forMedia, mediaRequestedBy)
 	if err != nil {
 		return payment.NewAmount(), payment.NewAmount(), payment.NewAmount(), stacktrace.Propagate(err, "failed to empty skip account")
 	}
 
-	rainTotal, rainedByRequester, err = s.sendFullBalanceToCollector(ctx, s.rainAccount, types.CrowdfundedTransactionTypeRain, &forMedia, mediaRequestedBy)
+	rainTotal, rainedByRequester, err = s.sendFullBalanceToCollector(ctx, s.rpc, s.rainAccount, s.collectorAccountAddress,
+		payment.HomeCurrency, homeCurrencyRate, pricer.DustThreshold, types.CrowdfundedTransactionTypeRain, &forMedia, mediaRequestedBy)
 	if err != nil {
 		return payment.NewAmount(), payment.NewAmount(), payment.NewAmount(), stacktrace.Propagate(err, "failed to empty rain account")
 	}
+
+	for _, n := range s.nativeCurrencies {
+		nativeSkipTotal, nativeRainTotal, nativeRainedByRequester, err := s.emptyNativeCurrencyAccounts(ctx, n, forMedia, mediaRequestedBy)
+		if err != nil {
+			// whatever remains in the accounts will count towards the next media
+			s.log.Printf("failed to empty %s skip and rain accounts: %v", n.currency, err)
+			continue
+		}
+		skipTotal.Add(skipTotal.Int, nativeSkipTotal.Int)
+		rainTotal.Add(rainTotal.Int, nativeRainTotal.Int)
+		rainedByRequester.Add(rainedByRequester.Int, nativeRainedByRequester.Int)
+	}
 	totalRainedByRequester := payment.NewAmount(rainedByRequester.Int, s.rainedByRequester.Int)
 	s.rainedByRequester = payment.NewAmount()
 
@@ -309,14 +426,40 @@ func (s *Manager) EmptySkipAndRainAccounts(ctx context.Context, forMedia string,
 	return skipTotal, rainTotal, totalRainedByRequester, nil
 }
 
-func (s *Manager) sendFullBalanceToCollector(ctx context.Context, account *wallet.Account, txType types.CrowdfundedTransactionType, forMedia, mediaRequestedBy *string) (payment.Amount, payment.Amount, error) {
-	sentByRequester, err := s.receiveAndRegisterPendings(ctx, account, txType, forMedia, mediaRequestedBy)
+// emptyNativeCurrencyAccounts empties the skip and rain accounts of a native currency and returns the totals that were
+// contained in them, and the amount rained by the media requester, in the home currency
+func (s *Manager) emptyNativeCurrencyAccounts(ctx context.Context, n *nativeCurrencyAccounts, forMedia string, mediaRequestedBy *string) (payment.Amount, payment.Amount, payment.Amount, error) {
+	rate, err := s.exchangeRateSource.HomeCurrencyPerUnit(ctx, n.currency)
+	if err != nil {
+		return payment.Amount{}, payment.Amount{}, payment.Amount{}, stacktrace.Propagate(err, "")
+	}
+
+	skipTotal, _, err := s.sendFullBalanceToCollector(ctx, n.rpc, n.skipAccount, n.collectorAccountAddress,
+		n.currency, rate, n.dustThreshold, types.CrowdfundedTransactionTypeSkip, &forMedia, mediaRequestedBy)
+	if err != nil {
+		return payment.Amount{}, payment.Amount{}, payment.Amount{}, stacktrace.Propagate(err, "failed to empty skip account")
+	}
+
+	rainTotal, rainedByRequester, err := s.sendFullBalanceToCollector(ctx, n.rpc, n.rainAccount, n.collectorAccountAddress,
+		n.currency, rate, n.dustThreshold, types.CrowdfundedTransactionTypeRain, &forMedia, mediaRequestedBy)
+	if err != nil {
+		return payment.Amount{}, payment.Amount{}, payment.Amount{}, stacktrace.Propagate(err, "failed to empty rain account")
+	}
+	return skipTotal, rainTotal, rainedByRequester, nil
+}
+
+// sendFullBalanceToCollector empties account into collectorAccountAddress and returns the total that was contained in
+// it, and the amount sent by the media requester, in the home currency
+func (s *Manager) sendFullBalanceToCollector(ctx context.Context, rpc node.RPC, account node.Account, collectorAccountAddress string,
+	currency payment.Currency, rate decimal.Decimal, dustThreshold *big.Int,
+	txType types.CrowdfundedTransactionType, forMedia, mediaRequestedBy *string) (payment.Amount, payment.Amount, error) {
+	sentByRequester, err := s.receiveAndRegisterPendings(ctx, account, currency, rate, dustThreshold, txType, forMedia, mediaRequestedBy)
 	if err != nil {
 		return payment.NewAmount(), payment.NewAmount(), stacktrace.Propagate(err, "failed to receive pendings in account")
 	}
 
 	// we use AccountInfo instead of balance because we want the balance including unconfirmed blocks
-	info, err := s.rpc.AccountInfo(account.Address())
+	info, err := rpc.AccountInfo(account.Address())
 	if err != nil {
 		return payment.NewAmount(), payment.NewAmount(), stacktrace.Propagate(err, "failed to get balance of account")
 	}
@@ -326,16 +469,41 @@ func (s *Manager) sendFullBalanceToCollector(ctx context.Context, account *walle
 	totalBalance.Add(totalBalance.Int, balance)
 
 	if balance.Cmp(big.NewInt(0)) > 0 {
-		_, err = account.Send(s.collectorAccountAddress, balance)
+		hash, err := account.Send(collectorAccountAddress, balance)
 		if err != nil {
 			return payment.NewAmount(), payment.NewAmount(), stacktrace.Propagate(err, "failed to empty account")
 		}
+		if currency == payment.HomeCurrency {
+			err = s.recordSendToCollector(ctx, account.Address(), collectorAccountAddress, totalBalance, hash)
+			if err != nil {
+				// the funds were already sent, the journal will show a discrepancy
+				s.log.Printf("Failed to record emptying of account %s in the journal: %v", account.Address(), err)
+			}
+		}
 	}
-	return totalBalance, sentByRequester, nil
+	return payment.ConvertToHomeCurrency(totalBalance, currency, rate), sentByRequester, nil
 }
 
-func (s *Manager) receiveAndRegisterPendings(ctxCtx context.Context, account *wallet.Account, txType types.CrowdfundedTransactionType, forMedia *string, mediaRequestedBy *string) (payment.Amount, error) {
-	recvPendings, err := account.ReceiveAndReturnPendings(pricer.DustThreshold)
+func (s *Manager) recordSendToCollector(ctxCtx context.Context, from, collectorAccountAddress string, amount payment.Amount, hash rpc.BlockHash) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	err = types.RecordJournalEntry(ctx, fmt.Sprintf("Account %s emptied into %s", from, collectorAccountAddress), hash.String(),
+		types.JournalTransfer(types.JournalAccountOnChain(from), types.JournalAccountOnChain(collectorAccountAddress), amount.Decimal())...)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// receiveAndRegisterPendings receives the pending amounts in account, which is an account of the specified currency, and
+// registers them as crowdfunded transactions. Returns the amount received from the media requester, in the home currency
+func (s *Manager) receiveAndRegisterPendings(ctxCtx context.Context, account node.Account, currency payment.Currency,
+	rate decimal.Decimal, dustThreshold *big.Int, txType types.CrowdfundedTransactionType, forMedia *string, mediaRequestedBy *string) (payment.Amount, error) {
+	recvPendings, err := account.ReceiveAndReturnPendings(dustThreshold)
 	if err != nil {
 		return payment.NewAmount(), stacktrace.Propagate(err, "")
 	}
@@ -353,19 +521,25 @@ func (s *Manager) receiveAndRegisterPendings(ctxCtx context.Context, account *wa
 	transactions := []*types.CrowdfundedTransaction{}
 	now := time.Now()
 	fromMediaRequester := big.NewInt(0)
+	received := payment.NewAmount()
 	for hash, pending := range recvPendings {
+		received.Add(received.Int, &pending.Amount.Int)
+		amount := payment.ConvertToHomeCurrency(payment.NewAmount(&pending.Amount.Int), currency, rate)
 		tx := &types.CrowdfundedTransaction{
-			TxHash:          hash,
-			FromAddress:     pending.Source,
-			Amount:          decimal.NewFromBigInt(&pending.Amount.Int, 0),
-			ReceivedAt:      now,
-			TransactionType: txType,
-			ForMedia:        forMedia,
+			TxHash:           hash,
+			FromAddress:      pending.Source,
+			Amount:           amount.Decimal(),
+			Currency:         string(currency),
+			AmountInCurrency: decimal.NewFromBigInt(&pending.Amount.Int, 0),
+			ReceivedAt:       now,
+			TransactionType:  txType,
+			ForMedia:         forMedia,
+			ChannelID:        s.mediaQueue.ChannelID(),
 		}
 		transactions = append(transactions, tx)
 		s.crowdfundedTransactionReceived.Notify(tx, true)
 		if mediaRequestedBy != nil && *mediaRequestedBy == pending.Source {
-			fromMediaRequester.Add(fromMediaRequester, &pending.Amount.Int)
+			fromMediaRequester.Add(fromMediaRequester, amount.Int)
 		}
 	}
 
@@ -374,6 +548,14 @@ func (s *Manager) receiveAndRegisterPendings(ctxCtx context.Context, account *wa
 		return payment.NewAmount(), stacktrace.Propagate(err, "")
 	}
 
+	if currency == payment.HomeCurrency {
+		err = types.RecordJournalEntry(ctx, fmt.Sprintf("%d crowdfunded transactions received by %s", len(transactions), account.Address()), "",
+			types.JournalTransfer(types.JournalAccountEquity, types.JournalAccountOnChain(account.Address()), received.Decimal())...)
+		if err != nil {
+			return payment.NewAmount(), stacktrace.Propagate(err, "")
+		}
+	}
+
 	return payment.NewAmount(fromMediaRequester), stacktrace.Propagate(ctx.Commit(), "")
 }
 
@@ -384,6 +566,8 @@ type SkipAccountStatus struct {
 	Balance            payment.Amount
 	Threshold          payment.Amount
 	ThresholdLowerable bool
+	SkipVotes          int
+	SkipVoteThreshold  int
 }
 
 func (s *Manager) SkipAccountStatus() *SkipAccountStatus {
@@ -395,6 +579,8 @@ func (s *Manager) SkipAccountStatus() *SkipAccountStatus {
 		Balance:            s.cachedSkipBalance,
 		Threshold:          s.currentSkipThreshold,
 		ThresholdLowerable: s.skipThresholdLowerable,
+		SkipVotes:          len(s.skipVotes),
+		SkipVoteThreshold:  s.computeSkipVoteThreshold(),
 	}
 }
 
@@ -411,6 +597,26 @@ func (s *Manager) RainAccountStatus() *RainAccountStatus {
 	}
 }
 
+// NativeCurrencyAccountAddresses contains the addresses of the skip and rain accounts of a native currency
+type NativeCurrencyAccountAddresses struct {
+	Currency    payment.Currency
+	SkipAddress string
+	RainAddress string
+}
+
+// NativeCurrencyAccounts returns the addresses of the skip and rain accounts of each native currency
+func (s *Manager) NativeCurrencyAccounts() []NativeCurrencyAccountAddresses {
+	addresses := make([]NativeCurrencyAccountAddresses, len(s.nativeCurrencies))
+	for i, n := range s.nativeCurrencies {
+		addresses[i] = NativeCurrencyAccountAddresses{
+			Currency:    n.currency,
+			SkipAddress: n.skipAccount.Address(),
+			RainAddress: n.rainAccount.Address(),
+		}
+	}
+	return addresses
+}
+
 func (s *Manager) UpdateSkipThreshold(resetChange bool) {
 	defer func() {
 		// start by deferring this since SkipAccountStatus must be called outside of the lock
@@ -422,6 +628,7 @@ func (s *Manager) UpdateSkipThreshold(resetChange bool) {
 
 	if resetChange {
 		s.skipThresholdChangedBy = payment.NewAmount()
+		s.skipVotes = make(map[string]struct{})
 	}
 
 	status := s.computeSkipStatus()
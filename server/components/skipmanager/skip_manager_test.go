@@ -0,0 +1,200 @@
+package skipmanager_test
+
+import (
+	"context"
+	"io"
+	"log"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/mediaqueue"
+	"github.com/tnyim/jungletv/server/components/node"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/server/components/skipmanager"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/server/media/applicationpage"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/event"
+	"github.com/tnyim/jungletv/utils/transaction/transactiontest"
+	"gopkg.in/alexcesaro/statsd.v2"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fixedEstimator int
+
+func (e fixedEstimator) EstimateEligibleSpectators() (int, bool) {
+	return int(e), true
+}
+
+func bananos(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), pricer.BananoUnit)
+}
+
+type testEnvironment struct {
+	node       *node.FakeNode
+	wallet     node.Wallet
+	collector  node.Account
+	mediaQueue *mediaqueue.MediaQueue
+	manager    *skipmanager.Manager
+}
+
+func newTestEnvironment(t *testing.T, ctx context.Context) *testEnvironment {
+	skipmanager.NoSkipPeriodAfterStartup = 10 * time.Millisecond
+	skipmanager.NoSkipPeriodAfterMediaStart = 10 * time.Millisecond
+
+	logger := log.New(io.Discard, "", 0)
+	statsClient, err := statsd.New(statsd.Mute(true))
+	require.NoError(t, err)
+
+	fakeNode := node.NewFakeNode()
+	w, err := fakeNode.NewWallet(make([]byte, 32))
+	require.NoError(t, err)
+	accounts := make([]node.Account, 3)
+	for i := range accounts {
+		index := uint32(i)
+		accounts[i], err = w.NewAccount(&index)
+		require.NoError(t, err)
+	}
+
+	mediaQueue, err := mediaqueue.New(ctx, logger, statsClient, "", "", map[types.MediaType]media.Provider{})
+	require.NoError(t, err)
+	p := pricer.New(logger, mediaQueue, nil)
+	p.SetEligibleSpectatorsEstimator(fixedEstimator(10))
+
+	manager := skipmanager.New(logger, fakeNode, accounts[1], accounts[2], accounts[0].Address(), mediaQueue, p)
+	return &testEnvironment{
+		node:       fakeNode,
+		wallet:     w,
+		collector:  accounts[0],
+		mediaQueue: mediaQueue,
+		manager:    manager,
+	}
+}
+
+func (e *testEnvironment) userAddress(t *testing.T, index uint32) string {
+	// users are not simulated by the fake node, so any valid address will do
+	account, err := e.wallet.NewAccount(&index)
+	require.NoError(t, err)
+	return account.Address()
+}
+
+func (e *testEnvironment) enqueue(requestedBy auth.User, unskippable bool) media.QueueEntry {
+	entry := applicationpage.NewApplicationPageQueueEntry("app", types.ApplicationVersion(time.Now()), "page", "Page", "",
+		10*time.Minute, requestedBy, payment.NewAmount(), unskippable, false)
+	e.mediaQueue.Enqueue(entry)
+	return entry
+}
+
+func (e *testEnvironment) startWorkers(t *testing.T, ctx context.Context) {
+	// the skip manager must be listening for media changes before the queue starts playing.
+	// The worker updates the skip status once it is listening
+	onStatusUpdated, statusUpdatedU := e.manager.StatusUpdated().Subscribe(event.BufferFirst)
+	defer statusUpdatedU()
+	go e.manager.Worker(ctx)
+	select {
+	case <-onStatusUpdated:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "skip manager worker did not start")
+	}
+	go e.manager.BalancesWorker(ctx, 10*time.Millisecond)
+	go e.mediaQueue.ProcessQueueWorker(ctx)
+}
+
+func (e *testEnvironment) waitForSkipStatus(t *testing.T, status proto.SkipStatus) {
+	require.Eventually(t, func() bool {
+		return e.manager.SkipAccountStatus().SkipStatus == status
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestCrowdfundedSkip(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = transactiontest.NullDatabaseContext(ctx)
+	env := newTestEnvironment(t, ctx)
+	onCrowdfundedSkip, crowdfundedSkipU := env.manager.CrowdfundedSkip().Subscribe(event.BufferAll)
+	defer crowdfundedSkipU()
+
+	env.enqueue(auth.NewAddressOnlyUser(env.userAddress(t, 1000)), false)
+	env.startWorkers(t, ctx)
+	env.waitForSkipStatus(t, proto.SkipStatus_SKIP_STATUS_ALLOWED)
+
+	status := env.manager.SkipAccountStatus()
+	require.Positive(t, status.Threshold.Sign())
+
+	// an amount below the threshold does not skip
+	below := new(big.Int).Sub(status.Threshold.Int, pricer.DustThreshold)
+	_, err := env.node.Fund(env.userAddress(t, 1001), status.Address, below)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return env.manager.SkipAccountStatus().Balance.Cmp(below) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	_, playing := env.mediaQueue.CurrentlyPlaying()
+	require.True(t, playing)
+
+	_, err = env.node.Fund(env.userAddress(t, 1002), status.Address, pricer.DustThreshold)
+	require.NoError(t, err)
+	select {
+	case amount := <-onCrowdfundedSkip:
+		require.Zero(t, amount.Cmp(status.Threshold.Int))
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "crowdfunded skip did not happen")
+	}
+	require.Eventually(t, func() bool {
+		_, playing := env.mediaQueue.CurrentlyPlaying()
+		return !playing
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Zero(t, env.node.AccountBalance(status.Address).Cmp(status.Threshold.Int))
+}
+
+func TestCrowdfundedSkipRespectsUnskippableEntries(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = transactiontest.NullDatabaseContext(ctx)
+	env := newTestEnvironment(t, ctx)
+	env.enqueue(auth.NewAddressOnlyUser(env.userAddress(t, 1000)), true)
+	env.startWorkers(t, ctx)
+	env.waitForSkipStatus(t, proto.SkipStatus_SKIP_STATUS_UNSKIPPABLE)
+
+	amount := bananos(1000000)
+	_, err := env.node.Fund(env.userAddress(t, 1001), env.manager.SkipAccountStatus().Address, amount)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return env.manager.SkipAccountStatus().Balance.Cmp(amount) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	_, playing := env.mediaQueue.CurrentlyPlaying()
+	require.True(t, playing)
+}
+
+func TestRainIsSentToCollector(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = transactiontest.NullDatabaseContext(ctx)
+	env := newTestEnvironment(t, ctx)
+	requester := env.userAddress(t, 1000)
+	entry := env.enqueue(auth.NewAddressOnlyUser(requester), false)
+
+	skipAddress := env.manager.SkipAccountStatus().Address
+	rainAddress := env.manager.RainAccountStatus().Address
+	_, err := env.node.Fund(env.userAddress(t, 1001), skipAddress, bananos(3))
+	require.NoError(t, err)
+	_, err = env.node.Fund(requester, rainAddress, bananos(2))
+	require.NoError(t, err)
+	_, err = env.node.Fund(env.userAddress(t, 1002), rainAddress, bananos(10))
+	require.NoError(t, err)
+
+	skipTotal, rainTotal, rainedByRequester, err := env.manager.EmptySkipAndRainAccounts(ctx, entry.PerformanceID(), &requester)
+	require.NoError(t, err)
+	require.Zero(t, skipTotal.Cmp(bananos(3)))
+	require.Zero(t, rainTotal.Cmp(bananos(12)))
+	require.Zero(t, rainedByRequester.Cmp(bananos(2)))
+
+	require.Zero(t, env.node.AccountBalance(skipAddress).Sign())
+	require.Zero(t, env.node.AccountBalance(rainAddress).Sign())
+	require.Zero(t, env.node.Receivable(env.collector.Address()).Cmp(bananos(15)))
+	require.Zero(t, env.manager.RainAccountStatus().Balance.Sign())
+}
@@ -0,0 +1,102 @@
+package skipmanager
+
+import (
+	"errors"
+	"math"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/pricer"
+)
+
+// SkipVoteThresholdFraction is the fraction of the estimated eligible spectators that must vote to skip the current
+// queue entry for it to be skipped
+var SkipVoteThresholdFraction = 0.3
+
+// MinSkipVoteThreshold is the minimum number of votes required to skip the current queue entry, regardless of how
+// many eligible spectators there are
+var MinSkipVoteThreshold = 3
+
+// ErrSkipVotingNotAllowed is returned when voting to skip is not possible for the current queue entry
+var ErrSkipVotingNotAllowed = errors.New("skip voting not allowed at this moment")
+
+// ErrAlreadyVotedToSkip is returned when a user votes to skip a queue entry they already voted to skip
+var ErrAlreadyVotedToSkip = errors.New("already voted to skip the current queue entry")
+
+// SetEligibleSpectatorsEstimator sets the estimator used to scale the number of votes required to skip
+func (s *Manager) SetEligibleSpectatorsEstimator(eligibleEstimator pricer.EligibleSpectatorsEstimator) {
+	s.skipThresholdMutex.Lock()
+	defer s.skipThresholdMutex.Unlock()
+	s.eligibleEstimator = eligibleEstimator
+}
+
+// computeSkipVoteThreshold must be called with skipThresholdMutex held
+func (s *Manager) computeSkipVoteThreshold() int {
+	if s.eligibleEstimator == nil {
+		return MinSkipVoteThreshold
+	}
+	eligible, ok := s.eligibleEstimator.EstimateEligibleSpectators()
+	if !ok {
+		return MinSkipVoteThreshold
+	}
+	threshold := int(math.Ceil(float64(eligible) * SkipVoteThresholdFraction))
+	if threshold < MinSkipVoteThreshold {
+		return MinSkipVoteThreshold
+	}
+	return threshold
+}
+
+// VoteToSkip registers a vote from user to skip the currently playing queue entry, which must have the specified
+// performance ID, skipping it if enough votes have been cast.
+// Once the vote is known to be allowed, and before it is registered, charge is called to collect any cost of the vote.
+// If charge returns an error, the vote is not registered and the error is returned.
+// Callers are responsible for ensuring that the user is an eligible spectator
+func (s *Manager) VoteToSkip(user auth.User, performanceID string, charge func() error) error {
+	voted, skipped := false, false
+	votes := 0
+	defer func() {
+		// start by deferring this since SkipAccountStatus must be called outside of the lock
+		if voted {
+			s.statusUpdated.Notify(SkipStatusUpdatedEventArgs{s.SkipAccountStatus(), s.RainAccountStatus()}, false)
+		}
+		if skipped {
+			s.skipVoteSucceeded.Notify(votes, false)
+			s.mediaQueue.SkipCurrentEntry()
+		}
+	}()
+
+	s.skipThresholdMutex.Lock()
+	defer s.skipThresholdMutex.Unlock()
+
+	// unlike crowdfunded skipping, skip voting is not possible during the no-skip period before the media ends
+	if s.computeSkipStatus() != proto.SkipStatus_SKIP_STATUS_ALLOWED {
+		return ErrSkipVotingNotAllowed
+	}
+	if currentEntry, playing := s.mediaQueue.CurrentlyPlaying(); !playing || currentEntry.PerformanceID() != performanceID {
+		return ErrSkipVotingNotAllowed
+	}
+
+	if _, alreadyVoted := s.skipVotes[user.Address()]; alreadyVoted {
+		return ErrAlreadyVotedToSkip
+	}
+
+	err := charge()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	s.skipVotes[user.Address()] = struct{}{}
+	voted = true
+
+	votes = len(s.skipVotes)
+	skipped = votes >= s.computeSkipVoteThreshold()
+	return nil
+}
+
+// HasVotedToSkip returns whether the user with the specified address voted to skip the currently playing queue entry
+func (s *Manager) HasVotedToSkip(address string) bool {
+	s.skipThresholdMutex.RLock()
+	defer s.skipThresholdMutex.RUnlock()
+	_, voted := s.skipVotes[address]
+	return voted
+}
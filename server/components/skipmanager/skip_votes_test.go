@@ -0,0 +1,137 @@
+package skipmanager_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/skipmanager"
+	"github.com/tnyim/jungletv/utils/event"
+	"github.com/tnyim/jungletv/utils/transaction/transactiontest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoteToSkip(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = transactiontest.NullDatabaseContext(ctx)
+
+	env := newTestEnvironment(t, ctx)
+	onSkipVoteSucceeded, skipVoteSucceededU := env.manager.SkipVoteSucceeded().Subscribe(event.BufferAll)
+	defer skipVoteSucceededU()
+
+	entry := env.enqueue(auth.NewAddressOnlyUser(env.userAddress(t, 1000)), false)
+	env.startWorkers(t, ctx)
+	env.waitForSkipStatus(t, proto.SkipStatus_SKIP_STATUS_ALLOWED)
+
+	charges := 0
+	charge := func() error {
+		charges++
+		return nil
+	}
+
+	err := env.manager.VoteToSkip(auth.NewAddressOnlyUser(env.userAddress(t, 1001)), "wrong", charge)
+	require.ErrorIs(t, err, skipmanager.ErrSkipVotingNotAllowed)
+
+	// a vote that can't be paid for is not registered
+	voter := auth.NewAddressOnlyUser(env.userAddress(t, 1001))
+	errPayment := errors.New("payment failed")
+	err = env.manager.VoteToSkip(voter, entry.PerformanceID(), func() error { return errPayment })
+	require.ErrorIs(t, err, errPayment)
+	require.False(t, env.manager.HasVotedToSkip(voter.Address()))
+	require.Zero(t, env.manager.SkipAccountStatus().SkipVotes)
+
+	threshold := env.manager.SkipAccountStatus().SkipVoteThreshold
+	require.GreaterOrEqual(t, threshold, skipmanager.MinSkipVoteThreshold)
+	for i := 0; i < threshold-1; i++ {
+		voter := auth.NewAddressOnlyUser(env.userAddress(t, uint32(1001+i)))
+		err = env.manager.VoteToSkip(voter, entry.PerformanceID(), charge)
+		require.NoError(t, err)
+		require.True(t, env.manager.HasVotedToSkip(voter.Address()))
+
+		err = env.manager.VoteToSkip(voter, entry.PerformanceID(), charge)
+		require.ErrorIs(t, err, skipmanager.ErrAlreadyVotedToSkip)
+	}
+	require.Equal(t, threshold-1, charges)
+	require.Equal(t, threshold-1, env.manager.SkipAccountStatus().SkipVotes)
+	_, playing := env.mediaQueue.CurrentlyPlaying()
+	require.True(t, playing)
+
+	err = env.manager.VoteToSkip(auth.NewAddressOnlyUser(env.userAddress(t, 2000)), entry.PerformanceID(), charge)
+	require.NoError(t, err)
+	require.Equal(t, threshold, charges)
+	select {
+	case votes := <-onSkipVoteSucceeded:
+		require.Equal(t, threshold, votes)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "skip vote did not succeed")
+	}
+	require.Eventually(t, func() bool {
+		_, playing := env.mediaQueue.CurrentlyPlaying()
+		return !playing
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestVoteToSkipRejections(t *testing.T) {
+	charge := func() error {
+		require.Fail(t, "rejected votes must not be charged")
+		return nil
+	}
+
+	t.Run("unskippable", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		ctx = transactiontest.NullDatabaseContext(ctx)
+
+		env := newTestEnvironment(t, ctx)
+		entry := env.enqueue(auth.NewAddressOnlyUser(env.userAddress(t, 1000)), true)
+		env.startWorkers(t, ctx)
+		env.waitForSkipStatus(t, proto.SkipStatus_SKIP_STATUS_UNSKIPPABLE)
+
+		voter := auth.NewAddressOnlyUser(env.userAddress(t, 1001))
+		err := env.manager.VoteToSkip(voter, entry.PerformanceID(), charge)
+		require.ErrorIs(t, err, skipmanager.ErrSkipVotingNotAllowed)
+		require.False(t, env.manager.HasVotedToSkip(voter.Address()))
+	})
+
+	t.Run("start of media", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		ctx = transactiontest.NullDatabaseContext(ctx)
+
+		env := newTestEnvironment(t, ctx)
+		skipmanager.NoSkipPeriodAfterMediaStart = time.Hour
+		entry := env.enqueue(auth.NewAddressOnlyUser(env.userAddress(t, 1000)), false)
+		env.startWorkers(t, ctx)
+		env.waitForSkipStatus(t, proto.SkipStatus_SKIP_STATUS_START_OF_MEDIA_PERIOD)
+
+		voter := auth.NewAddressOnlyUser(env.userAddress(t, 1001))
+		err := env.manager.VoteToSkip(voter, entry.PerformanceID(), charge)
+		require.ErrorIs(t, err, skipmanager.ErrSkipVotingNotAllowed)
+		require.False(t, env.manager.HasVotedToSkip(voter.Address()))
+	})
+
+	t.Run("end of media", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		ctx = transactiontest.NullDatabaseContext(ctx)
+
+		env := newTestEnvironment(t, ctx)
+		// unlike crowdfunded skipping, skip voting is not possible during the no-skip period before the media ends
+		previous := skipmanager.NoSkipPeriodBeforeMediaEnd
+		skipmanager.NoSkipPeriodBeforeMediaEnd = time.Hour
+		defer func() { skipmanager.NoSkipPeriodBeforeMediaEnd = previous }()
+		entry := env.enqueue(auth.NewAddressOnlyUser(env.userAddress(t, 1000)), false)
+		env.startWorkers(t, ctx)
+		env.waitForSkipStatus(t, proto.SkipStatus_SKIP_STATUS_END_OF_MEDIA_PERIOD)
+
+		voter := auth.NewAddressOnlyUser(env.userAddress(t, 1001))
+		err := env.manager.VoteToSkip(voter, entry.PerformanceID(), charge)
+		require.ErrorIs(t, err, skipmanager.ErrSkipVotingNotAllowed)
+		require.False(t, env.manager.HasVotedToSkip(voter.Address()))
+	})
+}
@@ -0,0 +1,203 @@
+package tipmanager
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/event"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+const flowExpiry = 10 * time.Minute
+const flowExpiryTolerance = 1 * time.Minute
+
+// CreateOrRecoverBananoTipFlow returns a flow through which the sender can tip BAN to the recipient.
+// The BAN received by the flow is forwarded to the recipient once the flow is destroyed
+func (m *Manager) CreateOrRecoverBananoTipFlow(sender auth.User, recipient Recipient, announce bool) (*BananoTipFlow, error) {
+	err := validateParticipants(sender, recipient)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	m.bananoTipFlowsLock.Lock()
+	defer m.bananoTipFlowsLock.Unlock()
+
+	key := sender.Address() + recipient.Address
+	if existingFlow, present := m.bananoTipFlows[key]; present && time.Until(existingFlow.Expiration()) > 10*time.Second {
+		existingFlow.lock.Lock()
+		existingFlow.recipient = recipient
+		existingFlow.announce = announce
+		existingFlow.lock.Unlock()
+		existingFlow.clientReconnected.Notify(true)
+		return existingFlow, nil
+	}
+
+	paymentReceiver, err := m.paymentAccountPool.ReceivePaymentIntoCollectorAccount(recipient.Address)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	flow := &BananoTipFlow{
+		createdOrRecoveredAt: time.Now(),
+		sender:               sender,
+		recipient:            recipient,
+		announce:             announce,
+		paymentAddress:       paymentReceiver.Address(),
+		clientReconnected:    event.NewNoArg(),
+		expired:              event.NewNoArg(),
+		destroyed:            event.NewNoArg(),
+		tipped:               event.New[*types.Tip](),
+		sessionTotal:         payment.NewAmount(),
+	}
+	m.bananoTipFlows[key] = flow
+
+	go flow.worker(m.workerContext, paymentReceiver, m.recordBananoTip, func() {
+		m.bananoTipFlowsLock.Lock()
+		defer m.bananoTipFlowsLock.Unlock()
+		delete(m.bananoTipFlows, key)
+		m.log.Printf("Destroyed Banano tip flow from %s to %s with payment address %s", sender.Address(), recipient.Address, flow.paymentAddress)
+	})
+
+	m.log.Printf("Created Banano tip flow from %s to %s with payment address %s", sender.Address(), recipient.Address, flow.paymentAddress)
+
+	return flow, nil
+}
+
+func (m *Manager) recordBananoTip(ctxCtx context.Context, sender auth.User, recipient Recipient, announce bool, paymentArgs payment.PaymentReceivedEventArgs) (*types.Tip, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	tip := m.newTip(sender, recipient, types.TipCurrencyBanano, paymentArgs.Amount.Decimal(), announce)
+	txHash := paymentArgs.BlockHash
+	tip.TxHash = &txHash
+	err = tip.Update(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	ctx.DeferToCommit(func() {
+		m.tipSent.Notify(tip, false)
+	})
+	return tip, stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// BananoTipFlow represents a flow through which one user tips BAN to another
+type BananoTipFlow struct {
+	lock sync.RWMutex
+
+	createdOrRecoveredAt time.Time
+	sender               auth.User
+	recipient            Recipient
+	announce             bool
+	paymentAddress       string
+
+	clientReconnected event.NoArgEvent
+	expired           event.NoArgEvent
+	destroyed         event.NoArgEvent
+	tipped            event.Event[*types.Tip]
+
+	sessionTotal payment.Amount
+}
+
+func (f *BananoTipFlow) PaymentAddress() string {
+	return f.paymentAddress
+}
+
+func (f *BananoTipFlow) RecipientAddress() string {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.recipient.Address
+}
+
+func (f *BananoTipFlow) SessionTotal() payment.Amount {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.sessionTotal
+}
+
+func (f *BananoTipFlow) Expired() event.NoArgEvent {
+	return f.expired
+}
+
+func (f *BananoTipFlow) Destroyed() event.NoArgEvent {
+	return f.destroyed
+}
+
+func (f *BananoTipFlow) Tipped() event.Event[*types.Tip] {
+	return f.tipped
+}
+
+func (f *BananoTipFlow) Expiration() time.Time {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.createdOrRecoveredAt.Add(flowExpiry)
+}
+
+type recordBananoTipFunction func(ctx context.Context, sender auth.User, recipient Recipient, announce bool, paymentArgs payment.PaymentReceivedEventArgs) (*types.Tip, error)
+
+func (f *BananoTipFlow) worker(ctx context.Context, paymentReceiver payment.PaymentReceiver, recordTipFn recordBananoTipFunction, cleanupFn func()) {
+	defer cleanupFn()
+	defer f.destroyed.Notify(true)
+
+	expireTimer := time.NewTimer(flowExpiry)
+	defer expireTimer.Stop()
+
+	actualExpirationTimer := time.NewTimer(flowExpiry + flowExpiryTolerance)
+	defer actualExpirationTimer.Stop()
+
+	onPaymentReceived, onPaymentReceivedUnsub := paymentReceiver.PaymentReceived().Subscribe(event.BufferAll)
+	defer onPaymentReceivedUnsub()
+
+	// closing the receiver forwards the received funds to the recipient
+	defer paymentReceiver.Close()
+
+	onClientReconnected, onClientReconnectedUnsub := f.clientReconnected.Subscribe(event.BufferFirst)
+	defer onClientReconnectedUnsub()
+
+	for {
+		select {
+		case <-onClientReconnected:
+			f.lock.Lock()
+			f.createdOrRecoveredAt = time.Now()
+			if !expireTimer.Stop() {
+				<-expireTimer.C
+			}
+			expireTimer.Reset(flowExpiry)
+
+			if !actualExpirationTimer.Stop() {
+				<-actualExpirationTimer.C
+			}
+			actualExpirationTimer.Reset(flowExpiry + flowExpiryTolerance)
+			f.lock.Unlock()
+		case <-expireTimer.C:
+			f.expired.Notify(true)
+		case <-actualExpirationTimer.C:
+			return
+		case <-ctx.Done():
+			return
+		case paymentArgs := <-onPaymentReceived:
+			func() {
+				f.lock.Lock()
+				defer f.lock.Unlock()
+				tip, err := recordTipFn(ctx, f.sender, f.recipient, f.announce, paymentArgs)
+				if err != nil {
+					// the funds are still forwarded to the recipient, we just fail to keep a record of them
+					log.Println(stacktrace.Propagate(err, "failed to record tip from %s, payment %+v", f.sender.Address(), paymentArgs))
+					return
+				}
+
+				f.sessionTotal = paymentArgs.Balance
+				f.tipped.Notify(tip, true)
+			}()
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package tipmanager
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/pointsmanager"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/event"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// MinimumPointsTip is the smallest amount of points that can be tipped at once
+const MinimumPointsTip = 10
+
+// ErrInvalidRecipient is returned when the recipient of a tip can't receive it
+var ErrInvalidRecipient = errors.New("invalid tip recipient")
+
+// ErrTipAmountTooSmall is returned when the amount of a tip is below the minimum
+var ErrTipAmountTooSmall = errors.New("tip amount too small")
+
+// Manager manages direct tips between users
+type Manager struct {
+	log                *log.Logger
+	workerContext      context.Context
+	pointsManager      *pointsmanager.Manager
+	paymentAccountPool *payment.PaymentAccountPool
+
+	bananoTipFlows     map[string]*BananoTipFlow
+	bananoTipFlowsLock sync.RWMutex
+
+	tipSent event.Event[*types.Tip]
+}
+
+// Recipient identifies who receives a tip
+type Recipient struct {
+	Address string
+	// QueueEntryID is set when the recipient is being tipped for a queue entry they requested
+	QueueEntryID string
+}
+
+// New returns a new initialized Manager
+func New(workerContext context.Context, log *log.Logger, pointsManager *pointsmanager.Manager, paymentAccountPool *payment.PaymentAccountPool) *Manager {
+	return &Manager{
+		log:                log,
+		workerContext:      workerContext,
+		pointsManager:      pointsManager,
+		paymentAccountPool: paymentAccountPool,
+		bananoTipFlows:     make(map[string]*BananoTipFlow),
+		tipSent:            event.New[*types.Tip](),
+	}
+}
+
+// TipSent returns the event that is fired when a tip is committed
+func (m *Manager) TipSent() event.Event[*types.Tip] {
+	return m.tipSent
+}
+
+func validateParticipants(sender auth.User, recipient Recipient) error {
+	if sender == nil || sender.IsUnknown() {
+		return stacktrace.NewError("can't tip as unknown user")
+	}
+	if recipient.Address == "" || recipient.Address == sender.Address() {
+		return stacktrace.Propagate(ErrInvalidRecipient, "")
+	}
+	return nil
+}
+
+// TipWithPoints transfers points from the sender to the recipient
+func (m *Manager) TipWithPoints(ctxCtx context.Context, sender auth.User, recipient Recipient, points int, announce bool) (*types.Tip, error) {
+	err := validateParticipants(sender, recipient)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if points < MinimumPointsTip {
+		return nil, stacktrace.Propagate(ErrTipAmountTooSmall, "")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	tip := m.newTip(sender, recipient, types.TipCurrencyPoints, decimal.NewFromInt(int64(points)), announce)
+	err = tip.Update(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	_, err = m.pointsManager.CreateTransaction(ctx, sender, types.PointsTxTypeTipSent, -points,
+		pointsmanager.TxExtraField{Key: "tip_id", Value: tip.ID},
+		pointsmanager.TxExtraField{Key: "recipient", Value: recipient.Address})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	_, err = m.pointsManager.CreateTransaction(ctx, auth.NewAddressOnlyUser(recipient.Address), types.PointsTxTypeTipReceived, points,
+		pointsmanager.TxExtraField{Key: "tip_id", Value: tip.ID},
+		pointsmanager.TxExtraField{Key: "sender", Value: sender.Address()})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	ctx.DeferToCommit(func() {
+		m.tipSent.Notify(tip, false)
+	})
+	return tip, stacktrace.Propagate(ctx.Commit(), "")
+}
+
+func (m *Manager) newTip(sender auth.User, recipient Recipient, currency types.TipCurrency, amount decimal.Decimal, announce bool) *types.Tip {
+	tip := &types.Tip{
+		ID:          uuid.NewV4().String(),
+		FromAddress: sender.Address(),
+		ToAddress:   recipient.Address,
+		Currency:    currency,
+		Amount:      amount,
+		Announced:   announce,
+		CreatedAt:   time.Now(),
+	}
+	if recipient.QueueEntryID != "" {
+		queueEntryID := recipient.QueueEntryID
+		tip.QueueEntryID = &queueEntryID
+	}
+	return tip
+}
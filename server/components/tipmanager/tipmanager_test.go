@@ -0,0 +1,204 @@
+package tipmanager_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"log"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/node"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/pointsmanager"
+	"github.com/tnyim/jungletv/server/components/tipmanager"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/event"
+	"github.com/tnyim/jungletv/utils/transaction/transactiontest"
+	"gopkg.in/alexcesaro/statsd.v2"
+
+	"github.com/stretchr/testify/require"
+)
+
+// tipDatabase is the part of the database touched by tips
+type tipDatabase struct {
+	tips          map[string]map[string]driver.Value
+	pointsBalance map[string]int64
+	pointsTxs     []map[string]driver.Value
+}
+
+func (d *tipDatabase) clone() *tipDatabase {
+	c := &tipDatabase{
+		tips:          make(map[string]map[string]driver.Value),
+		pointsBalance: make(map[string]int64),
+		pointsTxs:     append([]map[string]driver.Value{}, d.pointsTxs...),
+	}
+	for k, v := range d.tips {
+		c.tips[k] = v
+	}
+	for k, v := range d.pointsBalance {
+		c.pointsBalance[k] = v
+	}
+	return c
+}
+
+func newTipDatabase(pointsBalance map[string]int64) *transactiontest.FakeDatabase[*tipDatabase] {
+	db := transactiontest.NewFakeDatabase((&tipDatabase{pointsBalance: pointsBalance}).clone(), (*tipDatabase).clone)
+	db.Handle(`^INSERT INTO tip `, func(s *tipDatabase, stmt *transactiontest.FakeStatement) (*transactiontest.FakeResult, error) {
+		for _, row := range stmt.InsertedRows() {
+			s.tips[row["id"].(string)] = row
+		}
+		return nil, nil
+	})
+	db.Handle(`^INSERT INTO points_balance `, func(s *tipDatabase, stmt *transactiontest.FakeStatement) (*transactiontest.FakeResult, error) {
+		address := stmt.Args[0].(string)
+		if _, present := s.pointsBalance[address]; !present {
+			s.pointsBalance[address] = 0
+		}
+		return nil, nil
+	})
+	db.Handle(`^UPDATE points_balance SET balance = balance \+ \$1 WHERE points_balance.rewards_address = \$2$`, func(s *tipDatabase, stmt *transactiontest.FakeStatement) (*transactiontest.FakeResult, error) {
+		address := stmt.Args[1].(string)
+		balance := s.pointsBalance[address] + stmt.Args[0].(int64)
+		if balance < 0 {
+			return nil, errors.New(`new row for relation "points_balance" violates check constraint "points_balance_balance_check"`)
+		}
+		s.pointsBalance[address] = balance
+		return &transactiontest.FakeResult{RowsAffected: 1}, nil
+	})
+	db.Handle(`^INSERT INTO points_tx `, func(s *tipDatabase, stmt *transactiontest.FakeStatement) (*transactiontest.FakeResult, error) {
+		s.pointsTxs = append(s.pointsTxs, stmt.InsertedRows()...)
+		return nil, nil
+	})
+	return db
+}
+
+func newPointsManager(t *testing.T, ctx context.Context) *pointsmanager.Manager {
+	snowflakeNode, err := snowflake.NewNode(1)
+	require.NoError(t, err)
+	pointsManager, err := pointsmanager.New(ctx, log.New(io.Discard, "", 0), snowflakeNode, nil)
+	require.NoError(t, err)
+	return pointsManager
+}
+
+func TestTipWithPoints(t *testing.T) {
+	db := newTipDatabase(map[string]int64{"ban_1sender": 100})
+	ctx := db.Context(context.Background())
+
+	tipManager := tipmanager.New(ctx, log.New(io.Discard, "", 0), newPointsManager(t, ctx), nil)
+	onTipSent, tipSentU := tipManager.TipSent().Subscribe(event.BufferAll)
+	defer tipSentU()
+
+	sender := auth.NewAddressOnlyUser("ban_1sender")
+	tip, err := tipManager.TipWithPoints(ctx, sender, tipmanager.Recipient{Address: "ban_1recipient"}, 30, true)
+	require.NoError(t, err)
+	require.Equal(t, types.TipCurrencyPoints, tip.Currency)
+	require.True(t, tip.Amount.Equal(decimal.NewFromInt(30)))
+
+	state := db.State()
+	require.EqualValues(t, 70, state.pointsBalance["ban_1sender"])
+	require.EqualValues(t, 30, state.pointsBalance["ban_1recipient"])
+	require.Contains(t, state.tips, tip.ID)
+	require.Equal(t, "ban_1recipient", state.tips[tip.ID]["to_address"])
+
+	require.Len(t, state.pointsTxs, 2)
+	require.Equal(t, "ban_1sender", state.pointsTxs[0]["rewards_address"])
+	require.EqualValues(t, -30, state.pointsTxs[0]["value"])
+	require.EqualValues(t, types.PointsTxTypeTipSent, state.pointsTxs[0]["type"])
+	require.Equal(t, "ban_1recipient", state.pointsTxs[1]["rewards_address"])
+	require.EqualValues(t, 30, state.pointsTxs[1]["value"])
+	require.EqualValues(t, types.PointsTxTypeTipReceived, state.pointsTxs[1]["type"])
+
+	select {
+	case sentTip := <-onTipSent:
+		require.Equal(t, tip.ID, sentTip.ID)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "tip was not announced")
+	}
+
+	// the sender can't tip more than what is left in their balance
+	_, err = tipManager.TipWithPoints(ctx, sender, tipmanager.Recipient{Address: "ban_1recipient"}, 71, true)
+	require.ErrorIs(t, err, types.ErrInsufficientPointsBalance)
+
+	state = db.State()
+	require.EqualValues(t, 70, state.pointsBalance["ban_1sender"])
+	require.EqualValues(t, 30, state.pointsBalance["ban_1recipient"])
+	require.Len(t, state.tips, 1)
+	require.Len(t, state.pointsTxs, 2)
+}
+
+func TestTipWithBanano(t *testing.T) {
+	poolCtx, poolCancel := context.WithCancel(context.Background())
+	defer poolCancel()
+
+	fakeNode := node.NewFakeNode()
+	w, err := fakeNode.NewWallet(make([]byte, 32))
+	require.NoError(t, err)
+	collectorIndex := uint32(0)
+	collector, err := w.NewAccount(&collectorIndex)
+	require.NoError(t, err)
+	senderIndex := uint32(1000)
+	sender, err := w.NewAccount(&senderIndex)
+	require.NoError(t, err)
+	recipientIndex := uint32(1001)
+	recipient, err := w.NewAccount(&recipientIndex)
+	require.NoError(t, err)
+
+	statsClient, err := statsd.New(statsd.Mute(true))
+	require.NoError(t, err)
+
+	pool := payment.New(log.New(io.Discard, "", 0), statsClient, w, collector.Address(), nil,
+		payment.NewAmount(big.NewInt(10)), collector.Address(), nil)
+	go pool.Worker(poolCtx, 10*time.Millisecond)
+
+	db := newTipDatabase(map[string]int64{})
+	ctx, cancel := context.WithCancel(db.Context(context.Background()))
+	defer cancel()
+
+	tipManager := tipmanager.New(ctx, log.New(io.Discard, "", 0), newPointsManager(t, ctx), pool)
+	flow, err := tipManager.CreateOrRecoverBananoTipFlow(auth.NewAddressOnlyUser(sender.Address()),
+		tipmanager.Recipient{Address: recipient.Address()}, false)
+	require.NoError(t, err)
+	onTipped, tippedU := flow.Tipped().Subscribe(event.BufferAll)
+	defer tippedU()
+	onDestroyed, destroyedU := flow.Destroyed().Subscribe(event.BufferFirst)
+	defer destroyedU()
+
+	txHash, err := fakeNode.Fund(sender.Address(), flow.PaymentAddress(), big.NewInt(1000))
+	require.NoError(t, err)
+
+	select {
+	case tip := <-onTipped:
+		require.Equal(t, types.TipCurrencyBanano, tip.Currency)
+		require.True(t, tip.Amount.Equal(decimal.NewFromInt(1000)))
+		require.NotNil(t, tip.TxHash)
+		require.Equal(t, txHash.String(), *tip.TxHash)
+		require.Contains(t, db.State().tips, tip.ID)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "tip was not detected")
+	}
+	require.EqualValues(t, 1000, flow.SessionTotal().Int64())
+
+	// the funds only reach the recipient once the flow is destroyed
+	require.Zero(t, fakeNode.Receivable(recipient.Address()).Int64())
+	cancel()
+	select {
+	case <-onDestroyed:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "flow was not destroyed")
+	}
+	require.Eventually(t, func() bool {
+		return fakeNode.Receivable(recipient.Address()).Int64() == 1000
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Zero(t, fakeNode.AccountBalance(flow.PaymentAddress()).Int64())
+
+	state := db.State()
+	require.Len(t, state.tips, 1)
+	require.Empty(t, state.pointsBalance)
+	require.Empty(t, state.pointsTxs)
+}
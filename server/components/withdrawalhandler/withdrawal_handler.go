@@ -10,9 +10,9 @@ import (
 
 	"github.com/DisgoOrg/disgohook/api"
 	"github.com/hectorchu/gonano/rpc"
-	"github.com/hectorchu/gonano/wallet"
 	"github.com/palantir/stacktrace"
 	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/server/components/node"
 	"github.com/tnyim/jungletv/server/components/payment"
 	"github.com/tnyim/jungletv/server/components/pricer"
 	"github.com/tnyim/jungletv/types"
@@ -21,14 +21,26 @@ import (
 	"gopkg.in/alexcesaro/statsd.v2"
 )
 
+// DefaultAutoWithdrawalThreshold is the balance at which the balances of addresses without a withdrawal preference are
+// automatically withdrawn
+var DefaultAutoWithdrawalThreshold = new(big.Int).Mul(pricer.BananoUnit, big.NewInt(10)) // 10 BAN
+
+// MinimumAutoWithdrawalThreshold is the lowest automatic withdrawal threshold users can choose
+var MinimumAutoWithdrawalThreshold = new(big.Int).Set(pricer.BananoUnit) // 1 BAN
+
+// balances of addresses without a withdrawal preference are also withdrawn once they go unchanged for this long
+const defaultAutoWithdrawalUnchangedPeriod = 24 * time.Hour
+
+const autoWithdrawalInterval = 5 * time.Minute
+
 // Handler handles withdrawals
 type Handler struct {
 	log                          *log.Logger
 	modLogWebhook                api.WebhookClient
 	statsClient                  *statsd.Client
-	collectorAccountQueue        chan func(*wallet.Account, *rpc.Client, *rpc.Client)
+	collectorAccountQueue        chan func(node.Account)
 	completingPendingWithdrawals bool
-	rpcClient                    *rpc.Client
+	rpcClient                    node.RPC
 
 	pendingWithdrawalCreated event.Event[[]*types.PendingWithdrawal]
 
@@ -37,8 +49,8 @@ type Handler struct {
 
 func New(log *log.Logger,
 	statsClient *statsd.Client,
-	collectorAccountQueue chan func(*wallet.Account, *rpc.Client, *rpc.Client),
-	rpcClient *rpc.Client,
+	collectorAccountQueue chan func(node.Account),
+	rpcClient node.RPC,
 	modLogWebhook api.WebhookClient) *Handler {
 	return &Handler{
 		log:                   log,
@@ -56,7 +68,7 @@ func (w *Handler) Worker(ctx context.Context) error {
 	onPendingWithdrawalCreated, pendingWithdrawalCreatedU := w.pendingWithdrawalCreated.Subscribe(event.BufferFirst)
 	defer pendingWithdrawalCreatedU()
 
-	t := time.NewTicker(5 * time.Minute)
+	t := time.NewTicker(autoWithdrawalInterval)
 	defer t.Stop()
 	firstCheckTimer := time.NewTimer(1 * time.Minute)
 	defer firstCheckTimer.Stop()
@@ -109,12 +121,18 @@ func (w *Handler) AutoWithdrawBalances(ctxCtx context.Context) error {
 	}
 	defer ctx.Rollback()
 
-	threshold := new(big.Int).Mul(pricer.BananoUnit, big.NewInt(10)) // 10 BAN
+	now := time.Now()
+	balances, err := types.GetRewardBalancesReadyForAutoWithdrawal(ctx,
+		decimal.NewFromBigInt(DefaultAutoWithdrawalThreshold, 0), now.Add(-defaultAutoWithdrawalUnchangedPeriod))
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
 
-	balances, err := types.GetRewardBalancesReadyForAutoWithdrawal(ctx, decimal.NewFromBigInt(threshold, 0), time.Now().Add(-24*time.Hour))
+	preferredBalances, err := w.balancesReadyAccordingToPreferences(ctx, now)
 	if err != nil {
 		return stacktrace.Propagate(err, "")
 	}
+	balances = append(balances, preferredBalances...)
 
 	err = w.WithdrawBalances(ctx, balances)
 	if err != nil {
@@ -124,6 +142,98 @@ func (w *Handler) AutoWithdrawBalances(ctxCtx context.Context) error {
 	return stacktrace.Propagate(ctx.Commit(), "")
 }
 
+func (w *Handler) balancesReadyAccordingToPreferences(ctx transaction.WrappingContext, now time.Time) ([]*types.RewardBalance, error) {
+	candidates, err := types.GetRewardBalancesWithWithdrawalPreference(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	addresses := make([]string, len(candidates))
+	for i, balance := range candidates {
+		addresses[i] = balance.RewardsAddress
+	}
+	preferences, err := types.GetWithdrawalPreferencesOfAddresses(ctx, addresses)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	latestWithdrawals, err := types.GetLatestWithdrawalTimeOfAddresses(ctx, addresses)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	ready := []*types.RewardBalance{}
+	for _, balance := range candidates {
+		preference, ok := preferences[balance.RewardsAddress]
+		if !ok {
+			// preference was removed in the meantime
+			continue
+		}
+		readyAt, willBeReady := AutoWithdrawalReadyAt(balance, preference, latestWithdrawals[balance.RewardsAddress])
+		if willBeReady && !readyAt.After(now) {
+			ready = append(ready, balance)
+		}
+	}
+	return ready, nil
+}
+
+// AutoWithdrawalReadyAt returns the time at which balance becomes ready for automatic withdrawal, given the withdrawal
+// preference of its address (nil if there is none) and the start time of the latest withdrawal to that address (zero
+// if there was none). The returned time may be in the past.
+// If the balance can only become ready by growing, false is returned
+func AutoWithdrawalReadyAt(balance *types.RewardBalance, preference *types.WithdrawalPreference, latestWithdrawal time.Time) (time.Time, bool) {
+	if !balance.Balance.IsPositive() {
+		return time.Time{}, false
+	}
+
+	if preference == nil {
+		if balance.Balance.GreaterThanOrEqual(decimal.NewFromBigInt(DefaultAutoWithdrawalThreshold, 0)) {
+			return balance.UpdatedAt, true
+		}
+		return balance.UpdatedAt.Add(defaultAutoWithdrawalUnchangedPeriod), true
+	}
+
+	if preference.Threshold != nil && balance.Balance.GreaterThanOrEqual(*preference.Threshold) {
+		return balance.UpdatedAt, true
+	}
+	if preference.Cadence != nil {
+		periodStart := latestWithdrawal
+		if periodStart.IsZero() {
+			periodStart = preference.UpdatedAt
+		}
+		return preference.Cadence.After(periodStart), true
+	}
+	return time.Time{}, false
+}
+
+// NextExpectedAutoWithdrawal returns the approximate time at which the specified balance, which must not have a
+// pending withdrawal, is expected to be automatically withdrawn.
+// If it is not possible to predict when that will happen, false is returned
+func (w *Handler) NextExpectedAutoWithdrawal(ctx transaction.WrappingContext, balance *types.RewardBalance) (time.Time, bool, error) {
+	// preference is nil when the address has none
+	preference, _, err := types.GetWithdrawalPreferenceOfAddress(ctx, balance.RewardsAddress)
+	if err != nil {
+		return time.Time{}, false, stacktrace.Propagate(err, "")
+	}
+
+	latestWithdrawals, err := types.GetLatestWithdrawalTimeOfAddresses(ctx, []string{balance.RewardsAddress})
+	if err != nil {
+		return time.Time{}, false, stacktrace.Propagate(err, "")
+	}
+
+	readyAt, willBeReady := AutoWithdrawalReadyAt(balance, preference, latestWithdrawals[balance.RewardsAddress])
+	if !willBeReady {
+		return time.Time{}, false, nil
+	}
+	if now := time.Now(); readyAt.Before(now) {
+		// will be picked up by the next run of AutoWithdrawBalances
+		readyAt = now.Add(autoWithdrawalInterval)
+	}
+	return readyAt, true, nil
+}
+
 // WithdrawBalances initiates withdraws for the specified balances
 func (w *Handler) WithdrawBalances(ctxCtx context.Context, balances []*types.RewardBalance) error {
 	if len(balances) == 0 {
@@ -138,6 +248,7 @@ func (w *Handler) WithdrawBalances(ctxCtx context.Context, balances []*types.Rew
 	now := time.Now()
 	pendingWithdrawals := make([]*types.PendingWithdrawal, len(balances))
 	userAccounts := make([]string, len(balances))
+	journalLines := []*types.JournalLine{}
 	for i, balance := range balances {
 		pendingWithdrawals[i] = &types.PendingWithdrawal{
 			RewardsAddress: balance.RewardsAddress,
@@ -145,6 +256,10 @@ func (w *Handler) WithdrawBalances(ctxCtx context.Context, balances []*types.Rew
 			StartedAt:      now,
 		}
 		userAccounts[i] = balance.RewardsAddress
+		journalLines = append(journalLines, types.JournalTransfer(
+			types.JournalAccountPendingWithdrawal(balance.RewardsAddress),
+			types.JournalAccountRewardBalance(balance.RewardsAddress),
+			balance.Balance)...)
 	}
 
 	err = types.ZeroRewardBalanceOfAddresses(ctx, userAccounts)
@@ -157,6 +272,11 @@ func (w *Handler) WithdrawBalances(ctxCtx context.Context, balances []*types.Rew
 		return stacktrace.Propagate(err, "")
 	}
 
+	err = types.RecordJournalEntry(ctx, fmt.Sprintf("Withdrawal of %d reward balances started", len(balances)), "", journalLines...)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
 	ctx.DeferToCommit(func() { w.pendingWithdrawalCreated.Notify(pendingWithdrawals, false) })
 	return stacktrace.Propagate(ctx.Commit(), "")
 }
@@ -230,7 +350,9 @@ func (w *Handler) CompleteWithdrawal(ctxCtx context.Context, pending *types.Pend
 
 	done := make(chan struct{})
 	var blockHash rpc.BlockHash
-	w.collectorAccountQueue <- func(collectorAccount *wallet.Account, _, _ *rpc.Client) {
+	var collectorAccountAddress string
+	w.collectorAccountQueue <- func(collectorAccount node.Account) {
+		collectorAccountAddress = collectorAccount.Address()
 		if recvPending {
 			err = collectorAccount.ReceivePendings(pricer.DustThreshold)
 			if err != nil {
@@ -257,6 +379,15 @@ func (w *Handler) CompleteWithdrawal(ctxCtx context.Context, pending *types.Pend
 		return stacktrace.Propagate(err, "")
 	}
 
+	err = types.RecordJournalEntry(ctx, fmt.Sprintf("Withdrawal to %s completed", pending.RewardsAddress), withdrawal.TxHash,
+		types.JournalTransfer(
+			types.JournalAccountOnChain(collectorAccountAddress),
+			types.JournalAccountPendingWithdrawal(pending.RewardsAddress),
+			pending.Amount)...)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
 	return stacktrace.Propagate(ctx.Commit(), "")
 }
 
@@ -341,9 +472,26 @@ func (w *Handler) undoInvalidWithdrawal(ctxCtx context.Context, txHash string) e
 		return stacktrace.Propagate(err, "")
 	}
 
+	err = types.RecordJournalEntry(ctx, fmt.Sprintf("Withdrawal to %s undone", withdrawal.RewardsAddress), withdrawal.TxHash,
+		types.JournalTransfer(
+			types.JournalAccountRewardBalance(withdrawal.RewardsAddress),
+			types.JournalAccountOnChain(w.collectorAccountAddress()),
+			withdrawal.Amount)...)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
 	return stacktrace.Propagate(ctx.Commit(), "")
 }
 
+func (w *Handler) collectorAccountAddress() string {
+	addressChan := make(chan string, 1)
+	w.collectorAccountQueue <- func(collectorAccount node.Account) {
+		addressChan <- collectorAccount.Address()
+	}
+	return <-addressChan
+}
+
 func (w *Handler) warnAboutInvalidWithdrawals(ctx context.Context) error {
 	closeToBeingUndone, _, err := w.findInvalidWithdrawals(ctx, 10*time.Second, 48*time.Hour)
 	if err != nil {
@@ -0,0 +1,185 @@
+package withdrawalhandler_test
+
+import (
+	"context"
+	"io"
+	"log"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/server/components/node"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/server/components/rewards"
+	"github.com/tnyim/jungletv/server/components/withdrawalhandler"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/event"
+	"github.com/tnyim/jungletv/utils/transaction/transactiontest"
+	"gopkg.in/alexcesaro/statsd.v2"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoWithdrawalReadyAt(t *testing.T) {
+	updatedAt := time.Date(2024, time.March, 5, 12, 0, 0, 0, time.UTC)
+	ban := func(n int64) decimal.Decimal {
+		return decimal.NewFromBigInt(pricer.BananoUnit, 0).Mul(decimal.NewFromInt(n))
+	}
+	balance := func(n int64) *types.RewardBalance {
+		return &types.RewardBalance{
+			RewardsAddress: "ban_1test",
+			Balance:        ban(n),
+			UpdatedAt:      updatedAt,
+		}
+	}
+
+	// default rules
+	_, ready := withdrawalhandler.AutoWithdrawalReadyAt(balance(0), nil, time.Time{})
+	require.False(t, ready)
+
+	readyAt, ready := withdrawalhandler.AutoWithdrawalReadyAt(balance(10), nil, time.Time{})
+	require.True(t, ready)
+	require.Equal(t, updatedAt, readyAt)
+
+	readyAt, ready = withdrawalhandler.AutoWithdrawalReadyAt(balance(2), nil, time.Time{})
+	require.True(t, ready)
+	require.Equal(t, updatedAt.Add(24*time.Hour), readyAt)
+
+	// threshold only
+	threshold := ban(50)
+	thresholdOnly := &types.WithdrawalPreference{
+		Threshold: &threshold,
+		UpdatedAt: updatedAt.Add(-time.Hour),
+	}
+	_, ready = withdrawalhandler.AutoWithdrawalReadyAt(balance(20), thresholdOnly, time.Time{})
+	require.False(t, ready)
+
+	readyAt, ready = withdrawalhandler.AutoWithdrawalReadyAt(balance(50), thresholdOnly, time.Time{})
+	require.True(t, ready)
+	require.Equal(t, updatedAt, readyAt)
+
+	// cadence, counted from the latest withdrawal or, if there was none, from when the preference was set
+	weekly := types.WithdrawalCadenceWeekly
+	thresholdAndCadence := &types.WithdrawalPreference{
+		Threshold: &threshold,
+		Cadence:   &weekly,
+		UpdatedAt: updatedAt.Add(-time.Hour),
+	}
+	readyAt, ready = withdrawalhandler.AutoWithdrawalReadyAt(balance(20), thresholdAndCadence, time.Time{})
+	require.True(t, ready)
+	require.Equal(t, updatedAt.Add(-time.Hour).AddDate(0, 0, 7), readyAt)
+
+	latestWithdrawal := updatedAt.AddDate(0, 0, -3)
+	readyAt, ready = withdrawalhandler.AutoWithdrawalReadyAt(balance(20), thresholdAndCadence, latestWithdrawal)
+	require.True(t, ready)
+	require.Equal(t, latestWithdrawal.AddDate(0, 0, 7), readyAt)
+
+	readyAt, ready = withdrawalhandler.AutoWithdrawalReadyAt(balance(60), thresholdAndCadence, latestWithdrawal)
+	require.True(t, ready)
+	require.Equal(t, updatedAt, readyAt)
+}
+
+func newTestHandler(t *testing.T, ctx context.Context) (*withdrawalhandler.Handler, *node.FakeNode, node.Wallet, node.Account) {
+	fakeNode := node.NewFakeNode()
+	w, err := fakeNode.NewWallet(make([]byte, 32))
+	require.NoError(t, err)
+	collectorIndex := uint32(0)
+	collector, err := w.NewAccount(&collectorIndex)
+	require.NoError(t, err)
+
+	collectorAccountQueue := make(chan func(node.Account))
+	go func() {
+		for {
+			select {
+			case f := <-collectorAccountQueue:
+				f(collector)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	statsClient, err := statsd.New(statsd.Mute(true))
+	require.NoError(t, err)
+	handler := withdrawalhandler.New(log.New(io.Discard, "", 0), statsClient, collectorAccountQueue, fakeNode, nil)
+	return handler, fakeNode, w, collector
+}
+
+func userAddress(t *testing.T, w node.Wallet, index uint32) string {
+	// users are not simulated by the fake node, so any valid address will do
+	account, err := w.NewAccount(&index)
+	require.NoError(t, err)
+	return account.Address()
+}
+
+func TestRewardsAreWithdrawnFromCollector(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = transactiontest.NullDatabaseContext(ctx)
+
+	handler, fakeNode, w, collector := newTestHandler(t, ctx)
+	onPendingWithdrawalsCreated, pendingWithdrawalsCreatedU := handler.PendingWithdrawalsCreated().Subscribe(event.BufferAll)
+	defer pendingWithdrawalsCreatedU()
+
+	// the reward budget reaches the collector as receivables, e.g. from the payment, skip and rain accounts
+	budget := new(big.Int).Mul(pricer.BananoUnit, big.NewInt(30))
+	_, err := fakeNode.Fund(userAddress(t, w, 2000), collector.Address(), budget)
+	require.NoError(t, err)
+
+	recipients := make([]rewards.DistributionRecipient, 3)
+	for i := range recipients {
+		recipients[i].Address = userAddress(t, w, uint32(1000+i))
+	}
+	amounts := rewards.EqualDistributionStrategy{}.Distribute(payment.NewAmount(budget), recipients)
+	balances := make([]*types.RewardBalance, len(recipients))
+	for i, recipient := range recipients {
+		require.Positive(t, amounts[i].Sign())
+		balances[i] = &types.RewardBalance{
+			RewardsAddress: recipient.Address,
+			Balance:        amounts[i].Decimal(),
+		}
+	}
+
+	err = handler.WithdrawBalances(ctx, balances)
+	require.NoError(t, err)
+	var pending []*types.PendingWithdrawal
+	select {
+	case pending = <-onPendingWithdrawalsCreated:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "pending withdrawals were not created")
+	}
+	require.Len(t, pending, len(recipients))
+
+	err = handler.CompleteWithdrawals(ctx, pending)
+	require.NoError(t, err)
+
+	distributed := big.NewInt(0)
+	for i, recipient := range recipients {
+		require.Zero(t, fakeNode.Receivable(recipient.Address).Cmp(amounts[i].Int))
+		distributed.Add(distributed, amounts[i].Int)
+	}
+	require.Zero(t, fakeNode.Receivable(collector.Address()).Sign())
+	require.Zero(t, fakeNode.AccountBalance(collector.Address()).Cmp(new(big.Int).Sub(budget, distributed)))
+}
+
+func TestWithdrawalFailsWithoutFunds(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = transactiontest.NullDatabaseContext(ctx)
+
+	handler, fakeNode, w, collector := newTestHandler(t, ctx)
+	_, err := fakeNode.Fund(userAddress(t, w, 2000), collector.Address(), pricer.BananoUnit)
+	require.NoError(t, err)
+
+	recipient := userAddress(t, w, 1000)
+	err = handler.CompleteWithdrawals(ctx, []*types.PendingWithdrawal{{
+		RewardsAddress: recipient,
+		Amount:         decimal.NewFromBigInt(pricer.BananoUnit, 1),
+		StartedAt:      time.Now(),
+	}})
+	require.Error(t, err)
+	require.Zero(t, fakeNode.Receivable(recipient).Sign())
+	require.Zero(t, fakeNode.AccountBalance(collector.Address()).Cmp(pricer.BananoUnit))
+}
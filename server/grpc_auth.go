@@ -69,7 +69,7 @@ func (s *grpcServer) signInViaRepresentativeChange(ctx context.Context, r *proto
 	}
 
 	accountOpened := true
-	_, err = s.wallet.RPC.AccountRepresentative(r.RewardsAddress)
+	_, err = s.wallet.RPC().AccountRepresentative(r.RewardsAddress)
 	if err != nil {
 		if err.Error() == "Account not found" {
 			accountOpened = false
@@ -124,7 +124,7 @@ func (s *grpcServer) signInViaRepresentativeChange(ctx context.Context, r *proto
 			}
 			return nil
 		}
-		representative, err := s.wallet.RPC.AccountRepresentative(r.RewardsAddress)
+		representative, err := s.wallet.RPC().AccountRepresentative(r.RewardsAddress)
 		if err != nil {
 			if err.Error() == "Account not found" {
 				err = sendAccountUnopened()
@@ -11,7 +11,6 @@ import (
 	"github.com/tnyim/jungletv/server/auth"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
 	"github.com/tnyim/jungletv/server/media"
-	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils/event"
 	"github.com/tnyim/jungletv/utils/transaction"
 	"google.golang.org/grpc/codes"
@@ -20,6 +19,11 @@ import (
 )
 
 func (s *grpcServer) EnqueueMedia(ctxCtx context.Context, r *proto.EnqueueMediaRequest) (*proto.EnqueueMediaResponse, error) {
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
 	isAdmin := false
 	user := authinterceptor.UserFromContext(ctxCtx)
 	if banned, err := s.moderationStore.LoadRemoteAddressBannedFromVideoEnqueuing(ctxCtx, authinterceptor.RemoteAddressFromContext(ctxCtx)); err == nil && banned {
@@ -91,7 +95,7 @@ func (s *grpcServer) EnqueueMedia(ctxCtx context.Context, r *proto.EnqueueMediaR
 			return produceEnqueueMediaFailureResponse("Anonymous users can not enqueue entries with hidden media information")
 		}
 		// preliminary check for sufficient points balance
-		enoughPoints, err := s.enqueueManager.UserHasEnoughPointsToEnqueueConcealedEntry(ctx, user)
+		enoughPoints, err := channel.enqueueManager.UserHasEnoughPointsToEnqueueConcealedEntry(ctx, user)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "")
 		}
@@ -101,17 +105,27 @@ func (s *grpcServer) EnqueueMedia(ctxCtx context.Context, r *proto.EnqueueMediaR
 	}
 
 	var provider media.Provider
+	var collectionProvider media.CollectionProvider
 
-	for _, p := range s.mediaProviders {
+	for _, p := range channel.mediaProviders {
 		if p.CanHandleRequestType(r.GetMediaInfo()) {
 			provider = p
 		}
+		if cp, ok := p.(media.CollectionProvider); ok && cp.CanHandleCollectionRequestType(r.GetMediaInfo()) {
+			provider = p
+			collectionProvider = cp
+		}
 	}
 
 	if provider == media.Provider(nil) {
 		return nil, stacktrace.NewError("no provider found")
 	}
 
+	if collectionProvider != nil {
+		response, err := s.enqueueMediaCollection(ctx, r, channel, provider, collectionProvider)
+		return response, stacktrace.Propagate(err, "")
+	}
+
 	preInfo, result, err := provider.BeginEnqueueRequest(ctx, r.GetMediaInfo())
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
@@ -120,8 +134,7 @@ func (s *grpcServer) EnqueueMedia(ctxCtx context.Context, r *proto.EnqueueMediaR
 		return produceEnqueueRequestCreationFailedResponse(result)
 	}
 
-	mediaType, mediaID := preInfo.MediaID()
-	allowed, err := types.IsMediaAllowed(ctx, mediaType, mediaID)
+	allowed, err := media.IsAllowed(ctx, preInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
@@ -129,16 +142,6 @@ func (s *grpcServer) EnqueueMedia(ctxCtx context.Context, r *proto.EnqueueMediaR
 		return produceEnqueueRequestCreationFailedResponse(media.EnqueueRequestCreationFailedMediumIsDisallowed)
 	}
 
-	for _, collection := range preInfo.Collections() {
-		allowed, err := types.IsMediaCollectionAllowed(ctx, collection.Type, collection.ID)
-		if err != nil {
-			return nil, stacktrace.Propagate(err, "")
-		}
-		if !allowed {
-			return produceEnqueueRequestCreationFailedResponse(media.EnqueueRequestCreationFailedMediumIsDisallowed)
-		}
-	}
-
 	request, result, err := provider.ContinueEnqueueRequest(ctx, preInfo, r.Unskippable, r.Concealed, r.Anonymous,
 		s.allowMediaEnqueuing == proto.AllowedMediaEnqueuingType_STAFF_ONLY,
 		s.allowMediaEnqueuing == proto.AllowedMediaEnqueuingType_STAFF_ONLY,
@@ -150,7 +153,7 @@ func (s *grpcServer) EnqueueMedia(ctxCtx context.Context, r *proto.EnqueueMediaR
 		return produceEnqueueRequestCreationFailedResponse(result)
 	}
 
-	ticket, err := s.enqueueManager.RegisterRequest(ctx, request, r.Anonymous)
+	ticket, err := channel.enqueueManager.RegisterRequest(ctx, request, r.Anonymous)
 	if err != nil {
 		if strings.Contains(err.Error(), "failed to check balance for account") {
 			return produceEnqueueMediaFailureResponse("The JungleTV payment subsystem is unavailable")
@@ -159,8 +162,9 @@ func (s *grpcServer) EnqueueMedia(ctxCtx context.Context, r *proto.EnqueueMediaR
 	}
 
 	resp := ticket.SerializeForAPI()
-	currentEntry, playing := s.mediaQueue.CurrentlyPlaying()
-	resp.CurrentlyPlayingIsUnskippable = playing && (currentEntry.Unskippable() || !s.mediaQueue.SkippingEnabled())
+	currentEntry, playing := channel.mediaQueue.CurrentlyPlaying()
+	resp.CurrentlyPlayingIsUnskippable = playing && (currentEntry.Unskippable() || !channel.mediaQueue.SkippingEnabled())
+	resp.PredictedEnqueuePosition = uint32(channel.mediaQueue.PredictedEnqueuePosition(ticket.RequestedBy()))
 
 	// it's not very elegant to put this check here, but this avoids having to expose the concept of tickets to the media providers
 	// (and a media type that allows for doing this should very much be the exception, anyway)
@@ -175,30 +179,125 @@ func (s *grpcServer) EnqueueMedia(ctxCtx context.Context, r *proto.EnqueueMediaR
 	}, nil
 }
 
+func (s *grpcServer) enqueueMediaCollection(ctx transaction.WrappingContext, r *proto.EnqueueMediaRequest, channel *broadcastChannel,
+	provider media.Provider, collectionProvider media.CollectionProvider) (*proto.EnqueueMediaResponse, error) {
+	if r.Concealed {
+		return produceEnqueueMediaFailureResponse("Collections of media can not be enqueued with hidden media information")
+	}
+
+	items, result, err := collectionProvider.BeginCollectionEnqueueRequest(ctx, r.GetMediaInfo())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if result != media.EnqueueRequestCreationSucceeded {
+		return produceEnqueueRequestCreationFailedResponse(result)
+	}
+
+	requests := []media.EnqueueRequest{}
+	failedItems := []*proto.EnqueueMediaFailedItem{}
+	seenMedia := make(map[string]struct{})
+	for _, item := range items {
+		result := item.Result
+		var request media.EnqueueRequest
+		if result == media.EnqueueRequestCreationSucceeded {
+			request, result, err = s.continueEnqueueCollectionItem(ctx, r, provider, item.Info, seenMedia)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "")
+			}
+		}
+		if result != media.EnqueueRequestCreationSucceeded {
+			failedItems = append(failedItems, &proto.EnqueueMediaFailedItem{
+				Title:         item.Title,
+				FailureReason: enqueueRequestCreationFailureReason(result),
+			})
+			continue
+		}
+		requests = append(requests, request)
+	}
+
+	if len(requests) == 0 {
+		return produceEnqueueMediaFailureResponse("None of the content in this collection can be enqueued")
+	}
+
+	ticket, err := channel.enqueueManager.RegisterBatchRequest(ctx, requests, r.Anonymous)
+	if err != nil {
+		if strings.Contains(err.Error(), "failed to check balance for account") {
+			return produceEnqueueMediaFailureResponse("The JungleTV payment subsystem is unavailable")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	resp := ticket.SerializeForAPI()
+	currentEntry, playing := channel.mediaQueue.CurrentlyPlaying()
+	resp.CurrentlyPlayingIsUnskippable = playing && (currentEntry.Unskippable() || !channel.mediaQueue.SkippingEnabled())
+	resp.PredictedEnqueuePosition = uint32(channel.mediaQueue.PredictedEnqueuePosition(ticket.RequestedBy()))
+	resp.FailedItems = failedItems
+
+	return &proto.EnqueueMediaResponse{
+		EnqueueResponse: &proto.EnqueueMediaResponse_Ticket{
+			Ticket: resp,
+		},
+	}, nil
+}
+
+func (s *grpcServer) continueEnqueueCollectionItem(ctx transaction.WrappingContext, r *proto.EnqueueMediaRequest, provider media.Provider,
+	preInfo media.InitialInfo, seenMedia map[string]struct{}) (media.EnqueueRequest, media.EnqueueRequestCreationResult, error) {
+	mediaType, mediaID := preInfo.MediaID()
+	key := string(mediaType) + ":" + mediaID
+	if _, seen := seenMedia[key]; seen {
+		return nil, media.EnqueueRequestCreationFailedMediumIsRepeatedInCollection, nil
+	}
+	seenMedia[key] = struct{}{}
+
+	allowed, err := media.IsAllowed(ctx, preInfo)
+	if err != nil {
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+	if !allowed {
+		return nil, media.EnqueueRequestCreationFailedMediumIsDisallowed, nil
+	}
+
+	request, result, err := provider.ContinueEnqueueRequest(ctx, preInfo, r.Unskippable, r.Concealed, r.Anonymous,
+		s.allowMediaEnqueuing == proto.AllowedMediaEnqueuingType_STAFF_ONLY,
+		s.allowMediaEnqueuing == proto.AllowedMediaEnqueuingType_STAFF_ONLY,
+		s.allowMediaEnqueuing == proto.AllowedMediaEnqueuingType_STAFF_ONLY)
+	return request, result, stacktrace.Propagate(err, "")
+}
+
 func produceEnqueueRequestCreationFailedResponse(result media.EnqueueRequestCreationResult) (*proto.EnqueueMediaResponse, error) {
+	return produceEnqueueMediaFailureResponse(enqueueRequestCreationFailureReason(result))
+}
+
+func enqueueRequestCreationFailureReason(result media.EnqueueRequestCreationResult) string {
 	switch result {
 	case media.EnqueueRequestCreationFailedMediumNotFound:
-		return produceEnqueueMediaFailureResponse("Content not found")
+		return "Content not found"
 	case media.EnqueueRequestCreationFailedMediumAgeRestricted:
-		return produceEnqueueMediaFailureResponse("This content is age-restricted")
+		return "This content is age-restricted"
 	case media.EnqueueRequestCreationFailedMediumIsUpcomingLiveBroadcast:
-		return produceEnqueueMediaFailureResponse("This is an upcoming live broadcast")
+		return "This is an upcoming live broadcast"
 	case media.EnqueueRequestCreationFailedMediumIsUnpopularLiveBroadcast:
-		return produceEnqueueMediaFailureResponse("This live broadcast has insufficient viewers to be allowed on JungleTV")
+		return "This live broadcast has insufficient viewers to be allowed on JungleTV"
 	case media.EnqueueRequestCreationFailedMediumIsNotEmbeddable:
-		return produceEnqueueMediaFailureResponse("This content can't be played outside of its original website")
+		return "This content can't be played outside of its original website"
 	case media.EnqueueRequestCreationFailedMediumIsTooLong:
-		return produceEnqueueMediaFailureResponse("This content is longer than 35 minutes")
+		return "This content is longer than 35 minutes"
 	case media.EnqueueRequestCreationFailedMediumIsAlreadyInQueue:
-		return produceEnqueueMediaFailureResponse("This content (or the selected time range) is already in the queue")
+		return "This content (or the selected time range) is already in the queue"
 	case media.EnqueueRequestCreationFailedMediumPlayedTooRecently:
-		return produceEnqueueMediaFailureResponse("This content (or the selected time range) was last played on JungleTV too recently")
+		return "This content (or the selected time range) was last played on JungleTV too recently"
 	case media.EnqueueRequestCreationFailedMediumIsDisallowed:
-		return produceEnqueueMediaFailureResponse("This content is disallowed on JungleTV")
+		return "This content is disallowed on JungleTV"
 	case media.EnqueueRequestCreationFailedMediumIsNotATrack:
-		return produceEnqueueMediaFailureResponse("This is not a SoundCloud track")
+		return "This is not a SoundCloud track"
+	case media.EnqueueRequestCreationFailedMediumFormatIsUnsupported:
+		return "This media format is not supported"
+	case media.EnqueueRequestCreationFailedMediumIsRepeatedInCollection:
+		return "This content appears more than once in the collection"
+	case media.EnqueueRequestCreationFailedCollectionIsEmpty:
+		return "This collection is empty"
 	default:
-		return produceEnqueueMediaFailureResponse("Enqueue request failed")
+		return "Enqueue request failed"
 	}
 }
 
@@ -213,7 +312,7 @@ func produceEnqueueMediaFailureResponse(reason string) (*proto.EnqueueMediaRespo
 }
 
 func (s *grpcServer) MonitorTicket(r *proto.MonitorTicketRequest, stream proto.JungleTV_MonitorTicketServer) error {
-	ticket := s.enqueueManager.GetTicket(r.TicketId)
+	ticket, channel := s.enqueueTicket(r.TicketId)
 	if ticket == nil {
 		err := stream.Send(&proto.EnqueueMediaTicket{
 			Id:     r.TicketId,
@@ -222,26 +321,27 @@ func (s *grpcServer) MonitorTicket(r *proto.MonitorTicketRequest, stream proto.J
 		return stacktrace.Propagate(err, "")
 	}
 
-	onMediaChanged, mediaChangedU := s.mediaQueue.MediaChanged().Subscribe(event.BufferFirst)
-	defer mediaChangedU()
+	onQueueUpdated, queueUpdatedU := channel.mediaQueue.QueueUpdated().Subscribe(event.BufferFirst)
+	defer queueUpdatedU()
 
-	onSkippingAllowedUpdated, skippingAllowedUpdatedU := s.mediaQueue.SkippingAllowedUpdated().Subscribe(event.BufferFirst)
+	onSkippingAllowedUpdated, skippingAllowedUpdatedU := channel.mediaQueue.SkippingAllowedUpdated().Subscribe(event.BufferFirst)
 	defer skippingAllowedUpdatedU()
 
 	c, unsub := ticket.StatusChanged().Subscribe(event.BufferFirst)
 	defer unsub()
 	for {
 		response := ticket.SerializeForAPI()
-		currentEntry, playing := s.mediaQueue.CurrentlyPlaying()
-		response.CurrentlyPlayingIsUnskippable = playing && (currentEntry.Unskippable() || !s.mediaQueue.SkippingEnabled())
+		currentEntry, playing := channel.mediaQueue.CurrentlyPlaying()
+		response.CurrentlyPlayingIsUnskippable = playing && (currentEntry.Unskippable() || !channel.mediaQueue.SkippingEnabled())
+		response.PredictedEnqueuePosition = uint32(channel.mediaQueue.PredictedEnqueuePosition(ticket.RequestedBy()))
 
 		if err := stream.Send(response); err != nil {
 			return stacktrace.Propagate(err, "")
 		}
 
 		select {
-		case <-onMediaChanged:
-			// unblock loop
+		case <-onQueueUpdated:
+			// unblock loop (this includes changes to the currently playing entry)
 		case <-onSkippingAllowedUpdated:
 			// unblock loop
 		case <-c:
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"context"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *grpcServer) ReconcileJournal(ctx context.Context, r *proto.ReconcileJournalRequest) (*proto.ReconcileJournalResponse, error) {
+	report, err := s.journal.Reconcile(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	discrepancies := make([]*proto.JournalDiscrepancy, len(report.Discrepancies))
+	for i, discrepancy := range report.Discrepancies {
+		discrepancies[i] = &proto.JournalDiscrepancy{
+			Account:         string(discrepancy.Account),
+			ExpectedBalance: discrepancy.Expected.SerializeForAPI(),
+			ActualBalance:   discrepancy.Actual.SerializeForAPI(),
+		}
+	}
+
+	return &proto.ReconcileJournalResponse{
+		CheckedAt:       timestamppb.New(report.CheckedAt),
+		CheckedAccounts: uint32(report.CheckedAccounts),
+		Discrepancies:   discrepancies,
+	}, nil
+}
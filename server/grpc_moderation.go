@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/bwmarrin/snowflake"
 	"github.com/palantir/stacktrace"
@@ -13,8 +14,10 @@ import (
 	"github.com/tnyim/jungletv/proto"
 	"github.com/tnyim/jungletv/server/auth"
 	"github.com/tnyim/jungletv/server/components/chatmanager"
+	"github.com/tnyim/jungletv/server/components/mediaqueue"
 	"github.com/tnyim/jungletv/server/components/pointsmanager"
 	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/server/components/rewards"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
 	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils/transaction"
@@ -29,13 +32,29 @@ func (s *grpcServer) ForciblyEnqueueTicket(ctx context.Context, r *proto.Forcibl
 		return nil, status.Error(codes.Unauthenticated, "missing user claims")
 	}
 
-	ticket := s.enqueueManager.GetTicket(r.Id)
+	ticket, _ := s.enqueueTicket(r.Id)
 	if ticket == nil {
 		return nil, stacktrace.NewError("unknown ticket ID")
 	}
 	if ticket.Status() != proto.EnqueueMediaTicketStatus_ACTIVE {
 		return nil, stacktrace.NewError("ticket no longer active")
 	}
+	if r.Schedule != nil {
+		if !r.Schedule.PlannedStart.IsValid() {
+			return nil, status.Error(codes.InvalidArgument, "invalid planned start")
+		}
+		if !r.Schedule.PlannedStart.AsTime().After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "planned start must be in the future")
+		}
+		mode, err := schedulingModeFromProto(r.Schedule.Mode)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		ticket.ForceScheduling(r.Schedule.PlannedStart.AsTime(), mode)
+
+		s.log.Printf("Ticket %s forcibly scheduled for %s by %s (remote address %s)", r.Id, r.Schedule.PlannedStart.AsTime().String(), user.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+		return &proto.ForciblyEnqueueTicketResponse{}, nil
+	}
 	ticket.ForceEnqueuing(r.EnqueueType)
 
 	s.log.Printf("Ticket %s forcibly enqueued by %s (remote address %s)", r.Id, user.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
@@ -49,7 +68,7 @@ func (s *grpcServer) RemoveQueueEntry(ctx context.Context, r *proto.RemoveQueueE
 		return nil, status.Error(codes.Unauthenticated, "missing user claims")
 	}
 
-	entry, err := s.mediaQueue.RemoveEntry(r.Id)
+	entry, err := s.mediaQueueWithEntry(r.Id).RemoveEntry(r.Id)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "failed to remove queue entry")
 	}
@@ -469,6 +488,190 @@ func (s *grpcServer) SetQueueEntryReorderingAllowed(ctx context.Context, r *prot
 	return &proto.SetQueueEntryReorderingAllowedResponse{}, nil
 }
 
+func (s *grpcServer) SetQueueOrderingPolicy(ctx context.Context, r *proto.SetQueueOrderingPolicyRequest) (*proto.SetQueueOrderingPolicyResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	var policy mediaqueue.OrderingPolicy
+	var description string
+	switch r.Policy {
+	case proto.QueueOrderingPolicy_QUEUE_ORDERING_POLICY_FIFO:
+		policy = mediaqueue.FIFOOrderingPolicy{}
+		description = "first in, first out"
+	case proto.QueueOrderingPolicy_QUEUE_ORDERING_POLICY_ROUND_ROBIN:
+		policy = mediaqueue.RoundRobinOrderingPolicy{}
+		description = "round-robin across requesters"
+	case proto.QueueOrderingPolicy_QUEUE_ORDERING_POLICY_MAX_CONSECUTIVE:
+		if r.MaxConsecutiveEntries < 1 {
+			return nil, status.Error(codes.InvalidArgument, "the maximum number of consecutive entries must be at least 1")
+		}
+		policy = mediaqueue.MaxConsecutiveOrderingPolicy{MaxConsecutive: int(r.MaxConsecutiveEntries)}
+		description = fmt.Sprintf("at most %d consecutive entries per requester", r.MaxConsecutiveEntries)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unknown queue ordering policy")
+	}
+
+	channel.mediaQueue.SetOrderingPolicy(policy)
+
+	s.log.Printf("Queue ordering policy of channel %s set to %s by %s (remote address %s)", channel.id, description, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) set the queue ordering policy of channel %s to %s",
+				moderator.Address()[:14], moderator.ModeratorName(), channel.name, description))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.SetQueueOrderingPolicyResponse{}, nil
+}
+
+func (s *grpcServer) PauseBroadcast(ctx context.Context, r *proto.PauseBroadcastRequest) (*proto.PauseBroadcastResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	r.Reason = strings.TrimSpace(r.Reason)
+	if len(r.Reason) > 512 {
+		return nil, status.Error(codes.InvalidArgument, "reason too long")
+	}
+
+	channel.mediaQueue.Pause(r.Reason)
+
+	s.log.Printf("Broadcast of channel %s paused by %s (remote address %s) with reason: %s", channel.id, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx), r.Reason)
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) paused the broadcast of channel %s with reason: %s",
+				moderator.Address()[:14], moderator.ModeratorName(), channel.name, r.Reason))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.PauseBroadcastResponse{}, nil
+}
+
+func (s *grpcServer) ResumeBroadcast(ctx context.Context, r *proto.ResumeBroadcastRequest) (*proto.ResumeBroadcastResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = channel.mediaQueue.Resume()
+	if err != nil {
+		if errors.Is(err, mediaqueue.ErrBroadcastNotPaused) {
+			return nil, status.Error(codes.FailedPrecondition, "broadcast not paused")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	pausedFor := time.Since(channel.mediaQueue.PauseState().PausedAt).Round(time.Second)
+	s.log.Printf("Broadcast of channel %s resumed by %s (remote address %s) after being paused for %s", channel.id, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx), pausedFor)
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) resumed the broadcast of channel %s after it was paused for %s",
+				moderator.Address()[:14], moderator.ModeratorName(), channel.name, pausedFor))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.ResumeBroadcastResponse{}, nil
+}
+
+func serializeQueueOrderingPolicy(policy mediaqueue.OrderingPolicy) (proto.QueueOrderingPolicy, int32) {
+	switch p := policy.(type) {
+	case mediaqueue.RoundRobinOrderingPolicy:
+		return proto.QueueOrderingPolicy_QUEUE_ORDERING_POLICY_ROUND_ROBIN, 0
+	case mediaqueue.MaxConsecutiveOrderingPolicy:
+		return proto.QueueOrderingPolicy_QUEUE_ORDERING_POLICY_MAX_CONSECUTIVE, int32(p.MaxConsecutive)
+	default:
+		return proto.QueueOrderingPolicy_QUEUE_ORDERING_POLICY_FIFO, 0
+	}
+}
+
+func (s *grpcServer) SetRewardDistributionStrategy(ctx context.Context, r *proto.SetRewardDistributionStrategyRequest) (*proto.SetRewardDistributionStrategyResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	var strategy rewards.DistributionStrategy
+	var description string
+	switch r.Strategy {
+	case proto.RewardDistributionStrategy_REWARD_DISTRIBUTION_STRATEGY_EQUAL:
+		strategy = rewards.EqualDistributionStrategy{}
+		description = "equal split"
+	case proto.RewardDistributionStrategy_REWARD_DISTRIBUTION_STRATEGY_WATCH_TIME:
+		strategy = rewards.WatchTimeDistributionStrategy{}
+		description = "split weighted by watch time"
+	case proto.RewardDistributionStrategy_REWARD_DISTRIBUTION_STRATEGY_ACTIVITY_TIER:
+		strategy = rewards.ActivityTierDistributionStrategy{}
+		description = "split weighted by activity tier"
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unknown reward distribution strategy")
+	}
+
+	err = s.rewardsHandler.SetDistributionStrategy(ctx, channel.id, strategy)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Reward distribution strategy of channel %s set to %s by %s (remote address %s)", channel.id, description, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) set the reward distribution strategy of channel %s to %s",
+				moderator.Address()[:14], moderator.ModeratorName(), channel.name, description))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.SetRewardDistributionStrategyResponse{}, nil
+}
+
+func serializeRewardDistributionStrategy(strategy rewards.DistributionStrategy) proto.RewardDistributionStrategy {
+	switch strategy.(type) {
+	case rewards.WatchTimeDistributionStrategy:
+		return proto.RewardDistributionStrategy_REWARD_DISTRIBUTION_STRATEGY_WATCH_TIME
+	case rewards.ActivityTierDistributionStrategy:
+		return proto.RewardDistributionStrategy_REWARD_DISTRIBUTION_STRATEGY_ACTIVITY_TIER
+	default:
+		return proto.RewardDistributionStrategy_REWARD_DISTRIBUTION_STRATEGY_EQUAL
+	}
+}
+
 func (s *grpcServer) SetQueueInsertCursor(ctx context.Context, r *proto.SetQueueInsertCursorRequest) (*proto.SetQueueInsertCursorResponse, error) {
 	moderator := authinterceptor.UserFromContext(ctx)
 	if moderator == nil {
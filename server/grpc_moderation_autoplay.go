@@ -0,0 +1,344 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/samber/lo"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/components/autoplay"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func (s *grpcServer) AutoplayPools(ctxCtx context.Context, r *proto.AutoplayPoolsRequest) (*proto.AutoplayPoolsResponse, error) {
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	pools, err := types.GetAutoplayPools(ctx, channel.id)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	now := time.Now()
+	return &proto.AutoplayPoolsResponse{
+		Pools: lo.Map(pools, func(pool *types.AutoplayPool, _ int) *proto.AutoplayPool {
+			return serializeAutoplayPool(pool, now)
+		}),
+	}, nil
+}
+
+func (s *grpcServer) SetAutoplayPool(ctxCtx context.Context, r *proto.SetAutoplayPoolRequest) (*proto.SetAutoplayPoolResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctxCtx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Name == "" || len(r.Name) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "invalid name")
+	}
+	if r.Weight < 0 || r.HistoryMinPlays < 0 {
+		return nil, status.Error(codes.InvalidArgument, "weight and minimum number of plays must not be negative")
+	}
+	for _, d := range []*durationpb.Duration{r.ActiveFrom, r.ActiveUntil} {
+		if d.AsDuration() < 0 || d.AsDuration() >= 24*time.Hour {
+			return nil, status.Error(codes.InvalidArgument, "active period bounds must be within a day")
+		}
+	}
+
+	pool := &types.AutoplayPool{
+		ID:              uuid.NewV4().String(),
+		ChannelID:       channel.id,
+		Name:            r.Name,
+		Source:          types.AutoplayPoolSourceList,
+		Enabled:         r.Enabled,
+		Weight:          int(r.Weight),
+		Cooldown:        types.Duration(r.Cooldown.AsDuration()),
+		ActiveFrom:      types.Duration(r.ActiveFrom.AsDuration()),
+		ActiveUntil:     types.Duration(r.ActiveUntil.AsDuration()),
+		HistoryLookback: types.Duration(r.HistoryLookback.AsDuration()),
+		HistoryMinPlays: int(r.HistoryMinPlays),
+		UpdatedBy:       moderator.Address(),
+		UpdatedAt:       time.Now(),
+	}
+	if r.Source == proto.AutoplayPoolSource_AUTOPLAY_POOL_SOURCE_HISTORY {
+		pool.Source = types.AutoplayPoolSourceHistory
+		if pool.HistoryLookback <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "history pools must have a positive lookback period")
+		}
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	if r.Id != nil {
+		existing, err := types.GetAutoplayPoolWithID(ctx, *r.Id)
+		if err != nil {
+			if errors.Is(err, types.ErrAutoplayPoolNotFound) {
+				return nil, status.Error(codes.NotFound, "autoplay pool not found")
+			}
+			return nil, stacktrace.Propagate(err, "")
+		}
+		if existing.ChannelID != pool.ChannelID {
+			return nil, status.Error(codes.InvalidArgument, "the autoplay pool belongs to a different channel")
+		}
+		if existing.Source != pool.Source {
+			return nil, status.Error(codes.InvalidArgument, "the source of an existing pool can not be changed")
+		}
+		pool.ID = existing.ID
+	}
+
+	err = pool.Update(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Autoplay pool %s (%s) on channel %s set by %s (remote address %s)", pool.ID, pool.Name, channel.id, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		enabledStr := "disabled"
+		if pool.Enabled {
+			enabledStr = "enabled"
+		}
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) set %s autoplay pool \"%s\" of channel %s with weight %d",
+				moderator.Address()[:14], moderator.ModeratorName(), enabledStr, pool.Name, channel.name, pool.Weight))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.SetAutoplayPoolResponse{
+		Pool: serializeAutoplayPool(pool, time.Now()),
+	}, nil
+}
+
+func (s *grpcServer) RemoveAutoplayPool(ctxCtx context.Context, r *proto.RemoveAutoplayPoolRequest) (*proto.RemoveAutoplayPoolResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctxCtx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	pool, err := types.GetAutoplayPoolWithID(ctx, r.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrAutoplayPoolNotFound) {
+			return nil, status.Error(codes.NotFound, "autoplay pool not found")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = pool.Delete(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Autoplay pool %s (%s) removed by %s (remote address %s)", pool.ID, pool.Name, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) removed autoplay pool \"%s\"",
+				moderator.Address()[:14], moderator.ModeratorName(), pool.Name))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.RemoveAutoplayPoolResponse{}, nil
+}
+
+func (s *grpcServer) AutoplayPoolEntries(ctxCtx context.Context, r *proto.AutoplayPoolEntriesRequest) (*proto.AutoplayPoolEntriesResponse, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	entries, err := types.GetAutoplayPoolEntries(ctx, r.PoolId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.AutoplayPoolEntriesResponse{
+		Entries: lo.Map(entries, func(entry *types.AutoplayPoolEntry, _ int) *proto.AutoplayPoolEntry {
+			return serializeAutoplayPoolEntry(entry)
+		}),
+	}, nil
+}
+
+func (s *grpcServer) AddAutoplayPoolEntry(ctxCtx context.Context, r *proto.AddAutoplayPoolEntryRequest) (*proto.AddAutoplayPoolEntryResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctxCtx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	if r.Weight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "weight must not be negative")
+	}
+
+	entry := &types.AutoplayPoolEntry{
+		ID:     uuid.NewV4().String(),
+		PoolID: r.PoolId,
+		Weight: int(r.Weight),
+	}
+	switch m := r.Media.(type) {
+	case *proto.AddAutoplayPoolEntryRequest_YoutubeVideoId:
+		entry.MediaType = types.MediaTypeYouTubeVideo
+		entry.MediaReference = m.YoutubeVideoId
+	case *proto.AddAutoplayPoolEntryRequest_SoundcloudTrackPermalink:
+		entry.MediaType = types.MediaTypeSoundCloudTrack
+		entry.MediaReference = m.SoundcloudTrackPermalink
+	default:
+		return nil, status.Error(codes.InvalidArgument, "missing media")
+	}
+	if entry.MediaReference == "" || len(entry.MediaReference) > 200 {
+		return nil, status.Error(codes.InvalidArgument, "invalid media")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	pool, err := types.GetAutoplayPoolWithID(ctx, r.PoolId)
+	if err != nil {
+		if errors.Is(err, types.ErrAutoplayPoolNotFound) {
+			return nil, status.Error(codes.NotFound, "autoplay pool not found")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if pool.Source != types.AutoplayPoolSourceList {
+		return nil, status.Error(codes.FailedPrecondition, "entries can only be added to list pools")
+	}
+
+	err = entry.Update(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Media %s added to autoplay pool %s (%s) by %s (remote address %s)", entry.MediaReference, pool.ID, pool.Name, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	return &proto.AddAutoplayPoolEntryResponse{
+		Entry: serializeAutoplayPoolEntry(entry),
+	}, nil
+}
+
+func (s *grpcServer) RemoveAutoplayPoolEntry(ctxCtx context.Context, r *proto.RemoveAutoplayPoolEntryRequest) (*proto.RemoveAutoplayPoolEntryResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctxCtx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	entry, err := types.GetAutoplayPoolEntryWithID(ctx, r.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrAutoplayPoolEntryNotFound) {
+			return nil, status.Error(codes.NotFound, "autoplay pool entry not found")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = entry.Delete(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Media %s removed from autoplay pool %s by %s (remote address %s)", entry.MediaReference, entry.PoolID, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	return &proto.RemoveAutoplayPoolEntryResponse{}, nil
+}
+
+func serializeAutoplayPool(pool *types.AutoplayPool, now time.Time) *proto.AutoplayPool {
+	source := proto.AutoplayPoolSource_AUTOPLAY_POOL_SOURCE_LIST
+	if pool.Source == types.AutoplayPoolSourceHistory {
+		source = proto.AutoplayPoolSource_AUTOPLAY_POOL_SOURCE_HISTORY
+	}
+	return &proto.AutoplayPool{
+		Id:              pool.ID,
+		Name:            pool.Name,
+		Source:          source,
+		Enabled:         pool.Enabled,
+		Weight:          int32(pool.Weight),
+		Cooldown:        durationpb.New(time.Duration(pool.Cooldown)),
+		ActiveFrom:      durationpb.New(time.Duration(pool.ActiveFrom)),
+		ActiveUntil:     durationpb.New(time.Duration(pool.ActiveUntil)),
+		HistoryLookback: durationpb.New(time.Duration(pool.HistoryLookback)),
+		HistoryMinPlays: int32(pool.HistoryMinPlays),
+		Active:          autoplay.PoolActiveAt(pool, now),
+		ChannelId:       pool.ChannelID,
+	}
+}
+
+func serializeAutoplayPoolEntry(entry *types.AutoplayPoolEntry) *proto.AutoplayPoolEntry {
+	serialized := &proto.AutoplayPoolEntry{
+		Id:     entry.ID,
+		PoolId: entry.PoolID,
+		Weight: int32(entry.Weight),
+	}
+	switch entry.MediaType {
+	case types.MediaTypeYouTubeVideo:
+		serialized.Media = &proto.AutoplayPoolEntry_YoutubeVideoId{YoutubeVideoId: entry.MediaReference}
+	case types.MediaTypeSoundCloudTrack:
+		serialized.Media = &proto.AutoplayPoolEntry_SoundcloudTrackPermalink{SoundcloudTrackPermalink: entry.MediaReference}
+	}
+	return serialized
+}
@@ -65,6 +65,8 @@ func convertDisallowedMediaEntry(ctx context.Context, orig *types.DisallowedMedi
 		m.MediaType = proto.DisallowedMediaType_DISALLOWED_MEDIA_TYPE_YOUTUBE_VIDEO
 	case types.MediaTypeSoundCloudTrack:
 		m.MediaType = proto.DisallowedMediaType_DISALLOWED_MEDIA_TYPE_SOUNDCLOUD_TRACK
+	case types.MediaTypeDirectMedia:
+		m.MediaType = proto.DisallowedMediaType_DISALLOWED_MEDIA_TYPE_DIRECT_MEDIA
 	}
 	return m
 }
@@ -131,6 +133,8 @@ func (s *grpcServer) AddDisallowedMedia(ctxCtx context.Context, r *proto.AddDisa
 		mediaTypeString = "YouTube video"
 	case types.MediaTypeSoundCloudTrack:
 		mediaTypeString = "SoundCloud track"
+	case types.MediaTypeDirectMedia:
+		mediaTypeString = "Direct media"
 	}
 
 	if s.modLogWebhook != nil {
@@ -191,6 +195,8 @@ func (s *grpcServer) RemoveDisallowedMedia(ctxCtx context.Context, r *proto.Remo
 		mediaTypeString = "YouTube video"
 	case types.MediaTypeSoundCloudTrack:
 		mediaTypeString = "SoundCloud track"
+	case types.MediaTypeDirectMedia:
+		mediaTypeString = "Direct media"
 	}
 
 	if s.modLogWebhook != nil {
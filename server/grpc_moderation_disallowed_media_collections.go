@@ -65,6 +65,8 @@ func convertDisallowedMediaCollection(ctx context.Context, orig *types.Disallowe
 		m.CollectionType = proto.DisallowedMediaCollectionType_DISALLOWED_MEDIA_COLLECTION_TYPE_YOUTUBE_CHANNEL
 	case types.MediaCollectionTypeSoundCloudUser:
 		m.CollectionType = proto.DisallowedMediaCollectionType_DISALLOWED_MEDIA_COLLECTION_TYPE_SOUNDCLOUD_USER
+	case types.MediaCollectionTypeDirectMediaHost:
+		m.CollectionType = proto.DisallowedMediaCollectionType_DISALLOWED_MEDIA_COLLECTION_TYPE_DIRECT_MEDIA_HOST
 	}
 	return m
 }
@@ -132,6 +134,8 @@ func (s *grpcServer) AddDisallowedMediaCollection(ctxCtx context.Context, r *pro
 			mediaTypeString = "YouTube channel"
 		case types.MediaCollectionTypeSoundCloudUser:
 			mediaTypeString = "SoundCloud user"
+		case types.MediaCollectionTypeDirectMediaHost:
+			mediaTypeString = "Direct media host"
 		}
 
 		if s.modLogWebhook != nil {
@@ -194,6 +198,8 @@ func (s *grpcServer) RemoveDisallowedMediaCollection(ctxCtx context.Context, r *
 		mediaTypeString = "YouTube channel"
 	case types.MediaCollectionTypeSoundCloudUser:
 		mediaTypeString = "SoundCloud user"
+	case types.MediaCollectionTypeDirectMediaHost:
+		mediaTypeString = "Direct media host"
 	}
 
 	if s.modLogWebhook != nil {
@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/palantir/stacktrace"
+	"github.com/samber/lo"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/medialibrary"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/server/media/directmedia"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MaxLibraryMediaFileSize is the maximum size of the files that can be uploaded to the media library.
+// The maximum message size of the gRPC server that handles uploads must be large enough to fit files of this size
+const MaxLibraryMediaFileSize = 100 * 1024 * 1024
+
+const maxLibraryMediaTitleLength = 100
+
+func (s *grpcServer) LibraryMedia(ctxCtx context.Context, r *proto.LibraryMediaRequest) (*proto.LibraryMediaResponse, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	searchQuery := ""
+	if len(r.SearchQuery) >= 3 {
+		searchQuery = r.SearchQuery
+	}
+
+	files, total, err := types.GetLibraryMedia(ctx, searchQuery, readPaginationParameters(r))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.LibraryMediaResponse{
+		Files: lo.Map(files, func(file *types.LibraryMedia, _ int) *proto.LibraryMediaFile {
+			return s.serializeLibraryMedia(ctx, file)
+		}),
+		Offset: readOffset(r),
+		Total:  total,
+	}, nil
+}
+
+func (s *grpcServer) serializeLibraryMedia(ctx context.Context, file *types.LibraryMedia) *proto.LibraryMediaFile {
+	return &proto.LibraryMediaFile{
+		Id:         file.ID,
+		Title:      file.Title,
+		Url:        medialibrary.URL(s.websiteURL, file.ID),
+		Format:     directmedia.FormatToProto(file.Format),
+		Duration:   durationpb.New(time.Duration(file.Duration)),
+		FileSize:   uint64(file.FileSize),
+		Public:     file.Public,
+		UploadedBy: s.userSerializer(ctx, auth.NewAddressOnlyUser(file.UploadedBy)),
+		UploadedAt: timestamppb.New(file.UploadedAt),
+	}
+}
+
+func (s *grpcServer) UploadLibraryMedia(ctxCtx context.Context, r *proto.UploadLibraryMediaRequest) (*proto.UploadLibraryMediaResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctxCtx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	if len(r.Content) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing file content")
+	}
+	if len(r.Content) > MaxLibraryMediaFileSize {
+		return nil, status.Errorf(codes.InvalidArgument, "file exceeds the maximum size of %d bytes", MaxLibraryMediaFileSize)
+	}
+
+	fileInfo, ok := directmedia.ProbeFile(r.Content)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unsupported file format or unknown media duration")
+	}
+
+	title := strings.TrimSpace(r.Title)
+	if title == "" {
+		title = fileInfo.Title
+	}
+	if title == "" {
+		return nil, status.Error(codes.InvalidArgument, "a title is required as the file does not specify one")
+	}
+	if !utf8.ValidString(title) || utf8.RuneCountInString(title) > maxLibraryMediaTitleLength {
+		return nil, status.Error(codes.InvalidArgument, "invalid title")
+	}
+
+	file := &types.LibraryMedia{
+		ID:         uuid.NewV4().String(),
+		Title:      title,
+		Format:     fileInfo.Format,
+		Duration:   types.Duration(fileInfo.Duration),
+		FileSize:   int64(len(r.Content)),
+		Public:     r.Public,
+		UploadedBy: moderator.Address(),
+		UploadedAt: time.Now(),
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	err = file.Update(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	// store the file before committing, so that the library never references a file that does not exist
+	err = s.mediaLibrary.Store(file.ID, r.Content)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		// not much we can do if this also fails, other than leave an orphaned file behind
+		_ = s.mediaLibrary.Remove(file.ID)
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Library media %s (%s) uploaded by %s (remote address %s)", file.ID, file.Title, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) uploaded \"%s\" (`%s`, %s) to the media library",
+				moderator.Address()[:14], moderator.ModeratorName(), file.Title, file.ID, time.Duration(file.Duration)))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.UploadLibraryMediaResponse{
+		File: s.serializeLibraryMedia(ctxCtx, file),
+	}, nil
+}
+
+func (s *grpcServer) RemoveLibraryMedia(ctxCtx context.Context, r *proto.RemoveLibraryMediaRequest) (*proto.RemoveLibraryMediaResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctxCtx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	file, err := types.GetLibraryMediaWithID(ctx, r.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrLibraryMediaNotFound) {
+			return nil, status.Error(codes.NotFound, "library media not found")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = file.Delete(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	// played media history keeps its own copy of the media information, so it is not affected by the removal.
+	// Queue entries for this media will fail to load once they start playing
+	err = s.mediaLibrary.Remove(file.ID)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Library media %s (%s) removed by %s (remote address %s)", file.ID, file.Title, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) removed \"%s\" (`%s`) from the media library",
+				moderator.Address()[:14], moderator.ModeratorName(), file.Title, file.ID))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.RemoveLibraryMediaResponse{}, nil
+}
@@ -0,0 +1,229 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hectorchu/gonano/util"
+	"github.com/palantir/stacktrace"
+	"github.com/samber/lo"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var replayRuleMediaTypes = []types.MediaType{
+	types.MediaTypeYouTubeVideo,
+	types.MediaTypeSoundCloudTrack,
+	types.MediaTypeDocument,
+	types.MediaTypeDirectMedia,
+	types.MediaTypeLibraryMedia,
+}
+
+func (s *grpcServer) MediaReplayRules(ctxCtx context.Context, r *proto.MediaReplayRulesRequest) (*proto.MediaReplayRulesResponse, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	rules, total, err := types.GetMediaReplayRules(ctx, readPaginationParameters(r))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.MediaReplayRulesResponse{
+		Rules: lo.Map(rules, func(rule *types.MediaReplayRule, _ int) *proto.MediaReplayRule {
+			return s.serializeMediaReplayRule(ctx, rule)
+		}),
+		Offset: readOffset(r),
+		Total:  total,
+	}, nil
+}
+
+func (s *grpcServer) serializeMediaReplayRule(ctx context.Context, rule *types.MediaReplayRule) *proto.MediaReplayRule {
+	serialized := &proto.MediaReplayRule{
+		Id:                   rule.ID,
+		MediaType:            string(rule.MediaType),
+		CollectionId:         rule.CollectionID,
+		Requester:            rule.Requester,
+		Cooldown:             durationpb.New(time.Duration(rule.Cooldown)),
+		OverlapTolerance:     durationpb.New(time.Duration(rule.OverlapTolerance)),
+		MaxBroadcastPlayTime: durationpb.New(time.Duration(rule.MaxBroadcastPlayTime)),
+		UpdatedBy:            s.userSerializer(ctx, auth.NewAddressOnlyUser(rule.UpdatedBy)),
+		UpdatedAt:            timestamppb.New(rule.UpdatedAt),
+	}
+	if rule.CollectionType != nil {
+		var collectionType proto.DisallowedMediaCollectionType
+		switch *rule.CollectionType {
+		case types.MediaCollectionTypeYouTubeChannel:
+			collectionType = proto.DisallowedMediaCollectionType_DISALLOWED_MEDIA_COLLECTION_TYPE_YOUTUBE_CHANNEL
+		case types.MediaCollectionTypeSoundCloudUser:
+			collectionType = proto.DisallowedMediaCollectionType_DISALLOWED_MEDIA_COLLECTION_TYPE_SOUNDCLOUD_USER
+		case types.MediaCollectionTypeDirectMediaHost:
+			collectionType = proto.DisallowedMediaCollectionType_DISALLOWED_MEDIA_COLLECTION_TYPE_DIRECT_MEDIA_HOST
+		}
+		serialized.CollectionType = &collectionType
+	}
+	return serialized
+}
+
+func (s *grpcServer) SetMediaReplayRule(ctxCtx context.Context, r *proto.SetMediaReplayRuleRequest) (*proto.SetMediaReplayRuleResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctxCtx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	rule := &types.MediaReplayRule{
+		ID:                   uuid.NewV4().String(),
+		MediaType:            types.MediaType(r.MediaType),
+		Cooldown:             types.Duration(r.Cooldown.AsDuration()),
+		OverlapTolerance:     types.Duration(r.OverlapTolerance.AsDuration()),
+		MaxBroadcastPlayTime: types.Duration(r.MaxBroadcastPlayTime.AsDuration()),
+		UpdatedBy:            moderator.Address(),
+		UpdatedAt:            time.Now(),
+	}
+	if !lo.Contains(replayRuleMediaTypes, rule.MediaType) {
+		return nil, status.Error(codes.InvalidArgument, "invalid media type")
+	}
+	if rule.Cooldown < 0 || rule.OverlapTolerance < 0 || rule.MaxBroadcastPlayTime < 0 {
+		return nil, status.Error(codes.InvalidArgument, "durations must not be negative")
+	}
+
+	if (r.CollectionType == nil) != (r.CollectionId == nil) {
+		return nil, status.Error(codes.InvalidArgument, "collection type and collection ID must be specified together")
+	}
+	if r.CollectionType != nil {
+		var collectionType types.MediaCollectionType
+		switch *r.CollectionType {
+		case proto.DisallowedMediaCollectionType_DISALLOWED_MEDIA_COLLECTION_TYPE_YOUTUBE_CHANNEL:
+			collectionType = types.MediaCollectionTypeYouTubeChannel
+		case proto.DisallowedMediaCollectionType_DISALLOWED_MEDIA_COLLECTION_TYPE_SOUNDCLOUD_USER:
+			collectionType = types.MediaCollectionTypeSoundCloudUser
+		case proto.DisallowedMediaCollectionType_DISALLOWED_MEDIA_COLLECTION_TYPE_DIRECT_MEDIA_HOST:
+			collectionType = types.MediaCollectionTypeDirectMediaHost
+		default:
+			return nil, status.Error(codes.InvalidArgument, "invalid collection type")
+		}
+		if *r.CollectionId == "" || len(*r.CollectionId) > 36 {
+			return nil, status.Error(codes.InvalidArgument, "invalid collection ID")
+		}
+		rule.CollectionType = &collectionType
+		rule.CollectionID = r.CollectionId
+	}
+	if r.Requester != nil {
+		// we must check for ban since AddressToPubkey accepts nano too
+		if _, err := util.AddressToPubkey(*r.Requester); err != nil || (*r.Requester)[:4] != "ban_" {
+			return nil, status.Error(codes.InvalidArgument, "invalid requester address")
+		}
+		rule.Requester = r.Requester
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	if r.Id != nil {
+		existing, err := types.GetMediaReplayRuleWithID(ctx, *r.Id)
+		if err != nil {
+			if errors.Is(err, types.ErrMediaReplayRuleNotFound) {
+				return nil, status.Error(codes.NotFound, "media replay rule not found")
+			}
+			return nil, stacktrace.Propagate(err, "")
+		}
+		rule.ID = existing.ID
+	}
+
+	err = rule.Update(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Media replay rule %s for media type %s set by %s (remote address %s)", rule.ID, rule.MediaType, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) set a replay rule for media type %s%s with a cooldown of %s",
+				moderator.Address()[:14], moderator.ModeratorName(), rule.MediaType, describeMediaReplayRuleScope(rule), time.Duration(rule.Cooldown)))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.SetMediaReplayRuleResponse{
+		Rule: s.serializeMediaReplayRule(ctxCtx, rule),
+	}, nil
+}
+
+func describeMediaReplayRuleScope(rule *types.MediaReplayRule) string {
+	scope := ""
+	if rule.CollectionType != nil {
+		scope += fmt.Sprintf(", collection %s %s", *rule.CollectionType, *rule.CollectionID)
+	}
+	if rule.Requester != nil {
+		scope += fmt.Sprintf(", requester %s", *rule.Requester)
+	}
+	return scope
+}
+
+func (s *grpcServer) RemoveMediaReplayRule(ctxCtx context.Context, r *proto.RemoveMediaReplayRuleRequest) (*proto.RemoveMediaReplayRuleResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctxCtx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	rule, err := types.GetMediaReplayRuleWithID(ctx, r.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrMediaReplayRuleNotFound) {
+			return nil, status.Error(codes.NotFound, "media replay rule not found")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = rule.Delete(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Media replay rule %s for media type %s removed by %s (remote address %s)", rule.ID, rule.MediaType, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) removed the replay rule for media type %s%s",
+				moderator.Address()[:14], moderator.ModeratorName(), rule.MediaType, describeMediaReplayRuleScope(rule)))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.RemoveMediaReplayRuleResponse{}, nil
+}
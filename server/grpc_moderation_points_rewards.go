@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/samber/lo"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/components/pointsrewards"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *grpcServer) SetPointsReward(ctx context.Context, r *proto.SetPointsRewardRequest) (*proto.SetPointsRewardResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	if r.Reward == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing reward")
+	}
+
+	reward := &types.PointsReward{
+		ID:            r.Reward.Id,
+		Title:         r.Reward.Title,
+		Description:   r.Reward.Description,
+		Cost:          int(r.Reward.Cost),
+		Stock:         optionalInt32ToIntPtr(r.Reward.Stock),
+		PerUserLimit:  optionalInt32ToIntPtr(r.Reward.PerUserLimit),
+		RequiresInput: r.Reward.RequiresInput,
+		Enabled:       r.Reward.Enabled,
+		UpdatedBy:     moderator.Address(),
+		UpdatedAt:     time.Now(),
+	}
+	if reward.ID == "" {
+		reward.ID = uuid.NewV4().String()
+	}
+
+	err := s.pointsRewardsManager.SetReward(ctx, reward, r.UpdateStock)
+	if err != nil {
+		if errors.Is(err, pointsrewards.ErrInvalidReward) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Points reward %s (%s) set by %s (remote address %s)", reward.ID, reward.Title, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) set points reward \"%s\" with a cost of %d points (enabled: %t)",
+				moderator.Address()[:14], moderator.ModeratorName(), reward.Title, reward.Cost, reward.Enabled))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.SetPointsRewardResponse{
+		Reward: convertPointsReward(reward),
+	}, nil
+}
+
+func (s *grpcServer) AllPointsRewardRedemptions(ctx context.Context, r *proto.AllPointsRewardRedemptionsRequest) (*proto.AllPointsRewardRedemptionsResponse, error) {
+	statuses := make([]types.PointsRewardRedemptionStatus, len(r.Statuses))
+	for i, st := range r.Statuses {
+		var err error
+		statuses[i], err = pointsRewardRedemptionStatusFromProto(st)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+
+	redemptions, total, err := s.pointsRewardsManager.Redemptions(ctx, statuses, r.RewardsAddress, readPaginationParameters(r))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.AllPointsRewardRedemptionsResponse{
+		Redemptions: lo.Map(redemptions, func(redemption *types.PointsRewardRedemption, _ int) *proto.PointsRewardRedemption {
+			return s.convertPointsRewardRedemption(ctx, redemption)
+		}),
+		Offset: readOffset(r),
+		Total:  total,
+	}, nil
+}
+
+func (s *grpcServer) UpdatePointsRewardRedemption(ctx context.Context, r *proto.UpdatePointsRewardRedemptionRequest) (*proto.UpdatePointsRewardRedemptionResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	redemptionStatus, err := pointsRewardRedemptionStatusFromProto(r.Status)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	redemption, err := s.pointsRewardsManager.UpdateRedemption(ctx, r.Id, redemptionStatus, r.StaffNote, moderator.Address())
+	if err != nil {
+		if errors.Is(err, types.ErrPointsRewardRedemptionNotFound) {
+			return nil, status.Error(codes.NotFound, "redemption not found")
+		}
+		if errors.Is(err, pointsrewards.ErrInvalidStatusTransition) {
+			return nil, status.Error(codes.FailedPrecondition, "invalid status transition")
+		}
+		if errors.Is(err, pointsrewards.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, "staff note too long")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Points reward redemption %s marked as %s by %s (remote address %s)", redemption.ID, redemption.Status, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) marked points reward redemption %s by user %s as %s",
+				moderator.Address()[:14], moderator.ModeratorName(), redemption.ID, redemption.RewardsAddress, redemption.Status))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.UpdatePointsRewardRedemptionResponse{
+		Redemption: s.convertPointsRewardRedemption(ctx, redemption),
+	}, nil
+}
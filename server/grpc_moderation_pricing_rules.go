@@ -0,0 +1,244 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/samber/lo"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/pricer"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *grpcServer) PricingRules(ctx context.Context, r *proto.PricingRulesRequest) (*proto.PricingRulesResponse, error) {
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.PricingRulesResponse{
+		Rules: lo.Map(channel.pricer.PricingRules(), func(rule *types.PricingRule, _ int) *proto.PricingRule {
+			return s.serializePricingRule(ctx, rule)
+		}),
+	}, nil
+}
+
+func (s *grpcServer) SetPricingRule(ctx context.Context, r *proto.SetPricingRuleRequest) (*proto.SetPricingRuleResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	if r.Rule == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing rule")
+	}
+	if r.Rule.Label == "" || len(r.Rule.Label) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "invalid label")
+	}
+
+	rule := &types.PricingRule{
+		ID:                          r.Rule.Id,
+		Label:                       r.Rule.Label,
+		AppliesToEnqueue:            r.Rule.AppliesToEnqueue,
+		AppliesToCrowdfundedSkip:    r.Rule.AppliesToCrowdfundedSkip,
+		AppliesToQueueEntryMovement: r.Rule.AppliesToQueueEntryMovement,
+		Multiplier:                  int(r.Rule.Multiplier),
+		MinQueueLength:              optionalInt32ToIntPtr(r.Rule.MinQueueLength),
+		MaxQueueLength:              optionalInt32ToIntPtr(r.Rule.MaxQueueLength),
+		MinSpectators:               optionalInt32ToIntPtr(r.Rule.MinSpectators),
+		MaxSpectators:               optionalInt32ToIntPtr(r.Rule.MaxSpectators),
+		UpdatedBy:                   moderator.Address(),
+		UpdatedAt:                   time.Now(),
+	}
+	if rule.ID == "" {
+		rule.ID = uuid.NewV4().String()
+	}
+	for _, weekday := range r.Rule.Weekdays {
+		if weekday < 0 || weekday > 6 {
+			return nil, status.Error(codes.InvalidArgument, "invalid weekday")
+		}
+		rule.Weekdays |= 1 << weekday
+	}
+	if r.Rule.StartTimeOfDay != nil {
+		d := types.Duration(r.Rule.StartTimeOfDay.AsDuration())
+		rule.StartTimeOfDay = &d
+	}
+	if r.Rule.EndTimeOfDay != nil {
+		d := types.Duration(r.Rule.EndTimeOfDay.AsDuration())
+		rule.EndTimeOfDay = &d
+	}
+	if r.Rule.ActiveFrom != nil {
+		rule.ActiveFrom = sql.NullTime{Time: r.Rule.ActiveFrom.AsTime(), Valid: true}
+	}
+	if r.Rule.ActiveUntil != nil {
+		rule.ActiveUntil = sql.NullTime{Time: r.Rule.ActiveUntil.AsTime(), Valid: true}
+	}
+
+	err = channel.pricer.SetPricingRule(ctx, rule)
+	if err != nil {
+		if errors.Is(err, pricer.ErrMultiplierOutOfBounds) {
+			return nil, status.Errorf(codes.InvalidArgument, "multiplier must be between %d and %d",
+				pricer.MinimumPricingRuleMultiplier, pricer.MaximumPricingRuleMultiplier)
+		}
+		if errors.Is(err, pricer.ErrInvalidPricingRule) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Pricing rule %s (%s) on channel %s set by %s (remote address %s)", rule.ID, rule.Label, channel.id, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) set pricing rule \"%s\" of channel %s with a multiplier of %d%%",
+				moderator.Address()[:14], moderator.ModeratorName(), rule.Label, channel.name, rule.Multiplier))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.SetPricingRuleResponse{
+		Rule: s.serializePricingRule(ctx, rule),
+	}, nil
+}
+
+func (s *grpcServer) RemovePricingRule(ctx context.Context, r *proto.RemovePricingRuleRequest) (*proto.RemovePricingRuleResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = channel.pricer.RemovePricingRule(ctx, r.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrPricingRuleNotFound) {
+			return nil, status.Error(codes.NotFound, "pricing rule not found")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Pricing rule %s removed from channel %s by %s (remote address %s)", r.Id, channel.id, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) removed pricing rule %s from channel %s",
+				moderator.Address()[:14], moderator.ModeratorName(), r.Id, channel.name))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.RemovePricingRuleResponse{}, nil
+}
+
+func (s *grpcServer) SimulatePricing(ctx context.Context, r *proto.SimulatePricingRequest) (*proto.SimulatePricingResponse, error) {
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	if r.MediaLength == nil || r.MediaLength.AsDuration() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid media length")
+	}
+
+	conditions := pricer.Conditions{
+		Time:               time.Now(),
+		QueueLength:        int(r.QueueLength),
+		EligibleSpectators: int(r.EligibleSpectators),
+	}
+	if r.Time != nil {
+		conditions.Time = r.Time.AsTime()
+	}
+
+	simulation := channel.pricer.Simulate(conditions, r.MediaLength.AsDuration(), r.Unskippable, r.Concealed, int(r.RecentCrowdfundedSkips))
+	ruleIDs := func(kind pricer.PriceKind) []string {
+		return lo.Map(simulation.MatchingRules[kind], func(rule *types.PricingRule, _ int) string {
+			return rule.ID
+		})
+	}
+
+	return &proto.SimulatePricingResponse{
+		EnqueuePrice:                      simulation.EnqueuePricing.EnqueuePrice.SerializeForAPI(),
+		PlayNextPrice:                     simulation.EnqueuePricing.PlayAfterCurrentPrice.SerializeForAPI(),
+		PlayNowPrice:                      simulation.EnqueuePricing.PlayNowPrice.SerializeForAPI(),
+		CrowdfundedSkipPrice:              simulation.CrowdfundedSkipPrice.SerializeForAPI(),
+		QueueEntryMovementCost:            int32(simulation.QueueEntryMovementCost),
+		SubscriberQueueEntryMovementCost:  int32(simulation.SubscriberQueueEntryMovementCost),
+		MatchingEnqueueRuleIds:            ruleIDs(pricer.PriceKindEnqueue),
+		MatchingCrowdfundedSkipRuleIds:    ruleIDs(pricer.PriceKindCrowdfundedSkip),
+		MatchingQueueEntryMovementRuleIds: ruleIDs(pricer.PriceKindQueueEntryMovement),
+	}, nil
+}
+
+func (s *grpcServer) serializePricingRule(ctx context.Context, rule *types.PricingRule) *proto.PricingRule {
+	serialized := &proto.PricingRule{
+		Id:                          rule.ID,
+		Label:                       rule.Label,
+		AppliesToEnqueue:            rule.AppliesToEnqueue,
+		AppliesToCrowdfundedSkip:    rule.AppliesToCrowdfundedSkip,
+		AppliesToQueueEntryMovement: rule.AppliesToQueueEntryMovement,
+		Multiplier:                  int32(rule.Multiplier),
+		MinQueueLength:              intPtrToOptionalInt32(rule.MinQueueLength),
+		MaxQueueLength:              intPtrToOptionalInt32(rule.MaxQueueLength),
+		MinSpectators:               intPtrToOptionalInt32(rule.MinSpectators),
+		MaxSpectators:               intPtrToOptionalInt32(rule.MaxSpectators),
+		UpdatedBy:                   s.userSerializer(ctx, auth.NewAddressOnlyUser(rule.UpdatedBy)),
+		UpdatedAt:                   timestamppb.New(rule.UpdatedAt),
+	}
+	for weekday := int32(0); weekday < 7; weekday++ {
+		if rule.Weekdays&(1<<weekday) != 0 {
+			serialized.Weekdays = append(serialized.Weekdays, weekday)
+		}
+	}
+	if rule.StartTimeOfDay != nil {
+		serialized.StartTimeOfDay = durationpb.New(time.Duration(*rule.StartTimeOfDay))
+	}
+	if rule.EndTimeOfDay != nil {
+		serialized.EndTimeOfDay = durationpb.New(time.Duration(*rule.EndTimeOfDay))
+	}
+	if rule.ActiveFrom.Valid {
+		serialized.ActiveFrom = timestamppb.New(rule.ActiveFrom.Time)
+	}
+	if rule.ActiveUntil.Valid {
+		serialized.ActiveUntil = timestamppb.New(rule.ActiveUntil.Time)
+	}
+	return serialized
+}
+
+func optionalInt32ToIntPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
+
+func intPtrToOptionalInt32(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v)
+	return &i
+}
@@ -0,0 +1,310 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/components/payment"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/server/media/playlist"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxExportedPlayedMediaItems = 1000
+const maxImportedPlaylistItems = 100
+
+func playlistFormatFromProto(format proto.PlaylistFormat) (playlist.Format, error) {
+	switch format {
+	case proto.PlaylistFormat_PLAYLIST_FORMAT_M3U:
+		return playlist.FormatM3U, nil
+	case proto.PlaylistFormat_PLAYLIST_FORMAT_XSPF:
+		return playlist.FormatXSPF, nil
+	case proto.PlaylistFormat_PLAYLIST_FORMAT_JSON:
+		return playlist.FormatJSON, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "invalid playlist format")
+	}
+}
+
+func (s *grpcServer) ExportQueue(ctx context.Context, r *proto.ExportQueueRequest) (*proto.ExportQueueResponse, error) {
+	format, err := playlistFormatFromProto(r.Format)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	fileName, content, err := s.ExportQueuePlaylist(ctx, r.ChannelId, format)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.ExportQueueResponse{
+		File: &proto.PlaylistFile{
+			FileName:    fileName,
+			ContentType: format.ContentType(),
+			Content:     content,
+		},
+	}, nil
+}
+
+// ExportQueuePlaylist serializes the entries of the queue of the given channel as a playlist file
+func (s *grpcServer) ExportQueuePlaylist(ctx context.Context, channelID string, format playlist.Format) (string, []byte, error) {
+	channel, err := s.broadcastChannel(channelID)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "")
+	}
+
+	items := []playlist.Item{}
+	for _, entry := range channel.mediaQueue.Entries() {
+		item, ok := playlist.ItemFromQueueEntryMediaInfo(
+			entry.ActionableMediaInfo().SerializeForAPIQueue(ctx),
+			entry.MediaInfo().Offset(),
+			entry.MediaInfo().Length())
+		if ok {
+			items = append(items, item)
+		}
+	}
+
+	content, err := playlist.Encode(format, items)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "")
+	}
+
+	fileName := fmt.Sprintf("%s-queue-%s%s", channel.id, time.Now().UTC().Format("20060102-150405"), format.FileExtension())
+	return fileName, content, nil
+}
+
+func (s *grpcServer) ExportPlayedMedia(ctx context.Context, r *proto.ExportPlayedMediaRequest) (*proto.ExportPlayedMediaResponse, error) {
+	format, err := playlistFormatFromProto(r.Format)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	var since, until time.Time
+	if r.StartedSince != nil {
+		since = r.StartedSince.AsTime()
+	}
+	if r.StartedUntil != nil {
+		until = r.StartedUntil.AsTime()
+	}
+
+	fileName, content, err := s.ExportPlayedMediaPlaylist(ctx, r.ChannelId, since, until, format)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.ExportPlayedMediaResponse{
+		File: &proto.PlaylistFile{
+			FileName:    fileName,
+			ContentType: format.ContentType(),
+			Content:     content,
+		},
+	}, nil
+}
+
+// ExportPlayedMediaPlaylist serializes the media that started playing on the given channel within the specified time
+// range as a playlist file. since and until may be zero to leave the respective side of the range open
+func (s *grpcServer) ExportPlayedMediaPlaylist(ctxCtx context.Context, channelID string, since, until time.Time, format playlist.Format) (string, []byte, error) {
+	channel, err := s.broadcastChannel(channelID)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "")
+	}
+
+	if !since.IsZero() && !until.IsZero() && until.Before(since) {
+		return "", nil, status.Error(codes.InvalidArgument, "the end of the time range must not be before its start")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	playedMedia, _, err := types.GetPlayedMedia(ctx, types.GetPlayedMediaFilters{
+		StartedSince: since,
+		StartedUntil: until,
+		ChannelID:    channel.id,
+		OrderBy:      types.GetPlayedMediaOrderByStartedAtAsc,
+	}, &types.PaginationParams{
+		Limit: maxExportedPlayedMediaItems,
+	})
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "")
+	}
+
+	items := []playlist.Item{}
+	for _, pm := range playedMedia {
+		provider, ok := s.mediaProviders[pm.MediaType]
+		if !ok {
+			continue
+		}
+		info, err := provider.SerializePlayedMediaMediaInfo(pm)
+		if err != nil {
+			return "", nil, stacktrace.Propagate(err, "")
+		}
+		item, ok := playlist.ItemFromPlayedMediaInfo(info, time.Duration(pm.MediaOffset), time.Duration(pm.MediaLength))
+		if ok {
+			items = append(items, item)
+		}
+	}
+
+	content, err := playlist.Encode(format, items)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "")
+	}
+
+	fileName := fmt.Sprintf("%s-played-%s%s", channel.id, time.Now().UTC().Format("20060102-150405"), format.FileExtension())
+	return fileName, content, nil
+}
+
+func (s *grpcServer) ImportQueue(ctxCtx context.Context, r *proto.ImportQueueRequest) (*proto.ImportQueueResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctxCtx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	format, err := playlistFormatFromProto(r.Format)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	items, err := playlist.Decode(format, r.Content)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid playlist file")
+	}
+	if len(items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "the playlist is empty")
+	}
+	if len(items) > maxImportedPlaylistItems {
+		return nil, status.Errorf(codes.InvalidArgument, "playlists may contain at most %d items", maxImportedPlaylistItems)
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	entries := []media.QueueEntry{}
+	failedItems := []*proto.EnqueueMediaFailedItem{}
+	seenMedia := make(map[string]struct{})
+	for _, item := range items {
+		request, failureReason, err := s.prepareImportedPlaylistItem(ctx, channel, item, r.SkipDuplicationChecks, seenMedia)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		if failureReason != "" {
+			failedItems = append(failedItems, &proto.EnqueueMediaFailedItem{
+				Title:         importedPlaylistItemTitle(item),
+				FailureReason: failureReason,
+			})
+			continue
+		}
+		entries = append(entries, request.ActionableMediaInfo().ProduceMediaQueueEntry(
+			moderator, payment.NewAmount(), false, false, uuid.NewV4().String()))
+	}
+
+	// only enqueue once all items have been processed, so that an error halfway through does not leave the queue
+	// with part of the playlist
+	for _, entry := range entries {
+		channel.mediaQueue.Enqueue(entry)
+	}
+
+	s.log.Printf("%d of %d playlist items imported into the queue of channel %s by %s (remote address %s)",
+		len(entries), len(items), channel.id, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil && len(entries) > 0 {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) imported a playlist with %d items into the queue of channel `%s` (%d items failed)",
+				moderator.Address()[:14], moderator.ModeratorName(), len(entries), channel.id, len(failedItems)))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.ImportQueueResponse{
+		EnqueuedCount: uint32(len(entries)),
+		FailedItems:   failedItems,
+	}, nil
+}
+
+// prepareImportedPlaylistItem goes through the same provider checks as regular enqueue requests.
+// When the item can't be enqueued, the reason is returned as a non-empty string
+func (s *grpcServer) prepareImportedPlaylistItem(ctx transaction.WrappingContext, channel *broadcastChannel, item playlist.Item,
+	skipDuplicationChecks bool, seenMedia map[string]struct{}) (media.EnqueueRequest, string, error) {
+	mediaInfo, err := item.EnqueueParameters(s.websiteURL)
+	if err != nil {
+		if errors.Is(err, playlist.ErrUnsupportedItem) {
+			return nil, "This item does not correspond to content that can be enqueued", nil
+		}
+		return nil, "", stacktrace.Propagate(err, "")
+	}
+
+	var provider media.Provider
+	for _, p := range channel.mediaProviders {
+		if p.CanHandleRequestType(mediaInfo) {
+			provider = p
+		}
+	}
+	if provider == media.Provider(nil) {
+		return nil, "This item does not correspond to content that can be enqueued", nil
+	}
+
+	preInfo, result, err := provider.BeginEnqueueRequest(ctx, mediaInfo)
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "")
+	}
+	if result != media.EnqueueRequestCreationSucceeded {
+		return nil, enqueueRequestCreationFailureReason(result), nil
+	}
+
+	if !skipDuplicationChecks {
+		mediaType, mediaID := preInfo.MediaID()
+		key := string(mediaType) + ":" + mediaID
+		if _, seen := seenMedia[key]; seen {
+			return nil, "This content appears more than once in the playlist", nil
+		}
+		seenMedia[key] = struct{}{}
+	}
+
+	allowed, err := media.IsAllowed(ctx, preInfo)
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "")
+	}
+	if !allowed {
+		return nil, enqueueRequestCreationFailureReason(media.EnqueueRequestCreationFailedMediumIsDisallowed), nil
+	}
+
+	request, result, err := provider.ContinueEnqueueRequest(ctx, preInfo, false, false, false, true, true, skipDuplicationChecks)
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "")
+	}
+	if result != media.EnqueueRequestCreationSucceeded {
+		return nil, enqueueRequestCreationFailureReason(result), nil
+	}
+	return request, "", nil
+}
+
+func importedPlaylistItemTitle(item playlist.Item) string {
+	for _, s := range []string{item.Title, item.Location, item.MediaID} {
+		if s = strings.TrimSpace(s); s != "" {
+			return s
+		}
+	}
+	return "Unknown item"
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/palantir/stacktrace"
+	"github.com/samber/lo"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/components/mediaqueue"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *grpcServer) QueueSnapshots(ctx context.Context, r *proto.QueueSnapshotsRequest) (*proto.QueueSnapshotsResponse, error) {
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	snapshots, total, err := channel.mediaQueue.Snapshots(ctx, readPaginationParameters(r))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.QueueSnapshotsResponse{
+		Snapshots: lo.Map(snapshots, func(snapshot mediaqueue.SnapshotSummary, _ int) *proto.QueueSnapshotSummary {
+			return &proto.QueueSnapshotSummary{
+				TakenAt:    timestamppb.New(snapshot.TakenAt),
+				EntryCount: int32(snapshot.EntryCount),
+			}
+		}),
+		Offset: readOffset(r),
+		Total:  total,
+	}, nil
+}
+
+func (s *grpcServer) QueueSnapshot(ctx context.Context, r *proto.QueueSnapshotRequest) (*proto.QueueSnapshotResponse, error) {
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	if r.TakenAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing snapshot time")
+	}
+
+	snapshot, err := channel.mediaQueue.Snapshot(ctx, r.TakenAt.AsTime())
+	if err != nil {
+		if errors.Is(err, types.ErrMediaQueueEventNotFound) {
+			return nil, status.Error(codes.NotFound, "snapshot not found")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	response := &proto.QueueSnapshotResponse{
+		TakenAt: timestamppb.New(snapshot.TakenAt),
+		Entries: lo.Map(snapshot.Entries, func(entry media.QueueEntry, _ int) *proto.QueueEntry {
+			return s.serializeQueueSnapshotEntry(ctx, entry)
+		}),
+	}
+	if snapshot.InsertCursor != "" {
+		response.InsertCursor = &snapshot.InsertCursor
+	}
+	return response, nil
+}
+
+func (s *grpcServer) serializeQueueSnapshotEntry(ctx context.Context, entry media.QueueEntry) *proto.QueueEntry {
+	serialized := &proto.QueueEntry{
+		Id:          entry.PerformanceID(),
+		Offset:      durationpb.New(entry.MediaInfo().Offset()),
+		Unskippable: entry.Unskippable(),
+		Concealed:   entry.Concealed(),
+		RequestCost: entry.RequestCost().SerializeForAPI(),
+		RequestedAt: timestamppb.New(entry.RequestedAt()),
+		MediaInfo:   entry.ActionableMediaInfo().SerializeForAPIQueue(ctx),
+	}
+	if entry.MediaInfo().Length() != math.MaxInt64 {
+		serialized.Length = durationpb.New(entry.MediaInfo().Length())
+	}
+	if !entry.RequestedBy().IsUnknown() {
+		serialized.RequestedBy = s.userSerializer(ctx, entry.RequestedBy())
+	}
+	return serialized
+}
+
+func (s *grpcServer) RestoreQueueSnapshot(ctx context.Context, r *proto.RestoreQueueSnapshotRequest) (*proto.RestoreQueueSnapshotResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	if r.TakenAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing snapshot time")
+	}
+	takenAt := r.TakenAt.AsTime()
+
+	restored, err := channel.mediaQueue.RestoreSnapshot(ctx, takenAt)
+	if err != nil {
+		if errors.Is(err, types.ErrMediaQueueEventNotFound) {
+			return nil, status.Error(codes.NotFound, "snapshot not found")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Queue of channel %s restored to snapshot taken at %s (%d entries brought back) by %s (remote address %s)",
+		channel.id, takenAt.String(), restored, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) restored the queue of channel %s to the snapshot taken at %s (%d entries brought back)",
+				moderator.Address()[:14], moderator.ModeratorName(), channel.name, takenAt.String(), restored))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.RestoreQueueSnapshotResponse{
+		RestoredEntryCount: int32(restored),
+	}, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/tnyim/jungletv/server/auth"
 	"github.com/tnyim/jungletv/server/components/configurationmanager"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils/event"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -55,6 +56,12 @@ func (s *grpcServer) MonitorModerationStatus(r *proto.MonitorModerationStatusReq
 			AllowEntryReordering:                s.mediaQueue.EntryReorderingAllowed(),
 			VipUsers:                            protoVipUsers,
 		}
+		overview.QueueOrderingPolicy, overview.QueueMaxConsecutiveEntries = serializeQueueOrderingPolicy(s.mediaQueue.OrderingPolicy())
+		rewardDistributionStrategy, err := s.rewardsHandler.DistributionStrategy(types.MainBroadcastChannelID)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		overview.RewardDistributionStrategy = serializeRewardDistributionStrategy(rewardDistributionStrategy)
 		queueInsertCursor, hasQueueInsertCursor := s.mediaQueue.InsertCursor()
 		if hasQueueInsertCursor {
 			overview.QueueInsertCursor = &queueInsertCursor
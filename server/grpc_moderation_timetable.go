@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/samber/lo"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/components/mediaqueue"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *grpcServer) TimetableSlots(ctx context.Context, r *proto.TimetableSlotsRequest) (*proto.TimetableSlotsResponse, error) {
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	now := time.Now()
+	slots := channel.mediaQueue.TimetableSlots()
+	return &proto.TimetableSlotsResponse{
+		Slots: lo.Map(slots, func(slot *types.MediaQueueTimetableSlot, _ int) *proto.TimetableSlot {
+			return serializeTimetableSlot(slot, now)
+		}),
+	}, nil
+}
+
+func (s *grpcServer) SetTimetableSlot(ctx context.Context, r *proto.SetTimetableSlotRequest) (*proto.SetTimetableSlotResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	if r.Label == "" || len(r.Label) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "invalid label")
+	}
+	if r.StartTimeOfDay == nil || r.Duration == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing start time of day or duration")
+	}
+
+	slot := &types.MediaQueueTimetableSlot{
+		ID:             uuid.NewV4().String(),
+		Label:          r.Label,
+		Weekday:        int(r.Weekday),
+		StartTimeOfDay: types.Duration(r.StartTimeOfDay.AsDuration()),
+		Duration:       types.Duration(r.Duration.AsDuration()),
+		UpdatedBy:      moderator.Address(),
+		UpdatedAt:      time.Now(),
+	}
+	if r.Id != nil {
+		slot.ID = *r.Id
+	}
+
+	err = channel.mediaQueue.SetTimetableSlot(ctx, slot)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Timetable slot %s (%s) on channel %s set by %s (remote address %s)", slot.ID, slot.Label, channel.id, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) set timetable slot \"%s\" of channel %s on %s at %s for %s",
+				moderator.Address()[:14], moderator.ModeratorName(), slot.Label, channel.name,
+				time.Weekday(slot.Weekday).String(),
+				time.Duration(slot.StartTimeOfDay).String(),
+				time.Duration(slot.Duration).String()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.SetTimetableSlotResponse{
+		Slot: serializeTimetableSlot(slot, time.Now()),
+	}, nil
+}
+
+func (s *grpcServer) RemoveTimetableSlot(ctx context.Context, r *proto.RemoveTimetableSlotRequest) (*proto.RemoveTimetableSlotResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = channel.mediaQueue.RemoveTimetableSlot(ctx, r.Id)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Timetable slot %s removed from channel %s by %s (remote address %s)", r.Id, channel.id, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) removed timetable slot %s from channel %s",
+				moderator.Address()[:14], moderator.ModeratorName(), r.Id, channel.name))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.RemoveTimetableSlotResponse{}, nil
+}
+
+func serializeTimetableSlot(slot *types.MediaQueueTimetableSlot, now time.Time) *proto.TimetableSlot {
+	nextStart, active := mediaqueue.TimetableSlotOccurrence(slot, now)
+	return &proto.TimetableSlot{
+		Id:             slot.ID,
+		Label:          slot.Label,
+		Weekday:        int32(slot.Weekday),
+		StartTimeOfDay: durationpb.New(time.Duration(slot.StartTimeOfDay)),
+		Duration:       durationpb.New(time.Duration(slot.Duration)),
+		NextStart:      timestamppb.New(nextStart),
+		Active:         active,
+	}
+}
+
+func schedulingModeFromProto(mode proto.QueueEntrySchedulingMode) (types.MediaQueueSchedulingMode, error) {
+	switch mode {
+	case proto.QueueEntrySchedulingMode_QUEUE_ENTRY_SCHEDULING_MODE_NOT_BEFORE:
+		return types.MediaQueueSchedulingModeNotBefore, nil
+	case proto.QueueEntrySchedulingMode_QUEUE_ENTRY_SCHEDULING_MODE_EXACTLY_AT:
+		return types.MediaQueueSchedulingModeExactlyAt, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "unknown scheduling mode")
+	}
+}
+
+func serializeQueueEntrySchedule(plannedStart time.Time, mode types.MediaQueueSchedulingMode) *proto.QueueEntrySchedule {
+	protoMode := proto.QueueEntrySchedulingMode_QUEUE_ENTRY_SCHEDULING_MODE_NOT_BEFORE
+	if mode == types.MediaQueueSchedulingModeExactlyAt {
+		protoMode = proto.QueueEntrySchedulingMode_QUEUE_ENTRY_SCHEDULING_MODE_EXACTLY_AT
+	}
+	return &proto.QueueEntrySchedule{
+		PlannedStart: timestamppb.New(plannedStart),
+		Mode:         protoMode,
+	}
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/palantir/stacktrace"
+	"github.com/samber/lo"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/pointsrewards"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *grpcServer) PointsRewards(ctx context.Context, r *proto.PointsRewardsRequest) (*proto.PointsRewardsResponse, error) {
+	user := authinterceptor.UserFromContext(ctx)
+	includeDisabled := r.IncludeDisabled && user != nil && auth.UserPermissionLevelIsAtLeast(user, auth.AdminPermissionLevel)
+
+	rewards, err := s.pointsRewardsManager.Rewards(ctx, includeDisabled)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.PointsRewardsResponse{
+		Rewards: lo.Map(rewards, func(reward *types.PointsReward, _ int) *proto.PointsReward {
+			return convertPointsReward(reward)
+		}),
+	}, nil
+}
+
+func (s *grpcServer) RedeemPointsReward(ctx context.Context, r *proto.RedeemPointsRewardRequest) (*proto.RedeemPointsRewardResponse, error) {
+	user := authinterceptor.UserFromContext(ctx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	redemption, err := s.pointsRewardsManager.Redeem(ctx, user, r.RewardId, r.Input)
+	if err != nil {
+		if errors.Is(err, types.ErrInsufficientPointsBalance) {
+			return nil, status.Error(codes.FailedPrecondition, "insufficient points balance")
+		}
+		if errors.Is(err, pointsrewards.ErrRewardUnavailable) {
+			return nil, status.Error(codes.NotFound, "reward unavailable")
+		}
+		if errors.Is(err, pointsrewards.ErrRewardOutOfStock) {
+			return nil, status.Error(codes.FailedPrecondition, "reward out of stock")
+		}
+		if errors.Is(err, pointsrewards.ErrRedemptionLimitReached) {
+			return nil, status.Error(codes.FailedPrecondition, "redemption limit reached")
+		}
+		if errors.Is(err, pointsrewards.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, "invalid input")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.RedeemPointsRewardResponse{
+		Redemption: s.convertPointsRewardRedemption(ctx, redemption),
+	}, nil
+}
+
+func (s *grpcServer) PointsRewardRedemptions(ctx context.Context, r *proto.PointsRewardRedemptionsRequest) (*proto.PointsRewardRedemptionsResponse, error) {
+	user := authinterceptor.UserFromContext(ctx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	redemptions, total, err := s.pointsRewardsManager.Redemptions(ctx, nil, user.Address(), readPaginationParameters(r))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.PointsRewardRedemptionsResponse{
+		Redemptions: lo.Map(redemptions, func(redemption *types.PointsRewardRedemption, _ int) *proto.PointsRewardRedemption {
+			return s.convertPointsRewardRedemption(ctx, redemption)
+		}),
+		Offset: readOffset(r),
+		Total:  total,
+	}, nil
+}
+
+func convertPointsReward(orig *types.PointsReward) *proto.PointsReward {
+	return &proto.PointsReward{
+		Id:            orig.ID,
+		Title:         orig.Title,
+		Description:   orig.Description,
+		Cost:          int32(orig.Cost),
+		Stock:         intPtrToOptionalInt32(orig.Stock),
+		PerUserLimit:  intPtrToOptionalInt32(orig.PerUserLimit),
+		RequiresInput: orig.RequiresInput,
+		Enabled:       orig.Enabled,
+	}
+}
+
+func (s *grpcServer) convertPointsRewardRedemption(ctx context.Context, orig *types.PointsRewardRedemption) *proto.PointsRewardRedemption {
+	return &proto.PointsRewardRedemption{
+		Id:        orig.ID,
+		RewardId:  orig.RewardID,
+		User:      s.userSerializer(ctx, auth.NewAddressOnlyUser(orig.RewardsAddress)),
+		Cost:      int32(orig.Cost),
+		Input:     orig.Input,
+		Status:    convertPointsRewardRedemptionStatus(orig.Status),
+		StaffNote: orig.StaffNote,
+		CreatedAt: timestamppb.New(orig.CreatedAt),
+		UpdatedAt: timestamppb.New(orig.UpdatedAt),
+	}
+}
+
+func convertPointsRewardRedemptionStatus(orig types.PointsRewardRedemptionStatus) proto.PointsRewardRedemptionStatus {
+	switch orig {
+	case types.PointsRewardRedemptionStatusFulfilled:
+		return proto.PointsRewardRedemptionStatus_POINTS_REWARD_REDEMPTION_STATUS_FULFILLED
+	case types.PointsRewardRedemptionStatusRejected:
+		return proto.PointsRewardRedemptionStatus_POINTS_REWARD_REDEMPTION_STATUS_REJECTED
+	default:
+		return proto.PointsRewardRedemptionStatus_POINTS_REWARD_REDEMPTION_STATUS_PENDING
+	}
+}
+
+func pointsRewardRedemptionStatusFromProto(orig proto.PointsRewardRedemptionStatus) (types.PointsRewardRedemptionStatus, error) {
+	switch orig {
+	case proto.PointsRewardRedemptionStatus_POINTS_REWARD_REDEMPTION_STATUS_PENDING:
+		return types.PointsRewardRedemptionStatusPending, nil
+	case proto.PointsRewardRedemptionStatus_POINTS_REWARD_REDEMPTION_STATUS_FULFILLED:
+		return types.PointsRewardRedemptionStatusFulfilled, nil
+	case proto.PointsRewardRedemptionStatus_POINTS_REWARD_REDEMPTION_STATUS_REJECTED:
+		return types.PointsRewardRedemptionStatusRejected, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "unknown redemption status")
+	}
+}
@@ -60,11 +60,19 @@ func (s *grpcServer) UserProfile(ctxCtx context.Context, r *proto.UserProfileReq
 		return nil, stacktrace.Propagate(err, "")
 	}
 
+	recentTips, _, err := types.GetTipsInvolvingAddress(ctx, address, &types.PaginationParams{Limit: 10})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
 	response := &proto.UserProfileResponse{
 		User:                   s.userSerializer(ctx, user),
 		RecentlyPlayedRequests: protoPlayedMedias,
 		Biography:              profile.Biography,
 		CurrentSubscription:    convertSubscription(subscription),
+		RecentTips: lo.Map(recentTips, func(tip *types.Tip, _ int) *proto.Tip {
+			return s.convertTip(ctx, tip, s.userSerializer)
+		}),
 	}
 
 	if profile.FeaturedMedia != nil {
@@ -13,6 +13,7 @@ import (
 	"github.com/tnyim/jungletv/server/components/pointsmanager"
 	"github.com/tnyim/jungletv/server/components/stats"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/server/media"
 	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils/event"
 	"github.com/tnyim/jungletv/utils/transaction"
@@ -23,6 +24,12 @@ import (
 )
 
 func (s *grpcServer) MonitorQueue(r *proto.MonitorQueueRequest, stream proto.JungleTV_MonitorQueueServer) error {
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	mediaQueue := channel.mediaQueue
+
 	ctx := stream.Context()
 	user := authinterceptor.UserFromContext(ctx)
 
@@ -35,7 +42,7 @@ func (s *grpcServer) MonitorQueue(r *proto.MonitorQueueRequest, stream proto.Jun
 		canRemoveOwnEntries := false
 		if user != nil && !user.IsUnknown() {
 			var err error
-			canRemoveOwnEntries, err = s.mediaQueue.UserCanRemoveOwnEntries(ctx, user)
+			canRemoveOwnEntries, err = mediaQueue.UserCanRemoveOwnEntries(ctx, user)
 			if err != nil {
 				return stacktrace.Propagate(err, "")
 			}
@@ -43,42 +50,62 @@ func (s *grpcServer) MonitorQueue(r *proto.MonitorQueueRequest, stream proto.Jun
 
 		queue := &proto.Queue{
 			IsHeartbeat:            false,
-			OwnEntryRemovalEnabled: canRemoveOwnEntries && s.mediaQueue.RemovalOfOwnEntriesAllowed(),
+			OwnEntryRemovalEnabled: canRemoveOwnEntries && mediaQueue.RemovalOfOwnEntriesAllowed(),
 		}
-		entries := s.mediaQueue.Entries()
-		queue.Entries = make([]*proto.QueueEntry, len(entries))
-		for i, entry := range entries {
-			queue.Entries[i] = &proto.QueueEntry{
+		serializeEntry := func(entry media.QueueEntry, concealed bool) *proto.QueueEntry {
+			serialized := &proto.QueueEntry{
 				Id:          entry.PerformanceID(),
 				Offset:      durationpb.New(entry.MediaInfo().Offset()),
 				Unskippable: entry.Unskippable(),
-				Concealed:   entry.Concealed() && i > 0,
+				Concealed:   concealed,
 				RequestCost: entry.RequestCost().SerializeForAPI(),
 				RequestedAt: timestamppb.New(entry.RequestedAt()),
-				CanMoveUp:   s.mediaQueue.CanMoveEntryByIndex(i, user, true),
-				CanMoveDown: s.mediaQueue.CanMoveEntryByIndex(i, user, false),
 			}
 			if entry.MediaInfo().Length() != math.MaxInt64 {
-				queue.Entries[i].Length = durationpb.New(entry.MediaInfo().Length())
+				serialized.Length = durationpb.New(entry.MediaInfo().Length())
 			}
 			requestedBy := entry.RequestedBy()
-			if i == 0 || !entry.Concealed() || isAdmin ||
+			if !concealed || isAdmin ||
 				(!user.IsUnknown() && !entry.RequestedBy().IsUnknown() && requestedBy.Address() == user.Address()) {
-				queue.Entries[i].MediaInfo = entry.ActionableMediaInfo().SerializeForAPIQueue(ctx)
+				serialized.MediaInfo = entry.ActionableMediaInfo().SerializeForAPIQueue(ctx)
 			} else {
-				queue.Entries[i].MediaInfo = &proto.QueueEntry_ConcealedData{}
+				serialized.MediaInfo = &proto.QueueEntry_ConcealedData{}
 			}
 			if !entry.RequestedBy().IsUnknown() {
-				queue.Entries[i].RequestedBy = s.userSerializer(ctx, entry.RequestedBy())
+				serialized.RequestedBy = s.userSerializer(ctx, entry.RequestedBy())
+			}
+			return serialized
+		}
+
+		entries := mediaQueue.Entries()
+		queue.Entries = make([]*proto.QueueEntry, len(entries))
+		for i, entry := range entries {
+			queue.Entries[i] = serializeEntry(entry, entry.Concealed() && i > 0)
+			queue.Entries[i].CanMoveUp = mediaQueue.CanMoveEntryByIndex(i, user, true)
+			queue.Entries[i].CanMoveDown = mediaQueue.CanMoveEntryByIndex(i, user, false)
+			if plannedStart, mode, scheduled := mediaQueue.EntrySchedule(entry.PerformanceID()); scheduled {
+				queue.Entries[i].Schedule = serializeQueueEntrySchedule(plannedStart, mode)
 			}
 		}
 
-		insertCursor, hasInsertCursor := s.mediaQueue.InsertCursor()
+		scheduledEntries := mediaQueue.ScheduledEntries()
+		queue.ScheduledEntries = make([]*proto.QueueEntry, len(scheduledEntries))
+		for i, scheduled := range scheduledEntries {
+			queue.ScheduledEntries[i] = serializeEntry(scheduled.Entry, scheduled.Entry.Concealed())
+			queue.ScheduledEntries[i].Schedule = serializeQueueEntrySchedule(scheduled.PlannedStart, scheduled.Mode)
+		}
+
+		now := time.Now()
+		for _, slot := range mediaQueue.TimetableSlots() {
+			queue.TimetableSlots = append(queue.TimetableSlots, serializeTimetableSlot(slot, now))
+		}
+
+		insertCursor, hasInsertCursor := mediaQueue.InsertCursor()
 		if hasInsertCursor {
 			queue.InsertCursor = &insertCursor
 		}
 
-		playingSince := s.mediaQueue.PlayingSince()
+		playingSince := mediaQueue.PlayingSince()
 		if !playingSince.IsZero() {
 			queue.PlayingSince = timestamppb.New(playingSince)
 		}
@@ -86,13 +113,13 @@ func (s *grpcServer) MonitorQueue(r *proto.MonitorQueueRequest, stream proto.Jun
 		return stacktrace.Propagate(stream.Send(queue), "")
 	}
 
-	onQueueChanged, queueUpdatedU := s.mediaQueue.QueueUpdated().Subscribe(event.BufferFirst)
+	onQueueChanged, queueUpdatedU := mediaQueue.QueueUpdated().Subscribe(event.BufferFirst)
 	defer queueUpdatedU()
 
 	onVersionHashChanged, versionHashChangedU := s.versionInterceptor.VersionHashUpdated().Subscribe(event.BufferFirst)
 	defer versionHashChangedU()
 
-	err := send()
+	err = send()
 	if err != nil {
 		return stacktrace.Propagate(err, "")
 	}
@@ -130,7 +157,7 @@ func (s *grpcServer) RemoveOwnQueueEntry(ctx context.Context, r *proto.RemoveOwn
 		return nil, status.Error(codes.Unauthenticated, "missing user claims")
 	}
 
-	err := s.mediaQueue.RemoveOwnEntry(ctx, r.Id, user)
+	err := s.mediaQueueWithEntry(r.Id).RemoveOwnEntry(ctx, r.Id, user)
 	if err != nil {
 		if errors.Is(err, mediaqueue.ErrInsufficientPermissionsToRemoveEntry) {
 			return nil, status.Error(codes.PermissionDenied, "insufficient permissions")
@@ -163,6 +190,31 @@ func (s *grpcServer) MoveQueueEntry(ctxCtx context.Context, r *proto.MoveQueueEn
 	return &proto.MoveQueueEntryResponse{}, stacktrace.Propagate(err, "")
 }
 
+func (s *grpcServer) QueueEntryMovementCost(ctxCtx context.Context, r *proto.QueueEntryMovementCostRequest) (*proto.QueueEntryMovementCostResponse, error) {
+	user := authinterceptor.UserFromContext(ctxCtx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	cost, err := s.queueEntryMovementCost(ctxCtx, r.Id, user)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &proto.QueueEntryMovementCostResponse{
+		Cost: int32(cost),
+	}, nil
+}
+
+// queueEntryMovementCost returns the cost, in points, that the user would be charged for moving the entry
+func (s *grpcServer) queueEntryMovementCost(ctx context.Context, entryID string, user auth.User) (int, error) {
+	subscribed, err := s.pointsManager.IsUserCurrentlySubscribed(ctx, user)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+	return s.channelWithEntry(entryID).pricer.ComputeQueueEntryMovementCost(subscribed), nil
+}
+
 func (s *grpcServer) moveQueueEntryWithCost(ctxCtx context.Context, entryID string, up bool, user auth.User) error {
 	ctx, err := transaction.Begin(ctxCtx)
 	if err != nil {
@@ -175,14 +227,10 @@ func (s *grpcServer) moveQueueEntryWithCost(ctxCtx context.Context, entryID stri
 		direction = "up"
 	}
 
-	cost := 119
-	subscribed, err := s.pointsManager.IsUserCurrentlySubscribed(ctx, user)
+	cost, err := s.queueEntryMovementCost(ctx, entryID, user)
 	if err != nil {
 		return stacktrace.Propagate(err, "")
 	}
-	if subscribed {
-		cost = 69
-	}
 
 	// begin by deducting the points as this is what we can rollback if the queue movement fails, unlike the queue changes
 	_, err = s.pointsManager.CreateTransaction(ctx, user, types.PointsTxTypeQueueEntryReordering, -cost, pointsmanager.TxExtraField{
@@ -197,7 +245,7 @@ func (s *grpcServer) moveQueueEntryWithCost(ctxCtx context.Context, entryID stri
 	}
 
 	// now attempt the queue movement
-	err = s.mediaQueue.MoveEntry(entryID, user, up)
+	err = s.channelWithEntry(entryID).mediaQueue.MoveEntry(entryID, user, up)
 	if err != nil {
 		// this rolls back the points deduction
 		return stacktrace.Propagate(err, "")
@@ -0,0 +1,213 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hectorchu/gonano/util"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/refundmanager"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *grpcServer) Refunds(ctxCtx context.Context, r *proto.RefundsRequest) (*proto.RefundsResponse, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	statuses := []types.RefundStatus{}
+	for _, protoStatus := range r.Statuses {
+		switch protoStatus {
+		case proto.RefundStatus_REFUND_STATUS_PROPOSED:
+			statuses = append(statuses, types.RefundStatusProposed)
+		case proto.RefundStatus_REFUND_STATUS_APPROVED:
+			statuses = append(statuses, types.RefundStatusApproved)
+		case proto.RefundStatus_REFUND_STATUS_SENT:
+			statuses = append(statuses, types.RefundStatusSent)
+		case proto.RefundStatus_REFUND_STATUS_FAILED:
+			statuses = append(statuses, types.RefundStatusFailed)
+		default:
+			return nil, status.Error(codes.InvalidArgument, "invalid refund status")
+		}
+	}
+
+	refunds, total, err := types.GetRefunds(ctx, statuses, readPaginationParameters(r))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	protoRefunds := make([]*proto.Refund, len(refunds))
+	for i, refund := range refunds {
+		protoRefunds[i] = s.convertRefund(ctx, refund, s.userSerializer)
+	}
+
+	return &proto.RefundsResponse{
+		Refunds: protoRefunds,
+		Offset:  readOffset(r),
+		Total:   total,
+	}, nil
+}
+
+func (s *grpcServer) convertRefund(ctx context.Context, orig *types.Refund, userSerializer auth.APIUserSerializer) *proto.Refund {
+	refund := &proto.Refund{
+		Id:               orig.ID,
+		Recipient:        userSerializer(ctx, auth.NewAddressOnlyUser(orig.Address)),
+		Amount:           payment.NewAmountFromDecimal(orig.Amount).SerializeForAPI(),
+		Comment:          orig.Comment,
+		TicketId:         orig.TicketID,
+		QueueEntryId:     orig.QueueEntryID,
+		PaymentBlockHash: orig.PaymentBlockHash,
+		CreatedAt:        timestamppb.New(orig.CreatedAt),
+		UpdatedAt:        timestamppb.New(orig.UpdatedAt),
+		TxHash:           orig.TxHash,
+		FailureReason:    orig.FailureReason,
+	}
+
+	switch orig.Reason {
+	case types.RefundReasonRemovedEntry:
+		refund.Reason = proto.RefundReason_REFUND_REASON_REMOVED_ENTRY
+	case types.RefundReasonNoEligibleSpectators:
+		refund.Reason = proto.RefundReason_REFUND_REASON_NO_ELIGIBLE_SPECTATORS
+	case types.RefundReasonFailedTicket:
+		refund.Reason = proto.RefundReason_REFUND_REASON_FAILED_TICKET
+	case types.RefundReasonLatePayment:
+		refund.Reason = proto.RefundReason_REFUND_REASON_LATE_PAYMENT
+	case types.RefundReasonGoodwill:
+		refund.Reason = proto.RefundReason_REFUND_REASON_GOODWILL
+	case types.RefundReasonCancelledRain:
+		refund.Reason = proto.RefundReason_REFUND_REASON_CANCELLED_RAIN
+	}
+
+	switch orig.Status {
+	case types.RefundStatusProposed:
+		refund.Status = proto.RefundStatus_REFUND_STATUS_PROPOSED
+	case types.RefundStatusApproved:
+		refund.Status = proto.RefundStatus_REFUND_STATUS_APPROVED
+	case types.RefundStatusSent:
+		refund.Status = proto.RefundStatus_REFUND_STATUS_SENT
+	case types.RefundStatusFailed:
+		refund.Status = proto.RefundStatus_REFUND_STATUS_FAILED
+	}
+
+	if orig.ReviewedBy != nil {
+		refund.ReviewedBy = userSerializer(ctx, auth.NewAddressOnlyUser(*orig.ReviewedBy))
+	}
+	return refund
+}
+
+func (s *grpcServer) ProposeRefund(ctx context.Context, r *proto.ProposeRefundRequest) (*proto.ProposeRefundResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	// we must check for ban since AddressToPubkey accepts nano too
+	if _, err := util.AddressToPubkey(r.Address); err != nil || r.Address[:4] != "ban_" {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	amount, err := payment.NewAmountFromAPIString(r.Amount)
+	if err != nil || amount.Cmp(big.NewInt(0)) <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid amount")
+	}
+	if r.Comment == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing comment")
+	}
+	origin := refundmanager.Origin{}
+	if r.TicketId != nil {
+		origin.TicketID = *r.TicketId
+	}
+	if r.QueueEntryId != nil {
+		origin.QueueEntryID = *r.QueueEntryId
+	}
+
+	refund, err := s.refundManager.Propose(ctx, r.Address, amount, types.RefundReasonGoodwill, r.Comment, origin)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to propose refund")
+	}
+
+	s.log.Printf("Refund %s of %v to %s proposed by %s (remote address %s)", refund.ID, amount, r.Address,
+		moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) proposed refund `%s` of %s BAN to %s with comment \"%s\"",
+				moderator.Address()[:14], moderator.ModeratorName(), refund.ID, amount.SerializeForAPI(), r.Address, r.Comment))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.ProposeRefundResponse{
+		Refund: s.convertRefund(ctx, refund, s.userSerializer),
+	}, nil
+}
+
+func (s *grpcServer) ApproveRefund(ctx context.Context, r *proto.ApproveRefundRequest) (*proto.ApproveRefundResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	refund, err := s.refundManager.Approve(ctx, r.RefundId, moderator)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to approve refund")
+	}
+
+	s.log.Printf("Refund %s approved by %s (remote address %s), now %s", r.RefundId, moderator.ModeratorName(),
+		authinterceptor.RemoteAddressFromContext(ctx), refund.Status)
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) approved refund `%s`",
+				moderator.Address()[:14], moderator.ModeratorName(), r.RefundId))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.ApproveRefundResponse{
+		Refund: s.convertRefund(ctx, refund, s.userSerializer),
+	}, nil
+}
+
+func (s *grpcServer) RetryRefund(ctx context.Context, r *proto.RetryRefundRequest) (*proto.RetryRefundResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	refund, err := s.refundManager.Retry(ctx, r.RefundId, moderator)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to retry refund")
+	}
+
+	s.log.Printf("Refund %s retried by %s (remote address %s), now %s", r.RefundId, moderator.ModeratorName(),
+		authinterceptor.RemoteAddressFromContext(ctx), refund.Status)
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) retried refund `%s`",
+				moderator.Address()[:14], moderator.ModeratorName(), r.RefundId))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.RetryRefundResponse{
+		Refund: s.convertRefund(ctx, refund, s.userSerializer),
+	}, nil
+}
@@ -12,6 +12,7 @@ import (
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
 	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils/transaction"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var knownGoodReps = map[string]struct{}{
@@ -59,7 +60,7 @@ func (s *grpcServer) RewardInfo(ctxCtx context.Context, r *proto.RewardInfoReque
 	_, cachedGoodRepResult := s.addressesWithGoodRepCache.Get(userClaims.Address())
 	if !cachedGoodRepResult {
 		go func() {
-			representative, err := s.wallet.RPC.AccountRepresentative(userClaims.Address())
+			representative, err := s.wallet.RPC().AccountRepresentative(userClaims.Address())
 			if err != nil {
 				delegatorsErrChan <- stacktrace.Propagate(err, "")
 				return
@@ -82,7 +83,7 @@ func (s *grpcServer) RewardInfo(ctxCtx context.Context, r *proto.RewardInfoReque
 				delegatorsCountChan <- cachedCount
 				return
 			}
-			c, err := s.wallet.RPC.DelegatorsCount(representative)
+			c, err := s.wallet.RPC().DelegatorsCount(representative)
 			if err != nil {
 				delegatorsErrChan <- stacktrace.Propagate(err, "")
 				return
@@ -147,6 +148,14 @@ func (s *grpcServer) RewardInfo(ctxCtx context.Context, r *proto.RewardInfoReque
 		t := int32(total)
 		response.WithdrawalPositionInQueue = &p
 		response.WithdrawalsInQueue = &t
+	} else {
+		nextPayout, expected, err := s.withdrawalHandler.NextExpectedAutoWithdrawal(ctx, balance)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		if expected {
+			response.NextExpectedPayout = timestamppb.New(nextPayout)
+		}
 	}
 	return response, nil
 }
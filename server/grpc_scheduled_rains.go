@@ -0,0 +1,186 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/rewards"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *grpcServer) ScheduledRains(ctxCtx context.Context, r *proto.ScheduledRainsRequest) (*proto.ScheduledRainsResponse, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	statuses := []types.ScheduledRainStatus{}
+	for _, protoStatus := range r.Statuses {
+		switch protoStatus {
+		case proto.ScheduledRainStatus_SCHEDULED_RAIN_STATUS_SCHEDULED:
+			statuses = append(statuses, types.ScheduledRainStatusScheduled)
+		case proto.ScheduledRainStatus_SCHEDULED_RAIN_STATUS_COMPLETE:
+			statuses = append(statuses, types.ScheduledRainStatusComplete)
+		case proto.ScheduledRainStatus_SCHEDULED_RAIN_STATUS_CANCELLED:
+			statuses = append(statuses, types.ScheduledRainStatusCancelled)
+		default:
+			return nil, status.Error(codes.InvalidArgument, "invalid scheduled rain status")
+		}
+	}
+
+	rains, total, err := types.GetScheduledRains(ctx, statuses, nil, readPaginationParameters(r))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	protoRains := make([]*proto.ScheduledRain, len(rains))
+	for i, rain := range rains {
+		protoRains[i] = s.convertScheduledRain(ctx, rain, s.userSerializer)
+	}
+
+	return &proto.ScheduledRainsResponse{
+		Rains:  protoRains,
+		Offset: readOffset(r),
+		Total:  total,
+	}, nil
+}
+
+func (s *grpcServer) convertScheduledRain(ctx context.Context, orig *types.ScheduledRain, userSerializer auth.APIUserSerializer) *proto.ScheduledRain {
+	rain := &proto.ScheduledRain{
+		Id:                orig.ID,
+		ChannelId:         orig.ChannelID,
+		Amount:            payment.NewAmountFromDecimal(orig.Amount).SerializeForAPI(),
+		DistributedAmount: payment.NewAmountFromDecimal(orig.DistributedAmount).SerializeForAPI(),
+		ChatWindow:        durationpb.New(time.Duration(orig.ChatWindow)),
+		MediaCount:        uint32(orig.MediaCount),
+		MediaDistributed:  uint32(orig.MediaDistributed),
+		ScheduledFor:      timestamppb.New(orig.ScheduledFor),
+		CreatedBy:         userSerializer(ctx, auth.NewAddressOnlyUser(orig.CreatedBy)),
+		ApplicationId:     orig.ApplicationID,
+		CreatedAt:         timestamppb.New(orig.CreatedAt),
+		UpdatedAt:         timestamppb.New(orig.UpdatedAt),
+	}
+
+	switch orig.Audience {
+	case types.ScheduledRainAudienceSpectators:
+		rain.Audience = proto.ScheduledRainAudience_SCHEDULED_RAIN_AUDIENCE_SPECTATORS
+	case types.ScheduledRainAudienceChatters:
+		rain.Audience = proto.ScheduledRainAudience_SCHEDULED_RAIN_AUDIENCE_CHATTERS
+	}
+
+	switch orig.Status {
+	case types.ScheduledRainStatusScheduled:
+		rain.Status = proto.ScheduledRainStatus_SCHEDULED_RAIN_STATUS_SCHEDULED
+	case types.ScheduledRainStatusComplete:
+		rain.Status = proto.ScheduledRainStatus_SCHEDULED_RAIN_STATUS_COMPLETE
+	case types.ScheduledRainStatusCancelled:
+		rain.Status = proto.ScheduledRainStatus_SCHEDULED_RAIN_STATUS_CANCELLED
+	}
+	return rain
+}
+
+func (s *grpcServer) ScheduleRain(ctx context.Context, r *proto.ScheduleRainRequest) (*proto.ScheduleRainResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	amount, err := payment.NewAmountFromAPIString(r.Amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid amount")
+	}
+
+	params := rewards.ScheduledRainParams{
+		ChannelID:    channel.id,
+		Amount:       amount,
+		ChatWindow:   r.ChatWindow.AsDuration(),
+		MediaCount:   int(r.MediaCount),
+		ScheduledFor: r.ScheduledFor.AsTime(),
+		CreatedBy:    moderator.Address(),
+	}
+	switch r.Audience {
+	case proto.ScheduledRainAudience_SCHEDULED_RAIN_AUDIENCE_SPECTATORS:
+		params.Audience = types.ScheduledRainAudienceSpectators
+	case proto.ScheduledRainAudience_SCHEDULED_RAIN_AUDIENCE_CHATTERS:
+		params.Audience = types.ScheduledRainAudienceChatters
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid audience")
+	}
+	err = params.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rain, err := s.rewardsHandler.ScheduleRain(ctx, params)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to schedule rain")
+	}
+
+	s.log.Printf("Rain %s of %v scheduled by %s (remote address %s)", rain.ID, amount, moderator.ModeratorName(),
+		authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) scheduled rain `%s` of %s BAN on channel %s for %s",
+				moderator.Address()[:14], moderator.ModeratorName(), rain.ID, amount.SerializeForAPI(), rain.ChannelID,
+				rain.ScheduledFor.UTC().Format(time.RFC3339)))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.ScheduleRainResponse{
+		Rain: s.convertScheduledRain(ctx, rain, s.userSerializer),
+	}, nil
+}
+
+func (s *grpcServer) CancelScheduledRain(ctx context.Context, r *proto.CancelScheduledRainRequest) (*proto.CancelScheduledRainResponse, error) {
+	moderator := authinterceptor.UserFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	rain, err := s.rewardsHandler.CancelScheduledRain(ctx, r.RainId, "")
+	if err != nil {
+		if errors.Is(err, rewards.ErrScheduledRainNotFound) {
+			return nil, status.Error(codes.NotFound, "scheduled rain not found")
+		}
+		return nil, stacktrace.Propagate(err, "failed to cancel scheduled rain")
+	}
+
+	s.log.Printf("Scheduled rain %s cancelled by %s (remote address %s)", rain.ID, moderator.ModeratorName(),
+		authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) cancelled scheduled rain `%s`",
+				moderator.Address()[:14], moderator.ModeratorName(), rain.ID))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.CancelScheduledRainResponse{
+		Rain: s.convertScheduledRain(ctx, rain, s.userSerializer),
+	}, nil
+}
@@ -5,9 +5,6 @@ import (
 	"crypto/ecdsa"
 	"encoding/hex"
 	"log"
-	"math/rand"
-	"os"
-	"strings"
 	"sync"
 	"time"
 
@@ -16,12 +13,11 @@ import (
 	goaway "github.com/TwiN/go-away"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/bwmarrin/snowflake"
-	"github.com/hectorchu/gonano/rpc"
-	"github.com/hectorchu/gonano/wallet"
 	"github.com/palantir/stacktrace"
 	"github.com/patrickmn/go-cache"
 	"github.com/sethvargo/go-limiter"
 	"github.com/sethvargo/go-limiter/memorystore"
+	"github.com/shopspring/decimal"
 	"github.com/tnyim/jungletv/buildconfig"
 	"github.com/tnyim/jungletv/proto"
 	"github.com/tnyim/jungletv/segcha"
@@ -34,24 +30,33 @@ import (
 	"github.com/tnyim/jungletv/server/components/configurationmanager"
 	"github.com/tnyim/jungletv/server/components/enqueuemanager"
 	"github.com/tnyim/jungletv/server/components/ipreputation"
+	"github.com/tnyim/jungletv/server/components/journal"
+	"github.com/tnyim/jungletv/server/components/medialibrary"
 	"github.com/tnyim/jungletv/server/components/mediaqueue"
 	"github.com/tnyim/jungletv/server/components/nanswapclient"
+	"github.com/tnyim/jungletv/server/components/node"
 	"github.com/tnyim/jungletv/server/components/notificationmanager"
 	"github.com/tnyim/jungletv/server/components/oauth"
 	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/paymentintentmanager"
 	"github.com/tnyim/jungletv/server/components/pointsmanager"
+	"github.com/tnyim/jungletv/server/components/pointsrewards"
 	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/server/components/refundmanager"
 	"github.com/tnyim/jungletv/server/components/rewards"
 	"github.com/tnyim/jungletv/server/components/skipmanager"
 	"github.com/tnyim/jungletv/server/components/staffactivitymanager"
 	"github.com/tnyim/jungletv/server/components/stats"
+	"github.com/tnyim/jungletv/server/components/tipmanager"
 	"github.com/tnyim/jungletv/server/components/turnstileclient"
 	"github.com/tnyim/jungletv/server/components/withdrawalhandler"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
 	"github.com/tnyim/jungletv/server/interceptors/version"
 	"github.com/tnyim/jungletv/server/media"
 	"github.com/tnyim/jungletv/server/media/applicationpage"
+	"github.com/tnyim/jungletv/server/media/directmedia"
 	"github.com/tnyim/jungletv/server/media/document"
+	"github.com/tnyim/jungletv/server/media/library"
 	"github.com/tnyim/jungletv/server/media/soundcloud"
 	"github.com/tnyim/jungletv/server/media/youtube"
 	"github.com/tnyim/jungletv/server/stores/blockeduser"
@@ -62,7 +67,6 @@ import (
 	"github.com/tnyim/jungletv/utils"
 	"github.com/tnyim/jungletv/utils/event"
 	"github.com/tnyim/jungletv/utils/simplelogger"
-	"github.com/tnyim/jungletv/utils/transaction"
 	"github.com/vburenin/nsync"
 	"google.golang.org/api/option"
 	youtubeapi "google.golang.org/api/youtube/v3"
@@ -75,11 +79,14 @@ type grpcServer struct {
 
 	log                               *log.Logger
 	statsClient                       *statsd.Client
-	wallet                            *wallet.Wallet
-	collectorAccount                  *wallet.Account
-	collectorAccountQueue             chan func(*wallet.Account, *rpc.Client, *rpc.Client)
-	skipAccount                       *wallet.Account
-	rainAccount                       *wallet.Account
+	wallet                            node.Wallet
+	collectorAccount                  node.Account
+	nanoWallet                        node.Wallet
+	nanoCollectorAccount              node.Account
+	exchangeRateSource                payment.ExchangeRateSource
+	collectorAccountQueue             chan func(node.Account)
+	skipAccount                       node.Account
+	rainAccount                       node.Account
 	jwtManager                        *auth.JWTManager
 	thirdPartyAuthorizer              *auth.ThirdPartyAuthorizer
 	enqueueRequestRateLimiter         limiter.Store
@@ -108,20 +115,28 @@ type grpcServer struct {
 	enqueuingPassword                   string
 	enqueuingPasswordEdition            string
 	enqueuingPasswordAttemptRateLimiter limiter.Store
-	autoEnqueueVideos                   bool
-	autoEnqueueVideoListFile            string
 	ticketCheckPeriod                   time.Duration
 
 	signInProcesses           *cache.Cache[string, *signInProcess]
 	delegatorCountsPerRep     *cache.Cache[string, uint64]
 	addressesWithGoodRepCache *cache.Cache[string, struct{}]
 
-	mediaQueue           *mediaqueue.MediaQueue
-	pricer               *pricer.Pricer
-	enqueueManager       *enqueuemanager.Manager
-	skipManager          *skipmanager.Manager
+	channels   map[string]*broadcastChannel
+	channelIDs []string // sorted
+
+	// components of the main channel
+	mediaQueue     *mediaqueue.MediaQueue
+	pricer         *pricer.Pricer
+	enqueueManager *enqueuemanager.Manager
+	skipManager    *skipmanager.Manager
+
 	rewardsHandler       *rewards.Handler
 	withdrawalHandler    *withdrawalhandler.Handler
+	refundManager        *refundmanager.Manager
+	paymentIntentManager *paymentintentmanager.Manager
+	tipManager           *tipmanager.Manager
+	pointsRewardsManager *pointsrewards.Manager
+	journal              *journal.Journal
 	statsRegistry        *stats.Registry
 	chat                 *chatmanager.Manager
 	pointsManager        *pointsmanager.Manager
@@ -135,6 +150,8 @@ type grpcServer struct {
 	appRunner     *apprunner.AppRunner
 	configManager *configurationmanager.Manager
 
+	mediaLibrary *medialibrary.Library
+
 	soundCloudProvider *soundcloud.TrackProvider
 	mediaProviders     map[types.MediaType]media.Provider
 	modLogWebhook      api.WebhookClient
@@ -162,10 +179,16 @@ type Options struct {
 	Log         *log.Logger
 	StatsClient *statsd.Client
 
-	Wallet                *wallet.Wallet
+	Wallet                node.Wallet
 	OAuthManager          *oauth.Manager
 	RepresentativeAddress string
 
+	// NanoWallet enables receiving XNO directly, in addition to Banano, when not nil
+	NanoWallet                node.Wallet
+	NanoRepresentativeAddress string
+	// NanoExchangeRate is how much BAN one XNO is worth. When zero, exchange rates are obtained from Nanswap
+	NanoExchangeRate decimal.Decimal
+
 	JWTManager         *auth.JWTManager
 	AuthInterceptor    *authinterceptor.Interceptor
 	VersionInterceptor *version.VersionInterceptor
@@ -184,8 +207,9 @@ type Options struct {
 	AppRunner           *apprunner.AppRunner
 	ConfigManager       *configurationmanager.Manager
 	NotificationManager *notificationmanager.Manager
+	MediaLibrary        *medialibrary.Library
 
-	AutoEnqueueVideoListFile string
+	AutoEnqueueVideoListFile string // imported into a new autoplay pool of the main channel when it has no pools
 	QueueFile                string
 
 	TypeScriptTypeDefinitionsFile string
@@ -195,6 +219,13 @@ type Options struct {
 	WebsiteURL string
 
 	NanswapAPIKey string
+	// SwapProviders lists, in order of preference, the providers used to swap payments in extra currencies.
+	// Valid names are "nanswap" and, in lab and debug builds, "mock". When empty, only Nanswap is used
+	SwapProviders []string
+	// MockSwapLiquidityWallet is the wallet whose first account the mock swap provider pays out from
+	MockSwapLiquidityWallet node.Wallet
+	// MockSwapCompletionDelay is how long the mock swap provider waits before completing its orders
+	MockSwapCompletionDelay time.Duration
 
 	TurnstileSecretKey string
 
@@ -214,8 +245,11 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetChatNickname", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RewardHistory", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/WithdrawalHistory", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/WithdrawalPreferences", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetWithdrawalPreferences", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RemoveOwnQueueEntry", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/MoveQueueEntry", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/QueueEntryMovementCost", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ProduceSegchaChallenge", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/Connections", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/CreateConnection", auth.UserPermissionLevel)
@@ -229,8 +263,13 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/PointsTransactions", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ChatGifSearch", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ConvertBananoToPoints", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/TipWithPoints", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/TipWithBanano", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RedeemPointsReward", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/PointsRewardRedemptions", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/StartOrExtendSubscription", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/IncreaseOrReduceSkipThreshold", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/VoteToSkip", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/InvalidateAuthTokens", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/AuthorizationProcessData", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ConsentOrDissentToAuthorization", auth.UserPermissionLevel)
@@ -259,12 +298,24 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ConfirmRaffleWinner", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/CompleteRaffle", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RedrawRaffle", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/Refunds", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ProposeRefund", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ApproveRefund", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RetryRefund", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ReconcileJournal", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ScheduledRains", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ScheduleRain", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/CancelScheduledRain", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/TriggerAnnouncementsNotification", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SpectatorInfo", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ResetSpectatorStatus", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/MonitorModerationStatus", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetOwnQueueEntryRemovalAllowed", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetQueueEntryReorderingAllowed", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetQueueOrderingPolicy", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetRewardDistributionStrategy", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/PauseBroadcast", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ResumeBroadcast", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetNewQueueEntriesAlwaysUnskippable", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetSkippingEnabled", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetQueueInsertCursor", auth.AdminPermissionLevel)
@@ -273,6 +324,9 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/MarkAsActivelyModerating", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/StopActivelyModerating", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/AdjustPointsBalance", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetPointsReward", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/AllPointsRewardRedemptions", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/UpdatePointsRewardRedemption", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/AddVipUser", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RemoveVipUser", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/TriggerClientReload", auth.AdminPermissionLevel)
@@ -281,6 +335,32 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/InvalidateUserAuthTokens", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetRPCProxyEnabled", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/Spectators", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/TimetableSlots", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetTimetableSlot", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RemoveTimetableSlot", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/CreateBroadcastChannel", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/PricingRules", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetPricingRule", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RemovePricingRule", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SimulatePricing", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/AutoplayPools", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetAutoplayPool", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RemoveAutoplayPool", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/AutoplayPoolEntries", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/AddAutoplayPoolEntry", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RemoveAutoplayPoolEntry", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/QueueSnapshots", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/QueueSnapshot", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RestoreQueueSnapshot", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/MediaReplayRules", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetMediaReplayRule", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RemoveMediaReplayRule", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/LibraryMedia", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/UploadLibraryMedia", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RemoveLibraryMedia", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ExportQueue", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ExportPlayedMedia", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ImportQueue", auth.AdminPermissionLevel)
 
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/Applications", auth.AppEditorPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/GetApplication", auth.AppEditorPermissionLevel)
@@ -308,23 +388,23 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 	}
 
 	// API secret broken into parts to hopefully make it more difficult for automated API key leak detection tools to catch it
-	soundCloudProvider := soundcloud.NewProvider("api-widget.soundcloud.com", "2TbzkniijIj"+"R2bzVmnak"+"v77C05jctxtC", "1719569473") // TODO unhardcode
+	soundCloudClientID, soundCloudAppVersion := "2TbzkniijIj"+"R2bzVmnak"+"v77C05jctxtC", "1719569473" // TODO unhardcode
 
-	ytProvider, err := youtube.NewProvider(ctx, ytClient)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "error creating YouTube provider")
-	}
-
-	mediaProviders := map[types.MediaType]media.Provider{
-		types.MediaTypeYouTubeVideo:    ytProvider,
-		types.MediaTypeSoundCloudTrack: soundCloudProvider,
-		types.MediaTypeDocument:        document.NewProvider(ctx),
-		types.MediaTypeApplicationPage: applicationpage.NewProvider(),
-	}
+	// each channel gets its own set of providers, as providers check for duplicates in the queue they're attached to
+	newMediaProviders := func() (map[types.MediaType]media.Provider, error) {
+		ytProvider, err := youtube.NewProvider(ctx, ytClient)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "error creating YouTube provider")
+		}
 
-	mediaQueue, err := mediaqueue.New(ctx, options.Log, options.StatsClient, options.QueueFile, mediaProviders)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "")
+		return map[types.MediaType]media.Provider{
+			types.MediaTypeYouTubeVideo:    ytProvider,
+			types.MediaTypeSoundCloudTrack: soundcloud.NewProvider("api-widget.soundcloud.com", soundCloudClientID, soundCloudAppVersion),
+			types.MediaTypeDocument:        document.NewProvider(ctx),
+			types.MediaTypeApplicationPage: applicationpage.NewProvider(),
+			types.MediaTypeDirectMedia:     directmedia.NewProvider(),
+			types.MediaTypeLibraryMedia:    library.NewProvider(options.WebsiteURL),
+		}, nil
 	}
 
 	modStore, err := moderation.NewStoreDatabase(ctx)
@@ -340,15 +420,13 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 	s := &grpcServer{
 		log:                        options.Log,
 		wallet:                     options.Wallet,
+		nanoWallet:                 options.NanoWallet,
 		statsClient:                options.StatsClient,
 		jwtManager:                 options.JWTManager,
 		signInProcesses:            cache.New[string, *signInProcess](5*time.Minute, 1*time.Minute),
 		delegatorCountsPerRep:      cache.New[string, uint64](1*time.Hour, 5*time.Minute),
 		addressesWithGoodRepCache:  cache.New[string, struct{}](6*time.Hour, 5*time.Minute),
-		mediaQueue:                 mediaQueue,
-		collectorAccountQueue:      make(chan func(*wallet.Account, *rpc.Client, *rpc.Client), 10000),
-		autoEnqueueVideoListFile:   options.AutoEnqueueVideoListFile,
-		autoEnqueueVideos:          options.AutoEnqueueVideoListFile != "",
+		collectorAccountQueue:      make(chan func(node.Account), 10000),
 		allowMediaEnqueuing:        proto.AllowedMediaEnqueuingType_ENABLED,
 		allowMediaEnqueuingChanged: event.New[allowedMediaEnqueuingChangedEventArgs](),
 		ticketCheckPeriod:          options.TicketCheckPeriod,
@@ -361,6 +439,7 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 		appRunner:           options.AppRunner,
 		configManager:       options.ConfigManager,
 		notificationManager: options.NotificationManager,
+		mediaLibrary:        options.MediaLibrary,
 
 		oauthManager: options.OAuthManager,
 
@@ -371,9 +450,6 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 		segchaClient:           options.SegchaClient,
 		turnstileClient:        turnstileclient.New(options.TurnstileSecretKey, 10*time.Second),
 
-		mediaProviders:     mediaProviders,
-		soundCloudProvider: soundCloudProvider.(*soundcloud.TrackProvider),
-
 		announcementsUpdated: event.New[int](),
 
 		rewardHistoryMutex: nsync.NewNamedMutex(),
@@ -459,7 +535,7 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 		return nil, stacktrace.Propagate(err, "")
 	}
 
-	err = s.setupSpecialAccounts(options.RepresentativeAddress)
+	err = s.setupSpecialAccounts(options.RepresentativeAddress, options.NanoRepresentativeAddress)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
@@ -469,14 +545,37 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 		return nil, stacktrace.Propagate(err, "")
 	}
 
-	s.pricer = pricer.New(s.log, s.mediaQueue, s.statsRegistry)
+	nanswapProvider := payment.NewNanswapSwapProvider(nanswapclient.New("https://api.nanswap.com/v1", options.NanswapAPIKey))
+	if options.NanoExchangeRate.IsPositive() {
+		s.exchangeRateSource = payment.FixedExchangeRateSource{payment.CurrencyNano: options.NanoExchangeRate}
+	} else {
+		s.exchangeRateSource = payment.NewSwapProviderExchangeRateSource(nanswapProvider, 5*time.Minute)
+	}
 
-	s.skipManager = skipmanager.New(s.log, s.wallet.RPC, s.skipAccount, s.rainAccount, s.collectorAccount.Address(), s.mediaQueue, s.pricer)
+	swapProviders, err := s.setupSwapProviders(options.SwapProviders, nanswapProvider,
+		options.MockSwapLiquidityWallet, options.MockSwapCompletionDelay)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
 
-	nanswapClient := nanswapclient.New("https://api.nanswap.com/v1", options.NanswapAPIKey)
+	err = s.setupBroadcastChannels(ctx, options.RepresentativeAddress, options.NanoRepresentativeAddress, options.QueueFile, newMediaProviders)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	s.soundCloudProvider = s.mediaProviders[types.MediaTypeSoundCloudTrack].(*soundcloud.TrackProvider)
 
 	s.paymentAccountPool = payment.New(s.log, s.statsClient, options.Wallet, options.RepresentativeAddress, s.modLogWebhook,
-		payment.NewAmount(pricer.DustThreshold), s.collectorAccount.Address(), nanswapClient)
+		payment.NewAmount(pricer.DustThreshold), s.collectorAccount.Address(), swapProviders)
+	s.paymentAccountPool.SetExchangeRateSource(s.exchangeRateSource)
+	if s.nanoWallet != nil {
+		s.paymentAccountPool.EnableNativeCurrency(payment.CurrencyNano, s.nanoWallet, options.NanoRepresentativeAddress,
+			payment.NewAmount(pricer.NanoDustThreshold), s.nanoCollectorAccount.Address())
+	}
+
+	s.paymentIntentManager, err = paymentintentmanager.New(ctx, s.log, s.paymentAccountPool)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
 
 	s.appEditor = appeditor.New(s.log, s.appRunner, s.paymentAccountPool)
 
@@ -485,6 +584,9 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 		return nil, stacktrace.Propagate(err, "")
 	}
 
+	s.tipManager = tipmanager.New(ctx, s.log, s.pointsManager, s.paymentAccountPool)
+	s.pointsRewardsManager = pointsrewards.New(s.log, s.pointsManager)
+
 	chatStore := chat.NewStoreDatabase(s.log, s.nicknameCache)
 	s.chat, err = chatmanager.New(ctx, s.log, s.statsClient, chatStore, s.moderationStore,
 		blockeduser.NewStoreDatabase(), s.userSerializer, s.pointsManager, s.snowflakeNode, options.TenorAPIKey)
@@ -492,29 +594,46 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 		return nil, stacktrace.Propagate(err, "")
 	}
 
-	s.withdrawalHandler = withdrawalhandler.New(s.log, s.statsClient, s.collectorAccountQueue, &s.wallet.RPC, s.modLogWebhook)
+	s.withdrawalHandler = withdrawalhandler.New(s.log, s.statsClient, s.collectorAccountQueue, s.wallet.RPC(), s.modLogWebhook)
+	s.refundManager = refundmanager.New(s.log, s.collectorAccountQueue, s.wallet.RPC(), s.paymentAccountPool, s.modLogWebhook)
+
+	s.journal = journal.New(s.log, s.wallet.RPC(), s.paymentAccountPool)
+	journalAddresses := []string{s.collectorAccount.Address()}
+	for _, channelID := range s.channelIDs {
+		channel := s.channels[channelID]
+		journalAddresses = append(journalAddresses, channel.skipAccount.Address(), channel.rainAccount.Address())
+	}
+	err = s.journal.RecordOpeningBalances(ctx, journalAddresses)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
 
 	challengeCheckers := map[rewards.ActivityChallengeType]rewards.ChallengeCheckFunction{
 		rewards.ActivityChallengeTypeSegcha:    s.segchaResponseValid,
 		rewards.ActivityChallengeTypeTurnstile: s.turnstileResponseValid,
 	}
 
-	s.rewardsHandler, err = rewards.NewHandler(
-		s.log, options.StatsClient, s.mediaQueue, s.ipReputationChecker, s.withdrawalHandler, options.Wallet,
-		s.collectorAccountQueue, s.skipManager, s.chat, s.pointsManager, s.notificationManager, s.paymentAccountPool,
+	s.rewardsHandler, err = rewards.NewHandler(ctx,
+		s.log, options.StatsClient, s.rewardsChannels(), s.ipReputationChecker, s.withdrawalHandler, s.refundManager, options.Wallet,
+		s.collectorAccountQueue, s.chat, s.pointsManager, s.notificationManager, s.paymentAccountPool,
 		s.moderationStore, s.staffActivityManager, challengeCheckers, options.VersionInterceptor.VersionHash)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
 	s.staffActivityManager.SetAddressActivityMarker(s.rewardsHandler)
-	s.pricer.SetEligibleSpectatorsEstimator(s.rewardsHandler)
 
-	s.enqueueManager, err = enqueuemanager.New(ctx, s.log, s.statsClient, s.mediaQueue, s.pricer,
-		s.paymentAccountPool, s.rewardsHandler, s.pointsManager, s.moderationStore, s.modLogWebhook)
+	err = s.finishBroadcastChannelsSetup(ctx)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
 
+	if options.AutoEnqueueVideoListFile != "" {
+		err = s.channels[types.MainBroadcastChannelID].autoplay.ImportLegacyList(ctx, options.AutoEnqueueVideoListFile)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+
 	skBytes, err := hex.DecodeString(options.RaffleSecretKey)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
@@ -533,6 +652,9 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 		SkipManager:                  s.skipManager,
 		OtherMediaQueueMethods:       &appRuntimeMiscMethods{s: s},
 		PaymentAccountPool:           s.paymentAccountPool,
+		PaymentIntentManager:         s.paymentIntentManager,
+		TipManager:                   s.tipManager,
+		PointsRewardsManager:         s.pointsRewardsManager,
 		DefaultAccountRepresentative: options.RepresentativeAddress,
 		UserCache:                    s.nicknameCache,
 		RewardsHandler:               s.rewardsHandler,
@@ -542,7 +664,7 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 	return s, nil
 }
 
-func (s *grpcServer) setupSpecialAccounts(repAddress string) error {
+func (s *grpcServer) setupSpecialAccounts(repAddress, nanoRepAddress string) error {
 	var err error
 	collectorAccountIdx := uint32(0)
 	s.collectorAccount, err = s.wallet.NewAccount(&collectorAccountIdx)
@@ -555,28 +677,47 @@ func (s *grpcServer) setupSpecialAccounts(repAddress string) error {
 		return stacktrace.Propagate(err, "")
 	}
 
-	skipAccountIdx := uint32(11575)
-	s.skipAccount, err = s.wallet.NewAccount(&skipAccountIdx)
-	if err != nil {
-		return stacktrace.Propagate(err, "")
-	}
-
-	err = s.skipAccount.SetRep(repAddress)
-	if err != nil {
-		return stacktrace.Propagate(err, "")
-	}
+	if s.nanoWallet != nil {
+		s.nanoCollectorAccount, err = s.nanoWallet.NewAccount(&collectorAccountIdx)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
 
-	rainAccountIdx := uint32(397007)
-	s.rainAccount, err = s.wallet.NewAccount(&rainAccountIdx)
-	if err != nil {
-		return stacktrace.Propagate(err, "")
+		err = s.nanoCollectorAccount.SetRep(nanoRepAddress)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
 	}
+	return nil
+}
 
-	err = s.rainAccount.SetRep(repAddress)
-	if err != nil {
-		return stacktrace.Propagate(err, "")
+func (s *grpcServer) setupSwapProviders(names []string, nanswapProvider payment.SwapProvider,
+	mockLiquidityWallet node.Wallet, mockCompletionDelay time.Duration) ([]payment.SwapProvider, error) {
+	if len(names) == 0 {
+		return []payment.SwapProvider{nanswapProvider}, nil
+	}
+	providers := []payment.SwapProvider{}
+	for _, name := range names {
+		switch name {
+		case "nanswap":
+			providers = append(providers, nanswapProvider)
+		case "mock":
+			if (!buildconfig.LAB && !buildconfig.DEBUG) || mockLiquidityWallet == nil {
+				return nil, stacktrace.NewError("mock swap provider not available in production environments")
+			}
+			liquidityAccountIndex := uint32(0)
+			liquidityAccount, err := mockLiquidityWallet.NewAccount(&liquidityAccountIndex)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "")
+			}
+			s.log.Printf("WARNING: mock swap provider enabled. It will pay out from %s without receiving anything",
+				liquidityAccount.Address())
+			providers = append(providers, payment.NewMockSwapProvider(liquidityAccount, s.exchangeRateSource, mockCompletionDelay))
+		default:
+			return nil, stacktrace.NewError("unknown swap provider %s", name)
+		}
 	}
-	return nil
+	return providers, nil
 }
 
 func (s *grpcServer) getChatFriendlyUserName(ctx context.Context, address string) (string, error) {
@@ -601,54 +742,6 @@ func (s *grpcServer) getChatFriendlyUserName(ctx context.Context, address string
 	return name, nil
 }
 
-func (s *grpcServer) autoEnqueueNewVideo(ctx transaction.WrappingContext) error {
-	videoID, err := s.getRandomVideoForAutoEnqueue()
-	if err != nil {
-		return stacktrace.Propagate(err, "")
-	}
-
-	preInfo, result, err := s.mediaProviders[types.MediaTypeYouTubeVideo].BeginEnqueueRequest(ctx, &proto.EnqueueMediaRequest_YoutubeVideoData{
-		YoutubeVideoData: &proto.EnqueueYouTubeVideoData{
-			Id: videoID,
-		},
-	})
-	if err != nil {
-		return stacktrace.Propagate(err, "")
-	}
-
-	if result != media.EnqueueRequestCreationSucceeded {
-		return stacktrace.NewError("enqueue request for video %s creation failed due to video characteristics", videoID)
-	}
-
-	request, result, err := s.mediaProviders[types.MediaTypeYouTubeVideo].ContinueEnqueueRequest(ctx, preInfo, false, false, false, false, false, false)
-	if err != nil {
-		return stacktrace.Propagate(err, "")
-	}
-
-	if result != media.EnqueueRequestCreationSucceeded {
-		return stacktrace.NewError("enqueue request for video %s creation failed", videoID)
-	}
-
-	ticket, err := s.enqueueManager.RegisterRequest(ctx, request, true)
-	if err != nil {
-		return stacktrace.Propagate(err, "")
-	}
-	ticket.ForceEnqueuing(proto.ForcedTicketEnqueueType_ENQUEUE)
-	s.log.Printf("Auto-enqueued video with ID %s", videoID)
-	return nil
-}
-
-func (s *grpcServer) getRandomVideoForAutoEnqueue() (string, error) {
-	b, err := os.ReadFile(s.autoEnqueueVideoListFile)
-	if err != nil {
-		return "", stacktrace.Propagate(err, "error reading auto enqueue videos from file: %v", err)
-	}
-	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
-	line := lines[rand.Intn(len(lines))]
-	id := strings.TrimSpace(strings.Split(line, " ")[0])
-	return id, nil
-}
-
 type allowedMediaEnqueuingChangedEventArgs struct {
 	allowedMediaEnqueuing proto.AllowedMediaEnqueuingType
 	passwordEdition       string
@@ -12,7 +12,6 @@ import (
 	"github.com/tnyim/jungletv/server/components/chatmanager"
 	"github.com/tnyim/jungletv/server/components/notificationmanager/notifications"
 	"github.com/tnyim/jungletv/utils/event"
-	"github.com/tnyim/jungletv/utils/transaction"
 )
 
 func (s *grpcServer) Worker(ctx context.Context, errorCb func(error)) {
@@ -42,6 +41,40 @@ func (s *grpcServer) Worker(ctx context.Context, errorCb func(error)) {
 		}
 	}(ctx)
 
+	go func(ctx context.Context) {
+		for {
+			s.log.Println("Refund manager starting/restarting")
+			err := s.refundManager.Worker(ctx)
+			if err == nil {
+				return
+			}
+			errChan <- stacktrace.Propagate(err, "refund manager error")
+			select {
+			case <-ctx.Done():
+				s.log.Println("Refund manager done")
+				return
+			default:
+			}
+		}
+	}(ctx)
+
+	go func(ctx context.Context) {
+		for {
+			s.log.Println("Journal worker starting/restarting")
+			err := s.journal.Worker(ctx)
+			if err == nil {
+				return
+			}
+			errChan <- stacktrace.Propagate(err, "journal worker error")
+			select {
+			case <-ctx.Done():
+				s.log.Println("Journal worker done")
+				return
+			default:
+			}
+		}
+	}(ctx)
+
 	go func(ctx context.Context) {
 		for {
 			s.log.Println("Rewards handler starting/restarting")
@@ -78,45 +111,84 @@ func (s *grpcServer) Worker(ctx context.Context, errorCb func(error)) {
 		}(ctx)
 	}
 
-	go func(ctx context.Context) {
-		for {
-			s.log.Println("Skip manager starting/restarting")
-			err := s.skipManager.Worker(ctx)
-			if err == nil {
-				return
-			}
-			errChan <- stacktrace.Propagate(err, "skip manager error")
-			select {
-			case <-ctx.Done():
-				s.log.Println("Skip manager done")
-				return
-			default:
+	for _, channel := range s.channels {
+		go func(ctx context.Context, channel *broadcastChannel) {
+			for {
+				s.log.Printf("Skip manager of channel %s starting/restarting", channel.id)
+				err := channel.skipManager.Worker(ctx)
+				if err == nil {
+					return
+				}
+				errChan <- stacktrace.Propagate(err, "skip manager error")
+				select {
+				case <-ctx.Done():
+					s.log.Printf("Skip manager of channel %s done", channel.id)
+					return
+				default:
+				}
 			}
-		}
-	}(ctx)
+		}(ctx, channel)
 
-	go func(ctx context.Context) {
-		for {
-			s.log.Println("Skip manager balances checker starting/restarting")
-			err := s.skipManager.BalancesWorker(ctx, s.ticketCheckPeriod)
-			if err == nil {
-				return
+		go func(ctx context.Context, channel *broadcastChannel) {
+			for {
+				s.log.Printf("Skip manager balances checker of channel %s starting/restarting", channel.id)
+				err := channel.skipManager.BalancesWorker(ctx, s.ticketCheckPeriod)
+				if err == nil {
+					return
+				}
+				errChan <- stacktrace.Propagate(err, "skip manager balances worker error")
+				select {
+				case <-ctx.Done():
+					s.log.Printf("Skip manager balances worker of channel %s done", channel.id)
+					return
+				default:
+				}
 			}
-			errChan <- stacktrace.Propagate(err, "skip manager balances worker error")
-			select {
-			case <-ctx.Done():
-				s.log.Println("Skip manager balances worker done")
-				return
-			default:
+		}(ctx, channel)
+
+		go channel.mediaQueue.ProcessQueueWorker(ctx)
+
+		go func(ctx context.Context, channel *broadcastChannel) {
+			mediaChangedC, mediaChangedU := channel.mediaQueue.MediaChanged().Subscribe(event.BufferFirst)
+			defer mediaChangedU()
+
+			wait := time.Duration(90+rand.Intn(180)) * time.Second
+			t := time.NewTimer(wait)
+			defer t.Stop()
+			for {
+				select {
+				case v := <-mediaChangedC:
+					if v == nil {
+						wait = time.Duration(90+rand.Intn(180)) * time.Second
+						t.Reset(wait)
+					}
+				case <-t.C:
+					allowed := s.getAllowMediaEnqueuing() == proto.AllowedMediaEnqueuingType_ENABLED
+					if channel.mediaQueue.Length() == 0 && allowed {
+						for attempt := 0; attempt < 3; attempt++ {
+							_, err := channel.autoplay.EnqueueNext(ctx)
+							if err != nil {
+								errChan <- stacktrace.Propagate(err, "autoplay error on channel %s", channel.id)
+							} else {
+								wait = time.Duration(90+rand.Intn(180)) * time.Second
+								t.Reset(wait)
+								break
+							}
+						}
+					}
+				case <-ctx.Done():
+					s.log.Printf("Autoplay worker of channel %s done", channel.id)
+					return
+				}
 			}
-		}
-	}(ctx)
+		}(ctx, channel)
+	}
 
 	go func(ctx context.Context) {
 		for {
 			select {
 			case f := <-s.collectorAccountQueue:
-				f(s.collectorAccount, &s.wallet.RPC, &s.wallet.RPCWork)
+				f(s.collectorAccount)
 			case <-ctx.Done():
 				s.log.Println("Collector account worker done")
 				return
@@ -175,7 +247,6 @@ func (s *grpcServer) Worker(ctx context.Context, errorCb func(error)) {
 		}
 	}(ctx)
 
-	go s.mediaQueue.ProcessQueueWorker(ctx)
 	go s.staffActivityManager.StatsWorker(ctx)
 	go s.ipReputationChecker.Worker(ctx)
 
@@ -196,46 +267,6 @@ func (s *grpcServer) Worker(ctx context.Context, errorCb func(error)) {
 		}
 	}()
 
-	go func() {
-		mediaChangedC, mediaChangedU := s.mediaQueue.MediaChanged().Subscribe(event.BufferFirst)
-		defer mediaChangedU()
-
-		wait := time.Duration(90+rand.Intn(180)) * time.Second
-		t := time.NewTimer(wait)
-		defer t.Stop()
-		for {
-			select {
-			case v := <-mediaChangedC:
-				if v == nil {
-					wait = time.Duration(90+rand.Intn(180)) * time.Second
-					t.Reset(wait)
-				}
-			case <-t.C:
-				allowed := s.getAllowMediaEnqueuing() == proto.AllowedMediaEnqueuingType_ENABLED
-				if s.mediaQueue.Length() == 0 && s.autoEnqueueVideos &&
-					allowed {
-					for attempt := 0; attempt < 3; attempt++ {
-						err := func() error {
-							tx, err := transaction.Begin(ctx)
-							if err != nil {
-								return stacktrace.Propagate(err, "")
-							}
-							defer tx.Commit() // read-only tx
-							return s.autoEnqueueNewVideo(tx)
-						}()
-						if err != nil {
-							errChan <- stacktrace.Propagate(err, "")
-						} else {
-							wait = time.Duration(90+rand.Intn(180)) * time.Second
-							t.Reset(wait)
-							break
-						}
-					}
-				}
-			}
-		}
-	}()
-
 	defer s.chat.OnMessageCreated().SubscribeUsingCallback(event.BufferAll, func(evt chatmanager.MessageCreatedEventArgs) {
 		m := evt.Message
 		if m.Reference != nil && m.Reference.Author != nil && !m.Reference.Author.IsUnknown() &&
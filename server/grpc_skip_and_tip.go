@@ -2,13 +2,16 @@ package server
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"time"
 
 	"github.com/palantir/stacktrace"
 	"github.com/tnyim/jungletv/proto"
 	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/pointsmanager"
 	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/server/components/skipmanager"
 	"github.com/tnyim/jungletv/server/components/stats"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
 	"github.com/tnyim/jungletv/types"
@@ -19,10 +22,15 @@ import (
 )
 
 func (s *grpcServer) MonitorSkipAndTip(r *proto.MonitorSkipAndTipRequest, stream proto.JungleTV_MonitorSkipAndTipServer) error {
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
 	ctx := stream.Context()
 	user := authinterceptor.UserFromContext(ctx)
 
-	onStatusUpdated, statusUpdatedU := s.skipManager.StatusUpdated().Subscribe(event.BufferFirst)
+	onStatusUpdated, statusUpdatedU := channel.skipManager.StatusUpdated().Subscribe(event.BufferFirst)
 	defer statusUpdatedU()
 
 	onVersionHashChanged, versionHashChangedU := s.versionInterceptor.VersionHashUpdated().Subscribe(event.BufferFirst)
@@ -31,8 +39,17 @@ func (s *grpcServer) MonitorSkipAndTip(r *proto.MonitorSkipAndTipRequest, stream
 	unregister := s.statsRegistry.RegisterStreamSubscriber(stats.StatStreamConsumersCommunitySkipping, user != nil && !user.IsUnknown())
 	defer unregister()
 
-	latestSkipStatus := s.skipManager.SkipAccountStatus()
-	latestRainStatus := s.skipManager.RainAccountStatus()
+	latestSkipStatus := channel.skipManager.SkipAccountStatus()
+	latestRainStatus := channel.skipManager.RainAccountStatus()
+
+	extraCurrencyAddresses := []*proto.ExtraCurrencySkipAndTipAddresses{}
+	for _, addresses := range channel.skipManager.NativeCurrencyAccounts() {
+		extraCurrencyAddresses = append(extraCurrencyAddresses, &proto.ExtraCurrencySkipAndTipAddresses{
+			CurrencyTicker: string(addresses.Currency),
+			SkipAddress:    addresses.SkipAddress,
+			RainAddress:    addresses.RainAddress,
+		})
+	}
 
 	buildStatus := func() *proto.SkipAndTipStatus {
 		return &proto.SkipAndTipStatus{
@@ -43,10 +60,14 @@ func (s *grpcServer) MonitorSkipAndTip(r *proto.MonitorSkipAndTipRequest, stream
 			SkipThresholdLowerable: latestSkipStatus.ThresholdLowerable,
 			RainAddress:            latestRainStatus.Address,
 			RainBalance:            latestRainStatus.Balance.SerializeForAPI(),
+			SkipVotes:              int32(latestSkipStatus.SkipVotes),
+			SkipVoteThreshold:      int32(latestSkipStatus.SkipVoteThreshold),
+			VotedToSkip:            user != nil && !user.IsUnknown() && channel.skipManager.HasVotedToSkip(user.Address()),
+			ExtraCurrencyAddresses: extraCurrencyAddresses,
 		}
 	}
 
-	err := stream.Send(buildStatus())
+	err = stream.Send(buildStatus())
 	if err != nil {
 		return stacktrace.Propagate(err, "")
 	}
@@ -121,3 +142,66 @@ func (s *grpcServer) IncreaseOrReduceSkipThreshold(ctxCtx context.Context, r *pr
 
 	return &proto.IncreaseOrReduceSkipThresholdResponse{}, stacktrace.Propagate(ctx.Commit(), "")
 }
+
+func (s *grpcServer) VoteToSkip(ctxCtx context.Context, r *proto.VoteToSkipRequest) (*proto.VoteToSkipResponse, error) {
+	user := authinterceptor.UserFromContext(ctxCtx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	if !s.rewardsHandler.IsEligibleSpectator(ctxCtx, user.Address(), channel.id) {
+		return nil, status.Error(codes.PermissionDenied, "only eligible spectators of this channel may vote to skip")
+	}
+
+	currentEntry, playing := channel.mediaQueue.CurrentlyPlaying()
+	if !playing {
+		return nil, status.Error(codes.FailedPrecondition, "nothing is playing")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	cost := 50
+	subscribed, err := s.pointsManager.IsUserCurrentlySubscribed(ctx, user)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if subscribed {
+		cost = 45
+	}
+
+	err = channel.skipManager.VoteToSkip(user, currentEntry.PerformanceID(), func() error {
+		// the points are deducted and committed before the vote is registered, as the vote can't be rolled back
+		_, err := s.pointsManager.CreateTransaction(ctx, user, types.PointsTxTypeSkipVote, -cost, pointsmanager.TxExtraField{
+			Key:   "media",
+			Value: currentEntry.PerformanceID(),
+		})
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		return stacktrace.Propagate(ctx.Commit(), "")
+	})
+	if err != nil {
+		if errors.Is(err, types.ErrInsufficientPointsBalance) {
+			return nil, status.Error(codes.FailedPrecondition, "insufficient points balance")
+		}
+		if errors.Is(err, skipmanager.ErrSkipVotingNotAllowed) {
+			return nil, status.Error(codes.FailedPrecondition, "skip voting is not possible at this moment")
+		}
+		if errors.Is(err, skipmanager.ErrAlreadyVotedToSkip) {
+			return nil, status.Error(codes.FailedPrecondition, "already voted to skip the current queue entry")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.VoteToSkipResponse{}, nil
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hectorchu/gonano/util"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/tipmanager"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/event"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// resolveTipRecipient returns the recipient of a tip, which is either the user with the specified address or, when
+// currentEntryChannelID is not nil, the requester of the entry currently playing on that channel
+func (s *grpcServer) resolveTipRecipient(address string, currentEntryChannelID *string) (tipmanager.Recipient, error) {
+	if currentEntryChannelID == nil {
+		// we must check for ban since AddressToPubkey accepts nano too
+		if _, err := util.AddressToPubkey(address); err != nil || address[:4] != "ban_" {
+			return tipmanager.Recipient{}, status.Error(codes.InvalidArgument, "invalid address")
+		}
+		return tipmanager.Recipient{Address: address}, nil
+	}
+
+	channel, err := s.broadcastChannel(*currentEntryChannelID)
+	if err != nil {
+		return tipmanager.Recipient{}, stacktrace.Propagate(err, "")
+	}
+	entry, playing := channel.mediaQueue.CurrentlyPlaying()
+	if !playing || entry.RequestedBy() == nil || entry.RequestedBy().IsUnknown() {
+		return tipmanager.Recipient{}, status.Error(codes.FailedPrecondition, "the current queue entry has no requester that can be tipped")
+	}
+	return tipmanager.Recipient{
+		Address:      entry.RequestedBy().Address(),
+		QueueEntryID: entry.PerformanceID(),
+	}, nil
+}
+
+func (s *grpcServer) TipWithPoints(ctx context.Context, r *proto.TipWithPointsRequest) (*proto.TipWithPointsResponse, error) {
+	user := authinterceptor.UserFromContext(ctx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	var currentEntryChannelID *string
+	if c, ok := r.Recipient.(*proto.TipWithPointsRequest_CurrentEntryRequesterOfChannelId); ok {
+		currentEntryChannelID = &c.CurrentEntryRequesterOfChannelId
+	}
+	recipient, err := s.resolveTipRecipient(r.GetAddress(), currentEntryChannelID)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	tip, err := s.tipManager.TipWithPoints(ctx, user, recipient, int(r.Points), r.Announce)
+	if err != nil {
+		if errors.Is(err, types.ErrInsufficientPointsBalance) {
+			return nil, status.Error(codes.FailedPrecondition, "insufficient points balance")
+		}
+		if errors.Is(err, tipmanager.ErrInvalidRecipient) {
+			return nil, status.Error(codes.InvalidArgument, "invalid recipient")
+		}
+		if errors.Is(err, tipmanager.ErrTipAmountTooSmall) {
+			return nil, status.Errorf(codes.InvalidArgument, "tips must be of at least %d points", tipmanager.MinimumPointsTip)
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.TipWithPointsResponse{
+		Tip: s.convertTip(ctx, tip, s.userSerializer),
+	}, nil
+}
+
+func (s *grpcServer) TipWithBanano(r *proto.TipWithBananoRequest, stream proto.JungleTV_TipWithBananoServer) error {
+	ctx := stream.Context()
+	user := authinterceptor.UserFromContext(ctx)
+	if user == nil {
+		return stacktrace.NewError("user claims unexpectedly missing")
+	}
+
+	var currentEntryChannelID *string
+	if c, ok := r.Recipient.(*proto.TipWithBananoRequest_CurrentEntryRequesterOfChannelId); ok {
+		currentEntryChannelID = &c.CurrentEntryRequesterOfChannelId
+	}
+	recipient, err := s.resolveTipRecipient(r.GetAddress(), currentEntryChannelID)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	flow, err := s.tipManager.CreateOrRecoverBananoTipFlow(user, recipient, r.Announce)
+	if err != nil {
+		if errors.Is(err, tipmanager.ErrInvalidRecipient) {
+			return status.Error(codes.InvalidArgument, "invalid recipient")
+		}
+		return stacktrace.Propagate(err, "")
+	}
+
+	expired := time.Now().After(flow.Expiration())
+	send := func() error {
+		return stacktrace.Propagate(stream.Send(&proto.TipWithBananoStatus{
+			PaymentAddress: flow.PaymentAddress(),
+			Recipient:      s.userSerializer(ctx, auth.NewAddressOnlyUser(flow.RecipientAddress())),
+			BananoTipped:   flow.SessionTotal().SerializeForAPI(),
+			Expiration:     timestamppb.New(flow.Expiration()),
+			Expired:        expired,
+		}), "")
+	}
+
+	onTipped, onTippedU := flow.Tipped().Subscribe(event.BufferFirst)
+	defer onTippedU()
+
+	onExpired, onExpiredU := flow.Expired().Subscribe(event.BufferFirst)
+	defer onExpiredU()
+
+	onDestroyed, onDestroyedU := flow.Destroyed().Subscribe(event.BufferFirst)
+	defer onDestroyedU()
+
+	err = send()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	heartbeat := time.NewTicker(5 * time.Second)
+	defer heartbeat.Stop()
+
+	for {
+		var err error
+		select {
+		case <-onExpired:
+			expired = true
+			err = send()
+		case <-onTipped:
+			err = send()
+		case <-heartbeat.C:
+			err = send()
+		case <-onDestroyed:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+}
+
+func (s *grpcServer) convertTip(ctx context.Context, orig *types.Tip, userSerializer auth.APIUserSerializer) *proto.Tip {
+	tip := &proto.Tip{
+		Id:           orig.ID,
+		Sender:       userSerializer(ctx, auth.NewAddressOnlyUser(orig.FromAddress)),
+		Recipient:    userSerializer(ctx, auth.NewAddressOnlyUser(orig.ToAddress)),
+		QueueEntryId: orig.QueueEntryID,
+		CreatedAt:    timestamppb.New(orig.CreatedAt),
+	}
+	switch orig.Currency {
+	case types.TipCurrencyPoints:
+		tip.Currency = proto.TipCurrency_TIP_CURRENCY_POINTS
+		tip.Amount = orig.Amount.String()
+	case types.TipCurrencyBanano:
+		tip.Currency = proto.TipCurrency_TIP_CURRENCY_BANANO
+		tip.Amount = payment.NewAmountFromDecimal(orig.Amount).SerializeForAPI()
+	}
+	return tip
+}
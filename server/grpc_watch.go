@@ -6,6 +6,7 @@ import (
 
 	"github.com/palantir/stacktrace"
 	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/components/mediaqueue"
 	"github.com/tnyim/jungletv/server/components/notificationmanager"
 	"github.com/tnyim/jungletv/server/components/rewards"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
@@ -15,20 +16,25 @@ import (
 )
 
 func (s *grpcServer) ConsumeMedia(r *proto.ConsumeMediaRequest, stream proto.JungleTV_ConsumeMediaServer) error {
+	channel, err := s.broadcastChannel(r.ChannelId)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
 	cpChan := make(chan *proto.MediaConsumptionCheckpoint, 3)
 
 	user := authinterceptor.UserFromContext(stream.Context())
 
 	var initialActivityChallenge *rewards.ActivityChallenge
 	if user != nil && !user.IsUnknown() {
-		spectator, err := s.rewardsHandler.RegisterSpectator(stream.Context(), user)
+		spectator, err := s.rewardsHandler.RegisterSpectator(stream.Context(), user, channel.id)
 		if err != nil {
 			return stacktrace.Propagate(err, "")
 		}
 
 		initialActivityChallenge = spectator.CurrentActivityChallenge()
 		defer spectator.OnActivityChallenge().SubscribeUsingCallback(event.BufferFirst, func(challenge *rewards.ActivityChallenge) {
-			cp := s.produceMediaConsumptionCheckpoint(stream.Context(), false)
+			cp := s.produceMediaConsumptionCheckpoint(stream.Context(), channel, false)
 			cp.ActivityChallenge = challenge.SerializeForAPI()
 			cpChan <- cp
 		})()
@@ -37,13 +43,13 @@ func (s *grpcServer) ConsumeMedia(r *proto.ConsumeMediaRequest, stream proto.Jun
 	}
 
 	defer s.configManager.ClientConfigurationChanged().SubscribeUsingCallback(event.BufferAll, func(arg *proto.ConfigurationChange) {
-		cp := s.produceMediaConsumptionCheckpoint(stream.Context(), false)
+		cp := s.produceMediaConsumptionCheckpoint(stream.Context(), channel, false)
 		cp.ConfigurationChanges = []*proto.ConfigurationChange{arg}
 		cpChan <- cp
 	})()
 
 	defer s.notificationManager.SubscribeToEventsForUser(user, func(n notificationmanager.NotificationEvent) {
-		cp := s.produceMediaConsumptionCheckpoint(stream.Context(), false)
+		cp := s.produceMediaConsumptionCheckpoint(stream.Context(), channel, false)
 		for _, n := range n.NewNotifications {
 			cp.Notifications = append(cp.Notifications, serializeNotification(n))
 		}
@@ -56,8 +62,12 @@ func (s *grpcServer) ConsumeMedia(r *proto.ConsumeMediaRequest, stream proto.Jun
 	onVersionHashChanged, versionHashChangedU := s.versionInterceptor.VersionHashUpdated().Subscribe(event.BufferFirst)
 	defer versionHashChangedU()
 
-	defer s.mediaQueue.MediaChanged().SubscribeUsingCallback(event.BufferFirst, func(_ media.QueueEntry) {
-		cpChan <- s.produceMediaConsumptionCheckpoint(stream.Context(), true)
+	defer channel.mediaQueue.MediaChanged().SubscribeUsingCallback(event.BufferFirst, func(_ media.QueueEntry) {
+		cpChan <- s.produceMediaConsumptionCheckpoint(stream.Context(), channel, true)
+	})()
+
+	defer channel.mediaQueue.PauseStateChanged().SubscribeUsingCallback(event.BufferFirst, func(_ mediaqueue.PauseState) {
+		cpChan <- s.produceMediaConsumptionCheckpoint(stream.Context(), channel, false)
 	})()
 
 	statsCleanup, err := s.statsRegistry.RegisterSpectator(stream.Context())
@@ -66,7 +76,7 @@ func (s *grpcServer) ConsumeMedia(r *proto.ConsumeMediaRequest, stream proto.Jun
 	}
 	defer statsCleanup()
 
-	initialCp := s.produceMediaConsumptionCheckpoint(stream.Context(), true)
+	initialCp := s.produceMediaConsumptionCheckpoint(stream.Context(), channel, true)
 	initialCp.ConfigurationChanges = s.configManager.AllClientConfigurationChanges()
 	if initialActivityChallenge != nil {
 		initialCp.ActivityChallenge = initialActivityChallenge.SerializeForAPI()
@@ -83,7 +93,7 @@ func (s *grpcServer) ConsumeMedia(r *proto.ConsumeMediaRequest, stream proto.Jun
 	for {
 		select {
 		case <-t.C:
-			err := stream.Send(s.produceMediaConsumptionCheckpoint(stream.Context(), false))
+			err := stream.Send(s.produceMediaConsumptionCheckpoint(stream.Context(), channel, false))
 			if err != nil {
 				return stacktrace.Propagate(err, "")
 			}
@@ -100,8 +110,8 @@ func (s *grpcServer) ConsumeMedia(r *proto.ConsumeMediaRequest, stream proto.Jun
 	}
 }
 
-func (s *grpcServer) produceMediaConsumptionCheckpoint(ctx context.Context, needsTitle bool) *proto.MediaConsumptionCheckpoint {
-	cp := s.mediaQueue.ProduceCheckpointForAPI(ctx, s.userSerializer, needsTitle)
+func (s *grpcServer) produceMediaConsumptionCheckpoint(ctx context.Context, channel *broadcastChannel, needsTitle bool) *proto.MediaConsumptionCheckpoint {
+	cp := channel.mediaQueue.ProduceCheckpointForAPI(ctx, s.userSerializer, needsTitle)
 	cp.CurrentlyWatching = uint32(s.statsRegistry.CurrentlyWatching())
 	return cp
 }
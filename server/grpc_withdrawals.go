@@ -2,11 +2,13 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"github.com/palantir/stacktrace"
 	"github.com/tnyim/jungletv/buildconfig"
 	"github.com/tnyim/jungletv/proto"
 	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/withdrawalhandler"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
 	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils/transaction"
@@ -87,6 +89,112 @@ func (s *grpcServer) WithdrawalHistory(ctxCtx context.Context, r *proto.Withdraw
 	}, nil
 }
 
+func (s *grpcServer) WithdrawalPreferences(ctxCtx context.Context, r *proto.WithdrawalPreferencesRequest) (*proto.WithdrawalPreferencesResponse, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	userClaims := authinterceptor.UserFromContext(ctx)
+	if userClaims == nil {
+		return nil, stacktrace.NewError("user claims unexpectedly missing")
+	}
+
+	preference, _, err := types.GetWithdrawalPreferenceOfAddress(ctx, userClaims.Address())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.WithdrawalPreferencesResponse{
+		Preferences:      convertWithdrawalPreference(preference),
+		MinimumThreshold: payment.NewAmount(withdrawalhandler.MinimumAutoWithdrawalThreshold).SerializeForAPI(),
+	}, nil
+}
+
+func (s *grpcServer) SetWithdrawalPreferences(ctxCtx context.Context, r *proto.SetWithdrawalPreferencesRequest) (*proto.SetWithdrawalPreferencesResponse, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	userClaims := authinterceptor.UserFromContext(ctx)
+	if userClaims == nil {
+		return nil, stacktrace.NewError("user claims unexpectedly missing")
+	}
+
+	preference := &types.WithdrawalPreference{
+		RewardsAddress: userClaims.Address(),
+		UpdatedAt:      time.Now(),
+	}
+	if r.Preferences != nil && r.Preferences.Threshold != nil {
+		threshold, err := payment.NewAmountFromAPIString(*r.Preferences.Threshold)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid threshold")
+		}
+		if threshold.Cmp(withdrawalhandler.MinimumAutoWithdrawalThreshold) < 0 {
+			return nil, status.Error(codes.InvalidArgument, "threshold is lower than the minimum")
+		}
+		t := threshold.Decimal()
+		preference.Threshold = &t
+	}
+	if r.Preferences != nil && r.Preferences.Cadence != nil {
+		var cadence types.WithdrawalCadence
+		switch *r.Preferences.Cadence {
+		case proto.WithdrawalCadence_WITHDRAWAL_CADENCE_DAILY:
+			cadence = types.WithdrawalCadenceDaily
+		case proto.WithdrawalCadence_WITHDRAWAL_CADENCE_WEEKLY:
+			cadence = types.WithdrawalCadenceWeekly
+		case proto.WithdrawalCadence_WITHDRAWAL_CADENCE_MONTHLY:
+			cadence = types.WithdrawalCadenceMonthly
+		default:
+			return nil, status.Error(codes.InvalidArgument, "invalid cadence")
+		}
+		preference.Cadence = &cadence
+	}
+
+	if preference.Threshold == nil && preference.Cadence == nil {
+		// back to the default rules
+		err = preference.Delete(ctx)
+	} else {
+		err = preference.Update(ctx)
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &proto.SetWithdrawalPreferencesResponse{}, nil
+}
+
+func convertWithdrawalPreference(orig *types.WithdrawalPreference) *proto.WithdrawalPreferences {
+	preferences := &proto.WithdrawalPreferences{}
+	if orig == nil {
+		return preferences
+	}
+	if orig.Threshold != nil {
+		threshold := payment.NewAmountFromDecimal(*orig.Threshold).SerializeForAPI()
+		preferences.Threshold = &threshold
+	}
+	if orig.Cadence != nil {
+		var cadence proto.WithdrawalCadence
+		switch *orig.Cadence {
+		case types.WithdrawalCadenceDaily:
+			cadence = proto.WithdrawalCadence_WITHDRAWAL_CADENCE_DAILY
+		case types.WithdrawalCadenceWeekly:
+			cadence = proto.WithdrawalCadence_WITHDRAWAL_CADENCE_WEEKLY
+		case types.WithdrawalCadenceMonthly:
+			cadence = proto.WithdrawalCadence_WITHDRAWAL_CADENCE_MONTHLY
+		}
+		preferences.Cadence = &cadence
+	}
+	return preferences
+}
+
 func convertWithdrawals(orig []*types.Withdrawal) []*proto.Withdrawal {
 	protoEntries := make([]*proto.Withdrawal, len(orig))
 	for i, entry := range orig {
@@ -90,35 +90,39 @@ type queueEntryApplicationPageJsonRepresentation struct {
 	PageID             string
 	MediaID            string
 
-	QueueID     string
-	Type        string
-	Title       string
-	Duration    time.Duration
-	RequestedBy string
-	RequestCost *big.Int
-	RequestedAt time.Time
-	Unskippable bool
-	Concealed   bool
-	MovedBy     []string
+	QueueID               string
+	Type                  string
+	Title                 string
+	Duration              time.Duration
+	RequestedBy           string
+	RequestCost           *big.Int
+	RequestCostCurrency   string
+	RequestCostInCurrency *big.Int
+	RequestedAt           time.Time
+	Unskippable           bool
+	Concealed             bool
+	MovedBy               []string
 }
 
 func (e *queueEntryApplicationPage) MarshalJSON() ([]byte, error) {
 	_, mediaID := e.MediaID()
 	j, err := sonic.Marshal(queueEntryApplicationPageJsonRepresentation{
-		QueueID:            e.PerformanceID(),
-		Type:               string(types.MediaTypeApplicationPage),
-		ApplicationID:      e.applicationID,
-		ApplicationVersion: e.applicationVersion,
-		PageID:             e.pageID,
-		MediaID:            mediaID,
-		Title:              e.Title(),
-		Duration:           e.Length(),
-		RequestedBy:        e.RequestedBy().Address(),
-		RequestCost:        e.RequestCost().Int,
-		RequestedAt:        e.RequestedAt(),
-		Unskippable:        e.Unskippable(),
-		Concealed:          e.Concealed(),
-		MovedBy:            e.MovedBy(),
+		QueueID:               e.PerformanceID(),
+		Type:                  string(types.MediaTypeApplicationPage),
+		ApplicationID:         e.applicationID,
+		ApplicationVersion:    e.applicationVersion,
+		PageID:                e.pageID,
+		MediaID:               mediaID,
+		Title:                 e.Title(),
+		Duration:              e.Length(),
+		RequestedBy:           e.RequestedBy().Address(),
+		RequestCost:           e.RequestCost().Int,
+		RequestCostCurrency:   string(e.RequestCostCurrency()),
+		RequestCostInCurrency: e.RequestCostInCurrency().Int,
+		RequestedAt:           e.RequestedAt(),
+		Unskippable:           e.Unskippable(),
+		Concealed:             e.Concealed(),
+		MovedBy:               e.MovedBy(),
 	})
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "error serializing queue entry %s", e.PerformanceID())
@@ -0,0 +1,3 @@
+package directmedia
+
+var MediaID = mediaID
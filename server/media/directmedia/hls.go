@@ -0,0 +1,126 @@
+package directmedia
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/media"
+)
+
+type hlsPlaylist struct {
+	variants []string
+	duration time.Duration
+	ended    bool
+	title    string
+}
+
+func (c *MediaProvider) probeHLS(ctx context.Context, playlistURL *url.URL) (*probeResult, media.EnqueueRequestCreationResult, error) {
+	data, _, result, err := c.fetchRange(ctx, playlistURL, 0, maxPlaylistLength)
+	if err != nil || result != media.EnqueueRequestCreationSucceeded {
+		return nil, result, stacktrace.Propagate(err, "")
+	}
+
+	playlist := parseHLSPlaylist(data)
+	title := playlist.title
+
+	if len(playlist.variants) > 0 {
+		// this is a multivariant playlist. all variants should have the same duration, so just look at the first one
+		variantURL, err := playlistURL.Parse(playlist.variants[0])
+		if err != nil {
+			return nil, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+		}
+		data, _, result, err = c.fetchRange(ctx, variantURL, 0, maxPlaylistLength)
+		if err != nil || result != media.EnqueueRequestCreationSucceeded {
+			return nil, result, stacktrace.Propagate(err, "")
+		}
+		playlist = parseHLSPlaylist(data)
+		if playlist.title != "" {
+			title = playlist.title
+		}
+		if len(playlist.variants) > 0 {
+			return nil, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+		}
+	}
+
+	if !playlist.ended {
+		// live playlists have no known duration
+		return nil, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+	}
+
+	return &probeResult{
+		format:   mediaFormatHLS,
+		duration: playlist.duration,
+		title:    title,
+	}, media.EnqueueRequestCreationSucceeded, nil
+}
+
+func parseHLSPlaylist(data []byte) hlsPlaylist {
+	playlist := hlsPlaylist{}
+	expectVariantURI := false
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "#EXTINF:"):
+			value, _, _ := strings.Cut(strings.TrimPrefix(line, "#EXTINF:"), ",")
+			seconds, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err == nil && seconds > 0 {
+				playlist.duration += time.Duration(seconds * float64(time.Second))
+			}
+		case line == "#EXT-X-ENDLIST":
+			playlist.ended = true
+		case strings.HasPrefix(line, "#EXT-X-PLAYLIST-TYPE:"):
+			if strings.TrimPrefix(line, "#EXT-X-PLAYLIST-TYPE:") == "VOD" {
+				playlist.ended = true
+			}
+		case strings.HasPrefix(line, "#EXT-X-STREAM-INF:"):
+			expectVariantURI = true
+		case strings.HasPrefix(line, "#EXT-X-SESSION-DATA:"):
+			attributes := parseHLSAttributeList(strings.TrimPrefix(line, "#EXT-X-SESSION-DATA:"))
+			if attributes["DATA-ID"] == "com.apple.hls.title" && playlist.title == "" {
+				playlist.title = attributes["VALUE"]
+			}
+		case strings.HasPrefix(line, "#"):
+			continue
+		default:
+			if expectVariantURI {
+				playlist.variants = append(playlist.variants, line)
+				expectVariantURI = false
+			}
+		}
+	}
+	return playlist
+}
+
+func parseHLSAttributeList(list string) map[string]string {
+	attributes := make(map[string]string)
+	for len(list) > 0 {
+		name, rest, found := strings.Cut(list, "=")
+		if !found {
+			break
+		}
+		var value string
+		if strings.HasPrefix(rest, "\"") {
+			end := strings.Index(rest[1:], "\"")
+			if end < 0 {
+				break
+			}
+			value = rest[1 : end+1]
+			rest = rest[end+2:]
+			_, rest, _ = strings.Cut(rest, ",")
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		attributes[strings.TrimSpace(name)] = value
+		list = rest
+	}
+	return attributes
+}
@@ -0,0 +1,87 @@
+package directmedia
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/palantir/stacktrace"
+)
+
+// errNonPublicAddress is returned when a media URL, or one of its redirects, resolves to an address that is not
+// reachable from the public internet, e.g. loopback, private network or cloud metadata addresses
+var errNonPublicAddress = errors.New("refusing to connect to non-public address")
+
+const maxRedirects = 10
+
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"), // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64, may map to any IPv4 address
+	netip.MustParsePrefix("64:ff9b:1::/48"), // local-use NAT64
+	netip.MustParsePrefix("2001:db8::/32"),
+}
+
+func isPublicAddress(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsValid() || ip.IsUnspecified() || ip.IsLoopback() || ip.IsPrivate() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// newPublicOnlyHTTPClient returns an HTTP client that refuses to connect to non-public addresses.
+// The check happens after DNS resolution, on every connection, so it also covers redirects and DNS rebinding
+func newPublicOnlyHTTPClient() http.Client {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return stacktrace.Propagate(err, "")
+			}
+			if !isPublicAddress(addrPort.Addr()) {
+				return stacktrace.Propagate(errNonPublicAddress, "%s", address)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// with a proxy, the dialer would check the address of the proxy instead of the address of the media server
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return http.Client{
+		Timeout:   time.Second * 10,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return stacktrace.NewError("stopped after %d redirects", maxRedirects)
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return stacktrace.NewError("refusing to follow redirect to scheme %s", req.URL.Scheme)
+			}
+			// the dialer checks the resolved address of every hop; this catches IP literals before any connection is made
+			if ip, err := netip.ParseAddr(req.URL.Hostname()); err == nil && !isPublicAddress(ip) {
+				return stacktrace.Propagate(errNonPublicAddress, "%s", req.URL.Host)
+			}
+			return nil
+		},
+	}
+}
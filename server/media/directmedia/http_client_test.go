@@ -0,0 +1,51 @@
+package directmedia_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gbl08ma/sqalx"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/server/media/directmedia"
+
+	"github.com/stretchr/testify/require"
+)
+
+// probeContext satisfies transaction.WrappingContext; probing media never touches the database
+type probeContext struct {
+	context.Context
+	sqalx.Node
+}
+
+func (c probeContext) WithoutTx() context.Context {
+	return c.Context
+}
+
+func TestProviderRefusesNonPublicAddresses(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Error(w, "not found", http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	provider := directmedia.NewProvider()
+	for _, mediaURL := range []string{
+		server.URL + "/video.mp4",
+		"http://169.254.169.254/latest/meta-data/",
+		"http://[::1]/video.mp4",
+		"http://10.0.0.1/video.mp4",
+	} {
+		_, result, err := provider.BeginEnqueueRequest(probeContext{Context: context.Background()}, &proto.EnqueueMediaRequest_DirectMediaData{
+			DirectMediaData: &proto.EnqueueDirectMediaData{
+				Url: mediaURL,
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, media.EnqueueRequestCreationFailedMediumNotFound, result, mediaURL)
+	}
+	require.Zero(t, requests)
+}
@@ -0,0 +1,199 @@
+package directmedia
+
+import (
+	"context"
+	"encoding/binary"
+	"net/url"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/media"
+)
+
+const maxTopLevelBoxes = 64
+
+// probeMP4 walks the top-level boxes of an ISO base media file until it finds the movie box, which may be at the
+// start (for "fast start" files) or at the end of the file
+func (c *MediaProvider) probeMP4(ctx context.Context, mediaURL *url.URL, head []byte, totalLength int64) (*probeResult, media.EnqueueRequestCreationResult, error) {
+	offset := int64(0)
+	for i := 0; i < maxTopLevelBoxes; i++ {
+		if totalLength >= 0 && offset+8 > totalLength {
+			break
+		}
+
+		var header []byte
+		if offset+16 <= int64(len(head)) || int64(len(head)) == totalLength {
+			header = head[offset:min(offset+16, int64(len(head)))]
+		} else {
+			var result media.EnqueueRequestCreationResult
+			var err error
+			header, _, result, err = c.fetchRange(ctx, mediaURL, offset, 16)
+			if err != nil || result != media.EnqueueRequestCreationSucceeded {
+				return nil, result, stacktrace.Propagate(err, "")
+			}
+		}
+		if len(header) < 8 {
+			break
+		}
+
+		boxSize := int64(binary.BigEndian.Uint32(header[0:4]))
+		boxType := string(header[4:8])
+		headerSize := int64(8)
+		switch boxSize {
+		case 0:
+			// box extends to the end of the file
+			if totalLength < 0 {
+				boxSize = maxMovieBoxLength
+			} else {
+				boxSize = totalLength - offset
+			}
+		case 1:
+			if len(header) < 16 {
+				return nil, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+			}
+			boxSize = int64(binary.BigEndian.Uint64(header[8:16]))
+			headerSize = 16
+		}
+		if boxSize < headerSize {
+			return nil, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+		}
+		// reject boxes that claim to extend past the end of the file, or whose size would overflow the offset
+		if (totalLength >= 0 && boxSize > totalLength-offset) || offset+boxSize < offset {
+			return nil, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+		}
+
+		if boxType == "moov" {
+			if boxSize > maxMovieBoxLength {
+				return nil, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+			}
+			var box []byte
+			if offset+boxSize <= int64(len(head)) {
+				box = head[offset : offset+boxSize]
+			} else if int64(len(head)) == totalLength {
+				// the box claims to extend past the end of the file
+				return nil, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+			} else {
+				var result media.EnqueueRequestCreationResult
+				var err error
+				box, _, result, err = c.fetchRange(ctx, mediaURL, offset, boxSize)
+				if err != nil || result != media.EnqueueRequestCreationSucceeded {
+					return nil, result, stacktrace.Propagate(err, "")
+				}
+			}
+			if int64(len(box)) < headerSize {
+				return nil, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+			}
+			duration, title := parseMP4MovieBox(box[headerSize:])
+			return &probeResult{
+				format:   mediaFormatMP4,
+				duration: duration,
+				title:    title,
+			}, media.EnqueueRequestCreationSucceeded, nil
+		}
+
+		offset += boxSize
+	}
+	return nil, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+}
+
+// parseMP4MovieBox extracts the duration and title from the contents of a moov box
+func parseMP4MovieBox(moov []byte) (time.Duration, string) {
+	var duration time.Duration
+	var title string
+	iterateMP4Boxes(moov, func(boxType string, payload []byte) {
+		switch boxType {
+		case "mvhd":
+			duration = parseMP4MovieHeader(payload)
+		case "udta":
+			title = parseMP4UserData(payload)
+		}
+	})
+	return duration, title
+}
+
+func parseMP4MovieHeader(mvhd []byte) time.Duration {
+	if len(mvhd) < 1 {
+		return 0
+	}
+	var timescale, duration uint64
+	switch mvhd[0] {
+	case 0:
+		if len(mvhd) < 20 {
+			return 0
+		}
+		timescale = uint64(binary.BigEndian.Uint32(mvhd[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(mvhd[16:20]))
+		if duration == 0xffffffff {
+			return 0
+		}
+	case 1:
+		if len(mvhd) < 32 {
+			return 0
+		}
+		timescale = uint64(binary.BigEndian.Uint32(mvhd[20:24]))
+		duration = binary.BigEndian.Uint64(mvhd[24:32])
+		if duration == 0xffffffffffffffff {
+			return 0
+		}
+	default:
+		return 0
+	}
+	if timescale == 0 {
+		return 0
+	}
+	return time.Duration(float64(duration) / float64(timescale) * float64(time.Second))
+}
+
+// parseMP4UserData looks for the iTunes-style title (udta/meta/ilst/©nam/data) in the contents of a udta box
+func parseMP4UserData(udta []byte) string {
+	var title string
+	iterateMP4Boxes(udta, func(boxType string, payload []byte) {
+		if boxType != "meta" {
+			return
+		}
+		// meta is a full box in the ISO spec, but QuickTime files omit the version and flags
+		if len(payload) >= 8 && string(payload[4:8]) != "hdlr" {
+			payload = payload[4:]
+		}
+		iterateMP4Boxes(payload, func(boxType string, payload []byte) {
+			if boxType != "ilst" {
+				return
+			}
+			iterateMP4Boxes(payload, func(boxType string, payload []byte) {
+				if boxType != "\xa9nam" {
+					return
+				}
+				iterateMP4Boxes(payload, func(boxType string, payload []byte) {
+					// data box payload: 4 bytes of type indicator, 4 bytes of locale, then the value
+					if boxType == "data" && len(payload) > 8 && title == "" {
+						title = string(payload[8:])
+					}
+				})
+			})
+		})
+	})
+	return title
+}
+
+func iterateMP4Boxes(data []byte, f func(boxType string, payload []byte)) {
+	for len(data) >= 8 {
+		boxSize := uint64(binary.BigEndian.Uint32(data[0:4]))
+		boxType := string(data[4:8])
+		headerSize := uint64(8)
+		switch boxSize {
+		case 0:
+			boxSize = uint64(len(data))
+		case 1:
+			if len(data) < 16 {
+				return
+			}
+			boxSize = binary.BigEndian.Uint64(data[8:16])
+			headerSize = 16
+		}
+		if boxSize < headerSize || boxSize > uint64(len(data)) {
+			return
+		}
+		f(boxType, data[headerSize:boxSize])
+		data = data[boxSize:]
+	}
+}
@@ -0,0 +1,93 @@
+package directmedia_test
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/tnyim/jungletv/server/media/directmedia"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mp4Box(boxType string, payload ...[]byte) []byte {
+	size := 8
+	for _, p := range payload {
+		size += len(p)
+	}
+	box := binary.BigEndian.AppendUint32(nil, uint32(size))
+	box = append(box, boxType...)
+	for _, p := range payload {
+		box = append(box, p...)
+	}
+	return box
+}
+
+func mp4MovieBox(timescale, duration uint32) []byte {
+	mvhd := make([]byte, 20)
+	binary.BigEndian.PutUint32(mvhd[12:16], timescale)
+	binary.BigEndian.PutUint32(mvhd[16:20], duration)
+	return mp4Box("moov", mp4Box("mvhd", mvhd))
+}
+
+func concat(parts ...[]byte) []byte {
+	result := []byte{}
+	for _, p := range parts {
+		result = append(result, p...)
+	}
+	return result
+}
+
+func TestProbeMP4(t *testing.T) {
+	ftyp := mp4Box("ftyp", []byte("isom\x00\x00\x02\x00"))
+	moov := mp4MovieBox(1000, 5000)
+
+	info, ok := directmedia.ProbeFile(concat(ftyp, moov, mp4Box("mdat", make([]byte, 64))))
+	require.True(t, ok)
+	require.Equal(t, "mp4", info.Format)
+	require.Equal(t, 5*time.Second, info.Duration)
+
+	// movie box at the end of the file
+	info, ok = directmedia.ProbeFile(concat(ftyp, mp4Box("mdat", make([]byte, 64)), moov))
+	require.True(t, ok)
+	require.Equal(t, 5*time.Second, info.Duration)
+}
+
+func TestProbeMP4MalformedBoxes(t *testing.T) {
+	ftyp := mp4Box("ftyp", []byte("isom\x00\x00\x02\x00"))
+	moov := mp4MovieBox(1000, 5000)
+
+	// 64-bit box size large enough to overflow the offset
+	largeBox := binary.BigEndian.AppendUint32(nil, 1)
+	largeBox = append(largeBox, "free"...)
+	largeBox = binary.BigEndian.AppendUint64(largeBox, 1<<63-8)
+	_, ok := directmedia.ProbeFile(concat(ftyp, largeBox, moov))
+	require.False(t, ok)
+
+	// 64-bit box size that does not fit in a signed integer
+	largeBox = binary.BigEndian.AppendUint32(nil, 1)
+	largeBox = append(largeBox, "free"...)
+	largeBox = binary.BigEndian.AppendUint64(largeBox, 1<<64-1)
+	_, ok = directmedia.ProbeFile(concat(ftyp, largeBox, moov))
+	require.False(t, ok)
+
+	// box claiming to extend past the end of the file
+	oversized := mp4Box("free", make([]byte, 16))
+	binary.BigEndian.PutUint32(oversized[0:4], 1024)
+	_, ok = directmedia.ProbeFile(concat(ftyp, oversized, moov))
+	require.False(t, ok)
+
+	// box smaller than its own header
+	undersized := mp4Box("free")
+	binary.BigEndian.PutUint32(undersized[0:4], 4)
+	_, ok = directmedia.ProbeFile(concat(ftyp, undersized, moov))
+	require.False(t, ok)
+
+	// truncated movie box
+	_, ok = directmedia.ProbeFile(concat(ftyp, moov[:len(moov)-4]))
+	require.False(t, ok)
+
+	// truncated box header
+	_, ok = directmedia.ProbeFile(concat(ftyp, moov[:6]))
+	require.False(t, ok)
+}
@@ -0,0 +1,203 @@
+package directmedia
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/media"
+)
+
+type mediaFormat string
+
+const (
+	mediaFormatMP4  mediaFormat = "mp4"
+	mediaFormatWebM mediaFormat = "webm"
+	mediaFormatHLS  mediaFormat = "hls"
+)
+
+func (f mediaFormat) toProto() proto.DirectMediaFormat {
+	switch f {
+	case mediaFormatMP4:
+		return proto.DirectMediaFormat_DIRECT_MEDIA_FORMAT_MP4
+	case mediaFormatWebM:
+		return proto.DirectMediaFormat_DIRECT_MEDIA_FORMAT_WEBM
+	case mediaFormatHLS:
+		return proto.DirectMediaFormat_DIRECT_MEDIA_FORMAT_HLS
+	default:
+		return proto.DirectMediaFormat_DIRECT_MEDIA_FORMAT_UNKNOWN
+	}
+}
+
+type probeResult struct {
+	format   mediaFormat
+	duration time.Duration
+	title    string
+}
+
+const sniffLength = 256 * 1024
+const maxPlaylistLength = 2 * 1024 * 1024
+const maxMovieBoxLength = 32 * 1024 * 1024
+const maxTitleLength = 100
+
+// probe fetches the start of the resource at mediaURL, determines its format and extracts its duration and title
+// from the container metadata or playlist
+func (c *MediaProvider) probe(ctx context.Context, mediaURL *url.URL) (*probeResult, media.EnqueueRequestCreationResult, error) {
+	head, totalLength, result, err := c.fetchRange(ctx, mediaURL, 0, sniffLength)
+	if err != nil || result != media.EnqueueRequestCreationSucceeded {
+		return nil, result, stacktrace.Propagate(err, "")
+	}
+
+	var r *probeResult
+	switch {
+	case isHLSPlaylist(head):
+		r, result, err = c.probeHLS(ctx, mediaURL)
+	case isEBML(head):
+		r, result, err = probeWebM(head)
+	case isMP4(head):
+		r, result, err = c.probeMP4(ctx, mediaURL, head, totalLength)
+	default:
+		return nil, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+	}
+	if err != nil || result != media.EnqueueRequestCreationSucceeded {
+		return nil, result, stacktrace.Propagate(err, "")
+	}
+
+	if r.duration <= 0 {
+		// without knowing the duration we can't price nor schedule the media
+		return nil, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+	}
+
+	r.title = strings.TrimSpace(r.title)
+	if r.title == "" || !utf8.ValidString(r.title) {
+		r.title = titleFromURL(mediaURL)
+	}
+	if utf8.RuneCountInString(r.title) > maxTitleLength {
+		r.title = string([]rune(r.title)[:maxTitleLength-1]) + "…"
+	}
+
+	return r, media.EnqueueRequestCreationSucceeded, nil
+}
+
+// FileInfo contains the information obtained by probing a complete media file
+type FileInfo struct {
+	Format   string // mp4 or webm
+	Duration time.Duration
+	Title    string // as found in the container metadata, may be empty
+}
+
+// ProbeFile determines the format of a complete MP4 or WebM file held in memory, and extracts its duration and title
+// from the container metadata. Returns false if the file is not in a supported format or has no known duration
+func ProbeFile(content []byte) (FileInfo, bool) {
+	var r *probeResult
+	var result media.EnqueueRequestCreationResult
+	var err error
+	switch {
+	case isEBML(content):
+		r, result, err = probeWebM(content)
+	case isMP4(content):
+		// the whole file is available, so the provider will never need to fetch further ranges
+		r, result, err = (&MediaProvider{}).probeMP4(context.Background(), nil, content, int64(len(content)))
+	default:
+		return FileInfo{}, false
+	}
+	if err != nil || result != media.EnqueueRequestCreationSucceeded || r.duration <= 0 {
+		return FileInfo{}, false
+	}
+
+	title := strings.TrimSpace(r.title)
+	if !utf8.ValidString(title) {
+		title = ""
+	}
+	return FileInfo{
+		Format:   string(r.format),
+		Duration: r.duration,
+		Title:    title,
+	}, true
+}
+
+// FormatToProto converts a format, as returned in FileInfo, into its API representation
+func FormatToProto(format string) proto.DirectMediaFormat {
+	return mediaFormat(format).toProto()
+}
+
+// fetchRange retrieves up to length bytes of the resource at mediaURL, starting at offset.
+// It also returns the total length of the resource, or -1 if unknown
+func (c *MediaProvider) fetchRange(ctx context.Context, mediaURL *url.URL, offset, length int64) ([]byte, int64, media.EnqueueRequestCreationResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, mediaURL.String(), nil)
+	if err != nil {
+		return nil, 0, media.EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+
+	response, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, media.EnqueueRequestCreationFailedMediumNotFound, nil
+	}
+	defer response.Body.Close()
+
+	totalLength := int64(-1)
+	switch response.StatusCode {
+	case http.StatusPartialContent:
+		contentRange := response.Header.Get("Content-Range")
+		if idx := strings.LastIndex(contentRange, "/"); idx >= 0 {
+			if l, err := strconv.ParseInt(contentRange[idx+1:], 10, 64); err == nil {
+				totalLength = l
+			}
+		}
+	case http.StatusOK:
+		if offset > 0 {
+			// server does not support range requests and we'd need to download the whole file
+			return nil, 0, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+		}
+		totalLength = response.ContentLength
+	case http.StatusRequestedRangeNotSatisfiable:
+		return nil, 0, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+	case http.StatusNotFound, http.StatusGone:
+		return nil, 0, media.EnqueueRequestCreationFailedMediumNotFound, nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, 0, media.EnqueueRequestCreationFailedMediumIsNotEmbeddable, nil
+	default:
+		return nil, 0, media.EnqueueRequestCreationFailed, stacktrace.NewError("unexpected status code %d when fetching media", response.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(response.Body, length))
+	if err != nil {
+		return nil, 0, media.EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+	return data, totalLength, media.EnqueueRequestCreationSucceeded, nil
+}
+
+func titleFromURL(mediaURL *url.URL) string {
+	base := path.Base(mediaURL.Path)
+	if base == "/" || base == "." {
+		return mediaURL.Hostname()
+	}
+	if ext := path.Ext(base); ext != base {
+		base = strings.TrimSuffix(base, ext)
+	}
+	return base
+}
+
+func isHLSPlaylist(head []byte) bool {
+	head = bytes.TrimPrefix(head, []byte("\xef\xbb\xbf"))
+	return bytes.HasPrefix(bytes.TrimLeft(head, " \t\r\n"), []byte("#EXTM3U"))
+}
+
+func isEBML(head []byte) bool {
+	return bytes.HasPrefix(head, []byte{0x1a, 0x45, 0xdf, 0xa3})
+}
+
+func isMP4(head []byte) bool {
+	return len(head) >= 8 && string(head[4:8]) == "ftyp"
+}
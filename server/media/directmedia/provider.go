@@ -0,0 +1,209 @@
+package directmedia
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// MediaProvider provides media files and HLS playlists hosted on arbitrary web servers
+type MediaProvider struct {
+	mediaQueue media.MediaQueueStub
+
+	httpClient http.Client
+}
+
+// NewProvider returns a new direct media provider
+func NewProvider() media.Provider {
+	return &MediaProvider{
+		httpClient: newPublicOnlyHTTPClient(),
+	}
+}
+
+func (c *MediaProvider) SetMediaQueue(mediaQueue media.MediaQueueStub) {
+	c.mediaQueue = mediaQueue
+}
+
+func (c *MediaProvider) CanHandleRequestType(mediaParameters proto.IsEnqueueMediaRequest_MediaInfo) bool {
+	_, ok := mediaParameters.(*proto.EnqueueMediaRequest_DirectMediaData)
+	return ok
+}
+
+type initialInfo struct {
+	id         string
+	url        *url.URL
+	probe      *probeResult
+	parameters *proto.EnqueueMediaRequest_DirectMediaData
+}
+
+func (i *initialInfo) MediaID() (types.MediaType, string) {
+	return types.MediaTypeDirectMedia, i.id
+}
+
+func (i *initialInfo) Title() string {
+	return i.probe.title
+}
+
+func (i *initialInfo) Collections() []media.CollectionKey {
+	return []media.CollectionKey{
+		{
+			ID:    hostID(i.url.Hostname()),
+			Title: i.url.Hostname(),
+			Type:  types.MediaCollectionTypeDirectMediaHost,
+		},
+	}
+}
+
+func (c *MediaProvider) BeginEnqueueRequest(ctx transaction.WrappingContext, mediaParameters proto.IsEnqueueMediaRequest_MediaInfo) (media.InitialInfo, media.EnqueueRequestCreationResult, error) {
+	directParameters, ok := mediaParameters.(*proto.EnqueueMediaRequest_DirectMediaData)
+	if !ok {
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.NewError("invalid parameter type for direct media provider")
+	}
+
+	mediaURL, err := url.Parse(directParameters.DirectMediaData.GetUrl())
+	if err != nil || (mediaURL.Scheme != "http" && mediaURL.Scheme != "https") || mediaURL.Host == "" {
+		return nil, media.EnqueueRequestCreationFailedMediumNotFound, nil
+	}
+	mediaURL.Fragment = ""
+
+	probe, result, err := c.probe(ctx, mediaURL)
+	if err != nil || result != media.EnqueueRequestCreationSucceeded {
+		return nil, result, stacktrace.Propagate(err, "")
+	}
+
+	return &initialInfo{
+		id:         mediaID(mediaURL),
+		url:        mediaURL,
+		probe:      probe,
+		parameters: directParameters,
+	}, media.EnqueueRequestCreationSucceeded, nil
+}
+
+func (c *MediaProvider) ContinueEnqueueRequest(ctx transaction.WrappingContext, genericInfo media.InitialInfo, unskippable, concealed, anonymous,
+	allowUnpopular, skipLengthChecks, skipDuplicationChecks bool) (media.EnqueueRequest, media.EnqueueRequestCreationResult, error) {
+	ctx, err := transaction.Begin(ctx)
+	if err != nil {
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	preInfo, ok := genericInfo.(*initialInfo)
+	if !ok {
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.NewError("unexpected type")
+	}
+
+	var startOffsetDuration time.Duration
+	if preInfo.parameters.DirectMediaData.StartOffset != nil {
+		startOffsetDuration = preInfo.parameters.DirectMediaData.StartOffset.AsDuration()
+	}
+	var endOffsetDuration time.Duration
+	if preInfo.parameters.DirectMediaData.EndOffset != nil {
+		endOffsetDuration = preInfo.parameters.DirectMediaData.EndOffset.AsDuration()
+		if endOffsetDuration <= startOffsetDuration {
+			return nil, media.EnqueueRequestCreationFailed, stacktrace.NewError("media start offset past media end offset")
+		}
+	}
+
+	mediaDuration := preInfo.probe.duration
+
+	if startOffsetDuration > mediaDuration {
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.NewError("media start offset past end of media")
+	}
+
+	if endOffsetDuration == 0 || endOffsetDuration > mediaDuration {
+		endOffsetDuration = mediaDuration
+	}
+
+	playFor := endOffsetDuration - startOffsetDuration
+
+	if playFor > 35*time.Minute && !skipLengthChecks {
+		return nil, media.EnqueueRequestCreationFailedMediumIsTooLong, nil
+	}
+
+	userClaims := authinterceptor.UserFromContext(ctx)
+
+	if !skipDuplicationChecks {
+		result, err := media.CheckReplayRules(ctx, c.mediaQueue, media.ReplayCandidate{
+			MediaType:   types.MediaTypeDirectMedia,
+			MediaID:     preInfo.id,
+			Collections: preInfo.Collections(),
+			Requester:   userClaims,
+			Offset:      startOffsetDuration,
+			Length:      playFor,
+			TotalLength: mediaDuration,
+		})
+		if err != nil || result != media.EnqueueRequestCreationSucceeded {
+			return nil, result, stacktrace.Propagate(err, "")
+		}
+	}
+
+	request := &queueEntryDirectMedia{
+		id:     preInfo.id,
+		url:    preInfo.url.String(),
+		format: preInfo.probe.format,
+	}
+	request.InitializeBase(request, request)
+	request.SetTitle(preInfo.probe.title)
+	request.SetLength(playFor)
+	request.SetOffset(startOffsetDuration)
+	request.SetUnskippable(unskippable)
+	request.SetConcealed(concealed)
+
+	if userClaims != nil && !anonymous {
+		request.SetRequestedBy(userClaims)
+	}
+
+	return request, media.EnqueueRequestCreationSucceeded, nil
+}
+
+// mediaID derives a media ID from the media URL, as URLs are frequently longer than what fits in the media ID columns.
+// The URL is normalized first, so that equivalent URLs result in the same media ID
+func mediaID(mediaURL *url.URL) string {
+	h := sha256.Sum256([]byte(normalizeURL(mediaURL).String()))
+	return hex.EncodeToString(h[:16])
+}
+
+// normalizeURL lowercases the scheme and host, drops default ports and the fragment, and sorts the query parameters.
+// The order of repeated query parameters is kept, as servers may depend on it
+func normalizeURL(u *url.URL) *url.URL {
+	n := *u
+	n.Scheme = strings.ToLower(n.Scheme)
+	host, port := strings.ToLower(n.Hostname()), n.Port()
+	if (n.Scheme == "http" && port == "80") || (n.Scheme == "https" && port == "443") {
+		port = ""
+	}
+	if port != "" {
+		n.Host = net.JoinHostPort(host, port)
+	} else if strings.Contains(host, ":") {
+		n.Host = "[" + host + "]"
+	} else {
+		n.Host = host
+	}
+	if n.Path == "" {
+		n.Path = "/"
+		n.RawPath = ""
+	}
+	if query, err := url.ParseQuery(n.RawQuery); err == nil {
+		n.RawQuery = query.Encode()
+	}
+	n.ForceQuery = false
+	n.Fragment = ""
+	n.RawFragment = ""
+	return &n
+}
+
+func hostID(host string) string {
+	h := sha256.Sum256([]byte(strings.ToLower(host)))
+	return hex.EncodeToString(h[:16])
+}
@@ -0,0 +1,43 @@
+package directmedia_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/tnyim/jungletv/server/media/directmedia"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mediaIDOf(t *testing.T, rawURL string) string {
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	return directmedia.MediaID(u)
+}
+
+func TestMediaIDOfEquivalentURLs(t *testing.T) {
+	id := mediaIDOf(t, "https://media.example.com/video.mp4?a=1&b=2")
+	for _, equivalent := range []string{
+		"https://media.example.com/video.mp4?a=1&b=2#t=30",
+		"HTTPS://Media.Example.COM/video.mp4?a=1&b=2",
+		"https://media.example.com:443/video.mp4?a=1&b=2",
+		"https://media.example.com/video.mp4?b=2&a=1",
+	} {
+		require.Equal(t, id, mediaIDOf(t, equivalent), equivalent)
+	}
+	require.Equal(t, mediaIDOf(t, "http://example.com/"), mediaIDOf(t, "http://example.com:80"))
+	require.Equal(t, mediaIDOf(t, "https://[::1]:8443/a"), mediaIDOf(t, "https://[::1]:8443/a#b"))
+
+	for _, different := range []string{
+		"http://media.example.com/video.mp4?a=1&b=2",
+		"https://media.example.com:8443/video.mp4?a=1&b=2",
+		"https://media.example.com/Video.mp4?a=1&b=2",
+		"https://media.example.com/video.mp4?a=1&b=3",
+		"https://media.example.com/video.mp4?a=1",
+	} {
+		require.NotEqual(t, id, mediaIDOf(t, different), different)
+	}
+
+	// the order of repeated parameters may matter
+	require.NotEqual(t, mediaIDOf(t, "https://example.com/v?a=1&a=2"), mediaIDOf(t, "https://example.com/v?a=2&a=1"))
+}
@@ -0,0 +1,88 @@
+package directmedia
+
+import (
+	"context"
+
+	"github.com/bytedance/sonic"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/types"
+)
+
+func (e *queueEntryDirectMedia) ProducePlayedMedia() (*types.PlayedMedia, error) {
+	playedMedia, err := e.BaseProducePlayedMedia(types.MediaTypeDirectMedia, e.id, dbMediaInfo{
+		Title:  e.Title(),
+		URL:    e.url,
+		Format: string(e.format),
+	})
+	return playedMedia, stacktrace.Propagate(err, "")
+}
+
+type dbMediaInfo struct {
+	Title  string `json:"title"`
+	URL    string `json:"url"`
+	Format string `json:"format"`
+}
+
+func (s *MediaProvider) serializeProtoMediaData(playedMedia *types.PlayedMedia) (*proto.QueueDirectMediaData, error) {
+	var info dbMediaInfo
+	err := playedMedia.MediaInfo.Unmarshal(&info)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &proto.QueueDirectMediaData{
+		Id:     playedMedia.MediaID,
+		Title:  info.Title,
+		Url:    info.URL,
+		Format: mediaFormat(info.Format).toProto(),
+	}, nil
+}
+
+func (s *MediaProvider) SerializePlayedMediaMediaInfo(playedMedia *types.PlayedMedia) (proto.IsPlayedMedia_MediaInfo, error) {
+	info, err := s.serializeProtoMediaData(playedMedia)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &proto.PlayedMedia_DirectMediaData{
+		DirectMediaData: info,
+	}, nil
+}
+
+func (s *MediaProvider) SerializeUserProfileResponseFeaturedMedia(playedMedia *types.PlayedMedia) (proto.IsUserProfileResponse_FeaturedMedia, error) {
+	info, err := s.serializeProtoMediaData(playedMedia)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &proto.UserProfileResponse_DirectMediaData{
+		DirectMediaData: info,
+	}, nil
+}
+
+func (s *MediaProvider) UnmarshalQueueEntryJSON(ctx context.Context, b []byte) (media.QueueEntry, bool, error) {
+	v := &queueEntryDirectMedia{}
+	err := sonic.Unmarshal(b, &v)
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "")
+	}
+	return v, true, nil
+}
+
+func (s *MediaProvider) BasicMediaInfoFromPlayedMedia(playedMedia *types.PlayedMedia) (media.BasicInfo, error) {
+	var info dbMediaInfo
+	err := playedMedia.MediaInfo.Unmarshal(&info)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	// reuse the queue entry type, like the other providers do: only the media.BasicInfo methods will be called
+
+	v := &queueEntryDirectMedia{
+		CommonInfo: media.CommonMediaInfoFromPlayedMedia(playedMedia, info.Title),
+		id:         playedMedia.MediaID,
+		url:        info.URL,
+		format:     mediaFormat(info.Format),
+	}
+
+	return v, nil
+}
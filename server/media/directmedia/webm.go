@@ -0,0 +1,182 @@
+package directmedia
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/tnyim/jungletv/server/media"
+)
+
+const (
+	ebmlIDHeader        = 0x1a45dfa3
+	ebmlIDDocType       = 0x4282
+	ebmlIDSegment       = 0x18538067
+	ebmlIDInfo          = 0x1549a966
+	ebmlIDTimecodeScale = 0x2ad7b1
+	ebmlIDDuration      = 0x4489
+	ebmlIDTitle         = 0x7ba9
+	ebmlIDCluster       = 0x1f43b675
+)
+
+const ebmlUnknownSize = -1
+
+// probeWebM parses the EBML header and the segment information of a WebM (or Matroska) file.
+// The segment information is expected to be located before the first cluster, within the initially fetched bytes
+func probeWebM(head []byte) (*probeResult, media.EnqueueRequestCreationResult, error) {
+	reader := ebmlReader{data: head}
+
+	id, size, ok := reader.readElementHeader()
+	if !ok || id != ebmlIDHeader || size == ebmlUnknownSize {
+		return nil, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+	}
+	header, ok := reader.readBytes(size)
+	if !ok {
+		return nil, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+	}
+	docType := ""
+	headerReader := ebmlReader{data: header}
+	for {
+		id, payload, ok := headerReader.readElement()
+		if !ok {
+			break
+		}
+		if id == ebmlIDDocType {
+			docType = string(payload)
+		}
+	}
+	if docType != "webm" && docType != "matroska" {
+		return nil, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+	}
+
+	id, _, ok = reader.readElementHeader()
+	if !ok || id != ebmlIDSegment {
+		return nil, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+	}
+
+	for {
+		id, size, ok := reader.readElementHeader()
+		if !ok || id == ebmlIDCluster || size == ebmlUnknownSize {
+			break
+		}
+		payload, ok := reader.readBytes(size)
+		if !ok {
+			break
+		}
+		if id != ebmlIDInfo {
+			continue
+		}
+
+		duration, title := parseWebMSegmentInfo(payload)
+		return &probeResult{
+			format:   mediaFormatWebM,
+			duration: duration,
+			title:    title,
+		}, media.EnqueueRequestCreationSucceeded, nil
+	}
+	return nil, media.EnqueueRequestCreationFailedMediumFormatIsUnsupported, nil
+}
+
+func parseWebMSegmentInfo(info []byte) (time.Duration, string) {
+	timecodeScale := uint64(1000000)
+	var duration float64
+	var title string
+
+	reader := ebmlReader{data: info}
+	for {
+		id, payload, ok := reader.readElement()
+		if !ok {
+			break
+		}
+		switch id {
+		case ebmlIDTimecodeScale:
+			if len(payload) > 0 && len(payload) <= 8 {
+				timecodeScale = 0
+				for _, b := range payload {
+					timecodeScale = timecodeScale<<8 | uint64(b)
+				}
+			}
+		case ebmlIDDuration:
+			switch len(payload) {
+			case 4:
+				duration = float64(math.Float32frombits(binary.BigEndian.Uint32(payload)))
+			case 8:
+				duration = math.Float64frombits(binary.BigEndian.Uint64(payload))
+			}
+		case ebmlIDTitle:
+			title = string(payload)
+		}
+	}
+	if math.IsNaN(duration) || math.IsInf(duration, 0) || duration <= 0 {
+		return 0, title
+	}
+	return time.Duration(duration * float64(timecodeScale)), title
+}
+
+type ebmlReader struct {
+	data []byte
+	pos  int
+}
+
+// readVarInt reads an EBML variable-length integer, optionally keeping the length marker bits (as is done for element IDs)
+func (r *ebmlReader) readVarInt(keepMarker bool) (uint64, int, bool) {
+	if r.pos >= len(r.data) {
+		return 0, 0, false
+	}
+	first := r.data[r.pos]
+	length := 1
+	for mask := byte(0x80); length <= 8 && first&mask == 0; mask >>= 1 {
+		length++
+	}
+	if length > 8 || r.pos+length > len(r.data) {
+		return 0, 0, false
+	}
+	value := uint64(first)
+	if !keepMarker {
+		value &= uint64(0xff >> length)
+	}
+	for _, b := range r.data[r.pos+1 : r.pos+length] {
+		value = value<<8 | uint64(b)
+	}
+	r.pos += length
+	return value, length, true
+}
+
+func (r *ebmlReader) readElementHeader() (uint64, int64, bool) {
+	id, _, ok := r.readVarInt(true)
+	if !ok {
+		return 0, 0, false
+	}
+	size, length, ok := r.readVarInt(false)
+	if !ok {
+		return 0, 0, false
+	}
+	if size == (uint64(1)<<(7*length))-1 {
+		return id, ebmlUnknownSize, true
+	}
+	if size > math.MaxInt32 {
+		return 0, 0, false
+	}
+	return id, int64(size), true
+}
+
+func (r *ebmlReader) readBytes(size int64) ([]byte, bool) {
+	if size < 0 || int64(r.pos)+size > int64(len(r.data)) {
+		return nil, false
+	}
+	b := r.data[r.pos : r.pos+int(size)]
+	r.pos += int(size)
+	return b, true
+}
+
+func (r *ebmlReader) readElement() (uint64, []byte, bool) {
+	id, size, ok := r.readElementHeader()
+	if !ok {
+		return 0, nil, false
+	}
+	payload, ok := r.readBytes(size)
+	if !ok {
+		return 0, nil, false
+	}
+	return id, payload, true
+}
@@ -50,32 +50,36 @@ func (e *queueEntryDocument) SerializeForAPIQueue(ctx context.Context) proto.IsQ
 }
 
 type queueEntryDocumentJsonRepresentation struct {
-	QueueID     string
-	Type        string
-	ID          string
-	Title       string
-	Duration    time.Duration
-	RequestedBy string
-	RequestCost *big.Int
-	RequestedAt time.Time
-	Unskippable bool
-	Concealed   bool
-	MovedBy     []string
+	QueueID               string
+	Type                  string
+	ID                    string
+	Title                 string
+	Duration              time.Duration
+	RequestedBy           string
+	RequestCost           *big.Int
+	RequestCostCurrency   string
+	RequestCostInCurrency *big.Int
+	RequestedAt           time.Time
+	Unskippable           bool
+	Concealed             bool
+	MovedBy               []string
 }
 
 func (e *queueEntryDocument) MarshalJSON() ([]byte, error) {
 	j, err := sonic.Marshal(queueEntryDocumentJsonRepresentation{
-		QueueID:     e.PerformanceID(),
-		Type:        string(types.MediaTypeDocument),
-		ID:          e.document.ID,
-		Title:       e.Title(),
-		Duration:    e.Length(),
-		RequestedBy: e.RequestedBy().Address(),
-		RequestCost: e.RequestCost().Int,
-		RequestedAt: e.RequestedAt(),
-		Unskippable: e.Unskippable(),
-		Concealed:   e.Concealed(),
-		MovedBy:     e.MovedBy(),
+		QueueID:               e.PerformanceID(),
+		Type:                  string(types.MediaTypeDocument),
+		ID:                    e.document.ID,
+		Title:                 e.Title(),
+		Duration:              e.Length(),
+		RequestedBy:           e.RequestedBy().Address(),
+		RequestCost:           e.RequestCost().Int,
+		RequestCostCurrency:   string(e.RequestCostCurrency()),
+		RequestCostInCurrency: e.RequestCostInCurrency().Int,
+		RequestedAt:           e.RequestedAt(),
+		Unskippable:           e.Unskippable(),
+		Concealed:             e.Concealed(),
+		MovedBy:               e.MovedBy(),
 	})
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "error serializing queue entry %s", e.PerformanceID())
@@ -112,6 +116,9 @@ func (e *queueEntryDocument) UnmarshalJSON(b []byte) error {
 	e.SetOffset(0)
 	e.SetRequestedBy(auth.NewAddressOnlyUser(t.RequestedBy))
 	e.SetRequestCost(payment.NewAmount(t.RequestCost))
+	if t.RequestCostInCurrency != nil {
+		e.SetRequestCostInCurrency(payment.Currency(t.RequestCostCurrency), payment.NewAmount(t.RequestCostInCurrency))
+	}
 	e.SetRequestedAt(t.RequestedAt)
 	e.SetUnskippable(t.Unskippable)
 	e.SetConcealed(t.Concealed)
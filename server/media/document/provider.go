@@ -101,6 +101,22 @@ func (c *DocumentProvider) ContinueEnqueueRequest(ctx transaction.WrappingContex
 		duration = preInfo.parameters.DocumentData.Duration.AsDuration()
 	}
 
+	userClaims := authinterceptor.UserFromContext(ctx)
+
+	if !skipDuplicationChecks {
+		result, err := media.CheckReplayRules(ctx, c.mediaQueue, media.ReplayCandidate{
+			MediaType:   types.MediaTypeDocument,
+			MediaID:     preInfo.document.ID,
+			Collections: preInfo.Collections(),
+			Requester:   userClaims,
+			Length:      duration,
+			TotalLength: duration,
+		})
+		if err != nil || result != media.EnqueueRequestCreationSucceeded {
+			return nil, result, stacktrace.Propagate(err, "")
+		}
+	}
+
 	request := &queueEntryDocument{
 		document:          preInfo.document,
 		backgroundContext: c.queueContext,
@@ -112,7 +128,6 @@ func (c *DocumentProvider) ContinueEnqueueRequest(ctx transaction.WrappingContex
 	request.SetUnskippable(unskippable)
 	request.SetConcealed(concealed)
 
-	userClaims := authinterceptor.UserFromContext(ctx)
 	if userClaims != nil && !anonymous {
 		request.SetRequestedBy(userClaims)
 	}
@@ -1,6 +1,11 @@
 package media
 
-import "github.com/tnyim/jungletv/server/auth"
+import (
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
 
 // EnqueueRequest is a request to create an EnqueueTicket
 type EnqueueRequest interface {
@@ -26,4 +31,24 @@ const (
 	EnqueueRequestCreationFailedMediumPlayedTooRecently
 	EnqueueRequestCreationFailedMediumIsDisallowed
 	EnqueueRequestCreationFailedMediumIsNotATrack
+	EnqueueRequestCreationFailedMediumFormatIsUnsupported
+	EnqueueRequestCreationFailedMediumIsRepeatedInCollection
+	EnqueueRequestCreationFailedCollectionIsEmpty
 )
+
+// IsAllowed checks the media and each collection it belongs to against the disallow lists
+func IsAllowed(ctx transaction.WrappingContext, info InitialInfo) (bool, error) {
+	mediaType, mediaID := info.MediaID()
+	allowed, err := types.IsMediaAllowed(ctx, mediaType, mediaID)
+	if err != nil || !allowed {
+		return false, stacktrace.Propagate(err, "")
+	}
+
+	for _, collection := range info.Collections() {
+		allowed, err := types.IsMediaCollectionAllowed(ctx, collection.Type, collection.ID)
+		if err != nil || !allowed {
+			return false, stacktrace.Propagate(err, "")
+		}
+	}
+	return true, nil
+}
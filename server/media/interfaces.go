@@ -20,10 +20,21 @@ type QueueEntry interface {
 
 	ActionableMediaInfo() ActionableInfo
 	Concealed() bool
+	// RequestCostCurrency is the currency the entry was paid in. RequestCost is always in the home currency
+	RequestCostCurrency() payment.Currency
+	RequestCostInCurrency() payment.Amount
+	SetRequestCostInCurrency(currency payment.Currency, amount payment.Amount)
 	ProduceCheckpointForAPI(ctx context.Context) *proto.MediaConsumptionCheckpoint
 	ProducePlayedMedia() (*types.PlayedMedia, error)
 	Play()
 	Stop()
+	// Pause freezes the playback of an entry that is playing, until Resume is called
+	Pause()
+	// PauseAt pauses an entry that is playing as if it had played for exactly playedFor.
+	// Used to restore the playback position of an entry that was paused before a restart
+	PauseAt(playedFor time.Duration)
+	Resume()
+	Paused() bool
 	DonePlaying() event.NoArgEvent
 
 	WasMovedBy(user auth.User) bool
@@ -91,6 +102,25 @@ type Provider interface {
 	SerializeUserProfileResponseFeaturedMedia(playedMedia *types.PlayedMedia) (proto.IsUserProfileResponse_FeaturedMedia, error)
 }
 
+// CollectionProvider is implemented by media providers that can expand a collection of media (such as a playlist) into
+// multiple media, to be enqueued under a single ticket
+type CollectionProvider interface {
+	CanHandleCollectionRequestType(mediaParameters proto.IsEnqueueMediaRequest_MediaInfo) bool
+	// BeginCollectionEnqueueRequest returns the initial information for each item of the collection.
+	// Items that can not be enqueued are returned with a result other than EnqueueRequestCreationSucceeded
+	BeginCollectionEnqueueRequest(ctx transaction.WrappingContext, mediaParameters proto.IsEnqueueMediaRequest_MediaInfo) ([]CollectionItemInitialInfo, EnqueueRequestCreationResult, error)
+}
+
+// CollectionItemInitialInfo contains the initial information for one item of a media collection
+type CollectionItemInitialInfo struct {
+	Title  string
+	Info   InitialInfo // nil if Result is not EnqueueRequestCreationSucceeded
+	Result EnqueueRequestCreationResult
+}
+
+// MaxCollectionEnqueueItems is the maximum number of media that can be enqueued from a single collection
+const MaxCollectionEnqueueItems = 25
+
 // MediaQueueStub contains a subset of the methods implemented by the media queue which are useful to media providers
 type MediaQueueStub interface {
 	Entries() []QueueEntry
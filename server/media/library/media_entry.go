@@ -0,0 +1,141 @@
+package library
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/server/media/directmedia"
+	"github.com/tnyim/jungletv/types"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type queueEntryLibraryMedia struct {
+	media.CommonQueueEntry
+	media.CommonInfo
+	id     string
+	url    string
+	format string
+}
+
+func (e *queueEntryLibraryMedia) ProduceMediaQueueEntry(requestedBy auth.User, requestCost payment.Amount, unskippable, concealed bool, queueID string) media.QueueEntry {
+	e.FillMediaQueueEntryFields(requestedBy, requestCost, unskippable, concealed, queueID)
+	return e
+}
+
+func (e *queueEntryLibraryMedia) MediaID() (types.MediaType, string) {
+	return types.MediaTypeLibraryMedia, e.id
+}
+
+func (e *queueEntryLibraryMedia) serializeProtoMediaData() *proto.QueueLibraryMediaData {
+	return &proto.QueueLibraryMediaData{
+		Id:     e.id,
+		Title:  e.Title(),
+		Url:    e.url,
+		Format: directmedia.FormatToProto(e.format),
+	}
+}
+
+func (e *queueEntryLibraryMedia) SerializeForAPIQueue(ctx context.Context) proto.IsQueueEntry_MediaInfo {
+	return &proto.QueueEntry_LibraryMediaData{
+		LibraryMediaData: e.serializeProtoMediaData(),
+	}
+}
+
+type queueEntryLibraryMediaJsonRepresentation struct {
+	QueueID               string
+	Type                  string
+	ID                    string
+	Title                 string
+	URL                   string
+	Format                string
+	Duration              time.Duration
+	Offset                time.Duration
+	RequestedBy           string
+	RequestCost           *big.Int
+	RequestCostCurrency   string
+	RequestCostInCurrency *big.Int
+	RequestedAt           time.Time
+	Unskippable           bool
+	Concealed             bool
+	MovedBy               []string
+}
+
+func (e *queueEntryLibraryMedia) MarshalJSON() ([]byte, error) {
+	j, err := sonic.Marshal(queueEntryLibraryMediaJsonRepresentation{
+		QueueID:               e.PerformanceID(),
+		Type:                  string(types.MediaTypeLibraryMedia),
+		ID:                    e.id,
+		Title:                 e.Title(),
+		URL:                   e.url,
+		Format:                e.format,
+		Duration:              e.Length(),
+		Offset:                e.Offset(),
+		RequestedBy:           e.RequestedBy().Address(),
+		RequestCost:           e.RequestCost().Int,
+		RequestCostCurrency:   string(e.RequestCostCurrency()),
+		RequestCostInCurrency: e.RequestCostInCurrency().Int,
+		RequestedAt:           e.RequestedAt(),
+		Unskippable:           e.Unskippable(),
+		Concealed:             e.Concealed(),
+		MovedBy:               e.MovedBy(),
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "error serializing queue entry %s", e.PerformanceID())
+	}
+	return j, nil
+}
+
+func (e *queueEntryLibraryMedia) UnmarshalJSON(b []byte) error {
+	var t queueEntryLibraryMediaJsonRepresentation
+	if err := sonic.Unmarshal(b, &t); err != nil {
+		return stacktrace.Propagate(err, "error deserializing queue entry")
+	}
+
+	e.InitializeBase(e, e)
+	e.SetQueueID(t.QueueID)
+	e.id = t.ID
+	e.SetTitle(t.Title)
+	e.url = t.URL
+	e.format = t.Format
+	e.SetLength(t.Duration)
+	e.SetOffset(t.Offset)
+	e.SetRequestedBy(auth.NewAddressOnlyUser(t.RequestedBy))
+	e.SetRequestCost(payment.NewAmount(t.RequestCost))
+	if t.RequestCostInCurrency != nil {
+		e.SetRequestCostInCurrency(payment.Currency(t.RequestCostCurrency), payment.NewAmount(t.RequestCostInCurrency))
+	}
+	e.SetRequestedAt(t.RequestedAt)
+	e.SetUnskippable(t.Unskippable)
+	e.SetConcealed(t.Concealed)
+	for _, m := range t.MovedBy {
+		e.SetAsMovedBy(auth.NewAddressOnlyUser(m))
+	}
+	return nil
+}
+
+func (e *queueEntryLibraryMedia) FillAPITicketMediaInfo(ticket *proto.EnqueueMediaTicket) {
+	ticket.Length = durationpb.New(e.Length())
+	ticket.Offset = durationpb.New(e.Offset())
+	ticket.MediaInfo = &proto.EnqueueMediaTicket_LibraryMediaData{
+		LibraryMediaData: e.serializeProtoMediaData(),
+	}
+}
+
+func (e *queueEntryLibraryMedia) ProduceCheckpointForAPI(ctx context.Context) *proto.MediaConsumptionCheckpoint {
+	return &proto.MediaConsumptionCheckpoint{
+		MediaInfo: &proto.MediaConsumptionCheckpoint_LibraryMediaData{
+			LibraryMediaData: &proto.NowPlayingLibraryMediaData{
+				Id:     e.id,
+				Url:    e.url,
+				Format: directmedia.FormatToProto(e.format),
+			},
+		},
+	}
+}
@@ -0,0 +1,162 @@
+package library
+
+import (
+	"errors"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/medialibrary"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// MediaProvider provides media files uploaded to the server-hosted media library
+type MediaProvider struct {
+	mediaQueue media.MediaQueueStub
+	websiteURL string
+}
+
+// NewProvider returns a new library media provider
+func NewProvider(websiteURL string) media.Provider {
+	return &MediaProvider{
+		websiteURL: websiteURL,
+	}
+}
+
+func (c *MediaProvider) SetMediaQueue(mediaQueue media.MediaQueueStub) {
+	c.mediaQueue = mediaQueue
+}
+
+func (c *MediaProvider) CanHandleRequestType(mediaParameters proto.IsEnqueueMediaRequest_MediaInfo) bool {
+	_, ok := mediaParameters.(*proto.EnqueueMediaRequest_LibraryMediaData)
+	return ok
+}
+
+type initialInfo struct {
+	libraryMedia *types.LibraryMedia
+	parameters   *proto.EnqueueMediaRequest_LibraryMediaData
+}
+
+func (i *initialInfo) MediaID() (types.MediaType, string) {
+	return types.MediaTypeLibraryMedia, i.libraryMedia.ID
+}
+
+func (i *initialInfo) Title() string {
+	return i.libraryMedia.Title
+}
+
+func (i *initialInfo) Collections() []media.CollectionKey {
+	return []media.CollectionKey{}
+}
+
+func (c *MediaProvider) BeginEnqueueRequest(ctx transaction.WrappingContext, mediaParameters proto.IsEnqueueMediaRequest_MediaInfo) (media.InitialInfo, media.EnqueueRequestCreationResult, error) {
+	ctx, err := transaction.Begin(ctx)
+	if err != nil {
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	libraryParameters, ok := mediaParameters.(*proto.EnqueueMediaRequest_LibraryMediaData)
+	if !ok {
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.NewError("invalid parameter type for library media provider")
+	}
+
+	libraryMedia, err := types.GetLibraryMediaWithID(ctx, libraryParameters.LibraryMediaData.GetId())
+	if err != nil {
+		if errors.Is(err, types.ErrLibraryMediaNotFound) {
+			return nil, media.EnqueueRequestCreationFailedMediumNotFound, nil
+		}
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+
+	user := authinterceptor.UserFromContext(ctx)
+	if !libraryMedia.Public && !auth.UserPermissionLevelIsAtLeast(user, auth.AdminPermissionLevel) && (user == nil || user.ApplicationID() == "") {
+		return nil, media.EnqueueRequestCreationFailedMediumIsNotEmbeddable, nil
+	}
+
+	return &initialInfo{
+		libraryMedia: libraryMedia,
+		parameters:   libraryParameters,
+	}, media.EnqueueRequestCreationSucceeded, nil
+}
+
+func (c *MediaProvider) ContinueEnqueueRequest(ctx transaction.WrappingContext, genericInfo media.InitialInfo, unskippable, concealed, anonymous,
+	allowUnpopular, skipLengthChecks, skipDuplicationChecks bool) (media.EnqueueRequest, media.EnqueueRequestCreationResult, error) {
+	ctx, err := transaction.Begin(ctx)
+	if err != nil {
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	preInfo, ok := genericInfo.(*initialInfo)
+	if !ok {
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.NewError("unexpected type")
+	}
+
+	var startOffsetDuration time.Duration
+	if preInfo.parameters.LibraryMediaData.StartOffset != nil {
+		startOffsetDuration = preInfo.parameters.LibraryMediaData.StartOffset.AsDuration()
+	}
+	var endOffsetDuration time.Duration
+	if preInfo.parameters.LibraryMediaData.EndOffset != nil {
+		endOffsetDuration = preInfo.parameters.LibraryMediaData.EndOffset.AsDuration()
+		if endOffsetDuration <= startOffsetDuration {
+			return nil, media.EnqueueRequestCreationFailed, stacktrace.NewError("media start offset past media end offset")
+		}
+	}
+
+	mediaDuration := time.Duration(preInfo.libraryMedia.Duration)
+
+	if startOffsetDuration > mediaDuration {
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.NewError("media start offset past end of media")
+	}
+
+	if endOffsetDuration == 0 || endOffsetDuration > mediaDuration {
+		endOffsetDuration = mediaDuration
+	}
+
+	playFor := endOffsetDuration - startOffsetDuration
+
+	if playFor > 35*time.Minute && !skipLengthChecks {
+		return nil, media.EnqueueRequestCreationFailedMediumIsTooLong, nil
+	}
+
+	userClaims := authinterceptor.UserFromContext(ctx)
+
+	if !skipDuplicationChecks {
+		result, err := media.CheckReplayRules(ctx, c.mediaQueue, media.ReplayCandidate{
+			MediaType:   types.MediaTypeLibraryMedia,
+			MediaID:     preInfo.libraryMedia.ID,
+			Collections: preInfo.Collections(),
+			Requester:   userClaims,
+			Offset:      startOffsetDuration,
+			Length:      playFor,
+			TotalLength: mediaDuration,
+		})
+		if err != nil || result != media.EnqueueRequestCreationSucceeded {
+			return nil, result, stacktrace.Propagate(err, "")
+		}
+	}
+
+	request := &queueEntryLibraryMedia{
+		id:     preInfo.libraryMedia.ID,
+		url:    medialibrary.URL(c.websiteURL, preInfo.libraryMedia.ID),
+		format: preInfo.libraryMedia.Format,
+	}
+	request.InitializeBase(request, request)
+	request.SetTitle(preInfo.libraryMedia.Title)
+	request.SetLength(playFor)
+	request.SetOffset(startOffsetDuration)
+	request.SetUnskippable(unskippable)
+	request.SetConcealed(concealed)
+
+	if userClaims != nil && !anonymous {
+		request.SetRequestedBy(userClaims)
+	}
+
+	return request, media.EnqueueRequestCreationSucceeded, nil
+}
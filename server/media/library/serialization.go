@@ -0,0 +1,89 @@
+package library
+
+import (
+	"context"
+
+	"github.com/bytedance/sonic"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/components/medialibrary"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/server/media/directmedia"
+	"github.com/tnyim/jungletv/types"
+)
+
+func (e *queueEntryLibraryMedia) ProducePlayedMedia() (*types.PlayedMedia, error) {
+	playedMedia, err := e.BaseProducePlayedMedia(types.MediaTypeLibraryMedia, e.id, dbMediaInfo{
+		Title:  e.Title(),
+		Format: e.format,
+	})
+	return playedMedia, stacktrace.Propagate(err, "")
+}
+
+// the URL is not stored as it can be derived from the media ID, and would become stale if the website URL changed
+type dbMediaInfo struct {
+	Title  string `json:"title"`
+	Format string `json:"format"`
+}
+
+func (s *MediaProvider) serializeProtoMediaData(playedMedia *types.PlayedMedia) (*proto.QueueLibraryMediaData, error) {
+	var info dbMediaInfo
+	err := playedMedia.MediaInfo.Unmarshal(&info)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &proto.QueueLibraryMediaData{
+		Id:     playedMedia.MediaID,
+		Title:  info.Title,
+		Url:    medialibrary.URL(s.websiteURL, playedMedia.MediaID),
+		Format: directmedia.FormatToProto(info.Format),
+	}, nil
+}
+
+func (s *MediaProvider) SerializePlayedMediaMediaInfo(playedMedia *types.PlayedMedia) (proto.IsPlayedMedia_MediaInfo, error) {
+	info, err := s.serializeProtoMediaData(playedMedia)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &proto.PlayedMedia_LibraryMediaData{
+		LibraryMediaData: info,
+	}, nil
+}
+
+func (s *MediaProvider) SerializeUserProfileResponseFeaturedMedia(playedMedia *types.PlayedMedia) (proto.IsUserProfileResponse_FeaturedMedia, error) {
+	info, err := s.serializeProtoMediaData(playedMedia)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &proto.UserProfileResponse_LibraryMediaData{
+		LibraryMediaData: info,
+	}, nil
+}
+
+func (s *MediaProvider) UnmarshalQueueEntryJSON(ctx context.Context, b []byte) (media.QueueEntry, bool, error) {
+	v := &queueEntryLibraryMedia{}
+	err := sonic.Unmarshal(b, &v)
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "")
+	}
+	return v, true, nil
+}
+
+func (s *MediaProvider) BasicMediaInfoFromPlayedMedia(playedMedia *types.PlayedMedia) (media.BasicInfo, error) {
+	var info dbMediaInfo
+	err := playedMedia.MediaInfo.Unmarshal(&info)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	// reuse the queue entry type, like the other providers do: only the media.BasicInfo methods will be called
+
+	v := &queueEntryLibraryMedia{
+		CommonInfo: media.CommonMediaInfoFromPlayedMedia(playedMedia, info.Title),
+		id:         playedMedia.MediaID,
+		url:        medialibrary.URL(s.websiteURL, playedMedia.MediaID),
+		format:     info.Format,
+	}
+
+	return v, nil
+}
@@ -0,0 +1,70 @@
+package playlist
+
+import (
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/types"
+)
+
+const jsonPlaylistVersion = 1
+
+type jsonPlaylist struct {
+	Version int        `json:"version"`
+	Items   []jsonItem `json:"items"`
+}
+
+type jsonItem struct {
+	MediaType    string `json:"mediaType"`
+	MediaID      string `json:"mediaID"`
+	Location     string `json:"location,omitempty"`
+	Title        string `json:"title"`
+	OffsetMillis int64  `json:"offsetMillis"`
+	LengthMillis int64  `json:"lengthMillis"`
+}
+
+func encodeJSON(items []Item) ([]byte, error) {
+	p := jsonPlaylist{
+		Version: jsonPlaylistVersion,
+		Items:   make([]jsonItem, len(items)),
+	}
+	for i, item := range items {
+		p.Items[i] = jsonItem{
+			MediaType:    string(item.MediaType),
+			MediaID:      item.MediaID,
+			Location:     item.Location,
+			Title:        item.Title,
+			OffsetMillis: item.Offset.Milliseconds(),
+			LengthMillis: item.Length.Milliseconds(),
+		}
+	}
+	b, err := sonic.Marshal(p)
+	return b, stacktrace.Propagate(err, "")
+}
+
+func decodeJSON(data []byte) ([]Item, error) {
+	var p jsonPlaylist
+	err := sonic.Unmarshal(data, &p)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if p.Version != jsonPlaylistVersion {
+		return nil, stacktrace.NewError("unsupported playlist version %d", p.Version)
+	}
+	items := make([]Item, len(p.Items))
+	for i, item := range p.Items {
+		if item.OffsetMillis < 0 || item.LengthMillis < 0 {
+			return nil, stacktrace.NewError("negative offset or length in playlist item %d", i)
+		}
+		items[i] = Item{
+			MediaType: types.MediaType(item.MediaType),
+			MediaID:   item.MediaID,
+			Location:  item.Location,
+			Title:     item.Title,
+			Offset:    time.Duration(item.OffsetMillis) * time.Millisecond,
+			Length:    time.Duration(item.LengthMillis) * time.Millisecond,
+		}
+	}
+	return items, nil
+}
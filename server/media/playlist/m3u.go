@@ -0,0 +1,56 @@
+package playlist
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/palantir/stacktrace"
+)
+
+func encodeM3U(items []Item) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString("#EXTM3U\n")
+	for _, item := range items {
+		if item.Location == "" {
+			continue
+		}
+		// titles can't span multiple lines
+		title := strings.Join(strings.Fields(item.Title), " ")
+		fmt.Fprintf(&b, "#EXTINF:%d,%s\n%s\n", int64(item.Length.Seconds()), title, item.Location)
+	}
+	return b.Bytes(), nil
+}
+
+// decodeM3U parses both simple and extended M3U files.
+// Durations are not imported, as they are imprecise and media is always enqueued in its entirety from these files
+func decodeM3U(data []byte) ([]Item, error) {
+	items := []Item{}
+	title := ""
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "#EXTINF:"):
+			if idx := strings.Index(line, ","); idx >= 0 {
+				title = strings.TrimSpace(line[idx+1:])
+			}
+		case strings.HasPrefix(line, "#"):
+			// other directives and comments
+			continue
+		default:
+			items = append(items, Item{
+				Location: line,
+				Title:    title,
+			})
+			title = ""
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return items, nil
+}
@@ -0,0 +1,280 @@
+package playlist
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/types"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Format is a playlist file format
+type Format string
+
+const (
+	// FormatM3U is the extended M3U format. Only media that can be addressed by URL is included
+	FormatM3U Format = "m3u"
+	// FormatXSPF is the XML Shareable Playlist Format. Only media that can be addressed by URL is included
+	FormatXSPF Format = "xspf"
+	// FormatJSON is our native format, which preserves media types, IDs and play ranges
+	FormatJSON Format = "json"
+)
+
+// ContentType returns the MIME type of files in this format
+func (f Format) ContentType() string {
+	switch f {
+	case FormatM3U:
+		return "audio/x-mpegurl"
+	case FormatXSPF:
+		return "application/xspf+xml"
+	default:
+		return "application/json"
+	}
+}
+
+// FileExtension returns the file extension, including the leading dot, for files in this format
+func (f Format) FileExtension() string {
+	return "." + string(f)
+}
+
+// Item is one media item of a playlist
+type Item struct {
+	MediaType types.MediaType // may be empty for items decoded from formats other than FormatJSON
+	MediaID   string
+	Location  string // URL of the media, empty for media which can't be addressed by URL (e.g. documents)
+	Title     string
+	Offset    time.Duration
+	Length    time.Duration
+}
+
+// ErrUnsupportedFormat is returned when encoding or decoding a format that is not supported
+var ErrUnsupportedFormat = errors.New("unsupported playlist format")
+
+// ErrUnsupportedItem is returned when a playlist item does not correspond to media that can be enqueued
+var ErrUnsupportedItem = errors.New("unsupported playlist item")
+
+// Encode serializes the items in the specified format
+func Encode(format Format, items []Item) ([]byte, error) {
+	var b []byte
+	var err error
+	switch format {
+	case FormatM3U:
+		b, err = encodeM3U(items)
+	case FormatXSPF:
+		b, err = encodeXSPF(items)
+	case FormatJSON:
+		b, err = encodeJSON(items)
+	default:
+		return nil, stacktrace.Propagate(ErrUnsupportedFormat, "")
+	}
+	return b, stacktrace.Propagate(err, "")
+}
+
+// Decode parses a playlist file in the specified format
+func Decode(format Format, data []byte) ([]Item, error) {
+	var items []Item
+	var err error
+	switch format {
+	case FormatM3U:
+		items, err = decodeM3U(data)
+	case FormatXSPF:
+		items, err = decodeXSPF(data)
+	case FormatJSON:
+		items, err = decodeJSON(data)
+	default:
+		return nil, stacktrace.Propagate(ErrUnsupportedFormat, "")
+	}
+	return items, stacktrace.Propagate(err, "")
+}
+
+// ItemFromQueueEntryMediaInfo builds a playlist item from the API representation of a queue entry.
+// Returns false for media that can't be enqueued from a playlist, such as application pages
+func ItemFromQueueEntryMediaInfo(info proto.IsQueueEntry_MediaInfo, offset, length time.Duration) (Item, bool) {
+	switch i := info.(type) {
+	case *proto.QueueEntry_YoutubeVideoData:
+		return itemFromMediaData(i.YoutubeVideoData, offset, length)
+	case *proto.QueueEntry_SoundcloudTrackData:
+		return itemFromMediaData(i.SoundcloudTrackData, offset, length)
+	case *proto.QueueEntry_DocumentData:
+		return itemFromMediaData(i.DocumentData, offset, length)
+	case *proto.QueueEntry_DirectMediaData:
+		return itemFromMediaData(i.DirectMediaData, offset, length)
+	case *proto.QueueEntry_LibraryMediaData:
+		return itemFromMediaData(i.LibraryMediaData, offset, length)
+	default:
+		return Item{}, false
+	}
+}
+
+// ItemFromPlayedMediaInfo builds a playlist item from the API representation of a played media.
+// Returns false for media that can't be enqueued from a playlist, such as application pages
+func ItemFromPlayedMediaInfo(info proto.IsPlayedMedia_MediaInfo, offset, length time.Duration) (Item, bool) {
+	switch i := info.(type) {
+	case *proto.PlayedMedia_YoutubeVideoData:
+		return itemFromMediaData(i.YoutubeVideoData, offset, length)
+	case *proto.PlayedMedia_SoundcloudTrackData:
+		return itemFromMediaData(i.SoundcloudTrackData, offset, length)
+	case *proto.PlayedMedia_DocumentData:
+		return itemFromMediaData(i.DocumentData, offset, length)
+	case *proto.PlayedMedia_DirectMediaData:
+		return itemFromMediaData(i.DirectMediaData, offset, length)
+	case *proto.PlayedMedia_LibraryMediaData:
+		return itemFromMediaData(i.LibraryMediaData, offset, length)
+	default:
+		return Item{}, false
+	}
+}
+
+func itemFromMediaData(data any, offset, length time.Duration) (Item, bool) {
+	item := Item{
+		Offset: offset,
+		Length: length,
+	}
+	switch d := data.(type) {
+	case *proto.QueueYouTubeVideoData:
+		item.MediaType = types.MediaTypeYouTubeVideo
+		item.MediaID = d.Id
+		item.Location = "https://www.youtube.com/watch?v=" + d.Id
+		item.Title = d.Title
+	case *proto.QueueSoundCloudTrackData:
+		item.MediaType = types.MediaTypeSoundCloudTrack
+		item.MediaID = d.Id
+		item.Location = d.Permalink
+		item.Title = d.Title
+	case *proto.QueueDocumentData:
+		item.MediaType = types.MediaTypeDocument
+		item.MediaID = d.Id
+		item.Title = d.Title
+	case *proto.QueueDirectMediaData:
+		item.MediaType = types.MediaTypeDirectMedia
+		item.MediaID = d.Id
+		item.Location = d.Url
+		item.Title = d.Title
+	case *proto.QueueLibraryMediaData:
+		item.MediaType = types.MediaTypeLibraryMedia
+		item.MediaID = d.Id
+		item.Location = d.Url
+		item.Title = d.Title
+	default:
+		return Item{}, false
+	}
+	return item, true
+}
+
+var youTubeVideoIDRegex = regexp.MustCompile("^[A-Za-z0-9_-]{11}$")
+
+// resolveLocation determines the media type and ID of items that only have a location
+func (i Item) resolveLocation(websiteURL string) (Item, error) {
+	libraryPrefix := websiteURL + "/assets/library/"
+	if websiteURL != "" && strings.HasPrefix(i.Location, libraryPrefix) {
+		i.MediaType = types.MediaTypeLibraryMedia
+		i.MediaID = strings.TrimPrefix(i.Location, libraryPrefix)
+		return i, nil
+	}
+
+	u, err := url.Parse(i.Location)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return Item{}, stacktrace.Propagate(ErrUnsupportedItem, "invalid location")
+	}
+
+	switch strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.") {
+	case "youtube.com", "m.youtube.com", "music.youtube.com":
+		id := u.Query().Get("v")
+		for _, prefix := range []string{"/live/", "/shorts/", "/embed/"} {
+			if strings.HasPrefix(u.Path, prefix) {
+				id = strings.TrimPrefix(u.Path, prefix)
+			}
+		}
+		if !youTubeVideoIDRegex.MatchString(id) {
+			return Item{}, stacktrace.Propagate(ErrUnsupportedItem, "invalid YouTube video URL")
+		}
+		i.MediaType = types.MediaTypeYouTubeVideo
+		i.MediaID = id
+	case "youtu.be":
+		id := strings.TrimPrefix(u.Path, "/")
+		if !youTubeVideoIDRegex.MatchString(id) {
+			return Item{}, stacktrace.Propagate(ErrUnsupportedItem, "invalid YouTube video URL")
+		}
+		i.MediaType = types.MediaTypeYouTubeVideo
+		i.MediaID = id
+	case "soundcloud.com", "m.soundcloud.com":
+		i.MediaType = types.MediaTypeSoundCloudTrack
+	default:
+		i.MediaType = types.MediaTypeDirectMedia
+	}
+	return i, nil
+}
+
+// EnqueueParameters returns the parameters with which the media of the item can be enqueued.
+// websiteURL is used to recognize the locations of media from the media library
+func (i Item) EnqueueParameters(websiteURL string) (proto.IsEnqueueMediaRequest_MediaInfo, error) {
+	if i.MediaType == "" {
+		var err error
+		i, err = i.resolveLocation(websiteURL)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+
+	var startOffset, endOffset *durationpb.Duration
+	if i.Offset > 0 {
+		startOffset = durationpb.New(i.Offset)
+	}
+	if i.Length > 0 {
+		endOffset = durationpb.New(i.Offset + i.Length)
+	}
+
+	switch i.MediaType {
+	case types.MediaTypeYouTubeVideo:
+		return &proto.EnqueueMediaRequest_YoutubeVideoData{
+			YoutubeVideoData: &proto.EnqueueYouTubeVideoData{
+				Id:          i.MediaID,
+				StartOffset: startOffset,
+				EndOffset:   endOffset,
+			},
+		}, nil
+	case types.MediaTypeSoundCloudTrack:
+		return &proto.EnqueueMediaRequest_SoundcloudTrackData{
+			SoundcloudTrackData: &proto.EnqueueSoundCloudTrackData{
+				Permalink:   i.Location,
+				StartOffset: startOffset,
+				EndOffset:   endOffset,
+			},
+		}, nil
+	case types.MediaTypeDocument:
+		var duration *durationpb.Duration
+		if i.Length > 0 {
+			duration = durationpb.New(i.Length)
+		}
+		return &proto.EnqueueMediaRequest_DocumentData{
+			DocumentData: &proto.EnqueueDocumentData{
+				DocumentId: i.MediaID,
+				Title:      i.Title,
+				Duration:   duration,
+			},
+		}, nil
+	case types.MediaTypeDirectMedia:
+		return &proto.EnqueueMediaRequest_DirectMediaData{
+			DirectMediaData: &proto.EnqueueDirectMediaData{
+				Url:         i.Location,
+				StartOffset: startOffset,
+				EndOffset:   endOffset,
+			},
+		}, nil
+	case types.MediaTypeLibraryMedia:
+		return &proto.EnqueueMediaRequest_LibraryMediaData{
+			LibraryMediaData: &proto.EnqueueLibraryMediaData{
+				Id:          i.MediaID,
+				StartOffset: startOffset,
+				EndOffset:   endOffset,
+			},
+		}, nil
+	default:
+		return nil, stacktrace.Propagate(ErrUnsupportedItem, "unsupported media type %s", i.MediaType)
+	}
+}
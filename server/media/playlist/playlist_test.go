@@ -0,0 +1,93 @@
+package playlist_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/media/playlist"
+	"github.com/tnyim/jungletv/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testItems = []playlist.Item{
+	{
+		MediaType: types.MediaTypeYouTubeVideo,
+		MediaID:   "dQw4w9WgXcQ",
+		Location:  "https://www.youtube.com/watch?v=dQw4w9WgXcQ",
+		Title:     "Some video",
+		Offset:    30 * time.Second,
+		Length:    2 * time.Minute,
+	},
+	{
+		MediaType: types.MediaTypeDocument,
+		MediaID:   "announcement",
+		Title:     "Announcement",
+		Length:    5 * time.Minute,
+	},
+	{
+		MediaType: types.MediaTypeDirectMedia,
+		MediaID:   "0123456789abcdef0123456789abcdef",
+		Location:  "https://example.com/video.mp4",
+		Title:     "Some file",
+		Length:    time.Minute,
+	},
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	b, err := playlist.Encode(playlist.FormatJSON, testItems)
+	require.NoError(t, err)
+
+	items, err := playlist.Decode(playlist.FormatJSON, b)
+	require.NoError(t, err)
+	require.Equal(t, testItems, items)
+}
+
+func TestLocationOnlyFormatsRoundTrip(t *testing.T) {
+	for _, format := range []playlist.Format{playlist.FormatM3U, playlist.FormatXSPF} {
+		b, err := playlist.Encode(format, testItems)
+		require.NoError(t, err)
+
+		items, err := playlist.Decode(format, b)
+		require.NoError(t, err)
+
+		// media which can't be addressed by URL is left out, and only locations and titles are kept
+		require.Equal(t, []playlist.Item{
+			{Location: testItems[0].Location, Title: testItems[0].Title},
+			{Location: testItems[2].Location, Title: testItems[2].Title},
+		}, items, "format %s", format)
+	}
+}
+
+func TestEnqueueParameters(t *testing.T) {
+	websiteURL := "https://jungletv.live"
+
+	params, err := playlist.Item{Location: "https://youtu.be/dQw4w9WgXcQ"}.EnqueueParameters(websiteURL)
+	require.NoError(t, err)
+	require.Equal(t, "dQw4w9WgXcQ", params.(*proto.EnqueueMediaRequest_YoutubeVideoData).YoutubeVideoData.Id)
+
+	params, err = playlist.Item{Location: "https://soundcloud.com/artist/track"}.EnqueueParameters(websiteURL)
+	require.NoError(t, err)
+	require.Equal(t, "https://soundcloud.com/artist/track", params.(*proto.EnqueueMediaRequest_SoundcloudTrackData).SoundcloudTrackData.Permalink)
+
+	params, err = playlist.Item{Location: websiteURL + "/assets/library/4d0c2a6e-2c8b-4b8e-a0a5-9c1d3c6f1f70"}.EnqueueParameters(websiteURL)
+	require.NoError(t, err)
+	require.Equal(t, "4d0c2a6e-2c8b-4b8e-a0a5-9c1d3c6f1f70", params.(*proto.EnqueueMediaRequest_LibraryMediaData).LibraryMediaData.Id)
+
+	params, err = playlist.Item{Location: "https://example.com/video.webm"}.EnqueueParameters(websiteURL)
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/video.webm", params.(*proto.EnqueueMediaRequest_DirectMediaData).DirectMediaData.Url)
+
+	params, err = testItems[0].EnqueueParameters(websiteURL)
+	require.NoError(t, err)
+	ytParams := params.(*proto.EnqueueMediaRequest_YoutubeVideoData).YoutubeVideoData
+	require.Equal(t, 30*time.Second, ytParams.StartOffset.AsDuration())
+	require.Equal(t, 150*time.Second, ytParams.EndOffset.AsDuration())
+
+	_, err = playlist.Item{Location: "https://www.youtube.com/watch?v=invalid"}.EnqueueParameters(websiteURL)
+	require.ErrorIs(t, err, playlist.ErrUnsupportedItem)
+
+	_, err = playlist.Item{Location: "file:///home/user/song.mp3"}.EnqueueParameters(websiteURL)
+	require.ErrorIs(t, err, playlist.ErrUnsupportedItem)
+}
@@ -0,0 +1,68 @@
+package playlist
+
+import (
+	"encoding/xml"
+	"strings"
+
+	"github.com/palantir/stacktrace"
+)
+
+const xspfNamespace = "http://xspf.org/ns/0/"
+
+type xspfPlaylist struct {
+	XMLName xml.Name    `xml:"playlist"`
+	Xmlns   string      `xml:"xmlns,attr"`
+	Version string      `xml:"version,attr"`
+	Tracks  []xspfTrack `xml:"trackList>track"`
+}
+
+type xspfTrack struct {
+	Location string `xml:"location"`
+	Title    string `xml:"title,omitempty"`
+	Duration int64  `xml:"duration,omitempty"` // in milliseconds
+}
+
+func encodeXSPF(items []Item) ([]byte, error) {
+	p := xspfPlaylist{
+		Xmlns:   xspfNamespace,
+		Version: "1",
+		Tracks:  []xspfTrack{},
+	}
+	for _, item := range items {
+		if item.Location == "" {
+			continue
+		}
+		p.Tracks = append(p.Tracks, xspfTrack{
+			Location: item.Location,
+			Title:    item.Title,
+			Duration: item.Length.Milliseconds(),
+		})
+	}
+	b, err := xml.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return append([]byte(xml.Header), b...), nil
+}
+
+// decodeXSPF parses XSPF files.
+// Like with M3U, durations are not imported and media is always enqueued in its entirety from these files
+func decodeXSPF(data []byte) ([]Item, error) {
+	var p xspfPlaylist
+	err := xml.Unmarshal(data, &p)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	items := make([]Item, 0, len(p.Tracks))
+	for _, track := range p.Tracks {
+		location := strings.TrimSpace(track.Location)
+		if location == "" {
+			continue
+		}
+		items = append(items, Item{
+			Location: location,
+			Title:    strings.TrimSpace(track.Title),
+		})
+	}
+	return items, nil
+}
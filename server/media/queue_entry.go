@@ -1,6 +1,7 @@
 package media
 
 import (
+	"sync"
 	"time"
 
 	"github.com/bytedance/sonic"
@@ -20,12 +21,19 @@ type CommonQueueEntry struct {
 	unskippable bool
 	concealed   bool
 
-	requestedBy auth.User
-	requestCost payment.Amount
-	requestedAt time.Time
+	requestedBy           auth.User
+	requestCost           payment.Amount
+	requestCostCurrency   payment.Currency
+	requestCostInCurrency payment.Amount
+	requestedAt           time.Time
 
+	// playbackMutex guards the playback state below, which is changed by the end timer on its own goroutine
+	playbackMutex  sync.RWMutex
 	startedPlaying time.Time
 	stoppedPlaying time.Time
+	pausedAt       time.Time     // zero when not paused
+	pausedFor      time.Duration // total time spent paused, excluding the current pause
+	endTimer       *time.Timer   // nil unless playing and not paused
 	played         bool
 	donePlaying    event.NoArgEvent
 
@@ -61,47 +69,141 @@ func (e *CommonQueueEntry) SetQueueID(queueID string) {
 
 // Play implements the QueueEntry interface
 func (e *CommonQueueEntry) Play() {
+	e.playbackMutex.Lock()
+	defer e.playbackMutex.Unlock()
 	e.startedPlaying = time.Now()
-	c := time.NewTimer(e.mediaInfo.Length()).C
-	go func() {
-		donePlaying, donePlayingU := e.overrider.DonePlaying().Subscribe(event.BufferFirst)
-		defer donePlayingU()
-		select {
-		case <-donePlaying:
-			return
-		case <-c:
-			e.overrider.Stop()
+	e.startEndTimerInMutex(e.mediaInfo.Length())
+}
+
+// startEndTimerInMutex schedules the entry to stop playing after d.
+// A timer that fires after being replaced or stopped by a pause does nothing, as it may have fired just before
+func (e *CommonQueueEntry) startEndTimerInMutex(d time.Duration) {
+	var timer *time.Timer
+	timer = time.AfterFunc(d, func() {
+		e.playbackMutex.Lock()
+		stopped := e.endTimer == timer && e.stopInMutex()
+		e.playbackMutex.Unlock()
+		if stopped {
+			e.overrider.DonePlaying().Notify(true)
 		}
+	})
+	e.endTimer = timer
+}
+
+func (e *CommonQueueEntry) stopEndTimerInMutex() {
+	if e.endTimer != nil {
+		e.endTimer.Stop()
+		e.endTimer = nil
+	}
+}
+
+// Pause implements the QueueEntry interface
+func (e *CommonQueueEntry) Pause() {
+	e.playbackMutex.Lock()
+	defer e.playbackMutex.Unlock()
+	if !e.playingInMutex() || !e.pausedAt.IsZero() {
+		return
+	}
+	e.pausedAt = time.Now()
+	e.stopEndTimerInMutex()
+}
+
+// PauseAt implements the QueueEntry interface
+func (e *CommonQueueEntry) PauseAt(playedFor time.Duration) {
+	e.playbackMutex.Lock()
+	defer e.playbackMutex.Unlock()
+	if !e.playingInMutex() {
+		return
+	}
+	now := time.Now()
+	e.startedPlaying = now.Add(-playedFor)
+	e.pausedFor = 0
+	e.pausedAt = now
+	e.stopEndTimerInMutex()
+}
+
+// Resume implements the QueueEntry interface
+func (e *CommonQueueEntry) Resume() {
+	e.playbackMutex.Lock()
+	defer e.playbackMutex.Unlock()
+	if !e.playingInMutex() || e.pausedAt.IsZero() {
+		return
+	}
+	e.pausedFor += time.Since(e.pausedAt)
+	e.pausedAt = time.Time{}
+	remaining := e.mediaInfo.Length() - e.playedForInMutex()
+	if remaining < 0 {
+		remaining = 0
+	}
+	e.startEndTimerInMutex(remaining)
+}
 
-	}()
+// Paused implements the QueueEntry interface
+func (e *CommonQueueEntry) Paused() bool {
+	e.playbackMutex.RLock()
+	defer e.playbackMutex.RUnlock()
+	return !e.pausedAt.IsZero()
 }
 
 // Played implements the QueueEntry interface
 func (e *CommonQueueEntry) Played() bool {
+	e.playbackMutex.RLock()
+	defer e.playbackMutex.RUnlock()
 	return e.played
 }
 
 // Stop implements the QueueEntry interface
 func (e *CommonQueueEntry) Stop() {
-	if !e.overrider.Playing() {
-		return
+	e.playbackMutex.Lock()
+	stopped := e.stopInMutex()
+	e.playbackMutex.Unlock()
+	// notify outside of the mutex, as subscribers may look at the playback state
+	if stopped {
+		e.overrider.DonePlaying().Notify(true)
+	}
+}
+
+// stopInMutex marks the entry as played and returns true, if it was playing
+func (e *CommonQueueEntry) stopInMutex() bool {
+	if !e.playingInMutex() {
+		return false
 	}
 	e.played = true
 	e.stoppedPlaying = time.Now()
-	e.overrider.DonePlaying().Notify(true)
+	e.stopEndTimerInMutex()
+	return true
 }
 
 // Playing implements the QueueEntry interface
 func (e *CommonQueueEntry) Playing() bool {
+	e.playbackMutex.RLock()
+	defer e.playbackMutex.RUnlock()
+	return e.playingInMutex()
+}
+
+func (e *CommonQueueEntry) playingInMutex() bool {
 	return !e.startedPlaying.IsZero() && !e.played
 }
 
-// PlayedFor implements the QueueEntry interface
+// PlayedFor implements the QueueEntry interface. Time spent paused does not count
 func (e *CommonQueueEntry) PlayedFor() time.Duration {
-	if !e.overrider.Playing() {
-		return e.stoppedPlaying.Sub(e.startedPlaying)
+	e.playbackMutex.RLock()
+	defer e.playbackMutex.RUnlock()
+	return e.playedForInMutex()
+}
+
+func (e *CommonQueueEntry) playedForInMutex() time.Duration {
+	until := time.Now()
+	if !e.playingInMutex() {
+		until = e.stoppedPlaying
+	}
+	if !e.pausedAt.IsZero() {
+		until = e.pausedAt
 	}
-	return time.Since(e.startedPlaying)
+	if until.Before(e.startedPlaying) {
+		return 0
+	}
+	return until.Sub(e.startedPlaying) - e.pausedFor
 }
 
 // DonePlaying implements the QueueEntry interface
@@ -127,6 +229,28 @@ func (e *CommonQueueEntry) SetRequestCost(amount payment.Amount) {
 	e.requestCost = amount
 }
 
+// RequestCostCurrency implements the QueueEntry interface
+func (e *CommonQueueEntry) RequestCostCurrency() payment.Currency {
+	if e.requestCostCurrency == "" {
+		return payment.HomeCurrency
+	}
+	return e.requestCostCurrency
+}
+
+// RequestCostInCurrency implements the QueueEntry interface
+func (e *CommonQueueEntry) RequestCostInCurrency() payment.Amount {
+	if e.requestCostCurrency == "" || e.requestCostCurrency == payment.HomeCurrency {
+		return e.requestCost
+	}
+	return e.requestCostInCurrency
+}
+
+// SetRequestCostInCurrency implements the QueueEntry interface
+func (e *CommonQueueEntry) SetRequestCostInCurrency(currency payment.Currency, amount payment.Amount) {
+	e.requestCostCurrency = currency
+	e.requestCostInCurrency = amount
+}
+
 // RequestedAt implements the Performance interface
 func (e *CommonQueueEntry) RequestedAt() time.Time {
 	return e.requestedAt
@@ -147,6 +271,8 @@ func (e *CommonQueueEntry) SetUnskippable(unskippable bool) {
 
 // StartedAt implements the Performance interface
 func (e *CommonQueueEntry) StartedAt() time.Time {
+	e.playbackMutex.RLock()
+	defer e.playbackMutex.RUnlock()
 	return e.startedPlaying
 }
 
@@ -182,15 +308,17 @@ func (e *CommonQueueEntry) MovedBy() []string {
 
 func (e *CommonQueueEntry) BaseProducePlayedMedia(mediaType types.MediaType, mediaID string, mediaInfo interface{}) (*types.PlayedMedia, error) {
 	playedMedia := &types.PlayedMedia{
-		ID:          e.overrider.PerformanceID(),
-		EnqueuedAt:  e.overrider.RequestedAt(),
-		MediaLength: types.Duration(e.mediaInfo.Length()),
-		MediaOffset: types.Duration(e.mediaInfo.Offset()),
-		RequestedBy: e.overrider.RequestedBy().Address(),
-		RequestCost: e.overrider.RequestCost().Decimal(),
-		Unskippable: e.overrider.Unskippable(),
-		MediaType:   mediaType,
-		MediaID:     mediaID,
+		ID:                    e.overrider.PerformanceID(),
+		EnqueuedAt:            e.overrider.RequestedAt(),
+		MediaLength:           types.Duration(e.mediaInfo.Length()),
+		MediaOffset:           types.Duration(e.mediaInfo.Offset()),
+		RequestedBy:           e.overrider.RequestedBy().Address(),
+		RequestCost:           e.overrider.RequestCost().Decimal(),
+		RequestCostCurrency:   string(e.overrider.RequestCostCurrency()),
+		RequestCostInCurrency: e.overrider.RequestCostInCurrency().Decimal(),
+		Unskippable:           e.overrider.Unskippable(),
+		MediaType:             mediaType,
+		MediaID:               mediaID,
 	}
 
 	if mediaInfo != nil {
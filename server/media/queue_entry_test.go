@@ -0,0 +1,79 @@
+package media_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/server/media/applicationpage"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/event"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestQueueEntry(length time.Duration) media.QueueEntry {
+	return applicationpage.NewApplicationPageQueueEntry("app", types.ApplicationVersion(time.Now()), "page", "Page", "",
+		length, auth.UnknownUser, payment.NewAmount(), false, false)
+}
+
+func TestPausedQueueEntryDoesNotEnd(t *testing.T) {
+	entry := newTestQueueEntry(50 * time.Millisecond)
+	entry.Play()
+	entry.Pause()
+	require.True(t, entry.Paused())
+	playedFor := entry.PlayedFor()
+
+	time.Sleep(100 * time.Millisecond)
+	require.True(t, entry.Playing())
+	require.Equal(t, playedFor, entry.PlayedFor())
+
+	entry.Resume()
+	require.False(t, entry.Paused())
+	require.Eventually(t, entry.Played, 5*time.Second, 5*time.Millisecond)
+	require.GreaterOrEqual(t, entry.PlayedFor(), 50*time.Millisecond)
+}
+
+func TestQueueEntryPauseAt(t *testing.T) {
+	entry := newTestQueueEntry(time.Hour)
+	entry.Play()
+	entry.PauseAt(59 * time.Minute)
+	require.True(t, entry.Paused())
+	require.Equal(t, 59*time.Minute, entry.PlayedFor())
+
+	entry.Stop()
+	require.True(t, entry.Played())
+	require.False(t, entry.Playing())
+}
+
+func TestQueueEntryPlaybackStateIsSafeForConcurrentUse(t *testing.T) {
+	// the entry ends on the end timer goroutine while it is being paused and resumed
+	entry := newTestQueueEntry(20 * time.Millisecond)
+	done, unsubscribe := entry.DonePlaying().Subscribe(event.BufferFirst)
+	defer unsubscribe()
+	entry.Play()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for entry.Playing() {
+				entry.Pause()
+				_ = entry.PlayedFor()
+				entry.Resume()
+				_ = entry.Paused()
+			}
+		}()
+	}
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "entry did not finish playing")
+	}
+	wg.Wait()
+	require.True(t, entry.Played())
+}
@@ -0,0 +1,232 @@
+package media
+
+import (
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// ReplayRule determines whether media may be enqueued, given its recent plays and the entries already in the queue
+type ReplayRule struct {
+	// Cooldown is for how long after a play ends, plays of the same media that overlap with it are refused
+	Cooldown time.Duration
+	// OverlapTolerance is how much the candidate play may overlap with other plays of the same media.
+	// It is capped to 10% of the total media length
+	OverlapTolerance time.Duration
+	// MaxBroadcastPlayTime is for how long the same live broadcast may be played (or be in the queue) within a period
+	// of Cooldown + MaxBroadcastPlayTime
+	MaxBroadcastPlayTime time.Duration
+}
+
+// DefaultReplayRules are the rules that apply to each media type when no configured rule matches.
+// Media types without a default rule are only subject to replay checks when a rule is configured for them
+var DefaultReplayRules = map[types.MediaType]ReplayRule{
+	types.MediaTypeYouTubeVideo: {
+		Cooldown:             2 * time.Hour,
+		OverlapTolerance:     1 * time.Minute,
+		MaxBroadcastPlayTime: 2 * time.Hour,
+	},
+	types.MediaTypeSoundCloudTrack: {
+		Cooldown:         2 * time.Hour,
+		OverlapTolerance: 1 * time.Minute,
+	},
+	types.MediaTypeDirectMedia: {
+		Cooldown:         2 * time.Hour,
+		OverlapTolerance: 1 * time.Minute,
+	},
+}
+
+// ReplayCandidate describes media that is about to be enqueued
+type ReplayCandidate struct {
+	MediaType     types.MediaType
+	MediaID       string
+	Collections   []CollectionKey
+	Requester     auth.User // nil if unknown
+	Offset        time.Duration
+	Length        time.Duration
+	TotalLength   time.Duration // length of the whole media, regardless of offsets. Not relevant for live broadcasts
+	LiveBroadcast bool
+}
+
+// ReplayRuleFromConfigured converts a configured media replay rule into a ReplayRule
+func ReplayRuleFromConfigured(rule *types.MediaReplayRule) ReplayRule {
+	return ReplayRule{
+		Cooldown:             time.Duration(rule.Cooldown),
+		OverlapTolerance:     time.Duration(rule.OverlapTolerance),
+		MaxBroadcastPlayTime: time.Duration(rule.MaxBroadcastPlayTime),
+	}
+}
+
+// MostSpecificReplayRule returns the most specific of the configured rules, or nil if rules is empty.
+// Rules for a requester are more specific than rules for a collection, which are more specific than rules for a whole
+// media type. Among equally specific rules, the first one wins
+func MostSpecificReplayRule(rules []*types.MediaReplayRule) *types.MediaReplayRule {
+	var best *types.MediaReplayRule
+	bestSpecificity := -1
+	for _, rule := range rules {
+		specificity := 0
+		if rule.Requester != nil {
+			specificity += 2
+		}
+		if rule.CollectionType != nil {
+			specificity++
+		}
+		if specificity > bestSpecificity {
+			best, bestSpecificity = rule, specificity
+		}
+	}
+	return best
+}
+
+// ResolveReplayRule returns the rule that applies to the candidate: the most specific configured rule or, if no
+// configured rule matches, the default rule for its media type. Returns false if no rule applies
+func ResolveReplayRule(ctx transaction.WrappingContext, candidate ReplayCandidate) (ReplayRule, bool, error) {
+	collectionTypes := make([]types.MediaCollectionType, len(candidate.Collections))
+	collectionIDs := make([]string, len(candidate.Collections))
+	for i, collection := range candidate.Collections {
+		collectionTypes[i] = collection.Type
+		collectionIDs[i] = collection.ID
+	}
+	requester := ""
+	if candidate.Requester != nil && !candidate.Requester.IsUnknown() {
+		requester = candidate.Requester.Address()
+	}
+
+	rules, err := types.GetMediaReplayRulesForMedia(ctx, candidate.MediaType, collectionTypes, collectionIDs, requester)
+	if err != nil {
+		return ReplayRule{}, false, stacktrace.Propagate(err, "")
+	}
+	if rule := MostSpecificReplayRule(rules); rule != nil {
+		return ReplayRuleFromConfigured(rule), true, nil
+	}
+
+	rule, ok := DefaultReplayRules[candidate.MediaType]
+	return rule, ok, nil
+}
+
+// CheckReplayRules checks the candidate against the replay rule that applies to it, if any.
+// Media providers should call this during ContinueEnqueueRequest, unless duplication checks are to be skipped
+func CheckReplayRules(ctx transaction.WrappingContext, mediaQueue MediaQueueStub, candidate ReplayCandidate) (EnqueueRequestCreationResult, error) {
+	rule, ok, err := ResolveReplayRule(ctx, candidate)
+	if err != nil {
+		return EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+	if !ok {
+		return EnqueueRequestCreationSucceeded, nil
+	}
+
+	if candidate.LiveBroadcast {
+		result, err := checkBroadcastReplay(ctx, mediaQueue, candidate, rule)
+		return result, stacktrace.Propagate(err, "")
+	}
+	result, err := checkMediaReplay(ctx, mediaQueue, candidate, rule)
+	return result, stacktrace.Propagate(err, "")
+}
+
+func checkMediaReplay(ctx transaction.WrappingContext, mediaQueue MediaQueueStub, candidate ReplayCandidate, rule ReplayRule) (EnqueueRequestCreationResult, error) {
+	toleranceMargin := rule.OverlapTolerance
+	if candidate.TotalLength/10 < toleranceMargin {
+		toleranceMargin = candidate.TotalLength / 10
+	}
+
+	candidatePeriod := playPeriod{candidate.Offset + toleranceMargin, candidate.Offset + candidate.Length - toleranceMargin}
+	if candidatePeriod.start > candidatePeriod.end {
+		candidatePeriod.start = candidatePeriod.end
+	}
+	// check range overlap with enqueued entries
+	for _, entry := range mediaQueue.Entries() {
+		mediaInfo := entry.MediaInfo()
+		entryType, entryMediaID := mediaInfo.MediaID()
+		if entryType == candidate.MediaType && entryMediaID == candidate.MediaID {
+			enqueuedPeriod := playPeriod{mediaInfo.Offset(), mediaInfo.Offset() + mediaInfo.Length()}
+			if periodsOverlap(enqueuedPeriod, candidatePeriod) {
+				return EnqueueRequestCreationFailedMediumIsAlreadyInQueue, nil
+			}
+		}
+	}
+
+	if rule.Cooldown <= 0 {
+		return EnqueueRequestCreationSucceeded, nil
+	}
+
+	now := time.Now()
+
+	// check range overlap with previously played entries
+	lookback := rule.Cooldown + candidate.TotalLength
+	lastPlays, err := types.LastPlaysOfMedia(ctx, now.Add(-lookback), candidate.MediaType, candidate.MediaID)
+	if err != nil {
+		return EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+	for _, play := range lastPlays {
+		endedAt := now
+		if play.EndedAt.Valid {
+			endedAt = play.EndedAt.Time
+		}
+		playedFor := endedAt.Sub(play.StartedAt)
+		playedPeriod := playPeriod{time.Duration(play.MediaOffset), time.Duration(play.MediaOffset) + playedFor}
+
+		if periodsOverlap(playedPeriod, candidatePeriod) {
+			return EnqueueRequestCreationFailedMediumPlayedTooRecently, nil
+		}
+	}
+
+	return EnqueueRequestCreationSucceeded, nil
+}
+
+func checkBroadcastReplay(ctx transaction.WrappingContext, mediaQueue MediaQueueStub, candidate ReplayCandidate, rule ReplayRule) (EnqueueRequestCreationResult, error) {
+	if rule.MaxBroadcastPlayTime <= 0 {
+		return EnqueueRequestCreationSucceeded, nil
+	}
+
+	// check total enqueued length
+	totalLength := candidate.Length
+	for idx, entry := range mediaQueue.Entries() {
+		if idx == 0 {
+			// current entry will already be counted below
+			continue
+		}
+		mediaInfo := entry.MediaInfo()
+		entryType, entryMediaID := mediaInfo.MediaID()
+		if entryType == candidate.MediaType && entryMediaID == candidate.MediaID {
+			totalLength += mediaInfo.Length()
+		}
+	}
+	if totalLength > rule.MaxBroadcastPlayTime {
+		return EnqueueRequestCreationFailedMediumIsAlreadyInQueue, nil
+	}
+
+	now := time.Now()
+
+	// add total played length
+	lookback := rule.Cooldown + rule.MaxBroadcastPlayTime
+	lastPlays, err := types.LastPlaysOfMedia(ctx, now.Add(-lookback), candidate.MediaType, candidate.MediaID)
+	if err != nil {
+		return EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+	for _, play := range lastPlays {
+		endedAt := now
+		if play.EndedAt.Valid {
+			endedAt = play.EndedAt.Time
+		}
+		playedFor := endedAt.Sub(play.StartedAt)
+		totalLength += playedFor
+	}
+
+	if totalLength > rule.MaxBroadcastPlayTime {
+		return EnqueueRequestCreationFailedMediumPlayedTooRecently, nil
+	}
+
+	return EnqueueRequestCreationSucceeded, nil
+}
+
+type playPeriod struct {
+	start time.Duration
+	end   time.Duration
+}
+
+func periodsOverlap(first, second playPeriod) bool {
+	return first.start <= second.end && first.end >= second.start
+}
@@ -0,0 +1,31 @@
+package media_test
+
+import (
+	"testing"
+
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMostSpecificReplayRule(t *testing.T) {
+	collectionType := types.MediaCollectionTypeYouTubeChannel
+	collectionID := "UC123"
+	requester := "ban_1abc"
+
+	mediaTypeRule := &types.MediaReplayRule{ID: "media type"}
+	collectionRule := &types.MediaReplayRule{ID: "collection", CollectionType: &collectionType, CollectionID: &collectionID}
+	requesterRule := &types.MediaReplayRule{ID: "requester", Requester: &requester}
+	bothRule := &types.MediaReplayRule{ID: "both", CollectionType: &collectionType, CollectionID: &collectionID, Requester: &requester}
+
+	require.Nil(t, media.MostSpecificReplayRule(nil))
+	require.Equal(t, mediaTypeRule, media.MostSpecificReplayRule([]*types.MediaReplayRule{mediaTypeRule}))
+	require.Equal(t, collectionRule, media.MostSpecificReplayRule([]*types.MediaReplayRule{mediaTypeRule, collectionRule}))
+	require.Equal(t, requesterRule, media.MostSpecificReplayRule([]*types.MediaReplayRule{collectionRule, requesterRule, mediaTypeRule}))
+	require.Equal(t, bothRule, media.MostSpecificReplayRule([]*types.MediaReplayRule{requesterRule, bothRule, collectionRule}))
+
+	// among equally specific rules, the first one wins
+	otherMediaTypeRule := &types.MediaReplayRule{ID: "other media type"}
+	require.Equal(t, otherMediaTypeRule, media.MostSpecificReplayRule([]*types.MediaReplayRule{otherMediaTypeRule, mediaTypeRule}))
+}
@@ -94,12 +94,7 @@ func (c *TrackProvider) BeginEnqueueRequest(ctx transaction.WrappingContext, med
 		return nil, media.EnqueueRequestCreationFailedMediumIsNotATrack, nil
 	}
 
-	if response.EmbeddableBy != "all" ||
-		!response.Public ||
-		response.Sharing != "public" ||
-		!response.Streamable ||
-		response.Policy == "SNIP" ||
-		response.MonetizationModel == "SUB_HIGH_TIER" {
+	if !trackIsEmbeddable(response) {
 		return nil, media.EnqueueRequestCreationFailedMediumIsNotEmbeddable, nil
 	}
 
@@ -112,6 +107,15 @@ func (c *TrackProvider) BeginEnqueueRequest(ctx transaction.WrappingContext, med
 	}, media.EnqueueRequestCreationSucceeded, nil
 }
 
+func trackIsEmbeddable(response *APIResponse) bool {
+	return response.EmbeddableBy == "all" &&
+		response.Public &&
+		response.Sharing == "public" &&
+		response.Streamable &&
+		response.Policy != "SNIP" &&
+		response.MonetizationModel != "SUB_HIGH_TIER"
+}
+
 func (c *TrackProvider) ContinueEnqueueRequest(ctx transaction.WrappingContext, genericInfo media.InitialInfo, unskippable, concealed, anonymous,
 	allowUnpopular, skipLengthChecks, skipDuplicationChecks bool) (media.EnqueueRequest, media.EnqueueRequestCreationResult, error) {
 	ctx, err := transaction.Begin(ctx)
@@ -161,8 +165,18 @@ func (c *TrackProvider) ContinueEnqueueRequest(ctx transaction.WrappingContext,
 		return nil, media.EnqueueRequestCreationFailed, stacktrace.Propagate(err, "track start offset past end of track")
 	}
 
+	userClaims := authinterceptor.UserFromContext(ctx)
+
 	if !skipDuplicationChecks {
-		result, err := c.checkSoundCloudTrackContentDuplication(ctx, preInfo.id, startOffsetDuration, playFor, trackDuration)
+		result, err := media.CheckReplayRules(ctx, c.mediaQueue, media.ReplayCandidate{
+			MediaType:   types.MediaTypeSoundCloudTrack,
+			MediaID:     preInfo.id,
+			Collections: preInfo.Collections(),
+			Requester:   userClaims,
+			Offset:      startOffsetDuration,
+			Length:      playFor,
+			TotalLength: trackDuration,
+		})
 		if err != nil || result != media.EnqueueRequestCreationSucceeded {
 			return nil, result, stacktrace.Propagate(err, "")
 		}
@@ -182,7 +196,6 @@ func (c *TrackProvider) ContinueEnqueueRequest(ctx transaction.WrappingContext,
 	request.SetUnskippable(unskippable)
 	request.SetConcealed(concealed)
 
-	userClaims := authinterceptor.UserFromContext(ctx)
 	if userClaims != nil && !anonymous {
 		request.SetRequestedBy(userClaims)
 	}
@@ -190,52 +203,6 @@ func (c *TrackProvider) ContinueEnqueueRequest(ctx transaction.WrappingContext,
 	return request, media.EnqueueRequestCreationSucceeded, nil
 }
 
-func (s *TrackProvider) checkSoundCloudTrackContentDuplication(ctx transaction.WrappingContext, trackID string, offset, length, totalTrackLength time.Duration) (media.EnqueueRequestCreationResult, error) {
-	toleranceMargin := 1 * time.Minute
-	if totalTrackLength/10 < toleranceMargin {
-		toleranceMargin = totalTrackLength / 10
-	}
-
-	candidatePeriod := playPeriod{offset + toleranceMargin, offset + length - toleranceMargin}
-	if candidatePeriod.start > candidatePeriod.end {
-		candidatePeriod.start = candidatePeriod.end
-	}
-	// check range overlap with enqueued entries
-	for _, entry := range s.mediaQueue.Entries() {
-		mediaInfo := entry.MediaInfo()
-		entryType, entryMediaID := mediaInfo.MediaID()
-		if entryType == types.MediaTypeSoundCloudTrack && entryMediaID == trackID {
-			enqueuedPeriod := playPeriod{mediaInfo.Offset(), mediaInfo.Offset() + mediaInfo.Length()}
-			if periodsOverlap(enqueuedPeriod, candidatePeriod) {
-				return media.EnqueueRequestCreationFailedMediumIsAlreadyInQueue, nil
-			}
-		}
-	}
-
-	now := time.Now()
-
-	// check range overlap with previously played entries
-	lookback := 2*time.Hour + totalTrackLength
-	lastPlays, err := types.LastPlaysOfMedia(ctx, now.Add(-lookback), types.MediaTypeSoundCloudTrack, trackID)
-	if err != nil {
-		return media.EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
-	}
-	for _, play := range lastPlays {
-		endedAt := now
-		if play.EndedAt.Valid {
-			endedAt = play.EndedAt.Time
-		}
-		playedFor := endedAt.Sub(play.StartedAt)
-		playedPeriod := playPeriod{time.Duration(play.MediaOffset), time.Duration(play.MediaOffset) + playedFor}
-
-		if periodsOverlap(playedPeriod, candidatePeriod) {
-			return media.EnqueueRequestCreationFailedMediumPlayedTooRecently, nil
-		}
-	}
-
-	return media.EnqueueRequestCreationSucceeded, nil
-}
-
 type APIResponse struct {
 	ArtworkURL        string               `json:"artwork_url"`
 	Duration          int64                `json:"duration"`
@@ -265,37 +232,48 @@ type APIUser struct {
 }
 
 func (c *TrackProvider) TrackInfo(trackURL string) (*APIResponse, error) {
-	var err error
-	trackURL, err = c.resolvePermalink(trackURL)
+	var responseData APIResponse
+	err := c.resolve(trackURL, &responseData)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
+	return &responseData, nil
+}
+
+func (c *TrackProvider) resolve(permalink string, responseData interface{}) error {
+	permalink, err := c.resolvePermalink(permalink)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
 	query := url.Values{}
-	query.Set("url", trackURL)
+	query.Set("url", permalink)
 	query.Set("format", "json")
+
+	return stacktrace.Propagate(c.apiGet("resolve", query, responseData), "")
+}
+
+func (c *TrackProvider) apiGet(path string, query url.Values, responseData interface{}) error {
 	query.Set("client_id", c.clientID)
 	query.Set("app_version", c.appVersion)
 
 	url := url.URL{
 		Scheme:   "https",
 		Host:     c.apiHost,
-		Path:     "resolve",
+		Path:     path,
 		RawQuery: query.Encode(),
 	}
 	response, err := c.httpClient.Get(url.String())
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "")
+		return stacktrace.Propagate(err, "")
 	}
 
 	defer response.Body.Close()
 
-	var responseData APIResponse
-	err = sonic.ConfigDefault.NewDecoder(response.Body).Decode(&responseData)
+	err = sonic.ConfigDefault.NewDecoder(response.Body).Decode(responseData)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "")
+		return stacktrace.Propagate(err, "")
 	}
-
-	return &responseData, nil
+	return nil
 }
 
 func (c *TrackProvider) resolvePermalink(trackURLString string) (string, error) {
@@ -320,12 +298,3 @@ func (c *TrackProvider) resolvePermalink(trackURLString string) (string, error)
 func parseSoundCloudDuration(duration int64) time.Duration {
 	return time.Duration(duration) * time.Millisecond
 }
-
-type playPeriod struct {
-	start time.Duration
-	end   time.Duration
-}
-
-func periodsOverlap(first, second playPeriod) bool {
-	return first.start <= second.end && first.end >= second.start
-}
@@ -0,0 +1,121 @@
+package soundcloud
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// APISetResponse is the response of the SoundCloud API when resolving a set (playlist or album)
+type APISetResponse struct {
+	Kind   string         `json:"kind"`
+	ID     int64          `json:"id"`
+	Title  string         `json:"title"`
+	Tracks []*APIResponse `json:"tracks"`
+}
+
+func (c *TrackProvider) CanHandleCollectionRequestType(mediaParameters proto.IsEnqueueMediaRequest_MediaInfo) bool {
+	_, ok := mediaParameters.(*proto.EnqueueMediaRequest_SoundcloudSetData)
+	return ok
+}
+
+func (c *TrackProvider) BeginCollectionEnqueueRequest(ctx transaction.WrappingContext, mediaParameters proto.IsEnqueueMediaRequest_MediaInfo) ([]media.CollectionItemInitialInfo, media.EnqueueRequestCreationResult, error) {
+	ctx, err := transaction.Begin(ctx)
+	if err != nil {
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	setParameters, ok := mediaParameters.(*proto.EnqueueMediaRequest_SoundcloudSetData)
+	if !ok {
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.NewError("invalid parameter type for SoundCloud track provider")
+	}
+
+	set, err := c.SetInfo(setParameters.SoundcloudSetData.GetPermalink())
+	if err != nil {
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+
+	if set.Kind != "playlist" {
+		return nil, media.EnqueueRequestCreationFailedMediumNotFound, nil
+	}
+
+	if len(set.Tracks) == 0 {
+		return nil, media.EnqueueRequestCreationFailedCollectionIsEmpty, nil
+	}
+
+	results := make([]media.CollectionItemInitialInfo, len(set.Tracks))
+	for i, track := range set.Tracks {
+		results[i].Title = track.Title
+		if track.Kind != "track" {
+			results[i].Result = media.EnqueueRequestCreationFailedMediumIsNotATrack
+			continue
+		}
+		if !trackIsEmbeddable(track) {
+			results[i].Result = media.EnqueueRequestCreationFailedMediumIsNotEmbeddable
+			continue
+		}
+		results[i].Result = media.EnqueueRequestCreationSucceeded
+		results[i].Info = &initialInfo{
+			id:       strconv.FormatInt(track.ID, 10),
+			response: track,
+			parameters: &proto.EnqueueMediaRequest_SoundcloudTrackData{
+				SoundcloudTrackData: &proto.EnqueueSoundCloudTrackData{
+					Permalink: track.PermalinkURL,
+				},
+			},
+		}
+	}
+
+	return results, media.EnqueueRequestCreationSucceeded, nil
+}
+
+// SetInfo returns information about a SoundCloud set, including the full information of up to
+// media.MaxCollectionEnqueueItems of its tracks
+func (c *TrackProvider) SetInfo(setURL string) (*APISetResponse, error) {
+	var set APISetResponse
+	err := c.resolve(setURL, &set)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	if len(set.Tracks) > media.MaxCollectionEnqueueItems {
+		set.Tracks = set.Tracks[:media.MaxCollectionEnqueueItems]
+	}
+
+	// the API only includes the full information for the first few tracks of the set; the remaining ones only contain
+	// the track ID and must be fetched separately
+	missingIDs := []string{}
+	for _, track := range set.Tracks {
+		if track.Title == "" {
+			missingIDs = append(missingIDs, strconv.FormatInt(track.ID, 10))
+		}
+	}
+	if len(missingIDs) == 0 {
+		return &set, nil
+	}
+
+	query := url.Values{}
+	query.Set("ids", strings.Join(missingIDs, ","))
+	var tracks []*APIResponse
+	err = c.apiGet("tracks", query, &tracks)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	tracksByID := make(map[int64]*APIResponse, len(tracks))
+	for _, track := range tracks {
+		tracksByID[track.ID] = track
+	}
+	for i, track := range set.Tracks {
+		if fullTrack, ok := tracksByID[track.ID]; ok && track.Title == "" {
+			set.Tracks[i] = fullTrack
+		}
+	}
+	return &set, nil
+}
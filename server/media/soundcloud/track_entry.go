@@ -49,42 +49,46 @@ func (e *queueEntrySoundCloudTrack) SerializeForAPIQueue(ctx context.Context) pr
 }
 
 type queueEntrySoundCloudTrackJsonRepresentation struct {
-	QueueID      string
-	Type         string
-	ID           string
-	Title        string
-	Uploader     string
-	Artist       string
-	Permalink    string
-	ThumbnailURL string
-	Duration     time.Duration
-	Offset       time.Duration
-	RequestedBy  string
-	RequestCost  *big.Int
-	RequestedAt  time.Time
-	Unskippable  bool
-	Concealed    bool
-	MovedBy      []string
+	QueueID               string
+	Type                  string
+	ID                    string
+	Title                 string
+	Uploader              string
+	Artist                string
+	Permalink             string
+	ThumbnailURL          string
+	Duration              time.Duration
+	Offset                time.Duration
+	RequestedBy           string
+	RequestCost           *big.Int
+	RequestCostCurrency   string
+	RequestCostInCurrency *big.Int
+	RequestedAt           time.Time
+	Unskippable           bool
+	Concealed             bool
+	MovedBy               []string
 }
 
 func (e *queueEntrySoundCloudTrack) MarshalJSON() ([]byte, error) {
 	j, err := sonic.Marshal(queueEntrySoundCloudTrackJsonRepresentation{
-		QueueID:      e.PerformanceID(),
-		Type:         string(types.MediaTypeSoundCloudTrack),
-		ID:           e.id,
-		Title:        e.Title(),
-		Uploader:     e.uploader,
-		Artist:       e.artist,
-		Permalink:    e.permalink,
-		ThumbnailURL: e.thumbnailURL,
-		Duration:     e.Length(),
-		Offset:       e.Offset(),
-		RequestedBy:  e.RequestedBy().Address(),
-		RequestCost:  e.RequestCost().Int,
-		RequestedAt:  e.RequestedAt(),
-		Unskippable:  e.Unskippable(),
-		Concealed:    e.Concealed(),
-		MovedBy:      e.MovedBy(),
+		QueueID:               e.PerformanceID(),
+		Type:                  string(types.MediaTypeSoundCloudTrack),
+		ID:                    e.id,
+		Title:                 e.Title(),
+		Uploader:              e.uploader,
+		Artist:                e.artist,
+		Permalink:             e.permalink,
+		ThumbnailURL:          e.thumbnailURL,
+		Duration:              e.Length(),
+		Offset:                e.Offset(),
+		RequestedBy:           e.RequestedBy().Address(),
+		RequestCost:           e.RequestCost().Int,
+		RequestCostCurrency:   string(e.RequestCostCurrency()),
+		RequestCostInCurrency: e.RequestCostInCurrency().Int,
+		RequestedAt:           e.RequestedAt(),
+		Unskippable:           e.Unskippable(),
+		Concealed:             e.Concealed(),
+		MovedBy:               e.MovedBy(),
 	})
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "error serializing queue entry %s", e.PerformanceID())
@@ -110,6 +114,9 @@ func (e *queueEntrySoundCloudTrack) UnmarshalJSON(b []byte) error {
 	e.SetOffset(t.Offset)
 	e.SetRequestedBy(auth.NewAddressOnlyUser(t.RequestedBy))
 	e.SetRequestCost(payment.NewAmount(t.RequestCost))
+	if t.RequestCostInCurrency != nil {
+		e.SetRequestCostInCurrency(payment.Currency(t.RequestCostCurrency), payment.NewAmount(t.RequestCostInCurrency))
+	}
 	e.SetRequestedAt(t.RequestedAt)
 	e.SetUnskippable(t.Unskippable)
 	e.SetConcealed(t.Concealed)
@@ -0,0 +1,102 @@
+package youtube
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/media"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/youtube/v3"
+)
+
+func (c *VideoProvider) CanHandleCollectionRequestType(mediaParameters proto.IsEnqueueMediaRequest_MediaInfo) bool {
+	_, ok := mediaParameters.(*proto.EnqueueMediaRequest_YoutubePlaylistData)
+	return ok
+}
+
+func (c *VideoProvider) BeginCollectionEnqueueRequest(ctx transaction.WrappingContext, mediaParameters proto.IsEnqueueMediaRequest_MediaInfo) ([]media.CollectionItemInitialInfo, media.EnqueueRequestCreationResult, error) {
+	ctx, err := transaction.Begin(ctx)
+	if err != nil {
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	playlistParameters, ok := mediaParameters.(*proto.EnqueueMediaRequest_YoutubePlaylistData)
+	if !ok {
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.NewError("invalid parameter type for YouTube video provider")
+	}
+
+	// we make two API calls: one to list the playlist items and another to get the details of the videos
+	for i := 0; i < 2; i++ {
+		_, _, _, ok, err = c.globalRateLimiter.Take(ctx, globalRateLimiterKey)
+		if err != nil {
+			return nil, media.EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+		}
+		if !ok {
+			return nil, media.EnqueueRequestCreationFailed, stacktrace.NewError("global rate limit for YouTube provider reached")
+		}
+	}
+
+	itemsResponse, err := c.youtube.PlaylistItems.
+		List([]string{"snippet", "contentDetails"}).
+		PlaylistId(playlistParameters.YoutubePlaylistData.GetId()).
+		MaxResults(media.MaxCollectionEnqueueItems).Do()
+	if err != nil {
+		var apiErr *googleapi.Error
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+			return nil, media.EnqueueRequestCreationFailedMediumNotFound, nil
+		}
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+
+	if len(itemsResponse.Items) == 0 {
+		return nil, media.EnqueueRequestCreationFailedCollectionIsEmpty, nil
+	}
+
+	videoIDs := make([]string, len(itemsResponse.Items))
+	for i, item := range itemsResponse.Items {
+		videoIDs[i] = item.ContentDetails.VideoId
+	}
+
+	videosResponse, err := c.youtube.Videos.
+		List([]string{"snippet", "contentDetails", "status", "liveStreamingDetails"}).
+		Id(videoIDs...).
+		MaxResults(int64(len(videoIDs))).Do()
+	if err != nil {
+		return nil, media.EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
+	}
+
+	videosByID := make(map[string]*youtube.Video, len(videosResponse.Items))
+	for _, videoItem := range videosResponse.Items {
+		videosByID[videoItem.Id] = videoItem
+	}
+
+	results := make([]media.CollectionItemInitialInfo, len(itemsResponse.Items))
+	for i, item := range itemsResponse.Items {
+		// private and deleted videos remain in playlists, but are not returned when listing videos
+		results[i].Title = item.Snippet.Title
+		videoItem, ok := videosByID[item.ContentDetails.VideoId]
+		if !ok {
+			results[i].Result = media.EnqueueRequestCreationFailedMediumNotFound
+			continue
+		}
+
+		results[i].Result = checkVideoItem(videoItem)
+		if results[i].Result != media.EnqueueRequestCreationSucceeded {
+			continue
+		}
+		results[i].Info = &initialInfo{
+			videoItem: videoItem,
+			parameters: &proto.EnqueueMediaRequest_YoutubeVideoData{
+				YoutubeVideoData: &proto.EnqueueYouTubeVideoData{
+					Id: videoItem.Id,
+				},
+			},
+		}
+	}
+
+	return results, media.EnqueueRequestCreationSucceeded, nil
+}
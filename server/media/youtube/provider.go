@@ -111,22 +111,30 @@ func (c *VideoProvider) BeginEnqueueRequest(ctx transaction.WrappingContext, med
 
 	videoItem := response.Items[0]
 
+	result := checkVideoItem(videoItem)
+	if result != media.EnqueueRequestCreationSucceeded {
+		return nil, result, nil
+	}
+
+	return &initialInfo{
+		videoItem:  videoItem,
+		parameters: youTubeParameters,
+	}, media.EnqueueRequestCreationSucceeded, nil
+}
+
+func checkVideoItem(videoItem *youtube.Video) media.EnqueueRequestCreationResult {
 	if videoItem.ContentDetails.ContentRating.YtRating == "ytAgeRestricted" {
-		return nil, media.EnqueueRequestCreationFailedMediumAgeRestricted, nil
+		return media.EnqueueRequestCreationFailedMediumAgeRestricted
 	}
 
 	if !videoItem.Status.Embeddable {
-		return nil, media.EnqueueRequestCreationFailedMediumIsNotEmbeddable, nil
+		return media.EnqueueRequestCreationFailedMediumIsNotEmbeddable
 	}
 
 	if videoItem.Snippet.LiveBroadcastContent == "upcoming" {
-		return nil, media.EnqueueRequestCreationFailedMediumIsUpcomingLiveBroadcast, nil
+		return media.EnqueueRequestCreationFailedMediumIsUpcomingLiveBroadcast
 	}
-
-	return &initialInfo{
-		videoItem:  videoItem,
-		parameters: youTubeParameters,
-	}, media.EnqueueRequestCreationSucceeded, nil
+	return media.EnqueueRequestCreationSucceeded
 }
 
 func (c *VideoProvider) ContinueEnqueueRequest(ctx transaction.WrappingContext, genericInfo media.InitialInfo, unskippable, concealed, anonymous,
@@ -188,17 +196,21 @@ func (c *VideoProvider) ContinueEnqueueRequest(ctx transaction.WrappingContext,
 		return nil, media.EnqueueRequestCreationFailedMediumIsTooLong, nil
 	}
 
+	userClaims := authinterceptor.UserFromContext(ctx)
+
 	if !skipDuplicationChecks {
-		if preInfo.videoItem.Snippet.LiveBroadcastContent == "live" {
-			result, err := c.checkYouTubeBroadcastContentDuplication(ctx, preInfo.videoItem.Id, playFor)
-			if err != nil || result != media.EnqueueRequestCreationSucceeded {
-				return nil, result, stacktrace.Propagate(err, "")
-			}
-		} else {
-			result, err := c.checkYouTubeVideoContentDuplication(ctx, preInfo.videoItem.Id, startOffsetDuration, playFor, totalVideoDuration)
-			if err != nil || result != media.EnqueueRequestCreationSucceeded {
-				return nil, result, stacktrace.Propagate(err, "")
-			}
+		result, err := media.CheckReplayRules(ctx, c.mediaQueue, media.ReplayCandidate{
+			MediaType:     types.MediaTypeYouTubeVideo,
+			MediaID:       preInfo.videoItem.Id,
+			Collections:   preInfo.Collections(),
+			Requester:     userClaims,
+			Offset:        startOffsetDuration,
+			Length:        playFor,
+			TotalLength:   totalVideoDuration,
+			LiveBroadcast: preInfo.videoItem.Snippet.LiveBroadcastContent == "live",
+		})
+		if err != nil || result != media.EnqueueRequestCreationSucceeded {
+			return nil, result, stacktrace.Propagate(err, "")
 		}
 	}
 
@@ -215,106 +227,9 @@ func (c *VideoProvider) ContinueEnqueueRequest(ctx transaction.WrappingContext,
 	request.SetUnskippable(unskippable)
 	request.SetConcealed(concealed)
 
-	userClaims := authinterceptor.UserFromContext(ctx)
 	if userClaims != nil && !anonymous {
 		request.SetRequestedBy(userClaims)
 	}
 
 	return request, media.EnqueueRequestCreationSucceeded, nil
 }
-
-func (s *VideoProvider) checkYouTubeVideoContentDuplication(ctx transaction.WrappingContext, videoID string, offset, length, totalVideoLength time.Duration) (media.EnqueueRequestCreationResult, error) {
-	toleranceMargin := 1 * time.Minute
-	if totalVideoLength/10 < toleranceMargin {
-		toleranceMargin = totalVideoLength / 10
-	}
-
-	candidatePeriod := playPeriod{offset + toleranceMargin, offset + length - toleranceMargin}
-	if candidatePeriod.start > candidatePeriod.end {
-		candidatePeriod.start = candidatePeriod.end
-	}
-	// check range overlap with enqueued entries
-	for _, entry := range s.mediaQueue.Entries() {
-		mediaInfo := entry.MediaInfo()
-		entryType, entryMediaID := mediaInfo.MediaID()
-		if entryType == types.MediaTypeYouTubeVideo && entryMediaID == videoID {
-			enqueuedPeriod := playPeriod{mediaInfo.Offset(), mediaInfo.Offset() + mediaInfo.Length()}
-			if periodsOverlap(enqueuedPeriod, candidatePeriod) {
-				return media.EnqueueRequestCreationFailedMediumIsAlreadyInQueue, nil
-			}
-		}
-	}
-
-	now := time.Now()
-
-	// check range overlap with previously played entries
-	lookback := 2*time.Hour + totalVideoLength
-	lastPlays, err := types.LastPlaysOfMedia(ctx, now.Add(-lookback), types.MediaTypeYouTubeVideo, videoID)
-	if err != nil {
-		return media.EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
-	}
-	for _, play := range lastPlays {
-		endedAt := now
-		if play.EndedAt.Valid {
-			endedAt = play.EndedAt.Time
-		}
-		playedFor := endedAt.Sub(play.StartedAt)
-		playedPeriod := playPeriod{time.Duration(play.MediaOffset), time.Duration(play.MediaOffset) + playedFor}
-
-		if periodsOverlap(playedPeriod, candidatePeriod) {
-			return media.EnqueueRequestCreationFailedMediumPlayedTooRecently, nil
-		}
-	}
-
-	return media.EnqueueRequestCreationSucceeded, nil
-}
-
-func (s *VideoProvider) checkYouTubeBroadcastContentDuplication(ctx transaction.WrappingContext, videoID string, length time.Duration) (media.EnqueueRequestCreationResult, error) {
-	// check total enqueued length
-	totalLength := length
-	for idx, entry := range s.mediaQueue.Entries() {
-		if idx == 0 {
-			// current entry will already be counted below
-			continue
-		}
-		mediaInfo := entry.MediaInfo()
-		entryType, entryMediaID := mediaInfo.MediaID()
-		if entryType == types.MediaTypeYouTubeVideo && entryMediaID == videoID {
-			totalLength += mediaInfo.Length()
-		}
-	}
-	if totalLength > 2*time.Hour {
-		return media.EnqueueRequestCreationFailedMediumIsAlreadyInQueue, nil
-	}
-
-	now := time.Now()
-
-	// add total played length
-	lastPlays, err := types.LastPlaysOfMedia(ctx, now.Add(-4*time.Hour), types.MediaTypeYouTubeVideo, videoID)
-	if err != nil {
-		return media.EnqueueRequestCreationFailed, stacktrace.Propagate(err, "")
-	}
-	for _, play := range lastPlays {
-		endedAt := now
-		if play.EndedAt.Valid {
-			endedAt = play.EndedAt.Time
-		}
-		playedFor := endedAt.Sub(play.StartedAt)
-		totalLength += playedFor
-	}
-
-	if totalLength > 2*time.Hour {
-		return media.EnqueueRequestCreationFailedMediumPlayedTooRecently, nil
-	}
-
-	return media.EnqueueRequestCreationSucceeded, nil
-}
-
-type playPeriod struct {
-	start time.Duration
-	end   time.Duration
-}
-
-func periodsOverlap(first, second playPeriod) bool {
-	return first.start <= second.end && first.end >= second.start
-}
@@ -47,40 +47,44 @@ func (e *queueEntryYouTubeVideo) SerializeForAPIQueue(ctx context.Context) proto
 }
 
 type queueEntryYouTubeVideoJsonRepresentation struct {
-	QueueID       string
-	Type          string
-	ID            string
-	Title         string
-	ChannelTitle  string
-	ThumbnailURL  string
-	Duration      time.Duration
-	Offset        time.Duration
-	LiveBroadcast bool
-	RequestedBy   string
-	RequestCost   *big.Int
-	RequestedAt   time.Time
-	Unskippable   bool
-	Concealed     bool
-	MovedBy       []string
+	QueueID               string
+	Type                  string
+	ID                    string
+	Title                 string
+	ChannelTitle          string
+	ThumbnailURL          string
+	Duration              time.Duration
+	Offset                time.Duration
+	LiveBroadcast         bool
+	RequestedBy           string
+	RequestCost           *big.Int
+	RequestCostCurrency   string
+	RequestCostInCurrency *big.Int
+	RequestedAt           time.Time
+	Unskippable           bool
+	Concealed             bool
+	MovedBy               []string
 }
 
 func (e *queueEntryYouTubeVideo) MarshalJSON() ([]byte, error) {
 	j, err := sonic.Marshal(queueEntryYouTubeVideoJsonRepresentation{
-		QueueID:       e.PerformanceID(),
-		Type:          string(types.MediaTypeYouTubeVideo),
-		ID:            e.id,
-		Title:         e.Title(),
-		ChannelTitle:  e.channelTitle,
-		ThumbnailURL:  e.thumbnailURL,
-		Duration:      e.Length(),
-		Offset:        e.Offset(),
-		LiveBroadcast: e.liveBroadcast,
-		RequestedBy:   e.RequestedBy().Address(),
-		RequestCost:   e.RequestCost().Int,
-		RequestedAt:   e.RequestedAt(),
-		Unskippable:   e.Unskippable(),
-		Concealed:     e.Concealed(),
-		MovedBy:       e.MovedBy(),
+		QueueID:               e.PerformanceID(),
+		Type:                  string(types.MediaTypeYouTubeVideo),
+		ID:                    e.id,
+		Title:                 e.Title(),
+		ChannelTitle:          e.channelTitle,
+		ThumbnailURL:          e.thumbnailURL,
+		Duration:              e.Length(),
+		Offset:                e.Offset(),
+		LiveBroadcast:         e.liveBroadcast,
+		RequestedBy:           e.RequestedBy().Address(),
+		RequestCost:           e.RequestCost().Int,
+		RequestCostCurrency:   string(e.RequestCostCurrency()),
+		RequestCostInCurrency: e.RequestCostInCurrency().Int,
+		RequestedAt:           e.RequestedAt(),
+		Unskippable:           e.Unskippable(),
+		Concealed:             e.Concealed(),
+		MovedBy:               e.MovedBy(),
 	})
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "error serializing queue entry %s", e.PerformanceID())
@@ -105,6 +109,9 @@ func (e *queueEntryYouTubeVideo) UnmarshalJSON(b []byte) error {
 	e.liveBroadcast = t.LiveBroadcast
 	e.SetRequestedBy(auth.NewAddressOnlyUser(t.RequestedBy))
 	e.SetRequestCost(payment.NewAmount(t.RequestCost))
+	if t.RequestCostInCurrency != nil {
+		e.SetRequestCostInCurrency(payment.Currency(t.RequestCostCurrency), payment.NewAmount(t.RequestCostInCurrency))
+	}
 	e.SetRequestedAt(t.RequestedAt)
 	e.SetUnskippable(t.Unskippable)
 	e.SetConcealed(t.Concealed)
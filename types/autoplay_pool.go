@@ -0,0 +1,116 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// AutoplayPoolSource represents where an autoplay pool draws its media from
+type AutoplayPoolSource string
+
+// AutoplayPoolSourceList is the source of pools that draw media from their own list of entries
+const AutoplayPoolSourceList AutoplayPoolSource = "list"
+
+// AutoplayPoolSourceHistory is the source of pools that draw well-received media from the play history
+const AutoplayPoolSourceHistory AutoplayPoolSource = "history"
+
+// AutoplayPool is a moderator-managed set of media that is automatically enqueued when the queue of its channel is
+// empty
+type AutoplayPool struct {
+	ID              string `dbKey:"true"`
+	ChannelID       string
+	Name            string
+	Source          AutoplayPoolSource
+	Enabled         bool
+	Weight          int
+	Cooldown        Duration // minimum time between plays of the same media
+	ActiveFrom      Duration // since midnight UTC
+	ActiveUntil     Duration // since midnight UTC. When equal to ActiveFrom, the pool is active all day
+	HistoryLookback Duration
+	HistoryMinPlays int
+	UpdatedBy       string
+	UpdatedAt       time.Time
+}
+
+// ErrAutoplayPoolNotFound is returned when we can not find the specified autoplay pool
+var ErrAutoplayPoolNotFound = errors.New("autoplay pool not found")
+
+// GetAutoplayPools returns all the autoplay pools of a channel, sorted by name
+func GetAutoplayPools(ctx transaction.WrappingContext, channelID string) ([]*AutoplayPool, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"autoplay_pool.channel_id": channelID}).
+		OrderBy("autoplay_pool.name ASC")
+	return GetWithSelect[*AutoplayPool](ctx, s)
+}
+
+// GetAutoplayPoolWithID returns the autoplay pool with the given ID
+func GetAutoplayPoolWithID(ctx transaction.WrappingContext, id string) (*AutoplayPool, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"autoplay_pool.id": id})
+	items, err := GetWithSelect[*AutoplayPool](ctx, s)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if len(items) == 0 {
+		return nil, stacktrace.Propagate(ErrAutoplayPoolNotFound, "")
+	}
+	return items[0], nil
+}
+
+// Update updates or inserts the AutoplayPool
+func (obj *AutoplayPool) Update(ctx transaction.WrappingContext) error {
+	return Update(ctx, obj)
+}
+
+// Delete deletes the AutoplayPool and its entries
+func (obj *AutoplayPool) Delete(ctx transaction.WrappingContext) error {
+	return Delete(ctx, obj)
+}
+
+// AutoplayPoolEntry is a media belonging to an autoplay pool
+type AutoplayPoolEntry struct {
+	ID             string `dbKey:"true"`
+	PoolID         string
+	MediaType      MediaType
+	MediaReference string // YouTube video ID or SoundCloud track permalink
+	Weight         int
+}
+
+// ErrAutoplayPoolEntryNotFound is returned when we can not find the specified autoplay pool entry
+var ErrAutoplayPoolEntryNotFound = errors.New("autoplay pool entry not found")
+
+// GetAutoplayPoolEntries returns the entries of the specified autoplay pool
+func GetAutoplayPoolEntries(ctx transaction.WrappingContext, poolID string) ([]*AutoplayPoolEntry, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"autoplay_pool_entry.pool_id": poolID}).
+		OrderBy("autoplay_pool_entry.id ASC")
+	return GetWithSelect[*AutoplayPoolEntry](ctx, s)
+}
+
+// GetAutoplayPoolEntryWithID returns the autoplay pool entry with the given ID
+func GetAutoplayPoolEntryWithID(ctx transaction.WrappingContext, id string) (*AutoplayPoolEntry, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"autoplay_pool_entry.id": id})
+	items, err := GetWithSelect[*AutoplayPoolEntry](ctx, s)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if len(items) == 0 {
+		return nil, stacktrace.Propagate(ErrAutoplayPoolEntryNotFound, "")
+	}
+	return items[0], nil
+}
+
+// Update updates or inserts the AutoplayPoolEntry
+func (obj *AutoplayPoolEntry) Update(ctx transaction.WrappingContext) error {
+	return Update(ctx, obj)
+}
+
+// Delete deletes the AutoplayPoolEntry
+func (obj *AutoplayPoolEntry) Delete(ctx transaction.WrappingContext) error {
+	return Delete(ctx, obj)
+}
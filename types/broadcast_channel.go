@@ -0,0 +1,24 @@
+package types
+
+import (
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// MainBroadcastChannelID is the ID of the channel that existed before support for multiple channels was introduced.
+// Requests that do not specify a channel refer to this channel
+const MainBroadcastChannelID = "main"
+
+// BroadcastChannel is an independent broadcast channel, with its own queue and crowdfunding accounts
+type BroadcastChannel struct {
+	ID               string `dbKey:"true"`
+	Name             string
+	SkipAccountIndex uint32
+	RainAccountIndex uint32
+}
+
+// GetBroadcastChannels returns all the broadcast channels, sorted by ID
+func GetBroadcastChannels(ctx transaction.WrappingContext) ([]*BroadcastChannel, error) {
+	s := sdb.Select().
+		OrderBy("broadcast_channel.id ASC")
+	return GetWithSelect[*BroadcastChannel](ctx, s)
+}
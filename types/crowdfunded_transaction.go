@@ -14,16 +14,25 @@ type CrowdfundedTransactionType string
 const CrowdfundedTransactionTypeSkip CrowdfundedTransactionType = "skip"
 const CrowdfundedTransactionTypeRain CrowdfundedTransactionType = "rain"
 
+// CrowdfundedTransactionTypeScheduledRain is used for the distributions of scheduled rains, which are not backed by a
+// single block: their TxHash is the ID of the rain followed by the ordinal of the distribution
+const CrowdfundedTransactionTypeScheduledRain CrowdfundedTransactionType = "sched_rain"
+
 var CrowdfundedTransactionTypes = []CrowdfundedTransactionType{CrowdfundedTransactionTypeSkip, CrowdfundedTransactionTypeRain}
 
-// CrowdfundedTransaction represents a Skip & Tip transaction received by the service
+// CrowdfundedTransaction represents a Skip & Tip transaction received by the service.
+// Amount is always in Banano raw; for transactions received in another currency, it is the value of AmountInCurrency
+// at the time the transaction was received
 type CrowdfundedTransaction struct {
-	TxHash          string `dbKey:"true"`
-	FromAddress     string
-	Amount          decimal.Decimal
-	ReceivedAt      time.Time
-	TransactionType CrowdfundedTransactionType
-	ForMedia        *string
+	TxHash           string `dbKey:"true"`
+	FromAddress      string
+	Amount           decimal.Decimal
+	Currency         string
+	AmountInCurrency decimal.Decimal
+	ReceivedAt       time.Time
+	TransactionType  CrowdfundedTransactionType
+	ForMedia         *string
+	ChannelID        string
 }
 
 // SumCrowdfundedTransactionsFromAddressSince returns the sum of all crowdfunded transactions from an address since the specified time
@@ -55,8 +64,9 @@ func InsertCrowdfundedTransactions(ctx transaction.WrappingContext, items []*Cro
 	return stacktrace.Propagate(Insert(ctx, c...), "")
 }
 
-// SetMediaOfCrowdfundedTransactionsWithoutMedia sets the ForMedia field of all crowdfunded transactions without one to the specified value
-func SetMediaOfCrowdfundedTransactionsWithoutMedia(ctx transaction.WrappingContext, mediaID string) error {
+// SetMediaOfCrowdfundedTransactionsWithoutMedia sets the ForMedia field of all crowdfunded transactions of a channel
+// without one to the specified value
+func SetMediaOfCrowdfundedTransactionsWithoutMedia(ctx transaction.WrappingContext, channelID, mediaID string) error {
 	ctx, err := transaction.Begin(ctx)
 	if err != nil {
 		return stacktrace.Propagate(err, "")
@@ -66,6 +76,7 @@ func SetMediaOfCrowdfundedTransactionsWithoutMedia(ctx transaction.WrappingConte
 	_, err = sdb.Update("crowdfunded_transaction").
 		Set("for_media", mediaID).
 		Where("for_media IS NULL").
+		Where(sq.Eq{"channel_id": channelID}).
 		RunWith(ctx).ExecContext(ctx)
 	if err != nil {
 		return stacktrace.Propagate(err, "")
@@ -0,0 +1,212 @@
+package types
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// JournalAccount identifies an account of the double-entry journal.
+// Debits are recorded as positive amounts and credits as negative amounts, so the lines of every entry sum to zero
+type JournalAccount string
+
+const journalAccountOnChainPrefix = "chain:"
+const journalAccountRewardBalancePrefix = "reward_balance:"
+const journalAccountPendingWithdrawalPrefix = "pending_withdrawal:"
+
+// JournalAccountEquity is credited with the funds that enter our custody without being owed to anyone, and debited with
+// the funds that leave our custody or become owed to users
+const JournalAccountEquity JournalAccount = "equity"
+
+// JournalAccountOnChain returns the journal account of a Banano account in our custody.
+// Its balance is the raw amount the account is expected to hold, including receivables
+func JournalAccountOnChain(address string) JournalAccount {
+	return JournalAccount(journalAccountOnChainPrefix + address)
+}
+
+// JournalAccountRewardBalance returns the journal account of the reward balance of an address.
+// As a liability, its balance is the negated reward balance
+func JournalAccountRewardBalance(address string) JournalAccount {
+	return JournalAccount(journalAccountRewardBalancePrefix + address)
+}
+
+// JournalAccountPendingWithdrawal returns the journal account of the pending withdrawal of an address.
+// As a liability, its balance is the negated amount of the pending withdrawal
+func JournalAccountPendingWithdrawal(address string) JournalAccount {
+	return JournalAccount(journalAccountPendingWithdrawalPrefix + address)
+}
+
+// OnChainAddress returns the address of a journal account returned by JournalAccountOnChain
+func (a JournalAccount) OnChainAddress() (string, bool) {
+	return strings.CutPrefix(string(a), journalAccountOnChainPrefix)
+}
+
+// RewardBalanceAddress returns the address of a journal account returned by JournalAccountRewardBalance
+func (a JournalAccount) RewardBalanceAddress() (string, bool) {
+	return strings.CutPrefix(string(a), journalAccountRewardBalancePrefix)
+}
+
+// PendingWithdrawalAddress returns the address of a journal account returned by JournalAccountPendingWithdrawal
+func (a JournalAccount) PendingWithdrawalAddress() (string, bool) {
+	return strings.CutPrefix(string(a), journalAccountPendingWithdrawalPrefix)
+}
+
+// JournalEntry is a set of journal lines that record a single movement of funds
+type JournalEntry struct {
+	ID          string `dbKey:"true"`
+	CreatedAt   time.Time
+	Description string
+	Reference   *string
+}
+
+// JournalLine is the debit (positive amount) or credit (negative amount) of an account within a JournalEntry
+type JournalLine struct {
+	EntryID string         `dbKey:"true"`
+	Account JournalAccount `dbKey:"true"`
+	Amount  decimal.Decimal
+}
+
+// JournalTransfer returns the lines of a journal entry that moves amount from one account to another, crediting the
+// former and debiting the latter
+func JournalTransfer(from, to JournalAccount, amount decimal.Decimal) []*JournalLine {
+	return []*JournalLine{
+		{Account: to, Amount: amount},
+		{Account: from, Amount: amount.Neg()},
+	}
+}
+
+// NewJournalEntry builds a journal entry with the specified lines, which must sum to zero.
+// Lines of the same account are merged and lines that end up with a zero amount are omitted, so the returned lines may
+// be empty. reference should identify the object of the entry (a block hash, a ticket ID...) and may be empty
+func NewJournalEntry(description, reference string, lines ...*JournalLine) (*JournalEntry, []*JournalLine, error) {
+	sum := decimal.Zero
+	amountByAccount := make(map[JournalAccount]decimal.Decimal)
+	for _, line := range lines {
+		sum = sum.Add(line.Amount)
+		amountByAccount[line.Account] = amountByAccount[line.Account].Add(line.Amount)
+	}
+	if !sum.IsZero() {
+		return nil, nil, stacktrace.NewError("journal entry lines sum to %v instead of zero", sum)
+	}
+
+	entry := &JournalEntry{
+		ID:          uuid.NewV4().String(),
+		CreatedAt:   time.Now(),
+		Description: description,
+	}
+	if reference != "" {
+		entry.Reference = &reference
+	}
+
+	merged := make([]*JournalLine, 0, len(amountByAccount))
+	for account, amount := range amountByAccount {
+		if !amount.IsZero() {
+			merged = append(merged, &JournalLine{
+				EntryID: entry.ID,
+				Account: account,
+				Amount:  amount,
+			})
+		}
+	}
+	// consistent ordering avoids deadlocks between concurrent transactions
+	sort.Slice(merged, func(i, j int) bool { return merged[i].Account < merged[j].Account })
+	return entry, merged, nil
+}
+
+// RecordJournalEntry records a journal entry built by NewJournalEntry with the specified arguments.
+// Nothing is recorded if all the lines cancel out
+func RecordJournalEntry(ctx transaction.WrappingContext, description, reference string, lines ...*JournalLine) error {
+	ctx, err := transaction.Begin(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	entry, merged, err := NewJournalEntry(description, reference, lines...)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+
+	err = Insert(ctx, entry)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	err = Insert(ctx, merged...)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// JournalIsEmpty returns whether no journal entries have been recorded yet
+func JournalIsEmpty(ctx transaction.WrappingContext) (bool, error) {
+	ctx, err := transaction.Begin(ctx)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	var exists bool
+	err = sdb.Select("EXISTS (SELECT 1 FROM journal_entry)").RunWith(ctx).QueryRowContext(ctx).Scan(&exists)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	return !exists, nil
+}
+
+// GetJournalAccountBalances returns the balance of every account that appears in the journal
+func GetJournalAccountBalances(ctx transaction.WrappingContext) (map[JournalAccount]decimal.Decimal, error) {
+	ctx, err := transaction.Begin(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	rows, err := sdb.Select("journal_line.account", "SUM(journal_line.amount)").
+		From("journal_line").
+		GroupBy("journal_line.account").
+		RunWith(ctx).QueryContext(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer rows.Close()
+
+	result := make(map[JournalAccount]decimal.Decimal)
+	for rows.Next() {
+		var account JournalAccount
+		var balance decimal.Decimal
+		err := rows.Scan(&account, &balance)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		result[account] = balance
+	}
+	return result, stacktrace.Propagate(rows.Err(), "")
+}
+
+// JournalHasEntryWithReference returns whether a journal entry with the specified reference exists
+func JournalHasEntryWithReference(ctx transaction.WrappingContext, reference string) (bool, error) {
+	ctx, err := transaction.Begin(ctx)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	var exists bool
+	err = sdb.Select().
+		Column(sq.Expr("EXISTS (SELECT 1 FROM journal_entry WHERE reference = ?)", reference)).
+		RunWith(ctx).QueryRowContext(ctx).Scan(&exists)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	return exists, nil
+}
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJournalEntry(t *testing.T) {
+	payment := types.JournalAccountOnChain("ban_1payment")
+	collector := types.JournalAccountOnChain("ban_1collector")
+	alice := types.JournalAccountRewardBalance("ban_1alice")
+	bob := types.JournalAccountRewardBalance("ban_1bob")
+
+	_, _, err := types.NewJournalEntry("unbalanced", "",
+		&types.JournalLine{Account: payment, Amount: decimal.NewFromInt(10)},
+		&types.JournalLine{Account: types.JournalAccountEquity, Amount: decimal.NewFromInt(-9)})
+	require.Error(t, err)
+
+	// lines of the same account are merged, and are sorted by account
+	lines := append(types.JournalTransfer(alice, types.JournalAccountEquity, decimal.NewFromInt(4)),
+		types.JournalTransfer(bob, types.JournalAccountEquity, decimal.NewFromInt(6))...)
+	entry, merged, err := types.NewJournalEntry("rewards", "media", lines...)
+	require.NoError(t, err)
+	require.Equal(t, "rewards", entry.Description)
+	require.NotNil(t, entry.Reference)
+	require.Equal(t, "media", *entry.Reference)
+	require.Len(t, merged, 3)
+	require.Equal(t, types.JournalAccountEquity, merged[0].Account)
+	require.True(t, merged[0].Amount.Equal(decimal.NewFromInt(10)))
+	require.Equal(t, alice, merged[1].Account)
+	require.True(t, merged[1].Amount.Equal(decimal.NewFromInt(-4)))
+	require.Equal(t, bob, merged[2].Account)
+	require.True(t, merged[2].Amount.Equal(decimal.NewFromInt(-6)))
+	for _, line := range merged {
+		require.Equal(t, entry.ID, line.EntryID)
+	}
+
+	// lines that cancel out are omitted
+	lines = append(types.JournalTransfer(payment, collector, decimal.NewFromInt(5)),
+		types.JournalTransfer(collector, types.JournalAccountEquity, decimal.NewFromInt(5))...)
+	entry, merged, err = types.NewJournalEntry("collected", "", lines...)
+	require.NoError(t, err)
+	require.Nil(t, entry.Reference)
+	require.Len(t, merged, 2)
+	require.Equal(t, payment, merged[0].Account)
+	require.True(t, merged[0].Amount.Equal(decimal.NewFromInt(-5)))
+	require.Equal(t, types.JournalAccountEquity, merged[1].Account)
+	require.True(t, merged[1].Amount.Equal(decimal.NewFromInt(5)))
+
+	_, merged, err = types.NewJournalEntry("round trip", "",
+		append(types.JournalTransfer(payment, collector, decimal.NewFromInt(5)),
+			types.JournalTransfer(collector, payment, decimal.NewFromInt(5))...)...)
+	require.NoError(t, err)
+	require.Empty(t, merged)
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// LibraryMedia represents a media file uploaded to the server-hosted media library.
+// The file contents are kept outside of the database, see the medialibrary component
+type LibraryMedia struct {
+	ID         string `dbKey:"true"`
+	Title      string
+	Format     string // same values as the direct media formats, e.g. mp4, webm
+	Duration   Duration
+	FileSize   int64
+	Public     bool // whether non-staff users can enqueue this media
+	UploadedBy string
+	UploadedAt time.Time
+}
+
+// ErrLibraryMediaNotFound is returned when we can not find the specified library media
+var ErrLibraryMediaNotFound = errors.New("library media not found")
+
+// GetLibraryMedia returns all the media in the media library, most recently uploaded first
+func GetLibraryMedia(ctx transaction.WrappingContext, filter string, pagParams *PaginationParams) ([]*LibraryMedia, uint64, error) {
+	s := sdb.Select().
+		OrderBy("library_media.uploaded_at DESC")
+	if filter != "" {
+		s = s.Where(sq.Or{
+			sq.Expr("UPPER(library_media.title) LIKE '%' || UPPER(?) || '%'", filter),
+			sq.Eq{"library_media.id": filter},
+		})
+	}
+	s = applyPaginationParameters(s, pagParams)
+	return GetWithSelectAndCount[*LibraryMedia](ctx, s)
+}
+
+// GetLibraryMediaWithID returns the library media with the given ID
+func GetLibraryMediaWithID(ctx transaction.WrappingContext, id string) (*LibraryMedia, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"library_media.id": id})
+	items, err := GetWithSelect[*LibraryMedia](ctx, s)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if len(items) == 0 {
+		return nil, stacktrace.Propagate(ErrLibraryMediaNotFound, "")
+	}
+	return items[0], nil
+}
+
+// Update updates or inserts the LibraryMedia
+func (obj *LibraryMedia) Update(ctx transaction.WrappingContext) error {
+	return Update(ctx, obj)
+}
+
+// Delete deletes the LibraryMedia
+func (obj *LibraryMedia) Delete(ctx transaction.WrappingContext) error {
+	return Delete(ctx, obj)
+}
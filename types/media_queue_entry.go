@@ -0,0 +1,129 @@
+package types
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx/types"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// MediaQueueEntry is a media queue entry persisted in the database
+type MediaQueueEntry struct {
+	ID             string `dbKey:"true"`
+	ChannelID      string
+	Position       int
+	MediaType      MediaType
+	EntryData      types.JSONText
+	PlannedStart   sql.NullTime
+	SchedulingMode *MediaQueueSchedulingMode
+}
+
+// GetMediaQueueEntries returns all the persisted media queue entries of a channel, in queue order
+func GetMediaQueueEntries(ctx transaction.WrappingContext, channelID string) ([]*MediaQueueEntry, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"media_queue_entry.channel_id": channelID}).
+		OrderBy("media_queue_entry.position ASC")
+	return GetWithSelect[*MediaQueueEntry](ctx, s)
+}
+
+// InsertMediaQueueEntries inserts the passed media queue entries in the database
+func InsertMediaQueueEntries(ctx transaction.WrappingContext, items []*MediaQueueEntry) error {
+	c := make([]interface{}, len(items))
+	for i := range items {
+		c[i] = items[i]
+	}
+	return stacktrace.Propagate(Insert(ctx, c...), "")
+}
+
+// ShiftMediaQueueEntryPositions adds delta to the position of all persisted media queue entries of a channel at or after
+// fromPosition
+func ShiftMediaQueueEntryPositions(ctx transaction.WrappingContext, channelID string, fromPosition, delta int) error {
+	ctx, err := transaction.Begin(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	builder := sdb.Update("media_queue_entry").
+		Set("position", sq.Expr("position + ?", delta)).
+		Where(sq.Eq{"channel_id": channelID}).
+		Where(sq.GtOrEq{"position": fromPosition})
+	logger.Println(builder.ToSql())
+	_, err = builder.RunWith(ctx).ExecContext(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// ClearMediaQueueEntries deletes all persisted media queue entries of a channel
+func ClearMediaQueueEntries(ctx transaction.WrappingContext, channelID string) error {
+	return stacktrace.Propagate(DeleteCustom[*MediaQueueEntry](ctx, sdb.Delete("").Where(sq.Eq{"media_queue_entry.channel_id": channelID})), "")
+}
+
+// Update updates or inserts the MediaQueueEntry
+func (obj *MediaQueueEntry) Update(ctx transaction.WrappingContext) error {
+	return Update(ctx, obj)
+}
+
+// Delete deletes the MediaQueueEntry
+func (obj *MediaQueueEntry) Delete(ctx transaction.WrappingContext) error {
+	return Delete(ctx, obj)
+}
+
+// MediaQueueState contains the persisted media queue state that is not specific to any entry
+type MediaQueueState struct {
+	ID                   string `dbKey:"true"` // the ID of the channel the queue belongs to
+	InsertCursor         sql.NullString
+	PlayingSince         sql.NullTime
+	PausedAt             sql.NullTime
+	PauseReason          string
+	PausedEntryID        sql.NullString
+	PausedEntryPlayedFor Duration
+	// OrderingPolicy is the policy that decides where enqueued entries are placed
+	OrderingPolicy MediaQueueOrderingPolicy
+	// OrderingPolicyMaxConsecutive is the maximum number of consecutive entries per requester, when OrderingPolicy is
+	// MediaQueueOrderingPolicyMaxConsecutive
+	OrderingPolicyMaxConsecutive int
+	UpdatedAt                    time.Time
+}
+
+// MediaQueueOrderingPolicy represents how entries are placed when they are enqueued
+type MediaQueueOrderingPolicy string
+
+// MediaQueueOrderingPolicyFIFO is the ordering policy where new entries are placed at the end of the queue
+const MediaQueueOrderingPolicyFIFO MediaQueueOrderingPolicy = "fifo"
+
+// MediaQueueOrderingPolicyRoundRobin is the ordering policy where the entries of different requesters are interleaved
+const MediaQueueOrderingPolicyRoundRobin MediaQueueOrderingPolicy = "round_robin"
+
+// MediaQueueOrderingPolicyMaxConsecutive is the ordering policy where requesters can't have more than a certain number
+// of consecutive entries
+const MediaQueueOrderingPolicyMaxConsecutive MediaQueueOrderingPolicy = "max_consecutive"
+
+// ErrMediaQueueStateNotFound is returned when we can not find the persisted media queue state
+var ErrMediaQueueStateNotFound = errors.New("media queue state not found")
+
+// GetMediaQueueState returns the persisted media queue state with the given ID
+func GetMediaQueueState(ctx transaction.WrappingContext, id string) (*MediaQueueState, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"media_queue_state.id": id})
+	items, err := GetWithSelect[*MediaQueueState](ctx, s)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if len(items) == 0 {
+		return nil, stacktrace.Propagate(ErrMediaQueueStateNotFound, "")
+	}
+	return items[0], nil
+}
+
+// Update updates or inserts the MediaQueueState
+func (obj *MediaQueueState) Update(ctx transaction.WrappingContext) error {
+	return Update(ctx, obj)
+}
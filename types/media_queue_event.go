@@ -5,14 +5,17 @@ import (
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx/types"
 	"github.com/palantir/stacktrace"
 	"github.com/tnyim/jungletv/utils/transaction"
 )
 
 // MediaQueueEvent represents a noteworthy media queue event
 type MediaQueueEvent struct {
-	CreatedAt time.Time `dbKey:"true"`
-	EventType MediaQueueEventType
+	CreatedAt time.Time           `dbKey:"true"`
+	EventType MediaQueueEventType `dbKey:"true"`
+	ChannelID string              `dbKey:"true"`
+	Snapshot  types.NullJSONText
 }
 
 // MediaQueueEventType represents a type of media queue event
@@ -24,17 +27,23 @@ const MediaQueueFilled MediaQueueEventType = "filled"
 // MediaQueueEmptied is the media queue event type for when the queue becomes empty
 const MediaQueueEmptied MediaQueueEventType = "emptied"
 
+// MediaQueueSnapshotTaken is the media queue event type for when the contents of the queue are recorded after a change
+const MediaQueueSnapshotTaken MediaQueueEventType = "snapshot"
+
 // ErrMediaQueueEventNotFound is returned when we can not find the specified media queue event
 var ErrMediaQueueEventNotFound = errors.New("media queue event not found")
 
-// GetMostRecentMediaQueueEventWithType returns the most recent media queue event with the given type
+// GetMostRecentMediaQueueEventWithType returns the most recent media queue event of the main channel with the given type
 func GetMostRecentMediaQueueEventWithType(ctx transaction.WrappingContext, eventType ...MediaQueueEventType) (*MediaQueueEvent, error) {
 	s := sdb.Select().
+		Where(sq.Eq{"media_queue_event.channel_id": MainBroadcastChannelID}).
+		Where(sq.Eq{"media_queue_event.event_type": eventType}).
 		Where(subQueryEq(
 			"media_queue_event.created_at",
 			sq.Select("MAX(e.created_at)").
 				From("media_queue_event e").
-				Where(sq.Eq{"media_queue_event.event_type": eventType}),
+				Where(sq.Eq{"e.channel_id": MainBroadcastChannelID}).
+				Where(sq.Eq{"e.event_type": eventType}),
 		))
 	events, err := GetWithSelect[*MediaQueueEvent](ctx, s)
 	if err != nil {
@@ -46,6 +55,40 @@ func GetMostRecentMediaQueueEventWithType(ctx transaction.WrappingContext, event
 	return events[0], nil
 }
 
+// GetMediaQueueSnapshots returns the queue snapshots of a channel, most recent first
+func GetMediaQueueSnapshots(ctx transaction.WrappingContext, channelID string, pagParams *PaginationParams) ([]*MediaQueueEvent, uint64, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"media_queue_event.channel_id": channelID}).
+		Where(sq.Eq{"media_queue_event.event_type": MediaQueueSnapshotTaken}).
+		OrderBy("media_queue_event.created_at DESC")
+	s = applyPaginationParameters(s, pagParams)
+	return GetWithSelectAndCount[*MediaQueueEvent](ctx, s)
+}
+
+// GetMediaQueueSnapshot returns the queue snapshot of a channel taken at the specified time
+func GetMediaQueueSnapshot(ctx transaction.WrappingContext, channelID string, createdAt time.Time) (*MediaQueueEvent, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"media_queue_event.channel_id": channelID}).
+		Where(sq.Eq{"media_queue_event.event_type": MediaQueueSnapshotTaken}).
+		Where(sq.Eq{"media_queue_event.created_at": createdAt})
+	events, err := GetWithSelect[*MediaQueueEvent](ctx, s)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if len(events) == 0 {
+		return nil, stacktrace.Propagate(ErrMediaQueueEventNotFound, "")
+	}
+	return events[0], nil
+}
+
+// DeleteMediaQueueSnapshotsOlderThan deletes the queue snapshots of a channel taken before the specified time
+func DeleteMediaQueueSnapshotsOlderThan(ctx transaction.WrappingContext, channelID string, t time.Time) error {
+	return stacktrace.Propagate(DeleteCustom[*MediaQueueEvent](ctx, sdb.Delete("").
+		Where(sq.Eq{"media_queue_event.channel_id": channelID}).
+		Where(sq.Eq{"media_queue_event.event_type": MediaQueueSnapshotTaken}).
+		Where(sq.Lt{"media_queue_event.created_at": t})), "")
+}
+
 // InsertMediaQueueEvents inserts the passed received rewards in the database
 func InsertMediaQueueEvents(ctx transaction.WrappingContext, items []*MediaQueueEvent) error {
 	c := make([]interface{}, len(items))
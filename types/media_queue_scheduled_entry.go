@@ -0,0 +1,53 @@
+package types
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx/types"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// MediaQueueSchedulingMode represents how a scheduled media queue entry is released into the queue
+type MediaQueueSchedulingMode string
+
+// MediaQueueSchedulingModeNotBefore is the scheduling mode for entries that may not begin playing before their planned start time
+const MediaQueueSchedulingModeNotBefore MediaQueueSchedulingMode = "not_before"
+
+// MediaQueueSchedulingModeExactlyAt is the scheduling mode for entries that must begin playing at their planned start time
+const MediaQueueSchedulingModeExactlyAt MediaQueueSchedulingMode = "exactly_at"
+
+// MediaQueueScheduledEntry is a media queue entry waiting for its planned start time, persisted in the database
+type MediaQueueScheduledEntry struct {
+	ID             string `dbKey:"true"`
+	ChannelID      string
+	MediaType      MediaType
+	EntryData      types.JSONText
+	PlannedStart   time.Time
+	SchedulingMode MediaQueueSchedulingMode
+}
+
+// GetMediaQueueScheduledEntries returns all the persisted scheduled media queue entries of a channel, ordered by planned
+// start time
+func GetMediaQueueScheduledEntries(ctx transaction.WrappingContext, channelID string) ([]*MediaQueueScheduledEntry, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"media_queue_scheduled_entry.channel_id": channelID}).
+		OrderBy("media_queue_scheduled_entry.planned_start ASC")
+	return GetWithSelect[*MediaQueueScheduledEntry](ctx, s)
+}
+
+// ClearMediaQueueScheduledEntries deletes all persisted scheduled media queue entries of a channel
+func ClearMediaQueueScheduledEntries(ctx transaction.WrappingContext, channelID string) error {
+	return stacktrace.Propagate(DeleteCustom[*MediaQueueScheduledEntry](ctx, sdb.Delete("").Where(sq.Eq{"media_queue_scheduled_entry.channel_id": channelID})), "")
+}
+
+// Update updates or inserts the MediaQueueScheduledEntry
+func (obj *MediaQueueScheduledEntry) Update(ctx transaction.WrappingContext) error {
+	return Update(ctx, obj)
+}
+
+// Delete deletes the MediaQueueScheduledEntry
+func (obj *MediaQueueScheduledEntry) Delete(ctx transaction.WrappingContext) error {
+	return Delete(ctx, obj)
+}
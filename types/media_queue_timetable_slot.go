@@ -0,0 +1,59 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// MediaQueueTimetableSlot is a weekly recurring period of time during which scheduled entries take precedence over
+// the rest of the queue. Slots don't reserve airtime: entries that were not scheduled can still start playing during a
+// slot, or run into one, until a scheduled entry is released and interrupts them
+type MediaQueueTimetableSlot struct {
+	ID             string `dbKey:"true"`
+	ChannelID      string
+	Label          string
+	Weekday        int      // 0 is Sunday, in UTC
+	StartTimeOfDay Duration // since midnight UTC
+	Duration       Duration
+	UpdatedBy      string
+	UpdatedAt      time.Time
+}
+
+// ErrMediaQueueTimetableSlotNotFound is returned when we can not find the specified timetable slot
+var ErrMediaQueueTimetableSlotNotFound = errors.New("media queue timetable slot not found")
+
+// GetMediaQueueTimetableSlots returns all the media queue timetable slots of a channel, in weekly order
+func GetMediaQueueTimetableSlots(ctx transaction.WrappingContext, channelID string) ([]*MediaQueueTimetableSlot, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"media_queue_timetable_slot.channel_id": channelID}).
+		OrderBy("media_queue_timetable_slot.weekday ASC", "media_queue_timetable_slot.start_time_of_day ASC")
+	return GetWithSelect[*MediaQueueTimetableSlot](ctx, s)
+}
+
+// GetMediaQueueTimetableSlotWithID returns the media queue timetable slot with the given ID
+func GetMediaQueueTimetableSlotWithID(ctx transaction.WrappingContext, id string) (*MediaQueueTimetableSlot, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"media_queue_timetable_slot.id": id})
+	items, err := GetWithSelect[*MediaQueueTimetableSlot](ctx, s)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if len(items) == 0 {
+		return nil, stacktrace.Propagate(ErrMediaQueueTimetableSlotNotFound, "")
+	}
+	return items[0], nil
+}
+
+// Update updates or inserts the MediaQueueTimetableSlot
+func (obj *MediaQueueTimetableSlot) Update(ctx transaction.WrappingContext) error {
+	return Update(ctx, obj)
+}
+
+// Delete deletes the MediaQueueTimetableSlot
+func (obj *MediaQueueTimetableSlot) Delete(ctx transaction.WrappingContext) error {
+	return Delete(ctx, obj)
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// MediaReplayRule configures how soon media of a given type may be played again and how much its plays may overlap.
+// A rule may be narrowed down to a single collection (e.g. a YouTube channel or SoundCloud artist) and/or a single
+// requester
+type MediaReplayRule struct {
+	ID                   string `dbKey:"true"`
+	MediaType            MediaType
+	CollectionType       *MediaCollectionType
+	CollectionID         *string `dbColumn:"collection_id"`
+	Requester            *string
+	Cooldown             Duration // for how long after a play ends overlapping plays of the same media are refused
+	OverlapTolerance     Duration // how much plays of the same media may overlap, capped to 10% of the media length
+	MaxBroadcastPlayTime Duration // how long the same live broadcast may be played for, within the cooldown
+	UpdatedBy            string
+	UpdatedAt            time.Time
+}
+
+// ErrMediaReplayRuleNotFound is returned when we can not find the specified media replay rule
+var ErrMediaReplayRuleNotFound = errors.New("media replay rule not found")
+
+// GetMediaReplayRules returns all the media replay rules, sorted by media type
+func GetMediaReplayRules(ctx transaction.WrappingContext, pagParams *PaginationParams) ([]*MediaReplayRule, uint64, error) {
+	s := sdb.Select().
+		OrderBy("media_replay_rule.media_type ASC", "media_replay_rule.updated_at DESC")
+	s = applyPaginationParameters(s, pagParams)
+	return GetWithSelectAndCount[*MediaReplayRule](ctx, s)
+}
+
+// GetMediaReplayRuleWithID returns the media replay rule with the given ID
+func GetMediaReplayRuleWithID(ctx transaction.WrappingContext, id string) (*MediaReplayRule, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"media_replay_rule.id": id})
+	items, err := GetWithSelect[*MediaReplayRule](ctx, s)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if len(items) == 0 {
+		return nil, stacktrace.Propagate(ErrMediaReplayRuleNotFound, "")
+	}
+	return items[0], nil
+}
+
+// GetMediaReplayRulesForMedia returns the media replay rules of the specified media type that apply to media of the
+// specified collections, requested by the specified requester
+func GetMediaReplayRulesForMedia(ctx transaction.WrappingContext, mediaType MediaType, collectionTypes []MediaCollectionType, collectionIDs []string, requester string) ([]*MediaReplayRule, error) {
+	collectionConditions := sq.Or{
+		sq.Eq{"media_replay_rule.collection_type": nil},
+	}
+	for i := range collectionTypes {
+		collectionConditions = append(collectionConditions, sq.Eq{
+			"media_replay_rule.collection_type": collectionTypes[i],
+			"media_replay_rule.collection_id":   collectionIDs[i],
+		})
+	}
+	requesterConditions := sq.Or{
+		sq.Eq{"media_replay_rule.requester": nil},
+	}
+	if requester != "" {
+		requesterConditions = append(requesterConditions, sq.Eq{"media_replay_rule.requester": requester})
+	}
+
+	s := sdb.Select().
+		Where(sq.Eq{"media_replay_rule.media_type": mediaType}).
+		Where(collectionConditions).
+		Where(requesterConditions).
+		OrderBy("media_replay_rule.updated_at DESC")
+	items, err := GetWithSelect[*MediaReplayRule](ctx, s)
+	return items, stacktrace.Propagate(err, "")
+}
+
+// Update updates or inserts the MediaReplayRule
+func (obj *MediaReplayRule) Update(ctx transaction.WrappingContext) error {
+	return Update(ctx, obj)
+}
+
+// Delete deletes the MediaReplayRule
+func (obj *MediaReplayRule) Delete(ctx transaction.WrappingContext) error {
+	return Delete(ctx, obj)
+}
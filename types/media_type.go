@@ -6,8 +6,11 @@ const MediaTypeYouTubeVideo MediaType = "yt_video"
 const MediaTypeSoundCloudTrack MediaType = "sc_track"
 const MediaTypeDocument MediaType = "document"
 const MediaTypeApplicationPage MediaType = "app_page"
+const MediaTypeDirectMedia MediaType = "direct_url"
+const MediaTypeLibraryMedia MediaType = "lib_media"
 
 type MediaCollectionType string
 
 const MediaCollectionTypeYouTubeChannel MediaCollectionType = "yt_channel"
 const MediaCollectionTypeSoundCloudUser MediaCollectionType = "sc_user"
+const MediaCollectionTypeDirectMediaHost MediaCollectionType = "url_host"
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx/types"
+	"github.com/palantir/stacktrace"
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// PaymentIntentStatus is the status of a payment intent
+type PaymentIntentStatus string
+
+/* (intent created by application) -> pending
+   (received amount reaches expected amount, expiry reached or application cancels) -> concluding
+     (payment account emptied into the collector account) -> paid | expired | cancelled
+*/
+
+const PaymentIntentStatusPending PaymentIntentStatus = "pending"
+const PaymentIntentStatusConcluding PaymentIntentStatus = "concluding"
+const PaymentIntentStatusPaid PaymentIntentStatus = "paid"
+const PaymentIntentStatusExpired PaymentIntentStatus = "expired"
+const PaymentIntentStatusCancelled PaymentIntentStatus = "cancelled"
+
+// PaymentIntent is a durable request for a payment to an application, received through an account of the payment
+// account pool
+type PaymentIntent struct {
+	ID                  string `dbKey:"true"`
+	ApplicationID       string
+	PaymentAddress      string
+	PaymentAccountIndex int64
+	CollectorAddress    string
+	ExpectedAmount      decimal.Decimal
+	ReceivedAmount      decimal.Decimal
+	Metadata            types.JSONText
+	Status              PaymentIntentStatus
+	ConcludedStatus     *PaymentIntentStatus
+	CreatedAt           time.Time
+	ExpiresAt           time.Time
+	UpdatedAt           time.Time
+	DeliveredAt         sql.NullTime
+}
+
+// GetPaymentIntentsWithStatus returns all the payment intents in the specified status
+func GetPaymentIntentsWithStatus(ctx transaction.WrappingContext, status PaymentIntentStatus) ([]*PaymentIntent, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"payment_intent.status": status}).
+		OrderBy("payment_intent.created_at")
+	items, err := GetWithSelect[*PaymentIntent](ctx, s)
+	return items, stacktrace.Propagate(err, "")
+}
+
+// GetPaymentIntentOfApplication returns the payment intent with the specified ID, if it belongs to the specified
+// application
+func GetPaymentIntentOfApplication(ctx transaction.WrappingContext, applicationID, id string) (*PaymentIntent, bool, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"payment_intent.id": id}).
+		Where(sq.Eq{"payment_intent.application_id": applicationID})
+	items, err := GetWithSelect[*PaymentIntent](ctx, s)
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "")
+	}
+	if len(items) == 0 {
+		return nil, false, nil
+	}
+	return items[0], true, nil
+}
+
+// GetUndeliveredConcludedPaymentIntentsOfApplication returns the payment intents of the specified application that are
+// concluded and whose conclusion was not yet delivered to the application, in order of conclusion
+func GetUndeliveredConcludedPaymentIntentsOfApplication(ctx transaction.WrappingContext, applicationID string) ([]*PaymentIntent, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"payment_intent.application_id": applicationID}).
+		Where(sq.NotEq{"payment_intent.status": []PaymentIntentStatus{
+			PaymentIntentStatusPending,
+			PaymentIntentStatusConcluding,
+		}}).
+		Where(sq.Eq{"payment_intent.delivered_at": nil}).
+		OrderBy("payment_intent.updated_at")
+	items, err := GetWithSelect[*PaymentIntent](ctx, s)
+	return items, stacktrace.Propagate(err, "")
+}
+
+// Update updates or inserts the PaymentIntent
+func (obj *PaymentIntent) Update(ctx transaction.WrappingContext) error {
+	return Update(ctx, obj)
+}
@@ -11,20 +11,25 @@ import (
 	"github.com/tnyim/jungletv/utils/transaction"
 )
 
-// PlayedMedia is media that has played on the service
+// PlayedMedia is media that has played on the service.
+// RequestCost is always in Banano raw; RequestCostInCurrency is the amount that was actually paid, in the currency it
+// was paid in
 type PlayedMedia struct {
-	ID          string `dbKey:"true"`
-	EnqueuedAt  time.Time
-	StartedAt   time.Time
-	EndedAt     sql.NullTime
-	MediaLength Duration
-	MediaOffset Duration
-	RequestedBy string
-	RequestCost decimal.Decimal
-	Unskippable bool
-	MediaType   MediaType
-	MediaID     string `dbColumn:"media_id"`
-	MediaInfo   types.JSONText
+	ID                    string `dbKey:"true"`
+	EnqueuedAt            time.Time
+	StartedAt             time.Time
+	EndedAt               sql.NullTime
+	MediaLength           Duration
+	MediaOffset           Duration
+	RequestedBy           string
+	RequestCost           decimal.Decimal
+	RequestCostCurrency   string
+	RequestCostInCurrency decimal.Decimal
+	Unskippable           bool
+	MediaType             MediaType
+	MediaID               string `dbColumn:"media_id"`
+	MediaInfo             types.JSONText
+	ChannelID             string
 }
 
 // GetPlayedMediaFilters contains the filters to pass to GetPlayedMedia. Different conditions are ANDed together
@@ -36,6 +41,7 @@ type GetPlayedMediaFilters struct {
 	EnqueuedSince           time.Time
 	EnqueuedUntil           time.Time
 	TextFilter              string
+	ChannelID               string // when empty, media played on all channels is returned
 	OrderBy                 GetPlayedMediaOrderBy
 }
 
@@ -83,6 +89,9 @@ func GetPlayedMedia(ctx transaction.WrappingContext, filters GetPlayedMediaFilte
 	if !filters.EnqueuedUntil.IsZero() {
 		s = s.Where(sq.LtOrEq{"played_media.enqueued_at": filters.EnqueuedUntil})
 	}
+	if filters.ChannelID != "" {
+		s = s.Where(sq.Eq{"played_media.channel_id": filters.ChannelID})
+	}
 	if filters.TextFilter != "" {
 		s = s.Where(sq.Or{
 			sq.Eq{"played_media.media_id": filters.TextFilter},
@@ -274,3 +283,55 @@ func CountMediaRaffleEntriesRequestedByBetween(ctx transaction.WrappingContext,
 	err = s.RunWith(ctx).QueryRowContext(ctx).Scan(&count)
 	return count, stacktrace.Propagate(err, "")
 }
+
+// WellReceivedMedia is media that was paid for and played to completion multiple times
+type WellReceivedMedia struct {
+	MediaType MediaType
+	MediaID   string
+	Permalink string // only set for media types that are enqueued by permalink, like SoundCloud tracks
+	Plays     int
+}
+
+// GetWellReceivedMedia returns media of the specified types that, since the specified time, was played on a channel
+// to completion at least minPlays times as a result of a paid request. Results are sorted by number of plays, descending
+func GetWellReceivedMedia(ctx transaction.WrappingContext, channelID string, since time.Time, minPlays int, mediaTypes []MediaType, limit uint64) ([]WellReceivedMedia, error) {
+	ctx, err := transaction.Begin(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	rows, err := sdb.Select(
+		"played_media.media_type",
+		"played_media.media_id",
+		"COALESCE((ARRAY_AGG(played_media.media_info->>'permalink' ORDER BY played_media.started_at DESC))[1], '')",
+		"COUNT(*)").
+		From("played_media").
+		Where(sq.Eq{"played_media.channel_id": channelID}).
+		Where(sq.Gt{"played_media.started_at": since}).
+		Where(sq.Eq{"played_media.media_type": mediaTypes}).
+		Where(sq.NotEq{"played_media.requested_by": ""}).
+		Where(sq.Gt{"played_media.request_cost": 0}).
+		// media that was skipped or removed before reaching (close to) its end does not count
+		Where(sq.Expr("played_media.ended_at - played_media.started_at >= played_media.media_length * 0.9")).
+		GroupBy("played_media.media_type", "played_media.media_id").
+		Having(sq.GtOrEq{"COUNT(*)": minPlays}).
+		OrderBy("COUNT(*) DESC").
+		Limit(limit).
+		RunWith(ctx).QueryContext(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer rows.Close()
+
+	result := []WellReceivedMedia{}
+	for rows.Next() {
+		var m WellReceivedMedia
+		err := rows.Scan(&m.MediaType, &m.MediaID, &m.Permalink, &m.Plays)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		result = append(result, m)
+	}
+	return result, stacktrace.Propagate(rows.Err(), "")
+}
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// PointsReward is an item of the catalog of rewards that users can redeem with points
+type PointsReward struct {
+	ID            string `dbKey:"true"`
+	Title         string
+	Description   string
+	Cost          int
+	Stock         *int
+	PerUserLimit  *int
+	RequiresInput bool
+	Enabled       bool
+	UpdatedBy     string
+	UpdatedAt     time.Time
+}
+
+// ErrPointsRewardNotFound is returned when we can not find the specified points reward
+var ErrPointsRewardNotFound = errors.New("points reward not found")
+
+// GetPointsRewards returns the rewards in the catalog, optionally including the disabled ones
+func GetPointsRewards(ctx transaction.WrappingContext, includeDisabled bool) ([]*PointsReward, error) {
+	s := sdb.Select().
+		OrderBy("points_reward.cost ASC", "points_reward.title ASC", "points_reward.id")
+	if !includeDisabled {
+		s = s.Where(sq.Eq{"points_reward.enabled": true})
+	}
+	return GetWithSelect[*PointsReward](ctx, s)
+}
+
+// GetPointsRewardWithID returns the reward with the given ID
+func GetPointsRewardWithID(ctx transaction.WrappingContext, id string) (*PointsReward, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"points_reward.id": id})
+	items, err := GetWithSelect[*PointsReward](ctx, s)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if len(items) == 0 {
+		return nil, stacktrace.Propagate(ErrPointsRewardNotFound, "")
+	}
+	return items[0], nil
+}
+
+// Update updates or inserts the PointsReward
+func (obj *PointsReward) Update(ctx transaction.WrappingContext) error {
+	return Update(ctx, obj)
+}
+
+// AdjustStock adjusts the remaining stock of the PointsReward by the specified amount, if its stock is limited.
+// Returns false, without making changes, if the adjustment would make the stock negative
+func (obj *PointsReward) AdjustStock(ctx transaction.WrappingContext, amount int) (bool, error) {
+	if obj.Stock == nil {
+		return true, nil
+	}
+
+	result, err := sdb.Update("points_reward").
+		Set("stock", sq.Expr("stock + ?", amount)).
+		Where(sq.Eq{"id": obj.ID}).
+		Where(sq.Expr("stock + ? >= 0", amount)).
+		RunWith(ctx).ExecContext(ctx)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	if affected == 0 {
+		return false, nil
+	}
+	stock := *obj.Stock + amount
+	obj.Stock = &stock
+	return true, nil
+}
+
+// PointsRewardRedemptionStatus is the fulfilment status of a points reward redemption
+type PointsRewardRedemptionStatus string
+
+// PointsRewardRedemptionStatusPending is the status of redemptions that are yet to be fulfilled
+const PointsRewardRedemptionStatusPending PointsRewardRedemptionStatus = "pending"
+
+// PointsRewardRedemptionStatusFulfilled is the status of redemptions whose reward was delivered
+const PointsRewardRedemptionStatusFulfilled PointsRewardRedemptionStatus = "fulfilled"
+
+// PointsRewardRedemptionStatusRejected is the status of redemptions that were refunded without being fulfilled
+const PointsRewardRedemptionStatusRejected PointsRewardRedemptionStatus = "rejected"
+
+// PointsRewardRedemption is the redemption of a points reward by a user
+type PointsRewardRedemption struct {
+	ID             string `dbKey:"true"`
+	RewardID       string
+	RewardsAddress string
+	Cost           int
+	Input          *string
+	Status         PointsRewardRedemptionStatus
+	StaffNote      *string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	UpdatedBy      *string
+}
+
+// ErrPointsRewardRedemptionNotFound is returned when we can not find the specified points reward redemption
+var ErrPointsRewardRedemptionNotFound = errors.New("points reward redemption not found")
+
+// GetPointsRewardRedemptions returns redemptions, most recent first, optionally filtered by status and/or user
+func GetPointsRewardRedemptions(ctx transaction.WrappingContext, statuses []PointsRewardRedemptionStatus, address string, pagParams *PaginationParams) ([]*PointsRewardRedemption, uint64, error) {
+	s := sdb.Select().
+		OrderBy("points_reward_redemption.created_at DESC", "points_reward_redemption.id")
+	if len(statuses) > 0 {
+		s = s.Where(sq.Eq{"points_reward_redemption.status": statuses})
+	}
+	if address != "" {
+		s = s.Where(sq.Eq{"points_reward_redemption.rewards_address": address})
+	}
+	s = applyPaginationParameters(s, pagParams)
+	items, total, err := GetWithSelectAndCount[*PointsRewardRedemption](ctx, s)
+	return items, total, stacktrace.Propagate(err, "")
+}
+
+// GetPointsRewardRedemptionWithID returns the redemption with the given ID
+func GetPointsRewardRedemptionWithID(ctx transaction.WrappingContext, id string) (*PointsRewardRedemption, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"points_reward_redemption.id": id})
+	items, err := GetWithSelect[*PointsRewardRedemption](ctx, s)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if len(items) == 0 {
+		return nil, stacktrace.Propagate(ErrPointsRewardRedemptionNotFound, "")
+	}
+	return items[0], nil
+}
+
+// CountActivePointsRewardRedemptions returns how many times the specified user redeemed the specified reward,
+// excluding rejected redemptions
+func CountActivePointsRewardRedemptions(ctx transaction.WrappingContext, rewardID, address string) (int, error) {
+	var count int
+	err := sdb.Select("COUNT(*)").
+		From("points_reward_redemption").
+		Where(sq.Eq{"points_reward_redemption.reward_id": rewardID}).
+		Where(sq.Eq{"points_reward_redemption.rewards_address": address}).
+		Where(sq.NotEq{"points_reward_redemption.status": PointsRewardRedemptionStatusRejected}).
+		RunWith(ctx).QueryRowContext(ctx).Scan(&count)
+	return count, stacktrace.Propagate(err, "")
+}
+
+// Update updates or inserts the PointsRewardRedemption
+func (obj *PointsRewardRedemption) Update(ctx transaction.WrappingContext) error {
+	return Update(ctx, obj)
+}
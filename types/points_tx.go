@@ -117,4 +117,9 @@ const (
 	PointsTxTypeSkipThresholdIncrease       PointsTxType = 11
 	PointsTxTypeConcealedEntryEnqueuing     PointsTxType = 12
 	PointsTxTypeApplicationDefined          PointsTxType = 13
+	PointsTxTypeSkipVote                    PointsTxType = 14
+	PointsTxTypeTipSent                     PointsTxType = 15
+	PointsTxTypeTipReceived                 PointsTxType = 16
+	PointsTxTypeRewardRedemption            PointsTxType = 17
+	PointsTxTypeRewardRedemptionRefund      PointsTxType = 18
 )
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// PricingRule adjusts the prices of a channel when all of its conditions are met
+type PricingRule struct {
+	ID                          string `dbKey:"true"`
+	ChannelID                   string
+	Label                       string
+	AppliesToEnqueue            bool
+	AppliesToCrowdfundedSkip    bool
+	AppliesToQueueEntryMovement bool
+	Multiplier                  int       // percentage applied to the price, e.g. 80 for a 20% discount
+	Weekdays                    int       // bitmask where bit 0 is Sunday, in UTC. 0 means every day
+	StartTimeOfDay              *Duration // since midnight UTC
+	EndTimeOfDay                *Duration // since midnight UTC, before StartTimeOfDay when the period crosses midnight
+	MinQueueLength              *int
+	MaxQueueLength              *int
+	MinSpectators               *int
+	MaxSpectators               *int
+	ActiveFrom                  sql.NullTime
+	ActiveUntil                 sql.NullTime
+	UpdatedBy                   string
+	UpdatedAt                   time.Time
+}
+
+// ErrPricingRuleNotFound is returned when we can not find the specified pricing rule
+var ErrPricingRuleNotFound = errors.New("pricing rule not found")
+
+// GetPricingRules returns all the pricing rules of a channel
+func GetPricingRules(ctx transaction.WrappingContext, channelID string) ([]*PricingRule, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"pricing_rule.channel_id": channelID}).
+		OrderBy("pricing_rule.label ASC", "pricing_rule.id")
+	return GetWithSelect[*PricingRule](ctx, s)
+}
+
+// GetPricingRuleWithID returns the pricing rule with the given ID
+func GetPricingRuleWithID(ctx transaction.WrappingContext, id string) (*PricingRule, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"pricing_rule.id": id})
+	items, err := GetWithSelect[*PricingRule](ctx, s)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if len(items) == 0 {
+		return nil, stacktrace.Propagate(ErrPricingRuleNotFound, "")
+	}
+	return items[0], nil
+}
+
+// Update updates or inserts the PricingRule
+func (obj *PricingRule) Update(ctx transaction.WrappingContext) error {
+	return Update(ctx, obj)
+}
+
+// Delete deletes the PricingRule
+func (obj *PricingRule) Delete(ctx transaction.WrappingContext) error {
+	return Delete(ctx, obj)
+}
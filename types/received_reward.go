@@ -14,13 +14,36 @@ import (
 
 // ReceivedReward represents a reward received by a user for consuming media
 type ReceivedReward struct {
-	ID             string `dbKey:"true"`
-	RewardsAddress string
-	ReceivedAt     time.Time
-	Amount         decimal.Decimal
-	Media          string
+	ID                   string `dbKey:"true"`
+	RewardsAddress       string
+	ReceivedAt           time.Time
+	Amount               decimal.Decimal
+	Media                string
+	DistributionStrategy RewardDistributionStrategy
 }
 
+// RewardDistributionStrategy identifies how the amount of a received reward was determined
+type RewardDistributionStrategy string
+
+// RewardDistributionStrategyEqual means the reward budget was split equally among all eligible spectators
+const RewardDistributionStrategyEqual RewardDistributionStrategy = "equal"
+
+// RewardDistributionStrategyWatchTime means the reward budget was split in proportion to how long each eligible
+// spectator watched the media for
+const RewardDistributionStrategyWatchTime RewardDistributionStrategy = "watch_time"
+
+// RewardDistributionStrategyActivityTier means the reward budget was split according to how active each eligible
+// spectator was while the media played
+const RewardDistributionStrategyActivityTier RewardDistributionStrategy = "activity_tier"
+
+// RewardDistributionStrategyRequesterRainShare means the reward is the share of the rain that goes to the requester of
+// the media, which is not subject to the distribution strategy in use
+const RewardDistributionStrategyRequesterRainShare RewardDistributionStrategy = "requester_rain"
+
+// RewardDistributionStrategyScheduledRain means the reward is a share of a scheduled rain, which is split equally among
+// its recipients
+const RewardDistributionStrategyScheduledRain RewardDistributionStrategy = "scheduled_rain"
+
 // getReceivedRewardWithSelect returns a slice with all received rewards that match the conditions in sbuilder
 func getReceivedRewardWithSelect(ctx transaction.WrappingContext, sbuilder sq.SelectBuilder) ([]*ReceivedReward, uint64, error) {
 	ctx, err := transaction.Begin(ctx)
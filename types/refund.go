@@ -0,0 +1,114 @@
+package types
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/palantir/stacktrace"
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// RefundReason is the reason why a refund was made
+type RefundReason string
+
+// RefundReasonRemovedEntry is used when a queue entry is removed before it finishes playing
+const RefundReasonRemovedEntry RefundReason = "removed_entry"
+
+// RefundReasonNoEligibleSpectators is used when a queue entry finishes playing without anyone to reward
+const RefundReasonNoEligibleSpectators RefundReason = "no_spectators"
+
+// RefundReasonFailedTicket is used when a paid enqueue ticket can't be enqueued
+const RefundReasonFailedTicket RefundReason = "failed_ticket"
+
+// RefundReasonLatePayment is used when a payment arrives after its ticket expired
+const RefundReasonLatePayment RefundReason = "late_payment"
+
+// RefundReasonGoodwill is used for refunds manually proposed by moderators
+const RefundReasonGoodwill RefundReason = "goodwill"
+
+// RefundReasonCancelledRain is used when a scheduled rain funded by an application is cancelled before being fully
+// distributed, to return the remainder to the application wallet
+const RefundReasonCancelledRain RefundReason = "cancelled_rain"
+
+// RefundStatus is the status of a refund
+type RefundStatus string
+
+/* (refund needing review created) -> proposed
+     (moderator approves) -> approved
+   (refund not needing review created) -> approved
+     (refund sent) -> sent
+     (sending fails) -> failed
+       (moderator retries) -> approved
+*/
+
+const RefundStatusProposed RefundStatus = "proposed"
+const RefundStatusApproved RefundStatus = "approved"
+const RefundStatusSent RefundStatus = "sent"
+const RefundStatusFailed RefundStatus = "failed"
+
+// Refund is an entry in the refund ledger
+type Refund struct {
+	ID                  string `dbKey:"true"`
+	Address             string
+	Amount              decimal.Decimal
+	Reason              RefundReason
+	Status              RefundStatus
+	Comment             string
+	TicketID            *string
+	QueueEntryID        *string
+	PaymentBlockHash    *string
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	ReviewedBy          *string
+	TxHash              *string
+	FailureReason       *string
+	SendAttemptFrontier *string
+}
+
+// GetRefunds returns refunds in the specified statuses, or in any status if none are specified
+func GetRefunds(ctx transaction.WrappingContext, statuses []RefundStatus, pagParams *PaginationParams) ([]*Refund, uint64, error) {
+	s := sdb.Select().
+		OrderBy("refund.created_at DESC", "refund.id")
+	if len(statuses) > 0 {
+		s = s.Where(sq.Eq{"refund.status": statuses})
+	}
+	s = applyPaginationParameters(s, pagParams)
+	items, total, err := GetWithSelectAndCount[*Refund](ctx, s)
+	return items, total, stacktrace.Propagate(err, "")
+}
+
+// GetRefundsWithIDs returns the refunds with the specified IDs
+func GetRefundsWithIDs(ctx transaction.WrappingContext, ids []string) (map[string]*Refund, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"refund.id": ids})
+	items, err := GetWithSelect[*Refund](ctx, s)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	result := make(map[string]*Refund, len(items))
+	for i := range items {
+		result[items[i].ID] = items[i]
+	}
+	return result, nil
+}
+
+// GetRefundOfPaymentBlock returns the refund of the payment with the specified block hash, if one exists
+func GetRefundOfPaymentBlock(ctx transaction.WrappingContext, blockHash string) (*Refund, bool, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"refund.payment_block_hash": blockHash})
+	items, err := GetWithSelect[*Refund](ctx, s)
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "")
+	}
+	if len(items) == 0 {
+		return nil, false, nil
+	}
+	return items[0], true, nil
+}
+
+// Update updates or inserts the Refund
+func (obj *Refund) Update(ctx transaction.WrappingContext) error {
+	return Update(ctx, obj)
+}
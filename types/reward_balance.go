@@ -1,12 +1,14 @@
 package types
 
 import (
+	"slices"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/palantir/stacktrace"
 	"github.com/shopspring/decimal"
 	"github.com/tnyim/jungletv/utils/transaction"
+	"golang.org/x/exp/maps"
 )
 
 // RewardBalance represents the balance of not-yet-withdrawn rewards for a user
@@ -34,7 +36,8 @@ func GetRewardBalanceOfAddress(ctx transaction.WrappingContext, address string)
 }
 
 // GetRewardBalancesReadyForAutoWithdrawal returns rewards balances
-// that are ready for automated withdrawal according to the passed parameters
+// that are ready for automated withdrawal according to the passed parameters.
+// Balances of addresses with a withdrawal preference are not considered
 func GetRewardBalancesReadyForAutoWithdrawal(ctx transaction.WrappingContext, minBalance decimal.Decimal, unchangedSince time.Time) ([]*RewardBalance, error) {
 	s := sdb.Select().
 		Where(sq.Gt{"reward_balance.balance": decimal.Zero}).
@@ -42,7 +45,27 @@ func GetRewardBalancesReadyForAutoWithdrawal(ctx transaction.WrappingContext, mi
 			sq.GtOrEq{"reward_balance.balance": minBalance},
 			sq.Lt{"reward_balance.updated_at": unchangedSince},
 		}).
-		Where(sq.Expr("reward_balance.rewards_address NOT IN (SELECT rewards_address FROM pending_withdrawal)"))
+		Where(sq.Expr("reward_balance.rewards_address NOT IN (SELECT rewards_address FROM pending_withdrawal)")).
+		Where(sq.Expr("reward_balance.rewards_address NOT IN (SELECT rewards_address FROM withdrawal_preference)"))
+	items, err := GetWithSelect[*RewardBalance](ctx, s)
+	return items, stacktrace.Propagate(err, "")
+}
+
+// GetRewardBalancesWithWithdrawalPreference returns the positive rewards balances, without a pending withdrawal, of
+// addresses that have a withdrawal preference
+func GetRewardBalancesWithWithdrawalPreference(ctx transaction.WrappingContext) ([]*RewardBalance, error) {
+	s := sdb.Select().
+		Where(sq.Gt{"reward_balance.balance": decimal.Zero}).
+		Where(sq.Expr("reward_balance.rewards_address NOT IN (SELECT rewards_address FROM pending_withdrawal)")).
+		Where(sq.Expr("reward_balance.rewards_address IN (SELECT rewards_address FROM withdrawal_preference)"))
+	items, err := GetWithSelect[*RewardBalance](ctx, s)
+	return items, stacktrace.Propagate(err, "")
+}
+
+// GetNonZeroRewardBalances returns all reward balances that are not zero
+func GetNonZeroRewardBalances(ctx transaction.WrappingContext) ([]*RewardBalance, error) {
+	s := sdb.Select().
+		Where(sq.NotEq{"reward_balance.balance": decimal.Zero})
 	items, err := GetWithSelect[*RewardBalance](ctx, s)
 	return items, stacktrace.Propagate(err, "")
 }
@@ -65,6 +88,17 @@ func GetTotalOfRewardBalances(ctx transaction.WrappingContext) (decimal.Decimal,
 
 // AdjustRewardBalanceOfAddresses adjusts the balance of the specified addresses by the specified amount
 func AdjustRewardBalanceOfAddresses(ctx transaction.WrappingContext, addresses []string, amount decimal.Decimal) ([]*RewardBalance, error) {
+	amounts := make(map[string]decimal.Decimal, len(addresses))
+	for _, address := range addresses {
+		amounts[address] = amount
+	}
+	balances, err := AdjustRewardBalancesOfAddresses(ctx, amounts)
+	return balances, stacktrace.Propagate(err, "")
+}
+
+// AdjustRewardBalancesOfAddresses adjusts the balance of each address in amounts by the corresponding amount,
+// using a single statement
+func AdjustRewardBalancesOfAddresses(ctx transaction.WrappingContext, amounts map[string]decimal.Decimal) ([]*RewardBalance, error) {
 	ctx, err := transaction.Begin(ctx)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
@@ -73,9 +107,13 @@ func AdjustRewardBalanceOfAddresses(ctx transaction.WrappingContext, addresses [
 
 	now := time.Now()
 
+	// sort the addresses so that concurrent adjustments lock the rows in the same order
+	addresses := maps.Keys(amounts)
+	slices.Sort(addresses)
+
 	builder := sdb.Insert("reward_balance").Columns("rewards_address", "balance", "updated_at")
 	for _, address := range addresses {
-		builder = builder.Values(address, amount, now)
+		builder = builder.Values(address, amounts[address], now)
 	}
 	query, args, err := builder.Suffix(`
 		ON CONFLICT (rewards_address)
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// RewardDistributionSettings contains the persisted reward distribution settings of a broadcast channel
+type RewardDistributionSettings struct {
+	ChannelID            string `dbKey:"true"`
+	DistributionStrategy RewardDistributionStrategy
+	UpdatedAt            time.Time
+}
+
+// ErrRewardDistributionSettingsNotFound is returned when we can not find the reward distribution settings of a channel
+var ErrRewardDistributionSettingsNotFound = errors.New("reward distribution settings not found")
+
+// GetRewardDistributionSettings returns the reward distribution settings of the channel with the given ID
+func GetRewardDistributionSettings(ctx transaction.WrappingContext, channelID string) (*RewardDistributionSettings, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"reward_distribution_settings.channel_id": channelID})
+	items, err := GetWithSelect[*RewardDistributionSettings](ctx, s)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if len(items) == 0 {
+		return nil, stacktrace.Propagate(ErrRewardDistributionSettingsNotFound, "")
+	}
+	return items[0], nil
+}
+
+// Update updates or inserts the RewardDistributionSettings
+func (obj *RewardDistributionSettings) Update(ctx transaction.WrappingContext) error {
+	return Update(ctx, obj)
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/palantir/stacktrace"
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// ScheduledRainStatus is the status of a scheduled rain
+type ScheduledRainStatus string
+
+/* (rain scheduled by admin or application) -> scheduled
+   (whole amount distributed) -> complete
+   (admin or application cancels) -> cancelled
+*/
+
+const ScheduledRainStatusScheduled ScheduledRainStatus = "scheduled"
+const ScheduledRainStatusComplete ScheduledRainStatus = "complete"
+const ScheduledRainStatusCancelled ScheduledRainStatus = "cancelled"
+
+// ScheduledRainAudience determines who receives a scheduled rain
+type ScheduledRainAudience string
+
+// ScheduledRainAudienceSpectators means the rain is split among all spectators eligible for rewards
+const ScheduledRainAudienceSpectators ScheduledRainAudience = "spectators"
+
+// ScheduledRainAudienceChatters means the rain is split among the spectators eligible for rewards who sent chat
+// messages within the chat window of the rain
+const ScheduledRainAudienceChatters ScheduledRainAudience = "chatters"
+
+// ScheduledRain is an amount that is distributed among the spectators of a channel starting at a specific time,
+// either all at once or split over the rewards of multiple media
+type ScheduledRain struct {
+	ID                string `dbKey:"true"`
+	ChannelID         string
+	Amount            decimal.Decimal
+	DistributedAmount decimal.Decimal
+	Audience          ScheduledRainAudience
+	ChatWindow        Duration
+	MediaCount        int // zero when the rain is distributed all at once
+	MediaDistributed  int
+	ScheduledFor      time.Time
+	Status            ScheduledRainStatus
+	CreatedBy         string
+	ApplicationID     *string // set when the rain was funded by the wallet of an application
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// GetScheduledRains returns the scheduled rains in the specified statuses, most recently scheduled first.
+// If applicationID is not nil, only the rains of that application are returned
+func GetScheduledRains(ctx transaction.WrappingContext, statuses []ScheduledRainStatus, applicationID *string, pagParams *PaginationParams) ([]*ScheduledRain, uint64, error) {
+	s := sdb.Select().
+		OrderBy("scheduled_rain.scheduled_for DESC", "scheduled_rain.id")
+	if len(statuses) > 0 {
+		s = s.Where(sq.Eq{"scheduled_rain.status": statuses})
+	}
+	if applicationID != nil {
+		s = s.Where(sq.Eq{"scheduled_rain.application_id": *applicationID})
+	}
+	s = applyPaginationParameters(s, pagParams)
+	items, total, err := GetWithSelectAndCount[*ScheduledRain](ctx, s)
+	return items, total, stacktrace.Propagate(err, "")
+}
+
+// GetScheduledRainsWithIDs returns the scheduled rains with the specified IDs
+func GetScheduledRainsWithIDs(ctx transaction.WrappingContext, ids []string) (map[string]*ScheduledRain, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"scheduled_rain.id": ids})
+	items, err := GetWithSelect[*ScheduledRain](ctx, s)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	result := make(map[string]*ScheduledRain, len(items))
+	for i := range items {
+		result[items[i].ID] = items[i]
+	}
+	return result, nil
+}
+
+// GetDueScheduledRains returns the rains of a channel that are scheduled for a time no later than now, in order of
+// scheduling. overMedia determines whether the returned rains are the ones split over multiple media or the ones
+// distributed all at once
+func GetDueScheduledRains(ctx transaction.WrappingContext, channelID string, overMedia bool, now time.Time) ([]*ScheduledRain, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"scheduled_rain.channel_id": channelID}).
+		Where(sq.Eq{"scheduled_rain.status": ScheduledRainStatusScheduled}).
+		Where(sq.LtOrEq{"scheduled_rain.scheduled_for": now}).
+		OrderBy("scheduled_rain.scheduled_for", "scheduled_rain.id")
+	if overMedia {
+		s = s.Where(sq.Gt{"scheduled_rain.media_count": 0})
+	} else {
+		s = s.Where(sq.Eq{"scheduled_rain.media_count": 0})
+	}
+	items, err := GetWithSelect[*ScheduledRain](ctx, s)
+	return items, stacktrace.Propagate(err, "")
+}
+
+// RemainingAmount returns the amount of the rain that is yet to be distributed
+func (obj *ScheduledRain) RemainingAmount() decimal.Decimal {
+	return obj.Amount.Sub(obj.DistributedAmount)
+}
+
+// Update updates or inserts the ScheduledRain
+func (obj *ScheduledRain) Update(ctx transaction.WrappingContext) error {
+	return Update(ctx, obj)
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/palantir/stacktrace"
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// TipCurrency is the currency in which a tip was sent
+type TipCurrency string
+
+// TipCurrencyPoints is used for tips in points, whose amount is a number of points
+const TipCurrencyPoints TipCurrency = "points"
+
+// TipCurrencyBanano is used for tips in BAN, whose amount is in raw
+const TipCurrencyBanano TipCurrency = "banano"
+
+// Tip is a direct transfer of points or BAN from one user to another
+type Tip struct {
+	ID           string `dbKey:"true"`
+	FromAddress  string
+	ToAddress    string
+	Currency     TipCurrency
+	Amount       decimal.Decimal
+	QueueEntryID *string
+	Announced    bool
+	TxHash       *string
+	CreatedAt    time.Time
+}
+
+// GetTipsInvolvingAddress returns the tips sent or received by the specified address, most recent first
+func GetTipsInvolvingAddress(ctx transaction.WrappingContext, address string, pagParams *PaginationParams) ([]*Tip, uint64, error) {
+	s := sdb.Select().
+		Where(sq.Or{
+			sq.Eq{"tip.from_address": address},
+			sq.Eq{"tip.to_address": address},
+		}).
+		OrderBy("tip.created_at DESC", "tip.id")
+	s = applyPaginationParameters(s, pagParams)
+	items, total, err := GetWithSelectAndCount[*Tip](ctx, s)
+	return items, total, stacktrace.Propagate(err, "")
+}
+
+// Update updates or inserts the Tip
+func (obj *Tip) Update(ctx transaction.WrappingContext) error {
+	return Update(ctx, obj)
+}
@@ -114,6 +114,38 @@ func SumWithdrawalsToAddressSince(ctx transaction.WrappingContext, address strin
 	return totalAmount, nil
 }
 
+// GetLatestWithdrawalTimeOfAddresses returns the time at which the latest withdrawal to each of the specified
+// addresses was started, for those that have received withdrawals
+func GetLatestWithdrawalTimeOfAddresses(ctx transaction.WrappingContext, addresses []string) (map[string]time.Time, error) {
+	ctx, err := transaction.Begin(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	rows, err := sdb.Select("withdrawal.rewards_address", "MAX(withdrawal.started_at)").
+		From("withdrawal").
+		Where(sq.Eq{"withdrawal.rewards_address": addresses}).
+		GroupBy("withdrawal.rewards_address").
+		RunWith(ctx).QueryContext(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer rows.Close()
+
+	result := make(map[string]time.Time)
+	for rows.Next() {
+		var address string
+		var t time.Time
+		err := rows.Scan(&address, &t)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		result[address] = t
+	}
+	return result, stacktrace.Propagate(rows.Err(), "")
+}
+
 // Insert inserts the Withdrawal
 func (obj *Withdrawal) Insert(ctx transaction.WrappingContext) error {
 	return Insert(ctx, obj)
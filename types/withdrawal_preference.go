@@ -0,0 +1,80 @@
+package types
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/palantir/stacktrace"
+	"github.com/shopspring/decimal"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// WithdrawalCadence is the period of automatic withdrawals chosen by a user
+type WithdrawalCadence string
+
+const WithdrawalCadenceDaily WithdrawalCadence = "daily"
+const WithdrawalCadenceWeekly WithdrawalCadence = "weekly"
+const WithdrawalCadenceMonthly WithdrawalCadence = "monthly"
+
+// After returns the time at which a period of this cadence that starts at t ends
+func (c WithdrawalCadence) After(t time.Time) time.Time {
+	switch c {
+	case WithdrawalCadenceDaily:
+		return t.AddDate(0, 0, 1)
+	case WithdrawalCadenceWeekly:
+		return t.AddDate(0, 0, 7)
+	case WithdrawalCadenceMonthly:
+		return t.AddDate(0, 1, 0)
+	}
+	return t
+}
+
+// WithdrawalPreference holds the automatic withdrawal rules chosen by a user, which replace the default ones.
+// The balance is withdrawn as soon as either the threshold is reached or the cadence period has passed
+type WithdrawalPreference struct {
+	RewardsAddress string `dbKey:"true"`
+	Threshold      *decimal.Decimal
+	Cadence        *WithdrawalCadence
+	UpdatedAt      time.Time
+}
+
+// GetWithdrawalPreferenceOfAddress returns the withdrawal preference of the specified address, if one exists
+func GetWithdrawalPreferenceOfAddress(ctx transaction.WrappingContext, address string) (*WithdrawalPreference, bool, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"withdrawal_preference.rewards_address": address})
+	items, err := GetWithSelect[*WithdrawalPreference](ctx, s)
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "")
+	}
+	if len(items) == 0 {
+		return nil, false, nil
+	}
+	return items[0], true, nil
+}
+
+// GetWithdrawalPreferencesOfAddresses returns the withdrawal preferences of the specified addresses, for those that
+// have one
+func GetWithdrawalPreferencesOfAddresses(ctx transaction.WrappingContext, addresses []string) (map[string]*WithdrawalPreference, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"withdrawal_preference.rewards_address": addresses})
+	items, err := GetWithSelect[*WithdrawalPreference](ctx, s)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	result := make(map[string]*WithdrawalPreference, len(items))
+	for i := range items {
+		result[items[i].RewardsAddress] = items[i]
+	}
+	return result, nil
+}
+
+// Update updates or inserts the WithdrawalPreference
+func (obj *WithdrawalPreference) Update(ctx transaction.WrappingContext) error {
+	return Update(ctx, obj)
+}
+
+// Delete deletes the WithdrawalPreference
+func (obj *WithdrawalPreference) Delete(ctx transaction.WrappingContext) error {
+	return Delete(ctx, obj)
+}
@@ -0,0 +1,320 @@
+package transactiontest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/gbl08ma/sqalx"
+	"github.com/jmoiron/sqlx"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// FakeDatabase is an in-memory database for tests of components whose database effects are under test.
+// Statements are answered by the handlers registered through Handle, which read and modify a state of type S.
+// Transactions are serialized: each one works on a copy of the state, which replaces the committed state on commit
+type FakeDatabase[S any] struct {
+	txLock sync.Mutex // held for the duration of each transaction
+
+	stateLock sync.Mutex
+	state     S
+	clone     func(S) S
+	handlers  []fakeHandler[S]
+}
+
+// FakeHandlerFunc answers a statement executed against a FakeDatabase.
+// Handlers must not modify the state when they return an error
+type FakeHandlerFunc[S any] func(state S, stmt *FakeStatement) (*FakeResult, error)
+
+type fakeHandler[S any] struct {
+	pattern *regexp.Regexp
+	fn      FakeHandlerFunc[S]
+}
+
+// FakeStatement is a statement executed against a FakeDatabase
+type FakeStatement struct {
+	Query string
+	Args  []driver.Value
+}
+
+// FakeResult is the result of a statement executed against a FakeDatabase
+type FakeResult struct {
+	// Rows are the rows returned by queries. Values are converted like statement arguments are
+	Rows [][]any
+	// Columns are the names of the columns of Rows. They only need to be set for queries whose results are
+	// scanned by column name
+	Columns      []string
+	RowsAffected int64
+}
+
+// NewFakeDatabase returns a FakeDatabase with the given initial state.
+// clone must return a deep copy of the state it is given
+func NewFakeDatabase[S any](initialState S, clone func(S) S) *FakeDatabase[S] {
+	return &FakeDatabase[S]{
+		state: initialState,
+		clone: clone,
+	}
+}
+
+// Handle registers a handler for the statements matching the regular expression pattern.
+// When multiple handlers match a statement, the one registered first is used
+func (d *FakeDatabase[S]) Handle(pattern string, fn FakeHandlerFunc[S]) {
+	d.stateLock.Lock()
+	defer d.stateLock.Unlock()
+	d.handlers = append(d.handlers, fakeHandler[S]{
+		pattern: regexp.MustCompile(pattern),
+		fn:      fn,
+	})
+}
+
+// State returns a copy of the committed state
+func (d *FakeDatabase[S]) State() S {
+	d.stateLock.Lock()
+	defer d.stateLock.Unlock()
+	return d.clone(d.state)
+}
+
+// Context returns a context whose transactions run against this database
+func (d *FakeDatabase[S]) Context(ctx context.Context) context.Context {
+	db := sqlx.NewDb(sql.OpenDB(fakeConnector[S]{d}), "postgres")
+	node, err := sqalx.New(db)
+	if err != nil {
+		// sqalx.New only fails when given invalid options
+		panic(err)
+	}
+	return transaction.ContextWithBaseSqalxNode(ctx, node)
+}
+
+func (d *FakeDatabase[S]) begin() S {
+	d.txLock.Lock()
+	return d.State()
+}
+
+func (d *FakeDatabase[S]) commit(state S) {
+	d.stateLock.Lock()
+	d.state = state
+	d.stateLock.Unlock()
+	d.txLock.Unlock()
+}
+
+func (d *FakeDatabase[S]) rollback() {
+	d.txLock.Unlock()
+}
+
+func (d *FakeDatabase[S]) run(state S, query string, args []driver.Value) (*FakeResult, error) {
+	d.stateLock.Lock()
+	handlers := d.handlers
+	d.stateLock.Unlock()
+
+	for _, handler := range handlers {
+		if handler.pattern.MatchString(query) {
+			result, err := handler.fn(state, &FakeStatement{Query: query, Args: args})
+			if result == nil {
+				result = &FakeResult{}
+			}
+			return result, err
+		}
+	}
+	return nil, fmt.Errorf("fake database: unhandled statement: %s", query)
+}
+
+// Columns returns the unqualified names of the columns selected or inserted by the statement
+func (s *FakeStatement) Columns() []string {
+	var list string
+	upper := strings.ToUpper(s.Query)
+	switch {
+	case strings.HasPrefix(upper, "SELECT "):
+		end := strings.Index(upper, " FROM ")
+		if end < 0 {
+			end = len(upper)
+		}
+		list = s.Query[len("SELECT "):end]
+	case strings.HasPrefix(upper, "INSERT "):
+		start, end := strings.Index(s.Query, "("), strings.Index(s.Query, ")")
+		if start < 0 || end < start {
+			return nil
+		}
+		list = s.Query[start+1 : end]
+	default:
+		return nil
+	}
+	columns := strings.Split(list, ",")
+	for i := range columns {
+		columns[i] = strings.TrimSpace(columns[i])
+		if dot := strings.LastIndex(columns[i], "."); dot >= 0 {
+			columns[i] = columns[i][dot+1:]
+		}
+	}
+	return columns
+}
+
+// InsertedRows returns the rows inserted by an INSERT statement, as maps from column names to values
+func (s *FakeStatement) InsertedRows() []map[string]driver.Value {
+	columns := s.Columns()
+	if len(columns) == 0 {
+		return nil
+	}
+	rows := []map[string]driver.Value{}
+	for i := 0; i+len(columns) <= len(s.Args); i += len(columns) {
+		row := make(map[string]driver.Value)
+		for j, column := range columns {
+			row[column] = s.Args[i+j]
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// Row returns a row with the values for the columns selected by a SELECT statement, taken from values by column name.
+// Columns missing from values are NULL
+func (s *FakeStatement) Row(values map[string]driver.Value) []any {
+	columns := s.Columns()
+	row := make([]any, len(columns))
+	for i, column := range columns {
+		row[i] = values[column]
+	}
+	return row
+}
+
+type fakeConnector[S any] struct {
+	db *FakeDatabase[S]
+}
+
+func (c fakeConnector[S]) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn[S]{db: c.db}, nil
+}
+
+func (c fakeConnector[S]) Driver() driver.Driver {
+	return fakeDriver[S](c)
+}
+
+type fakeDriver[S any] struct {
+	db *FakeDatabase[S]
+}
+
+func (d fakeDriver[S]) Open(string) (driver.Conn, error) {
+	return &fakeConn[S]{db: d.db}, nil
+}
+
+type fakeConn[S any] struct {
+	db    *FakeDatabase[S]
+	inTx  bool
+	state S
+}
+
+func (c *fakeConn[S]) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt[S]{conn: c, query: query}, nil
+}
+
+func (c *fakeConn[S]) Close() error {
+	if c.inTx {
+		c.inTx = false
+		c.db.rollback()
+	}
+	return nil
+}
+
+func (c *fakeConn[S]) Begin() (driver.Tx, error) {
+	c.state = c.db.begin()
+	c.inTx = true
+	return &fakeTx[S]{conn: c}, nil
+}
+
+func (c *fakeConn[S]) run(query string, args []driver.Value) (*FakeResult, error) {
+	if c.inTx {
+		return c.db.run(c.state, query, args)
+	}
+	// statements outside of a transaction run in a transaction of their own
+	state := c.db.begin()
+	result, err := c.db.run(state, query, args)
+	if err != nil {
+		c.db.rollback()
+		return nil, err
+	}
+	c.db.commit(state)
+	return result, nil
+}
+
+type fakeTx[S any] struct {
+	conn *fakeConn[S]
+}
+
+func (t *fakeTx[S]) Commit() error {
+	t.conn.inTx = false
+	t.conn.db.commit(t.conn.state)
+	return nil
+}
+
+func (t *fakeTx[S]) Rollback() error {
+	t.conn.inTx = false
+	t.conn.db.rollback()
+	return nil
+}
+
+type fakeStmt[S any] struct {
+	conn  *fakeConn[S]
+	query string
+}
+
+func (s *fakeStmt[S]) Close() error {
+	return nil
+}
+
+func (s *fakeStmt[S]) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt[S]) Exec(args []driver.Value) (driver.Result, error) {
+	result, err := s.conn.run(s.query, args)
+	if err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(result.RowsAffected), nil
+}
+
+func (s *fakeStmt[S]) Query(args []driver.Value) (driver.Rows, error) {
+	result, err := s.conn.run(s.query, args)
+	if err != nil {
+		return nil, err
+	}
+	return &fakeRows{rows: result.Rows, columns: result.Columns}, nil
+}
+
+type fakeRows struct {
+	rows    [][]any
+	columns []string
+}
+
+func (r *fakeRows) Columns() []string {
+	if r.columns != nil {
+		return r.columns
+	}
+	if len(r.rows) == 0 {
+		return []string{}
+	}
+	return make([]string, len(r.rows[0]))
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	for i, value := range r.rows[0] {
+		converted, err := driver.DefaultParameterConverter.ConvertValue(value)
+		if err != nil {
+			return err
+		}
+		dest[i] = converted
+	}
+	r.rows = r.rows[1:]
+	return nil
+}
@@ -0,0 +1,105 @@
+// Package transactiontest provides utilities for testing components that persist their state through
+// transaction.WrappingContext
+package transactiontest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+
+	"github.com/gbl08ma/sqalx"
+	"github.com/jmoiron/sqlx"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// NullDatabaseContext returns a context whose transactions run against a database that accepts every statement and
+// returns no rows. It is meant for tests of components whose database effects are not under test, such as the money
+// paths exercised against a fake node
+func NullDatabaseContext(ctx context.Context) context.Context {
+	db := sqlx.NewDb(sql.OpenDB(nullConnector{}), "postgres")
+	node, err := sqalx.New(db)
+	if err != nil {
+		// sqalx.New only fails when given invalid options
+		panic(err)
+	}
+	return transaction.ContextWithBaseSqalxNode(ctx, node)
+}
+
+type nullConnector struct{}
+
+func (nullConnector) Connect(context.Context) (driver.Conn, error) {
+	return nullConn{}, nil
+}
+
+func (nullConnector) Driver() driver.Driver {
+	return nullDriver{}
+}
+
+type nullDriver struct{}
+
+func (nullDriver) Open(string) (driver.Conn, error) {
+	return nullConn{}, nil
+}
+
+type nullConn struct{}
+
+func (nullConn) Prepare(string) (driver.Stmt, error) {
+	return nullStmt{}, nil
+}
+
+func (nullConn) Close() error {
+	return nil
+}
+
+func (nullConn) Begin() (driver.Tx, error) {
+	return nullTx{}, nil
+}
+
+// CheckNamedValue accepts arguments of any type, since they are never looked at
+func (nullConn) CheckNamedValue(*driver.NamedValue) error {
+	return nil
+}
+
+type nullTx struct{}
+
+func (nullTx) Commit() error {
+	return nil
+}
+
+func (nullTx) Rollback() error {
+	return nil
+}
+
+type nullStmt struct{}
+
+func (nullStmt) Close() error {
+	return nil
+}
+
+func (nullStmt) NumInput() int {
+	return -1
+}
+
+// Exec reports a single affected row, so that updates and deletes of rows that are expected to exist succeed
+func (nullStmt) Exec([]driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(1), nil
+}
+
+func (nullStmt) Query([]driver.Value) (driver.Rows, error) {
+	return nullRows{}, nil
+}
+
+type nullRows struct{}
+
+func (nullRows) Columns() []string {
+	return []string{}
+}
+
+func (nullRows) Close() error {
+	return nil
+}
+
+func (nullRows) Next([]driver.Value) error {
+	return io.EOF
+}
@@ -14,10 +14,11 @@ import (
 	"github.com/gbl08ma/keybox"
 	"github.com/hectorchu/gonano/wallet"
 	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/components/node"
 	"github.com/tnyim/jungletv/types"
 )
 
-func buildWallet(secrets *keybox.Keybox) (*wallet.Wallet, *walletBuilder, error) {
+func buildWallet(secrets *keybox.Keybox) (node.Wallet, *walletBuilder, error) {
 	seedHex, present := secrets.Get("walletSeed")
 	if !present {
 		return nil, nil, stacktrace.NewError("wallet seed not present in keybox")
@@ -43,7 +44,7 @@ func buildWallet(secrets *keybox.Keybox) (*wallet.Wallet, *walletBuilder, error)
 	if present {
 		wallet.RPCWork = rpc.Client{URL: walletWorkRPCAddress}
 	}
-	return wallet, &walletBuilder{
+	return node.NewGonanoWallet(wallet), &walletBuilder{
 		masterSeed:           seed,
 		walletRPCAddress:     walletRPCAddress,
 		walletWorkRPCAddress: walletWorkRPCAddress,
@@ -56,7 +57,18 @@ type walletBuilder struct {
 	walletWorkRPCAddress string
 }
 
-func (b *walletBuilder) BuildApplicationWallet(applicationID string, earliestVersion types.ApplicationVersion) (*wallet.Wallet, error) {
+func (b *walletBuilder) deriveSeed(info []byte) ([]byte, error) {
+	hkdf := hkdf.New(sha256.New, b.masterSeed, nil, info)
+
+	seed := make([]byte, 32)
+	_, err := io.ReadFull(hkdf, seed)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return seed, nil
+}
+
+func (b *walletBuilder) BuildApplicationWallet(applicationID string, earliestVersion types.ApplicationVersion) (node.Wallet, error) {
 	info := new(bytes.Buffer)
 	_, err := info.WriteString("wallet-" + applicationID)
 	if err != nil {
@@ -67,10 +79,32 @@ func (b *walletBuilder) BuildApplicationWallet(applicationID string, earliestVer
 		return nil, stacktrace.Propagate(err, "")
 	}
 
-	hkdf := hkdf.New(sha256.New, b.masterSeed, nil, info.Bytes())
+	seed, err := b.deriveSeed(info.Bytes())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
 
-	seed := make([]byte, 32)
-	_, err = io.ReadFull(hkdf, seed)
+	wallet, err := wallet.NewBananoWallet(seed)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to create wallet")
+	}
+	wallet.WorkDifficulty = "fffffe0000000000"
+	wallet.ReceiveWorkDifficulty = "fffffe0000000000"
+
+	if b.walletRPCAddress != "" {
+		wallet.RPC = rpc.Client{URL: b.walletRPCAddress}
+	}
+	if b.walletWorkRPCAddress != "" {
+		wallet.RPCWork = rpc.Client{URL: b.walletWorkRPCAddress}
+	}
+
+	return node.NewGonanoWallet(wallet), nil
+}
+
+// BuildMockSwapLiquidityWallet returns the wallet the mock swap provider pays out from.
+// Its seed is derived from the master seed, so that none of its accounts can be accounts of the main wallet
+func (b *walletBuilder) BuildMockSwapLiquidityWallet() (node.Wallet, error) {
+	seed, err := b.deriveSeed([]byte("wallet-mock-swap-liquidity"))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
@@ -89,5 +123,31 @@ func (b *walletBuilder) BuildApplicationWallet(applicationID string, earliestVer
 		wallet.RPCWork = rpc.Client{URL: b.walletWorkRPCAddress}
 	}
 
-	return wallet, nil
+	return node.NewGonanoWallet(wallet), nil
+}
+
+// buildNanoWallet returns the wallet used to receive XNO directly, or nil if no XNO node is configured.
+// Its seed is derived from the master seed, so no additional secret needs to be kept
+func buildNanoWallet(secrets *keybox.Keybox, b *walletBuilder) (node.Wallet, error) {
+	rpcAddress, present := secrets.Get("nanoWalletRPCAddress")
+	if !present {
+		return nil, nil
+	}
+
+	seed, err := b.deriveSeed([]byte("wallet-nano"))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	wallet, err := wallet.NewWallet(seed)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to create wallet")
+	}
+	wallet.RPC = rpc.Client{URL: rpcAddress}
+
+	workRPCAddress, present := secrets.Get("nanoWalletWorkRPCAddress")
+	if present {
+		wallet.RPCWork = rpc.Client{URL: workRPCAddress}
+	}
+	return node.NewGonanoWallet(wallet), nil
 }